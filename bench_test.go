@@ -0,0 +1,195 @@
+package gotalog
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+// benchProgram is a generated program with the number of answers its query
+// should have.
+type benchProgram struct {
+	spec    ProgramSpec
+	answers int
+}
+
+var benchHuge = flag.Bool("huge", false, "benchmark programs of size 10000 and more, whose queries take minutes")
+
+// skip reports whether p is too large to check or benchmark: in short mode
+// those of size 1000 or more are skipped, and otherwise, unless -huge is
+// set, those of 10000 or more.
+func (p benchProgram) skip() bool {
+	return p.spec.Size >= 1000 && testing.Short() || p.spec.Size >= 10000 && !*benchHuge
+}
+
+var benchPrograms = []benchProgram{
+	{ProgramSpec{Kind: "clique", Size: 10}, 1},
+	{ProgramSpec{Kind: "clique", Size: 100}, 1},
+	{ProgramSpec{Kind: "clique", Size: 200}, 1},
+	{ProgramSpec{Kind: "clique", Size: 500}, 1},
+	{ProgramSpec{Kind: "clique", Size: 1000}, 1},
+	{ProgramSpec{Kind: "clique", Size: 10000}, 1},
+	{ProgramSpec{Kind: "graph", Size: 10}, 11},
+	{ProgramSpec{Kind: "graph", Size: 100}, 101},
+	{ProgramSpec{Kind: "graph", Size: 200}, 201},
+	{ProgramSpec{Kind: "graph", Size: 500}, 501},
+	{ProgramSpec{Kind: "graph", Size: 1000}, 1001},
+	{ProgramSpec{Kind: "graph", Size: 1500}, 1501},
+	{ProgramSpec{Kind: "graph", Size: 2000}, 2001},
+	{ProgramSpec{Kind: "graph", Size: 5000}, 5001},
+	{ProgramSpec{Kind: "graph", Size: 10000}, 10001},
+	{ProgramSpec{Kind: "induction", Size: 10}, 1},
+	{ProgramSpec{Kind: "induction", Size: 100}, 1},
+	{ProgramSpec{Kind: "induction", Size: 200}, 1},
+	{ProgramSpec{Kind: "induction", Size: 500}, 1},
+	{ProgramSpec{Kind: "induction", Size: 1000}, 1},
+	{ProgramSpec{Kind: "induction", Size: 1500}, 1},
+	{ProgramSpec{Kind: "induction", Size: 2000}, 1},
+	{ProgramSpec{Kind: "induction", Size: 5000}, 1},
+	{ProgramSpec{Kind: "induction", Size: 10000}, 1},
+	{ProgramSpec{Kind: "induction", Size: 50000}, 1},
+	{ProgramSpec{Kind: "random", Size: 100, Seed: 1}, 76},
+	{ProgramSpec{Kind: "random", Size: 1000, Seed: 1}, 815},
+}
+
+// benchDB names a database implementation and makes empty instances of it.
+// The returned function releases the instance.
+type benchDB struct {
+	name  string
+	newDB func(tb testing.TB) (Database, func())
+}
+
+var benchDBs = []benchDB{
+	{"MemDB", func(tb testing.TB) (Database, func()) {
+		return NewMemDatabase(), func() {}
+	}},
+	{"LockingDB", func(tb testing.TB) (Database, func()) {
+		return NewLockingDatabase(), func() {}
+	}},
+	{"DiskLogDB", func(tb testing.TB) (Database, func()) {
+		db, err := NewDiskLogDB(&bytes.Buffer{}, NewMemDatabase())
+		if err != nil {
+			tb.Fatal(err)
+		}
+		return db, func() {}
+	}},
+	{"DiskLogDir", func(tb testing.TB) (Database, func()) {
+		dir, err := ioutil.TempDir("", "benchlogdir")
+		if err != nil {
+			tb.Fatal(err)
+		}
+		db, err := OpenDiskLogDir(dir, NewMemDatabase(), DiskLogOptions{})
+		if err != nil {
+			os.RemoveAll(dir)
+			tb.Fatal(err)
+		}
+		return db, func() {
+			db.Close()
+			os.RemoveAll(dir)
+		}
+	}},
+}
+
+// generate returns the clauses of a program and its query.
+func (p benchProgram) generate(tb testing.TB) ([]DatalogCommand, DatalogCommand) {
+	var program []DatalogCommand
+	var query DatalogCommand
+	err := GenerateProgram(p.spec, func(cmd DatalogCommand) error {
+		if cmd.CommandType == Query {
+			query = cmd
+		} else {
+			program = append(program, cmd)
+		}
+		return nil
+	})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return program, query
+}
+
+func (p benchProgram) name() string {
+	return fmt.Sprintf("%v%v", p.spec.Kind, p.spec.Size)
+}
+
+func applyProgram(tb testing.TB, program []DatalogCommand, db Database) {
+	for _, cmd := range program {
+		_, err := Apply(cmd, db)
+		if err != nil {
+			tb.Fatal(err)
+		}
+	}
+}
+
+// checkAnswers runs the query of p on db, failing if it does not have the
+// reference number of answers.
+func (p benchProgram) checkAnswers(tb testing.TB, query DatalogCommand, db Database) {
+	res, err := Apply(query, db)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if len(res.Answers) != p.answers {
+		tb.Fatalf("%v: %v has %v answers, expected %v", p.spec, query, len(res.Answers), p.answers)
+	}
+}
+
+// TestBenchPrograms checks the reference answers of the benchmark programs.
+func TestBenchPrograms(t *testing.T) {
+	for _, p := range benchPrograms {
+		if p.spec.Size >= 1000 {
+			continue
+		}
+		program, query := p.generate(t)
+		for _, d := range benchDBs {
+			db, release := d.newDB(t)
+			applyProgram(t, program, db)
+			p.checkAnswers(t, query, db)
+			release()
+		}
+	}
+}
+
+// BenchmarkPrograms loads each generated benchmark program into each database
+// implementation, then answers its query, timing the two separately:
+//
+//	go test -run XXX -bench Programs/MemDB/clique
+//
+// Query times grow quadratically with the size of the programs.
+func BenchmarkPrograms(b *testing.B) {
+	for _, d := range benchDBs {
+		for _, p := range benchPrograms {
+			d, p := d, p
+			b.Run(fmt.Sprintf("%v/%v", d.name, p.name()), func(b *testing.B) {
+				if p.skip() {
+					b.SkipNow()
+				}
+				program, query := p.generate(b)
+				b.Run("load", func(b *testing.B) {
+					b.ReportAllocs()
+					for i := 0; i < b.N; i++ {
+						b.StopTimer()
+						db, release := d.newDB(b)
+						b.StartTimer()
+						applyProgram(b, program, db)
+						b.StopTimer()
+						release()
+						b.StartTimer()
+					}
+				})
+				b.Run("query", func(b *testing.B) {
+					db, release := d.newDB(b)
+					defer release()
+					applyProgram(b, program, db)
+					b.ReportAllocs()
+					b.ResetTimer()
+					for i := 0; i < b.N; i++ {
+						p.checkAnswers(b, query, db)
+					}
+				})
+			})
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package gotalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"time"
+)
+
+// A binary log starts with binaryLogMagic followed by a single version byte.
+// Each record that follows is framed as
+//
+//	length   uint32, big endian, the size of payload
+//	checksum uint32, big endian, CRC-32C of payload
+//	payload  the encoded command
+//
+// A payload starts with the record's sequence number as a uvarint and the
+// time it was written as a varint count of nanoseconds since the Unix epoch,
+// or zero if unknown. Version 1 logs omit both. Then follows the command
+// type as a byte, the head literal, the number of
+// body literals as a uvarint, and then the body literals. A literal is its
+// predicate name, its number of terms as a uvarint, and its terms. A term is
+// a byte that is 1 for constants and 0 for variables, followed by its value.
+// Declarations instead follow the command type with the directive name, the
+// predicate name, the number of attributes as a uvarint, and the name and
+// type of each attribute. Strings are written as a uvarint length followed
+// by their bytes.
+var binaryLogMagic = []byte("GTLG")
+
+const (
+	binaryLogVersion      = 2
+	binaryLogHeaderSize   = 5
+	binaryRecordFrameSize = 8
+	// Guards against allocating absurd amounts of memory when a length
+	// prefix has been corrupted.
+	maxBinaryRecordSize = 1 << 26
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+type binaryLogReader struct {
+	r       *bufio.Reader
+	off     int64
+	version byte
+}
+
+func newBinaryLogReader(r *bufio.Reader) (*binaryLogReader, error) {
+	header := make([]byte, binaryLogHeaderSize)
+	_, err := io.ReadFull(r, header)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header[:len(binaryLogMagic)], binaryLogMagic) {
+		return nil, fmt.Errorf("not a binary log")
+	}
+	version := header[len(binaryLogMagic)]
+	if version < 1 || version > binaryLogVersion {
+		return nil, fmt.Errorf("unsupported binary log version %v", version)
+	}
+	return &binaryLogReader{r: r, off: binaryLogHeaderSize, version: version}, nil
+}
+
+func (b *binaryLogReader) next() (logRecord, error) {
+	frame := make([]byte, binaryRecordFrameSize)
+	_, err := io.ReadFull(b.r, frame)
+	if err != nil {
+		return logRecord{}, err
+	}
+	length := binary.BigEndian.Uint32(frame[0:4])
+	if length > maxBinaryRecordSize {
+		return logRecord{}, fmt.Errorf("record at offset %v claims an invalid length of %v bytes", b.off, length)
+	}
+	payload := make([]byte, length)
+	_, err = io.ReadFull(b.r, payload)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return logRecord{}, err
+	}
+	if crc32.Checksum(payload, castagnoli) != binary.BigEndian.Uint32(frame[4:8]) {
+		return logRecord{}, fmt.Errorf("checksum mismatch in record at offset %v", b.off)
+	}
+	rec, err := decodeRecord(payload, b.version)
+	if err != nil {
+		return rec, fmt.Errorf("record at offset %v: %v", b.off, err)
+	}
+	b.off += binaryRecordFrameSize + int64(length)
+	return rec, nil
+}
+
+func (b *binaryLogReader) offset() int64 {
+	return b.off
+}
+
+func (b *binaryLogReader) appender(w io.Writer) logWriter {
+	return &binaryLogWriter{w: w, version: b.version}
+}
+
+// isTornBinaryTail reports whether tail is what an interrupted write of a
+// final record leaves behind: less than a frame header, a record cut short
+// with no intact record after it, or a single whole record that fails its
+// checksum. Anything else is corruption.
+func isTornBinaryTail(tail []byte) bool {
+	if len(tail) < binaryRecordFrameSize {
+		return true
+	}
+	length := int64(binary.BigEndian.Uint32(tail[0:4]))
+	if binaryRecordFrameSize+length > int64(len(tail)) {
+		// A corrupted length may claim more than the log holds, hiding the
+		// records after it.
+		return !holdsBinaryRecord(tail[binaryRecordFrameSize:])
+	}
+	if binaryRecordFrameSize+length < int64(len(tail)) {
+		return false
+	}
+	return crc32.Checksum(tail[binaryRecordFrameSize:], castagnoli) != binary.BigEndian.Uint32(tail[4:8])
+}
+
+// holdsBinaryRecord reports whether a non-empty record with a valid checksum
+// starts at any offset in b.
+func holdsBinaryRecord(b []byte) bool {
+	for i := 0; i+binaryRecordFrameSize < len(b); i++ {
+		length := int(binary.BigEndian.Uint32(b[i : i+4]))
+		end := i + binaryRecordFrameSize + length
+		if length == 0 || end > len(b) {
+			continue
+		}
+		if crc32.Checksum(b[i+binaryRecordFrameSize:end], castagnoli) == binary.BigEndian.Uint32(b[i+4:i+8]) {
+			return true
+		}
+	}
+	return false
+}
+
+type binaryLogWriter struct {
+	w       io.Writer
+	version byte
+}
+
+func newBinaryLogWriter(w io.Writer) (*binaryLogWriter, error) {
+	header := append(append([]byte{}, binaryLogMagic...), binaryLogVersion)
+	_, err := w.Write(header)
+	if err != nil {
+		return nil, err
+	}
+	return &binaryLogWriter{w: w, version: binaryLogVersion}, nil
+}
+
+func (b *binaryLogWriter) write(rec logRecord) error {
+	var payload []byte
+	if b.version >= 2 {
+		payload = binary.AppendUvarint(payload, rec.seq)
+		var nanos int64
+		if !rec.time.IsZero() {
+			nanos = rec.time.UnixNano()
+		}
+		payload = binary.AppendVarint(payload, nanos)
+	}
+	payload = encodeCommand(payload, rec.cmd)
+	record := make([]byte, binaryRecordFrameSize, binaryRecordFrameSize+len(payload))
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.Checksum(payload, castagnoli))
+	_, err := b.w.Write(append(record, payload...))
+	return err
+}
+
+func encodeCommand(buf []byte, cmd DatalogCommand) []byte {
+	buf = append(buf, byte(cmd.CommandType))
+	if cmd.CommandType == Directive {
+		return encodeDeclaration(buf, cmd.Directive)
+	}
+	buf = encodeLiteral(buf, cmd.Head)
+	buf = binary.AppendUvarint(buf, uint64(len(cmd.Body)))
+	for _, l := range cmd.Body {
+		buf = encodeLiteral(buf, l)
+	}
+	return buf
+}
+
+func encodeDeclaration(buf []byte, d *DirectiveDefinition) []byte {
+	buf = encodeString(buf, d.Name)
+	buf = encodeString(buf, d.Predicate)
+	buf = binary.AppendUvarint(buf, uint64(len(d.Attributes)))
+	for _, a := range d.Attributes {
+		buf = encodeString(buf, a.Name)
+		buf = encodeString(buf, a.Type)
+	}
+	return buf
+}
+
+func encodeLiteral(buf []byte, l LiteralDefinition) []byte {
+	buf = encodeString(buf, l.PredicateName)
+	buf = binary.AppendUvarint(buf, uint64(len(l.Terms)))
+	for _, t := range l.Terms {
+		if t.isConstant {
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+		}
+		buf = encodeString(buf, t.value)
+	}
+	return buf
+}
+
+func encodeString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+// payloadDecoder reads the fields of a single record's payload.
+type payloadDecoder struct {
+	buf []byte
+	err error
+}
+
+func decodeRecord(payload []byte, version byte) (logRecord, error) {
+	d := &payloadDecoder{buf: payload}
+	var rec logRecord
+	if version >= 2 {
+		rec.seq = d.uvarint()
+		if nanos := d.varint(); nanos != 0 {
+			rec.time = time.Unix(0, nanos).UTC()
+		}
+	}
+	rec.cmd = d.command()
+	if d.err == nil && len(d.buf) > 0 {
+		d.err = fmt.Errorf("%v unexpected trailing bytes", len(d.buf))
+	}
+	return rec, d.err
+}
+
+func (d *payloadDecoder) command() DatalogCommand {
+	var cmd DatalogCommand
+	cmd.CommandType = CommandType(d.byte())
+	if cmd.CommandType == Directive {
+		cmd.Directive = d.declaration()
+		return cmd
+	}
+	cmd.Head = d.literal()
+	n := d.uvarint()
+	for i := uint64(0); i < n && d.err == nil; i++ {
+		cmd.Body = append(cmd.Body, d.literal())
+	}
+	if d.err == nil && cmd.CommandType != Assert && cmd.CommandType != Retract && cmd.CommandType != Query {
+		d.err = fmt.Errorf("invalid command type %v", cmd.CommandType)
+	}
+	return cmd
+}
+
+func (d *payloadDecoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.buf) < 1 {
+		d.err = io.ErrUnexpectedEOF
+		return 0
+	}
+	b := d.buf[0]
+	d.buf = d.buf[1:]
+	return b
+}
+
+func (d *payloadDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 {
+		d.err = fmt.Errorf("invalid length")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *payloadDecoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = fmt.Errorf("invalid varint")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *payloadDecoder) string() string {
+	n := d.uvarint()
+	if d.err != nil {
+		return ""
+	}
+	if n > uint64(len(d.buf)) {
+		d.err = io.ErrUnexpectedEOF
+		return ""
+	}
+	s := string(d.buf[:n])
+	d.buf = d.buf[n:]
+	return s
+}
+
+func (d *payloadDecoder) declaration() *DirectiveDefinition {
+	decl := &DirectiveDefinition{Name: d.string(), Predicate: d.string()}
+	n := d.uvarint()
+	for i := uint64(0); i < n && d.err == nil; i++ {
+		decl.Attributes = append(decl.Attributes, Attribute{Name: d.string(), Type: d.string()})
+	}
+	if d.err == nil && decl.Name != "decl" {
+		d.err = fmt.Errorf("invalid directive .%v", decl.Name)
+	}
+	return decl
+}
+
+func (d *payloadDecoder) literal() LiteralDefinition {
+	l := LiteralDefinition{PredicateName: d.string()}
+	n := d.uvarint()
+	for i := uint64(0); i < n && d.err == nil; i++ {
+		isConstant := d.byte() == 1
+		l.Terms = append(l.Terms, Term{isConstant: isConstant, value: d.string()})
+	}
+	return l
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"../../gotalog"
+)
+
+// csvFlags selects the format of CSV files.
+type csvFlags struct {
+	tsv     *bool
+	header  *bool
+	columns *string
+}
+
+func addCSVFlags(fs *flag.FlagSet) *csvFlags {
+	return &csvFlags{
+		tsv:     fs.Bool("tsv", false, "use tabs rather than commas between fields"),
+		header:  fs.Bool("header", false, "the first line names the columns"),
+		columns: fs.String("columns", "", "comma-separated column names or numbers"),
+	}
+}
+
+func (c *csvFlags) options() gotalog.CSVOptions {
+	opts := gotalog.CSVOptions{Header: *c.header}
+	if *c.tsv {
+		opts.Comma = '\t'
+	}
+	if *c.columns != "" {
+		opts.Columns = strings.Split(*c.columns, ",")
+	}
+	return opts
+}
+
+func exitOnError(err error) {
+	if err != nil {
+		printError(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// importCSV asserts the records of each file given as arguments as facts.
+func importCSV(args []string) {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	dbf := addDatabaseFlags(fs)
+	cf := addCSVFlags(fs)
+	pred := fs.String("pred", "", "predicate of the imported facts")
+	fs.Parse(args)
+	if *pred == "" || *dbf.path == "" {
+		fmt.Fprintln(os.Stderr, "usage: import -db path -pred name [flags] file...")
+		fs.PrintDefaults()
+		os.Exit(2)
+	}
+
+	db, err := dbf.open(gotalog.NewMemDatabase)
+	exitOnError(err)
+	defer closeDatabase(db)
+
+	for _, filename := range fs.Args() {
+		f, err := os.Open(filename)
+		exitClosingOnError(db, err)
+		n, err := gotalog.ImportCSV(f, db, *pred, cf.options())
+		f.Close()
+		exitClosingOnError(db, err)
+		fmt.Fprintf(os.Stderr, "%v: imported %v facts\n", filename, n)
+	}
+}
+
+// exportCSV writes the facts of a predicate, or the answers to a query, as
+// CSV, after loading any datalog files given as arguments.
+func exportCSV(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	dbf := addDatabaseFlags(fs)
+	cf := addCSVFlags(fs)
+	pred := fs.String("pred", "", "export the facts of this predicate, given as name/arity")
+	query := fs.String("query", "", "export the answers to this query")
+	fs.Parse(args)
+	if (*pred == "") == (*query == "") {
+		fmt.Fprintln(os.Stderr, "usage: export (-pred name/arity | -query 'q(X)?') [flags] [file...]")
+		fs.PrintDefaults()
+		os.Exit(2)
+	}
+
+	db, err := dbf.open(gotalog.NewMemDatabase)
+	exitOnError(err)
+	defer closeDatabase(db)
+	for _, filename := range fs.Args() {
+		_, err := runFile(filename, db)
+		exitClosingOnError(db, err)
+	}
+
+	q := *query
+	if *pred != "" {
+		name, arity, err := parsePredicate(*pred)
+		exitClosingOnError(db, err)
+		if arity < 0 {
+			exitClosingOnError(db, fmt.Errorf("-pred needs an arity, as in %v/2", name))
+		}
+		vars := make([]string, arity)
+		for i := range vars {
+			vars[i] = fmt.Sprintf("X%v", i)
+		}
+		q = name + "?"
+		if arity > 0 {
+			q = fmt.Sprintf("%v(%v)?", name, strings.Join(vars, ", "))
+		}
+	}
+	cmds, err := gotalog.Parse(strings.NewReader(q))
+	exitClosingOnError(db, err)
+	if len(cmds) != 1 || cmds[0].CommandType != gotalog.Query {
+		exitClosingOnError(db, fmt.Errorf("-query must be a single query"))
+	}
+
+	res, err := gotalog.Apply(cmds[0], db)
+	exitClosingOnError(db, err)
+	exitClosingOnError(db, gotalog.ExportCSV(os.Stdout, *res, cf.options()))
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 3
+
+// lineEdit is a line kept (' '), removed ('-') or added ('+') by a diff.
+type lineEdit struct {
+	op   byte
+	line string
+}
+
+// splitLines splits text into lines, each keeping its newline, if it has
+// one.
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines returns the shortest edit script turning a into b, found with
+// the linear space refinement of Myers' algorithm: each middle snake found
+// splits the search in two.
+func diffLines(a, b []string) []lineEdit {
+	var edits []lineEdit
+	keep := func(lines []string) {
+		for _, line := range lines {
+			edits = append(edits, lineEdit{' ', line})
+		}
+	}
+	var diff func(a, b []string)
+	diff = func(a, b []string) {
+		i := 0
+		for i < len(a) && i < len(b) && a[i] == b[i] {
+			i++
+		}
+		keep(a[:i])
+		a, b = a[i:], b[i:]
+		j := 0
+		for j < len(a) && j < len(b) && a[len(a)-1-j] == b[len(b)-1-j] {
+			j++
+		}
+		suffix := a[len(a)-j:]
+		a, b = a[:len(a)-j], b[:len(b)-j]
+
+		switch {
+		case len(a) == 0:
+			for _, line := range b {
+				edits = append(edits, lineEdit{'+', line})
+			}
+		case len(b) == 0:
+			for _, line := range a {
+				edits = append(edits, lineEdit{'-', line})
+			}
+		default:
+			x, y, u, v := middleSnake(a, b)
+			diff(a[:x], b[:y])
+			keep(a[x:u])
+			diff(a[u:], b[v:])
+		}
+		keep(suffix)
+	}
+	diff(a, b)
+	return edits
+}
+
+// middleSnake returns the start (x, y) and end (u, v) of the snake in the
+// middle of a shortest edit script turning a into b, found by searching
+// forwards from the start and backwards from the end until the two meet.
+func middleSnake(a, b []string) (x, y, u, v int) {
+	n, m := len(a), len(b)
+	max := (n + m + 1) / 2
+	delta := n - m
+	offset := max + 1
+	// forward[offset+k] is the furthest x reached from the start on diagonal
+	// k = x - y, and backward[offset+k] the furthest reached from the end on
+	// diagonal k = (n - x) - (m - y).
+	forward := make([]int, 2*offset+1)
+	backward := make([]int, 2*offset+1)
+	for d := 0; d <= max; d++ {
+		for k := -d; k <= d; k += 2 {
+			if k == -d || k != d && forward[offset+k-1] < forward[offset+k+1] {
+				x = forward[offset+k+1]
+			} else {
+				x = forward[offset+k-1] + 1
+			}
+			y = x - k
+			u, v = x, y
+			for u < n && v < m && a[u] == b[v] {
+				u, v = u+1, v+1
+			}
+			forward[offset+k] = u
+			if back := delta - k; delta%2 != 0 && back >= -(d-1) && back <= d-1 && u+backward[offset+back] >= n {
+				return x, y, u, v
+			}
+		}
+		for k := -d; k <= d; k += 2 {
+			var bx int
+			if k == -d || k != d && backward[offset+k-1] < backward[offset+k+1] {
+				bx = backward[offset+k+1]
+			} else {
+				bx = backward[offset+k-1] + 1
+			}
+			by := bx - k
+			ex, ey := bx, by
+			for ex < n && ey < m && a[n-1-ex] == b[m-1-ey] {
+				ex, ey = ex+1, ey+1
+			}
+			backward[offset+k] = ex
+			if fwd := delta - k; delta%2 == 0 && fwd >= -d && fwd <= d && ex+forward[offset+fwd] >= n {
+				return n - ex, m - ey, n - bx, m - by
+			}
+		}
+	}
+	panic("unreachable")
+}
+
+// writeUnifiedDiff writes the differences between from and to as a unified
+// diff, with the file names given, as diff -u would. It writes nothing if
+// they are the same.
+func writeUnifiedDiff(w io.Writer, fromName, toName, from, to string) error {
+	edits := diffLines(splitLines(from), splitLines(to))
+	var changes []int
+	for i, e := range edits {
+		if e.op != ' ' {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	_, err := fmt.Fprintf(w, "--- %v\n+++ %v\n", fromName, toName)
+	if err != nil {
+		return err
+	}
+
+	// aLine and bLine number the lines of from and to before edits[i].
+	aLine, bLine, i := 0, 0, 0
+	for c := 0; c < len(changes); {
+		// A hunk runs from context before its first change to context after
+		// its last, merging changes whose contexts would overlap.
+		last := c
+		for last+1 < len(changes) && changes[last+1]-changes[last] <= 2*diffContext+1 {
+			last++
+		}
+		start := changes[c] - diffContext
+		if start < i {
+			start = i
+		}
+		end := changes[last] + diffContext + 1
+		if end > len(edits) {
+			end = len(edits)
+		}
+		for ; i < start; i++ {
+			aLine, bLine = aLine+1, bLine+1
+		}
+
+		var aCount, bCount int
+		for _, e := range edits[start:end] {
+			if e.op != '+' {
+				aCount++
+			}
+			if e.op != '-' {
+				bCount++
+			}
+		}
+		_, err = fmt.Fprintf(w, "@@ -%v +%v @@\n", hunkRange(aLine, aCount), hunkRange(bLine, bCount))
+		if err != nil {
+			return err
+		}
+		for _, e := range edits[start:end] {
+			line := string(e.op) + e.line
+			if !strings.HasSuffix(line, "\n") {
+				line += "\n\\ No newline at end of file\n"
+			}
+			_, err = io.WriteString(w, line)
+			if err != nil {
+				return err
+			}
+			if e.op != '+' {
+				aLine++
+			}
+			if e.op != '-' {
+				bLine++
+			}
+		}
+		i = end
+		c = last + 1
+	}
+	return nil
+}
+
+// hunkRange formats the range of a hunk of count lines that follows the
+// first lines of a file, as diff does: an empty range is numbered by the
+// line before it, and a count of one is left out.
+func hunkRange(first, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%v,0", first)
+	case 1:
+		return fmt.Sprint(first + 1)
+	}
+	return fmt.Sprintf("%v,%v", first+1, count)
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// applyEdits returns the lines an edit script turns from, and those it turns
+// them into.
+func applyEdits(edits []lineEdit) (from, to []string) {
+	for _, e := range edits {
+		if e.op != '+' {
+			from = append(from, e.line)
+		}
+		if e.op != '-' {
+			to = append(to, e.line)
+		}
+	}
+	return from, to
+}
+
+// countChanges returns the number of lines an edit script removes or adds.
+func countChanges(edits []lineEdit) int {
+	changes := 0
+	for _, e := range edits {
+		if e.op != ' ' {
+			changes++
+		}
+	}
+	return changes
+}
+
+// editDistance returns the fewest lines that must be removed or added to
+// turn a into b.
+func editDistance(a, b []string) int {
+	dist := make([][]int, len(a)+1)
+	for i := range dist {
+		dist[i] = make([]int, len(b)+1)
+		dist[i][0] = i
+	}
+	for j := range dist[0] {
+		dist[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				dist[i][j] = dist[i-1][j-1]
+			} else if dist[i-1][j] < dist[i][j-1] {
+				dist[i][j] = dist[i-1][j] + 1
+			} else {
+				dist[i][j] = dist[i][j-1] + 1
+			}
+		}
+	}
+	return dist[len(a)][len(b)]
+}
+
+func checkDiffLines(t *testing.T, a, b []string, changes int) {
+	edits := diffLines(a, b)
+	from, to := applyEdits(edits)
+	if strings.Join(from, "") != strings.Join(a, "") || strings.Join(to, "") != strings.Join(b, "") {
+		t.Errorf("diff of %q and %q: got edits %q", a, b, edits)
+	}
+	if got := countChanges(edits); got != changes {
+		t.Errorf("diff of %q and %q: got %v changes, expected %v", a, b, got, changes)
+	}
+}
+
+func TestDiffLinesIsShortest(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomLines := func() []string {
+		lines := make([]string, r.Intn(12))
+		for i := range lines {
+			lines[i] = string(rune('a'+r.Intn(3))) + "\n"
+		}
+		return lines
+	}
+	for i := 0; i < 2000; i++ {
+		a, b := randomLines(), randomLines()
+		checkDiffLines(t, a, b, editDistance(a, b))
+	}
+}
+
+func TestDiffLinesLargeInput(t *testing.T) {
+	var a, b []string
+	for i := 0; i < 10000; i++ {
+		line := fmt.Sprintf("edge(%v, %v).\n", i, i+1)
+		a = append(a, line)
+		if i%2 == 0 {
+			line = fmt.Sprintf("edge(%v, %v).\n", i+1, i)
+		}
+		b = append(b, line)
+	}
+	checkDiffLines(t, a, b, 10000)
+	checkDiffLines(t, a, nil, 10000)
+}
+
+func TestWriteUnifiedDiff(t *testing.T) {
+	numbered := func(n int, changed map[int]string) string {
+		var b strings.Builder
+		for i := 1; i <= n; i++ {
+			if line, ok := changed[i]; ok {
+				b.WriteString(line)
+				continue
+			}
+			fmt.Fprintf(&b, "%v\n", i)
+		}
+		return b.String()
+	}
+	for _, c := range []struct {
+		name, from, to, expected string
+	}{
+		{"same", "a\nb\n", "a\nb\n", ""},
+		{"empty", "", "", ""},
+		{"added", "", "a\n", "--- f\n+++ g\n@@ -0,0 +1 @@\n+a\n"},
+		{"removed", "a\nb\n", "", "--- f\n+++ g\n@@ -1,2 +0,0 @@\n-a\n-b\n"},
+		{"no newline", "a\nb", "a\nb\n", "--- f\n+++ g\n@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n"},
+		{"context", numbered(10, nil), numbered(10, map[int]string{5: "five\n"}),
+			"--- f\n+++ g\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n"},
+		{"merged hunks", numbered(12, nil), numbered(12, map[int]string{2: "two\n", 9: "nine\n"}),
+			"--- f\n+++ g\n@@ -1,12 +1,12 @@\n 1\n-2\n+two\n 3\n 4\n 5\n 6\n 7\n 8\n-9\n+nine\n 10\n 11\n 12\n"},
+		{"separate hunks", numbered(14, nil), numbered(14, map[int]string{2: "two\n", 10: "ten\n"}),
+			"--- f\n+++ g\n@@ -1,5 +1,5 @@\n 1\n-2\n+two\n 3\n 4\n 5\n@@ -7,7 +7,7 @@\n 7\n 8\n 9\n-10\n+ten\n 11\n 12\n 13\n"},
+	} {
+		var b strings.Builder
+		err := writeUnifiedDiff(&b, "f", "g", c.from, c.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b.String() != c.expected {
+			t.Errorf("%v: got\n%v\nexpected\n%v", c.name, b.String(), c.expected)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+
+	"../../gotalog"
+)
+
+// format reformats the datalog files given as arguments, or standard input,
+// printing the results. With -w, files are rewritten instead, and with -d,
+// the differences are printed as unified diffs, without needing a diff
+// command, and the exit status is 1 if there are any.
+func format(args []string) {
+	fs := flag.NewFlagSet("fmt", flag.ExitOnError)
+	write := fs.Bool("w", false, "write the result to each file instead of printing it")
+	diff := fs.Bool("d", false, "print unified diffs rather than the formatted files, and fail if any differ")
+	fs.Parse(args)
+
+	if fs.NArg() == 0 {
+		src, err := ioutil.ReadAll(os.Stdin)
+		if err == nil {
+			src, err = gotalog.Format(src)
+		}
+		if err != nil {
+			printError(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Stdout.Write(src)
+		return
+	}
+
+	changed := false
+	for _, filename := range fs.Args() {
+		src, err := ioutil.ReadFile(filename)
+		if err != nil {
+			printError(os.Stderr, err)
+			os.Exit(1)
+		}
+		out, err := gotalog.FormatFile(filename)
+		if err != nil {
+			printError(os.Stderr, err)
+			os.Exit(1)
+		}
+		switch {
+		case *diff:
+			if !bytes.Equal(src, out) {
+				changed = true
+				err = writeUnifiedDiff(os.Stdout, filename, filename+" (formatted)", string(src), string(out))
+			}
+		case *write:
+			if !bytes.Equal(src, out) {
+				err = ioutil.WriteFile(filename, out, 0644)
+			}
+		default:
+			_, err = os.Stdout.Write(out)
+		}
+		if err != nil {
+			printError(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+	if changed {
+		os.Exit(1)
+	}
+}
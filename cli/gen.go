@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"../../gotalog"
+)
+
+// gen generates a synthetic program of the kind and size given as
+// arguments, printing it, or with -db, asserting its clauses straight into
+// the database.
+func gen(args []string) {
+	fs := flag.NewFlagSet("gen", flag.ExitOnError)
+	dbf := addDatabaseFlags(fs)
+	seed := fs.Int64("seed", 1, "seed for random programs")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: gen [flags] %v size\n", strings.Join(gotalog.GeneratedKinds, "|"))
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	size, err := strconv.Atoi(fs.Arg(1))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "invalid size %q\n", fs.Arg(1))
+		os.Exit(2)
+	}
+	spec := gotalog.ProgramSpec{Kind: fs.Arg(0), Size: size, Seed: *seed}
+
+	if *dbf.path == "" {
+		w := bufio.NewWriter(os.Stdout)
+		err = gotalog.WriteProgram(w, spec)
+		if err == nil {
+			err = w.Flush()
+		}
+		if err != nil {
+			printError(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	db, err := dbf.open(gotalog.NewMemDatabase)
+	if err != nil {
+		printError(os.Stderr, err)
+		os.Exit(1)
+	}
+	_, err = gotalog.LoadProgram(db, spec)
+	closeDatabase(db)
+	exitOnError(err)
+}
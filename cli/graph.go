@@ -0,0 +1,44 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"../../gotalog"
+)
+
+// graph prints the predicate dependency graph of a database, after loading
+// any files given as arguments.
+func graph(args []string) {
+	fs := flag.NewFlagSet("graph", flag.ExitOnError)
+	dbf := addDatabaseFlags(fs)
+	format := fs.String("format", "dot", "output format: dot or json")
+	fs.Parse(args)
+	if *format != "dot" && *format != "json" {
+		fmt.Fprintf(os.Stderr, "unknown format %q\n", *format)
+		os.Exit(2)
+	}
+
+	db, err := dbf.open(gotalog.NewMemDatabase)
+	if err != nil {
+		printError(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer closeDatabase(db)
+	for _, filename := range fs.Args() {
+		_, err := runFile(filename, db)
+		exitClosingOnError(db, err)
+	}
+
+	g := gotalog.PredicateGraph(db)
+	if *format == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(g)
+	} else {
+		err = g.WriteDOT(os.Stdout)
+	}
+	exitClosingOnError(db, err)
+}
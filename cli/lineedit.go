@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// errInterrupted is returned by readLine when the user presses Ctrl-C.
+var errInterrupted = errors.New("interrupted")
+
+// lineEditor reads lines from a terminal, supporting cursor movement and a
+// history recalled with the arrow keys. When input is not a terminal, it
+// simply reads lines.
+type lineEditor struct {
+	in       *bufio.Reader
+	out      io.Writer
+	fd       int
+	terminal bool
+
+	history     []string
+	historyFile string
+}
+
+const maxHistory = 1000
+
+func newLineEditor(in *os.File, out io.Writer, historyFile string) *lineEditor {
+	e := &lineEditor{
+		in:          bufio.NewReader(in),
+		out:         out,
+		fd:          int(in.Fd()),
+		historyFile: historyFile,
+	}
+	e.terminal = isTerminal(e.fd)
+	if historyFile != "" {
+		if b, err := os.ReadFile(historyFile); err == nil {
+			for _, line := range strings.Split(string(b), "\n") {
+				if line != "" {
+					e.history = append(e.history, line)
+				}
+			}
+		}
+	}
+	return e
+}
+
+// addHistory records a line, so that it can be recalled later.
+func (e *lineEditor) addHistory(line string) {
+	if strings.TrimSpace(line) == "" || strings.Contains(line, "\n") {
+		return
+	}
+	if len(e.history) > 0 && e.history[len(e.history)-1] == line {
+		return
+	}
+	e.history = append(e.history, line)
+	if len(e.history) > maxHistory {
+		e.history = e.history[len(e.history)-maxHistory:]
+	}
+}
+
+// saveHistory writes the history to the history file, if there is one.
+func (e *lineEditor) saveHistory() error {
+	if e.historyFile == "" {
+		return nil
+	}
+	return os.WriteFile(e.historyFile, []byte(strings.Join(e.history, "\n")+"\n"), 0600)
+}
+
+// readLine prompts for and returns a line of input, without its newline.
+func (e *lineEditor) readLine(prompt string) (string, error) {
+	fmt.Fprint(e.out, prompt)
+	if !e.terminal {
+		line, err := e.in.ReadString('\n')
+		if err == io.EOF && line != "" {
+			err = nil
+		}
+		return strings.TrimRight(line, "\r\n"), err
+	}
+
+	restore, err := makeRaw(e.fd)
+	if err != nil {
+		return "", err
+	}
+	defer restore()
+
+	var (
+		buf []rune
+		pos int
+		// Position in history being edited; len(history) is the new line.
+		hist  = len(e.history)
+		draft []rune
+	)
+	redraw := func() {
+		fmt.Fprintf(e.out, "\r%v%v\x1b[K", prompt, string(buf))
+		if back := len(buf) - pos; back > 0 {
+			fmt.Fprintf(e.out, "\x1b[%vD", back)
+		}
+	}
+	recall := func(i int) {
+		if i < 0 || i > len(e.history) {
+			return
+		}
+		if hist == len(e.history) {
+			draft = buf
+		}
+		hist = i
+		if i == len(e.history) {
+			buf = draft
+		} else {
+			buf = []rune(e.history[i])
+		}
+		pos = len(buf)
+		redraw()
+	}
+
+	for {
+		ch, _, err := e.in.ReadRune()
+		if err != nil {
+			return string(buf), err
+		}
+		switch ch {
+		case '\r', '\n':
+			fmt.Fprint(e.out, "\r\n")
+			return string(buf), nil
+		case 3: // Ctrl-C
+			fmt.Fprint(e.out, "^C\r\n")
+			return "", errInterrupted
+		case 4: // Ctrl-D
+			if len(buf) == 0 {
+				fmt.Fprint(e.out, "\r\n")
+				return "", io.EOF
+			}
+			if pos < len(buf) {
+				buf = append(buf[:pos], buf[pos+1:]...)
+			}
+		case 1: // Ctrl-A
+			pos = 0
+		case 5: // Ctrl-E
+			pos = len(buf)
+		case 2: // Ctrl-B
+			if pos > 0 {
+				pos--
+			}
+		case 6: // Ctrl-F
+			if pos < len(buf) {
+				pos++
+			}
+		case 11: // Ctrl-K
+			buf = buf[:pos]
+		case 21: // Ctrl-U
+			buf = append([]rune{}, buf[pos:]...)
+			pos = 0
+		case 16: // Ctrl-P
+			recall(hist - 1)
+		case 14: // Ctrl-N
+			recall(hist + 1)
+		case 127, 8: // Backspace
+			if pos > 0 {
+				buf = append(buf[:pos-1], buf[pos:]...)
+				pos--
+			}
+		case 27: // Escape sequences for arrow and editing keys
+			e.escape(&buf, &pos, recall, hist)
+		default:
+			if ch < 32 {
+				continue
+			}
+			buf = append(buf[:pos], append([]rune{ch}, buf[pos:]...)...)
+			pos++
+		}
+		redraw()
+	}
+}
+
+// escape handles the remainder of an ANSI escape sequence.
+func (e *lineEditor) escape(buf *[]rune, pos *int, recall func(int), hist int) {
+	ch, _, err := e.in.ReadRune()
+	if err != nil || (ch != '[' && ch != 'O') {
+		return
+	}
+	ch, _, err = e.in.ReadRune()
+	if err != nil {
+		return
+	}
+	switch ch {
+	case 'A':
+		recall(hist - 1)
+	case 'B':
+		recall(hist + 1)
+	case 'C':
+		if *pos < len(*buf) {
+			*pos++
+		}
+	case 'D':
+		if *pos > 0 {
+			*pos--
+		}
+	case 'H':
+		*pos = 0
+	case 'F':
+		*pos = len(*buf)
+	case '1', '3', '4', '7', '8':
+		// Sequences of the form ESC [ n ~
+		if next, _, err := e.in.ReadRune(); err != nil || next != '~' {
+			return
+		}
+		switch ch {
+		case '1', '7':
+			*pos = 0
+		case '4', '8':
+			*pos = len(*buf)
+		case '3':
+			if *pos < len(*buf) {
+				*buf = append((*buf)[:*pos], (*buf)[*pos+1:]...)
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"../../gotalog"
+)
+
+// lint reports problems in the datalog files given as arguments, which are
+// analyzed together as one program. It exits with status 1 if any are errors
+// or, with -werror, warnings.
+func lint(args []string) {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	werror := fs.Bool("werror", false, "treat warnings as errors")
+	fs.Parse(args)
+
+	var cmds []gotalog.DatalogCommand
+	for _, filename := range fs.Args() {
+		fileCmds, err := readFile(filename)
+		if err != nil {
+			printError(os.Stderr, err)
+			os.Exit(1)
+		}
+		cmds = append(cmds, fileCmds...)
+	}
+
+	failed := false
+	for _, d := range gotalog.Lint(cmds) {
+		fmt.Println(d)
+		if d.Severity == gotalog.LintError || *werror {
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
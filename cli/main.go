@@ -1,44 +1,204 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"time"
 
 	"../../gotalog"
 )
 
-// This is a bare bones executor for datalog files.
-func main() {
-	db := gotalog.NewMemDatabase()
-	for i, filename := range os.Args {
-		if i == 0 {
-			continue
-		}
-		f, err := os.Open(filename)
-		defer f.Close()
+// dbFlags selects the database a command operates on.
+type dbFlags struct {
+	path     *string
+	asOfSeq  *uint64
+	asOfTime *string
+	strict   *bool
+}
+
+func addDatabaseFlags(fs *flag.FlagSet) *dbFlags {
+	return &dbFlags{
+		path:     fs.String("db", "", "persist the database in this log file, or in this directory with snapshots"),
+		asOfSeq:  fs.Uint64("as-of-seq", 0, "open -db as it was after this log sequence number"),
+		asOfTime: fs.String("as-of-time", "", "open -db as it was at this RFC 3339 time"),
+		strict:   fs.Bool("strict", false, "reject predicates that are not declared with .decl"),
+	}
+}
 
+// open returns a database from newBacking, or, if -db is set, one backed by
+// the log it names and replayed into a database from newBacking.
+func (d *dbFlags) open(newBacking func() gotalog.Database) (gotalog.Database, error) {
+	db, err := d.openLog(newBacking)
+	if err != nil {
+		return nil, err
+	}
+	// Set only once the log is replayed, as it may hold clauses asserted
+	// before their predicates were declared.
+	gotalog.SetStrict(db, *d.strict)
+	return db, nil
+}
+
+func (d *dbFlags) openLog(newBacking func() gotalog.Database) (gotalog.Database, error) {
+	if *d.path == "" {
+		return newBacking(), nil
+	}
+	opts := gotalog.DiskLogOptions{
+		RecoverTornTail: true,
+		AsOfSequence:    *d.asOfSeq,
+		Warn: func(msg string) {
+			fmt.Fprintln(os.Stderr, "warning:", msg)
+		},
+	}
+	if *d.asOfTime != "" {
+		t, err := time.Parse(time.RFC3339, *d.asOfTime)
 		if err != nil {
-			panic(err)
+			return nil, err
 		}
-		results := make([]gotalog.Result, 0)
-		commands, errors := gotalog.Scan(f)
-		for command := range commands {
-			res, err := gotalog.Apply(command, db)
-			if err != nil {
-				panic(err)
-			}
-			if res != nil {
-				results = append(results, *res)
-			}
+		opts.AsOfTime = t
+	}
+	if info, err := os.Stat(*d.path); err == nil && info.IsDir() {
+		return gotalog.OpenDiskLogDir(*d.path, newBacking(), opts)
+	}
+	flags := os.O_RDWR | os.O_CREATE
+	if opts.AsOfSequence != 0 || !opts.AsOfTime.IsZero() {
+		flags = os.O_RDONLY
+	}
+	f, err := os.OpenFile(*d.path, flags, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return gotalog.NewDiskLogDBWithOptions(f, newBacking(), opts)
+}
+
+// printError reports err. Parse errors are listed in full, each followed by
+// the offending line.
+func printError(w io.Writer, err error) {
+	var pes gotalog.ParseErrors
+	if errors.As(err, &pes) {
+		if msg := err.Error(); msg != pes.Error() {
+			// Report where the errors were included from.
+			fmt.Fprintln(w, "error:", msg)
 		}
-		select {
-		case err := <-errors:
-			if err != nil {
-				panic(err)
-			}
-		default:
+		for _, pe := range pes {
+			fmt.Fprintln(w, "error:", pe)
+			fmt.Fprintln(w, pe.Excerpt())
 		}
+		return
+	}
+	fmt.Fprintln(w, "error:", err)
+	var pe *gotalog.ParseError
+	if errors.As(err, &pe) {
+		fmt.Fprintln(w, pe.Excerpt())
+	}
+}
+
+// closeDatabase releases the files held by a log-backed database.
+func closeDatabase(db gotalog.Database) {
+	if c, ok := db.(io.Closer); ok {
+		c.Close()
+	}
+}
+
+// exitClosingOnError reports err and exits, if it is not nil, closing db
+// first, since exiting skips the deferred closeDatabase.
+func exitClosingOnError(db gotalog.Database, err error) {
+	if err != nil {
+		closeDatabase(db)
+		exitOnError(err)
+	}
+}
+
+// subcommands maps the first argument to the command it selects. Without
+// one, the arguments are datalog files to execute.
+var subcommands = map[string]func(args []string){
+	"export": exportCSV,
+	"fmt":    format,
+	"gen":    gen,
+	"graph":  graph,
+	"import": importCSV,
+	"lint":   lint,
+	"repl":   repl,
+	"serve":  serve,
+}
+
+// readFile returns the commands in a file. Files named *.jsonl hold
+// JSON-encoded commands, one per line; others hold datalog, whose includes
+// are expanded.
+func readFile(filename string) ([]gotalog.DatalogCommand, error) {
+	if filepath.Ext(filename) != ".jsonl" {
+		return gotalog.ParseFile(filename)
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var cmds []gotalog.DatalogCommand
+	commands, errors := gotalog.ScanJSON(f)
+	for command := range commands {
+		cmds = append(cmds, command)
+	}
+	return cmds, <-errors
+}
+
+// runFile applies each command in a file to db, and returns the results.
+// Directives are run relative to the file's directory.
+func runFile(filename string, db gotalog.Database) ([]gotalog.Result, error) {
+	commands, err := readFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return gotalog.ApplyProgramFunc(commands, db, filepath.Dir(filename), applyCommand)
+}
+
+// profile, if set, profiles each query, writing a table of its profile to
+// standard error.
+var profile bool
+
+// applyCommand applies a command, warning of retractions that matched
+// nothing.
+func applyCommand(command gotalog.DatalogCommand, db gotalog.Database) (*gotalog.Result, error) {
+	if !profile || command.CommandType != gotalog.Query {
+		res, err := gotalog.Apply(command, db)
+		if err == nil && command.CommandType == gotalog.Retract && res.Retracted == 0 {
+			fmt.Fprintf(os.Stderr, "warning: no clause of %v/%v matched a retraction\n", res.Name, res.Arity)
+		}
+		return res, err
+	}
+	res, p, err := gotalog.ApplyProfiled(context.Background(), command, db)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(os.Stderr, "profile of %v\n", command)
+	p.WriteTable(os.Stderr)
+	fmt.Fprintln(os.Stderr)
+	return res, nil
+}
+
+// This is a bare bones executor for datalog files.
+func main() {
+	if len(os.Args) > 1 {
+		if cmd, ok := subcommands[os.Args[1]]; ok {
+			cmd(os.Args[2:])
+			return
+		}
+	}
 
+	dbf := addDatabaseFlags(flag.CommandLine)
+	flag.BoolVar(&profile, "profile", false, "profile each query, printing a table to standard error")
+	flag.Parse()
+	db, err := dbf.open(gotalog.NewMemDatabase)
+	exitOnError(err)
+	defer closeDatabase(db)
+	for _, filename := range flag.Args() {
+		results, err := runFile(filename, db)
+		exitClosingOnError(db, err)
 		fmt.Print(gotalog.ToString(results))
 	}
 
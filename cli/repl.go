@@ -0,0 +1,342 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"../../gotalog"
+)
+
+const replHelp = `Enter datalog commands, ending each with '.', '?' or '~', or
+directives such as .input pred(filename="file.csv", delimiter=",").
+Commands may span several lines. Meta-commands are:
+  :load file...          execute the commands in each file
+  :list [pred[/arity]]   list stored clauses, optionally of one predicate
+  :retractall pred[/arity]
+                         retract every clause of a predicate
+  :time                  toggle reporting how long each command takes
+  :profile               toggle profiling queries
+  :help                  show this message
+  :quit                  leave the repl
+`
+
+// session is the state of an interactive repl.
+type session struct {
+	db     gotalog.Database
+	out    io.Writer
+	editor *lineEditor
+	timing bool
+	// profiling prints a profile of each query.
+	profiling bool
+}
+
+func defaultHistoryFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".gotalog_history")
+}
+
+// repl runs an interactive session, after loading any files given as
+// arguments.
+func repl(args []string) {
+	fs := flag.NewFlagSet("repl", flag.ExitOnError)
+	dbf := addDatabaseFlags(fs)
+	history := fs.String("history", defaultHistoryFile(), "file in which to keep line editing history")
+	fs.Parse(args)
+
+	db, err := dbf.open(gotalog.NewMemDatabase)
+	if err != nil {
+		printError(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer closeDatabase(db)
+
+	s := &session{
+		db:     db,
+		out:    os.Stdout,
+		editor: newLineEditor(os.Stdin, os.Stdout, *history),
+	}
+	for _, filename := range fs.Args() {
+		s.load(filename)
+	}
+	s.run()
+	err = s.editor.saveHistory()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "error saving history:", err)
+	}
+}
+
+func (s *session) run() {
+	var pending []string
+	for {
+		prompt := "?- "
+		if len(pending) > 0 {
+			prompt = "|  "
+		}
+		line, err := s.editor.readLine(prompt)
+		if err == errInterrupted {
+			pending = nil
+			continue
+		}
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			printError(s.out, err)
+			return
+		}
+
+		if len(pending) == 0 && strings.HasPrefix(strings.TrimSpace(line), ":") {
+			s.editor.addHistory(line)
+			if !s.meta(strings.Fields(line)) {
+				return
+			}
+			continue
+		}
+
+		pending = append(pending, line)
+		text := strings.Join(pending, "\n")
+		if !isComplete(text) {
+			continue
+		}
+		s.editor.addHistory(strings.Join(pending, " "))
+		pending = nil
+		cmds, err := gotalog.Parse(strings.NewReader(text))
+		if err != nil {
+			printError(s.out, err)
+			continue
+		}
+		s.execute(cmds, ".")
+	}
+}
+
+// isComplete reports whether text holds whole commands: that, ignoring
+// comments and whitespace, it is empty or ends with a terminal outside of any
+// quoted constant or block comment.
+func isComplete(text string) bool {
+	var (
+		last    rune
+		prev    rune
+		quote   rune
+		escaped bool
+		comment bool
+		block   bool
+	)
+	for _, ch := range text {
+		switch {
+		case block:
+			block = !(prev == '*' && ch == '/')
+			if !block {
+				ch = 0
+			}
+		case comment:
+			comment = ch != '\n'
+		case quote != 0:
+			if escaped {
+				escaped = false
+			} else if ch == '\\' {
+				escaped = true
+			} else if ch == quote {
+				quote = 0
+			}
+			last = ch
+		case ch == '%':
+			comment = true
+		case prev == '/' && ch == '*':
+			block = true
+			// Don't let the opening '*' also close the comment.
+			ch = 0
+		case ch == '\'' || ch == '"':
+			quote, last = ch, ch
+		case ch != ' ' && ch != '\t' && ch != '\n' && ch != '\r' && ch != '/':
+			last = ch
+		}
+		prev = ch
+	}
+	if quote != 0 || block {
+		return false
+	}
+	if trimmed := strings.TrimSpace(text); strings.HasPrefix(trimmed, ".") || strings.HasPrefix(trimmed, "#") {
+		// Directives have no terminal, so end at the end of the line, unless
+		// a parameter list is still open.
+		return strings.Count(text, "(") == strings.Count(text, ")")
+	}
+	return last == 0 || last == '.' || last == '?' || last == '~'
+}
+
+// execute applies commands with gotalog.ApplyProgram, reporting their results.
+// Directives are run relative to dir, and includes are loaded.
+func (s *session) execute(cmds []gotalog.DatalogCommand, dir string) {
+	cmds, err := expandIncludes(cmds, dir)
+	if err == nil {
+		_, err = gotalog.ApplyProgramFunc(cmds, s.db, dir, s.apply)
+	}
+	if err != nil {
+		printError(s.out, err)
+	}
+}
+
+// apply applies a command other than a directive, reporting its result.
+func (s *session) apply(cmd gotalog.DatalogCommand, db gotalog.Database) (*gotalog.Result, error) {
+	start := time.Now()
+	var (
+		res     *gotalog.Result
+		profile *gotalog.Profile
+		err     error
+	)
+	if s.profiling {
+		res, profile, err = gotalog.ApplyProfiled(context.Background(), cmd, db)
+	} else {
+		res, err = gotalog.Apply(cmd, db)
+	}
+	elapsed := time.Since(start)
+	if err != nil {
+		return nil, err
+	}
+	switch cmd.CommandType {
+	case gotalog.Query:
+		if len(res.Answers) == 0 {
+			fmt.Fprintln(s.out, "% no answers")
+		}
+		fmt.Fprint(s.out, gotalog.ToString([]gotalog.Result{*res}))
+	case gotalog.Retract:
+		fmt.Fprintf(s.out, "%% %v retracted\n", plural(res.Retracted, "clause"))
+	}
+	if s.timing {
+		fmt.Fprintf(s.out, "%% %v\n", elapsed)
+	}
+	if profile != nil {
+		profile.WriteTable(s.out)
+	}
+	return res, nil
+}
+
+// expandIncludes replaces each include directive in cmds with the commands of
+// the file it names, found relative to dir, as gotalog.ParseFile would.
+func expandIncludes(cmds []gotalog.DatalogCommand, dir string) ([]gotalog.DatalogCommand, error) {
+	var expanded []gotalog.DatalogCommand
+	for _, cmd := range cmds {
+		if cmd.CommandType != gotalog.Directive || cmd.Directive.Name != "include" {
+			expanded = append(expanded, cmd)
+			continue
+		}
+		filename := cmd.Directive.Params["filename"]
+		if !filepath.IsAbs(filename) {
+			filename = filepath.Join(dir, filename)
+		}
+		included, err := gotalog.ParseFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, included...)
+	}
+	return expanded, nil
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return strconv.Itoa(n) + " " + noun + "s"
+}
+
+func (s *session) load(filename string) {
+	cmds, err := gotalog.ParseFile(filename)
+	if err != nil {
+		printError(s.out, err)
+		return
+	}
+	s.execute(cmds, filepath.Dir(filename))
+}
+
+// parsePredicate parses a predicate given as name or name/arity. Without an
+// arity, it returns -1.
+func parsePredicate(spec string) (string, int, error) {
+	i := strings.LastIndex(spec, "/")
+	if i < 0 {
+		return spec, -1, nil
+	}
+	arity, err := strconv.Atoi(spec[i+1:])
+	if err != nil || arity < 0 {
+		return "", 0, fmt.Errorf("invalid arity in %v", spec)
+	}
+	return spec[:i], arity, nil
+}
+
+// meta executes a meta-command, returning false if the session should end.
+func (s *session) meta(fields []string) bool {
+	args := fields[1:]
+	switch fields[0] {
+	case ":quit", ":q", ":exit":
+		return false
+	case ":help", ":h":
+		fmt.Fprint(s.out, replHelp)
+	case ":load", ":l":
+		if len(args) == 0 {
+			fmt.Fprintln(s.out, "usage: :load file...")
+		}
+		for _, filename := range args {
+			s.load(filename)
+		}
+	case ":list":
+		name, arity := "", -1
+		if len(args) > 0 {
+			var err error
+			name, arity, err = parsePredicate(args[0])
+			if err != nil {
+				printError(s.out, err)
+				return true
+			}
+		}
+		for _, cmd := range gotalog.ListClauses(s.db, name, arity) {
+			fmt.Fprintln(s.out, cmd)
+		}
+	case ":retractall":
+		if len(args) != 1 {
+			fmt.Fprintln(s.out, "usage: :retractall pred[/arity]")
+			return true
+		}
+		name, arity, err := parsePredicate(args[0])
+		if err != nil {
+			printError(s.out, err)
+			return true
+		}
+		removed := 0
+		for _, cmd := range gotalog.ListClauses(s.db, name, arity) {
+			cmd.CommandType = gotalog.Retract
+			res, err := gotalog.Apply(cmd, s.db)
+			if err != nil {
+				printError(s.out, err)
+				break
+			}
+			removed += res.Retracted
+		}
+		fmt.Fprintf(s.out, "%% %v retracted\n", plural(removed, "clause"))
+	case ":time":
+		s.timing = !s.timing
+		if s.timing {
+			fmt.Fprintln(s.out, "% timing on")
+		} else {
+			fmt.Fprintln(s.out, "% timing off")
+		}
+	case ":profile":
+		s.profiling = !s.profiling
+		if s.profiling {
+			fmt.Fprintln(s.out, "% profiling on")
+		} else {
+			fmt.Fprintln(s.out, "% profiling off")
+		}
+	default:
+		fmt.Fprintf(s.out, "unknown command %v; try :help\n", fields[0])
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"../../gotalog"
+)
+
+// serve exposes a database over HTTP, after loading any files given as
+// arguments.
+func serve(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	dbf := addDatabaseFlags(fs)
+	addr := fs.String("addr", ":8080", "address on which to listen")
+	timeout := fs.Duration("timeout", 30*time.Second, "abandon requests that take longer than this; 0 for no limit")
+	fs.Parse(args)
+
+	db, err := dbf.open(gotalog.NewLockingDatabase)
+	if err != nil {
+		printError(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer closeDatabase(db)
+
+	for _, filename := range fs.Args() {
+		_, err := runFile(filename, db)
+		exitClosingOnError(db, err)
+	}
+
+	fmt.Fprintln(os.Stderr, "listening on", *addr)
+	err = http.ListenAndServe(*addr, gotalog.NewHandler(db, *timeout))
+	printError(os.Stderr, err)
+}
@@ -0,0 +1,13 @@
+//go:build !linux && !darwin
+
+package main
+
+import "errors"
+
+func isTerminal(fd int) bool {
+	return false
+}
+
+func makeRaw(fd int) (func(), error) {
+	return nil, errors.New("line editing is not supported on this platform")
+}
@@ -0,0 +1,156 @@
+package gotalog
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// CSVOptions controls how facts are read from and written to CSV files.
+type CSVOptions struct {
+	// Comma is the field delimiter. It defaults to ','; use '\t' for TSV.
+	Comma rune
+	// Header indicates that the first record names the columns. When
+	// exporting, a header is written.
+	Header bool
+	// Columns selects, when importing, the columns that become the terms of
+	// each fact, in order. Each is a name from the header, or a zero-based
+	// column number. By default every column is used. When exporting, it
+	// names the columns in the header, which default to arg1, arg2, ...
+	Columns []string
+}
+
+func (opts CSVOptions) comma() rune {
+	if opts.Comma == 0 {
+		return ','
+	}
+	return opts.Comma
+}
+
+// ImportCSV asserts a fact of the predicate name for each record read from r,
+// whose terms are the selected columns of the record, taken as constants. It
+// returns the number of facts asserted.
+func ImportCSV(r io.Reader, db Database, name string, opts CSVOptions) (int, error) {
+	if !isIdentifier(name) {
+		return 0, fmt.Errorf("invalid predicate name %q", name)
+	}
+	cr := csv.NewReader(r)
+	cr.Comma = opts.comma()
+	cr.ReuseRecord = true
+
+	var header []string
+	if opts.Header {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		header = append(header, record...)
+	}
+
+	var columns []int
+	for _, name := range opts.Columns {
+		column, err := findColumn(header, name)
+		if err != nil {
+			return 0, err
+		}
+		columns = append(columns, column)
+	}
+
+	var pred *predicate
+	count := 0
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+
+		var terms []Term
+		if columns == nil {
+			terms = make([]Term, len(record))
+			for i, value := range record {
+				terms[i] = makeConst(value)
+			}
+		} else {
+			terms = make([]Term, len(columns))
+			for i, column := range columns {
+				if column >= len(record) {
+					line, _ := cr.FieldPos(0)
+					return count, fmt.Errorf("line %v: no column %v", line, column)
+				}
+				terms[i] = makeConst(record[column])
+			}
+		}
+		if pred == nil {
+			pred = db.newPredicate(name, len(terms))
+		}
+
+		c := &clause{head: literal{pred: pred, terms: terms}}
+		err = checkClause(db, c)
+		if err != nil {
+			line, _ := cr.FieldPos(0)
+			return count, fmt.Errorf("line %v: %v", line, err)
+		}
+		err = db.assert(c)
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+}
+
+// findColumn returns the column named name in header, or numbered name.
+func findColumn(header []string, name string) (int, error) {
+	for i, h := range header {
+		if h == name {
+			return i, nil
+		}
+	}
+	column, err := strconv.Atoi(name)
+	if err != nil || column < 0 {
+		return 0, fmt.Errorf("no column named %q", name)
+	}
+	return column, nil
+}
+
+// ExportCSV writes each answer in res as a CSV record.
+func ExportCSV(w io.Writer, res Result, opts CSVOptions) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = opts.comma()
+
+	if opts.Header {
+		header := opts.Columns
+		if header == nil {
+			header = make([]string, res.Arity)
+			for i := range header {
+				header[i] = "arg" + strconv.Itoa(i+1)
+			}
+		}
+		if len(header) != res.Arity {
+			return fmt.Errorf("%v columns named for %v/%v", len(header), res.Name, res.Arity)
+		}
+		err := cw.Write(header)
+		if err != nil {
+			return err
+		}
+	}
+
+	record := make([]string, res.Arity)
+	for _, terms := range res.Answers {
+		for i, t := range terms {
+			record[i] = t.value
+		}
+		err := cw.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
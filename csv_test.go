@@ -0,0 +1,113 @@
+package gotalog
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+const customersCSV = `id,name,city
+1,Alice,"San Francisco"
+2,bob,"Portland, OR"
+3,"O'Brien",paris
+`
+
+func TestImportCSV(t *testing.T) {
+	db := NewMemDatabase()
+	n, err := ImportCSV(strings.NewReader(customersCSV), db, "customer_city", CSVOptions{
+		Header:  true,
+		Columns: []string{"name", "2"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("imported %v facts, expected 3", n)
+	}
+	result := parseApplyExecute(t, "customer_city(Name, City)?", db)
+	compareDatalogResult(t, result, `customer_city('Alice', 'San Francisco').
+customer_city(bob, 'Portland, OR').
+customer_city('O\'Brien', paris).
+`)
+
+	db = NewMemDatabase()
+	_, err = ImportCSV(strings.NewReader("a\tb\nc\td\n"), db, "edge", CSVOptions{Comma: '\t'})
+	if err != nil {
+		t.Fatal(err)
+	}
+	compareDatalogResult(t, parseApplyExecute(t, "edge(a, X)?", db), "edge(a, b).\n")
+
+	invalid := []CSVOptions{
+		{Header: true, Columns: []string{"country"}},
+		{Columns: []string{"7"}},
+	}
+	for _, opts := range invalid {
+		_, err = ImportCSV(strings.NewReader(customersCSV), NewMemDatabase(), "p", opts)
+		if err == nil {
+			t.Errorf("%+v: expected an error", opts)
+		}
+	}
+	for _, name := range []string{"", "my pred", "p(a)"} {
+		_, err = ImportCSV(strings.NewReader(customersCSV), NewMemDatabase(), name, CSVOptions{})
+		if err == nil {
+			t.Errorf("predicate name %q: expected an error", name)
+		}
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	db := NewMemDatabase()
+	_, err := ImportCSV(strings.NewReader(customersCSV), db, "customer", CSVOptions{Header: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Apply(mustParse(t, "customer(Id, Name, 'San Francisco')?")[0], db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	err = ExportCSV(&buf, *res, CSVOptions{Header: true, Comma: '\t'})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "arg1\targ2\targ3\n1\tAlice\tSan Francisco\n"
+	if buf.String() != expected {
+		t.Errorf("got %q, expected %q", buf.String(), expected)
+	}
+
+	err = ExportCSV(&buf, *res, CSVOptions{Header: true, Columns: []string{"id"}})
+	if err == nil {
+		t.Errorf("expected an error for a header of the wrong length")
+	}
+}
+
+// Imported constants need not be valid unquoted datalog, so they must survive
+// a trip through a text log.
+func TestImportCSVIntoDiskLog(t *testing.T) {
+	f, err := ioutil.TempFile("", "csvimport")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	db, err := NewDiskLogDB(f, NewMemDatabase())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ImportCSV(strings.NewReader(customersCSV+"4,\"multi\nline\",\n"), db, "customer", CSVOptions{Header: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := parseApplyExecute(t, "customer(Id, Name, City)?", db)
+
+	_, err = f.Seek(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reopened, err := NewDiskLogDB(f, NewMemDatabase())
+	if err != nil {
+		t.Fatal(err)
+	}
+	compareDatalogResult(t, parseApplyExecute(t, "customer(Id, Name, City)?", reopened), expected)
+}
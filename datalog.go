@@ -1,6 +1,12 @@
 package gotalog
 
-import "strconv"
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
 
 func (t Term) getID() string {
 	if t.isConstant {
@@ -16,15 +22,65 @@ func makeVar(id string) Term {
 	}
 }
 
-// Not threadsafe. TODO.
-var globalFreshVarState = 0
+// Shared by concurrent queries, so only accessed atomically.
+var globalFreshVarState int64
 
 func makeFreshVar() Term {
-	id := strconv.Itoa(globalFreshVarState)
-	globalFreshVarState = globalFreshVarState + 1
+	id := strconv.FormatInt(atomic.AddInt64(&globalFreshVarState, 1)-1, 10)
 	return makeVar(id)
 }
 
+// anonymous is the variable _. Each occurrence of it is a distinct variable.
+var anonymous = makeVar("_")
+
+func (t Term) isAnonymous() bool {
+	return t == anonymous
+}
+
+// nameAnonymous replaces each occurrence of the anonymous variable in l with
+// a fresh variable.
+func nameAnonymous(l literal) literal {
+	var terms []Term
+	for i, t := range l.terms {
+		if !t.isAnonymous() {
+			continue
+		}
+		if terms == nil {
+			terms = append([]Term(nil), l.terms...)
+		}
+		terms[i] = makeFreshVar()
+	}
+	if terms == nil {
+		return l
+	}
+	return literal{pred: l.pred, terms: terms}
+}
+
+// isUnreported reports whether t is a variable whose bindings are left out
+// of answers: _, or one whose name starts with _.
+func (t Term) isUnreported() bool {
+	return !t.isConstant && strings.HasPrefix(t.value, "_")
+}
+
+// unbindAnonymous returns fact with the variable of query in place of each
+// term whose binding is unreported.
+func unbindAnonymous(fact, query literal) literal {
+	var terms []Term
+	for i, t := range query.terms {
+		if !t.isUnreported() {
+			continue
+		}
+		if terms == nil {
+			terms = append([]Term(nil), fact.terms...)
+		}
+		terms[i] = t
+	}
+	if terms == nil {
+		return fact
+	}
+	return literal{pred: fact.pred, terms: terms}
+}
+
 func makeConst(value string) Term {
 	return Term{
 		isConstant: true,
@@ -89,6 +145,10 @@ func (t Term) getTag(i int, mapping map[Term]string) string {
 	if t.isConstant {
 		return "ct" + t.value
 	}
+	if t.isAnonymous() {
+		// Never the same as any other variable.
+		return "vt" + strconv.Itoa(i)
+	}
 	if _, ok := mapping[t]; !ok {
 		mapping[t] = "vt" + strconv.Itoa(i)
 	}
@@ -113,6 +173,10 @@ func (t Term) substitute(env envirionment) Term {
 	if t.isConstant {
 		return t
 	}
+	if t.isAnonymous() {
+		// Renaming gives each occurrence its own variable.
+		return makeFreshVar()
+	}
 	if v, ok := env[t.value]; ok {
 		return v
 	}
@@ -222,15 +286,79 @@ type clause struct {
 	body []literal
 }
 
+// Two clauses have the same ID if they are variants of each other, that is,
+// if they differ only in the names of their variables. Variables are
+// numbered in order of first appearance, so that, for instance,
+// ancestor(X, Y) :- parent(X, Y) and ancestor(A, B) :- parent(A, B) share an
+// ID, but ancestor(X, Y) :- parent(Y, X) does not.
 func (c *clause) getID() string {
 	// TODO: cache inside clause
-	id := prefixLength(c.head.getID())
+	mapping := make(map[Term]string)
+	id := prefixLength(c.head.getVariantID(mapping))
 	for _, l := range c.body {
-		id = id + prefixLength(l.getID())
+		id = id + prefixLength(l.getVariantID(mapping))
 	}
 	return id
 }
 
+func (l *literal) getVariantID(mapping map[Term]string) string {
+	s := l.pred.id
+	for _, t := range l.terms {
+		s = s + prefixLength(t.getVariantID(mapping))
+	}
+	return s
+}
+
+func (t Term) getVariantID(mapping map[Term]string) string {
+	if t.isConstant {
+		return t.getID()
+	}
+	if t.isAnonymous() {
+		return "_"
+	}
+	if _, ok := mapping[t]; !ok {
+		mapping[t] = "v" + strconv.Itoa(len(mapping))
+	}
+	return mapping[t]
+}
+
+// isRetractionPattern reports whether c, when retracted, stands for every
+// fact it matches rather than for a single clause. Facts cannot contain
+// variables, so a fact with variables is always a pattern.
+func isRetractionPattern(c *clause) bool {
+	if len(c.body) > 0 {
+		return false
+	}
+	for _, t := range c.head.terms {
+		if !t.isConstant {
+			return true
+		}
+	}
+	return false
+}
+
+// removeClauses deletes the clauses a retraction of c refers to from a
+// store of clauses keyed by ID, and returns them.
+func removeClauses(store map[string]*clause, c *clause) []*clause {
+	var removed []*clause
+	if !isRetractionPattern(c) {
+		id := c.getID()
+		if existing, ok := store[id]; ok {
+			delete(store, id)
+			removed = append(removed, existing)
+		}
+		return removed
+	}
+	pattern := nameAnonymous(c.head)
+	for id, existing := range store {
+		if len(existing.body) == 0 && unify(pattern, existing.head) != nil {
+			delete(store, id)
+			removed = append(removed, existing)
+		}
+	}
+	return removed
+}
+
 // Apply a given substitition for each literal.
 func substituteInClause(c *clause, env envirionment) *clause {
 	if len(env) == 0 {
@@ -262,18 +390,31 @@ func renameClause(c *clause) *clause {
 // the lack of negation, allows us to garuntee that datalog programs
 // will terminate.
 func isSafe(c *clause) bool {
+	return checkSafe(c) == nil
+}
+
+// checkSafe explains why c is unsafe, if it is.
+func checkSafe(c *clause) error {
 	for _, t := range c.head.terms {
-		if !t.isSafe(c) {
-			return false
+		if t.isSafe(c) {
+			continue
+		}
+		if t.isAnonymous() {
+			return fmt.Errorf("cannot assert unsafe clause %v: its head has an anonymous variable", clauseCommand(c, Assert))
 		}
+		return fmt.Errorf("cannot assert unsafe clause %v: %v appears in its head but not its body", clauseCommand(c, Assert), t.value)
 	}
-	return true
+	return nil
 }
 
 func (t Term) isSafe(c *clause) bool {
 	if t.isConstant {
 		return true
 	}
+	if t.isAnonymous() {
+		// It can never be bound by the body.
+		return false
+	}
 	for _, l := range c.body {
 		if isIn(t, l) {
 			return true
@@ -282,7 +423,39 @@ func (t Term) isSafe(c *clause) bool {
 	return false
 }
 
-type goals map[string]*subgoal
+// goals holds the state of a single query: the table of subgoals, and
+// whether the search has been abandoned.
+type goals struct {
+	subgoals map[string]*subgoal
+	ctx      context.Context
+	// err is set once ctx is done, after which the search unwinds.
+	err   error
+	steps int
+	// profile, if set, accumulates statistics about the search.
+	profile *profiler
+}
+
+// How many clauses are added between checks for cancellation.
+const cancellationInterval = 1024
+
+func newGoals(ctx context.Context) *goals {
+	return &goals{
+		subgoals: make(map[string]*subgoal),
+		ctx:      ctx,
+	}
+}
+
+// cancelled reports whether the search should stop.
+func (g *goals) cancelled() bool {
+	if g.err != nil {
+		return true
+	}
+	g.steps++
+	if g.steps%cancellationInterval == 0 {
+		g.err = g.ctx.Err()
+	}
+	return g.err != nil
+}
 
 // A subgoal is the item tabled by out solving algorithm.
 // A subgoals
@@ -299,13 +472,16 @@ func newSubGoal(l literal) *subgoal {
 	}
 }
 
+// A waiter is a clause whose selected literal awaits facts from a subgoal.
+// source is the stored clause it was derived from.
 type waiter struct {
-	c    *clause
-	goal *subgoal
+	c      *clause
+	goal   *subgoal
+	source *clause
 }
 
-func (g goals) merge(sg *subgoal) {
-	g[sg.literal.getTag()] = sg
+func (g *goals) merge(sg *subgoal) {
+	g.subgoals[sg.literal.getTag()] = sg
 }
 
 // TODO: probably mroe golang-like to return an error here than nil.
@@ -328,78 +504,141 @@ func resolve(c *clause, l literal) *clause {
 	}
 }
 
-func (g goals) fact(sg *subgoal, l literal) {
+func (g *goals) fact(sg *subgoal, l literal, source *clause) {
 	if !isMember(l, sg.facts) {
 		adjoin(l, sg.facts)
+		if g.profile != nil {
+			g.profile.fact(sg.literal.pred, source)
+		}
 		for _, w := range sg.waiters {
-			resolvent := resolve(w.c, l)
-			if resolvent != nil {
-				g.addClause(w.goal, resolvent)
-			}
+			g.resolveWaiter(w, l)
 		}
 	}
 }
 
-func (g goals) rule(subgoal *subgoal, c *clause, selected literal) {
-	if sg, ok := g[selected.getTag()]; ok {
-		sg.waiters = append(sg.waiters, waiter{goal: subgoal, c: c})
+// resolveWaiter resolves the clause of w with a fact, adding the resolvent
+// to the subgoal awaiting it.
+func (g *goals) resolveWaiter(w waiter, fact literal) {
+	resolvent := resolve(w.c, fact)
+	if g.profile != nil {
+		g.profile.resolution(w.source, resolvent != nil)
+	}
+	if resolvent != nil {
+		g.addClause(w.goal, resolvent, w.source)
+	}
+}
+
+func (g *goals) rule(subgoal *subgoal, c *clause, selected literal, source *clause) {
+	w := waiter{goal: subgoal, c: c, source: source}
+	if sg, ok := g.subgoals[selected.getTag()]; ok {
+		sg.waiters = append(sg.waiters, w)
 		for _, fact := range sg.facts {
-			resolvent := resolve(c, fact)
-			if resolvent != nil {
-				g.addClause(subgoal, resolvent)
-			}
+			g.resolveWaiter(w, fact)
 		}
 	} else {
 		sg := newSubGoal(selected)
-		sg.waiters = []waiter{waiter{goal: subgoal, c: c}}
+		sg.waiters = []waiter{w}
+		if g.profile != nil {
+			g.profile.subgoal(selected.pred, source)
+		}
 
 		g.merge(sg)
 		g.search(sg)
 	}
 }
 
-func (g goals) addClause(sg *subgoal, c *clause) {
+func (g *goals) addClause(sg *subgoal, c *clause, source *clause) {
+	if g.cancelled() {
+		return
+	}
 	if len(c.body) == 0 {
-		g.fact(sg, c.head)
+		g.fact(sg, c.head, source)
 	} else {
-		g.rule(sg, c, c.body[0])
+		g.rule(sg, c, c.body[0], source)
 	}
 }
 
-func (g goals) search(sg *subgoal) error {
+func (g *goals) search(sg *subgoal) error {
 	l := sg.literal
 	if l.pred.primitive != nil {
 		l.pred.primitive(l, sg)
 	}
+	if g.profile != nil {
+		g.profile.enterPredicate()
+		defer g.profile.exitPredicate(l.pred)
+	}
 
 	clauses := l.pred.clauses()
 	for _, c := range clauses {
+		if g.err != nil {
+			return g.err
+		}
+		profiled := g.profile != nil && len(c.body) > 0
+		if profiled {
+			g.profile.enterRule()
+		}
 		renamed := renameClause(c)
 		env := unify(l, renamed.head)
+		if g.profile != nil {
+			g.profile.unification(l.pred, c, env != nil)
+		}
 		if env != nil {
 			substituted := substituteInClause(renamed, env)
-			g.addClause(sg, substituted)
+			g.addClause(sg, substituted, c)
+		}
+		if profiled {
+			g.profile.exitRule(c)
 		}
 	}
 	return nil
 }
 
 func ask(l literal) Result {
-	subgoals := goals{}
-	sg := newSubGoal(l)
+	res, _ := askContext(context.Background(), l)
+	return res
+}
+
+// askContext answers a query, giving up with the context's error if it is
+// done before the query completes.
+func askContext(ctx context.Context, l literal) (Result, error) {
+	return askProfiled(ctx, l, nil)
+}
+
+// askProfiled is like askContext, but if p is set, records statistics about
+// the search in it.
+func askProfiled(ctx context.Context, l literal, p *profiler) (Result, error) {
+	err := ctx.Err()
+	if err != nil {
+		return Result{}, err
+	}
+	subgoals := newGoals(ctx)
+	subgoals.profile = p
+	sg := newSubGoal(nameAnonymous(l))
+	if p != nil {
+		p.subgoal(l.pred, nil)
+	}
 	subgoals.merge(sg)
 	subgoals.search(sg)
+	if subgoals.err != nil {
+		return Result{}, subgoals.err
+	}
 
+	res := Result{
+		Name:  l.pred.Name,
+		Arity: l.pred.Arity,
+	}
 	if len(sg.facts) > 0 {
-		answers := make([][]Term, 0)
-		for _, l := range sg.facts {
-			answers = append(answers, l.terms)
-		}
-		return Result{
-			Name:    l.pred.Name,
-			Arity:   l.pred.Arity,
-			Answers: answers,
+		res.Answers = make([][]Term, 0)
+		// Answers leave variables starting with _ unbound, so answers
+		// differing only in their values are the same answer.
+		seen := make(map[string]bool)
+		for _, fact := range sg.facts {
+			fact = unbindAnonymous(fact, l)
+			if id := fact.getID(); !seen[id] {
+				seen[id] = true
+				res.Answers = append(res.Answers, fact.terms)
+			}
 		}
 	}
-	return Result{}
+	return res, nil
 }
@@ -0,0 +1,299 @@
+package gotalog
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// diffDB is a database under differential test.
+type diffDB struct {
+	db Database
+	// reopen, if set, opens what db persisted as a new database, which
+	// should hold the same clauses.
+	reopen func() (Database, error)
+	close  func()
+}
+
+// diffImplementations are run against the same commands and expected to
+// give the same results as the first. New database implementations and
+// evaluation engines belong here.
+var diffImplementations = []struct {
+	name string
+	open func() (diffDB, error)
+}{
+	{"memdb", func() (diffDB, error) {
+		return diffDB{db: NewMemDatabase(), close: func() {}}, nil
+	}},
+	{"lockingdb", func() (diffDB, error) {
+		return diffDB{db: NewLockingDatabase(), close: func() {}}, nil
+	}},
+	{"disklogdb", openDiffDiskLog(TextLog)},
+	{"disklogdb binary", openDiffDiskLog(BinaryLog)},
+	{"disklogdir", func() (diffDB, error) {
+		dir, err := ioutil.TempDir("", "differential")
+		if err != nil {
+			return diffDB{}, err
+		}
+		// Snapshot often, so that reopening replays a snapshot and a log.
+		opts := DiskLogOptions{SnapshotEvery: 7}
+		db, err := OpenDiskLogDir(dir, NewMemDatabase(), opts)
+		if err != nil {
+			os.RemoveAll(dir)
+			return diffDB{}, err
+		}
+		var reopened *DiskLogDir
+		return diffDB{
+			db: db,
+			reopen: func() (Database, error) {
+				err := db.Close()
+				if err != nil {
+					return nil, err
+				}
+				reopened, err = OpenDiskLogDir(dir, NewMemDatabase(), opts)
+				return reopened, err
+			},
+			close: func() {
+				db.Close()
+				if reopened != nil {
+					reopened.Close()
+				}
+				os.RemoveAll(dir)
+			},
+		}, nil
+	}},
+}
+
+func openDiffDiskLog(format LogFormat) func() (diffDB, error) {
+	return func() (diffDB, error) {
+		var log bytes.Buffer
+		db, err := NewDiskLogDBWithOptions(&log, NewMemDatabase(), DiskLogOptions{Format: format})
+		if err != nil {
+			return diffDB{}, err
+		}
+		return diffDB{
+			db: db,
+			reopen: func() (Database, error) {
+				return NewDiskLogDB(bytes.NewBuffer(log.Bytes()), NewMemDatabase())
+			},
+			close: func() {},
+		}, nil
+	}
+}
+
+// diffRules are the rules random programs assert and retract. Some are
+// variants of others, and the last is unsafe, so cannot be asserted.
+var diffRules = []string{
+	"path(X, Y) :- edge(X, Y)",
+	"path(A, B) :- edge(A, B)",
+	"path(X, Z) :- edge(X, Y), path(Y, Z)",
+	"path(X, Y) :- edge(Y, X)",
+	"node(X) :- edge(X, _)",
+	"node(Y) :- edge(_, Y)",
+	"loop(X) :- path(X, X)",
+	"path(X, Y) :- node(X)",
+}
+
+// randomCommands generates n random assertions, retractions and queries.
+func randomCommands(r *rand.Rand, n int) []DatalogCommand {
+	constants := []string{"a", "b", "c", "d"}
+	term := func(vars bool) string {
+		switch x := r.Intn(10); {
+		case vars && x < 3:
+			return []string{"X", "Y"}[x%2]
+		case vars && x < 4:
+			return "_"
+		}
+		return constants[r.Intn(len(constants))]
+	}
+	literal := func(vars bool) string {
+		switch r.Intn(4) {
+		case 0:
+			return fmt.Sprintf("node(%v)", term(vars))
+		case 1:
+			return fmt.Sprintf("loop(%v)", term(vars))
+		case 2:
+			return fmt.Sprintf("path(%v, %v)", term(vars), term(vars))
+		}
+		return fmt.Sprintf("edge(%v, %v)", term(vars), term(vars))
+	}
+
+	var prog strings.Builder
+	for i := 0; i < n; i++ {
+		switch x := r.Intn(20); {
+		case x < 8:
+			fmt.Fprintf(&prog, "%v.\n", literal(false))
+		case x < 10:
+			fmt.Fprintf(&prog, "%v.\n", diffRules[r.Intn(len(diffRules))])
+		case x < 13:
+			fmt.Fprintf(&prog, "%v~\n", literal(r.Intn(2) == 0))
+		case x < 14:
+			fmt.Fprintf(&prog, "%v~\n", diffRules[r.Intn(len(diffRules))])
+		default:
+			fmt.Fprintf(&prog, "%v?\n", literal(true))
+		}
+	}
+	cmds, err := Parse(strings.NewReader(prog.String()))
+	if err != nil {
+		panic(err)
+	}
+	return cmds
+}
+
+// diffOutcome normalizes the result of applying cmd.
+func diffOutcome(cmd DatalogCommand, res *Result, err error) string {
+	switch {
+	case err != nil:
+		return "error"
+	case cmd.CommandType == Retract:
+		return fmt.Sprintf("retracted %v", res.Retracted)
+	case cmd.CommandType != Query:
+		return "ok"
+	}
+	answers := strings.Split(strings.TrimSuffix(ToString([]Result{*res}), "\n"), "\n")
+	sort.Strings(answers)
+	return "answers " + strings.Join(answers, " ")
+}
+
+// diffState normalizes the clauses held by a database.
+func diffState(db Database) string {
+	var clauses []string
+	for _, c := range db.allClauses() {
+		clauses = append(clauses, c.getID())
+	}
+	sort.Strings(clauses)
+	return strings.Join(clauses, " ")
+}
+
+// runDiff applies cmds to every implementation, returning the first
+// difference from the first, or an empty string if there is none.
+func runDiff(cmds []DatalogCommand) (string, error) {
+	var expected []string
+	for i, impl := range diffImplementations {
+		d, err := impl.open()
+		if err != nil {
+			return "", err
+		}
+		got := make([]string, 0, len(cmds)+2)
+		for _, cmd := range cmds {
+			res, err := Apply(cmd, d.db)
+			got = append(got, diffOutcome(cmd, res, err))
+		}
+		state := diffState(d.db)
+		got = append(got, state)
+		if d.reopen != nil {
+			db, err := d.reopen()
+			if err != nil {
+				d.close()
+				return fmt.Sprintf("%v: reopening: %v", impl.name, err), nil
+			}
+			if reopened := diffState(db); reopened != state {
+				d.close()
+				return fmt.Sprintf("%v: reopened with clauses %v, but held %v", impl.name, reopened, state), nil
+			}
+		}
+		d.close()
+
+		if i == 0 {
+			expected = got
+			continue
+		}
+		for j := range got {
+			if got[j] == expected[j] {
+				continue
+			}
+			what := "final clauses"
+			if j < len(cmds) {
+				what = cmds[j].String()
+			}
+			return fmt.Sprintf("%v: %v: got %v, but %v got %v",
+				impl.name, what, got[j], diffImplementations[0].name, expected[j]), nil
+		}
+	}
+	return "", nil
+}
+
+// shrinkDiff removes commands from cmds while they still make a difference,
+// first in large chunks and then one at a time, returning the smallest
+// program found and its difference.
+func shrinkDiff(cmds []DatalogCommand, diff string) ([]DatalogCommand, string) {
+	for chunk := len(cmds) / 2; chunk > 0; {
+		removed := false
+		for start := 0; start+chunk <= len(cmds); {
+			shorter := append(append([]DatalogCommand{}, cmds[:start]...), cmds[start+chunk:]...)
+			if d, err := runDiff(shorter); err == nil && d != "" {
+				cmds, diff, removed = shorter, d, true
+				continue
+			}
+			start += chunk
+		}
+		if !removed {
+			chunk /= 2
+		}
+	}
+	return cmds, diff
+}
+
+func TestDifferential(t *testing.T) {
+	cases, length := 200, 60
+	if testing.Short() {
+		cases = 20
+	}
+	for seed := int64(0); seed < int64(cases); seed++ {
+		cmds := randomCommands(rand.New(rand.NewSource(seed)), length)
+		diff, err := runDiff(cmds)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff == "" {
+			continue
+		}
+		cmds, diff = shrinkDiff(cmds, diff)
+		var prog strings.Builder
+		for _, cmd := range cmds {
+			fmt.Fprintln(&prog, cmd)
+		}
+		t.Fatalf("seed %v: %v\nminimal program:\n%v", seed, diff, prog.String())
+	}
+}
+
+func TestShrinkDiff(t *testing.T) {
+	// A database that loses retractions should be caught, and the program
+	// showing it shrunk to an assertion and a retraction.
+	saved := diffImplementations
+	defer func() { diffImplementations = saved }()
+	diffImplementations = append(diffImplementations[:1:1], struct {
+		name string
+		open func() (diffDB, error)
+	}{"forgetful", func() (diffDB, error) {
+		return diffDB{db: forgetfulDB{NewMemDatabase()}, close: func() {}}, nil
+	}})
+
+	cmds := mustParse(t, `edge(a, b). edge(b, c). path(X, Y) :- edge(X, Y).
+path(a, X)? edge(b, c)~ node(a). edge(X, c)?`)
+	diff, err := runDiff(cmds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == "" {
+		t.Fatal("expected a difference")
+	}
+	cmds, _ = shrinkDiff(cmds, diff)
+	if len(cmds) != 2 || cmds[0].String() != "edge(b, c)." || cmds[1].String() != "edge(b, c)~" {
+		t.Errorf("expected a minimal program, got %v", cmds)
+	}
+}
+
+// forgetfulDB ignores retractions.
+type forgetfulDB struct {
+	Database
+}
+
+func (forgetfulDB) retract(c *clause) ([]*clause, error) {
+	return nil, nil
+}
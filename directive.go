@@ -0,0 +1,162 @@
+package gotalog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+)
+
+// Directives follow Soufflé's conventions. By default, .input pred reads
+// tab-separated facts from pred.facts, and .output pred writes them to
+// pred.csv, also tab-separated. Either accepts the parameters
+//
+//	filename   the file to read or write
+//	delimiter  the field separator, a single character
+//	headers    "true" if the file starts with a line of column names
+//
+// and .output also accepts IO="stdout" to write to standard output.
+//
+// .decl pred(name: type, ...) declares the arguments of pred, each a symbol
+// or a number. Clauses and queries using pred must then match its arity and
+// types.
+
+// ExecuteDirective runs a directive against db, resolving relative file names
+// against dir.
+func ExecuteDirective(d DirectiveDefinition, db Database, dir string) error {
+	opts := CSVOptions{Comma: '\t', Header: d.Params["headers"] == "true"}
+	if delim, ok := d.Params["delimiter"]; ok {
+		r, size := utf8.DecodeRuneInString(delim)
+		if size == 0 || size != len(delim) {
+			return fmt.Errorf(".%v %v: delimiter must be a single character", d.Name, d.Predicate)
+		}
+		opts.Comma = r
+	}
+
+	switch d.Name {
+	case "input":
+		f, err := os.Open(directiveFile(d, dir))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = ImportCSV(f, db, d.Predicate, opts)
+		if err != nil {
+			return fmt.Errorf("%v: %v", f.Name(), err)
+		}
+		return nil
+	case "output":
+		res, err := predicateFacts(db, d.Predicate)
+		if err != nil {
+			return err
+		}
+		if d.Params["IO"] == "stdout" {
+			return ExportCSV(os.Stdout, res, opts)
+		}
+		f, err := os.Create(directiveFile(d, dir))
+		if err != nil {
+			return err
+		}
+		err = ExportCSV(f, res, opts)
+		closeErr := f.Close()
+		if err == nil {
+			err = closeErr
+		}
+		return err
+	case "decl":
+		_, err := db.declare(d.Predicate, d.Attributes)
+		return err
+	case "include":
+		return fmt.Errorf("includes are only supported by ParseFile")
+	}
+	return fmt.Errorf("unknown directive .%v", d.Name)
+}
+
+// directiveFile returns the file read or written by an .input or .output
+// directive.
+func directiveFile(d DirectiveDefinition, dir string) string {
+	name, ok := d.Params["filename"]
+	if !ok && d.Name == "input" {
+		name = d.Predicate + ".facts"
+	} else if !ok {
+		name = d.Predicate + ".csv"
+	}
+	return resolvePath(dir, name)
+}
+
+// predicateFacts returns every fact of the predicate name, stored or derived.
+// Its arity is taken from the clauses defining it; a predicate with no clauses
+// has no facts.
+func predicateFacts(db Database, name string) (Result, error) {
+	arity := -1
+	for _, c := range db.allClauses() {
+		if c.head.pred.Name != name || c.head.pred.Arity == arity {
+			continue
+		}
+		if arity >= 0 {
+			return Result{}, fmt.Errorf("%v is defined with arities %v and %v", name, arity, c.head.pred.Arity)
+		}
+		arity = c.head.pred.Arity
+	}
+	if arity < 0 {
+		return Result{Name: name}, nil
+	}
+
+	terms := make([]Term, arity)
+	for i := range terms {
+		terms[i] = makeVar(fmt.Sprintf("X%v", i))
+	}
+	return ask(literal{pred: db.newPredicate(name, arity), terms: terms}), nil
+}
+
+// ApplyProgram applies commands as ApplyAll does, but also runs directives,
+// resolving relative file names against dir. As in Soufflé, .output
+// directives are run once every other command has been applied.
+func ApplyProgram(cmds []DatalogCommand, db Database, dir string) ([]Result, error) {
+	return ApplyProgramFunc(cmds, db, dir, Apply)
+}
+
+// ApplyProgramFunc is like ApplyProgram, but applies each command other than
+// a directive with apply, which may, for instance, profile queries or report
+// results as they arrive.
+func ApplyProgramFunc(cmds []DatalogCommand, db Database, dir string, apply func(DatalogCommand, Database) (*Result, error)) ([]Result, error) {
+	var outputs []DirectiveDefinition
+	results := make([]Result, 0)
+	for _, cmd := range cmds {
+		if cmd.CommandType == Directive {
+			if cmd.Directive.Name == "output" {
+				outputs = append(outputs, *cmd.Directive)
+				continue
+			}
+			err := ExecuteDirective(*cmd.Directive, db, dir)
+			if err != nil {
+				return results, err
+			}
+			continue
+		}
+		res, err := apply(cmd, db)
+		if err != nil {
+			return results, err
+		}
+		if res != nil && cmd.CommandType == Query {
+			results = append(results, *res)
+		}
+	}
+	for _, d := range outputs {
+		err := ExecuteDirective(d, db, dir)
+		if err != nil {
+			return results, err
+		}
+	}
+	return results, nil
+}
+
+// RunFile parses the datalog program in filename with ParseFile, and applies
+// it with ApplyProgram.
+func RunFile(filename string, db Database) ([]Result, error) {
+	cmds, err := ParseFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ApplyProgram(cmds, db, filepath.Dir(filename))
+}
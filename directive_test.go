@@ -0,0 +1,76 @@
+package gotalog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDirectiveString(t *testing.T) {
+	prog := `.input edge(delimiter="\t", filename="my edges.csv")
+.output reachable
+`
+	cmds := mustParse(t, prog)
+	var lines []string
+	for _, cmd := range cmds {
+		lines = append(lines, cmd.String())
+	}
+	if got := strings.Join(lines, "\n") + "\n"; got != prog {
+		t.Errorf("got %q, expected %q", got, prog)
+	}
+	if cmds[0].Directive.Params["delimiter"] != "\t" {
+		t.Errorf("got delimiter %q", cmds[0].Directive.Params["delimiter"])
+	}
+}
+
+func TestApplyProgram(t *testing.T) {
+	dir, err := ioutil.TempDir("", "directives")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "edges.csv"), []byte("from,to\na,b\nb,c\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "edge.facts"), []byte("c\td\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prog := `
+.output reachable
+.input edge(filename="edges.csv", delimiter=",", headers=true)
+.input edge
+reachable(X, Y) :- edge(X, Y).
+reachable(X, Z) :- edge(X, Y), reachable(Y, Z).
+reachable(a, X)?
+`
+	err = ioutil.WriteFile(filepath.Join(dir, "prog.pl"), []byte(prog), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := RunFile(filepath.Join(dir, "prog.pl"), NewMemDatabase())
+	if err != nil {
+		t.Fatal(err)
+	}
+	compareDatalogResult(t, ToString(results), "reachable(a, b).\nreachable(a, c).\nreachable(a, d).\n")
+
+	// Outputs run after the rest of the program, so see every fact.
+	b, err := ioutil.ReadFile(filepath.Join(dir, "reachable.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	if len(lines) != 6 {
+		t.Errorf("got %q, expected 6 facts", b)
+	}
+
+	_, err = Apply(mustParse(t, ".output reachable")[0], NewMemDatabase())
+	if err == nil {
+		t.Errorf("expected Apply to refuse a directive")
+	}
+}
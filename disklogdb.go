@@ -1,57 +1,304 @@
 package gotalog
 
-import "io"
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+)
 
 type disklogdb struct {
-	w       io.Writer
+	// w is nil if the database was opened at a past point in time.
+	w       logWriter
 	backing Database
+	// m orders changes to backing with the records written for them.
+	m sync.Mutex
+	// seq is the sequence number of the last record in the log.
+	seq uint64
 }
 
+var errPointInTimeReadOnly = fmt.Errorf("a database opened at a past point in time cannot be modified")
+
 func (db *disklogdb) newPredicate(n string, a int) *predicate {
 	return db.backing.newPredicate(n, a)
 }
 
 func (db *disklogdb) assert(c *clause) error {
-	// what happens if one fails and one succeeds?
+	if db.w == nil {
+		return errPointInTimeReadOnly
+	}
+	db.m.Lock()
+	defer db.m.Unlock()
+	held := db.backing.holds(c)
 	err := db.backing.assert(c)
 	if err != nil {
 		return err
 	}
-	return writeClause(db.w, c, Assert)
+	err = db.write(c, Assert)
+	if err != nil && !held {
+		// Remove the clause whose assertion was not logged, so that the
+		// database still matches its log.
+		db.backing.retract(c)
+	}
+	return err
 }
 
-func (db *disklogdb) retract(c *clause) error {
-	err := db.backing.retract(c)
+func (db *disklogdb) retract(c *clause) ([]*clause, error) {
+	if db.w == nil {
+		return nil, errPointInTimeReadOnly
+	}
+	db.m.Lock()
+	defer db.m.Unlock()
+	removed, err := db.backing.retract(c)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	// Log each clause actually removed, rather than c itself, so that
+	// replaying the log does not depend on what else it matched.
+	for i, r := range removed {
+		err = db.write(r, Retract)
+		if err != nil {
+			// Restore the clauses whose retractions were not logged, so
+			// that the database still matches its log.
+			for _, unlogged := range removed[i:] {
+				db.backing.assert(unlogged)
+			}
+			return removed[:i], err
+		}
+	}
+	return removed, nil
+}
+
+func (db *disklogdb) holds(c *clause) bool {
+	return db.backing.holds(c)
+}
+
+func (db *disklogdb) allClauses() []*clause {
+	return db.backing.allClauses()
+}
+
+func (db *disklogdb) schema() *schema {
+	return db.backing.schema()
+}
+
+// declare logs new declarations. A database opened at a past point in time
+// accepts declarations, but does not log them.
+func (db *disklogdb) declare(name string, attrs []Attribute) (bool, error) {
+	db.m.Lock()
+	defer db.m.Unlock()
+	added, err := db.backing.declare(name, attrs)
+	if err != nil || !added || db.w == nil {
+		return added, err
+	}
+	err = db.record(declarationCommand(name, attrs))
+	if err != nil {
+		removeDeclaration(db.backing, name)
+		return false, err
 	}
-	return writeClause(db.w, c, Retract)
+	return true, nil
+}
+
+func (db *disklogdb) write(c *clause, t CommandType) error {
+	return db.record(clauseCommand(c, t))
+}
+
+func (db *disklogdb) record(cmd DatalogCommand) error {
+	db.seq++
+	return db.w.write(logRecord{cmd: cmd, seq: db.seq, time: logClock()})
+}
+
+// LogFile is the subset of *os.File needed to repair a damaged log.
+type LogFile interface {
+	io.ReadWriteSeeker
+	Truncate(size int64) error
+}
+
+// DiskLogOptions controls how NewDiskLogDBWithOptions replays a log.
+type DiskLogOptions struct {
+	// RecoverTornTail allows opening a log whose final record was only
+	// partially written, for instance because the process died while
+	// writing it. The partial record is truncated from the log, which must
+	// then implement LogFile. Damaged headers, and damage before the final
+	// record, are still errors.
+	RecoverTornTail bool
+	// Format is the encoding used when the log is empty. Existing logs
+	// keep the format they were created with.
+	Format LogFormat
+	// SnapshotEvery is the number of records after which a DiskLogDir
+	// snapshots its contents and starts a new log. Zero disables automatic
+	// snapshots.
+	SnapshotEvery int
+	// KeepSnapshots is the number of snapshots a DiskLogDir retains, along
+	// with their logs, in addition to the current one. Retained history can
+	// be opened with AsOfSequence and AsOfTime.
+	KeepSnapshots int
+	// AsOfSequence, if set, replays only the records of the log up to and
+	// including this sequence number. Records are numbered from 1.
+	AsOfSequence uint64
+	// AsOfTime, if set, replays only the records of the log written no
+	// later than this time.
+	//
+	// A database opened with AsOfSequence or AsOfTime reflects a past
+	// state, and refuses to be modified.
+	AsOfTime time.Time
+	// Warn, if set, is called with a description of each repair made
+	// while opening the log.
+	Warn func(msg string)
 }
 
 // NewDiskLogDB returns a database initialized from an io.ReadWritter. All assertions
 // and retractions on this databased will be persisted in the log.
 func NewDiskLogDB(rw io.ReadWriter, backing Database) (Database, error) {
-	ch := make(chan DatalogCommand, 1000)
-	go func() {
-		for c := range ch {
-			_, err := Apply(c, backing)
-			if err != nil {
-				// TODO
+	return NewDiskLogDBWithOptions(rw, backing, DiskLogOptions{})
+}
+
+// NewDiskLogDBWithOptions is like NewDiskLogDB, but allows control over how
+// the existing log is replayed.
+func NewDiskLogDBWithOptions(rw io.ReadWriter, backing Database, opts DiskLogOptions) (Database, error) {
+	return openDiskLog(rw, backing, 0, opts)
+}
+
+func (opts DiskLogOptions) pointInTime() bool {
+	return opts.AsOfSequence != 0 || !opts.AsOfTime.IsZero()
+}
+
+// includes reports whether a record was written at or before the point in
+// time selected by opts.
+func (opts DiskLogOptions) includes(rec logRecord) bool {
+	if opts.AsOfSequence != 0 && rec.seq > opts.AsOfSequence {
+		return false
+	}
+	if !opts.AsOfTime.IsZero() && rec.time.After(opts.AsOfTime) {
+		return false
+	}
+	return true
+}
+
+// openDiskLog replays a log into backing. Records in the log that do not
+// carry a sequence number are numbered following seq.
+func openDiskLog(rw io.ReadWriter, backing Database, seq uint64, opts DiskLogOptions) (*disklogdb, error) {
+	db := &disklogdb{backing: backing, seq: seq}
+	br := bufio.NewReader(rw)
+	_, err := br.Peek(1)
+	if err == io.EOF {
+		return db, db.startLog(rw, opts)
+	}
+	format := detectLogFormat(br)
+	r, err := newLogReader(br, format)
+	if err != nil {
+		// A bad header is never a torn write, so is not recovered from.
+		return nil, err
+	}
+	db.seq, err = replayLog(r, backing, seq, opts)
+	// The offset just past the last record that was successfully replayed.
+	good := r.offset()
+	if err != nil {
+		if !opts.RecoverTornTail {
+			return nil, err
+		}
+		if opts.pointInTime() {
+			// Leave the log untouched, since we won't be writing to it.
+			if opts.Warn != nil {
+				opts.Warn(fmt.Sprintf("ignoring damaged log after offset %v: %v", good, err))
 			}
+			return db, nil
 		}
-	}()
-	commands, errors := Scan(rw)
-	for command := range commands {
-		_, err := Apply(command, backing)
+		err = truncateTornTail(rw, good, format, err, opts.Warn)
 		if err != nil {
 			return nil, err
 		}
+		if good == 0 {
+			return db, db.startLog(rw, opts)
+		}
+	}
+	if !opts.pointInTime() {
+		db.w = r.appender(rw)
+	}
+	return db, nil
+}
+
+// startLog prepares an empty log for writing.
+func (db *disklogdb) startLog(w io.Writer, opts DiskLogOptions) error {
+	if opts.pointInTime() {
+		return nil
 	}
-	select {
-	case err := <-errors:
+	var err error
+	db.w, err = newLogWriter(w, opts.Format)
+	return err
+}
+
+// replayLog applies the records of a log to a database, stopping at the
+// first record after the point in time selected by opts. It returns the
+// sequence number of the last record applied, numbering records that lack
+// one following seq.
+func replayLog(r logReader, backing Database, seq uint64, opts DiskLogOptions) (uint64, error) {
+	for {
+		rec, err := r.next()
+		if err == io.EOF {
+			return seq, nil
+		}
 		if err != nil {
-			return nil, err
+			return seq, err
+		}
+		if rec.seq == 0 {
+			rec.seq = seq + 1
 		}
+		if !opts.includes(rec) {
+			return seq, nil
+		}
+		if rec.cmd.CommandType == Directive {
+			err = replayDirective(rec.cmd.Directive, backing)
+		} else {
+			_, err = Apply(rec.cmd, backing)
+		}
+		if err != nil {
+			return seq, err
+		}
+		seq = rec.seq
+	}
+}
+
+// replayDirective applies a directive found in a log, where only
+// declarations are written.
+func replayDirective(d *DirectiveDefinition, backing Database) error {
+	if d.Name != "decl" {
+		return fmt.Errorf("unexpected directive .%v in log", d.Name)
+	}
+	_, err := backing.declare(d.Predicate, d.Attributes)
+	return err
+}
+
+// truncateTornTail removes everything after offset good, provided that what
+// follows is a single record that was cut short. Anything else is corruption
+// that we refuse to paper over.
+func truncateTornTail(rw io.ReadWriter, good int64, format LogFormat, cause error, warn func(string)) error {
+	f, ok := rw.(LogFile)
+	if !ok {
+		return fmt.Errorf("cannot truncate log to recover from: %v", cause)
+	}
+	_, err := f.Seek(good, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	tail, err := ioutil.ReadAll(f)
+	if err != nil {
+		return err
+	}
+	if !isTornTail(tail, format) {
+		return fmt.Errorf("log is corrupt after offset %v: %v", good, cause)
+	}
+	err = f.Truncate(good)
+	if err != nil {
+		return err
+	}
+	_, err = f.Seek(good, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	if warn != nil {
+		warn(fmt.Sprintf("truncated torn record of %v bytes at offset %v: %v", len(tail), good, cause))
 	}
-	return &disklogdb{w: rw, backing: backing}, nil
+	return nil
 }
@@ -1,24 +1,37 @@
 package gotalog
 
 import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
 	"io/ioutil"
 	"os"
 	"strings"
 	"testing"
+	"time"
 )
 
-func TestDiskLogDBInterface(t *testing.T) {
-	interfaceTest(t, func() Database {
+func newTempDiskLogDB(format LogFormat) func() Database {
+	return func() Database {
 		f, err := ioutil.TempFile("", "logdbtestsInterfaceTests")
 		if err != nil {
 			panic(err)
 		}
-		db, err := NewDiskLogDB(f, NewMemDatabase())
+		db, err := NewDiskLogDBWithOptions(f, NewMemDatabase(), DiskLogOptions{Format: format})
 		if err != nil {
 			panic(err)
 		}
 		return db
-	})
+	}
+}
+
+func TestDiskLogDBInterface(t *testing.T) {
+	interfaceTest(t, newTempDiskLogDB(TextLog))
+}
+
+func TestBinaryLogDBInterface(t *testing.T) {
+	interfaceTest(t, newTempDiskLogDB(BinaryLog))
 }
 
 func panicOnError(err error) {
@@ -27,7 +40,7 @@ func panicOnError(err error) {
 	}
 }
 
-func testPersistence(t *testing.T, c pCase) {
+func testPersistence(t *testing.T, c pCase, format LogFormat) {
 	commands, err := Parse(strings.NewReader(c.prog))
 	panicOnError(err)
 
@@ -36,7 +49,7 @@ func testPersistence(t *testing.T, c pCase) {
 
 	fname := f.Name()
 
-	db, err := NewDiskLogDB(f, NewMemDatabase())
+	db, err := NewDiskLogDBWithOptions(f, NewMemDatabase(), DiskLogOptions{Format: format})
 	panicOnError(err)
 
 	results, err := ApplyAll(commands[0:len(commands)-2], db)
@@ -61,6 +74,414 @@ func testPersistence(t *testing.T, c pCase) {
 
 func TestDiskLogPersistence(t *testing.T) {
 	for _, c := range programCases {
-		testPersistence(t, c)
+		testPersistence(t, c, TextLog)
+	}
+}
+
+func TestBinaryLogPersistence(t *testing.T) {
+	for _, c := range programCases {
+		testPersistence(t, c, BinaryLog)
+	}
+}
+
+func TestConvertLog(t *testing.T) {
+	var text bytes.Buffer
+	db, err := NewDiskLogDB(&text, NewMemDatabase())
+	panicOnError(err)
+	parseApplyExecute(t, tornLogProgram, db)
+	original := text.String()
+
+	var binary bytes.Buffer
+	panicOnError(ConvertLog(&binary, strings.NewReader(original), BinaryLog))
+	if !bytes.HasPrefix(binary.Bytes(), binaryLogMagic) {
+		t.Errorf("converted log is missing its header")
+	}
+	var back bytes.Buffer
+	panicOnError(ConvertLog(&back, &binary, TextLog))
+	if back.String() != original {
+		t.Errorf("round trip changed the log. Got:\n%v\nExpected:\n%v", back.String(), original)
+	}
+}
+
+func TestBinaryLogRefusesMidLogCorruption(t *testing.T) {
+	var log bytes.Buffer
+	panicOnError(ConvertLog(&log, strings.NewReader(tornLogProgram), BinaryLog))
+	corrupt := log.Bytes()
+	// Flip a bit inside the payload of the first record.
+	corrupt[binaryLogHeaderSize+binaryRecordFrameSize+2] ^= 1
+
+	f, err := ioutil.TempFile("", "logdbCorrupt")
+	panicOnError(err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+	_, err = f.Write(corrupt)
+	panicOnError(err)
+	_, err = f.Seek(0, io.SeekStart)
+	panicOnError(err)
+
+	_, err = NewDiskLogDBWithOptions(f, NewMemDatabase(), DiskLogOptions{RecoverTornTail: true})
+	if err == nil {
+		t.Error("expected a checksum failure before the final record to be reported")
+	}
+}
+
+// openCorruptBinaryLog writes tornLogProgram as a binary log, damaged by
+// corrupt, to a temporary file, and opens it with RecoverTornTail. It
+// returns the size of the log as written and afterwards, and the result of
+// opening it.
+func openCorruptBinaryLog(t *testing.T, corrupt func([]byte)) (int64, int64, error) {
+	var log bytes.Buffer
+	panicOnError(ConvertLog(&log, strings.NewReader(tornLogProgram), BinaryLog))
+	corrupt(log.Bytes())
+
+	f, err := ioutil.TempFile("", "logdbCorrupt")
+	panicOnError(err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+	_, err = f.Write(log.Bytes())
+	panicOnError(err)
+	_, err = f.Seek(0, io.SeekStart)
+	panicOnError(err)
+
+	_, openErr := NewDiskLogDBWithOptions(f, NewMemDatabase(), DiskLogOptions{RecoverTornTail: true})
+	stat, err := f.Stat()
+	panicOnError(err)
+	return int64(log.Len()), stat.Size(), openErr
+}
+
+func TestBinaryLogRefusesBadHeader(t *testing.T) {
+	for _, c := range []struct {
+		name    string
+		corrupt func([]byte)
+	}{
+		{"unsupported version", func(b []byte) { b[len(binaryLogMagic)] = binaryLogVersion + 1 }},
+		{"zero version", func(b []byte) { b[len(binaryLogMagic)] = 0 }},
+	} {
+		size, after, err := openCorruptBinaryLog(t, c.corrupt)
+		if err == nil {
+			t.Errorf("%v: expected an error", c.name)
+		}
+		if after != size {
+			t.Errorf("%v: log was truncated from %v to %v bytes", c.name, size, after)
+		}
+	}
+}
+
+func TestBinaryLogRefusesCorruptedLength(t *testing.T) {
+	for _, length := range []uint32{1 << 20, maxBinaryRecordSize + 1, 1<<32 - 1} {
+		size, after, err := openCorruptBinaryLog(t, func(b []byte) {
+			// Make the first record claim more bytes than the log holds.
+			binary.BigEndian.PutUint32(b[binaryLogHeaderSize:], length)
+		})
+		if err == nil {
+			t.Errorf("length %v: expected an error", length)
+		}
+		if after != size {
+			t.Errorf("length %v: log was truncated from %v to %v bytes", length, size, after)
+		}
+	}
+}
+
+const tornLogProgram = `parent(abby, bob).
+parent(bob, charlie).
+ancestor(X, Y) :- parent(X, Y).
+ancestor(X, Y) :- parent(X, Z), ancestor(Z, Y).
+parent(abby, bob)~
+parent(charlie, dana).
+`
+
+// tornDeclLogProgram is tornLogProgram with declarations, one of them of a
+// nullary predicate, among its clauses.
+const tornDeclLogProgram = `parent(abby, bob).
+.decl parent(from: symbol, to: symbol)
+.decl reach
+parent(bob, charlie).
+ancestor(X, Y) :- parent(X, Y).
+.decl ancestor(from: symbol, to: symbol)
+ancestor(X, Y) :- parent(X, Z), ancestor(Z, Y).
+parent(abby, bob)~
+parent(charlie, dana).
+`
+
+// replayPrefix returns the answers to ancestor(X, Y)? after applying the
+// first n commands of a program to a fresh database.
+func replayPrefix(t *testing.T, cmds []DatalogCommand, n int) string {
+	return parseApplyExecute(t, "ancestor(X, Y)?", prefixDatabase(cmds, n))
+}
+
+// prefixDatabase applies the first n commands of a program to a fresh
+// database.
+func prefixDatabase(cmds []DatalogCommand, n int) Database {
+	db := NewMemDatabase()
+	_, err := ApplyProgram(cmds[:n], db, ".")
+	panicOnError(err)
+	return db
+}
+
+// declarationsOf returns the declarations of db as a program.
+func declarationsOf(db Database) string {
+	var b strings.Builder
+	for _, cmd := range declarationCommands(db) {
+		b.WriteString(cmd.String() + "\n")
+	}
+	return b.String()
+}
+
+func testTornTailRecovery(t *testing.T, format LogFormat) {
+	cmds, err := Parse(strings.NewReader(tornDeclLogProgram))
+	panicOnError(err)
+
+	f, err := ioutil.TempFile("", "logdbTornTail")
+	panicOnError(err)
+	defer os.Remove(f.Name())
+	db, err := NewDiskLogDBWithOptions(f, NewMemDatabase(), DiskLogOptions{Format: format})
+	panicOnError(err)
+
+	// The size of the log after its header, and after each record.
+	var ends []int
+	for i := 0; i <= len(cmds); i++ {
+		stat, err := f.Stat()
+		panicOnError(err)
+		ends = append(ends, int(stat.Size()))
+		if i < len(cmds) {
+			_, err = ApplyProgram(cmds[i:i+1], db, ".")
+			panicOnError(err)
+		}
+	}
+	panicOnError(f.Close())
+
+	log, err := ioutil.ReadFile(f.Name())
+	panicOnError(err)
+
+	for size := 0; size <= len(log); size++ {
+		if format == BinaryLog && size > 0 && size < ends[0] {
+			// A cut off header is not recovered from, as tested by
+			// TestBinaryLogRefusesBadHeader.
+			continue
+		}
+		complete, expectedSize := 0, 0
+		for i, end := range ends {
+			if end <= size {
+				complete, expectedSize = i, end
+			}
+		}
+
+		panicOnError(ioutil.WriteFile(f.Name(), log[:size], 0600))
+		f, err := os.OpenFile(f.Name(), os.O_RDWR, 0600)
+		panicOnError(err)
+
+		if _, err := NewDiskLogDB(f, NewMemDatabase()); err == nil && expectedSize != size {
+			t.Errorf("size %v: expected an error without recovery", size)
+		}
+		_, err = f.Seek(0, io.SeekStart)
+		panicOnError(err)
+
+		warnings := 0
+		db, err := NewDiskLogDBWithOptions(f, NewMemDatabase(), DiskLogOptions{
+			RecoverTornTail: true,
+			Warn:            func(string) { warnings++ },
+		})
+		if err != nil {
+			t.Errorf("size %v: %v", size, err)
+			f.Close()
+			continue
+		}
+		if (warnings > 0) != (expectedSize != size) {
+			t.Errorf("size %v: got %v warnings", size, warnings)
+		}
+		compareDatalogResult(t, parseApplyExecute(t, "ancestor(X, Y)?", db), replayPrefix(t, cmds, complete))
+		if got, expected := declarationsOf(db), declarationsOf(prefixDatabase(cmds, complete)); got != expected {
+			t.Errorf("size %v: got declarations\n%v\nexpected\n%v", size, got, expected)
+		}
+
+		// The repaired log must accept new records and replay cleanly.
+		_, err = ApplyAll([]DatalogCommand{cmds[len(cmds)-1]}, db)
+		panicOnError(err)
+		panicOnError(f.Close())
+		f, err = os.Open(f.Name())
+		panicOnError(err)
+		stat, err := f.Stat()
+		panicOnError(err)
+		if stat.Size() < int64(expectedSize) {
+			t.Errorf("size %v: log shrank to %v bytes", size, stat.Size())
+		}
+		_, err = NewDiskLogDB(f, NewMemDatabase())
+		if err != nil {
+			t.Errorf("size %v: repaired log does not replay: %v", size, err)
+		}
+		f.Close()
+	}
+}
+
+func TestDiskLogTornTailRecovery(t *testing.T) {
+	testTornTailRecovery(t, TextLog)
+}
+
+func TestBinaryLogTornTailRecovery(t *testing.T) {
+	testTornTailRecovery(t, BinaryLog)
+}
+
+func TestDiskLogRefusesMidLogCorruption(t *testing.T) {
+	f, err := ioutil.TempFile("", "logdbCorrupt")
+	panicOnError(err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString("parent(abby, bob).\nparent(bob, (charlie).\nparent(charlie, dana).\n")
+	panicOnError(err)
+	_, err = f.Seek(0, io.SeekStart)
+	panicOnError(err)
+	defer f.Close()
+
+	_, err = NewDiskLogDBWithOptions(f, NewMemDatabase(), DiskLogOptions{RecoverTornTail: true})
+	if err == nil {
+		t.Error("expected corruption before the final record to be reported")
+	}
+}
+
+// tickingClock makes each log record one minute later than the last,
+// starting from base.
+func tickingClock(base time.Time) func() {
+	now := base
+	logClock = func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+	return func() { logClock = time.Now }
+}
+
+func TestDiskLogPointInTime(t *testing.T) {
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	cmds, err := Parse(strings.NewReader(tornLogProgram))
+	panicOnError(err)
+
+	for _, format := range []LogFormat{TextLog, BinaryLog} {
+		defer tickingClock(base)()
+		var log bytes.Buffer
+		db, err := NewDiskLogDBWithOptions(&log, NewMemDatabase(), DiskLogOptions{Format: format})
+		panicOnError(err)
+		_, err = ApplyAll(cmds, db)
+		panicOnError(err)
+
+		for n := 1; n <= len(cmds); n++ {
+			for _, opts := range []DiskLogOptions{
+				{AsOfSequence: uint64(n)},
+				{AsOfTime: base.Add(time.Duration(n)*time.Minute + time.Second)},
+			} {
+				db, err := NewDiskLogDBWithOptions(bytes.NewBuffer(log.Bytes()), NewMemDatabase(), opts)
+				panicOnError(err)
+				compareDatalogResult(t, parseApplyExecute(t, "ancestor(X, Y)?", db), replayPrefix(t, cmds, n))
+				if _, err := Apply(cmds[0], db); err == nil {
+					t.Errorf("expected a database opened at a past point in time to be read-only")
+				}
+			}
+		}
+	}
+}
+
+func TestDiskLogPointInTimeWithoutSequenceNumbers(t *testing.T) {
+	cmds, err := Parse(strings.NewReader(tornLogProgram))
+	panicOnError(err)
+	// Logs written before records were numbered contain only commands.
+	db, err := NewDiskLogDBWithOptions(bytes.NewBufferString(tornLogProgram), NewMemDatabase(), DiskLogOptions{AsOfSequence: 2})
+	panicOnError(err)
+	compareDatalogResult(t, parseApplyExecute(t, "ancestor(X, Y)?", db), replayPrefix(t, cmds, 2))
+}
+
+func TestDiskLogRecordsPatternRetractions(t *testing.T) {
+	var log bytes.Buffer
+	db, err := NewDiskLogDB(&log, NewMemDatabase())
+	panicOnError(err)
+	parseApplyExecute(t, `member(alice, admins). member(alice, users). member(bob, users).
+	member(alice, G)~`, db)
+
+	cmds, err := Parse(bytes.NewReader(log.Bytes()))
+	panicOnError(err)
+	retracted := 0
+	for _, c := range cmds {
+		if c.CommandType == Retract {
+			retracted++
+			if !c.Head.Terms[1].isConstant {
+				t.Errorf("expected the log to record the facts removed, not the pattern")
+			}
+		}
+	}
+	if retracted != 2 {
+		t.Errorf("expected 2 retractions in the log, got %v", retracted)
+	}
+
+	db, err = NewDiskLogDB(&log, NewMemDatabase())
+	panicOnError(err)
+	compareDatalogResult(t, parseApplyExecute(t, "member(X, G)?", db), "member(bob, users).\n")
+}
+
+// failingWriter accepts writes until its budget runs out, and fails after.
+type failingWriter struct {
+	w      io.Writer
+	writes int
+}
+
+var errDiskFull = errors.New("disk full")
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	if f.writes == 0 {
+		return 0, errDiskFull
+	}
+	f.writes--
+	return f.w.Write(p)
+}
+
+func TestDiskLogRetractionWriteFailure(t *testing.T) {
+	for _, format := range []LogFormat{TextLog, BinaryLog} {
+		var log bytes.Buffer
+		w := &failingWriter{w: &log, writes: 100}
+		db, err := NewDiskLogDBWithOptions(struct {
+			io.Reader
+			io.Writer
+		}{&log, w}, NewMemDatabase(), DiskLogOptions{Format: format})
+		panicOnError(err)
+		_, err = ApplyAll(mustParse(t, "edge(a, b). edge(a, c). edge(a, d). edge(b, c)."), db)
+		panicOnError(err)
+
+		// Only the first of the three retractions can be logged.
+		w.writes = 1
+		_, err = Apply(mustParse(t, "edge(a, X)~")[0], db)
+		if !errors.Is(err, errDiskFull) {
+			t.Errorf("format %v: got %v, expected the write error", format, err)
+		}
+
+		reopened, err := NewDiskLogDB(bytes.NewBuffer(log.Bytes()), NewMemDatabase())
+		panicOnError(err)
+		if got, logged := diffState(db), diffState(reopened); got != logged {
+			t.Errorf("format %v: database holds %v, but its log %v", format, got, logged)
+		}
+	}
+}
+
+func TestDiskLogAssertionWriteFailure(t *testing.T) {
+	for _, format := range []LogFormat{TextLog, BinaryLog} {
+		var log bytes.Buffer
+		w := &failingWriter{w: &log, writes: 100}
+		db, err := NewDiskLogDBWithOptions(struct {
+			io.Reader
+			io.Writer
+		}{&log, w}, NewMemDatabase(), DiskLogOptions{Format: format})
+		panicOnError(err)
+		_, err = ApplyAll(mustParse(t, "edge(a, b). path(X, Y) :- edge(X, Y)."), db)
+		panicOnError(err)
+
+		// Neither assertion can be logged, but the second was logged before.
+		w.writes = 0
+		for _, prog := range []string{"edge(b, c).", "path(A, B) :- edge(A, B)."} {
+			_, err = Apply(mustParse(t, prog)[0], db)
+			if !errors.Is(err, errDiskFull) {
+				t.Errorf("format %v: %v: got %v, expected the write error", format, prog, err)
+			}
+		}
+
+		reopened, err := NewDiskLogDB(bytes.NewBuffer(log.Bytes()), NewMemDatabase())
+		panicOnError(err)
+		if got, logged := diffState(db), diffState(reopened); got != logged {
+			t.Errorf("format %v: database holds %v, but its log %v", format, got, logged)
+		}
+		compareDatalogResult(t, parseApplyExecute(t, "path(X, Y)?", db), "path(a, b).\n")
 	}
 }
@@ -0,0 +1,453 @@
+package gotalog
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// A disk log directory holds one or more generations of state. Each
+// generation is an optional snapshot of every declaration and clause in the
+// database, and a log of the declarations, assertions and retractions made
+// since that snapshot was taken.
+// The file named CURRENT identifies the live generation. Taking a snapshot
+// writes the next generation's files in full, and then atomically replaces
+// CURRENT, so that a crash at any point leaves either the old or the new pair
+// in place.
+const currentGenerationFile = "CURRENT"
+
+// generation identifies a snapshot by the sequence number of the last record
+// it includes, and the time at which it was taken. The first generation of a
+// directory has no snapshot, and a zero time.
+type generation struct {
+	seq  uint64
+	time time.Time
+}
+
+func (g generation) String() string {
+	return fmt.Sprintf("%v %v", g.seq, g.time.UnixNano())
+}
+
+func parseGeneration(s string) (generation, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return generation{}, fmt.Errorf("invalid generation %q", s)
+	}
+	seq, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return generation{}, err
+	}
+	nanos, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return generation{}, err
+	}
+	g := generation{seq: seq}
+	if nanos != 0 {
+		g.time = time.Unix(0, nanos).UTC()
+	}
+	return g, nil
+}
+
+func (g generation) hasSnapshot() bool {
+	return !g.time.IsZero()
+}
+
+func (g generation) snapshotFile() string {
+	return fmt.Sprintf("snapshot-%08d-%v", g.seq, g.time.UnixNano())
+}
+
+func (g generation) logFile() string {
+	return fmt.Sprintf("log-%08d", g.seq)
+}
+
+// DiskLogDir is a log-backed database stored in a directory, which
+// periodically snapshots its contents so that reopening it replays only the
+// records written since the last snapshot.
+type DiskLogDir struct {
+	dir     string
+	opts    DiskLogOptions
+	backing Database
+
+	m             sync.Mutex
+	gen           generation
+	f             *os.File
+	log           *disklogdb
+	sinceSnapshot int
+}
+
+// OpenDiskLogDir opens, or creates, a log-backed database in the directory
+// dir. Its state is loaded from the latest snapshot into backing, followed
+// by the log written since. If opts.SnapshotEvery is set, a new snapshot is
+// taken after that many assertions and retractions.
+//
+// If opts selects a past point in time, the state is instead loaded from the
+// latest retained snapshot preceding it, and the directory is left untouched.
+func OpenDiskLogDir(dir string, backing Database, opts DiskLogOptions) (*DiskLogDir, error) {
+	d := &DiskLogDir{dir: dir, opts: opts, backing: backing}
+	if opts.pointInTime() {
+		return d, d.openPointInTime()
+	}
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+	d.gen, err = readCurrentGeneration(dir)
+	if err != nil {
+		return nil, err
+	}
+	err = d.removeStaleFiles()
+	if err != nil {
+		return nil, err
+	}
+	err = d.loadSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	d.f, err = os.OpenFile(d.path(d.gen.logFile()), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	d.log, err = openDiskLog(d.f, backing, d.gen.seq, opts)
+	if err != nil {
+		d.f.Close()
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *DiskLogDir) openPointInTime() error {
+	current, err := readCurrentGeneration(d.dir)
+	if err != nil {
+		return err
+	}
+	gens, err := d.generations(current)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, g := range gens {
+		if (d.opts.AsOfSequence == 0 || g.seq <= d.opts.AsOfSequence) &&
+			(d.opts.AsOfTime.IsZero() || !g.time.After(d.opts.AsOfTime)) {
+			d.gen, found = g, true
+		}
+	}
+	if !found {
+		return fmt.Errorf("no retained snapshot precedes the requested point in time")
+	}
+	err = d.loadSnapshot()
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(d.path(d.gen.logFile()))
+	if os.IsNotExist(err) {
+		d.log = &disklogdb{backing: d.backing, seq: d.gen.seq}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	d.log, err = openDiskLog(f, d.backing, d.gen.seq, d.opts)
+	return err
+}
+
+func (d *DiskLogDir) path(name string) string {
+	return filepath.Join(d.dir, name)
+}
+
+func readCurrentGeneration(dir string) (generation, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dir, currentGenerationFile))
+	if os.IsNotExist(err) {
+		return generation{}, nil
+	}
+	if err != nil {
+		return generation{}, err
+	}
+	g, err := parseGeneration(string(b))
+	if err != nil {
+		return g, fmt.Errorf("invalid %v file: %v", currentGenerationFile, err)
+	}
+	return g, nil
+}
+
+// generations lists the generations with snapshots in the directory, up to
+// and including current, oldest first. The first generation is included if
+// its log is still present.
+func (d *DiskLogDir) generations(current generation) ([]generation, error) {
+	var gens []generation
+	_, err := os.Stat(d.path(generation{}.logFile()))
+	if err == nil || current == (generation{}) {
+		gens = append(gens, generation{})
+	}
+	matches, err := filepath.Glob(d.path("snapshot-*"))
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range matches {
+		name := strings.Replace(strings.TrimPrefix(filepath.Base(m), "snapshot-"), "-", " ", 1)
+		g, err := parseGeneration(name)
+		if err != nil || !g.hasSnapshot() || g.seq > current.seq || g.time.After(current.time) {
+			continue
+		}
+		gens = append(gens, g)
+	}
+	sort.Slice(gens, func(i, j int) bool {
+		if gens[i].seq != gens[j].seq {
+			return gens[i].seq < gens[j].seq
+		}
+		return gens[i].time.Before(gens[j].time)
+	})
+	return gens, nil
+}
+
+// removeStaleFiles deletes files left behind by generations older than those
+// we retain, or by a snapshot that was interrupted before it became current.
+func (d *DiskLogDir) removeStaleFiles() error {
+	gens, err := d.generations(d.gen)
+	if err != nil {
+		return err
+	}
+	live := map[string]bool{currentGenerationFile: true}
+	for i := len(gens) - 1; i >= 0 && i >= len(gens)-1-d.opts.KeepSnapshots; i-- {
+		live[gens[i].logFile()] = true
+		if gens[i].hasSnapshot() {
+			live[gens[i].snapshotFile()] = true
+		}
+	}
+	for _, pattern := range []string{"snapshot-*", "log-*", "*.tmp"} {
+		matches, err := filepath.Glob(d.path(pattern))
+		if err != nil {
+			return err
+		}
+		for _, m := range matches {
+			if live[filepath.Base(m)] {
+				continue
+			}
+			err = os.Remove(m)
+			if err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (d *DiskLogDir) loadSnapshot() error {
+	if !d.gen.hasSnapshot() {
+		return nil
+	}
+	f, err := os.Open(d.path(d.gen.snapshotFile()))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	br := bufio.NewReader(f)
+	_, err = br.Peek(1)
+	if err == io.EOF {
+		return nil
+	}
+	r, err := newLogReader(br, detectLogFormat(br))
+	if err != nil {
+		return err
+	}
+	_, err = replayLog(r, d.backing, d.gen.seq, DiskLogOptions{})
+	if err != nil {
+		return fmt.Errorf("loading %v: %v", d.gen.snapshotFile(), err)
+	}
+	return nil
+}
+
+func (d *DiskLogDir) newPredicate(n string, a int) *predicate {
+	return d.backing.newPredicate(n, a)
+}
+
+func (d *DiskLogDir) assert(c *clause) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	err := d.log.assert(c)
+	if err != nil {
+		return err
+	}
+	return d.wrote()
+}
+
+func (d *DiskLogDir) retract(c *clause) ([]*clause, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	removed, err := d.log.retract(c)
+	if err != nil {
+		return removed, err
+	}
+	for range removed {
+		err = d.wrote()
+		if err != nil {
+			return removed, err
+		}
+	}
+	return removed, nil
+}
+
+func (d *DiskLogDir) holds(c *clause) bool {
+	return d.backing.holds(c)
+}
+
+func (d *DiskLogDir) allClauses() []*clause {
+	return d.backing.allClauses()
+}
+
+func (d *DiskLogDir) schema() *schema {
+	return d.backing.schema()
+}
+
+func (d *DiskLogDir) declare(name string, attrs []Attribute) (bool, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	added, err := d.log.declare(name, attrs)
+	if err != nil || !added || d.log.w == nil {
+		return added, err
+	}
+	return true, d.wrote()
+}
+
+// wrote counts a record written to the log, taking a snapshot if one is due.
+// Callers must hold d.m.
+func (d *DiskLogDir) wrote() error {
+	d.sinceSnapshot++
+	if d.opts.SnapshotEvery > 0 && d.sinceSnapshot >= d.opts.SnapshotEvery {
+		return d.snapshot()
+	}
+	return nil
+}
+
+// Snapshot writes the current contents of the database to a new snapshot,
+// and starts a new, empty log.
+func (d *DiskLogDir) Snapshot() error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.log.w == nil {
+		return errPointInTimeReadOnly
+	}
+	return d.snapshot()
+}
+
+func (d *DiskLogDir) snapshot() error {
+	next := generation{seq: d.log.seq, time: logClock().UTC()}
+	if d.gen.hasSnapshot() && !next.time.After(d.gen.time) {
+		// Keep generations ordered, even if the clock is coarse.
+		next.time = d.gen.time.Add(time.Nanosecond)
+	}
+
+	err := d.writeFileAtomically(next.snapshotFile(), func(f *os.File) error {
+		w, err := newLogWriter(f, d.opts.Format)
+		if err != nil {
+			return err
+		}
+		// Declarations come first, so that they are checked against the
+		// clauses that follow them.
+		cmds := declarationCommands(d.backing)
+		for _, c := range d.backing.allClauses() {
+			cmds = append(cmds, clauseCommand(c, Assert))
+		}
+		for _, cmd := range cmds {
+			err = w.write(logRecord{cmd: cmd, seq: next.seq, time: next.time})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	f, w := d.f, d.log.w
+	if next.seq != d.gen.seq {
+		f, err = os.OpenFile(d.path(next.logFile()), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+		if err != nil {
+			return err
+		}
+		w, err = newLogWriter(f, d.opts.Format)
+		if err == nil {
+			err = f.Sync()
+		}
+	}
+	// Otherwise nothing was written since the last snapshot, so the live
+	// log is empty, and remains the log for the new generation.
+	if err == nil {
+		err = d.writeFileAtomically(currentGenerationFile, func(f *os.File) error {
+			_, err := fmt.Fprintln(f, next)
+			return err
+		})
+	}
+	if err != nil {
+		if f != d.f {
+			f.Close()
+		}
+		return err
+	}
+
+	// The new generation is now live.
+	if f != d.f {
+		d.f.Close()
+	}
+	d.gen, d.f = next, f
+	d.log = &disklogdb{w: w, backing: d.backing, seq: next.seq}
+	d.sinceSnapshot = 0
+	return d.removeStaleFiles()
+}
+
+// writeFileAtomically creates a file in the directory with the contents
+// produced by write, such that the file either does not exist or is complete,
+// even across crashes.
+func (d *DiskLogDir) writeFileAtomically(name string, write func(*os.File) error) error {
+	tmp := d.path(name + ".tmp")
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	err = write(f)
+	if err == nil {
+		err = f.Sync()
+	}
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, d.path(name))
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return d.syncDir()
+}
+
+func (d *DiskLogDir) syncDir() error {
+	dir, err := os.Open(d.dir)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+// Close closes the log. The database must not be used afterwards.
+func (d *DiskLogDir) Close() error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.f == nil {
+		return nil
+	}
+	return d.f.Close()
+}
@@ -0,0 +1,153 @@
+package gotalog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDiskLogDirInterface(t *testing.T) {
+	interfaceTest(t, func() Database {
+		dir, err := ioutil.TempDir("", "logdirInterfaceTests")
+		panicOnError(err)
+		db, err := OpenDiskLogDir(dir, NewMemDatabase(), DiskLogOptions{SnapshotEvery: 100})
+		panicOnError(err)
+		return db
+	})
+}
+
+func countRecords(filename string) int {
+	f, err := os.Open(filename)
+	panicOnError(err)
+	defer f.Close()
+	cmds, err := Parse(f)
+	panicOnError(err)
+	return len(cmds)
+}
+
+func TestDiskLogDirSnapshot(t *testing.T) {
+	for _, format := range []LogFormat{TextLog, BinaryLog} {
+		dir, err := ioutil.TempDir("", "logdirSnapshot")
+		panicOnError(err)
+		defer os.RemoveAll(dir)
+
+		cmds, err := Parse(strings.NewReader(tornLogProgram))
+		panicOnError(err)
+		db, err := OpenDiskLogDir(dir, NewMemDatabase(), DiskLogOptions{Format: format})
+		panicOnError(err)
+		_, err = ApplyAll(cmds[:4], db)
+		panicOnError(err)
+		panicOnError(db.Snapshot())
+		_, err = ApplyAll(cmds[4:], db)
+		panicOnError(err)
+		panicOnError(db.Close())
+
+		names, err := filepath.Glob(filepath.Join(dir, "*"))
+		panicOnError(err)
+		if len(names) != 3 {
+			t.Errorf("expected CURRENT, one snapshot and one log, got %v", names)
+		}
+		gen, err := readCurrentGeneration(dir)
+		panicOnError(err)
+		if gen.seq != 4 {
+			t.Errorf("expected the snapshot to cover 4 records, got %v", gen.seq)
+		}
+		if format == TextLog {
+			if n := countRecords(filepath.Join(dir, gen.logFile())); n != len(cmds)-4 {
+				t.Errorf("expected the log to hold only records after the snapshot, got %v", n)
+			}
+		}
+
+		db, err = OpenDiskLogDir(dir, NewMemDatabase(), DiskLogOptions{Format: format})
+		panicOnError(err)
+		compareDatalogResult(t, parseApplyExecute(t, "ancestor(X, Y)?", db), replayPrefix(t, cmds, len(cmds)))
+		panicOnError(db.Close())
+	}
+}
+
+func TestDiskLogDirPeriodicSnapshots(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logdirPeriodic")
+	panicOnError(err)
+	defer os.RemoveAll(dir)
+
+	db, err := OpenDiskLogDir(dir, NewMemDatabase(), DiskLogOptions{SnapshotEvery: 4})
+	panicOnError(err)
+	parseApplyExecute(t, "n(1). n(2). n(3). n(4). n(5). n(6). n(7). n(8). n(9). n(10).", db)
+	panicOnError(db.Close())
+
+	gen, err := readCurrentGeneration(dir)
+	panicOnError(err)
+	if gen.seq != 8 {
+		t.Errorf("expected the last snapshot to cover 8 records, got %v", gen.seq)
+	}
+	if n := countRecords(filepath.Join(dir, gen.logFile())); n != 2 {
+		t.Errorf("expected 2 records after the last snapshot, got %v", n)
+	}
+	if n := countRecords(filepath.Join(dir, gen.snapshotFile())); n != 8 {
+		t.Errorf("expected 8 records in the snapshot, got %v", n)
+	}
+}
+
+func TestDiskLogDirInterruptedSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logdirInterrupted")
+	panicOnError(err)
+	defer os.RemoveAll(dir)
+
+	db, err := OpenDiskLogDir(dir, NewMemDatabase(), DiskLogOptions{})
+	panicOnError(err)
+	parseApplyExecute(t, "n(1). n(2).", db)
+	panicOnError(db.Close())
+
+	// Simulate a crash after the next generation's files were written, but
+	// before CURRENT was replaced.
+	next := generation{seq: 2, time: time.Now()}
+	panicOnError(ioutil.WriteFile(filepath.Join(dir, next.snapshotFile()), []byte("n(1).\n"), 0644))
+	panicOnError(ioutil.WriteFile(filepath.Join(dir, next.logFile()), nil, 0644))
+	panicOnError(ioutil.WriteFile(filepath.Join(dir, currentGenerationFile+".tmp"), []byte(next.String()), 0644))
+
+	db, err = OpenDiskLogDir(dir, NewMemDatabase(), DiskLogOptions{})
+	panicOnError(err)
+	defer db.Close()
+	compareDatalogResult(t, parseApplyExecute(t, "n(X)?", db), "n(1).\nn(2).\n")
+	names, err := filepath.Glob(filepath.Join(dir, "*"))
+	panicOnError(err)
+	if len(names) != 1 {
+		t.Errorf("expected stale files to be removed, got %v", names)
+	}
+}
+
+func TestDiskLogDirPointInTime(t *testing.T) {
+	defer tickingClock(time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC))()
+	cmds, err := Parse(strings.NewReader(tornLogProgram))
+	panicOnError(err)
+
+	for _, keep := range []int{0, 10} {
+		dir, err := ioutil.TempDir("", "logdirPointInTime")
+		panicOnError(err)
+		defer os.RemoveAll(dir)
+
+		db, err := OpenDiskLogDir(dir, NewMemDatabase(), DiskLogOptions{SnapshotEvery: 2, KeepSnapshots: keep})
+		panicOnError(err)
+		_, err = ApplyAll(cmds, db)
+		panicOnError(err)
+		panicOnError(db.Close())
+
+		for n := 1; n <= len(cmds); n++ {
+			db, err := OpenDiskLogDir(dir, NewMemDatabase(), DiskLogOptions{AsOfSequence: uint64(n)})
+			if keep == 0 && n < len(cmds) {
+				if err == nil {
+					t.Errorf("expected history before the last snapshot to be gone")
+				}
+				continue
+			}
+			panicOnError(err)
+			compareDatalogResult(t, parseApplyExecute(t, "ancestor(X, Y)?", db), replayPrefix(t, cmds, n))
+			if db.Snapshot() == nil {
+				t.Errorf("expected a database opened at a past point in time to be read-only")
+			}
+		}
+	}
+}
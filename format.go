@@ -0,0 +1,161 @@
+package gotalog
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxLineLength is the length beyond which the formatter puts each literal of
+// a rule's body on its own line.
+const maxLineLength = 80
+
+// Format reformats a datalog program canonically: one command per line, with
+// single spaces after commas and around ':-', and constants quoted only where
+// required. Rules too long for one line have one body literal per line.
+// Runs of clauses for the same predicate are grouped, separated from other
+// commands by a blank line; other blank lines are kept, but not repeated.
+// Comments are kept, those ending a command's line after it.
+//
+// Syntax errors are reported as a *ParseError.
+func Format(src []byte) ([]byte, error) {
+	return formatNamed(bytes.NewReader(src), "")
+}
+
+// FormatFile is like Format, but reads the program from filename, which is
+// named in syntax errors. Include directives are kept rather than expanded.
+func FormatFile(filename string) ([]byte, error) {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return formatNamed(bytes.NewReader(src), filename)
+}
+
+// formatItem is a formatted command or a comment standing on its own.
+type formatItem struct {
+	text               string
+	startLine, endLine int
+	// group is the key of the group a command belongs to, and empty for
+	// comments.
+	group string
+}
+
+func formatNamed(input io.Reader, filename string) ([]byte, error) {
+	s := newScanner(input)
+	s.filename = filename
+	s.keepComments = true
+
+	var items []formatItem
+	// next is the index of the first comment not yet added to items.
+	next := 0
+	addComment := func() {
+		c := s.comments[next]
+		items = append(items, formatItem{text: c.text, startLine: c.pos.Line, endLine: c.endLine})
+		next++
+	}
+	for {
+		c, finished, err := s.scanOneCommand()
+		if err != nil {
+			return nil, err
+		}
+		if finished {
+			break
+		}
+		// Comments before the end of a command, even within it, are put
+		// before it.
+		last := s.last.pos
+		for next < len(s.comments) && (s.comments[next].pos.Line < last.Line ||
+			s.comments[next].pos.Line == last.Line && s.comments[next].pos.Column < last.Column) {
+			addComment()
+		}
+
+		item := formatItem{
+			text:      formatCommand(c),
+			startLine: c.Pos.Line,
+			endLine:   last.Line,
+			group:     commandGroup(c),
+		}
+		// Those following it on its last line stay there. Peeking at the
+		// next token reads them.
+		_, err = s.peek()
+		if err != nil {
+			return nil, err
+		}
+		for next < len(s.comments) && s.comments[next].pos.Line == last.Line {
+			item.text += " " + s.comments[next].text
+			item.endLine = s.comments[next].endLine
+			next++
+		}
+		items = append(items, item)
+	}
+	for next < len(s.comments) {
+		addComment()
+	}
+
+	var b strings.Builder
+	for i, item := range items {
+		if i > 0 && (item.startLine > items[i-1].endLine+1 || startsGroup(items, i)) {
+			b.WriteString("\n")
+		}
+		b.WriteString(item.text)
+		b.WriteString("\n")
+	}
+	return []byte(b.String()), nil
+}
+
+// commandGroup returns the key of the group of commands a command belongs
+// to: clauses are grouped by predicate, and other commands by type.
+func commandGroup(c DatalogCommand) string {
+	switch c.CommandType {
+	case Assert:
+		if len(c.Body) == 0 {
+			return "fact " + predicateID(c.Head.PredicateName, len(c.Head.Terms))
+		}
+		return "rule " + predicateID(c.Head.PredicateName, len(c.Head.Terms))
+	case Directive:
+		return "directive"
+	}
+	return commandNames[c.CommandType]
+}
+
+// startsGroup reports whether items[i] is the first of the comments leading
+// a command in a different group from the command before them.
+func startsGroup(items []formatItem, i int) bool {
+	if items[i-1].group == "" {
+		return false
+	}
+	for _, item := range items[i:] {
+		if item.group != "" {
+			return item.group != items[i-1].group
+		}
+	}
+	return false
+}
+
+// formatCommand formats a command, splitting rules that are too long for one
+// line.
+func formatCommand(c DatalogCommand) string {
+	line := c.String()
+	if len(c.Body) < 2 || utf8.RuneCountInString(line) <= maxLineLength {
+		return line
+	}
+	var b strings.Builder
+	writeLiteral(&b, c.Head)
+	b.WriteString(" :-")
+	for i, l := range c.Body {
+		b.WriteString("\n    ")
+		writeLiteral(&b, l)
+		if i < len(c.Body)-1 {
+			b.WriteString(",")
+		}
+	}
+	if c.CommandType == Retract {
+		b.WriteString("~")
+	} else {
+		b.WriteString(".")
+	}
+	return b.String()
+}
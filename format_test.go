@@ -0,0 +1,135 @@
+package gotalog
+
+import (
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	src := `% product shipping example
+
+
+ship_to(ProdName,City):-has_ordered(CustNo,ProdNo),customer_city(CustNo,City),product_name(ProdNo,ProdName).
+customer_city(1,london). customer_city(3,"San Francisco"). % trailing
+/* block
+   comment */
+customer_city(4, 'munich').
+.input edge(delimiter=",")
+reachable(X,Y) :- edge(X,Y).   reachable(X,Y) :- edge(X,Z), % inside
+  reachable(Z,Y).
+reachable(X, Y)?
+% the end
+`
+	expected := `% product shipping example
+
+ship_to(ProdName, City) :-
+    has_ordered(CustNo, ProdNo),
+    customer_city(CustNo, City),
+    product_name(ProdNo, ProdName).
+
+customer_city(1, london).
+customer_city(3, 'San Francisco'). % trailing
+/* block
+   comment */
+customer_city(4, munich).
+
+.input edge(delimiter=",")
+
+reachable(X, Y) :- edge(X, Y).
+% inside
+reachable(X, Y) :- edge(X, Z), reachable(Z, Y).
+
+reachable(X, Y)?
+% the end
+`
+	out, err := Format([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != expected {
+		t.Errorf("got\n%s\nexpected\n%s", out, expected)
+	}
+}
+
+func TestFormatIsIdempotent(t *testing.T) {
+	for _, filename := range []string{"tests/ship.pl", "tests/small.pl", "tests/graph10.pl"} {
+		src, err := ioutil.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		once, err := Format(src)
+		if err != nil {
+			t.Fatalf("%v: %v", filename, err)
+		}
+		twice, err := Format(once)
+		if err != nil {
+			t.Fatalf("%v: %v", filename, err)
+		}
+		if string(once) != string(twice) {
+			t.Errorf("%v: formatting again changed\n%s\nto\n%s", filename, once, twice)
+		}
+	}
+	// ship.pl is already formatted.
+	src, _ := ioutil.ReadFile("tests/ship.pl")
+	if out, _ := Format(src); string(out) != string(src) {
+		t.Errorf("tests/ship.pl was reformatted as\n%s", out)
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	for _, src := range []string{"foo(a", "foo(a). /* open", "foo(a). bar(b) $"} {
+		_, err := Format([]byte(src))
+		if _, ok := err.(*ParseError); !ok {
+			t.Errorf("%q: got %v, expected a *ParseError", src, err)
+		}
+	}
+}
+
+// FuzzFormat checks that formatting keeps a program's meaning, and that
+// formatted programs are left alone.
+func FuzzFormat(f *testing.F) {
+	addParseSeeds(f)
+	f.Add("p(a). % c\n/* d */ q(X) :- /* e */ p(X).\n\n\nq(X)? /* f\ng */ % h")
+	f.Fuzz(func(t *testing.T, src string) {
+		cmds, err := Parse(strings.NewReader(src))
+		out, fmtErr := Format([]byte(src))
+		if (err == nil) != (fmtErr == nil) {
+			t.Fatalf("Parse returned %v, but Format returned %v", err, fmtErr)
+		}
+		if err != nil {
+			return
+		}
+		reparsed, err := Parse(strings.NewReader(string(out)))
+		if err != nil {
+			t.Fatalf("%q was formatted as %q, which does not parse: %v", src, out, err)
+		}
+		if !reflect.DeepEqual(withoutPositions(reparsed), withoutPositions(cmds)) {
+			t.Fatalf("%q was formatted as %q, which parses as %v rather than %v", src, out, reparsed, cmds)
+		}
+		if c, d := commentTexts(src), commentTexts(string(out)); !reflect.DeepEqual(c, d) {
+			t.Fatalf("%q was formatted as %q, changing its comments from %q to %q", src, out, c, d)
+		}
+		again, err := Format(out)
+		if err != nil || string(again) != string(out) {
+			t.Fatalf("%q was formatted as %q, but that as %q", src, out, again)
+		}
+	})
+}
+
+func commentTexts(src string) []string {
+	s := newScanner(strings.NewReader(src))
+	s.keepComments = true
+	for {
+		_, finished, err := s.scanOneCommand()
+		if err != nil || finished {
+			break
+		}
+	}
+	var texts []string
+	for _, c := range s.comments {
+		texts = append(texts, c.text)
+	}
+	return texts
+}
@@ -0,0 +1,147 @@
+package gotalog
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func generate(t *testing.T, spec ProgramSpec) []DatalogCommand {
+	var cmds []DatalogCommand
+	err := GenerateProgram(spec, func(cmd DatalogCommand) error {
+		cmds = append(cmds, cmd)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cmds
+}
+
+func commandStrings(cmds []DatalogCommand) []string {
+	var s []string
+	for _, cmd := range cmds {
+		s = append(s, cmd.String())
+	}
+	return s
+}
+
+// asGenerated removes from the commands of a program in tests/ the
+// differences from generated programs that GeneratedKinds lists, adding the
+// generated query if it has none.
+func asGenerated(cmds []DatalogCommand, spec ProgramSpec, query DatalogCommand) []DatalogCommand {
+	var kept []DatalogCommand
+	for _, cmd := range cmds {
+		head := cmd.Head
+		if head.PredicateName == "increasing" {
+			continue
+		}
+		if spec.Kind == "clique" && head.PredicateName == "edge" && len(cmd.Body) == 0 &&
+			head.Terms[1] == makeConst("0") && head.Terms[0] != makeConst(strconv.Itoa(spec.Size)) {
+			continue
+		}
+		kept = append(kept, cmd)
+	}
+	if len(kept) == 0 || kept[len(kept)-1].CommandType != Query {
+		kept = append(kept, query)
+	}
+	return kept
+}
+
+func TestGeneratedProgramsLikeTests(t *testing.T) {
+	filenames, err := filepath.Glob("tests/*.pl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	generated := regexp.MustCompile(`^tests/(clique|graph|induction)([0-9]+)\.pl$`)
+	compared := 0
+	for _, filename := range filenames {
+		m := generated.FindStringSubmatch(filepath.ToSlash(filename))
+		if m == nil {
+			continue
+		}
+		size, err := strconv.Atoi(m[2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		spec := ProgramSpec{Kind: m[1], Size: size}
+
+		f, err := os.Open(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmds, err := Parse(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := generate(t, spec)
+		expected := commandStrings(asGenerated(cmds, spec, got[len(got)-1]))
+		if !reflect.DeepEqual(commandStrings(got), expected) {
+			t.Errorf("%v differs from %v", spec, filename)
+		}
+		compared++
+	}
+	if compared != 12 {
+		t.Errorf("compared %v programs in tests/, expected 12", compared)
+	}
+}
+
+func TestWriteProgram(t *testing.T) {
+	for _, kind := range GeneratedKinds {
+		spec := ProgramSpec{Kind: kind, Size: 20, Seed: 3}
+		var b bytes.Buffer
+		err := WriteProgram(&b, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cmds := mustParse(t, b.String())
+		if !reflect.DeepEqual(commandStrings(cmds), commandStrings(generate(t, spec))) {
+			t.Errorf("%v was written as\n%v", spec, b.String())
+		}
+	}
+
+	// Random programs depend only on their seed.
+	var a, b bytes.Buffer
+	WriteProgram(&a, ProgramSpec{Kind: "random", Size: 20, Seed: 3})
+	WriteProgram(&b, ProgramSpec{Kind: "random", Size: 20, Seed: 3})
+	if a.String() != b.String() {
+		t.Errorf("random programs with the same seed differ")
+	}
+	b.Reset()
+	WriteProgram(&b, ProgramSpec{Kind: "random", Size: 20, Seed: 4})
+	if a.String() == b.String() {
+		t.Errorf("random programs with different seeds are the same")
+	}
+}
+
+func TestLoadProgram(t *testing.T) {
+	db := NewMemDatabase()
+	query, err := LoadProgram(db, ProgramSpec{Kind: "graph", Size: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := Apply(query, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Answers) != 51 {
+		t.Errorf("%v has %v answers, expected 51", query, len(res.Answers))
+	}
+}
+
+func TestGenerateProgramErrors(t *testing.T) {
+	for _, spec := range []ProgramSpec{
+		{Kind: "tree", Size: 10},
+		{Kind: "clique", Size: 0},
+	} {
+		err := GenerateProgram(spec, func(DatalogCommand) error { return nil })
+		if err == nil {
+			t.Errorf("%+v: expected an error", spec)
+		}
+	}
+}
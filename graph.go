@@ -0,0 +1,190 @@
+package gotalog
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// DependencyGraph shows which predicates feed which in a database's clauses.
+// Predicates are identified as name/arity.
+type DependencyGraph struct {
+	Predicates []string         `json:"predicates"`
+	Edges      []DependencyEdge `json:"edges"`
+	Components []GraphComponent `json:"components"`
+}
+
+// DependencyEdge records that a rule for To has From in its body.
+type DependencyEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Recursive is set if To also feeds From, directly or not.
+	Recursive bool `json:"recursive,omitempty"`
+}
+
+// GraphComponent is a strongly connected component of a dependency graph:
+// a set of predicates that each depend on all the others.
+type GraphComponent struct {
+	Predicates []string `json:"predicates"`
+	// Recursive is set if the predicates depend on themselves, which is
+	// always so for a component of more than one predicate.
+	Recursive bool `json:"recursive,omitempty"`
+}
+
+// PredicateGraph computes the dependency graph of the clauses stored in db.
+// Its predicates and edges are sorted, and its components are listed with
+// those each depends on first.
+func PredicateGraph(db Database) DependencyGraph {
+	nodes := make(map[string]bool)
+	edges := make(map[DependencyEdge]bool)
+	for _, c := range db.allClauses() {
+		head := c.head.pred.id
+		nodes[head] = true
+		for _, l := range c.body {
+			nodes[l.pred.id] = true
+			edges[DependencyEdge{From: l.pred.id, To: head}] = true
+		}
+	}
+
+	g := DependencyGraph{
+		Predicates: make([]string, 0, len(nodes)),
+		Edges:      make([]DependencyEdge, 0, len(edges)),
+		Components: make([]GraphComponent, 0),
+	}
+	for n := range nodes {
+		g.Predicates = append(g.Predicates, n)
+	}
+	sort.Strings(g.Predicates)
+	successors := make(map[string][]string)
+	for e := range edges {
+		g.Edges = append(g.Edges, e)
+		successors[e.From] = append(successors[e.From], e.To)
+	}
+	sort.Slice(g.Edges, func(i, j int) bool {
+		if g.Edges[i].From != g.Edges[j].From {
+			return g.Edges[i].From < g.Edges[j].From
+		}
+		return g.Edges[i].To < g.Edges[j].To
+	})
+	for _, s := range successors {
+		sort.Strings(s)
+	}
+
+	component := make(map[string]int)
+	for i, preds := range stronglyConnected(g.Predicates, successors) {
+		sort.Strings(preds)
+		g.Components = append(g.Components, GraphComponent{Predicates: preds, Recursive: len(preds) > 1})
+		for _, p := range preds {
+			component[p] = i
+		}
+	}
+	for i, e := range g.Edges {
+		if component[e.From] == component[e.To] {
+			g.Edges[i].Recursive = true
+			g.Components[component[e.From]].Recursive = true
+		}
+	}
+	return g
+}
+
+// stronglyConnected returns the strongly connected components of a graph with
+// Tarjan's algorithm, ordered so that no component has an edge to an earlier
+// one.
+func stronglyConnected(nodes []string, successors map[string][]string) [][]string {
+	var (
+		index      = make(map[string]int)
+		lowlink    = make(map[string]int)
+		onStack    = make(map[string]bool)
+		stack      []string
+		components [][]string
+	)
+	var visit func(n string)
+	visit = func(n string) {
+		index[n] = len(index)
+		lowlink[n] = index[n]
+		stack = append(stack, n)
+		onStack[n] = true
+		for _, s := range successors[n] {
+			if _, ok := index[s]; !ok {
+				visit(s)
+				if lowlink[s] < lowlink[n] {
+					lowlink[n] = lowlink[s]
+				}
+			} else if onStack[s] && index[s] < lowlink[n] {
+				lowlink[n] = index[s]
+			}
+		}
+		if lowlink[n] != index[n] {
+			return
+		}
+		var c []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			c = append(c, top)
+			if top == n {
+				break
+			}
+		}
+		components = append(components, c)
+	}
+	for _, n := range nodes {
+		if _, ok := index[n]; !ok {
+			visit(n)
+		}
+	}
+
+	// Tarjan's algorithm finds components after those they have edges to.
+	for i, j := 0, len(components)-1; i < j; i, j = i+1, j-1 {
+		components[i], components[j] = components[j], components[i]
+	}
+	return components
+}
+
+// WriteDOT writes the graph in Graphviz's DOT language. Recursive edges are
+// drawn in red, and each recursive component is boxed.
+func (g DependencyGraph) WriteDOT(w io.Writer) error {
+	_, err := fmt.Fprintln(w, "digraph dependencies {")
+	if err != nil {
+		return err
+	}
+	for i, c := range g.Components {
+		if !c.Recursive {
+			for _, p := range c.Predicates {
+				_, err = fmt.Fprintf(w, "\t%v;\n", strconv.Quote(p))
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		_, err = fmt.Fprintf(w, "\tsubgraph cluster_%v {\n\t\tstyle=dashed;\n", i)
+		if err != nil {
+			return err
+		}
+		for _, p := range c.Predicates {
+			_, err = fmt.Fprintf(w, "\t\t%v;\n", strconv.Quote(p))
+			if err != nil {
+				return err
+			}
+		}
+		_, err = fmt.Fprintln(w, "\t}")
+		if err != nil {
+			return err
+		}
+	}
+	for _, e := range g.Edges {
+		attrs := ""
+		if e.Recursive {
+			attrs = " [color=red]"
+		}
+		_, err = fmt.Fprintf(w, "\t%v -> %v%v;\n", strconv.Quote(e.From), strconv.Quote(e.To), attrs)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintln(w, "}")
+	return err
+}
@@ -0,0 +1,73 @@
+package gotalog
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const graphProgram = `edge(a, b). edge(b, c).
+path(X, Y) :- edge(X, Y).
+path(X, Z) :- path(X, Y), edge(Y, Z).
+even(zero).
+even(X) :- succ(Y, X), odd(Y).
+odd(X) :- succ(Y, X), even(Y).
+report(X) :- path(a, X), even(X).`
+
+func TestPredicateGraph(t *testing.T) {
+	db := NewMemDatabase()
+	_, err := ApplyAll(mustParse(t, graphProgram), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := PredicateGraph(db)
+
+	var b strings.Builder
+	err = g.WriteDOT(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `digraph dependencies {
+	"succ/2";
+	subgraph cluster_1 {
+		style=dashed;
+		"even/1";
+		"odd/1";
+	}
+	"edge/2";
+	subgraph cluster_3 {
+		style=dashed;
+		"path/2";
+	}
+	"report/1";
+	"edge/2" -> "path/2";
+	"even/1" -> "odd/1" [color=red];
+	"even/1" -> "report/1";
+	"odd/1" -> "even/1" [color=red];
+	"path/2" -> "path/2" [color=red];
+	"path/2" -> "report/1";
+	"succ/2" -> "even/1";
+	"succ/2" -> "odd/1";
+}
+`
+	if b.String() != expected {
+		t.Errorf("got\n%v\nexpected\n%v", b.String(), expected)
+	}
+}
+
+func TestPredicateGraphJSON(t *testing.T) {
+	db := NewMemDatabase()
+	_, err := ApplyAll(mustParse(t, "p(X) :- q(X). q(a)."), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(PredicateGraph(db))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"predicates":["p/1","q/1"],"edges":[{"from":"q/1","to":"p/1"}],` +
+		`"components":[{"predicates":["q/1"]},{"predicates":["p/1"]}]}`
+	if string(b) != expected {
+		t.Errorf("got %s, expected %s", b, expected)
+	}
+}
@@ -0,0 +1,112 @@
+package gotalog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ParseFile parses the datalog program in filename, replacing each include
+// directive with the commands of the file it names, found relative to the
+// including file. The file names of other directives are made absolute, so
+// that each is resolved relative to the file it appears in.
+//
+// Syntax errors are reported as ParseErrors, listing every error in the
+// first file found to have any.
+func ParseFile(filename string) ([]DatalogCommand, error) {
+	return parseIncluded(filename, nil)
+}
+
+// parseIncluded parses filename, which was included through the files in
+// chain, outermost first.
+func parseIncluded(filename string, chain []string) ([]DatalogCommand, error) {
+	path, err := filepath.Abs(filename)
+	if err != nil {
+		return nil, err
+	}
+	for _, including := range chain {
+		if including == path {
+			return nil, fmt.Errorf("include cycle: %v", strings.Join(append(chain, path), " includes "))
+		}
+	}
+	chain = append(chain, path)
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, includeError(chain, err)
+	}
+	defer f.Close()
+	// Report positions in the outermost file as it was named.
+	name := path
+	if len(chain) == 1 {
+		name = filename
+	}
+	cmds, err := parseRecovering(f, name)
+	if err != nil {
+		return nil, includeError(chain, err)
+	}
+
+	dir := filepath.Dir(path)
+	var expanded []DatalogCommand
+	for _, cmd := range cmds {
+		if cmd.CommandType != Directive {
+			expanded = append(expanded, cmd)
+			continue
+		}
+		d := cmd.Directive
+		if d.Name == "include" {
+			included, err := parseIncluded(resolvePath(dir, d.Params["filename"]), chain)
+			if err != nil {
+				return nil, err
+			}
+			expanded = append(expanded, included...)
+			continue
+		}
+		if (d.Name == "input" || d.Name == "output") && d.Params["IO"] != "stdout" {
+			resolved := *d
+			resolved.Params = map[string]string{"filename": directiveFile(*d, dir)}
+			for k, v := range d.Params {
+				if k != "filename" {
+					resolved.Params[k] = v
+				}
+			}
+			cmd.Directive = &resolved
+		}
+		expanded = append(expanded, cmd)
+	}
+	return expanded, nil
+}
+
+func resolvePath(dir, name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+	return filepath.Join(dir, name)
+}
+
+// includeError reports err in the last file of chain, along with the files
+// that included it. Parse errors, which already name the file, remain
+// available through errors.As.
+func includeError(chain []string, err error) error {
+	if _, ok := err.(ParseErrors); ok {
+		if len(chain) == 1 {
+			return err
+		}
+		return fmt.Errorf("%w (included from %v)", err,
+			strings.Join(reversed(chain[:len(chain)-1]), ", included from "))
+	}
+	if len(chain) == 1 {
+		return fmt.Errorf("%v: %v", chain[0], err)
+	}
+	return fmt.Errorf("%v (included from %v): %v", chain[len(chain)-1],
+		strings.Join(reversed(chain[:len(chain)-1]), ", included from "), err)
+}
+
+func reversed(s []string) []string {
+	r := make([]string, len(s))
+	for i, v := range s {
+		r[len(s)-1-i] = v
+	}
+	return r
+}
@@ -0,0 +1,115 @@
+package gotalog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeFiles creates files in a new temporary directory, which it returns.
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "include")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, contents := range files {
+		path := filepath.Join(dir, name)
+		err = os.MkdirAll(filepath.Dir(path), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(path, []byte(contents), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestParseFileIncludes(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"main.pl": `#include "rules/base.pl"
+.include "fixtures/edges.pl"
+path(a, X)?
+`,
+		"rules/base.pl": `path(X, Y) :- edge(X, Y).
+path(X, Z) :- edge(X, Y), path(Y, Z).
+`,
+		"fixtures/edges.pl": `.input edge(delimiter=",")
+edge(c, d).
+`,
+		"fixtures/edge.facts": "a,b\nb,c\n",
+	})
+	defer os.RemoveAll(dir)
+
+	cmds, err := ParseFile(filepath.Join(dir, "main.pl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cmds) != 5 {
+		t.Errorf("got %v commands, expected 5: %v", len(cmds), cmds)
+	}
+
+	// The .input directive is resolved relative to the file it appears in.
+	results, err := RunFile(filepath.Join(dir, "main.pl"), NewMemDatabase())
+	if err != nil {
+		t.Fatal(err)
+	}
+	compareDatalogResult(t, ToString(results), "path(a, b).\npath(a, c).\npath(a, d).\n")
+}
+
+func TestParseFileIncludedDeclaration(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"main.pl":  ".include \"decls.pl\"\n",
+		"decls.pl": ".decl edge(from: symbol, to: number)\n",
+	})
+	defer os.RemoveAll(dir)
+
+	cmds, err := ParseFile(filepath.Join(dir, "main.pl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := withoutPositions(mustParse(t, ".decl edge(from: symbol, to: number)"))
+	if len(cmds) != 1 {
+		t.Fatalf("got %v, expected %v", cmds, expected)
+	}
+	if !reflect.DeepEqual(withoutPositions(cmds), expected) {
+		t.Errorf("got %+v, expected %+v", *cmds[0].Directive, *expected[0].Directive)
+	}
+}
+
+func TestParseFileIncludeErrors(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"a.pl":       `.include "b.pl"`,
+		"b.pl":       `.include "sub/c.pl"`,
+		"sub/c.pl":   `.include "../a.pl"`,
+		"bad.pl":     `.include "broken.pl"`,
+		"broken.pl":  `edge(a, b`,
+		"missing.pl": `.include "nonexistent.pl"`,
+	})
+	defer os.RemoveAll(dir)
+
+	cases := []struct {
+		filename string
+		expected []string
+	}{
+		{"a.pl", []string{"include cycle", "a.pl includes", "b.pl includes", "c.pl includes", "a.pl"}},
+		{"bad.pl", []string{"broken.pl:1:10: unexpected end of input (included from", "bad.pl)"}},
+		{"missing.pl", []string{"nonexistent.pl (included from", "missing.pl)"}},
+	}
+	for _, c := range cases {
+		_, err := ParseFile(filepath.Join(dir, c.filename))
+		if err == nil {
+			t.Errorf("%v: expected an error", c.filename)
+			continue
+		}
+		for _, s := range c.expected {
+			if !strings.Contains(err.Error(), s) {
+				t.Errorf("%v: error %q does not mention %q", c.filename, err, s)
+			}
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package gotalog
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 )
 
@@ -13,7 +16,19 @@ import (
 type Database interface {
 	newPredicate(n string, a int) *predicate
 	assert(c *clause) error
-	retract(c *clause) error
+	// retract removes the clause that is a variant of c, if there is one.
+	// If c is a fact containing variables, it instead removes every fact
+	// that c matches. It returns the clauses removed.
+	retract(c *clause) ([]*clause, error)
+	// holds reports whether the database stores c or a variant of it.
+	holds(c *clause) bool
+	// allClauses returns every clause currently held by the database.
+	allClauses() []*clause
+	// schema returns the predicate declarations of the database.
+	schema() *schema
+	// declare records the attributes of the predicate name, reporting
+	// whether it was not already declared.
+	declare(name string, attrs []Attribute) (bool, error)
 }
 
 // Term contains either a variable or a constant.
@@ -25,10 +40,85 @@ type Term struct {
 	value string
 }
 
+// Position locates a command or literal in the text it was parsed from.
+// Lines and columns count from 1; columns count runes.
+type Position struct {
+	Filename     string
+	Line, Column int
+}
+
+// IsValid reports whether the position is known. Commands that were not
+// parsed from text have no position.
+func (p Position) IsValid() bool {
+	return p.Line > 0
+}
+
+func (p Position) String() string {
+	s := fmt.Sprintf("%v:%v", p.Line, p.Column)
+	if p.Filename != "" {
+		s = p.Filename + ":" + s
+	}
+	return s
+}
+
+// ParseError describes a syntax error and where it was found.
+type ParseError struct {
+	Position
+	Msg string
+	// Source is the line of input containing the error, and Caret marks the
+	// error's column beneath it.
+	Source string
+	Caret  string
+	// Err is the underlying error, such as io.EOF if the input ended part
+	// way through a command.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Position, e.Msg)
+}
+
+// Excerpt returns the source line containing the error, with a caret
+// beneath the error.
+func (e *ParseError) Excerpt() string {
+	return e.Source + "\n" + e.Caret
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseErrors lists the syntax errors found in one pass over some input, in
+// the order they occur.
+type ParseErrors []*ParseError
+
+func (e ParseErrors) Error() string {
+	switch len(e) {
+	case 0:
+		return "no parse errors"
+	case 1:
+		return e[0].Error()
+	case 2:
+		return fmt.Sprintf("%v (and 1 more error)", e[0])
+	}
+	return fmt.Sprintf("%v (and %v more errors)", e[0], len(e)-1)
+}
+
+// Unwrap returns the individual errors, for errors.Is and errors.As.
+func (e ParseErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, pe := range e {
+		errs[i] = pe
+	}
+	return errs
+}
+
 // LiteralDefinition defines a literal PredicateName(Term0, Term1, ...).
 type LiteralDefinition struct {
 	PredicateName string
 	Terms         []Term
+	// Pos is where the literal was parsed from, if it was.
+	Pos Position
 }
 
 // CommandType differentiates different possible datalog commands.
@@ -42,18 +132,58 @@ const (
 	Query
 	// Retract - remove a fact from a database.
 	Retract
+	// Directive - an instruction to the program's runner, such as to load
+	// facts from a file, rather than to the database.
+	Directive
 )
 
+// DirectiveDefinition defines a directive .Name Predicate(key="value", ...),
+// or, for a declaration, .decl Predicate(name: type, ...).
+type DirectiveDefinition struct {
+	Name      string            `json:"name"`
+	Predicate string            `json:"predicate"`
+	Params    map[string]string `json:"params,omitempty"`
+	// Attributes are the arguments of a declared predicate.
+	Attributes []Attribute `json:"attributes,omitempty"`
+}
+
 // DatalogCommand a command to mutate or query a gotalog database.
 type DatalogCommand struct {
 	Head        LiteralDefinition
 	Body        []LiteralDefinition
 	CommandType CommandType
+	// Directive is set only for commands of type Directive, which have no
+	// head or body.
+	Directive *DirectiveDefinition
+	// Pos is where the command was parsed from, if it was.
+	Pos Position
+}
+
+// String formats a command as datalog.
+func (cmd DatalogCommand) String() string {
+	var b strings.Builder
+	writeCommand(&b, cmd)
+	return b.String()
 }
 
 // Parse consumes a reader, producing a slice of datalogCommands.
+// Syntax errors are reported as a *ParseError.
 func Parse(input io.Reader) ([]DatalogCommand, error) {
+	return parseNamed(input, "")
+}
+
+// ParseWithRecovery is like Parse, but rather than stopping at the first
+// syntax error, it skips to the end of the erroneous command and carries on.
+// It returns the commands that parsed successfully and, if there were any
+// errors, all of them as ParseErrors.
+func ParseWithRecovery(input io.Reader) ([]DatalogCommand, error) {
+	return parseRecovering(input, "")
+}
+
+// parseNamed is like Parse, but records filename in positions.
+func parseNamed(input io.Reader, filename string) ([]DatalogCommand, error) {
 	s := newScanner(input)
+	s.filename = filename
 
 	commands := make([]DatalogCommand, 0)
 
@@ -66,12 +196,43 @@ func Parse(input io.Reader) ([]DatalogCommand, error) {
 	}
 }
 
+// parseRecovering is like ParseWithRecovery, but records filename in
+// positions.
+func parseRecovering(input io.Reader, filename string) ([]DatalogCommand, error) {
+	s := newScanner(input)
+	s.filename = filename
+
+	commands := make([]DatalogCommand, 0)
+	var errs ParseErrors
+	for {
+		c, finished, err := s.scanOneCommand()
+		if finished {
+			break
+		}
+		if err != nil {
+			pe := err.(*ParseError)
+			errs = append(errs, pe)
+			if errors.Is(err, io.EOF) || s.readErr != nil || !s.resync(c.CommandType == Directive, pe.Line) {
+				break
+			}
+			continue
+		}
+		commands = append(commands, c)
+	}
+	if errs != nil {
+		return commands, errs
+	}
+	return commands, nil
+}
+
 // Scan iterates through a io reader, throwing commands into a channel as
 // they are read from the reader.
 func Scan(input io.Reader) (chan DatalogCommand, chan error) {
 
 	commands := make(chan DatalogCommand, 1000)
-	errors := make(chan error)
+	// Buffered so that the scanning goroutine can report an error and exit
+	// even if the caller only checks for errors after draining commands.
+	errors := make(chan error, 1)
 
 	s := newScanner(input)
 
@@ -97,6 +258,21 @@ func Scan(input io.Reader) (chan DatalogCommand, chan error) {
 // Apply applies a single command.
 // TODO: do we really need this and ApplyAll?
 func Apply(cmd DatalogCommand, db Database) (*Result, error) {
+	return ApplyContext(context.Background(), cmd, db)
+}
+
+// ApplyContext is like Apply, but abandons queries with the context's error
+// if it is done before they complete.
+func ApplyContext(ctx context.Context, cmd DatalogCommand, db Database) (*Result, error) {
+	return applyProfiled(ctx, cmd, db, nil)
+}
+
+// applyProfiled is like ApplyContext, but profiles queries in p, if it is
+// set.
+func applyProfiled(ctx context.Context, cmd DatalogCommand, db Database, p *profiler) (*Result, error) {
+	if cmd.CommandType == Directive {
+		return nil, fmt.Errorf("%v must be run with ExecuteDirective", cmd)
+	}
 	head := buildLiteral(cmd.Head, db)
 	switch cmd.CommandType {
 	case Assert:
@@ -104,44 +280,82 @@ func Apply(cmd DatalogCommand, db Database) (*Result, error) {
 		for i, ml := range cmd.Body {
 			body[i] = buildLiteral(ml, db)
 		}
-		err := db.assert(&clause{
+		c := &clause{
 			head: head,
 			body: body,
-		})
-		return nil, err
+		}
+		err := checkClause(db, c)
+		if err != nil {
+			return nil, err
+		}
+		return nil, db.assert(c)
 	case Query:
-		res := ask(head)
+		err := checkLiteral(db, head)
+		if err != nil {
+			return nil, err
+		}
+		res, err := askProfiled(ctx, head, p)
+		if err != nil {
+			return nil, err
+		}
 		return &res, nil
 	case Retract:
 		body := make([]literal, len(cmd.Body))
 		for i, ml := range cmd.Body {
 			body[i] = buildLiteral(ml, db)
 		}
-		db.retract(&clause{
+		removed, err := db.retract(&clause{
 			head: head,
 			body: body,
-		}) // really, no errors can happen?
-		return nil, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &Result{
+			Name:      head.pred.Name,
+			Arity:     head.pred.Arity,
+			Retracted: len(removed),
+		}, nil
 	}
 	return nil, fmt.Errorf("bogus command - this should never happen")
 }
 
-// Result contain deduced facts that match a query.
+// ListClauses returns, as assertions, the clauses stored in a database for
+// the predicate name/arity, sorted by their text. A negative arity selects
+// predicates named name of any arity, and an empty name selects every
+// predicate.
+func ListClauses(db Database, name string, arity int) []DatalogCommand {
+	var cmds []DatalogCommand
+	for _, c := range db.allClauses() {
+		if (name == "" || c.head.pred.Name == name) && (arity < 0 || c.head.pred.Arity == arity) {
+			cmds = append(cmds, clauseCommand(c, Assert))
+		}
+	}
+	sort.Slice(cmds, func(i, j int) bool {
+		return cmds[i].String() < cmds[j].String()
+	})
+	return cmds
+}
+
+// Result contain deduced facts that match a query, or, for a retraction,
+// the number of clauses removed.
 type Result struct {
-	Name    string
-	Arity   int
-	Answers [][]Term
+	Name      string
+	Arity     int
+	Answers   [][]Term
+	Retracted int
 }
 
 // ApplyAll iterates over a slice of commands, executes each in turn
-// on a provided database, and accumulates and then returns results.
+// on a provided database, and accumulates and then returns the results of
+// its queries. Only Apply reports the number of clauses a retraction removed.
 func ApplyAll(cmds []DatalogCommand, db Database) (results []Result, err error) {
 	for _, cmd := range cmds {
 		res, err := Apply(cmd, db)
 		if err != nil {
 			return results, err
 		}
-		if res != nil {
+		if res != nil && cmd.CommandType == Query {
 			results = append(results, *res)
 		}
 	}
@@ -159,7 +373,7 @@ func ToString(results []Result) string {
 				str += "("
 				termStrings := make([]string, len(terms))
 				for i, t := range terms {
-					termStrings[i] = t.value
+					termStrings[i] = formatTerm(t)
 				}
 				str += strings.Join(termStrings, ", ")
 				str += ")"
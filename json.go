@@ -0,0 +1,269 @@
+package gotalog
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// The JSON encoding of commands and results is:
+//
+//	command: {"type": "assert" | "query" | "retract", "head": literal, "body": [literal, ...]}
+//	         or {"type": "directive", "directive": directive}
+//	literal: {"predicate": name, "terms": [term, ...]}
+//	directive: {"name": name, "predicate": name, "params": {key: value, ...},
+//	            "attributes": [{"name": name, "type": type}, ...]}
+//	term:    {"constant": value} or {"variable": name}
+//	result:  {"name": name, "arity": n, "answers": [[term, ...], ...], "retracted": n}
+//
+// "body" may be omitted for facts, and "terms" for literals without terms.
+// Directives omit "params" and "attributes" when they are empty.
+// Results omit "answers" and "retracted" when they are empty.
+
+var commandTypeNames = map[CommandType]string{
+	Assert:    "assert",
+	Query:     "query",
+	Retract:   "retract",
+	Directive: "directive",
+}
+
+// MarshalJSON encodes a command type as "assert", "query" or "retract".
+func (t CommandType) MarshalJSON() ([]byte, error) {
+	name, ok := commandTypeNames[t]
+	if !ok {
+		return nil, fmt.Errorf("unknown command type %d", int(t))
+	}
+	return json.Marshal(name)
+}
+
+// UnmarshalJSON decodes a command type encoded by MarshalJSON.
+func (t *CommandType) UnmarshalJSON(b []byte) error {
+	var name string
+	err := json.Unmarshal(b, &name)
+	if err != nil {
+		return err
+	}
+	for ct, n := range commandTypeNames {
+		if n == name {
+			*t = ct
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown command type %q", name)
+}
+
+type commandJSON struct {
+	Type      *CommandType         `json:"type"`
+	Head      *LiteralDefinition   `json:"head,omitempty"`
+	Body      []LiteralDefinition  `json:"body,omitempty"`
+	Directive *DirectiveDefinition `json:"directive,omitempty"`
+}
+
+// MarshalJSON encodes a command as a JSON object.
+func (cmd DatalogCommand) MarshalJSON() ([]byte, error) {
+	if cmd.CommandType == Directive {
+		return json.Marshal(commandJSON{Type: &cmd.CommandType, Directive: cmd.Directive})
+	}
+	return json.Marshal(commandJSON{Type: &cmd.CommandType, Head: &cmd.Head, Body: cmd.Body})
+}
+
+// UnmarshalJSON decodes a command encoded by MarshalJSON. Its type is
+// required, as is a head or, for directives, the directive.
+func (cmd *DatalogCommand) UnmarshalJSON(b []byte) error {
+	var j commandJSON
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+	if j.Type == nil {
+		return fmt.Errorf("command has no \"type\"")
+	}
+	if *j.Type == Directive {
+		if j.Directive == nil {
+			return fmt.Errorf("directive command has no \"directive\"")
+		}
+		err = checkDirective(j.Directive)
+		if err != nil {
+			return err
+		}
+		*cmd = DatalogCommand{CommandType: Directive, Directive: j.Directive}
+		return nil
+	}
+	if j.Head == nil {
+		return fmt.Errorf("command has no \"head\"")
+	}
+	*cmd = DatalogCommand{Head: *j.Head, CommandType: *j.Type}
+	if len(j.Body) > 0 {
+		// As for parsed commands, facts have a nil body.
+		cmd.Body = j.Body
+	}
+	return nil
+}
+
+// checkDirective returns an error unless the parser reads d back as written.
+func checkDirective(d *DirectiveDefinition) error {
+	if !directives[d.Name] {
+		return fmt.Errorf("unknown directive %q", d.Name)
+	}
+	if d.Name == "include" {
+		if _, ok := d.Params["filename"]; !ok || len(d.Params) != 1 || d.Predicate != "" || len(d.Attributes) > 0 {
+			return fmt.Errorf("include directive must have only a \"filename\" parameter")
+		}
+		return nil
+	}
+	if !isIdentifier(d.Predicate) {
+		return fmt.Errorf("invalid predicate name %q", d.Predicate)
+	}
+	if d.Name == "decl" {
+		if len(d.Params) > 0 {
+			return fmt.Errorf("decl directive has parameters")
+		}
+		for _, a := range d.Attributes {
+			if !isIdentifier(a.Name) {
+				return fmt.Errorf("invalid attribute name %q", a.Name)
+			}
+			if _, ok := attributeTypes[a.Type]; !ok {
+				return fmt.Errorf("unknown type %q", a.Type)
+			}
+		}
+		return nil
+	}
+	if len(d.Attributes) > 0 {
+		return fmt.Errorf("%v directive has attributes", d.Name)
+	}
+	for key := range d.Params {
+		if !isIdentifier(key) {
+			return fmt.Errorf("invalid parameter name %q", key)
+		}
+	}
+	return nil
+}
+
+type literalJSON struct {
+	Predicate string `json:"predicate"`
+	Terms     []Term `json:"terms"`
+}
+
+// MarshalJSON encodes a literal as a JSON object.
+func (l LiteralDefinition) MarshalJSON() ([]byte, error) {
+	terms := l.Terms
+	if terms == nil {
+		terms = []Term{}
+	}
+	return json.Marshal(literalJSON{Predicate: l.PredicateName, Terms: terms})
+}
+
+// UnmarshalJSON decodes a literal encoded by MarshalJSON.
+func (l *LiteralDefinition) UnmarshalJSON(b []byte) error {
+	var j literalJSON
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+	if j.Predicate == "" {
+		return fmt.Errorf("literal has no \"predicate\"")
+	}
+	if !isIdentifier(j.Predicate) {
+		return fmt.Errorf("invalid predicate name %q", j.Predicate)
+	}
+	*l = LiteralDefinition{PredicateName: j.Predicate}
+	if len(j.Terms) > 0 {
+		l.Terms = j.Terms
+	}
+	return nil
+}
+
+// termJSON is the JSON encoding of a term. Exactly one field is set.
+type termJSON struct {
+	Constant *string `json:"constant,omitempty"`
+	Variable *string `json:"variable,omitempty"`
+}
+
+// MarshalJSON encodes a term as {"constant": value} or {"variable": name}.
+func (t Term) MarshalJSON() ([]byte, error) {
+	value := t.value
+	if t.isConstant {
+		return json.Marshal(termJSON{Constant: &value})
+	}
+	return json.Marshal(termJSON{Variable: &value})
+}
+
+// UnmarshalJSON decodes a term encoded by MarshalJSON.
+func (t *Term) UnmarshalJSON(b []byte) error {
+	var j termJSON
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+	switch {
+	case j.Constant != nil && j.Variable == nil:
+		*t = Term{isConstant: true, value: *j.Constant}
+	case j.Variable != nil && j.Constant == nil:
+		if !isVariableName(*j.Variable) {
+			return fmt.Errorf("invalid variable %q: variables start with an uppercase letter or _", *j.Variable)
+		}
+		*t = Term{isConstant: false, value: *j.Variable}
+	default:
+		return fmt.Errorf("a term must have exactly one of \"constant\" or \"variable\": %s", b)
+	}
+	return nil
+}
+
+type resultJSON struct {
+	Name      string   `json:"name"`
+	Arity     int      `json:"arity"`
+	Answers   [][]Term `json:"answers,omitempty"`
+	Retracted int      `json:"retracted,omitempty"`
+}
+
+// MarshalJSON encodes a result as a JSON object.
+func (r Result) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resultJSON(r))
+}
+
+// UnmarshalJSON decodes a result encoded by MarshalJSON.
+func (r *Result) UnmarshalJSON(b []byte) error {
+	var j resultJSON
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+	*r = Result(j)
+	return nil
+}
+
+// ScanJSON is like Scan, but reads JSON lines: one JSON-encoded command per
+// line. Blank lines are ignored.
+func ScanJSON(input io.Reader) (chan DatalogCommand, chan error) {
+	commands := make(chan DatalogCommand, 1000)
+	errors := make(chan error, 1)
+
+	r := bufio.NewReader(input)
+
+	go func() {
+		for lineNumber := 1; ; lineNumber++ {
+			line, err := r.ReadBytes('\n')
+			if err != nil && err != io.EOF {
+				errors <- err
+				break
+			}
+			if trimmed := bytes.TrimSpace(line); len(trimmed) > 0 {
+				var c DatalogCommand
+				jsonErr := json.Unmarshal(trimmed, &c)
+				if jsonErr != nil {
+					errors <- fmt.Errorf("line %v: %v", lineNumber, jsonErr)
+					break
+				}
+				commands <- c
+			}
+			if err == io.EOF {
+				break
+			}
+		}
+		close(errors)
+		close(commands)
+	}()
+	return commands, errors
+}
@@ -0,0 +1,196 @@
+package gotalog
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCommandJSON(t *testing.T) {
+	cmds, err := Parse(bytes.NewBufferString(`
+		edge(a, b).
+		path(X, Y) :- edge(X, Z), path(Z, Y).
+		path(a, X)?
+		edge(X, b)~
+		nullary.
+		.input edge(filename="edges.csv", delimiter=",")
+		.output path
+		.decl edge(from: symbol, to: number)
+		.decl flag
+		.include "more.dl"`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(cmds[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"type":"assert","head":{"predicate":"path","terms":[{"variable":"X"},{"variable":"Y"}]},` +
+		`"body":[{"predicate":"edge","terms":[{"variable":"X"},{"variable":"Z"}]},{"predicate":"path","terms":[{"variable":"Z"},{"variable":"Y"}]}]}`
+	if string(b) != expected {
+		t.Errorf("got %v, expected %v", string(b), expected)
+	}
+
+	b, err = json.Marshal(cmds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded []DatalogCommand
+	err = json.Unmarshal(b, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Positions are not encoded.
+	if !reflect.DeepEqual(decoded, withoutPositions(cmds)) {
+		t.Errorf("round trip: got %v, expected %v", decoded, cmds)
+	}
+	// Decoded commands are read back as written.
+	for _, cmd := range decoded {
+		if reparsed := withoutPositions(mustParse(t, cmd.String())); !reflect.DeepEqual(reparsed, []DatalogCommand{cmd}) {
+			t.Errorf("%v was read back as %v", cmd, reparsed)
+		}
+	}
+}
+
+func withoutPositions(cmds []DatalogCommand) []DatalogCommand {
+	stripped := make([]DatalogCommand, len(cmds))
+	for i, cmd := range cmds {
+		cmd.Pos = Position{}
+		cmd.Head.Pos = Position{}
+		if cmd.Body != nil {
+			cmd.Body = append([]LiteralDefinition(nil), cmd.Body...)
+			for j := range cmd.Body {
+				cmd.Body[j].Pos = Position{}
+			}
+		}
+		stripped[i] = cmd
+	}
+	return stripped
+}
+
+func TestCommandJSONErrors(t *testing.T) {
+	invalid := []string{
+		`{"head": {"predicate": "p"}}`,
+		`{"type": "assert"}`,
+		`{"type": "insert", "head": {"predicate": "p"}}`,
+		`{"type": "assert", "head": {"terms": []}}`,
+		`{"type": "assert", "head": {"predicate": "p", "terms": [{"constant": "a", "variable": "X"}]}}`,
+		`{"type": "assert", "head": {"predicate": "my pred"}}`,
+		`{"type": "assert", "head": {"predicate": "p(a)"}}`,
+		`{"type": "query", "head": {"predicate": "p", "terms": [{"variable": "x"}]}}`,
+		`{"type": "query", "head": {"predicate": "p", "terms": [{"variable": ""}]}}`,
+		`{"type": "query", "head": {"predicate": "p", "terms": [{"variable": "X Y"}]}}`,
+		`{"type": "directive"}`,
+		`{"type": "directive", "directive": {"name": "print", "predicate": "p"}}`,
+		`{"type": "directive", "directive": {"name": "output", "predicate": "p(a)"}}`,
+		`{"type": "directive", "directive": {"name": "output", "predicate": ""}}`,
+		`{"type": "directive", "directive": {"name": "output", "predicate": "p", "params": {"file name": "p.csv"}}}`,
+		`{"type": "directive", "directive": {"name": "output", "predicate": "p", "attributes": [{"name": "a", "type": "symbol"}]}}`,
+		`{"type": "directive", "directive": {"name": "decl", "predicate": "p", "attributes": [{"name": "a b", "type": "symbol"}]}}`,
+		`{"type": "directive", "directive": {"name": "decl", "predicate": "p", "attributes": [{"name": "a", "type": "string"}]}}`,
+		`{"type": "directive", "directive": {"name": "decl", "predicate": "p", "params": {"a": "b"}}}`,
+		`{"type": "directive", "directive": {"name": "include", "predicate": "p", "params": {"filename": "a.dl"}}}`,
+		`{"type": "directive", "directive": {"name": "include", "params": {}}}`,
+	}
+	for _, s := range invalid {
+		var cmd DatalogCommand
+		if err := json.Unmarshal([]byte(s), &cmd); err == nil {
+			t.Errorf("%v: expected an error, got %v", s, cmd)
+		}
+	}
+}
+
+func TestCommandJSONSurvivesLog(t *testing.T) {
+	input := `{"type": "assert", "head": {"predicate": "p", "terms": [{"variable": "X"}, {"constant": "my value"}]},` +
+		` "body": [{"predicate": "q", "terms": [{"variable": "X"}, {"variable": "_Y"}]}]}`
+	var cmd DatalogCommand
+	err := json.Unmarshal([]byte(input), &cmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var log bytes.Buffer
+	db, err := NewDiskLogDB(&log, NewMemDatabase())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Apply(cmd, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reopened, err := NewDiskLogDB(&log, NewMemDatabase())
+	if err != nil {
+		t.Fatalf("replaying %q: %v", log.String(), err)
+	}
+	if got, expected := diffState(reopened), diffState(db); got != expected {
+		t.Errorf("replayed %v, expected %v", got, expected)
+	}
+}
+
+func TestResultJSON(t *testing.T) {
+	db := NewMemDatabase()
+	results, err := ApplyAll(mustParse(t, "edge(a, b). edge(a, c). edge(X, Y)?"), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	retracted, err := Apply(mustParse(t, "edge(a, c)~")[0], db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results = append(results, *retracted)
+	b, err := json.Marshal(results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded []Result
+	err = json.Unmarshal(b, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, results) {
+		t.Errorf("round trip: got %v, expected %v", decoded, results)
+	}
+	if !strings.Contains(string(b), `{"name":"edge","arity":2,"retracted":1}`) {
+		t.Errorf("unexpected encoding of a retraction: %s", b)
+	}
+}
+
+func mustParse(t *testing.T, prog string) []DatalogCommand {
+	cmds, err := Parse(bytes.NewBufferString(prog))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cmds
+}
+
+func TestScanJSON(t *testing.T) {
+	input := `{"type": "assert", "head": {"predicate": "edge", "terms": [{"constant": "a"}, {"constant": "b"}]}}
+
+{"type": "query", "head": {"predicate": "edge", "terms": [{"variable": "X"}, {"variable": "Y"}]}}`
+	commands, errors := ScanJSON(strings.NewReader(input))
+	db := NewMemDatabase()
+	var results []Result
+	for cmd := range commands {
+		res, err := Apply(cmd, db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res != nil {
+			results = append(results, *res)
+		}
+	}
+	if err := <-errors; err != nil {
+		t.Fatal(err)
+	}
+	compareDatalogResult(t, ToString(results), "edge(a, b).\n")
+
+	commands, errors = ScanJSON(strings.NewReader(input + "\n{\"type\": \"query\"}\n"))
+	for range commands {
+	}
+	err := <-errors
+	if err == nil || !strings.HasPrefix(err.Error(), "line 4:") {
+		t.Errorf("got %v, expected an error on line 4", err)
+	}
+}
@@ -0,0 +1,411 @@
+package gotalog
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// The lexer splits datalog text into tokens, discarding whitespace and
+// comments, for the recursive-descent parser in parse.go.
+
+// tokenKind classifies tokens.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	// tokenIdentifier is a predicate name, constant or variable. Variables
+	// start with an uppercase letter or an underscore.
+	tokenIdentifier
+	// tokenString is a quoted constant.
+	tokenString
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+	tokenImplies
+	tokenColon
+	tokenPeriod
+	tokenQuestion
+	tokenTilde
+	tokenEquals
+	tokenHash
+)
+
+var punctuation = map[rune]tokenKind{
+	'(': tokenLeftParen,
+	')': tokenRightParen,
+	',': tokenComma,
+	'.': tokenPeriod,
+	'?': tokenQuestion,
+	'~': tokenTilde,
+	'=': tokenEquals,
+	'#': tokenHash,
+}
+
+type token struct {
+	kind tokenKind
+	// text is an identifier, the unescaped value of a quoted constant, or
+	// the punctuation itself.
+	text string
+	pos  Position
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of input"
+	case tokenString:
+		return quote(t.text, '\'')
+	}
+	return t.text
+}
+
+// isTerminal reports whether a token ends a command.
+func (t token) isTerminal() bool {
+	return t.kind == tokenPeriod || t.kind == tokenQuestion || t.kind == tokenTilde
+}
+
+type lexer struct {
+	r        *bufio.Reader
+	filename string
+	// offset is the number of bytes consumed from the input so far.
+	offset   int64
+	lastSize int
+	// readErr is the first error reading the input other than io.EOF. Once
+	// set, every read fails with it, so that the error is reported rather
+	// than taken for the end of a token.
+	readErr error
+
+	// cur locates the last rune read, and prev the one before it, so that
+	// it can be unread.
+	cur, prev runePosition
+
+	// keepComments collects the comments skipped into comments, for the
+	// formatter.
+	keepComments bool
+	comments     []comment
+}
+
+// comment is the text of a comment, including its delimiters, and where it
+// was found.
+type comment struct {
+	text    string
+	pos     Position
+	endLine int
+}
+
+// runePosition tracks the line and column of the last rune read.
+type runePosition struct {
+	line, column int
+	// text is the current line up to and including the last rune read, and
+	// prevText the whole of the line before.
+	text, prevText []rune
+	// newline is set if the last rune read ended its line.
+	newline bool
+}
+
+func newLexer(input io.Reader) *lexer {
+	return &lexer{r: bufio.NewReader(input), cur: runePosition{line: 1}}
+}
+
+func (l *lexer) readRune() (rune, int, error) {
+	if l.readErr != nil {
+		return 0, 0, l.readErr
+	}
+	ch, size, err := l.r.ReadRune()
+	if err != nil && err != io.EOF {
+		l.readErr = err
+	}
+	l.offset += int64(size)
+	l.lastSize = size
+	if err == nil {
+		l.prev = l.cur
+		if l.cur.newline {
+			l.cur = runePosition{line: l.cur.line + 1, prevText: l.cur.text}
+		}
+		l.cur.column++
+		l.cur.newline = ch == '\n'
+		if !l.cur.newline {
+			l.cur.text = append(l.cur.text, ch)
+		}
+	}
+	return ch, size, err
+}
+
+func (l *lexer) unreadRune() error {
+	err := l.r.UnreadRune()
+	if err == nil {
+		l.offset -= int64(l.lastSize)
+		l.lastSize = 0
+		l.cur = l.prev
+	}
+	return err
+}
+
+// peekByte returns the next byte without consuming it, or 0 at the end of
+// the input. Unlike reading and unreading a rune, it leaves the last rune
+// read available to unreadRune.
+func (l *lexer) peekByte() byte {
+	b, err := l.r.Peek(1)
+	if err != nil {
+		return 0
+	}
+	return b[0]
+}
+
+// nextPos returns the position of the next rune to be read.
+func (l *lexer) nextPos() Position {
+	if l.cur.newline {
+		return Position{Filename: l.filename, Line: l.cur.line + 1, Column: 1}
+	}
+	return Position{Filename: l.filename, Line: l.cur.line, Column: l.cur.column + 1}
+}
+
+func isWhitespace(ch rune) bool {
+	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'
+}
+
+func isUpperCase(ch rune) bool {
+	return unicode.IsUpper(ch)
+}
+
+func isNumber(ch rune) bool {
+	return unicode.IsDigit(ch)
+}
+
+func isLetter(ch rune) bool {
+	return unicode.IsLetter(ch)
+}
+
+func isAllowedBodyRune(ch rune) bool {
+	return isLetter(ch) ||
+		isNumber(ch) ||
+		(ch == '_' || ch == '-')
+}
+
+func isQuote(ch rune) bool {
+	return ch == '\'' || ch == '"'
+}
+
+// skipSpace consumes whitespace, % line comments and /* block comments */.
+func (l *lexer) skipSpace() error {
+	for {
+		ch, _, err := l.readRune()
+		if err != nil {
+			return nil
+		}
+		pos := Position{Filename: l.filename, Line: l.cur.line, Column: l.cur.column}
+		switch {
+		case isWhitespace(ch):
+		case ch == '%':
+			l.addComment(pos, "%"+strings.TrimRight(l.consumeRestOfLine(), "\r"))
+		case ch == '/' && l.peekByte() == '*':
+			text, err := l.skipBlockComment()
+			if err != nil {
+				return err
+			}
+			l.addComment(pos, "/"+text)
+		default:
+			l.unreadRune()
+			return nil
+		}
+	}
+}
+
+func (l *lexer) addComment(pos Position, text string) {
+	if l.keepComments {
+		l.comments = append(l.comments, comment{text: text, pos: pos, endLine: l.cur.line})
+	}
+}
+
+// consumeRestOfLine returns the rest of the current line, consuming it and
+// the newline ending it.
+func (l *lexer) consumeRestOfLine() string {
+	var b strings.Builder
+	for {
+		ch, _, err := l.readRune()
+		if err != nil || ch == '\n' {
+			return b.String()
+		}
+		b.WriteRune(ch)
+	}
+}
+
+// skipBlockComment consumes a block comment, after its opening '/', and
+// returns its text.
+func (l *lexer) skipBlockComment() (string, error) {
+	pos := l.nextPos()
+	pos.Column--
+	var b strings.Builder
+	l.readRune()
+	b.WriteRune('*')
+	star := false
+	for {
+		ch, _, err := l.readRune()
+		if err == io.EOF {
+			return "", l.parseError(pos, truncatedError("Unterminated block comment"))
+		}
+		if err != nil {
+			return "", l.parseError(l.nextPos(), err)
+		}
+		b.WriteRune(ch)
+		if star && ch == '/' {
+			return b.String(), nil
+		}
+		star = ch == '*'
+	}
+}
+
+// next returns the next token. Errors are returned as a *ParseError, after
+// consuming the offending input, so that lexing can continue.
+func (l *lexer) next() (token, error) {
+	err := l.skipSpace()
+	if err != nil {
+		return token{}, err
+	}
+	pos := l.nextPos()
+	ch, _, err := l.readRune()
+	if err == io.EOF {
+		return token{kind: tokenEOF, pos: pos}, nil
+	}
+	if err != nil {
+		return token{}, l.parseError(pos, err)
+	}
+
+	if kind, ok := punctuation[ch]; ok {
+		return token{kind: kind, text: string(ch), pos: pos}, nil
+	}
+	switch {
+	case ch == ':':
+		if l.peekByte() != '-' {
+			return token{kind: tokenColon, text: ":", pos: pos}, nil
+		}
+		l.readRune()
+		return token{kind: tokenImplies, text: ":-", pos: pos}, nil
+	case isQuote(ch):
+		value, err := l.scanQuoted(ch)
+		if err != nil {
+			return token{}, l.parseError(l.nextPos(), err)
+		}
+		return token{kind: tokenString, text: value, pos: pos}, nil
+	case isLetter(ch) || isNumber(ch) || ch == '_':
+		text := l.scanIdentifier(ch)
+		if l.readErr != nil {
+			// The identifier may have been cut short.
+			return token{}, l.parseError(l.nextPos(), l.readErr)
+		}
+		return token{kind: tokenIdentifier, text: text, pos: pos}, nil
+	}
+	return token{}, l.parseError(pos, fmt.Errorf("Unexpected character %q", ch))
+}
+
+// scanIdentifier reads the rest of an identifier starting with first.
+func (l *lexer) scanIdentifier(first rune) string {
+	var b strings.Builder
+	b.WriteRune(first)
+	for {
+		ch, _, err := l.readRune()
+		if err != nil {
+			return b.String()
+		}
+		if !isAllowedBodyRune(ch) {
+			l.unreadRune()
+			return b.String()
+		}
+		b.WriteRune(ch)
+	}
+}
+
+// scanQuoted reads a constant enclosed in quote, after the opening quote.
+// A backslash escapes the following character, and \n and \t stand for a
+// newline and a tab.
+func (l *lexer) scanQuoted(quote rune) (string, error) {
+	var b strings.Builder
+	for {
+		ch, _, err := l.readRune()
+		if err != nil {
+			return "", quotedError(err, quote, b.String())
+		}
+		if ch == quote {
+			return b.String(), nil
+		}
+		if ch == '\\' {
+			ch, _, err = l.readRune()
+			if err != nil {
+				return "", quotedError(err, quote, b.String())
+			}
+			switch ch {
+			case 'n':
+				ch = '\n'
+			case 't':
+				ch = '\t'
+			}
+		}
+		b.WriteRune(ch)
+	}
+}
+
+// quotedError describes err, found reading a quoted constant that so far
+// holds value.
+func quotedError(err error, quote rune, value string) error {
+	if err != io.EOF {
+		return err
+	}
+	return truncatedError(fmt.Sprintf("Unterminated quoted constant %v%v", string(quote), value))
+}
+
+// truncatedError describes input that ended part way through a token. It
+// matches io.EOF with errors.Is, as the input may have been cut short.
+type truncatedError string
+
+func (e truncatedError) Error() string {
+	return string(e)
+}
+
+func (e truncatedError) Is(target error) bool {
+	return target == io.EOF
+}
+
+// parseError describes err as found at pos, which must be on the current or
+// previous line. Errors matching io.EOF are reported at the end of the input.
+func (l *lexer) parseError(pos Position, err error) *ParseError {
+	e := &ParseError{Position: pos, Msg: err.Error(), Err: err}
+	if errors.Is(err, io.EOF) {
+		e.Position = l.nextPos()
+	}
+	if err == io.EOF {
+		e.Msg = "unexpected end of input"
+	}
+
+	var source []rune
+	switch e.Line {
+	case l.cur.line:
+		source = l.cur.text
+		if !l.cur.newline {
+			// Complete the line from whatever input is already buffered.
+			rest, _ := l.r.Peek(l.r.Buffered())
+			if i := strings.IndexByte(string(rest), '\n'); i >= 0 {
+				rest = rest[:i]
+			}
+			source = append(source[:len(source):len(source)], []rune(string(rest))...)
+		}
+	case l.cur.line - 1:
+		source = l.cur.prevText
+	}
+	e.Source = strings.TrimRight(string(source), "\r")
+
+	var caret strings.Builder
+	for i := 0; i < e.Column-1; i++ {
+		if i < len(source) && source[i] == '\t' {
+			caret.WriteRune('\t')
+		} else {
+			caret.WriteRune(' ')
+		}
+	}
+	caret.WriteRune('^')
+	e.Caret = caret.String()
+	return e
+}
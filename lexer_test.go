@@ -0,0 +1,70 @@
+package gotalog
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestLexer(t *testing.T) {
+	input := "path(X, 'a b') :- /* comment */ edge(X, Y), % comment\r\n\tnœud(Y)~ .input e(k=v) #"
+	expected := []struct {
+		kind tokenKind
+		text string
+		line int
+	}{
+		{tokenIdentifier, "path", 1}, {tokenLeftParen, "(", 1}, {tokenIdentifier, "X", 1},
+		{tokenComma, ",", 1}, {tokenString, "a b", 1}, {tokenRightParen, ")", 1},
+		{tokenImplies, ":-", 1}, {tokenIdentifier, "edge", 1}, {tokenLeftParen, "(", 1},
+		{tokenIdentifier, "X", 1}, {tokenComma, ",", 1}, {tokenIdentifier, "Y", 1},
+		{tokenRightParen, ")", 1}, {tokenComma, ",", 1}, {tokenIdentifier, "nœud", 2},
+		{tokenLeftParen, "(", 2}, {tokenIdentifier, "Y", 2}, {tokenRightParen, ")", 2},
+		{tokenTilde, "~", 2}, {tokenPeriod, ".", 2}, {tokenIdentifier, "input", 2},
+		{tokenIdentifier, "e", 2}, {tokenLeftParen, "(", 2}, {tokenIdentifier, "k", 2},
+		{tokenEquals, "=", 2}, {tokenIdentifier, "v", 2}, {tokenRightParen, ")", 2},
+		{tokenHash, "#", 2}, {tokenEOF, "", 2},
+	}
+
+	l := newLexer(strings.NewReader(input))
+	for i, e := range expected {
+		tok, err := l.next()
+		if err != nil {
+			t.Fatalf("token %v: %v", i, err)
+		}
+		if tok.kind != e.kind || tok.text != e.text || tok.pos.Line != e.line {
+			t.Errorf("token %v: got %v %q on line %v, expected %v %q on line %v",
+				i, tok.kind, tok.text, tok.pos.Line, e.kind, e.text, e.line)
+		}
+	}
+}
+
+func TestLexerErrors(t *testing.T) {
+	for _, input := range []string{"$", "'open", "/* open", "/ x"} {
+		l := newLexer(strings.NewReader(input))
+		if _, err := l.next(); err == nil {
+			t.Errorf("%q: expected an error", input)
+		}
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	// Errors reading the input are reported, however far into a token they
+	// happen, rather than taken for its end.
+	boom := errors.New("boom")
+	for _, input := range []io.Reader{
+		io.MultiReader(strings.NewReader("foo(a"), iotest.ErrReader(boom)),
+		io.MultiReader(strings.NewReader("foo(a). bar"), iotest.ErrReader(boom)),
+		io.MultiReader(strings.NewReader("foo(a). 'ab"), iotest.ErrReader(boom)),
+		io.MultiReader(strings.NewReader("foo(a). /* ab"), iotest.ErrReader(boom)),
+		iotest.TimeoutReader(iotest.OneByteReader(strings.NewReader("foo(a)."))),
+	} {
+		_, err := ParseWithRecovery(input)
+		var pes ParseErrors
+		if !errors.As(err, &pes) || len(pes) != 1 || errors.Is(err, io.EOF) ||
+			!errors.Is(err, boom) && !errors.Is(err, iotest.ErrTimeout) {
+			t.Errorf("expected a single read error, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package gotalog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Severity grades a Diagnostic.
+type Severity int
+
+const (
+	// LintWarning marks a likely mistake that does not stop a program from
+	// running.
+	LintWarning Severity = iota
+	// LintError marks a mistake that will make applying the program fail.
+	LintError
+)
+
+func (s Severity) String() string {
+	if s == LintError {
+		return "error"
+	}
+	return "warning"
+}
+
+// Diagnostic is a problem found in a program by Lint.
+type Diagnostic struct {
+	Pos      Position
+	Severity Severity
+	Msg      string
+}
+
+func (d Diagnostic) String() string {
+	if !d.Pos.IsValid() {
+		return fmt.Sprintf("%v: %v", d.Severity, d.Msg)
+	}
+	return fmt.Sprintf("%v: %v: %v", d.Pos, d.Severity, d.Msg)
+}
+
+// Lint analyzes a program without running it, reporting
+//
+//   - unsafe rules, whose head has a variable that is not in their body
+//   - singleton variables, which appear only once in a rule, other than
+//     those named _ or starting with _
+//   - predicates used by a rule or query but never defined by a clause or
+//     .input directive
+//   - predicates defined but never used by a rule, query or .output
+//     directive
+//   - predicates used with more than one arity
+//
+// The language has no negation, so no program can recurse through it.
+func Lint(cmds []DatalogCommand) []Diagnostic {
+	l := &linter{
+		arities: make(map[string]map[int]Position),
+		defined: make(map[string]Position),
+		used:    make(map[string]Position),
+		seen:    make(map[string]bool),
+	}
+	for _, cmd := range cmds {
+		switch cmd.CommandType {
+		case Directive:
+			l.directive(cmd)
+		case Assert:
+			l.define(cmd.Head)
+			for _, lit := range cmd.Body {
+				l.use(lit)
+			}
+			l.checkVariables(cmd)
+		case Query:
+			l.use(cmd.Head)
+		case Retract:
+			l.arity(cmd.Head.PredicateName, len(cmd.Head.Terms), cmd.Head.Pos)
+			for _, lit := range cmd.Body {
+				l.arity(lit.PredicateName, len(lit.Terms), lit.Pos)
+			}
+		}
+	}
+
+	for _, name := range l.order {
+		if pos, ok := l.used[name]; ok {
+			if _, ok := l.defined[name]; !ok {
+				l.report(pos, LintWarning, "%v is used but never defined", name)
+			}
+		}
+	}
+	for _, name := range l.order {
+		if pos, ok := l.defined[name]; ok {
+			if _, ok := l.used[name]; !ok {
+				l.report(pos, LintWarning, "%v is defined but never used", name)
+			}
+		}
+	}
+	return l.diags
+}
+
+type linter struct {
+	diags []Diagnostic
+	// arities maps each predicate name to the position of its first use
+	// with each arity.
+	arities map[string]map[int]Position
+	// defined and used give the first position at which each predicate was
+	// defined and used.
+	defined, used map[string]Position
+	// order lists predicate names in order of first appearance.
+	order []string
+	seen  map[string]bool
+}
+
+func (l *linter) see(name string) {
+	if !l.seen[name] {
+		l.seen[name] = true
+		l.order = append(l.order, name)
+	}
+}
+
+func (l *linter) report(pos Position, severity Severity, format string, args ...interface{}) {
+	l.diags = append(l.diags, Diagnostic{Pos: pos, Severity: severity, Msg: fmt.Sprintf(format, args...)})
+}
+
+// arity records a use of name/arity, reporting the first use of each arity
+// after the first.
+func (l *linter) arity(name string, arity int, pos Position) {
+	l.see(name)
+	seen, ok := l.arities[name]
+	if !ok {
+		seen = make(map[int]Position)
+		l.arities[name] = seen
+	}
+	if _, ok := seen[arity]; ok {
+		return
+	}
+	if len(seen) > 0 {
+		var others []string
+		for a, p := range seen {
+			others = append(others, fmt.Sprintf("%v/%v at %v", name, a, p))
+		}
+		sort.Strings(others)
+		l.report(pos, LintError, "%v/%v is also used as %v", name, arity, strings.Join(others, ", "))
+	}
+	seen[arity] = pos
+}
+
+func (l *linter) define(lit LiteralDefinition) {
+	l.arity(lit.PredicateName, len(lit.Terms), lit.Pos)
+	if _, ok := l.defined[lit.PredicateName]; !ok {
+		l.defined[lit.PredicateName] = lit.Pos
+	}
+}
+
+func (l *linter) use(lit LiteralDefinition) {
+	l.arity(lit.PredicateName, len(lit.Terms), lit.Pos)
+	if _, ok := l.used[lit.PredicateName]; !ok {
+		l.used[lit.PredicateName] = lit.Pos
+	}
+}
+
+func (l *linter) directive(cmd DatalogCommand) {
+	d := cmd.Directive
+	switch d.Name {
+	case "decl":
+		l.arity(d.Predicate, len(d.Attributes), cmd.Pos)
+	case "input":
+		l.see(d.Predicate)
+		if _, ok := l.defined[d.Predicate]; !ok {
+			l.defined[d.Predicate] = cmd.Pos
+		}
+	case "output":
+		l.see(d.Predicate)
+		if _, ok := l.used[d.Predicate]; !ok {
+			l.used[d.Predicate] = cmd.Pos
+		}
+	}
+}
+
+// checkVariables reports the unsafe and singleton variables of a clause.
+func (l *linter) checkVariables(cmd DatalogCommand) {
+	counts := make(map[string]int)
+	var first []Term
+	firstPos := make(map[string]Position)
+	lits := append([]LiteralDefinition{cmd.Head}, cmd.Body...)
+	for _, lit := range lits {
+		for _, t := range lit.Terms {
+			if t.isConstant || t.isAnonymous() {
+				continue
+			}
+			if counts[t.value] == 0 {
+				first = append(first, t)
+				firstPos[t.value] = lit.Pos
+			}
+			counts[t.value]++
+		}
+	}
+
+	unsafe := make(map[string]bool)
+	for _, t := range cmd.Head.Terms {
+		if t.isAnonymous() {
+			l.report(cmd.Head.Pos, LintError, "anonymous variable in the head of %v", cmd.Head.PredicateName)
+			continue
+		}
+		if t.isConstant || unsafe[t.value] || inBody(t, cmd.Body) {
+			continue
+		}
+		unsafe[t.value] = true
+		l.report(cmd.Head.Pos, LintError, "unsafe variable %v: it appears in the head of %v but not in its body",
+			t.value, cmd.Head.PredicateName)
+	}
+
+	for _, t := range first {
+		if counts[t.value] == 1 && !unsafe[t.value] && !strings.HasPrefix(t.value, "_") {
+			l.report(firstPos[t.value], LintWarning, "singleton variable %v in a clause of %v; name it _ if it is unused",
+				t.value, cmd.Head.PredicateName)
+		}
+	}
+}
+
+func inBody(t Term, body []LiteralDefinition) bool {
+	for _, lit := range body {
+		for _, bt := range lit.Terms {
+			if bt == t {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package gotalog
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	prog := `.input edge
+.output path
+path(X, Y) :- edge(X, Y).
+path(X, Z) :- edge(X, Y), path(Y, W).
+has_child(X) :- parent(X, _), age(_Kid).
+orphan(X, _) :- edge(X, X).
+edge(a, b, c).
+unused(a).
+edge(X, Y)?
+`
+	expected := []string{
+		"4:1: error: unsafe variable Z: it appears in the head of path but not in its body",
+		"4:27: warning: singleton variable W in a clause of path; name it _ if it is unused",
+		"6:1: error: anonymous variable in the head of orphan",
+		"7:1: error: edge/3 is also used as edge/2 at 3:15",
+		"5:17: warning: parent is used but never defined",
+		"5:31: warning: age is used but never defined",
+		"5:1: warning: has_child is defined but never used",
+		"6:1: warning: orphan is defined but never used",
+		"8:1: warning: unused is defined but never used",
+	}
+
+	var got []string
+	for _, d := range Lint(mustParse(t, prog)) {
+		got = append(got, d.String())
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("got\n%v\nexpected\n%v", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestLintCleanProgram(t *testing.T) {
+	prog := `edge(a, b). edge(b, c).
+path(X, Y) :- edge(X, Y).
+path(X, Z) :- edge(X, Y), path(Y, Z).
+path(a, X)?`
+	if diags := Lint(mustParse(t, prog)); len(diags) != 0 {
+		t.Errorf("expected no diagnostics, got %v", diags)
+	}
+}
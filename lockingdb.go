@@ -20,6 +20,7 @@ func (store lockingClauseStore) clauses() []*clause {
 type lockingDatabase struct {
 	predicates map[string]*predicate
 	clauses    map[string]lockingClauseStore
+	decls      *schema
 	m          sync.RWMutex
 }
 
@@ -28,18 +29,23 @@ func NewLockingDatabase() Database {
 	return &lockingDatabase{
 		predicates: make(map[string]*predicate),
 		clauses:    make(map[string]lockingClauseStore),
+		decls:      newSchema(),
 	}
 }
 
+func (db *lockingDatabase) lookupPredicate(id string) (*predicate, bool) {
+	db.m.RLock()
+	defer db.m.RUnlock()
+	p, ok := db.predicates[id]
+	return p, ok
+}
+
 func (db *lockingDatabase) newPredicate(n string, a int) *predicate {
 
 	id := predicateID(n, a)
-	db.m.RLock()
-	if existing, ok := db.predicates[id]; ok {
-		db.m.RUnlock()
+	if existing, ok := db.lookupPredicate(id); ok {
 		return existing
 	}
-	db.m.RUnlock()
 
 	p := &predicate{
 		Name:      n,
@@ -55,9 +61,15 @@ func (db *lockingDatabase) newPredicate(n string, a int) *predicate {
 	}
 
 	db.m.Lock()
+	defer db.m.Unlock()
+	// Another caller may have made the predicate since it was looked up.
+	if existing, ok := db.predicates[id]; ok {
+		return existing
+	}
 	db.predicates[p.id] = p
-	db.clauses[p.id] = lockingClauseStore{}
-	db.m.Unlock()
+	if _, ok := db.clauses[p.id]; !ok {
+		db.clauses[p.id] = lockingClauseStore{}
+	}
 	return p
 }
 
@@ -65,8 +77,9 @@ func (db *lockingDatabase) newPredicate(n string, a int) *predicate {
 // predicates originate within the same database.
 func (db *lockingDatabase) assert(c *clause) error {
 
-	if !isSafe(c) {
-		return fmt.Errorf("cannot assert unsafe clauses")
+	err := checkSafe(c)
+	if err != nil {
+		return err
 	}
 
 	pred := c.head.pred
@@ -76,22 +89,60 @@ func (db *lockingDatabase) assert(c *clause) error {
 	}
 
 	db.m.Lock()
-	db.clauses[pred.id][c.getID()] = c
-	db.m.Unlock()
+	defer db.m.Unlock()
+	// A concurrent retraction may have removed the predicate since it was
+	// made, so it is restored.
+	store, ok := db.clauses[pred.id]
+	if !ok {
+		store = lockingClauseStore{}
+		db.clauses[pred.id] = store
+	}
+	if _, ok := db.predicates[pred.id]; !ok {
+		db.predicates[pred.id] = pred
+	}
+	store[c.getID()] = c
 	return nil
 }
 
-func (db *lockingDatabase) retract(c *clause) error {
+func (db *lockingDatabase) retract(c *clause) ([]*clause, error) {
 	pred := c.head.pred
 	db.m.Lock()
-	delete(db.clauses[pred.id], c.getID())
+	defer db.m.Unlock()
+	store, ok := db.clauses[pred.id]
+	if !ok {
+		return nil, nil
+	}
+	removed := removeClauses(store, c)
 
 	// If a predicate has no clauses associated with it, remove it from the db.
 	if len(db.clauses[pred.id]) == 0 {
 		delete(db.predicates, pred.id)
 		delete(db.clauses, pred.id)
 	}
-	defer db.m.Unlock()
+	return removed, nil
+}
 
-	return nil
+func (db *lockingDatabase) holds(c *clause) bool {
+	db.m.RLock()
+	defer db.m.RUnlock()
+	_, ok := db.clauses[c.head.pred.id][c.getID()]
+	return ok
+}
+
+func (db *lockingDatabase) allClauses() []*clause {
+	db.m.RLock()
+	defer db.m.RUnlock()
+	var all []*clause
+	for _, store := range db.clauses {
+		all = append(all, store.clauses()...)
+	}
+	return all
+}
+
+func (db *lockingDatabase) schema() *schema {
+	return db.decls
+}
+
+func (db *lockingDatabase) declare(name string, attrs []Attribute) (bool, error) {
+	return addDeclaration(db, name, attrs)
 }
@@ -21,3 +21,19 @@ func BenchmarkInductionLockingDB(b *testing.B) {
 func TestLockingConcurrency(t *testing.T) {
 	concurrencyTests(t, NewLockingDatabase())
 }
+
+// A clause may be asserted with a predicate made before a concurrent
+// retraction removed every clause of it.
+func TestLockingAssertAfterPredicateRemoved(t *testing.T) {
+	db := NewLockingDatabase()
+	edge := db.newPredicate("edge", 2)
+	fact := &clause{head: literal{edge, []Term{makeConst("a"), makeConst("b")}}}
+	panicOnError(db.assert(fact))
+
+	stale := db.newPredicate("edge", 2)
+	_, err := db.retract(fact)
+	panicOnError(err)
+	panicOnError(db.assert(&clause{head: literal{stale, []Term{makeConst("a"), makeConst("c")}}}))
+
+	compareDatalogResult(t, parseApplyExecute(t, "edge(X, Y)?", db), "edge(a, c).\n")
+}
@@ -0,0 +1,236 @@
+package gotalog
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LogFormat selects how records are encoded in a disk log.
+type LogFormat int
+
+const (
+	// TextLog stores each record as a line of datalog.
+	TextLog LogFormat = iota
+	// BinaryLog stores length-prefixed, checksummed records after a version
+	// header. It is more compact and faster to replay than TextLog.
+	BinaryLog
+)
+
+// logRecord is a single entry in a log.
+type logRecord struct {
+	cmd DatalogCommand
+	// seq numbers records in the order they were written, starting from 1.
+	// Records from logs written before sequence numbers were recorded have
+	// a seq of 0.
+	seq uint64
+	// time is when the record was written, or the zero time if unknown.
+	time time.Time
+}
+
+// logClock stamps new log records. Tests replace it to control time.
+var logClock = time.Now
+
+// logReader iterates over the records of an existing log.
+type logReader interface {
+	// next returns the next record, or io.EOF once the log is exhausted.
+	next() (logRecord, error)
+	// offset is the number of bytes of the log consumed by the records
+	// returned so far.
+	offset() int64
+	// appender returns a writer that continues the log in its format.
+	appender(w io.Writer) logWriter
+}
+
+// logWriter appends records to a log.
+type logWriter interface {
+	write(rec logRecord) error
+}
+
+// detectLogFormat inspects the start of a non-empty log.
+func detectLogFormat(r *bufio.Reader) LogFormat {
+	magic, err := r.Peek(len(binaryLogMagic))
+	if err == nil && bytes.Equal(magic, binaryLogMagic) {
+		return BinaryLog
+	}
+	return TextLog
+}
+
+func newLogReader(r *bufio.Reader, format LogFormat) (logReader, error) {
+	switch format {
+	case TextLog:
+		return &textLogReader{s: newScanner(r)}, nil
+	case BinaryLog:
+		return newBinaryLogReader(r)
+	}
+	return nil, fmt.Errorf("unknown log format %v", format)
+}
+
+// newLogWriter starts a new, empty log in the given format.
+func newLogWriter(w io.Writer, format LogFormat) (logWriter, error) {
+	switch format {
+	case TextLog:
+		return textLogWriter{w}, nil
+	case BinaryLog:
+		return newBinaryLogWriter(w)
+	}
+	return nil, fmt.Errorf("unknown log format %v", format)
+}
+
+// isTornTail reports whether tail, everything following the last intact
+// record of a log, is a single record that was cut short.
+func isTornTail(tail []byte, format LogFormat) bool {
+	if format == BinaryLog {
+		return isTornBinaryTail(tail)
+	}
+	// Text records are written one per line, so a torn record never spans
+	// a newline.
+	return !strings.ContainsRune(strings.TrimLeft(string(tail), " \t\r\n"), '\n')
+}
+
+// In text logs, each record's sequence number and time follow it on the same
+// line, in a comment starting with this marker.
+const textRecordMarker = "%@"
+
+type textLogReader struct {
+	s   *scanner
+	off int64
+}
+
+func (t *textLogReader) next() (logRecord, error) {
+	c, finished, err := t.s.scanOneCommand()
+	if errors.Is(err, io.EOF) {
+		// The scanner reports running out of input part way through a
+		// command as io.EOF, which would look like a clean end of the log.
+		return logRecord{}, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return logRecord{}, err
+	}
+	if finished {
+		return logRecord{}, io.EOF
+	}
+	rec := logRecord{cmd: c}
+	err = t.scanRecordMarker(&rec)
+	if err != nil {
+		return logRecord{}, err
+	}
+	t.off = t.s.offset
+	return rec, nil
+}
+
+// scanRecordMarker reads the sequence number and time that may follow a
+// command on the same line. Every record ends its line, so one that ends the
+// log without a newline was cut short, even if it looks complete: a
+// declaration has no terminator, and may have been cut off part way through
+// its name.
+func (t *textLogReader) scanRecordMarker(rec *logRecord) error {
+	for {
+		ch, _, err := t.s.readRune()
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return err
+		}
+		if ch == ' ' || ch == '\t' {
+			continue
+		}
+		if ch != '%' {
+			t.s.unreadRune()
+			return nil
+		}
+		break
+	}
+	line, err := t.s.r.ReadString('\n')
+	t.s.offset += int64(len(line))
+	if err == io.EOF {
+		// A crash cut the record off while writing its marker.
+		return io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return err
+	}
+	fields := strings.Fields(line)
+	if len(fields) != 3 || fields[0] != textRecordMarker[1:] {
+		// An ordinary comment.
+		return nil
+	}
+	rec.seq, err = strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid sequence number in log: %v", err)
+	}
+	rec.time, err = time.Parse(time.RFC3339Nano, fields[2])
+	if err != nil {
+		return fmt.Errorf("invalid time in log: %v", err)
+	}
+	return nil
+}
+
+func (t *textLogReader) offset() int64 {
+	return t.off
+}
+
+func (t *textLogReader) appender(w io.Writer) logWriter {
+	return textLogWriter{w}
+}
+
+type textLogWriter struct {
+	w io.Writer
+}
+
+// write appends a record in a single call, so that a crash leaves at most
+// one partial record at the end of the log.
+func (t textLogWriter) write(rec logRecord) error {
+	var buf bytes.Buffer
+	err := writeCommand(&buf, rec.cmd)
+	if err != nil {
+		return err
+	}
+	if d := rec.cmd.Directive; d != nil && len(d.Attributes) == 0 {
+		// Reading back a declaration without parentheses would look past
+		// its end, consuming the record marker.
+		buf.WriteString("()")
+	}
+	if rec.seq != 0 {
+		fmt.Fprintf(&buf, " %v %v %v", textRecordMarker, rec.seq, rec.time.UTC().Format(time.RFC3339Nano))
+	}
+	buf.WriteString("\n")
+	_, err = t.w.Write(buf.Bytes())
+	return err
+}
+
+// ConvertLog rewrites the log read from src into dst, using the given format.
+// The format of src is detected automatically.
+func ConvertLog(dst io.Writer, src io.Reader, format LogFormat) error {
+	br := bufio.NewReader(src)
+	_, err := br.Peek(1)
+	empty := err == io.EOF
+
+	w, err := newLogWriter(dst, format)
+	if err != nil || empty {
+		return err
+	}
+	r, err := newLogReader(br, detectLogFormat(br))
+	if err != nil {
+		return err
+	}
+	for {
+		rec, err := r.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		err = w.write(rec)
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -8,8 +8,8 @@ func (mem memClauseStore) add(c *clause) {
 	mem[c.getID()] = c
 }
 
-func (mem memClauseStore) delete(c *clause) {
-	delete(mem, c.getID())
+func (mem memClauseStore) delete(c *clause) []*clause {
+	return removeClauses(mem, c)
 }
 
 func (mem memClauseStore) size() int {
@@ -30,6 +30,7 @@ func (mem memClauseStore) clauses() []*clause {
 type memDatabase struct {
 	predicates map[string]*predicate
 	clauses    map[string]memClauseStore
+	decls      *schema
 }
 
 // NewMemDatabase constructs a new in-memory database.
@@ -37,6 +38,7 @@ func NewMemDatabase() Database {
 	return &memDatabase{
 		predicates: make(map[string]*predicate),
 		clauses:    make(map[string]memClauseStore),
+		decls:      newSchema(),
 	}
 }
 
@@ -68,8 +70,9 @@ func (db *memDatabase) newPredicate(n string, a int) *predicate {
 // assertions should only be made for clauses' whose
 // predicates originate within the same database.
 func (db memDatabase) assert(c *clause) error {
-	if !isSafe(c) {
-		return fmt.Errorf("cannot assert unsafe clauses")
+	err := checkSafe(c)
+	if err != nil {
+		return err
 	}
 
 	pred := c.head.pred
@@ -82,14 +85,39 @@ func (db memDatabase) assert(c *clause) error {
 	return nil
 }
 
-func (db memDatabase) retract(c *clause) error {
+func (db memDatabase) retract(c *clause) ([]*clause, error) {
 	pred := c.head.pred
-	db.clauses[pred.id].delete(c)
+	store, ok := db.clauses[pred.id]
+	if !ok {
+		return nil, nil
+	}
+	removed := store.delete(c)
 
 	// If a predicate has no clauses associated with it, remove it from the db.
 	if db.clauses[pred.id].size() == 0 {
 		delete(db.predicates, pred.id)
 		delete(db.clauses, pred.id)
 	}
-	return nil
+	return removed, nil
+}
+
+func (db memDatabase) holds(c *clause) bool {
+	_, ok := db.clauses[c.head.pred.id][c.getID()]
+	return ok
+}
+
+func (db *memDatabase) allClauses() []*clause {
+	var all []*clause
+	for _, store := range db.clauses {
+		all = append(all, store.clauses()...)
+	}
+	return all
+}
+
+func (db *memDatabase) schema() *schema {
+	return db.decls
+}
+
+func (db *memDatabase) declare(name string, attrs []Attribute) (bool, error) {
+	return addDeclaration(db, name, attrs)
 }
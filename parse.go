@@ -1,249 +1,370 @@
 package gotalog
 
 import (
-	"bufio"
+	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"unicode/utf8"
 )
 
+// scanner parses commands from the tokens produced by its lexer.
 type scanner struct {
-	r *bufio.Reader
+	*lexer
+	// peeked holds a token read ahead by peek.
+	peeked *token
+	// last is the last token consumed.
+	last token
 }
 
-func newScanner(input io.Reader) scanner {
-	return scanner{bufio.NewReader(input)}
+func newScanner(input io.Reader) *scanner {
+	return &scanner{lexer: newLexer(input)}
 }
 
-func isWhitespace(ch rune) bool {
-	return ch == ' ' || ch == '\t' || ch == '\n'
-}
-
-func isLowerCase(ch rune) bool {
-	return (ch >= 'a' && ch <= 'z')
-}
-
-func isUpperCase(ch rune) bool {
-	return (ch >= 'A' && ch <= 'Z')
+func (s *scanner) next() (token, error) {
+	if s.peeked != nil {
+		t := *s.peeked
+		s.peeked = nil
+		s.last = t
+		return t, nil
+	}
+	t, err := s.lexer.next()
+	if err == nil {
+		s.last = t
+	}
+	return t, err
 }
 
-func isNumber(ch rune) bool {
-	return (ch >= '0' && ch <= '9')
+func (s *scanner) peek() (token, error) {
+	if s.peeked != nil {
+		return *s.peeked, nil
+	}
+	t, err := s.lexer.next()
+	if err == nil {
+		s.peeked = &t
+	}
+	return t, err
 }
 
-func isLetter(ch rune) bool {
-	return isLowerCase(ch) || isUpperCase(ch)
+// unexpected reports that t was found where expected should have been.
+func (s *scanner) unexpected(t token, expected string) error {
+	if t.kind == tokenEOF {
+		return s.parseError(t.pos, io.EOF)
+	}
+	return s.parseError(t.pos, fmt.Errorf("Expected %v, but got %v", expected, t))
 }
 
-func isTerminal(ch rune) bool {
-	return ch == '?' || ch == '.' || ch == '~'
+// expect consumes the next token, which must be of the given kind.
+func (s *scanner) expect(kind tokenKind, expected string) (token, error) {
+	t, err := s.next()
+	if err != nil {
+		return t, err
+	}
+	if t.kind != kind {
+		return t, s.unexpected(t, expected)
+	}
+	return t, nil
 }
 
-func commandForTerminal(ch rune) CommandType {
-	switch ch {
-	case '.':
+func commandForTerminal(t token) CommandType {
+	switch t.kind {
+	case tokenPeriod:
 		return Assert
-	case '?':
+	case tokenQuestion:
 		return Query
-	case '~':
+	case tokenTilde:
 		return Retract
 	default:
-		panic("invalid terminal rune.")
+		panic("invalid terminal token.")
 	}
 }
 
-func isAllowedBodyRune(ch rune) bool {
-	return isLetter(ch) ||
-		isNumber(ch) ||
-		(ch == '_' || ch == '-')
-}
-
-var eof = rune(0)
-
-func (s scanner) mustConsume(r rune) error {
-	ch, _, err := s.r.ReadRune()
-	if err != nil {
-		return err
-	}
-	if ch != r {
-		return fmt.Errorf("Expected %v, but got %v", string(r), string(ch))
-	}
-	return nil
-}
-
-func (s scanner) consumeRestOfLine() {
-	for {
-		ch, _, err := s.r.ReadRune()
-		if err != nil || ch == '\n' {
-			break
-		}
+// resync discards the rest of a command in which a syntax error was found:
+// tokens up to and including the next terminal or, for directives, those on
+// the line of the error. It returns false if the input ends first.
+func (s *scanner) resync(directive bool, line int) bool {
+	if !directive && s.last.isTerminal() {
+		// The error was found at the end of the command.
+		return true
 	}
-}
-
-func (s scanner) consumeWhitespace() {
 	for {
-		ch, _, err := s.r.ReadRune()
-		if err != nil || !isWhitespace(ch) {
-			if ch == '%' {
-				s.consumeRestOfLine()
-			} else {
-				s.r.UnreadRune()
-				return
+		t, err := s.peek()
+		if err != nil {
+			if _, ok := err.(*ParseError); ok && !errors.Is(err, io.EOF) && s.readErr == nil {
+				// The lexer has skipped the offending input.
+				continue
 			}
+			return false
 		}
-	}
-}
-
-func (s scanner) scanIdentifier() (str string, err error) {
-	s.consumeWhitespace()
-	ch, _, err := s.r.ReadRune()
-	if !isLetter(ch) && !isNumber(ch) {
-		return str, fmt.Errorf("Expected a term startign with a letter or number, but got %v", string(ch))
-	}
-	str = str + string(ch)
-	for {
-		ch, _, err = s.r.ReadRune()
-
-		if !isAllowedBodyRune(ch) {
-			s.r.UnreadRune()
-			return
+		if t.kind == tokenEOF {
+			return false
+		}
+		if directive && t.pos.Line > line {
+			return true
+		}
+		s.next()
+		if !directive && t.isTerminal() {
+			return true
 		}
-		str = str + string(ch)
 	}
 }
 
-func (s scanner) scanTerm() (t Term, err error) {
-
-	t.value, err = s.scanIdentifier()
+func (s *scanner) scanTerm() (t Term, err error) {
+	tok, err := s.next()
 	if err != nil {
-		return t, err
+		return
 	}
-	leading, _ := utf8.DecodeRuneInString(t.value)
-
-	if !isUpperCase(leading) {
-		t.isConstant = true
+	switch tok.kind {
+	case tokenString:
+		return Term{isConstant: true, value: tok.text}, nil
+	case tokenIdentifier:
+		leading, _ := utf8.DecodeRuneInString(tok.text)
+		return Term{isConstant: !isUpperCase(leading) && leading != '_', value: tok.text}, nil
 	}
-	return
+	return t, s.unexpected(tok, "a term")
 }
 
-func (s scanner) scanLiteral() (lit LiteralDefinition, err error) {
-	name, err := s.scanIdentifier()
+func (s *scanner) scanLiteral() (lit LiteralDefinition, err error) {
+	name, err := s.expect(tokenIdentifier, "a predicate name")
 	if err != nil {
 		return
 	}
-
 	lit = LiteralDefinition{
-		PredicateName: name,
+		PredicateName: name.text,
+		Pos:           name.pos,
 	}
 
-	s.consumeWhitespace()
-
-	// We might have  a 0-arity literal, so check if we have a period, and return if so.
-
-	ch, _, err := s.r.ReadRune()
-	if err != nil {
-		return lit, err
-	}
-	s.r.UnreadRune()
-	if isTerminal(ch) {
-		return
-	}
-
-	err = s.mustConsume('(')
-	if err != nil {
+	// A literal without a parenthesized list of terms has arity 0.
+	tok, err := s.peek()
+	if err != nil || tok.kind != tokenLeftParen {
 		return
 	}
+	s.next()
 	for {
-		s.consumeWhitespace()
-
-		t, err := s.scanTerm()
+		var t Term
+		t, err = s.scanTerm()
 		if err != nil {
-			return lit, err
+			return
 		}
 		lit.Terms = append(lit.Terms, t)
 
-		s.consumeWhitespace()
-
-		ch, _, err := s.r.ReadRune()
+		tok, err = s.next()
 		if err != nil {
-			return lit, err
+			return
 		}
 		// ')' closes the literal
-		if ch == ')' {
-			break
+		if tok.kind == tokenRightParen {
+			return
+		}
+		if tok.kind != tokenComma {
+			return lit, s.unexpected(tok, "',' or ')'")
 		}
-		s.r.UnreadRune()
-		s.mustConsume(',')
 	}
-	return
 }
 
-func (s scanner) scanCommand() (cmd DatalogCommand, err error) {
-	s.consumeWhitespace()
+func (s *scanner) scanCommand() (cmd DatalogCommand, err error) {
 	cmd.Head, err = s.scanLiteral()
 	if err != nil {
 		return
 	}
+	cmd.Pos = cmd.Head.Pos
 
-	s.consumeWhitespace()
-	ch, _, err := s.r.ReadRune()
+	tok, err := s.next()
 	if err != nil {
-		return cmd, err
+		return
 	}
-
-	if isTerminal(ch) {
-		cmd.CommandType = commandForTerminal(ch)
+	if tok.isTerminal() {
+		cmd.CommandType = commandForTerminal(tok)
 		return
 	}
+	if tok.kind != tokenImplies {
+		return cmd, s.unexpected(tok, "'.', '?', '~' or ':-'")
+	}
 
-	s.r.UnreadRune()
-	err = s.mustConsume(':')
+	for {
+		var l LiteralDefinition
+		l, err = s.scanLiteral()
+		if err != nil {
+			return
+		}
+		cmd.Body = append(cmd.Body, l)
+
+		// Check for terminus
+		tok, err = s.next()
+		if err != nil {
+			return
+		}
+		if tok.kind == tokenPeriod || tok.kind == tokenTilde {
+			cmd.CommandType = commandForTerminal(tok)
+			return
+		}
+		if tok.kind != tokenComma {
+			return cmd, s.unexpected(tok, "'.', '~' or ','")
+		}
+	}
+}
+
+// directives lists the names of the directives the parser accepts.
+var directives = map[string]bool{
+	"decl":    true,
+	"include": true,
+	"input":   true,
+	"output":  true,
+}
+
+// scanDirective reads a directive, after its leading '.', of the form
+// name predicate or name predicate(key=value, ...). Values are identifiers or
+// quoted strings. Declarations instead take decl predicate(name: type, ...).
+func (s *scanner) scanDirective() (cmd DatalogCommand, err error) {
+	name, err := s.expect(tokenIdentifier, "a directive name")
 	if err != nil {
 		return
 	}
-	err = s.mustConsume('-')
+	if !directives[name.text] {
+		return cmd, s.parseError(name.pos, fmt.Errorf("Unknown directive .%v", name.text))
+	}
+	if name.text == "include" {
+		return s.scanInclude()
+	}
+	pred, err := s.expect(tokenIdentifier, "a predicate name")
 	if err != nil {
 		return
 	}
+	d := &DirectiveDefinition{Name: name.text, Predicate: pred.text}
+	cmd = DatalogCommand{CommandType: Directive, Directive: d}
 
+	tok, err := s.peek()
+	if err != nil || tok.kind != tokenLeftParen {
+		return
+	}
+	s.next()
+	if d.Name == "decl" {
+		d.Attributes, err = s.scanAttributes()
+		return
+	}
+	d.Params = make(map[string]string)
 	for {
-		var l LiteralDefinition
-		s.consumeWhitespace()
-		l, err = s.scanLiteral()
+		var key token
+		key, err = s.expect(tokenIdentifier, "a parameter name")
 		if err != nil {
 			return
 		}
-		cmd.Body = append(cmd.Body, l)
+		_, err = s.expect(tokenEquals, "'='")
+		if err != nil {
+			return
+		}
+		var value Term
+		value, err = s.scanTerm()
+		if err != nil {
+			return
+		}
+		d.Params[key.text] = value.value
 
-		s.consumeWhitespace()
+		tok, err = s.next()
+		if err != nil {
+			return
+		}
+		if tok.kind == tokenRightParen {
+			return cmd, nil
+		}
+		if tok.kind != tokenComma {
+			return cmd, s.unexpected(tok, "',' or ')'")
+		}
+	}
+}
 
-		// Check for terminus
-		ch, _, err = s.r.ReadRune()
+// scanAttributes reads the attributes of a declaration, name: type, ..., up
+// to and including the closing ')'.
+func (s *scanner) scanAttributes() (attrs []Attribute, err error) {
+	tok, err := s.peek()
+	if err == nil && tok.kind == tokenRightParen {
+		s.next()
+		return nil, nil
+	}
+	for {
+		var name, typ token
+		name, err = s.expect(tokenIdentifier, "an attribute name")
 		if err != nil {
 			return
 		}
-		if ch == '.' {
+		_, err = s.expect(tokenColon, "':'")
+		if err != nil {
 			return
 		}
-		if ch == ',' {
-			continue
+		typ, err = s.expect(tokenIdentifier, "a type")
+		if err != nil {
+			return
+		}
+		if _, ok := attributeTypes[typ.text]; !ok {
+			return attrs, s.parseError(typ.pos, fmt.Errorf("Unknown type %v", typ.text))
+		}
+		attrs = append(attrs, Attribute{Name: name.text, Type: typ.text})
+
+		var tok token
+		tok, err = s.next()
+		if err != nil {
+			return
+		}
+		if tok.kind == tokenRightParen {
+			return attrs, nil
+		}
+		if tok.kind != tokenComma {
+			return attrs, s.unexpected(tok, "',' or ')'")
 		}
-		err = fmt.Errorf("Expected '.' or ',', but got %v", string(ch))
-		return
 	}
 }
 
-func (s scanner) scanOneCommand() (DatalogCommand, bool, error) {
-	s.consumeWhitespace()
-	ch, _, err := s.r.ReadRune()
+// scanInclude reads the quoted file name of an include directive.
+func (s *scanner) scanInclude() (cmd DatalogCommand, err error) {
+	filename, err := s.expect(tokenString, "a quoted file name to include")
+	if err != nil {
+		return
+	}
+	cmd = DatalogCommand{
+		CommandType: Directive,
+		Directive: &DirectiveDefinition{
+			Name:   "include",
+			Params: map[string]string{"filename": filename.text},
+		},
+	}
+	return cmd, nil
+}
 
-	if ch == eof || err != nil {
+// scanOneCommand parses the next command. It reports whether the input is
+// exhausted, and returns syntax errors as a *ParseError.
+func (s *scanner) scanOneCommand() (DatalogCommand, bool, error) {
+	tok, err := s.peek()
+	if err != nil {
+		return DatalogCommand{}, false, err
+	}
+	if tok.kind == tokenEOF {
 		return DatalogCommand{}, true, nil
 	}
-	s.r.UnreadRune()
 
-	c, err := s.scanCommand()
+	var c DatalogCommand
+	switch tok.kind {
+	case tokenPeriod:
+		s.next()
+		c, err = s.scanDirective()
+	case tokenHash:
+		// C-style #include, the only directive written this way.
+		s.next()
+		var name token
+		name, err = s.peek()
+		if err == nil && name.kind == tokenIdentifier && name.text != "include" {
+			err = s.parseError(name.pos, fmt.Errorf("Unknown directive #%v", name.text))
+			s.next()
+		} else {
+			c, err = s.scanDirective()
+		}
+	default:
+		c, err = s.scanCommand()
+	}
+	if tok.kind == tokenPeriod || tok.kind == tokenHash {
+		c.CommandType = Directive
+		c.Pos = tok.pos
+	}
 	return c, false, err
 }
 
@@ -254,22 +375,106 @@ func buildLiteral(ml LiteralDefinition, db Database) literal {
 	}
 }
 
-// Should we instead write commands back to disk,
-// and focus on providing utility methods to convert clauses back to commands?
-// TODO:consider this.
-func writeLiteral(w io.Writer, l *literal) error {
-	_, err := io.WriteString(w, l.pred.Name)
+// clauseCommand converts a clause back into the command that would create
+// (or, depending on t, retract or query) it.
+func clauseCommand(c *clause, t CommandType) DatalogCommand {
+	cmd := DatalogCommand{
+		Head:        literalDefinition(c.head),
+		CommandType: t,
+	}
+	for _, l := range c.body {
+		cmd.Body = append(cmd.Body, literalDefinition(l))
+	}
+	return cmd
+}
+
+func literalDefinition(l literal) LiteralDefinition {
+	return LiteralDefinition{
+		PredicateName: l.pred.Name,
+		Terms:         l.terms,
+	}
+}
+
+// needsQuotes reports whether a constant must be quoted to be parsed back as
+// the same constant.
+func needsQuotes(value string) bool {
+	if value == "" {
+		return true
+	}
+	for i, ch := range value {
+		if i == 0 && !(isLetter(ch) && !isUpperCase(ch)) && !isNumber(ch) {
+			return true
+		}
+		if !isAllowedBodyRune(ch) {
+			return true
+		}
+	}
+	return false
+}
+
+// isIdentifier reports whether s is read back as a single identifier, as
+// predicate names and variables must be.
+func isIdentifier(s string) bool {
+	for i, ch := range s {
+		if i == 0 && !isLetter(ch) && !isNumber(ch) && ch != '_' {
+			return false
+		}
+		if !isAllowedBodyRune(ch) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// isVariableName reports whether s is read back as a variable.
+func isVariableName(s string) bool {
+	leading, _ := utf8.DecodeRuneInString(s)
+	return isIdentifier(s) && (isUpperCase(leading) || leading == '_')
+}
+
+// formatTerm returns a term as datalog, quoting constants where necessary.
+func formatTerm(t Term) string {
+	if !t.isConstant || !needsQuotes(t.value) {
+		return t.value
+	}
+	return quote(t.value, '\'')
+}
+
+// quote encloses value in q, escaping it to be read back by scanQuoted.
+func quote(value string, q rune) string {
+	var b strings.Builder
+	b.WriteRune(q)
+	for _, ch := range value {
+		switch ch {
+		case q, '\\':
+			b.WriteRune('\\')
+			b.WriteRune(ch)
+		case '\n':
+			// Escaped so that each command stays on one line.
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			b.WriteRune(ch)
+		}
+	}
+	b.WriteRune(q)
+	return b.String()
+}
+
+func writeLiteral(w io.Writer, l LiteralDefinition) error {
+	_, err := io.WriteString(w, l.PredicateName)
 	if err != nil {
 		return err
 	}
-	if l.pred.Arity > 0 {
+	if len(l.Terms) > 0 {
 		_, err := io.WriteString(w, "(")
 		if err != nil {
 			return err
 		}
-		strs := make([]string, len(l.terms))
-		for i, t := range l.terms {
-			strs[i] = t.value
+		strs := make([]string, len(l.Terms))
+		for i, t := range l.Terms {
+			strs[i] = formatTerm(t)
 		}
 		_, err = io.WriteString(w, strings.Join(strs, ", "))
 		if err != nil {
@@ -284,33 +489,81 @@ func writeLiteral(w io.Writer, l *literal) error {
 	return nil
 }
 
-func writeClause(w io.Writer, c *clause, t CommandType) error {
-	err := writeLiteral(w, &c.head)
+func writeDirective(w io.Writer, d *DirectiveDefinition) error {
+	if d.Name == "include" {
+		_, err := fmt.Fprintf(w, ".include %v", quote(d.Params["filename"], '"'))
+		return err
+	}
+	_, err := fmt.Fprintf(w, ".%v %v", d.Name, d.Predicate)
 	if err != nil {
 		return err
 	}
-	if len(c.body) > 0 {
+	if len(d.Attributes) > 0 {
+		attrs := make([]string, len(d.Attributes))
+		for i, a := range d.Attributes {
+			attrs[i] = a.Name + ": " + a.Type
+		}
+		_, err = fmt.Fprintf(w, "(%v)", strings.Join(attrs, ", "))
+		return err
+	}
+	if len(d.Params) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(d.Params))
+	for k := range d.Params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	params := make([]string, len(keys))
+	for i, k := range keys {
+		params[i] = k + "=" + quote(d.Params[k], '"')
+	}
+	_, err = fmt.Fprintf(w, "(%v)", strings.Join(params, ", "))
+	return err
+}
+
+func writeCommand(w io.Writer, cmd DatalogCommand) error {
+	if cmd.CommandType == Directive {
+		return writeDirective(w, cmd.Directive)
+	}
+	err := writeLiteral(w, cmd.Head)
+	if err != nil {
+		return err
+	}
+	if len(cmd.Body) > 0 {
 		_, err := io.WriteString(w, " :- ")
-		for i, l := range c.body {
+		if err != nil {
+			return err
+		}
+		for i, l := range cmd.Body {
 			if i > 0 {
 				_, err := io.WriteString(w, ", ")
 				if err != nil {
 					return err
 				}
 			}
-			err = writeLiteral(w, &l)
+			err = writeLiteral(w, l)
 			if err != nil {
 				return err
 			}
 		}
 	}
-	switch t {
+	switch cmd.CommandType {
 	case Assert:
-		_, err = io.WriteString(w, ".\n")
+		_, err = io.WriteString(w, ".")
 	case Query:
-		_, err = io.WriteString(w, "?\n")
+		_, err = io.WriteString(w, "?")
 	case Retract:
-		_, err = io.WriteString(w, "~\n")
+		_, err = io.WriteString(w, "~")
+	}
+	return err
+}
+
+func writeClause(w io.Writer, c *clause, t CommandType) error {
+	err := writeCommand(w, clauseCommand(c, t))
+	if err != nil {
+		return err
 	}
+	_, err = io.WriteString(w, "\n")
 	return err
 }
@@ -2,6 +2,7 @@ package gotalog
 
 import "testing"
 import "strings"
+import "reflect"
 
 type testCase struct {
 	s            string
@@ -21,8 +22,27 @@ var cases = []testCase{
 	{"foo(bar).foo(baz).quux(bar,baz).", false, 3},
 	{"foo(bar,baz) :- quux(bar, baz).", false, 1},
 	{"foo(bar,baz) :- quux(bar, baz), woz(bar)?", true, 1},
+	{"foo(X,baz) :- quux(X, baz), woz(X)~", false, 1},
 	{"foo(X)?", false, 1},
 	{"               \t\tfoo(X) :-    baz ( X )   .", false, 1},
+	{"customer_city(3, 'San Francisco').", false, 1},
+	{`father("jean-jacques", 'O\'Brien').`, false, 1},
+	{"foo('unterminated).", true, 1},
+	{".input edge", false, 1},
+	{".input edge(filename=\"edges.csv\", delimiter=\",\") edge(X, Y)?", false, 2},
+	{".output reachable(IO=stdout)\n.output edge", false, 2},
+	{".input edge(filename)", true, 1},
+	{".bogus edge", true, 1},
+	{"#include \"base.pl\"\n.include 'overrides.pl' foo(X)?", false, 3},
+	{".include base.pl", true, 1},
+	{"#input edge", true, 1},
+	{"foo(bar).\r\nbaz(qux).\r\n", false, 2},
+	{"/* a block\ncomment. */ foo(a). /* another */", false, 1},
+	{"foo(a). /* unterminated", true, 1},
+	{"größe(Äpfel, straße)?", false, 1},
+	{"foo(a) : bar(a).", true, 1},
+	{"foo(a) / bar(a).", true, 1},
+	{"has_child(X) :- parent(X, _), age(_Kid, _).", false, 1},
 	{"", false, 0},
 	{"foo(bar,baz). \n", false, 1},
 	{`% Transitive closure test from Guo & Gupta
@@ -47,3 +67,221 @@ func TestParse(t *testing.T) {
 		}
 	}
 }
+
+func TestQuotedConstants(t *testing.T) {
+	values := []string{"plain", "San Francisco", "Upper", "", "it's", `back\slash`, "two\nlines", "50%", "a,b)", "été", "Éclair", "a/*b*/", "_", "_x"}
+	for _, v := range values {
+		cmd := DatalogCommand{Head: LiteralDefinition{
+			PredicateName: "p",
+			Terms:         []Term{{isConstant: true, value: v}, {isConstant: false, value: "X"}},
+		}}
+		text := cmd.String()
+		if strings.Contains(text, "\n") {
+			t.Errorf("%q: formatted over several lines: %v", v, text)
+		}
+		parsed, err := Parse(strings.NewReader(text))
+		if err != nil || len(parsed) != 1 {
+			t.Errorf("%q: failed to parse %v: %v", v, text, err)
+			continue
+		}
+		terms := parsed[0].Head.Terms
+		if len(terms) != 2 || terms[0] != cmd.Head.Terms[0] || terms[1] != cmd.Head.Terms[1] {
+			t.Errorf("%q: %v parsed as %v", v, text, terms)
+		}
+	}
+}
+
+func TestParseErrorPositions(t *testing.T) {
+	errorCases := []struct {
+		s            string
+		line, column int
+		excerpt      string
+	}{
+		{"foo(bar) :- baz(bar) x.", 1, 22, "foo(bar) :- baz(bar) x.\n                     ^"},
+		{"foo(a).\n\tbar(b) :- ;\nbaz(c).", 2, 12, "\tbar(b) :- ;\n\t          ^"},
+		{"foo(a).\nbar(b", 2, 6, "bar(b\n     ^"},
+		{"foo(a).\nbar(b,\n", 3, 1, "\n^"},
+		{"p('unterminated).", 1, 18, "p('unterminated).\n                 ^"},
+	}
+	for _, c := range errorCases {
+		_, err := Parse(strings.NewReader(c.s))
+		pe, ok := err.(*ParseError)
+		if !ok {
+			t.Errorf("%q: got %v, expected a *ParseError", c.s, err)
+			continue
+		}
+		if pe.Line != c.line || pe.Column != c.column {
+			t.Errorf("%q: got error at %v:%v, expected %v:%v", c.s, pe.Line, pe.Column, c.line, c.column)
+		}
+		if pe.Excerpt() != c.excerpt {
+			t.Errorf("%q: got excerpt\n%v\nexpected\n%v", c.s, pe.Excerpt(), c.excerpt)
+		}
+	}
+}
+
+func TestCommandPositions(t *testing.T) {
+	cmds, err := Parse(strings.NewReader("foo(a).\n  bar(X) :-\n\tfoo(X), baz(X).\n.output bar"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	positions := []Position{
+		cmds[0].Pos, cmds[0].Head.Pos,
+		cmds[1].Pos, cmds[1].Head.Pos, cmds[1].Body[0].Pos, cmds[1].Body[1].Pos,
+		cmds[2].Pos,
+	}
+	expected := []Position{
+		{Line: 1, Column: 1}, {Line: 1, Column: 1},
+		{Line: 2, Column: 3}, {Line: 2, Column: 3}, {Line: 3, Column: 2}, {Line: 3, Column: 10},
+		{Line: 4, Column: 1},
+	}
+	for i := range expected {
+		if positions[i] != expected[i] {
+			t.Errorf("position %v: got %v, expected %v", i, positions[i], expected[i])
+		}
+	}
+}
+
+func TestParseWithRecovery(t *testing.T) {
+	input := `edge(a, b).
+edge(b c).
+path(X, Y) :- edge(X, Y) edge(Y, Z).
+edge(c, 'd. e').
+.bogus directive(x=1)
+.output path
+bad(X)? :- x.
+edge(d, e).
+query(X)?
+`
+	cmds, err := ParseWithRecovery(strings.NewReader(input))
+	errs, ok := err.(ParseErrors)
+	if !ok {
+		t.Fatalf("got %v, expected ParseErrors", err)
+	}
+	var lines []int
+	for _, e := range errs {
+		lines = append(lines, e.Line)
+	}
+	expectedLines := []int{2, 3, 5, 7}
+	if len(lines) != len(expectedLines) {
+		t.Fatalf("got errors on lines %v, expected %v: %v", lines, expectedLines, err)
+	}
+	for i := range lines {
+		if lines[i] != expectedLines[i] {
+			t.Errorf("got errors on lines %v, expected %v", lines, expectedLines)
+			break
+		}
+	}
+
+	var parsed []string
+	for _, cmd := range cmds {
+		parsed = append(parsed, cmd.String())
+	}
+	expected := "edge(a, b). edge(c, 'd. e'). .output path bad(X)? edge(d, e). query(X)?"
+	if got := strings.Join(parsed, " "); got != expected {
+		t.Errorf("got commands %v, expected %v", got, expected)
+	}
+
+	cmds, err = ParseWithRecovery(strings.NewReader("edge(a, b). edge(b,"))
+	if errs, ok := err.(ParseErrors); !ok || len(errs) != 1 || len(cmds) != 1 {
+		t.Errorf("got %v and %v, expected one command and one error", cmds, err)
+	}
+	_, err = ParseWithRecovery(strings.NewReader("edge(a, b)."))
+	if err != nil {
+		t.Errorf("got %v, expected no error", err)
+	}
+}
+
+// addParseSeeds adds the parser's test cases to a fuzz corpus.
+func addParseSeeds(f *testing.F) {
+	for _, c := range cases {
+		f.Add(c.s)
+	}
+	f.Add("foo('a\\nb', \"\\t\", '').")
+	f.Add(".decl edge(from: symbol, to: number)")
+}
+
+func FuzzParse(f *testing.F) {
+	addParseSeeds(f)
+	f.Fuzz(func(t *testing.T, src string) {
+		cmds, err := Parse(strings.NewReader(src))
+		recovered, recErr := ParseWithRecovery(strings.NewReader(src))
+		if (err == nil) != (recErr == nil) {
+			t.Fatalf("Parse returned %v, but ParseWithRecovery returned %v", err, recErr)
+		}
+		if err != nil {
+			pe, ok := err.(*ParseError)
+			if !ok {
+				t.Fatalf("%v is not a *ParseError", err)
+			}
+			for _, pe := range append(recErr.(ParseErrors), pe) {
+				if !pe.IsValid() || pe.Column < 1 {
+					t.Fatalf("%v has an invalid position", pe)
+				}
+			}
+			if recErr.(ParseErrors)[0].Error() != err.Error() {
+				t.Fatalf("Parse failed with %v, but ParseWithRecovery first with %v", err, recErr)
+			}
+			return
+		}
+		if !reflect.DeepEqual(cmds, recovered) {
+			t.Fatalf("Parse returned %v, but ParseWithRecovery returned %v", cmds, recovered)
+		}
+	})
+}
+
+func FuzzScan(f *testing.F) {
+	addParseSeeds(f)
+	f.Fuzz(func(t *testing.T, src string) {
+		expected, expectedErr := Parse(strings.NewReader(src))
+		commands, errors := Scan(strings.NewReader(src))
+		var cmds []DatalogCommand
+		for cmd := range commands {
+			cmds = append(cmds, cmd)
+		}
+		err := <-errors
+		if (err == nil) != (expectedErr == nil) || err != nil && err.Error() != expectedErr.Error() {
+			t.Fatalf("Scan returned %v, but Parse returned %v", err, expectedErr)
+		}
+		if len(cmds) != len(expected) || len(cmds) > 0 && !reflect.DeepEqual(cmds, expected) {
+			t.Fatalf("Scan returned %v, but Parse returned %v", cmds, expected)
+		}
+	})
+}
+
+// FuzzRoundTrip checks that commands written back as datalog, directly or
+// as clauses of a database, parse as the same commands.
+func FuzzRoundTrip(f *testing.F) {
+	addParseSeeds(f)
+	f.Fuzz(func(t *testing.T, src string) {
+		cmds, err := Parse(strings.NewReader(src))
+		if err != nil {
+			return
+		}
+		var written, clauses strings.Builder
+		db := NewMemDatabase()
+		for _, cmd := range cmds {
+			writeCommand(&written, cmd)
+			written.WriteString("\n")
+			if cmd.CommandType == Directive {
+				writeCommand(&clauses, cmd)
+				clauses.WriteString("\n")
+				continue
+			}
+			c := &clause{head: buildLiteral(cmd.Head, db)}
+			for _, l := range cmd.Body {
+				c.body = append(c.body, buildLiteral(l, db))
+			}
+			writeClause(&clauses, c, cmd.CommandType)
+		}
+
+		for _, text := range []string{written.String(), clauses.String()} {
+			reparsed, err := Parse(strings.NewReader(text))
+			if err != nil {
+				t.Fatalf("%q was written as %q, which does not parse: %v", src, text, err)
+			}
+			if !reflect.DeepEqual(withoutPositions(reparsed), withoutPositions(cmds)) {
+				t.Fatalf("%q was written as %q, which parses as %v rather than %v", src, text, reparsed, cmds)
+			}
+		}
+	})
+}
@@ -0,0 +1,248 @@
+package gotalog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+)
+
+// ProfileCounts are statistics gathered while evaluating a query.
+type ProfileCounts struct {
+	// Subgoals is the number of subgoals created: for a predicate, those
+	// for it, and for a clause, those for literals of its body.
+	Subgoals int `json:"subgoals"`
+	// Resolutions is the number of times a fact was resolved with the
+	// selected literal of a rule, and Unifications the number of successful
+	// unifications, both of clause heads with subgoals and in resolution.
+	Resolutions  int `json:"resolutions"`
+	Unifications int `json:"unifications"`
+	// Facts is the number of distinct facts derived.
+	Facts int `json:"facts"`
+	// Time is the wall time spent evaluating the predicate's subgoals, or
+	// the rule against them, less that spent on the subgoals they create.
+	Time time.Duration `json:"time"`
+}
+
+// PredicateProfile holds the statistics of a predicate, named name/arity.
+type PredicateProfile struct {
+	Predicate string `json:"predicate"`
+	ProfileCounts
+}
+
+// ClauseProfile holds the statistics of a rule.
+type ClauseProfile struct {
+	Clause string `json:"clause"`
+	ProfileCounts
+}
+
+// Profile describes where the evaluation of a query spent its effort. Its
+// predicates and clauses are sorted by decreasing time.
+type Profile struct {
+	Predicates []PredicateProfile `json:"predicates"`
+	Clauses    []ClauseProfile    `json:"clauses"`
+	Time       time.Duration      `json:"time"`
+}
+
+// ApplyProfiled is like ApplyContext, but also profiles the evaluation of
+// queries. Other commands have no profile.
+func ApplyProfiled(ctx context.Context, cmd DatalogCommand, db Database) (*Result, *Profile, error) {
+	if cmd.CommandType != Query {
+		res, err := ApplyContext(ctx, cmd, db)
+		return res, nil, err
+	}
+	p := newProfiler()
+	start := time.Now()
+	res, err := applyProfiled(ctx, cmd, db, p)
+	if err != nil {
+		return nil, nil, err
+	}
+	profile := p.profile()
+	profile.Time = time.Since(start)
+	return res, profile, nil
+}
+
+// WriteTable writes the profile as tables of predicates and rules.
+func (p *Profile) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "subgoals\tresolutions\tunifications\tfacts\ttime\t\tpredicate")
+	for _, pp := range p.Predicates {
+		writeProfileRow(tw, pp.ProfileCounts, pp.Predicate)
+	}
+	if len(p.Clauses) > 0 {
+		fmt.Fprintln(tw, "\t\t\t\t\t\t")
+		fmt.Fprintln(tw, "subgoals\tresolutions\tunifications\tfacts\ttime\t\trule")
+		for _, cp := range p.Clauses {
+			writeProfileRow(tw, cp.ProfileCounts, cp.Clause)
+		}
+	}
+	fmt.Fprintf(tw, "\t\t\t\t%v\t\ttotal\n", p.Time)
+	return tw.Flush()
+}
+
+func writeProfileRow(w io.Writer, c ProfileCounts, name string) {
+	fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\t\t%v\n", c.Subgoals, c.Resolutions, c.Unifications, c.Facts, c.Time, name)
+}
+
+// profiler accumulates statistics during a search. Only rules are profiled
+// individually.
+type profiler struct {
+	preds   map[*predicate]*ProfileCounts
+	clauses map[*clause]*ProfileCounts
+	// predicates and rules time the searches in progress, innermost last.
+	predicates, rules []frame
+}
+
+// frame times a search, less the nested searches it makes.
+type frame struct {
+	start  time.Time
+	nested time.Duration
+}
+
+func newProfiler() *profiler {
+	return &profiler{
+		preds:   make(map[*predicate]*ProfileCounts),
+		clauses: make(map[*clause]*ProfileCounts),
+	}
+}
+
+func (p *profiler) predicate(pred *predicate) *ProfileCounts {
+	c, ok := p.preds[pred]
+	if !ok {
+		c = &ProfileCounts{}
+		p.preds[pred] = c
+	}
+	return c
+}
+
+// clause returns the counts of source, or nil if it is not a rule.
+func (p *profiler) clause(source *clause) *ProfileCounts {
+	if source == nil || len(source.body) == 0 {
+		return nil
+	}
+	c, ok := p.clauses[source]
+	if !ok {
+		c = &ProfileCounts{}
+		p.clauses[source] = c
+	}
+	return c
+}
+
+// subgoal records a subgoal for pred, created by selecting a literal of
+// source, if it is set.
+func (p *profiler) subgoal(pred *predicate, source *clause) {
+	p.predicate(pred).Subgoals++
+	if c := p.clause(source); c != nil {
+		c.Subgoals++
+	}
+}
+
+// resolution records a resolution with a clause derived from source.
+func (p *profiler) resolution(source *clause, unified bool) {
+	counts := []*ProfileCounts{p.predicate(source.head.pred), p.clause(source)}
+	for _, c := range counts {
+		if c == nil {
+			continue
+		}
+		c.Resolutions++
+		if unified {
+			c.Unifications++
+		}
+	}
+}
+
+// unification records an attempt to unify a subgoal for pred with the head
+// of source.
+func (p *profiler) unification(pred *predicate, source *clause, unified bool) {
+	if !unified {
+		return
+	}
+	p.predicate(pred).Unifications++
+	if c := p.clause(source); c != nil {
+		c.Unifications++
+	}
+}
+
+// fact records a fact derived for pred from source.
+func (p *profiler) fact(pred *predicate, source *clause) {
+	p.predicate(pred).Facts++
+	if c := p.clause(source); c != nil {
+		c.Facts++
+	}
+}
+
+func enter(stack []frame) []frame {
+	return append(stack, frame{start: time.Now()})
+}
+
+// exit ends the innermost frame of stack, returning the time spent in it,
+// and the time spent in it but not in the searches nested in it.
+func exit(stack []frame) ([]frame, time.Duration, time.Duration) {
+	f := stack[len(stack)-1]
+	elapsed := time.Since(f.start)
+	return stack[:len(stack)-1], elapsed, elapsed - f.nested
+}
+
+// enterPredicate starts timing a search for a subgoal, and exitPredicate
+// stops it, recording the time against pred.
+func (p *profiler) enterPredicate() {
+	p.predicates = enter(p.predicates)
+}
+
+func (p *profiler) exitPredicate(pred *predicate) {
+	var elapsed, own time.Duration
+	p.predicates, elapsed, own = exit(p.predicates)
+	p.predicate(pred).Time += own
+	// The search was nested in the innermost predicate and rule still being
+	// evaluated, which exclude its time from their own.
+	for _, stack := range [][]frame{p.predicates, p.rules} {
+		if len(stack) > 0 {
+			stack[len(stack)-1].nested += elapsed
+		}
+	}
+}
+
+// enterRule starts timing the evaluation of a rule against a subgoal, and
+// exitRule stops it, recording the time against the rule.
+func (p *profiler) enterRule() {
+	p.rules = enter(p.rules)
+}
+
+func (p *profiler) exitRule(source *clause) {
+	var own time.Duration
+	p.rules, _, own = exit(p.rules)
+	p.clause(source).Time += own
+}
+
+func (p *profiler) profile() *Profile {
+	profile := &Profile{
+		Predicates: make([]PredicateProfile, 0, len(p.preds)),
+		Clauses:    make([]ClauseProfile, 0, len(p.clauses)),
+	}
+	for pred, c := range p.preds {
+		profile.Predicates = append(profile.Predicates, PredicateProfile{Predicate: pred.id, ProfileCounts: *c})
+	}
+	sort.Slice(profile.Predicates, func(i, j int) bool {
+		a, b := profile.Predicates[i], profile.Predicates[j]
+		if a.Time != b.Time {
+			return a.Time > b.Time
+		}
+		return a.Predicate < b.Predicate
+	})
+	for source, c := range p.clauses {
+		profile.Clauses = append(profile.Clauses, ClauseProfile{
+			Clause:        clauseCommand(source, Assert).String(),
+			ProfileCounts: *c,
+		})
+	}
+	sort.Slice(profile.Clauses, func(i, j int) bool {
+		a, b := profile.Clauses[i], profile.Clauses[j]
+		if a.Time != b.Time {
+			return a.Time > b.Time
+		}
+		return a.Clause < b.Clause
+	})
+	return profile
+}
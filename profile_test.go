@@ -0,0 +1,101 @@
+package gotalog
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApplyProfiled(t *testing.T) {
+	db := NewMemDatabase()
+	_, err := ApplyAll(mustParse(t, `edge(a, b). edge(b, c). edge(c, d).
+	path(X, Y) :- edge(X, Y).
+	path(X, Z) :- edge(X, Y), path(Y, Z).`), db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, profile, err := ApplyProfiled(context.Background(), mustParse(t, "path(a, X)?")[0], db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Answers) != 3 {
+		t.Errorf("got %v answers, expected 3", len(res.Answers))
+	}
+
+	counts := make(map[string]ProfileCounts)
+	for _, p := range profile.Predicates {
+		p.Time = 0
+		counts[p.Predicate] = p.ProfileCounts
+	}
+	for name, expected := range map[string]ProfileCounts{
+		// path(a, X), path(b, X), path(c, X) and path(d, X).
+		"path/2": {Subgoals: 4, Resolutions: 9, Unifications: 17, Facts: 6},
+		"edge/2": {Subgoals: 4, Unifications: 3, Facts: 3},
+	} {
+		if counts[name] != expected {
+			t.Errorf("%v: got %+v, expected %+v", name, counts[name], expected)
+		}
+	}
+	// Which rule creates which subgoal depends on the order the rules are
+	// stored in, but each derives three facts.
+	if len(profile.Clauses) != 2 {
+		t.Fatalf("got %v rules, expected 2", len(profile.Clauses))
+	}
+	var total ProfileCounts
+	for _, p := range profile.Clauses {
+		if p.Facts != 3 {
+			t.Errorf("%v: got %v facts, expected 3", p.Clause, p.Facts)
+		}
+		total.Subgoals += p.Subgoals
+		total.Resolutions += p.Resolutions
+	}
+	if total.Subgoals != 7 || total.Resolutions != 9 {
+		t.Errorf("rules created %v subgoals and made %v resolutions, expected 7 and 9", total.Subgoals, total.Resolutions)
+	}
+
+	var b strings.Builder
+	err = profile.WriteTable(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(b.String(), "path(X, Z) :- edge(X, Y), path(Y, Z).") {
+		t.Errorf("rules missing from\n%v", b.String())
+	}
+
+	_, profile, err = ApplyProfiled(context.Background(), mustParse(t, "edge(d, e).")[0], db)
+	if err != nil || profile != nil {
+		t.Errorf("got profile %v and error %v for an assertion", profile, err)
+	}
+}
+
+func TestProfileTimesExcludeNestedSearches(t *testing.T) {
+	db := NewMemDatabase()
+	query, err := LoadProgram(db, ProgramSpec{Kind: "graph", Size: 200})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, profile, err := ApplyProfiled(context.Background(), query, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A predicate's time includes that of its rules, but none of them
+	// include the time of the subgoals they search, so none overlap.
+	var predicates, rules time.Duration
+	for _, p := range profile.Predicates {
+		predicates += p.Time
+		if p.Predicate == "reachable/2" {
+			for _, c := range profile.Clauses {
+				rules += c.Time
+			}
+			if rules > p.Time {
+				t.Errorf("reachable/2 took %v, but its rules %v", p.Time, rules)
+			}
+		}
+	}
+	if predicates > profile.Time {
+		t.Errorf("the query took %v, but its predicates %v", profile.Time, predicates)
+	}
+}
@@ -0,0 +1,176 @@
+package gotalog
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Attribute is a named and typed argument of a predicate declared with a
+// .decl directive.
+type Attribute struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// attributeTypes maps the name of each type an attribute may have to a check
+// of whether a constant is of that type.
+var attributeTypes = map[string]func(value string) bool{
+	"symbol": func(string) bool { return true },
+	"number": func(value string) bool {
+		_, err := strconv.ParseInt(value, 10, 64)
+		return err == nil
+	},
+}
+
+// schema records a database's predicate declarations. Disk logs persist
+// them along with the clauses.
+type schema struct {
+	m     sync.RWMutex
+	decls map[string][]Attribute
+	// strict rejects predicates that have not been declared.
+	strict bool
+}
+
+func newSchema() *schema {
+	return &schema{decls: make(map[string][]Attribute)}
+}
+
+// SetStrict sets whether db rejects assertions and queries using predicates
+// that have not been declared with a .decl directive.
+func SetStrict(db Database, strict bool) {
+	s := db.schema()
+	s.m.Lock()
+	s.strict = strict
+	s.m.Unlock()
+}
+
+// addDeclaration records the attributes of the predicate name, checking the
+// clauses already in db against them, and reports whether name was not
+// already declared. A predicate may only be declared again with the same
+// attributes.
+func addDeclaration(db Database, name string, attrs []Attribute) (bool, error) {
+	for _, a := range attrs {
+		if _, ok := attributeTypes[a.Type]; !ok {
+			return false, fmt.Errorf(".decl %v: unknown type %v", name, a.Type)
+		}
+	}
+	s := db.schema()
+	s.m.Lock()
+	defer s.m.Unlock()
+	if existing, ok := s.decls[name]; ok {
+		if !sameAttributes(existing, attrs) {
+			return false, fmt.Errorf("%v is already declared as %v", name, formatDeclaration(name, existing))
+		}
+		return false, nil
+	}
+	for _, c := range db.allClauses() {
+		for _, l := range append([]literal{c.head}, c.body...) {
+			if l.pred.Name != name {
+				continue
+			}
+			err := checkAttributes(l, attrs)
+			if err != nil {
+				return false, fmt.Errorf("cannot declare %v: %v", formatDeclaration(name, attrs), err)
+			}
+		}
+	}
+	s.decls[name] = attrs
+	return true, nil
+}
+
+// removeDeclaration forgets the declaration of the predicate name.
+func removeDeclaration(db Database, name string) {
+	s := db.schema()
+	s.m.Lock()
+	delete(s.decls, name)
+	s.m.Unlock()
+}
+
+// declarationCommands returns a .decl directive for each declaration in db,
+// ordered by predicate name.
+func declarationCommands(db Database) []DatalogCommand {
+	s := db.schema()
+	s.m.RLock()
+	defer s.m.RUnlock()
+	names := make([]string, 0, len(s.decls))
+	for name := range s.decls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	cmds := make([]DatalogCommand, len(names))
+	for i, name := range names {
+		cmds[i] = declarationCommand(name, s.decls[name])
+	}
+	return cmds
+}
+
+func declarationCommand(name string, attrs []Attribute) DatalogCommand {
+	return DatalogCommand{
+		CommandType: Directive,
+		Directive:   &DirectiveDefinition{Name: "decl", Predicate: name, Attributes: attrs},
+	}
+}
+
+func sameAttributes(a, b []Attribute) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkClause checks each literal of c against the declarations of db.
+func checkClause(db Database, c *clause) error {
+	err := checkLiteral(db, c.head)
+	if err != nil {
+		return err
+	}
+	for _, l := range c.body {
+		err = checkLiteral(db, l)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkLiteral checks that l has the arity declared for its predicate, and
+// that its constants have the declared types. In strict mode, its predicate
+// must have been declared.
+func checkLiteral(db Database, l literal) error {
+	s := db.schema()
+	s.m.RLock()
+	attrs, ok := s.decls[l.pred.Name]
+	strict := s.strict
+	s.m.RUnlock()
+	if !ok {
+		if strict {
+			return fmt.Errorf("%v is not declared", l.pred.id)
+		}
+		return nil
+	}
+	return checkAttributes(l, attrs)
+}
+
+func checkAttributes(l literal, attrs []Attribute) error {
+	if len(l.terms) != len(attrs) {
+		return fmt.Errorf("%v does not match its declaration %v", l.pred.id, formatDeclaration(l.pred.Name, attrs))
+	}
+	for i, t := range l.terms {
+		if t.isConstant && !attributeTypes[attrs[i].Type](t.value) {
+			return fmt.Errorf("%v of %v must be a %v, but got %v", attrs[i].Name, l.pred.Name, attrs[i].Type, formatTerm(t))
+		}
+	}
+	return nil
+}
+
+// formatDeclaration returns the .decl directive declaring attrs for name.
+func formatDeclaration(name string, attrs []Attribute) string {
+	return declarationCommand(name, attrs).String()
+}
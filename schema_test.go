@@ -0,0 +1,153 @@
+package gotalog
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestDeclarations(t *testing.T) {
+	db := NewMemDatabase()
+	_, err := ApplyProgram(mustParse(t, `edge(a, 1).
+.decl edge(from: symbol, to: number)
+.decl edge(from: symbol, to: number)
+edge(b, 2).
+path(X, Y) :- edge(X, Y).`), db, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		prog string
+		err  string
+	}{
+		{"edge(1, 2, 3).", "edge/3 does not match its declaration .decl edge(from: symbol, to: number)"},
+		{"edge(c, d).", "to of edge must be a number, but got d"},
+		{"edge(X, '-5') :- path(X, Y).", ""},
+		{"bad(X) :- edge(X, x).", "to of edge must be a number, but got x"},
+		{"edge(a, 'one')?", "to of edge must be a number, but got one"},
+		{"edge(X)?", "edge/1 does not match"},
+		{"edge(X, 1)?", ""},
+		{".decl edge(from: symbol)", "edge is already declared as .decl edge(from: symbol, to: number)"},
+		{".decl path(from: symbol)", "cannot declare .decl path(from: symbol): path/2 does not match"},
+	} {
+		_, err := ApplyProgram(mustParse(t, c.prog), db, ".")
+		if c.err == "" && err != nil {
+			t.Errorf("%v: %v", c.prog, err)
+		}
+		if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("%v: got error %v, expected %v", c.prog, err, c.err)
+		}
+	}
+
+	_, err = ImportCSV(strings.NewReader("c,3\nd,four\n"), db, "edge", CSVOptions{})
+	if err == nil || err.Error() != "line 2: to of edge must be a number, but got four" {
+		t.Errorf("got %v importing a badly typed fact", err)
+	}
+}
+
+func TestStrictMode(t *testing.T) {
+	db := NewLockingDatabase()
+	SetStrict(db, true)
+	_, err := ApplyProgram(mustParse(t, ".decl edge(from: symbol, to: symbol)\nedge(a, b).\nedge(X, Y)?"), db, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, prog := range []string{"node(a).", "path(X, Y) :- edge(X, Y).", "path(X, Y)?"} {
+		_, err = ApplyAll(mustParse(t, prog), db)
+		if err == nil || !strings.Contains(err.Error(), "is not declared") {
+			t.Errorf("%v: got %v, expected an undeclared predicate error", prog, err)
+		}
+	}
+}
+
+func TestDeclarationSyntax(t *testing.T) {
+	prog := ".decl edge(from: symbol, to: number)\n.decl flag\n"
+	cmds := mustParse(t, prog)
+	var b strings.Builder
+	for _, cmd := range cmds {
+		b.WriteString(cmd.String() + "\n")
+	}
+	if b.String() != prog {
+		t.Errorf("got %q, expected %q", b.String(), prog)
+	}
+	for _, bad := range []string{".decl edge(from: string)", ".decl edge(from)", ".decl edge(from: symbol"} {
+		_, err := Parse(strings.NewReader(bad))
+		if err == nil {
+			t.Errorf("%v: expected a syntax error", bad)
+		}
+	}
+}
+
+// checkStrictDeclarations checks that db, in strict mode, still holds the
+// declarations made by declarationProgram.
+func checkStrictDeclarations(t *testing.T, name string, db Database) {
+	SetStrict(db, true)
+	for _, c := range []struct {
+		prog string
+		err  string
+	}{
+		{"edge(b, 2).", ""},
+		{"flag.", ""},
+		{"edge(X, 1)?", ""},
+		{"edge(b, c).", "to of edge must be a number, but got c"},
+		{"node(a).", "is not declared"},
+	} {
+		_, err := ApplyAll(mustParse(t, c.prog), db)
+		if c.err == "" && err != nil {
+			t.Errorf("%v: %v: %v", name, c.prog, err)
+		}
+		if c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)) {
+			t.Errorf("%v: %v: got error %v, expected %v", name, c.prog, err, c.err)
+		}
+	}
+}
+
+const declarationProgram = `edge(a, 1).
+.decl edge(from: symbol, to: number)
+.decl flag
+`
+
+func TestDeclarationsSurviveReopening(t *testing.T) {
+	for _, format := range []LogFormat{TextLog, BinaryLog} {
+		var log bytes.Buffer
+		db, err := NewDiskLogDBWithOptions(&log, NewMemDatabase(), DiskLogOptions{Format: format})
+		panicOnError(err)
+		_, err = ApplyProgram(mustParse(t, declarationProgram), db, ".")
+		panicOnError(err)
+		size := log.Len()
+		// Declaring a predicate again as before writes nothing.
+		_, err = ApplyProgram(mustParse(t, ".decl flag"), db, ".")
+		panicOnError(err)
+		if log.Len() != size {
+			t.Errorf("format %v: repeating a declaration grew the log", format)
+		}
+
+		db, err = NewDiskLogDB(bytes.NewBuffer(log.Bytes()), NewMemDatabase())
+		if err != nil {
+			t.Fatalf("format %v: %v", format, err)
+		}
+		checkStrictDeclarations(t, fmt.Sprintf("format %v", format), db)
+	}
+
+	// Snapshots keep declarations, wherever they fall among the clauses.
+	for _, every := range []int{0, 1, 2, 3} {
+		dir, err := ioutil.TempDir("", "logdirDeclarations")
+		panicOnError(err)
+		defer os.RemoveAll(dir)
+		opts := DiskLogOptions{SnapshotEvery: every}
+		db, err := OpenDiskLogDir(dir, NewMemDatabase(), opts)
+		panicOnError(err)
+		_, err = ApplyProgram(mustParse(t, declarationProgram), db, ".")
+		panicOnError(err)
+		panicOnError(db.Close())
+
+		db, err = OpenDiskLogDir(dir, NewMemDatabase(), opts)
+		panicOnError(err)
+		checkStrictDeclarations(t, fmt.Sprintf("snapshot every %v", every), db)
+		panicOnError(db.Close())
+	}
+}
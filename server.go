@@ -0,0 +1,176 @@
+package gotalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+)
+
+// maxRequestBytes bounds the size of a request body accepted by the handler.
+const maxRequestBytes = 10 << 20
+
+// serverResponse is the JSON body of every response from the handler.
+type serverResponse struct {
+	Results   []Result `json:"results,omitempty"`
+	Asserted  int      `json:"asserted,omitempty"`
+	Retracted int      `json:"retracted,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
+
+type handler struct {
+	db      Database
+	timeout time.Duration
+}
+
+// NewHandler returns an http.Handler exposing db at three endpoints, /assert,
+// /retract and /query. Each accepts a POST whose body is either datalog text
+// or, with a Content-Type of application/json, a JSON DatalogCommand or array
+// of them. Either must only hold commands of the endpoint's kind. /query also
+// accepts a GET with the query in the q parameter.
+//
+// Commands are applied in order, stopping at the first error. Responses are
+// JSON objects holding the results of queries, the number of clauses asserted
+// and retracted, and any error. If timeout is positive, requests taking longer
+// are abandoned with status 503.
+//
+// db must be safe for concurrent use, such as one from NewLockingDatabase.
+func NewHandler(db Database, timeout time.Duration) http.Handler {
+	h := &handler{db: db, timeout: timeout}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/assert", h.endpoint(Assert))
+	mux.HandleFunc("/retract", h.endpoint(Retract))
+	mux.HandleFunc("/query", h.endpoint(Query))
+	return mux
+}
+
+func (h *handler) endpoint(kind CommandType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cmds, err := readCommands(r, kind)
+		if err != nil {
+			status := http.StatusBadRequest
+			if err == errMethodNotAllowed {
+				status = http.StatusMethodNotAllowed
+			}
+			writeResponse(w, status, serverResponse{Error: err.Error()})
+			return
+		}
+
+		ctx := r.Context()
+		if h.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, h.timeout)
+			defer cancel()
+		}
+
+		var resp serverResponse
+		for _, cmd := range cmds {
+			if err = ctx.Err(); err != nil {
+				break
+			}
+			var res *Result
+			res, err = ApplyContext(ctx, cmd, h.db)
+			if err != nil {
+				break
+			}
+			switch cmd.CommandType {
+			case Assert:
+				resp.Asserted++
+			case Retract:
+				resp.Retracted += res.Retracted
+			case Query:
+				resp.Results = append(resp.Results, *res)
+			}
+		}
+
+		status := http.StatusOK
+		switch {
+		case err == context.DeadlineExceeded:
+			status = http.StatusServiceUnavailable
+			err = fmt.Errorf("request timed out after %v", h.timeout)
+		case err == context.Canceled:
+			// The client has gone away.
+			return
+		case err != nil:
+			status = http.StatusUnprocessableEntity
+		}
+		if err != nil {
+			resp.Error = err.Error()
+		}
+		writeResponse(w, status, resp)
+	}
+}
+
+var errMethodNotAllowed = fmt.Errorf("method not allowed")
+
+// readCommands extracts the commands of a request to the endpoint for kind.
+func readCommands(r *http.Request, kind CommandType) ([]DatalogCommand, error) {
+	if r.Method == http.MethodGet && kind == Query {
+		q := r.URL.Query().Get("q")
+		if q == "" {
+			return nil, fmt.Errorf("missing query parameter q")
+		}
+		return parseCommands(bytes.NewBufferString(q), kind)
+	}
+	if r.Method != http.MethodPost {
+		return nil, errMethodNotAllowed
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxRequestBytes {
+		return nil, fmt.Errorf("request body exceeds %v bytes", maxRequestBytes)
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "application/json" {
+		return parseCommands(bytes.NewBuffer(body), kind)
+	}
+
+	var cmds []DatalogCommand
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(body, &cmds)
+	} else {
+		var cmd DatalogCommand
+		err = json.Unmarshal(body, &cmd)
+		cmds = []DatalogCommand{cmd}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cmds, checkCommandTypes(cmds, kind)
+}
+
+func parseCommands(r io.Reader, kind CommandType) ([]DatalogCommand, error) {
+	cmds, err := Parse(r)
+	if err != nil {
+		return nil, err
+	}
+	return cmds, checkCommandTypes(cmds, kind)
+}
+
+func checkCommandTypes(cmds []DatalogCommand, kind CommandType) error {
+	for _, cmd := range cmds {
+		if cmd.CommandType != kind {
+			return fmt.Errorf("%v is not a %v", cmd, commandNames[kind])
+		}
+	}
+	return nil
+}
+
+var commandNames = map[CommandType]string{
+	Assert:  "assertion",
+	Query:   "query",
+	Retract: "retraction",
+}
+
+func writeResponse(w http.ResponseWriter, status int, resp serverResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,124 @@
+package gotalog
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func serverRequest(t *testing.T, h http.Handler, method, path, contentType, body string) (int, serverResponse) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var resp serverResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &resp)
+	if err != nil {
+		t.Fatalf("%v %v: invalid response %q: %v", method, path, rec.Body.String(), err)
+	}
+	return rec.Code, resp
+}
+
+func TestServer(t *testing.T) {
+	h := NewHandler(NewLockingDatabase(), time.Minute)
+
+	code, resp := serverRequest(t, h, "POST", "/assert", "text/plain",
+		"parent(alice, bob). parent(bob, charlie). ancestor(A, B) :- parent(A, B). ancestor(A, C) :- parent(A, B), ancestor(B, C).")
+	if code != http.StatusOK || resp.Asserted != 4 {
+		t.Errorf("assert: got %v %+v", code, resp)
+	}
+
+	code, resp = serverRequest(t, h, "POST", "/query", "text/plain", "ancestor(alice, X)?")
+	if code != http.StatusOK || len(resp.Results) != 1 {
+		t.Fatalf("query: got %v %+v", code, resp)
+	}
+	compareDatalogResult(t, ToString(resp.Results), "ancestor(alice, bob).\nancestor(alice, charlie).\n")
+
+	code, resp = serverRequest(t, h, "GET", "/query?q="+url.QueryEscape("parent(X, charlie)?"), "", "")
+	if code != http.StatusOK || len(resp.Results) != 1 {
+		t.Fatalf("GET query: got %v %+v", code, resp)
+	}
+	compareDatalogResult(t, ToString(resp.Results), "parent(bob, charlie).\n")
+
+	code, resp = serverRequest(t, h, "POST", "/retract", "application/json",
+		`{"type": "retract", "head": {"predicate": "parent", "terms": [{"variable": "X"}, {"constant": "charlie"}]}}`)
+	if code != http.StatusOK || resp.Retracted != 1 {
+		t.Errorf("JSON retract: got %v %+v", code, resp)
+	}
+	code, resp = serverRequest(t, h, "POST", "/query", "application/json",
+		`[{"type": "query", "head": {"predicate": "ancestor", "terms": [{"constant": "alice"}, {"variable": "X"}]}}]`)
+	if code != http.StatusOK || len(resp.Results) != 1 {
+		t.Fatalf("JSON query: got %v %+v", code, resp)
+	}
+	compareDatalogResult(t, ToString(resp.Results), "ancestor(alice, bob).\n")
+}
+
+func TestServerErrors(t *testing.T) {
+	h := NewHandler(NewLockingDatabase(), time.Minute)
+	cases := []struct {
+		method, path, contentType, body string
+		code                            int
+	}{
+		{"POST", "/query", "text/plain", "parent(alice, bob).", http.StatusBadRequest},
+		{"POST", "/assert", "text/plain", "parent(alice, bob", http.StatusBadRequest},
+		{"POST", "/assert", "application/json", `{"type": "assert", "head": {"predicate": "p", "terms": [{}]}}`, http.StatusBadRequest},
+		{"POST", "/assert", "application/json", `{"type": "query", "head": {"predicate": "p"}}`, http.StatusBadRequest},
+		{"GET", "/assert", "", "", http.StatusMethodNotAllowed},
+		{"GET", "/query", "", "", http.StatusBadRequest},
+		{"POST", "/assert", "text/plain", "unsafe(X) :- other(Y).", http.StatusUnprocessableEntity},
+	}
+	for _, c := range cases {
+		code, resp := serverRequest(t, h, c.method, c.path, c.contentType, c.body)
+		if code != c.code || resp.Error == "" {
+			t.Errorf("%v %v %q: got %v %+v, expected status %v and an error", c.method, c.path, c.body, code, resp, c.code)
+		}
+	}
+}
+
+func TestServerTimeout(t *testing.T) {
+	db := NewLockingDatabase()
+	err := checkFile("tests/clique100.pl", func() Database { return db })
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewHandler(db, time.Nanosecond)
+	code, resp := serverRequest(t, h, "POST", "/query", "text/plain", "reachable(X, Y)?")
+	if code != http.StatusServiceUnavailable || resp.Error == "" {
+		t.Errorf("got %v %+v, expected a timeout", code, resp)
+	}
+}
+
+func TestServerConcurrentAssertRetract(t *testing.T) {
+	h := NewHandler(NewLockingDatabase(), time.Minute)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				serverRequest(t, h, "POST", "/assert", "text/plain", "edge(a, b).")
+				serverRequest(t, h, "POST", "/retract", "text/plain", "edge(a, b)~")
+				serverRequest(t, h, "POST", "/query", "text/plain", "edge(X, Y)?")
+			}
+		}()
+	}
+	wg.Wait()
+
+	code, resp := serverRequest(t, h, "POST", "/assert", "text/plain", "edge(a, b).")
+	if code != http.StatusOK || resp.Asserted != 1 {
+		t.Fatalf("assert: got %v %+v", code, resp)
+	}
+	code, resp = serverRequest(t, h, "POST", "/query", "text/plain", "edge(X, Y)?")
+	if code != http.StatusOK || len(resp.Results) != 1 {
+		t.Fatalf("query: got %v %+v", code, resp)
+	}
+	compareDatalogResult(t, ToString(resp.Results), "edge(a, b).\n")
+}
@@ -1,2006 +0,0 @@
-% generate problem of size 2000
-reachable(X,Y) :- edge(X,Y).
-reachable(X,Y) :- edge(X,Z), reachable(Z,Y).
-increasing(X,Y) :- edge(X,Y), lt(X,Y).
-increasing(X,Y) :- edge(X,Z), lt(X,Z), increasing(Z,Y).
-edge(0, 1).
-edge(1, 2).
-edge(2, 3).
-edge(3, 4).
-edge(4, 5).
-edge(5, 6).
-edge(6, 7).
-edge(7, 8).
-edge(8, 9).
-edge(9, 10).
-edge(10, 11).
-edge(11, 12).
-edge(12, 13).
-edge(13, 14).
-edge(14, 15).
-edge(15, 16).
-edge(16, 17).
-edge(17, 18).
-edge(18, 19).
-edge(19, 20).
-edge(20, 21).
-edge(21, 22).
-edge(22, 23).
-edge(23, 24).
-edge(24, 25).
-edge(25, 26).
-edge(26, 27).
-edge(27, 28).
-edge(28, 29).
-edge(29, 30).
-edge(30, 31).
-edge(31, 32).
-edge(32, 33).
-edge(33, 34).
-edge(34, 35).
-edge(35, 36).
-edge(36, 37).
-edge(37, 38).
-edge(38, 39).
-edge(39, 40).
-edge(40, 41).
-edge(41, 42).
-edge(42, 43).
-edge(43, 44).
-edge(44, 45).
-edge(45, 46).
-edge(46, 47).
-edge(47, 48).
-edge(48, 49).
-edge(49, 50).
-edge(50, 51).
-edge(51, 52).
-edge(52, 53).
-edge(53, 54).
-edge(54, 55).
-edge(55, 56).
-edge(56, 57).
-edge(57, 58).
-edge(58, 59).
-edge(59, 60).
-edge(60, 61).
-edge(61, 62).
-edge(62, 63).
-edge(63, 64).
-edge(64, 65).
-edge(65, 66).
-edge(66, 67).
-edge(67, 68).
-edge(68, 69).
-edge(69, 70).
-edge(70, 71).
-edge(71, 72).
-edge(72, 73).
-edge(73, 74).
-edge(74, 75).
-edge(75, 76).
-edge(76, 77).
-edge(77, 78).
-edge(78, 79).
-edge(79, 80).
-edge(80, 81).
-edge(81, 82).
-edge(82, 83).
-edge(83, 84).
-edge(84, 85).
-edge(85, 86).
-edge(86, 87).
-edge(87, 88).
-edge(88, 89).
-edge(89, 90).
-edge(90, 91).
-edge(91, 92).
-edge(92, 93).
-edge(93, 94).
-edge(94, 95).
-edge(95, 96).
-edge(96, 97).
-edge(97, 98).
-edge(98, 99).
-edge(99, 100).
-edge(100, 101).
-edge(101, 102).
-edge(102, 103).
-edge(103, 104).
-edge(104, 105).
-edge(105, 106).
-edge(106, 107).
-edge(107, 108).
-edge(108, 109).
-edge(109, 110).
-edge(110, 111).
-edge(111, 112).
-edge(112, 113).
-edge(113, 114).
-edge(114, 115).
-edge(115, 116).
-edge(116, 117).
-edge(117, 118).
-edge(118, 119).
-edge(119, 120).
-edge(120, 121).
-edge(121, 122).
-edge(122, 123).
-edge(123, 124).
-edge(124, 125).
-edge(125, 126).
-edge(126, 127).
-edge(127, 128).
-edge(128, 129).
-edge(129, 130).
-edge(130, 131).
-edge(131, 132).
-edge(132, 133).
-edge(133, 134).
-edge(134, 135).
-edge(135, 136).
-edge(136, 137).
-edge(137, 138).
-edge(138, 139).
-edge(139, 140).
-edge(140, 141).
-edge(141, 142).
-edge(142, 143).
-edge(143, 144).
-edge(144, 145).
-edge(145, 146).
-edge(146, 147).
-edge(147, 148).
-edge(148, 149).
-edge(149, 150).
-edge(150, 151).
-edge(151, 152).
-edge(152, 153).
-edge(153, 154).
-edge(154, 155).
-edge(155, 156).
-edge(156, 157).
-edge(157, 158).
-edge(158, 159).
-edge(159, 160).
-edge(160, 161).
-edge(161, 162).
-edge(162, 163).
-edge(163, 164).
-edge(164, 165).
-edge(165, 166).
-edge(166, 167).
-edge(167, 168).
-edge(168, 169).
-edge(169, 170).
-edge(170, 171).
-edge(171, 172).
-edge(172, 173).
-edge(173, 174).
-edge(174, 175).
-edge(175, 176).
-edge(176, 177).
-edge(177, 178).
-edge(178, 179).
-edge(179, 180).
-edge(180, 181).
-edge(181, 182).
-edge(182, 183).
-edge(183, 184).
-edge(184, 185).
-edge(185, 186).
-edge(186, 187).
-edge(187, 188).
-edge(188, 189).
-edge(189, 190).
-edge(190, 191).
-edge(191, 192).
-edge(192, 193).
-edge(193, 194).
-edge(194, 195).
-edge(195, 196).
-edge(196, 197).
-edge(197, 198).
-edge(198, 199).
-edge(199, 200).
-edge(200, 201).
-edge(201, 202).
-edge(202, 203).
-edge(203, 204).
-edge(204, 205).
-edge(205, 206).
-edge(206, 207).
-edge(207, 208).
-edge(208, 209).
-edge(209, 210).
-edge(210, 211).
-edge(211, 212).
-edge(212, 213).
-edge(213, 214).
-edge(214, 215).
-edge(215, 216).
-edge(216, 217).
-edge(217, 218).
-edge(218, 219).
-edge(219, 220).
-edge(220, 221).
-edge(221, 222).
-edge(222, 223).
-edge(223, 224).
-edge(224, 225).
-edge(225, 226).
-edge(226, 227).
-edge(227, 228).
-edge(228, 229).
-edge(229, 230).
-edge(230, 231).
-edge(231, 232).
-edge(232, 233).
-edge(233, 234).
-edge(234, 235).
-edge(235, 236).
-edge(236, 237).
-edge(237, 238).
-edge(238, 239).
-edge(239, 240).
-edge(240, 241).
-edge(241, 242).
-edge(242, 243).
-edge(243, 244).
-edge(244, 245).
-edge(245, 246).
-edge(246, 247).
-edge(247, 248).
-edge(248, 249).
-edge(249, 250).
-edge(250, 251).
-edge(251, 252).
-edge(252, 253).
-edge(253, 254).
-edge(254, 255).
-edge(255, 256).
-edge(256, 257).
-edge(257, 258).
-edge(258, 259).
-edge(259, 260).
-edge(260, 261).
-edge(261, 262).
-edge(262, 263).
-edge(263, 264).
-edge(264, 265).
-edge(265, 266).
-edge(266, 267).
-edge(267, 268).
-edge(268, 269).
-edge(269, 270).
-edge(270, 271).
-edge(271, 272).
-edge(272, 273).
-edge(273, 274).
-edge(274, 275).
-edge(275, 276).
-edge(276, 277).
-edge(277, 278).
-edge(278, 279).
-edge(279, 280).
-edge(280, 281).
-edge(281, 282).
-edge(282, 283).
-edge(283, 284).
-edge(284, 285).
-edge(285, 286).
-edge(286, 287).
-edge(287, 288).
-edge(288, 289).
-edge(289, 290).
-edge(290, 291).
-edge(291, 292).
-edge(292, 293).
-edge(293, 294).
-edge(294, 295).
-edge(295, 296).
-edge(296, 297).
-edge(297, 298).
-edge(298, 299).
-edge(299, 300).
-edge(300, 301).
-edge(301, 302).
-edge(302, 303).
-edge(303, 304).
-edge(304, 305).
-edge(305, 306).
-edge(306, 307).
-edge(307, 308).
-edge(308, 309).
-edge(309, 310).
-edge(310, 311).
-edge(311, 312).
-edge(312, 313).
-edge(313, 314).
-edge(314, 315).
-edge(315, 316).
-edge(316, 317).
-edge(317, 318).
-edge(318, 319).
-edge(319, 320).
-edge(320, 321).
-edge(321, 322).
-edge(322, 323).
-edge(323, 324).
-edge(324, 325).
-edge(325, 326).
-edge(326, 327).
-edge(327, 328).
-edge(328, 329).
-edge(329, 330).
-edge(330, 331).
-edge(331, 332).
-edge(332, 333).
-edge(333, 334).
-edge(334, 335).
-edge(335, 336).
-edge(336, 337).
-edge(337, 338).
-edge(338, 339).
-edge(339, 340).
-edge(340, 341).
-edge(341, 342).
-edge(342, 343).
-edge(343, 344).
-edge(344, 345).
-edge(345, 346).
-edge(346, 347).
-edge(347, 348).
-edge(348, 349).
-edge(349, 350).
-edge(350, 351).
-edge(351, 352).
-edge(352, 353).
-edge(353, 354).
-edge(354, 355).
-edge(355, 356).
-edge(356, 357).
-edge(357, 358).
-edge(358, 359).
-edge(359, 360).
-edge(360, 361).
-edge(361, 362).
-edge(362, 363).
-edge(363, 364).
-edge(364, 365).
-edge(365, 366).
-edge(366, 367).
-edge(367, 368).
-edge(368, 369).
-edge(369, 370).
-edge(370, 371).
-edge(371, 372).
-edge(372, 373).
-edge(373, 374).
-edge(374, 375).
-edge(375, 376).
-edge(376, 377).
-edge(377, 378).
-edge(378, 379).
-edge(379, 380).
-edge(380, 381).
-edge(381, 382).
-edge(382, 383).
-edge(383, 384).
-edge(384, 385).
-edge(385, 386).
-edge(386, 387).
-edge(387, 388).
-edge(388, 389).
-edge(389, 390).
-edge(390, 391).
-edge(391, 392).
-edge(392, 393).
-edge(393, 394).
-edge(394, 395).
-edge(395, 396).
-edge(396, 397).
-edge(397, 398).
-edge(398, 399).
-edge(399, 400).
-edge(400, 401).
-edge(401, 402).
-edge(402, 403).
-edge(403, 404).
-edge(404, 405).
-edge(405, 406).
-edge(406, 407).
-edge(407, 408).
-edge(408, 409).
-edge(409, 410).
-edge(410, 411).
-edge(411, 412).
-edge(412, 413).
-edge(413, 414).
-edge(414, 415).
-edge(415, 416).
-edge(416, 417).
-edge(417, 418).
-edge(418, 419).
-edge(419, 420).
-edge(420, 421).
-edge(421, 422).
-edge(422, 423).
-edge(423, 424).
-edge(424, 425).
-edge(425, 426).
-edge(426, 427).
-edge(427, 428).
-edge(428, 429).
-edge(429, 430).
-edge(430, 431).
-edge(431, 432).
-edge(432, 433).
-edge(433, 434).
-edge(434, 435).
-edge(435, 436).
-edge(436, 437).
-edge(437, 438).
-edge(438, 439).
-edge(439, 440).
-edge(440, 441).
-edge(441, 442).
-edge(442, 443).
-edge(443, 444).
-edge(444, 445).
-edge(445, 446).
-edge(446, 447).
-edge(447, 448).
-edge(448, 449).
-edge(449, 450).
-edge(450, 451).
-edge(451, 452).
-edge(452, 453).
-edge(453, 454).
-edge(454, 455).
-edge(455, 456).
-edge(456, 457).
-edge(457, 458).
-edge(458, 459).
-edge(459, 460).
-edge(460, 461).
-edge(461, 462).
-edge(462, 463).
-edge(463, 464).
-edge(464, 465).
-edge(465, 466).
-edge(466, 467).
-edge(467, 468).
-edge(468, 469).
-edge(469, 470).
-edge(470, 471).
-edge(471, 472).
-edge(472, 473).
-edge(473, 474).
-edge(474, 475).
-edge(475, 476).
-edge(476, 477).
-edge(477, 478).
-edge(478, 479).
-edge(479, 480).
-edge(480, 481).
-edge(481, 482).
-edge(482, 483).
-edge(483, 484).
-edge(484, 485).
-edge(485, 486).
-edge(486, 487).
-edge(487, 488).
-edge(488, 489).
-edge(489, 490).
-edge(490, 491).
-edge(491, 492).
-edge(492, 493).
-edge(493, 494).
-edge(494, 495).
-edge(495, 496).
-edge(496, 497).
-edge(497, 498).
-edge(498, 499).
-edge(499, 500).
-edge(500, 501).
-edge(501, 502).
-edge(502, 503).
-edge(503, 504).
-edge(504, 505).
-edge(505, 506).
-edge(506, 507).
-edge(507, 508).
-edge(508, 509).
-edge(509, 510).
-edge(510, 511).
-edge(511, 512).
-edge(512, 513).
-edge(513, 514).
-edge(514, 515).
-edge(515, 516).
-edge(516, 517).
-edge(517, 518).
-edge(518, 519).
-edge(519, 520).
-edge(520, 521).
-edge(521, 522).
-edge(522, 523).
-edge(523, 524).
-edge(524, 525).
-edge(525, 526).
-edge(526, 527).
-edge(527, 528).
-edge(528, 529).
-edge(529, 530).
-edge(530, 531).
-edge(531, 532).
-edge(532, 533).
-edge(533, 534).
-edge(534, 535).
-edge(535, 536).
-edge(536, 537).
-edge(537, 538).
-edge(538, 539).
-edge(539, 540).
-edge(540, 541).
-edge(541, 542).
-edge(542, 543).
-edge(543, 544).
-edge(544, 545).
-edge(545, 546).
-edge(546, 547).
-edge(547, 548).
-edge(548, 549).
-edge(549, 550).
-edge(550, 551).
-edge(551, 552).
-edge(552, 553).
-edge(553, 554).
-edge(554, 555).
-edge(555, 556).
-edge(556, 557).
-edge(557, 558).
-edge(558, 559).
-edge(559, 560).
-edge(560, 561).
-edge(561, 562).
-edge(562, 563).
-edge(563, 564).
-edge(564, 565).
-edge(565, 566).
-edge(566, 567).
-edge(567, 568).
-edge(568, 569).
-edge(569, 570).
-edge(570, 571).
-edge(571, 572).
-edge(572, 573).
-edge(573, 574).
-edge(574, 575).
-edge(575, 576).
-edge(576, 577).
-edge(577, 578).
-edge(578, 579).
-edge(579, 580).
-edge(580, 581).
-edge(581, 582).
-edge(582, 583).
-edge(583, 584).
-edge(584, 585).
-edge(585, 586).
-edge(586, 587).
-edge(587, 588).
-edge(588, 589).
-edge(589, 590).
-edge(590, 591).
-edge(591, 592).
-edge(592, 593).
-edge(593, 594).
-edge(594, 595).
-edge(595, 596).
-edge(596, 597).
-edge(597, 598).
-edge(598, 599).
-edge(599, 600).
-edge(600, 601).
-edge(601, 602).
-edge(602, 603).
-edge(603, 604).
-edge(604, 605).
-edge(605, 606).
-edge(606, 607).
-edge(607, 608).
-edge(608, 609).
-edge(609, 610).
-edge(610, 611).
-edge(611, 612).
-edge(612, 613).
-edge(613, 614).
-edge(614, 615).
-edge(615, 616).
-edge(616, 617).
-edge(617, 618).
-edge(618, 619).
-edge(619, 620).
-edge(620, 621).
-edge(621, 622).
-edge(622, 623).
-edge(623, 624).
-edge(624, 625).
-edge(625, 626).
-edge(626, 627).
-edge(627, 628).
-edge(628, 629).
-edge(629, 630).
-edge(630, 631).
-edge(631, 632).
-edge(632, 633).
-edge(633, 634).
-edge(634, 635).
-edge(635, 636).
-edge(636, 637).
-edge(637, 638).
-edge(638, 639).
-edge(639, 640).
-edge(640, 641).
-edge(641, 642).
-edge(642, 643).
-edge(643, 644).
-edge(644, 645).
-edge(645, 646).
-edge(646, 647).
-edge(647, 648).
-edge(648, 649).
-edge(649, 650).
-edge(650, 651).
-edge(651, 652).
-edge(652, 653).
-edge(653, 654).
-edge(654, 655).
-edge(655, 656).
-edge(656, 657).
-edge(657, 658).
-edge(658, 659).
-edge(659, 660).
-edge(660, 661).
-edge(661, 662).
-edge(662, 663).
-edge(663, 664).
-edge(664, 665).
-edge(665, 666).
-edge(666, 667).
-edge(667, 668).
-edge(668, 669).
-edge(669, 670).
-edge(670, 671).
-edge(671, 672).
-edge(672, 673).
-edge(673, 674).
-edge(674, 675).
-edge(675, 676).
-edge(676, 677).
-edge(677, 678).
-edge(678, 679).
-edge(679, 680).
-edge(680, 681).
-edge(681, 682).
-edge(682, 683).
-edge(683, 684).
-edge(684, 685).
-edge(685, 686).
-edge(686, 687).
-edge(687, 688).
-edge(688, 689).
-edge(689, 690).
-edge(690, 691).
-edge(691, 692).
-edge(692, 693).
-edge(693, 694).
-edge(694, 695).
-edge(695, 696).
-edge(696, 697).
-edge(697, 698).
-edge(698, 699).
-edge(699, 700).
-edge(700, 701).
-edge(701, 702).
-edge(702, 703).
-edge(703, 704).
-edge(704, 705).
-edge(705, 706).
-edge(706, 707).
-edge(707, 708).
-edge(708, 709).
-edge(709, 710).
-edge(710, 711).
-edge(711, 712).
-edge(712, 713).
-edge(713, 714).
-edge(714, 715).
-edge(715, 716).
-edge(716, 717).
-edge(717, 718).
-edge(718, 719).
-edge(719, 720).
-edge(720, 721).
-edge(721, 722).
-edge(722, 723).
-edge(723, 724).
-edge(724, 725).
-edge(725, 726).
-edge(726, 727).
-edge(727, 728).
-edge(728, 729).
-edge(729, 730).
-edge(730, 731).
-edge(731, 732).
-edge(732, 733).
-edge(733, 734).
-edge(734, 735).
-edge(735, 736).
-edge(736, 737).
-edge(737, 738).
-edge(738, 739).
-edge(739, 740).
-edge(740, 741).
-edge(741, 742).
-edge(742, 743).
-edge(743, 744).
-edge(744, 745).
-edge(745, 746).
-edge(746, 747).
-edge(747, 748).
-edge(748, 749).
-edge(749, 750).
-edge(750, 751).
-edge(751, 752).
-edge(752, 753).
-edge(753, 754).
-edge(754, 755).
-edge(755, 756).
-edge(756, 757).
-edge(757, 758).
-edge(758, 759).
-edge(759, 760).
-edge(760, 761).
-edge(761, 762).
-edge(762, 763).
-edge(763, 764).
-edge(764, 765).
-edge(765, 766).
-edge(766, 767).
-edge(767, 768).
-edge(768, 769).
-edge(769, 770).
-edge(770, 771).
-edge(771, 772).
-edge(772, 773).
-edge(773, 774).
-edge(774, 775).
-edge(775, 776).
-edge(776, 777).
-edge(777, 778).
-edge(778, 779).
-edge(779, 780).
-edge(780, 781).
-edge(781, 782).
-edge(782, 783).
-edge(783, 784).
-edge(784, 785).
-edge(785, 786).
-edge(786, 787).
-edge(787, 788).
-edge(788, 789).
-edge(789, 790).
-edge(790, 791).
-edge(791, 792).
-edge(792, 793).
-edge(793, 794).
-edge(794, 795).
-edge(795, 796).
-edge(796, 797).
-edge(797, 798).
-edge(798, 799).
-edge(799, 800).
-edge(800, 801).
-edge(801, 802).
-edge(802, 803).
-edge(803, 804).
-edge(804, 805).
-edge(805, 806).
-edge(806, 807).
-edge(807, 808).
-edge(808, 809).
-edge(809, 810).
-edge(810, 811).
-edge(811, 812).
-edge(812, 813).
-edge(813, 814).
-edge(814, 815).
-edge(815, 816).
-edge(816, 817).
-edge(817, 818).
-edge(818, 819).
-edge(819, 820).
-edge(820, 821).
-edge(821, 822).
-edge(822, 823).
-edge(823, 824).
-edge(824, 825).
-edge(825, 826).
-edge(826, 827).
-edge(827, 828).
-edge(828, 829).
-edge(829, 830).
-edge(830, 831).
-edge(831, 832).
-edge(832, 833).
-edge(833, 834).
-edge(834, 835).
-edge(835, 836).
-edge(836, 837).
-edge(837, 838).
-edge(838, 839).
-edge(839, 840).
-edge(840, 841).
-edge(841, 842).
-edge(842, 843).
-edge(843, 844).
-edge(844, 845).
-edge(845, 846).
-edge(846, 847).
-edge(847, 848).
-edge(848, 849).
-edge(849, 850).
-edge(850, 851).
-edge(851, 852).
-edge(852, 853).
-edge(853, 854).
-edge(854, 855).
-edge(855, 856).
-edge(856, 857).
-edge(857, 858).
-edge(858, 859).
-edge(859, 860).
-edge(860, 861).
-edge(861, 862).
-edge(862, 863).
-edge(863, 864).
-edge(864, 865).
-edge(865, 866).
-edge(866, 867).
-edge(867, 868).
-edge(868, 869).
-edge(869, 870).
-edge(870, 871).
-edge(871, 872).
-edge(872, 873).
-edge(873, 874).
-edge(874, 875).
-edge(875, 876).
-edge(876, 877).
-edge(877, 878).
-edge(878, 879).
-edge(879, 880).
-edge(880, 881).
-edge(881, 882).
-edge(882, 883).
-edge(883, 884).
-edge(884, 885).
-edge(885, 886).
-edge(886, 887).
-edge(887, 888).
-edge(888, 889).
-edge(889, 890).
-edge(890, 891).
-edge(891, 892).
-edge(892, 893).
-edge(893, 894).
-edge(894, 895).
-edge(895, 896).
-edge(896, 897).
-edge(897, 898).
-edge(898, 899).
-edge(899, 900).
-edge(900, 901).
-edge(901, 902).
-edge(902, 903).
-edge(903, 904).
-edge(904, 905).
-edge(905, 906).
-edge(906, 907).
-edge(907, 908).
-edge(908, 909).
-edge(909, 910).
-edge(910, 911).
-edge(911, 912).
-edge(912, 913).
-edge(913, 914).
-edge(914, 915).
-edge(915, 916).
-edge(916, 917).
-edge(917, 918).
-edge(918, 919).
-edge(919, 920).
-edge(920, 921).
-edge(921, 922).
-edge(922, 923).
-edge(923, 924).
-edge(924, 925).
-edge(925, 926).
-edge(926, 927).
-edge(927, 928).
-edge(928, 929).
-edge(929, 930).
-edge(930, 931).
-edge(931, 932).
-edge(932, 933).
-edge(933, 934).
-edge(934, 935).
-edge(935, 936).
-edge(936, 937).
-edge(937, 938).
-edge(938, 939).
-edge(939, 940).
-edge(940, 941).
-edge(941, 942).
-edge(942, 943).
-edge(943, 944).
-edge(944, 945).
-edge(945, 946).
-edge(946, 947).
-edge(947, 948).
-edge(948, 949).
-edge(949, 950).
-edge(950, 951).
-edge(951, 952).
-edge(952, 953).
-edge(953, 954).
-edge(954, 955).
-edge(955, 956).
-edge(956, 957).
-edge(957, 958).
-edge(958, 959).
-edge(959, 960).
-edge(960, 961).
-edge(961, 962).
-edge(962, 963).
-edge(963, 964).
-edge(964, 965).
-edge(965, 966).
-edge(966, 967).
-edge(967, 968).
-edge(968, 969).
-edge(969, 970).
-edge(970, 971).
-edge(971, 972).
-edge(972, 973).
-edge(973, 974).
-edge(974, 975).
-edge(975, 976).
-edge(976, 977).
-edge(977, 978).
-edge(978, 979).
-edge(979, 980).
-edge(980, 981).
-edge(981, 982).
-edge(982, 983).
-edge(983, 984).
-edge(984, 985).
-edge(985, 986).
-edge(986, 987).
-edge(987, 988).
-edge(988, 989).
-edge(989, 990).
-edge(990, 991).
-edge(991, 992).
-edge(992, 993).
-edge(993, 994).
-edge(994, 995).
-edge(995, 996).
-edge(996, 997).
-edge(997, 998).
-edge(998, 999).
-edge(999, 1000).
-edge(1000, 1001).
-edge(1001, 1002).
-edge(1002, 1003).
-edge(1003, 1004).
-edge(1004, 1005).
-edge(1005, 1006).
-edge(1006, 1007).
-edge(1007, 1008).
-edge(1008, 1009).
-edge(1009, 1010).
-edge(1010, 1011).
-edge(1011, 1012).
-edge(1012, 1013).
-edge(1013, 1014).
-edge(1014, 1015).
-edge(1015, 1016).
-edge(1016, 1017).
-edge(1017, 1018).
-edge(1018, 1019).
-edge(1019, 1020).
-edge(1020, 1021).
-edge(1021, 1022).
-edge(1022, 1023).
-edge(1023, 1024).
-edge(1024, 1025).
-edge(1025, 1026).
-edge(1026, 1027).
-edge(1027, 1028).
-edge(1028, 1029).
-edge(1029, 1030).
-edge(1030, 1031).
-edge(1031, 1032).
-edge(1032, 1033).
-edge(1033, 1034).
-edge(1034, 1035).
-edge(1035, 1036).
-edge(1036, 1037).
-edge(1037, 1038).
-edge(1038, 1039).
-edge(1039, 1040).
-edge(1040, 1041).
-edge(1041, 1042).
-edge(1042, 1043).
-edge(1043, 1044).
-edge(1044, 1045).
-edge(1045, 1046).
-edge(1046, 1047).
-edge(1047, 1048).
-edge(1048, 1049).
-edge(1049, 1050).
-edge(1050, 1051).
-edge(1051, 1052).
-edge(1052, 1053).
-edge(1053, 1054).
-edge(1054, 1055).
-edge(1055, 1056).
-edge(1056, 1057).
-edge(1057, 1058).
-edge(1058, 1059).
-edge(1059, 1060).
-edge(1060, 1061).
-edge(1061, 1062).
-edge(1062, 1063).
-edge(1063, 1064).
-edge(1064, 1065).
-edge(1065, 1066).
-edge(1066, 1067).
-edge(1067, 1068).
-edge(1068, 1069).
-edge(1069, 1070).
-edge(1070, 1071).
-edge(1071, 1072).
-edge(1072, 1073).
-edge(1073, 1074).
-edge(1074, 1075).
-edge(1075, 1076).
-edge(1076, 1077).
-edge(1077, 1078).
-edge(1078, 1079).
-edge(1079, 1080).
-edge(1080, 1081).
-edge(1081, 1082).
-edge(1082, 1083).
-edge(1083, 1084).
-edge(1084, 1085).
-edge(1085, 1086).
-edge(1086, 1087).
-edge(1087, 1088).
-edge(1088, 1089).
-edge(1089, 1090).
-edge(1090, 1091).
-edge(1091, 1092).
-edge(1092, 1093).
-edge(1093, 1094).
-edge(1094, 1095).
-edge(1095, 1096).
-edge(1096, 1097).
-edge(1097, 1098).
-edge(1098, 1099).
-edge(1099, 1100).
-edge(1100, 1101).
-edge(1101, 1102).
-edge(1102, 1103).
-edge(1103, 1104).
-edge(1104, 1105).
-edge(1105, 1106).
-edge(1106, 1107).
-edge(1107, 1108).
-edge(1108, 1109).
-edge(1109, 1110).
-edge(1110, 1111).
-edge(1111, 1112).
-edge(1112, 1113).
-edge(1113, 1114).
-edge(1114, 1115).
-edge(1115, 1116).
-edge(1116, 1117).
-edge(1117, 1118).
-edge(1118, 1119).
-edge(1119, 1120).
-edge(1120, 1121).
-edge(1121, 1122).
-edge(1122, 1123).
-edge(1123, 1124).
-edge(1124, 1125).
-edge(1125, 1126).
-edge(1126, 1127).
-edge(1127, 1128).
-edge(1128, 1129).
-edge(1129, 1130).
-edge(1130, 1131).
-edge(1131, 1132).
-edge(1132, 1133).
-edge(1133, 1134).
-edge(1134, 1135).
-edge(1135, 1136).
-edge(1136, 1137).
-edge(1137, 1138).
-edge(1138, 1139).
-edge(1139, 1140).
-edge(1140, 1141).
-edge(1141, 1142).
-edge(1142, 1143).
-edge(1143, 1144).
-edge(1144, 1145).
-edge(1145, 1146).
-edge(1146, 1147).
-edge(1147, 1148).
-edge(1148, 1149).
-edge(1149, 1150).
-edge(1150, 1151).
-edge(1151, 1152).
-edge(1152, 1153).
-edge(1153, 1154).
-edge(1154, 1155).
-edge(1155, 1156).
-edge(1156, 1157).
-edge(1157, 1158).
-edge(1158, 1159).
-edge(1159, 1160).
-edge(1160, 1161).
-edge(1161, 1162).
-edge(1162, 1163).
-edge(1163, 1164).
-edge(1164, 1165).
-edge(1165, 1166).
-edge(1166, 1167).
-edge(1167, 1168).
-edge(1168, 1169).
-edge(1169, 1170).
-edge(1170, 1171).
-edge(1171, 1172).
-edge(1172, 1173).
-edge(1173, 1174).
-edge(1174, 1175).
-edge(1175, 1176).
-edge(1176, 1177).
-edge(1177, 1178).
-edge(1178, 1179).
-edge(1179, 1180).
-edge(1180, 1181).
-edge(1181, 1182).
-edge(1182, 1183).
-edge(1183, 1184).
-edge(1184, 1185).
-edge(1185, 1186).
-edge(1186, 1187).
-edge(1187, 1188).
-edge(1188, 1189).
-edge(1189, 1190).
-edge(1190, 1191).
-edge(1191, 1192).
-edge(1192, 1193).
-edge(1193, 1194).
-edge(1194, 1195).
-edge(1195, 1196).
-edge(1196, 1197).
-edge(1197, 1198).
-edge(1198, 1199).
-edge(1199, 1200).
-edge(1200, 1201).
-edge(1201, 1202).
-edge(1202, 1203).
-edge(1203, 1204).
-edge(1204, 1205).
-edge(1205, 1206).
-edge(1206, 1207).
-edge(1207, 1208).
-edge(1208, 1209).
-edge(1209, 1210).
-edge(1210, 1211).
-edge(1211, 1212).
-edge(1212, 1213).
-edge(1213, 1214).
-edge(1214, 1215).
-edge(1215, 1216).
-edge(1216, 1217).
-edge(1217, 1218).
-edge(1218, 1219).
-edge(1219, 1220).
-edge(1220, 1221).
-edge(1221, 1222).
-edge(1222, 1223).
-edge(1223, 1224).
-edge(1224, 1225).
-edge(1225, 1226).
-edge(1226, 1227).
-edge(1227, 1228).
-edge(1228, 1229).
-edge(1229, 1230).
-edge(1230, 1231).
-edge(1231, 1232).
-edge(1232, 1233).
-edge(1233, 1234).
-edge(1234, 1235).
-edge(1235, 1236).
-edge(1236, 1237).
-edge(1237, 1238).
-edge(1238, 1239).
-edge(1239, 1240).
-edge(1240, 1241).
-edge(1241, 1242).
-edge(1242, 1243).
-edge(1243, 1244).
-edge(1244, 1245).
-edge(1245, 1246).
-edge(1246, 1247).
-edge(1247, 1248).
-edge(1248, 1249).
-edge(1249, 1250).
-edge(1250, 1251).
-edge(1251, 1252).
-edge(1252, 1253).
-edge(1253, 1254).
-edge(1254, 1255).
-edge(1255, 1256).
-edge(1256, 1257).
-edge(1257, 1258).
-edge(1258, 1259).
-edge(1259, 1260).
-edge(1260, 1261).
-edge(1261, 1262).
-edge(1262, 1263).
-edge(1263, 1264).
-edge(1264, 1265).
-edge(1265, 1266).
-edge(1266, 1267).
-edge(1267, 1268).
-edge(1268, 1269).
-edge(1269, 1270).
-edge(1270, 1271).
-edge(1271, 1272).
-edge(1272, 1273).
-edge(1273, 1274).
-edge(1274, 1275).
-edge(1275, 1276).
-edge(1276, 1277).
-edge(1277, 1278).
-edge(1278, 1279).
-edge(1279, 1280).
-edge(1280, 1281).
-edge(1281, 1282).
-edge(1282, 1283).
-edge(1283, 1284).
-edge(1284, 1285).
-edge(1285, 1286).
-edge(1286, 1287).
-edge(1287, 1288).
-edge(1288, 1289).
-edge(1289, 1290).
-edge(1290, 1291).
-edge(1291, 1292).
-edge(1292, 1293).
-edge(1293, 1294).
-edge(1294, 1295).
-edge(1295, 1296).
-edge(1296, 1297).
-edge(1297, 1298).
-edge(1298, 1299).
-edge(1299, 1300).
-edge(1300, 1301).
-edge(1301, 1302).
-edge(1302, 1303).
-edge(1303, 1304).
-edge(1304, 1305).
-edge(1305, 1306).
-edge(1306, 1307).
-edge(1307, 1308).
-edge(1308, 1309).
-edge(1309, 1310).
-edge(1310, 1311).
-edge(1311, 1312).
-edge(1312, 1313).
-edge(1313, 1314).
-edge(1314, 1315).
-edge(1315, 1316).
-edge(1316, 1317).
-edge(1317, 1318).
-edge(1318, 1319).
-edge(1319, 1320).
-edge(1320, 1321).
-edge(1321, 1322).
-edge(1322, 1323).
-edge(1323, 1324).
-edge(1324, 1325).
-edge(1325, 1326).
-edge(1326, 1327).
-edge(1327, 1328).
-edge(1328, 1329).
-edge(1329, 1330).
-edge(1330, 1331).
-edge(1331, 1332).
-edge(1332, 1333).
-edge(1333, 1334).
-edge(1334, 1335).
-edge(1335, 1336).
-edge(1336, 1337).
-edge(1337, 1338).
-edge(1338, 1339).
-edge(1339, 1340).
-edge(1340, 1341).
-edge(1341, 1342).
-edge(1342, 1343).
-edge(1343, 1344).
-edge(1344, 1345).
-edge(1345, 1346).
-edge(1346, 1347).
-edge(1347, 1348).
-edge(1348, 1349).
-edge(1349, 1350).
-edge(1350, 1351).
-edge(1351, 1352).
-edge(1352, 1353).
-edge(1353, 1354).
-edge(1354, 1355).
-edge(1355, 1356).
-edge(1356, 1357).
-edge(1357, 1358).
-edge(1358, 1359).
-edge(1359, 1360).
-edge(1360, 1361).
-edge(1361, 1362).
-edge(1362, 1363).
-edge(1363, 1364).
-edge(1364, 1365).
-edge(1365, 1366).
-edge(1366, 1367).
-edge(1367, 1368).
-edge(1368, 1369).
-edge(1369, 1370).
-edge(1370, 1371).
-edge(1371, 1372).
-edge(1372, 1373).
-edge(1373, 1374).
-edge(1374, 1375).
-edge(1375, 1376).
-edge(1376, 1377).
-edge(1377, 1378).
-edge(1378, 1379).
-edge(1379, 1380).
-edge(1380, 1381).
-edge(1381, 1382).
-edge(1382, 1383).
-edge(1383, 1384).
-edge(1384, 1385).
-edge(1385, 1386).
-edge(1386, 1387).
-edge(1387, 1388).
-edge(1388, 1389).
-edge(1389, 1390).
-edge(1390, 1391).
-edge(1391, 1392).
-edge(1392, 1393).
-edge(1393, 1394).
-edge(1394, 1395).
-edge(1395, 1396).
-edge(1396, 1397).
-edge(1397, 1398).
-edge(1398, 1399).
-edge(1399, 1400).
-edge(1400, 1401).
-edge(1401, 1402).
-edge(1402, 1403).
-edge(1403, 1404).
-edge(1404, 1405).
-edge(1405, 1406).
-edge(1406, 1407).
-edge(1407, 1408).
-edge(1408, 1409).
-edge(1409, 1410).
-edge(1410, 1411).
-edge(1411, 1412).
-edge(1412, 1413).
-edge(1413, 1414).
-edge(1414, 1415).
-edge(1415, 1416).
-edge(1416, 1417).
-edge(1417, 1418).
-edge(1418, 1419).
-edge(1419, 1420).
-edge(1420, 1421).
-edge(1421, 1422).
-edge(1422, 1423).
-edge(1423, 1424).
-edge(1424, 1425).
-edge(1425, 1426).
-edge(1426, 1427).
-edge(1427, 1428).
-edge(1428, 1429).
-edge(1429, 1430).
-edge(1430, 1431).
-edge(1431, 1432).
-edge(1432, 1433).
-edge(1433, 1434).
-edge(1434, 1435).
-edge(1435, 1436).
-edge(1436, 1437).
-edge(1437, 1438).
-edge(1438, 1439).
-edge(1439, 1440).
-edge(1440, 1441).
-edge(1441, 1442).
-edge(1442, 1443).
-edge(1443, 1444).
-edge(1444, 1445).
-edge(1445, 1446).
-edge(1446, 1447).
-edge(1447, 1448).
-edge(1448, 1449).
-edge(1449, 1450).
-edge(1450, 1451).
-edge(1451, 1452).
-edge(1452, 1453).
-edge(1453, 1454).
-edge(1454, 1455).
-edge(1455, 1456).
-edge(1456, 1457).
-edge(1457, 1458).
-edge(1458, 1459).
-edge(1459, 1460).
-edge(1460, 1461).
-edge(1461, 1462).
-edge(1462, 1463).
-edge(1463, 1464).
-edge(1464, 1465).
-edge(1465, 1466).
-edge(1466, 1467).
-edge(1467, 1468).
-edge(1468, 1469).
-edge(1469, 1470).
-edge(1470, 1471).
-edge(1471, 1472).
-edge(1472, 1473).
-edge(1473, 1474).
-edge(1474, 1475).
-edge(1475, 1476).
-edge(1476, 1477).
-edge(1477, 1478).
-edge(1478, 1479).
-edge(1479, 1480).
-edge(1480, 1481).
-edge(1481, 1482).
-edge(1482, 1483).
-edge(1483, 1484).
-edge(1484, 1485).
-edge(1485, 1486).
-edge(1486, 1487).
-edge(1487, 1488).
-edge(1488, 1489).
-edge(1489, 1490).
-edge(1490, 1491).
-edge(1491, 1492).
-edge(1492, 1493).
-edge(1493, 1494).
-edge(1494, 1495).
-edge(1495, 1496).
-edge(1496, 1497).
-edge(1497, 1498).
-edge(1498, 1499).
-edge(1499, 1500).
-edge(1500, 1501).
-edge(1501, 1502).
-edge(1502, 1503).
-edge(1503, 1504).
-edge(1504, 1505).
-edge(1505, 1506).
-edge(1506, 1507).
-edge(1507, 1508).
-edge(1508, 1509).
-edge(1509, 1510).
-edge(1510, 1511).
-edge(1511, 1512).
-edge(1512, 1513).
-edge(1513, 1514).
-edge(1514, 1515).
-edge(1515, 1516).
-edge(1516, 1517).
-edge(1517, 1518).
-edge(1518, 1519).
-edge(1519, 1520).
-edge(1520, 1521).
-edge(1521, 1522).
-edge(1522, 1523).
-edge(1523, 1524).
-edge(1524, 1525).
-edge(1525, 1526).
-edge(1526, 1527).
-edge(1527, 1528).
-edge(1528, 1529).
-edge(1529, 1530).
-edge(1530, 1531).
-edge(1531, 1532).
-edge(1532, 1533).
-edge(1533, 1534).
-edge(1534, 1535).
-edge(1535, 1536).
-edge(1536, 1537).
-edge(1537, 1538).
-edge(1538, 1539).
-edge(1539, 1540).
-edge(1540, 1541).
-edge(1541, 1542).
-edge(1542, 1543).
-edge(1543, 1544).
-edge(1544, 1545).
-edge(1545, 1546).
-edge(1546, 1547).
-edge(1547, 1548).
-edge(1548, 1549).
-edge(1549, 1550).
-edge(1550, 1551).
-edge(1551, 1552).
-edge(1552, 1553).
-edge(1553, 1554).
-edge(1554, 1555).
-edge(1555, 1556).
-edge(1556, 1557).
-edge(1557, 1558).
-edge(1558, 1559).
-edge(1559, 1560).
-edge(1560, 1561).
-edge(1561, 1562).
-edge(1562, 1563).
-edge(1563, 1564).
-edge(1564, 1565).
-edge(1565, 1566).
-edge(1566, 1567).
-edge(1567, 1568).
-edge(1568, 1569).
-edge(1569, 1570).
-edge(1570, 1571).
-edge(1571, 1572).
-edge(1572, 1573).
-edge(1573, 1574).
-edge(1574, 1575).
-edge(1575, 1576).
-edge(1576, 1577).
-edge(1577, 1578).
-edge(1578, 1579).
-edge(1579, 1580).
-edge(1580, 1581).
-edge(1581, 1582).
-edge(1582, 1583).
-edge(1583, 1584).
-edge(1584, 1585).
-edge(1585, 1586).
-edge(1586, 1587).
-edge(1587, 1588).
-edge(1588, 1589).
-edge(1589, 1590).
-edge(1590, 1591).
-edge(1591, 1592).
-edge(1592, 1593).
-edge(1593, 1594).
-edge(1594, 1595).
-edge(1595, 1596).
-edge(1596, 1597).
-edge(1597, 1598).
-edge(1598, 1599).
-edge(1599, 1600).
-edge(1600, 1601).
-edge(1601, 1602).
-edge(1602, 1603).
-edge(1603, 1604).
-edge(1604, 1605).
-edge(1605, 1606).
-edge(1606, 1607).
-edge(1607, 1608).
-edge(1608, 1609).
-edge(1609, 1610).
-edge(1610, 1611).
-edge(1611, 1612).
-edge(1612, 1613).
-edge(1613, 1614).
-edge(1614, 1615).
-edge(1615, 1616).
-edge(1616, 1617).
-edge(1617, 1618).
-edge(1618, 1619).
-edge(1619, 1620).
-edge(1620, 1621).
-edge(1621, 1622).
-edge(1622, 1623).
-edge(1623, 1624).
-edge(1624, 1625).
-edge(1625, 1626).
-edge(1626, 1627).
-edge(1627, 1628).
-edge(1628, 1629).
-edge(1629, 1630).
-edge(1630, 1631).
-edge(1631, 1632).
-edge(1632, 1633).
-edge(1633, 1634).
-edge(1634, 1635).
-edge(1635, 1636).
-edge(1636, 1637).
-edge(1637, 1638).
-edge(1638, 1639).
-edge(1639, 1640).
-edge(1640, 1641).
-edge(1641, 1642).
-edge(1642, 1643).
-edge(1643, 1644).
-edge(1644, 1645).
-edge(1645, 1646).
-edge(1646, 1647).
-edge(1647, 1648).
-edge(1648, 1649).
-edge(1649, 1650).
-edge(1650, 1651).
-edge(1651, 1652).
-edge(1652, 1653).
-edge(1653, 1654).
-edge(1654, 1655).
-edge(1655, 1656).
-edge(1656, 1657).
-edge(1657, 1658).
-edge(1658, 1659).
-edge(1659, 1660).
-edge(1660, 1661).
-edge(1661, 1662).
-edge(1662, 1663).
-edge(1663, 1664).
-edge(1664, 1665).
-edge(1665, 1666).
-edge(1666, 1667).
-edge(1667, 1668).
-edge(1668, 1669).
-edge(1669, 1670).
-edge(1670, 1671).
-edge(1671, 1672).
-edge(1672, 1673).
-edge(1673, 1674).
-edge(1674, 1675).
-edge(1675, 1676).
-edge(1676, 1677).
-edge(1677, 1678).
-edge(1678, 1679).
-edge(1679, 1680).
-edge(1680, 1681).
-edge(1681, 1682).
-edge(1682, 1683).
-edge(1683, 1684).
-edge(1684, 1685).
-edge(1685, 1686).
-edge(1686, 1687).
-edge(1687, 1688).
-edge(1688, 1689).
-edge(1689, 1690).
-edge(1690, 1691).
-edge(1691, 1692).
-edge(1692, 1693).
-edge(1693, 1694).
-edge(1694, 1695).
-edge(1695, 1696).
-edge(1696, 1697).
-edge(1697, 1698).
-edge(1698, 1699).
-edge(1699, 1700).
-edge(1700, 1701).
-edge(1701, 1702).
-edge(1702, 1703).
-edge(1703, 1704).
-edge(1704, 1705).
-edge(1705, 1706).
-edge(1706, 1707).
-edge(1707, 1708).
-edge(1708, 1709).
-edge(1709, 1710).
-edge(1710, 1711).
-edge(1711, 1712).
-edge(1712, 1713).
-edge(1713, 1714).
-edge(1714, 1715).
-edge(1715, 1716).
-edge(1716, 1717).
-edge(1717, 1718).
-edge(1718, 1719).
-edge(1719, 1720).
-edge(1720, 1721).
-edge(1721, 1722).
-edge(1722, 1723).
-edge(1723, 1724).
-edge(1724, 1725).
-edge(1725, 1726).
-edge(1726, 1727).
-edge(1727, 1728).
-edge(1728, 1729).
-edge(1729, 1730).
-edge(1730, 1731).
-edge(1731, 1732).
-edge(1732, 1733).
-edge(1733, 1734).
-edge(1734, 1735).
-edge(1735, 1736).
-edge(1736, 1737).
-edge(1737, 1738).
-edge(1738, 1739).
-edge(1739, 1740).
-edge(1740, 1741).
-edge(1741, 1742).
-edge(1742, 1743).
-edge(1743, 1744).
-edge(1744, 1745).
-edge(1745, 1746).
-edge(1746, 1747).
-edge(1747, 1748).
-edge(1748, 1749).
-edge(1749, 1750).
-edge(1750, 1751).
-edge(1751, 1752).
-edge(1752, 1753).
-edge(1753, 1754).
-edge(1754, 1755).
-edge(1755, 1756).
-edge(1756, 1757).
-edge(1757, 1758).
-edge(1758, 1759).
-edge(1759, 1760).
-edge(1760, 1761).
-edge(1761, 1762).
-edge(1762, 1763).
-edge(1763, 1764).
-edge(1764, 1765).
-edge(1765, 1766).
-edge(1766, 1767).
-edge(1767, 1768).
-edge(1768, 1769).
-edge(1769, 1770).
-edge(1770, 1771).
-edge(1771, 1772).
-edge(1772, 1773).
-edge(1773, 1774).
-edge(1774, 1775).
-edge(1775, 1776).
-edge(1776, 1777).
-edge(1777, 1778).
-edge(1778, 1779).
-edge(1779, 1780).
-edge(1780, 1781).
-edge(1781, 1782).
-edge(1782, 1783).
-edge(1783, 1784).
-edge(1784, 1785).
-edge(1785, 1786).
-edge(1786, 1787).
-edge(1787, 1788).
-edge(1788, 1789).
-edge(1789, 1790).
-edge(1790, 1791).
-edge(1791, 1792).
-edge(1792, 1793).
-edge(1793, 1794).
-edge(1794, 1795).
-edge(1795, 1796).
-edge(1796, 1797).
-edge(1797, 1798).
-edge(1798, 1799).
-edge(1799, 1800).
-edge(1800, 1801).
-edge(1801, 1802).
-edge(1802, 1803).
-edge(1803, 1804).
-edge(1804, 1805).
-edge(1805, 1806).
-edge(1806, 1807).
-edge(1807, 1808).
-edge(1808, 1809).
-edge(1809, 1810).
-edge(1810, 1811).
-edge(1811, 1812).
-edge(1812, 1813).
-edge(1813, 1814).
-edge(1814, 1815).
-edge(1815, 1816).
-edge(1816, 1817).
-edge(1817, 1818).
-edge(1818, 1819).
-edge(1819, 1820).
-edge(1820, 1821).
-edge(1821, 1822).
-edge(1822, 1823).
-edge(1823, 1824).
-edge(1824, 1825).
-edge(1825, 1826).
-edge(1826, 1827).
-edge(1827, 1828).
-edge(1828, 1829).
-edge(1829, 1830).
-edge(1830, 1831).
-edge(1831, 1832).
-edge(1832, 1833).
-edge(1833, 1834).
-edge(1834, 1835).
-edge(1835, 1836).
-edge(1836, 1837).
-edge(1837, 1838).
-edge(1838, 1839).
-edge(1839, 1840).
-edge(1840, 1841).
-edge(1841, 1842).
-edge(1842, 1843).
-edge(1843, 1844).
-edge(1844, 1845).
-edge(1845, 1846).
-edge(1846, 1847).
-edge(1847, 1848).
-edge(1848, 1849).
-edge(1849, 1850).
-edge(1850, 1851).
-edge(1851, 1852).
-edge(1852, 1853).
-edge(1853, 1854).
-edge(1854, 1855).
-edge(1855, 1856).
-edge(1856, 1857).
-edge(1857, 1858).
-edge(1858, 1859).
-edge(1859, 1860).
-edge(1860, 1861).
-edge(1861, 1862).
-edge(1862, 1863).
-edge(1863, 1864).
-edge(1864, 1865).
-edge(1865, 1866).
-edge(1866, 1867).
-edge(1867, 1868).
-edge(1868, 1869).
-edge(1869, 1870).
-edge(1870, 1871).
-edge(1871, 1872).
-edge(1872, 1873).
-edge(1873, 1874).
-edge(1874, 1875).
-edge(1875, 1876).
-edge(1876, 1877).
-edge(1877, 1878).
-edge(1878, 1879).
-edge(1879, 1880).
-edge(1880, 1881).
-edge(1881, 1882).
-edge(1882, 1883).
-edge(1883, 1884).
-edge(1884, 1885).
-edge(1885, 1886).
-edge(1886, 1887).
-edge(1887, 1888).
-edge(1888, 1889).
-edge(1889, 1890).
-edge(1890, 1891).
-edge(1891, 1892).
-edge(1892, 1893).
-edge(1893, 1894).
-edge(1894, 1895).
-edge(1895, 1896).
-edge(1896, 1897).
-edge(1897, 1898).
-edge(1898, 1899).
-edge(1899, 1900).
-edge(1900, 1901).
-edge(1901, 1902).
-edge(1902, 1903).
-edge(1903, 1904).
-edge(1904, 1905).
-edge(1905, 1906).
-edge(1906, 1907).
-edge(1907, 1908).
-edge(1908, 1909).
-edge(1909, 1910).
-edge(1910, 1911).
-edge(1911, 1912).
-edge(1912, 1913).
-edge(1913, 1914).
-edge(1914, 1915).
-edge(1915, 1916).
-edge(1916, 1917).
-edge(1917, 1918).
-edge(1918, 1919).
-edge(1919, 1920).
-edge(1920, 1921).
-edge(1921, 1922).
-edge(1922, 1923).
-edge(1923, 1924).
-edge(1924, 1925).
-edge(1925, 1926).
-edge(1926, 1927).
-edge(1927, 1928).
-edge(1928, 1929).
-edge(1929, 1930).
-edge(1930, 1931).
-edge(1931, 1932).
-edge(1932, 1933).
-edge(1933, 1934).
-edge(1934, 1935).
-edge(1935, 1936).
-edge(1936, 1937).
-edge(1937, 1938).
-edge(1938, 1939).
-edge(1939, 1940).
-edge(1940, 1941).
-edge(1941, 1942).
-edge(1942, 1943).
-edge(1943, 1944).
-edge(1944, 1945).
-edge(1945, 1946).
-edge(1946, 1947).
-edge(1947, 1948).
-edge(1948, 1949).
-edge(1949, 1950).
-edge(1950, 1951).
-edge(1951, 1952).
-edge(1952, 1953).
-edge(1953, 1954).
-edge(1954, 1955).
-edge(1955, 1956).
-edge(1956, 1957).
-edge(1957, 1958).
-edge(1958, 1959).
-edge(1959, 1960).
-edge(1960, 1961).
-edge(1961, 1962).
-edge(1962, 1963).
-edge(1963, 1964).
-edge(1964, 1965).
-edge(1965, 1966).
-edge(1966, 1967).
-edge(1967, 1968).
-edge(1968, 1969).
-edge(1969, 1970).
-edge(1970, 1971).
-edge(1971, 1972).
-edge(1972, 1973).
-edge(1973, 1974).
-edge(1974, 1975).
-edge(1975, 1976).
-edge(1976, 1977).
-edge(1977, 1978).
-edge(1978, 1979).
-edge(1979, 1980).
-edge(1980, 1981).
-edge(1981, 1982).
-edge(1982, 1983).
-edge(1983, 1984).
-edge(1984, 1985).
-edge(1985, 1986).
-edge(1986, 1987).
-edge(1987, 1988).
-edge(1988, 1989).
-edge(1989, 1990).
-edge(1990, 1991).
-edge(1991, 1992).
-edge(1992, 1993).
-edge(1993, 1994).
-edge(1994, 1995).
-edge(1995, 1996).
-edge(1996, 1997).
-edge(1997, 1998).
-edge(1998, 1999).
-edge(1999, 2000).
-edge(2000, 0).
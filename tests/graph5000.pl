@@ -1,5006 +0,0 @@
-% generate problem of size 5000
-reachable(X,Y) :- edge(X,Y).
-reachable(X,Y) :- edge(X,Z), reachable(Z,Y).
-increasing(X,Y) :- edge(X,Y), lt(X,Y).
-increasing(X,Y) :- edge(X,Z), lt(X,Z), lt(Z,Y), increasing(Z,Y).
-edge(0, 1).
-edge(1, 2).
-edge(2, 3).
-edge(3, 4).
-edge(4, 5).
-edge(5, 6).
-edge(6, 7).
-edge(7, 8).
-edge(8, 9).
-edge(9, 10).
-edge(10, 11).
-edge(11, 12).
-edge(12, 13).
-edge(13, 14).
-edge(14, 15).
-edge(15, 16).
-edge(16, 17).
-edge(17, 18).
-edge(18, 19).
-edge(19, 20).
-edge(20, 21).
-edge(21, 22).
-edge(22, 23).
-edge(23, 24).
-edge(24, 25).
-edge(25, 26).
-edge(26, 27).
-edge(27, 28).
-edge(28, 29).
-edge(29, 30).
-edge(30, 31).
-edge(31, 32).
-edge(32, 33).
-edge(33, 34).
-edge(34, 35).
-edge(35, 36).
-edge(36, 37).
-edge(37, 38).
-edge(38, 39).
-edge(39, 40).
-edge(40, 41).
-edge(41, 42).
-edge(42, 43).
-edge(43, 44).
-edge(44, 45).
-edge(45, 46).
-edge(46, 47).
-edge(47, 48).
-edge(48, 49).
-edge(49, 50).
-edge(50, 51).
-edge(51, 52).
-edge(52, 53).
-edge(53, 54).
-edge(54, 55).
-edge(55, 56).
-edge(56, 57).
-edge(57, 58).
-edge(58, 59).
-edge(59, 60).
-edge(60, 61).
-edge(61, 62).
-edge(62, 63).
-edge(63, 64).
-edge(64, 65).
-edge(65, 66).
-edge(66, 67).
-edge(67, 68).
-edge(68, 69).
-edge(69, 70).
-edge(70, 71).
-edge(71, 72).
-edge(72, 73).
-edge(73, 74).
-edge(74, 75).
-edge(75, 76).
-edge(76, 77).
-edge(77, 78).
-edge(78, 79).
-edge(79, 80).
-edge(80, 81).
-edge(81, 82).
-edge(82, 83).
-edge(83, 84).
-edge(84, 85).
-edge(85, 86).
-edge(86, 87).
-edge(87, 88).
-edge(88, 89).
-edge(89, 90).
-edge(90, 91).
-edge(91, 92).
-edge(92, 93).
-edge(93, 94).
-edge(94, 95).
-edge(95, 96).
-edge(96, 97).
-edge(97, 98).
-edge(98, 99).
-edge(99, 100).
-edge(100, 101).
-edge(101, 102).
-edge(102, 103).
-edge(103, 104).
-edge(104, 105).
-edge(105, 106).
-edge(106, 107).
-edge(107, 108).
-edge(108, 109).
-edge(109, 110).
-edge(110, 111).
-edge(111, 112).
-edge(112, 113).
-edge(113, 114).
-edge(114, 115).
-edge(115, 116).
-edge(116, 117).
-edge(117, 118).
-edge(118, 119).
-edge(119, 120).
-edge(120, 121).
-edge(121, 122).
-edge(122, 123).
-edge(123, 124).
-edge(124, 125).
-edge(125, 126).
-edge(126, 127).
-edge(127, 128).
-edge(128, 129).
-edge(129, 130).
-edge(130, 131).
-edge(131, 132).
-edge(132, 133).
-edge(133, 134).
-edge(134, 135).
-edge(135, 136).
-edge(136, 137).
-edge(137, 138).
-edge(138, 139).
-edge(139, 140).
-edge(140, 141).
-edge(141, 142).
-edge(142, 143).
-edge(143, 144).
-edge(144, 145).
-edge(145, 146).
-edge(146, 147).
-edge(147, 148).
-edge(148, 149).
-edge(149, 150).
-edge(150, 151).
-edge(151, 152).
-edge(152, 153).
-edge(153, 154).
-edge(154, 155).
-edge(155, 156).
-edge(156, 157).
-edge(157, 158).
-edge(158, 159).
-edge(159, 160).
-edge(160, 161).
-edge(161, 162).
-edge(162, 163).
-edge(163, 164).
-edge(164, 165).
-edge(165, 166).
-edge(166, 167).
-edge(167, 168).
-edge(168, 169).
-edge(169, 170).
-edge(170, 171).
-edge(171, 172).
-edge(172, 173).
-edge(173, 174).
-edge(174, 175).
-edge(175, 176).
-edge(176, 177).
-edge(177, 178).
-edge(178, 179).
-edge(179, 180).
-edge(180, 181).
-edge(181, 182).
-edge(182, 183).
-edge(183, 184).
-edge(184, 185).
-edge(185, 186).
-edge(186, 187).
-edge(187, 188).
-edge(188, 189).
-edge(189, 190).
-edge(190, 191).
-edge(191, 192).
-edge(192, 193).
-edge(193, 194).
-edge(194, 195).
-edge(195, 196).
-edge(196, 197).
-edge(197, 198).
-edge(198, 199).
-edge(199, 200).
-edge(200, 201).
-edge(201, 202).
-edge(202, 203).
-edge(203, 204).
-edge(204, 205).
-edge(205, 206).
-edge(206, 207).
-edge(207, 208).
-edge(208, 209).
-edge(209, 210).
-edge(210, 211).
-edge(211, 212).
-edge(212, 213).
-edge(213, 214).
-edge(214, 215).
-edge(215, 216).
-edge(216, 217).
-edge(217, 218).
-edge(218, 219).
-edge(219, 220).
-edge(220, 221).
-edge(221, 222).
-edge(222, 223).
-edge(223, 224).
-edge(224, 225).
-edge(225, 226).
-edge(226, 227).
-edge(227, 228).
-edge(228, 229).
-edge(229, 230).
-edge(230, 231).
-edge(231, 232).
-edge(232, 233).
-edge(233, 234).
-edge(234, 235).
-edge(235, 236).
-edge(236, 237).
-edge(237, 238).
-edge(238, 239).
-edge(239, 240).
-edge(240, 241).
-edge(241, 242).
-edge(242, 243).
-edge(243, 244).
-edge(244, 245).
-edge(245, 246).
-edge(246, 247).
-edge(247, 248).
-edge(248, 249).
-edge(249, 250).
-edge(250, 251).
-edge(251, 252).
-edge(252, 253).
-edge(253, 254).
-edge(254, 255).
-edge(255, 256).
-edge(256, 257).
-edge(257, 258).
-edge(258, 259).
-edge(259, 260).
-edge(260, 261).
-edge(261, 262).
-edge(262, 263).
-edge(263, 264).
-edge(264, 265).
-edge(265, 266).
-edge(266, 267).
-edge(267, 268).
-edge(268, 269).
-edge(269, 270).
-edge(270, 271).
-edge(271, 272).
-edge(272, 273).
-edge(273, 274).
-edge(274, 275).
-edge(275, 276).
-edge(276, 277).
-edge(277, 278).
-edge(278, 279).
-edge(279, 280).
-edge(280, 281).
-edge(281, 282).
-edge(282, 283).
-edge(283, 284).
-edge(284, 285).
-edge(285, 286).
-edge(286, 287).
-edge(287, 288).
-edge(288, 289).
-edge(289, 290).
-edge(290, 291).
-edge(291, 292).
-edge(292, 293).
-edge(293, 294).
-edge(294, 295).
-edge(295, 296).
-edge(296, 297).
-edge(297, 298).
-edge(298, 299).
-edge(299, 300).
-edge(300, 301).
-edge(301, 302).
-edge(302, 303).
-edge(303, 304).
-edge(304, 305).
-edge(305, 306).
-edge(306, 307).
-edge(307, 308).
-edge(308, 309).
-edge(309, 310).
-edge(310, 311).
-edge(311, 312).
-edge(312, 313).
-edge(313, 314).
-edge(314, 315).
-edge(315, 316).
-edge(316, 317).
-edge(317, 318).
-edge(318, 319).
-edge(319, 320).
-edge(320, 321).
-edge(321, 322).
-edge(322, 323).
-edge(323, 324).
-edge(324, 325).
-edge(325, 326).
-edge(326, 327).
-edge(327, 328).
-edge(328, 329).
-edge(329, 330).
-edge(330, 331).
-edge(331, 332).
-edge(332, 333).
-edge(333, 334).
-edge(334, 335).
-edge(335, 336).
-edge(336, 337).
-edge(337, 338).
-edge(338, 339).
-edge(339, 340).
-edge(340, 341).
-edge(341, 342).
-edge(342, 343).
-edge(343, 344).
-edge(344, 345).
-edge(345, 346).
-edge(346, 347).
-edge(347, 348).
-edge(348, 349).
-edge(349, 350).
-edge(350, 351).
-edge(351, 352).
-edge(352, 353).
-edge(353, 354).
-edge(354, 355).
-edge(355, 356).
-edge(356, 357).
-edge(357, 358).
-edge(358, 359).
-edge(359, 360).
-edge(360, 361).
-edge(361, 362).
-edge(362, 363).
-edge(363, 364).
-edge(364, 365).
-edge(365, 366).
-edge(366, 367).
-edge(367, 368).
-edge(368, 369).
-edge(369, 370).
-edge(370, 371).
-edge(371, 372).
-edge(372, 373).
-edge(373, 374).
-edge(374, 375).
-edge(375, 376).
-edge(376, 377).
-edge(377, 378).
-edge(378, 379).
-edge(379, 380).
-edge(380, 381).
-edge(381, 382).
-edge(382, 383).
-edge(383, 384).
-edge(384, 385).
-edge(385, 386).
-edge(386, 387).
-edge(387, 388).
-edge(388, 389).
-edge(389, 390).
-edge(390, 391).
-edge(391, 392).
-edge(392, 393).
-edge(393, 394).
-edge(394, 395).
-edge(395, 396).
-edge(396, 397).
-edge(397, 398).
-edge(398, 399).
-edge(399, 400).
-edge(400, 401).
-edge(401, 402).
-edge(402, 403).
-edge(403, 404).
-edge(404, 405).
-edge(405, 406).
-edge(406, 407).
-edge(407, 408).
-edge(408, 409).
-edge(409, 410).
-edge(410, 411).
-edge(411, 412).
-edge(412, 413).
-edge(413, 414).
-edge(414, 415).
-edge(415, 416).
-edge(416, 417).
-edge(417, 418).
-edge(418, 419).
-edge(419, 420).
-edge(420, 421).
-edge(421, 422).
-edge(422, 423).
-edge(423, 424).
-edge(424, 425).
-edge(425, 426).
-edge(426, 427).
-edge(427, 428).
-edge(428, 429).
-edge(429, 430).
-edge(430, 431).
-edge(431, 432).
-edge(432, 433).
-edge(433, 434).
-edge(434, 435).
-edge(435, 436).
-edge(436, 437).
-edge(437, 438).
-edge(438, 439).
-edge(439, 440).
-edge(440, 441).
-edge(441, 442).
-edge(442, 443).
-edge(443, 444).
-edge(444, 445).
-edge(445, 446).
-edge(446, 447).
-edge(447, 448).
-edge(448, 449).
-edge(449, 450).
-edge(450, 451).
-edge(451, 452).
-edge(452, 453).
-edge(453, 454).
-edge(454, 455).
-edge(455, 456).
-edge(456, 457).
-edge(457, 458).
-edge(458, 459).
-edge(459, 460).
-edge(460, 461).
-edge(461, 462).
-edge(462, 463).
-edge(463, 464).
-edge(464, 465).
-edge(465, 466).
-edge(466, 467).
-edge(467, 468).
-edge(468, 469).
-edge(469, 470).
-edge(470, 471).
-edge(471, 472).
-edge(472, 473).
-edge(473, 474).
-edge(474, 475).
-edge(475, 476).
-edge(476, 477).
-edge(477, 478).
-edge(478, 479).
-edge(479, 480).
-edge(480, 481).
-edge(481, 482).
-edge(482, 483).
-edge(483, 484).
-edge(484, 485).
-edge(485, 486).
-edge(486, 487).
-edge(487, 488).
-edge(488, 489).
-edge(489, 490).
-edge(490, 491).
-edge(491, 492).
-edge(492, 493).
-edge(493, 494).
-edge(494, 495).
-edge(495, 496).
-edge(496, 497).
-edge(497, 498).
-edge(498, 499).
-edge(499, 500).
-edge(500, 501).
-edge(501, 502).
-edge(502, 503).
-edge(503, 504).
-edge(504, 505).
-edge(505, 506).
-edge(506, 507).
-edge(507, 508).
-edge(508, 509).
-edge(509, 510).
-edge(510, 511).
-edge(511, 512).
-edge(512, 513).
-edge(513, 514).
-edge(514, 515).
-edge(515, 516).
-edge(516, 517).
-edge(517, 518).
-edge(518, 519).
-edge(519, 520).
-edge(520, 521).
-edge(521, 522).
-edge(522, 523).
-edge(523, 524).
-edge(524, 525).
-edge(525, 526).
-edge(526, 527).
-edge(527, 528).
-edge(528, 529).
-edge(529, 530).
-edge(530, 531).
-edge(531, 532).
-edge(532, 533).
-edge(533, 534).
-edge(534, 535).
-edge(535, 536).
-edge(536, 537).
-edge(537, 538).
-edge(538, 539).
-edge(539, 540).
-edge(540, 541).
-edge(541, 542).
-edge(542, 543).
-edge(543, 544).
-edge(544, 545).
-edge(545, 546).
-edge(546, 547).
-edge(547, 548).
-edge(548, 549).
-edge(549, 550).
-edge(550, 551).
-edge(551, 552).
-edge(552, 553).
-edge(553, 554).
-edge(554, 555).
-edge(555, 556).
-edge(556, 557).
-edge(557, 558).
-edge(558, 559).
-edge(559, 560).
-edge(560, 561).
-edge(561, 562).
-edge(562, 563).
-edge(563, 564).
-edge(564, 565).
-edge(565, 566).
-edge(566, 567).
-edge(567, 568).
-edge(568, 569).
-edge(569, 570).
-edge(570, 571).
-edge(571, 572).
-edge(572, 573).
-edge(573, 574).
-edge(574, 575).
-edge(575, 576).
-edge(576, 577).
-edge(577, 578).
-edge(578, 579).
-edge(579, 580).
-edge(580, 581).
-edge(581, 582).
-edge(582, 583).
-edge(583, 584).
-edge(584, 585).
-edge(585, 586).
-edge(586, 587).
-edge(587, 588).
-edge(588, 589).
-edge(589, 590).
-edge(590, 591).
-edge(591, 592).
-edge(592, 593).
-edge(593, 594).
-edge(594, 595).
-edge(595, 596).
-edge(596, 597).
-edge(597, 598).
-edge(598, 599).
-edge(599, 600).
-edge(600, 601).
-edge(601, 602).
-edge(602, 603).
-edge(603, 604).
-edge(604, 605).
-edge(605, 606).
-edge(606, 607).
-edge(607, 608).
-edge(608, 609).
-edge(609, 610).
-edge(610, 611).
-edge(611, 612).
-edge(612, 613).
-edge(613, 614).
-edge(614, 615).
-edge(615, 616).
-edge(616, 617).
-edge(617, 618).
-edge(618, 619).
-edge(619, 620).
-edge(620, 621).
-edge(621, 622).
-edge(622, 623).
-edge(623, 624).
-edge(624, 625).
-edge(625, 626).
-edge(626, 627).
-edge(627, 628).
-edge(628, 629).
-edge(629, 630).
-edge(630, 631).
-edge(631, 632).
-edge(632, 633).
-edge(633, 634).
-edge(634, 635).
-edge(635, 636).
-edge(636, 637).
-edge(637, 638).
-edge(638, 639).
-edge(639, 640).
-edge(640, 641).
-edge(641, 642).
-edge(642, 643).
-edge(643, 644).
-edge(644, 645).
-edge(645, 646).
-edge(646, 647).
-edge(647, 648).
-edge(648, 649).
-edge(649, 650).
-edge(650, 651).
-edge(651, 652).
-edge(652, 653).
-edge(653, 654).
-edge(654, 655).
-edge(655, 656).
-edge(656, 657).
-edge(657, 658).
-edge(658, 659).
-edge(659, 660).
-edge(660, 661).
-edge(661, 662).
-edge(662, 663).
-edge(663, 664).
-edge(664, 665).
-edge(665, 666).
-edge(666, 667).
-edge(667, 668).
-edge(668, 669).
-edge(669, 670).
-edge(670, 671).
-edge(671, 672).
-edge(672, 673).
-edge(673, 674).
-edge(674, 675).
-edge(675, 676).
-edge(676, 677).
-edge(677, 678).
-edge(678, 679).
-edge(679, 680).
-edge(680, 681).
-edge(681, 682).
-edge(682, 683).
-edge(683, 684).
-edge(684, 685).
-edge(685, 686).
-edge(686, 687).
-edge(687, 688).
-edge(688, 689).
-edge(689, 690).
-edge(690, 691).
-edge(691, 692).
-edge(692, 693).
-edge(693, 694).
-edge(694, 695).
-edge(695, 696).
-edge(696, 697).
-edge(697, 698).
-edge(698, 699).
-edge(699, 700).
-edge(700, 701).
-edge(701, 702).
-edge(702, 703).
-edge(703, 704).
-edge(704, 705).
-edge(705, 706).
-edge(706, 707).
-edge(707, 708).
-edge(708, 709).
-edge(709, 710).
-edge(710, 711).
-edge(711, 712).
-edge(712, 713).
-edge(713, 714).
-edge(714, 715).
-edge(715, 716).
-edge(716, 717).
-edge(717, 718).
-edge(718, 719).
-edge(719, 720).
-edge(720, 721).
-edge(721, 722).
-edge(722, 723).
-edge(723, 724).
-edge(724, 725).
-edge(725, 726).
-edge(726, 727).
-edge(727, 728).
-edge(728, 729).
-edge(729, 730).
-edge(730, 731).
-edge(731, 732).
-edge(732, 733).
-edge(733, 734).
-edge(734, 735).
-edge(735, 736).
-edge(736, 737).
-edge(737, 738).
-edge(738, 739).
-edge(739, 740).
-edge(740, 741).
-edge(741, 742).
-edge(742, 743).
-edge(743, 744).
-edge(744, 745).
-edge(745, 746).
-edge(746, 747).
-edge(747, 748).
-edge(748, 749).
-edge(749, 750).
-edge(750, 751).
-edge(751, 752).
-edge(752, 753).
-edge(753, 754).
-edge(754, 755).
-edge(755, 756).
-edge(756, 757).
-edge(757, 758).
-edge(758, 759).
-edge(759, 760).
-edge(760, 761).
-edge(761, 762).
-edge(762, 763).
-edge(763, 764).
-edge(764, 765).
-edge(765, 766).
-edge(766, 767).
-edge(767, 768).
-edge(768, 769).
-edge(769, 770).
-edge(770, 771).
-edge(771, 772).
-edge(772, 773).
-edge(773, 774).
-edge(774, 775).
-edge(775, 776).
-edge(776, 777).
-edge(777, 778).
-edge(778, 779).
-edge(779, 780).
-edge(780, 781).
-edge(781, 782).
-edge(782, 783).
-edge(783, 784).
-edge(784, 785).
-edge(785, 786).
-edge(786, 787).
-edge(787, 788).
-edge(788, 789).
-edge(789, 790).
-edge(790, 791).
-edge(791, 792).
-edge(792, 793).
-edge(793, 794).
-edge(794, 795).
-edge(795, 796).
-edge(796, 797).
-edge(797, 798).
-edge(798, 799).
-edge(799, 800).
-edge(800, 801).
-edge(801, 802).
-edge(802, 803).
-edge(803, 804).
-edge(804, 805).
-edge(805, 806).
-edge(806, 807).
-edge(807, 808).
-edge(808, 809).
-edge(809, 810).
-edge(810, 811).
-edge(811, 812).
-edge(812, 813).
-edge(813, 814).
-edge(814, 815).
-edge(815, 816).
-edge(816, 817).
-edge(817, 818).
-edge(818, 819).
-edge(819, 820).
-edge(820, 821).
-edge(821, 822).
-edge(822, 823).
-edge(823, 824).
-edge(824, 825).
-edge(825, 826).
-edge(826, 827).
-edge(827, 828).
-edge(828, 829).
-edge(829, 830).
-edge(830, 831).
-edge(831, 832).
-edge(832, 833).
-edge(833, 834).
-edge(834, 835).
-edge(835, 836).
-edge(836, 837).
-edge(837, 838).
-edge(838, 839).
-edge(839, 840).
-edge(840, 841).
-edge(841, 842).
-edge(842, 843).
-edge(843, 844).
-edge(844, 845).
-edge(845, 846).
-edge(846, 847).
-edge(847, 848).
-edge(848, 849).
-edge(849, 850).
-edge(850, 851).
-edge(851, 852).
-edge(852, 853).
-edge(853, 854).
-edge(854, 855).
-edge(855, 856).
-edge(856, 857).
-edge(857, 858).
-edge(858, 859).
-edge(859, 860).
-edge(860, 861).
-edge(861, 862).
-edge(862, 863).
-edge(863, 864).
-edge(864, 865).
-edge(865, 866).
-edge(866, 867).
-edge(867, 868).
-edge(868, 869).
-edge(869, 870).
-edge(870, 871).
-edge(871, 872).
-edge(872, 873).
-edge(873, 874).
-edge(874, 875).
-edge(875, 876).
-edge(876, 877).
-edge(877, 878).
-edge(878, 879).
-edge(879, 880).
-edge(880, 881).
-edge(881, 882).
-edge(882, 883).
-edge(883, 884).
-edge(884, 885).
-edge(885, 886).
-edge(886, 887).
-edge(887, 888).
-edge(888, 889).
-edge(889, 890).
-edge(890, 891).
-edge(891, 892).
-edge(892, 893).
-edge(893, 894).
-edge(894, 895).
-edge(895, 896).
-edge(896, 897).
-edge(897, 898).
-edge(898, 899).
-edge(899, 900).
-edge(900, 901).
-edge(901, 902).
-edge(902, 903).
-edge(903, 904).
-edge(904, 905).
-edge(905, 906).
-edge(906, 907).
-edge(907, 908).
-edge(908, 909).
-edge(909, 910).
-edge(910, 911).
-edge(911, 912).
-edge(912, 913).
-edge(913, 914).
-edge(914, 915).
-edge(915, 916).
-edge(916, 917).
-edge(917, 918).
-edge(918, 919).
-edge(919, 920).
-edge(920, 921).
-edge(921, 922).
-edge(922, 923).
-edge(923, 924).
-edge(924, 925).
-edge(925, 926).
-edge(926, 927).
-edge(927, 928).
-edge(928, 929).
-edge(929, 930).
-edge(930, 931).
-edge(931, 932).
-edge(932, 933).
-edge(933, 934).
-edge(934, 935).
-edge(935, 936).
-edge(936, 937).
-edge(937, 938).
-edge(938, 939).
-edge(939, 940).
-edge(940, 941).
-edge(941, 942).
-edge(942, 943).
-edge(943, 944).
-edge(944, 945).
-edge(945, 946).
-edge(946, 947).
-edge(947, 948).
-edge(948, 949).
-edge(949, 950).
-edge(950, 951).
-edge(951, 952).
-edge(952, 953).
-edge(953, 954).
-edge(954, 955).
-edge(955, 956).
-edge(956, 957).
-edge(957, 958).
-edge(958, 959).
-edge(959, 960).
-edge(960, 961).
-edge(961, 962).
-edge(962, 963).
-edge(963, 964).
-edge(964, 965).
-edge(965, 966).
-edge(966, 967).
-edge(967, 968).
-edge(968, 969).
-edge(969, 970).
-edge(970, 971).
-edge(971, 972).
-edge(972, 973).
-edge(973, 974).
-edge(974, 975).
-edge(975, 976).
-edge(976, 977).
-edge(977, 978).
-edge(978, 979).
-edge(979, 980).
-edge(980, 981).
-edge(981, 982).
-edge(982, 983).
-edge(983, 984).
-edge(984, 985).
-edge(985, 986).
-edge(986, 987).
-edge(987, 988).
-edge(988, 989).
-edge(989, 990).
-edge(990, 991).
-edge(991, 992).
-edge(992, 993).
-edge(993, 994).
-edge(994, 995).
-edge(995, 996).
-edge(996, 997).
-edge(997, 998).
-edge(998, 999).
-edge(999, 1000).
-edge(1000, 1001).
-edge(1001, 1002).
-edge(1002, 1003).
-edge(1003, 1004).
-edge(1004, 1005).
-edge(1005, 1006).
-edge(1006, 1007).
-edge(1007, 1008).
-edge(1008, 1009).
-edge(1009, 1010).
-edge(1010, 1011).
-edge(1011, 1012).
-edge(1012, 1013).
-edge(1013, 1014).
-edge(1014, 1015).
-edge(1015, 1016).
-edge(1016, 1017).
-edge(1017, 1018).
-edge(1018, 1019).
-edge(1019, 1020).
-edge(1020, 1021).
-edge(1021, 1022).
-edge(1022, 1023).
-edge(1023, 1024).
-edge(1024, 1025).
-edge(1025, 1026).
-edge(1026, 1027).
-edge(1027, 1028).
-edge(1028, 1029).
-edge(1029, 1030).
-edge(1030, 1031).
-edge(1031, 1032).
-edge(1032, 1033).
-edge(1033, 1034).
-edge(1034, 1035).
-edge(1035, 1036).
-edge(1036, 1037).
-edge(1037, 1038).
-edge(1038, 1039).
-edge(1039, 1040).
-edge(1040, 1041).
-edge(1041, 1042).
-edge(1042, 1043).
-edge(1043, 1044).
-edge(1044, 1045).
-edge(1045, 1046).
-edge(1046, 1047).
-edge(1047, 1048).
-edge(1048, 1049).
-edge(1049, 1050).
-edge(1050, 1051).
-edge(1051, 1052).
-edge(1052, 1053).
-edge(1053, 1054).
-edge(1054, 1055).
-edge(1055, 1056).
-edge(1056, 1057).
-edge(1057, 1058).
-edge(1058, 1059).
-edge(1059, 1060).
-edge(1060, 1061).
-edge(1061, 1062).
-edge(1062, 1063).
-edge(1063, 1064).
-edge(1064, 1065).
-edge(1065, 1066).
-edge(1066, 1067).
-edge(1067, 1068).
-edge(1068, 1069).
-edge(1069, 1070).
-edge(1070, 1071).
-edge(1071, 1072).
-edge(1072, 1073).
-edge(1073, 1074).
-edge(1074, 1075).
-edge(1075, 1076).
-edge(1076, 1077).
-edge(1077, 1078).
-edge(1078, 1079).
-edge(1079, 1080).
-edge(1080, 1081).
-edge(1081, 1082).
-edge(1082, 1083).
-edge(1083, 1084).
-edge(1084, 1085).
-edge(1085, 1086).
-edge(1086, 1087).
-edge(1087, 1088).
-edge(1088, 1089).
-edge(1089, 1090).
-edge(1090, 1091).
-edge(1091, 1092).
-edge(1092, 1093).
-edge(1093, 1094).
-edge(1094, 1095).
-edge(1095, 1096).
-edge(1096, 1097).
-edge(1097, 1098).
-edge(1098, 1099).
-edge(1099, 1100).
-edge(1100, 1101).
-edge(1101, 1102).
-edge(1102, 1103).
-edge(1103, 1104).
-edge(1104, 1105).
-edge(1105, 1106).
-edge(1106, 1107).
-edge(1107, 1108).
-edge(1108, 1109).
-edge(1109, 1110).
-edge(1110, 1111).
-edge(1111, 1112).
-edge(1112, 1113).
-edge(1113, 1114).
-edge(1114, 1115).
-edge(1115, 1116).
-edge(1116, 1117).
-edge(1117, 1118).
-edge(1118, 1119).
-edge(1119, 1120).
-edge(1120, 1121).
-edge(1121, 1122).
-edge(1122, 1123).
-edge(1123, 1124).
-edge(1124, 1125).
-edge(1125, 1126).
-edge(1126, 1127).
-edge(1127, 1128).
-edge(1128, 1129).
-edge(1129, 1130).
-edge(1130, 1131).
-edge(1131, 1132).
-edge(1132, 1133).
-edge(1133, 1134).
-edge(1134, 1135).
-edge(1135, 1136).
-edge(1136, 1137).
-edge(1137, 1138).
-edge(1138, 1139).
-edge(1139, 1140).
-edge(1140, 1141).
-edge(1141, 1142).
-edge(1142, 1143).
-edge(1143, 1144).
-edge(1144, 1145).
-edge(1145, 1146).
-edge(1146, 1147).
-edge(1147, 1148).
-edge(1148, 1149).
-edge(1149, 1150).
-edge(1150, 1151).
-edge(1151, 1152).
-edge(1152, 1153).
-edge(1153, 1154).
-edge(1154, 1155).
-edge(1155, 1156).
-edge(1156, 1157).
-edge(1157, 1158).
-edge(1158, 1159).
-edge(1159, 1160).
-edge(1160, 1161).
-edge(1161, 1162).
-edge(1162, 1163).
-edge(1163, 1164).
-edge(1164, 1165).
-edge(1165, 1166).
-edge(1166, 1167).
-edge(1167, 1168).
-edge(1168, 1169).
-edge(1169, 1170).
-edge(1170, 1171).
-edge(1171, 1172).
-edge(1172, 1173).
-edge(1173, 1174).
-edge(1174, 1175).
-edge(1175, 1176).
-edge(1176, 1177).
-edge(1177, 1178).
-edge(1178, 1179).
-edge(1179, 1180).
-edge(1180, 1181).
-edge(1181, 1182).
-edge(1182, 1183).
-edge(1183, 1184).
-edge(1184, 1185).
-edge(1185, 1186).
-edge(1186, 1187).
-edge(1187, 1188).
-edge(1188, 1189).
-edge(1189, 1190).
-edge(1190, 1191).
-edge(1191, 1192).
-edge(1192, 1193).
-edge(1193, 1194).
-edge(1194, 1195).
-edge(1195, 1196).
-edge(1196, 1197).
-edge(1197, 1198).
-edge(1198, 1199).
-edge(1199, 1200).
-edge(1200, 1201).
-edge(1201, 1202).
-edge(1202, 1203).
-edge(1203, 1204).
-edge(1204, 1205).
-edge(1205, 1206).
-edge(1206, 1207).
-edge(1207, 1208).
-edge(1208, 1209).
-edge(1209, 1210).
-edge(1210, 1211).
-edge(1211, 1212).
-edge(1212, 1213).
-edge(1213, 1214).
-edge(1214, 1215).
-edge(1215, 1216).
-edge(1216, 1217).
-edge(1217, 1218).
-edge(1218, 1219).
-edge(1219, 1220).
-edge(1220, 1221).
-edge(1221, 1222).
-edge(1222, 1223).
-edge(1223, 1224).
-edge(1224, 1225).
-edge(1225, 1226).
-edge(1226, 1227).
-edge(1227, 1228).
-edge(1228, 1229).
-edge(1229, 1230).
-edge(1230, 1231).
-edge(1231, 1232).
-edge(1232, 1233).
-edge(1233, 1234).
-edge(1234, 1235).
-edge(1235, 1236).
-edge(1236, 1237).
-edge(1237, 1238).
-edge(1238, 1239).
-edge(1239, 1240).
-edge(1240, 1241).
-edge(1241, 1242).
-edge(1242, 1243).
-edge(1243, 1244).
-edge(1244, 1245).
-edge(1245, 1246).
-edge(1246, 1247).
-edge(1247, 1248).
-edge(1248, 1249).
-edge(1249, 1250).
-edge(1250, 1251).
-edge(1251, 1252).
-edge(1252, 1253).
-edge(1253, 1254).
-edge(1254, 1255).
-edge(1255, 1256).
-edge(1256, 1257).
-edge(1257, 1258).
-edge(1258, 1259).
-edge(1259, 1260).
-edge(1260, 1261).
-edge(1261, 1262).
-edge(1262, 1263).
-edge(1263, 1264).
-edge(1264, 1265).
-edge(1265, 1266).
-edge(1266, 1267).
-edge(1267, 1268).
-edge(1268, 1269).
-edge(1269, 1270).
-edge(1270, 1271).
-edge(1271, 1272).
-edge(1272, 1273).
-edge(1273, 1274).
-edge(1274, 1275).
-edge(1275, 1276).
-edge(1276, 1277).
-edge(1277, 1278).
-edge(1278, 1279).
-edge(1279, 1280).
-edge(1280, 1281).
-edge(1281, 1282).
-edge(1282, 1283).
-edge(1283, 1284).
-edge(1284, 1285).
-edge(1285, 1286).
-edge(1286, 1287).
-edge(1287, 1288).
-edge(1288, 1289).
-edge(1289, 1290).
-edge(1290, 1291).
-edge(1291, 1292).
-edge(1292, 1293).
-edge(1293, 1294).
-edge(1294, 1295).
-edge(1295, 1296).
-edge(1296, 1297).
-edge(1297, 1298).
-edge(1298, 1299).
-edge(1299, 1300).
-edge(1300, 1301).
-edge(1301, 1302).
-edge(1302, 1303).
-edge(1303, 1304).
-edge(1304, 1305).
-edge(1305, 1306).
-edge(1306, 1307).
-edge(1307, 1308).
-edge(1308, 1309).
-edge(1309, 1310).
-edge(1310, 1311).
-edge(1311, 1312).
-edge(1312, 1313).
-edge(1313, 1314).
-edge(1314, 1315).
-edge(1315, 1316).
-edge(1316, 1317).
-edge(1317, 1318).
-edge(1318, 1319).
-edge(1319, 1320).
-edge(1320, 1321).
-edge(1321, 1322).
-edge(1322, 1323).
-edge(1323, 1324).
-edge(1324, 1325).
-edge(1325, 1326).
-edge(1326, 1327).
-edge(1327, 1328).
-edge(1328, 1329).
-edge(1329, 1330).
-edge(1330, 1331).
-edge(1331, 1332).
-edge(1332, 1333).
-edge(1333, 1334).
-edge(1334, 1335).
-edge(1335, 1336).
-edge(1336, 1337).
-edge(1337, 1338).
-edge(1338, 1339).
-edge(1339, 1340).
-edge(1340, 1341).
-edge(1341, 1342).
-edge(1342, 1343).
-edge(1343, 1344).
-edge(1344, 1345).
-edge(1345, 1346).
-edge(1346, 1347).
-edge(1347, 1348).
-edge(1348, 1349).
-edge(1349, 1350).
-edge(1350, 1351).
-edge(1351, 1352).
-edge(1352, 1353).
-edge(1353, 1354).
-edge(1354, 1355).
-edge(1355, 1356).
-edge(1356, 1357).
-edge(1357, 1358).
-edge(1358, 1359).
-edge(1359, 1360).
-edge(1360, 1361).
-edge(1361, 1362).
-edge(1362, 1363).
-edge(1363, 1364).
-edge(1364, 1365).
-edge(1365, 1366).
-edge(1366, 1367).
-edge(1367, 1368).
-edge(1368, 1369).
-edge(1369, 1370).
-edge(1370, 1371).
-edge(1371, 1372).
-edge(1372, 1373).
-edge(1373, 1374).
-edge(1374, 1375).
-edge(1375, 1376).
-edge(1376, 1377).
-edge(1377, 1378).
-edge(1378, 1379).
-edge(1379, 1380).
-edge(1380, 1381).
-edge(1381, 1382).
-edge(1382, 1383).
-edge(1383, 1384).
-edge(1384, 1385).
-edge(1385, 1386).
-edge(1386, 1387).
-edge(1387, 1388).
-edge(1388, 1389).
-edge(1389, 1390).
-edge(1390, 1391).
-edge(1391, 1392).
-edge(1392, 1393).
-edge(1393, 1394).
-edge(1394, 1395).
-edge(1395, 1396).
-edge(1396, 1397).
-edge(1397, 1398).
-edge(1398, 1399).
-edge(1399, 1400).
-edge(1400, 1401).
-edge(1401, 1402).
-edge(1402, 1403).
-edge(1403, 1404).
-edge(1404, 1405).
-edge(1405, 1406).
-edge(1406, 1407).
-edge(1407, 1408).
-edge(1408, 1409).
-edge(1409, 1410).
-edge(1410, 1411).
-edge(1411, 1412).
-edge(1412, 1413).
-edge(1413, 1414).
-edge(1414, 1415).
-edge(1415, 1416).
-edge(1416, 1417).
-edge(1417, 1418).
-edge(1418, 1419).
-edge(1419, 1420).
-edge(1420, 1421).
-edge(1421, 1422).
-edge(1422, 1423).
-edge(1423, 1424).
-edge(1424, 1425).
-edge(1425, 1426).
-edge(1426, 1427).
-edge(1427, 1428).
-edge(1428, 1429).
-edge(1429, 1430).
-edge(1430, 1431).
-edge(1431, 1432).
-edge(1432, 1433).
-edge(1433, 1434).
-edge(1434, 1435).
-edge(1435, 1436).
-edge(1436, 1437).
-edge(1437, 1438).
-edge(1438, 1439).
-edge(1439, 1440).
-edge(1440, 1441).
-edge(1441, 1442).
-edge(1442, 1443).
-edge(1443, 1444).
-edge(1444, 1445).
-edge(1445, 1446).
-edge(1446, 1447).
-edge(1447, 1448).
-edge(1448, 1449).
-edge(1449, 1450).
-edge(1450, 1451).
-edge(1451, 1452).
-edge(1452, 1453).
-edge(1453, 1454).
-edge(1454, 1455).
-edge(1455, 1456).
-edge(1456, 1457).
-edge(1457, 1458).
-edge(1458, 1459).
-edge(1459, 1460).
-edge(1460, 1461).
-edge(1461, 1462).
-edge(1462, 1463).
-edge(1463, 1464).
-edge(1464, 1465).
-edge(1465, 1466).
-edge(1466, 1467).
-edge(1467, 1468).
-edge(1468, 1469).
-edge(1469, 1470).
-edge(1470, 1471).
-edge(1471, 1472).
-edge(1472, 1473).
-edge(1473, 1474).
-edge(1474, 1475).
-edge(1475, 1476).
-edge(1476, 1477).
-edge(1477, 1478).
-edge(1478, 1479).
-edge(1479, 1480).
-edge(1480, 1481).
-edge(1481, 1482).
-edge(1482, 1483).
-edge(1483, 1484).
-edge(1484, 1485).
-edge(1485, 1486).
-edge(1486, 1487).
-edge(1487, 1488).
-edge(1488, 1489).
-edge(1489, 1490).
-edge(1490, 1491).
-edge(1491, 1492).
-edge(1492, 1493).
-edge(1493, 1494).
-edge(1494, 1495).
-edge(1495, 1496).
-edge(1496, 1497).
-edge(1497, 1498).
-edge(1498, 1499).
-edge(1499, 1500).
-edge(1500, 1501).
-edge(1501, 1502).
-edge(1502, 1503).
-edge(1503, 1504).
-edge(1504, 1505).
-edge(1505, 1506).
-edge(1506, 1507).
-edge(1507, 1508).
-edge(1508, 1509).
-edge(1509, 1510).
-edge(1510, 1511).
-edge(1511, 1512).
-edge(1512, 1513).
-edge(1513, 1514).
-edge(1514, 1515).
-edge(1515, 1516).
-edge(1516, 1517).
-edge(1517, 1518).
-edge(1518, 1519).
-edge(1519, 1520).
-edge(1520, 1521).
-edge(1521, 1522).
-edge(1522, 1523).
-edge(1523, 1524).
-edge(1524, 1525).
-edge(1525, 1526).
-edge(1526, 1527).
-edge(1527, 1528).
-edge(1528, 1529).
-edge(1529, 1530).
-edge(1530, 1531).
-edge(1531, 1532).
-edge(1532, 1533).
-edge(1533, 1534).
-edge(1534, 1535).
-edge(1535, 1536).
-edge(1536, 1537).
-edge(1537, 1538).
-edge(1538, 1539).
-edge(1539, 1540).
-edge(1540, 1541).
-edge(1541, 1542).
-edge(1542, 1543).
-edge(1543, 1544).
-edge(1544, 1545).
-edge(1545, 1546).
-edge(1546, 1547).
-edge(1547, 1548).
-edge(1548, 1549).
-edge(1549, 1550).
-edge(1550, 1551).
-edge(1551, 1552).
-edge(1552, 1553).
-edge(1553, 1554).
-edge(1554, 1555).
-edge(1555, 1556).
-edge(1556, 1557).
-edge(1557, 1558).
-edge(1558, 1559).
-edge(1559, 1560).
-edge(1560, 1561).
-edge(1561, 1562).
-edge(1562, 1563).
-edge(1563, 1564).
-edge(1564, 1565).
-edge(1565, 1566).
-edge(1566, 1567).
-edge(1567, 1568).
-edge(1568, 1569).
-edge(1569, 1570).
-edge(1570, 1571).
-edge(1571, 1572).
-edge(1572, 1573).
-edge(1573, 1574).
-edge(1574, 1575).
-edge(1575, 1576).
-edge(1576, 1577).
-edge(1577, 1578).
-edge(1578, 1579).
-edge(1579, 1580).
-edge(1580, 1581).
-edge(1581, 1582).
-edge(1582, 1583).
-edge(1583, 1584).
-edge(1584, 1585).
-edge(1585, 1586).
-edge(1586, 1587).
-edge(1587, 1588).
-edge(1588, 1589).
-edge(1589, 1590).
-edge(1590, 1591).
-edge(1591, 1592).
-edge(1592, 1593).
-edge(1593, 1594).
-edge(1594, 1595).
-edge(1595, 1596).
-edge(1596, 1597).
-edge(1597, 1598).
-edge(1598, 1599).
-edge(1599, 1600).
-edge(1600, 1601).
-edge(1601, 1602).
-edge(1602, 1603).
-edge(1603, 1604).
-edge(1604, 1605).
-edge(1605, 1606).
-edge(1606, 1607).
-edge(1607, 1608).
-edge(1608, 1609).
-edge(1609, 1610).
-edge(1610, 1611).
-edge(1611, 1612).
-edge(1612, 1613).
-edge(1613, 1614).
-edge(1614, 1615).
-edge(1615, 1616).
-edge(1616, 1617).
-edge(1617, 1618).
-edge(1618, 1619).
-edge(1619, 1620).
-edge(1620, 1621).
-edge(1621, 1622).
-edge(1622, 1623).
-edge(1623, 1624).
-edge(1624, 1625).
-edge(1625, 1626).
-edge(1626, 1627).
-edge(1627, 1628).
-edge(1628, 1629).
-edge(1629, 1630).
-edge(1630, 1631).
-edge(1631, 1632).
-edge(1632, 1633).
-edge(1633, 1634).
-edge(1634, 1635).
-edge(1635, 1636).
-edge(1636, 1637).
-edge(1637, 1638).
-edge(1638, 1639).
-edge(1639, 1640).
-edge(1640, 1641).
-edge(1641, 1642).
-edge(1642, 1643).
-edge(1643, 1644).
-edge(1644, 1645).
-edge(1645, 1646).
-edge(1646, 1647).
-edge(1647, 1648).
-edge(1648, 1649).
-edge(1649, 1650).
-edge(1650, 1651).
-edge(1651, 1652).
-edge(1652, 1653).
-edge(1653, 1654).
-edge(1654, 1655).
-edge(1655, 1656).
-edge(1656, 1657).
-edge(1657, 1658).
-edge(1658, 1659).
-edge(1659, 1660).
-edge(1660, 1661).
-edge(1661, 1662).
-edge(1662, 1663).
-edge(1663, 1664).
-edge(1664, 1665).
-edge(1665, 1666).
-edge(1666, 1667).
-edge(1667, 1668).
-edge(1668, 1669).
-edge(1669, 1670).
-edge(1670, 1671).
-edge(1671, 1672).
-edge(1672, 1673).
-edge(1673, 1674).
-edge(1674, 1675).
-edge(1675, 1676).
-edge(1676, 1677).
-edge(1677, 1678).
-edge(1678, 1679).
-edge(1679, 1680).
-edge(1680, 1681).
-edge(1681, 1682).
-edge(1682, 1683).
-edge(1683, 1684).
-edge(1684, 1685).
-edge(1685, 1686).
-edge(1686, 1687).
-edge(1687, 1688).
-edge(1688, 1689).
-edge(1689, 1690).
-edge(1690, 1691).
-edge(1691, 1692).
-edge(1692, 1693).
-edge(1693, 1694).
-edge(1694, 1695).
-edge(1695, 1696).
-edge(1696, 1697).
-edge(1697, 1698).
-edge(1698, 1699).
-edge(1699, 1700).
-edge(1700, 1701).
-edge(1701, 1702).
-edge(1702, 1703).
-edge(1703, 1704).
-edge(1704, 1705).
-edge(1705, 1706).
-edge(1706, 1707).
-edge(1707, 1708).
-edge(1708, 1709).
-edge(1709, 1710).
-edge(1710, 1711).
-edge(1711, 1712).
-edge(1712, 1713).
-edge(1713, 1714).
-edge(1714, 1715).
-edge(1715, 1716).
-edge(1716, 1717).
-edge(1717, 1718).
-edge(1718, 1719).
-edge(1719, 1720).
-edge(1720, 1721).
-edge(1721, 1722).
-edge(1722, 1723).
-edge(1723, 1724).
-edge(1724, 1725).
-edge(1725, 1726).
-edge(1726, 1727).
-edge(1727, 1728).
-edge(1728, 1729).
-edge(1729, 1730).
-edge(1730, 1731).
-edge(1731, 1732).
-edge(1732, 1733).
-edge(1733, 1734).
-edge(1734, 1735).
-edge(1735, 1736).
-edge(1736, 1737).
-edge(1737, 1738).
-edge(1738, 1739).
-edge(1739, 1740).
-edge(1740, 1741).
-edge(1741, 1742).
-edge(1742, 1743).
-edge(1743, 1744).
-edge(1744, 1745).
-edge(1745, 1746).
-edge(1746, 1747).
-edge(1747, 1748).
-edge(1748, 1749).
-edge(1749, 1750).
-edge(1750, 1751).
-edge(1751, 1752).
-edge(1752, 1753).
-edge(1753, 1754).
-edge(1754, 1755).
-edge(1755, 1756).
-edge(1756, 1757).
-edge(1757, 1758).
-edge(1758, 1759).
-edge(1759, 1760).
-edge(1760, 1761).
-edge(1761, 1762).
-edge(1762, 1763).
-edge(1763, 1764).
-edge(1764, 1765).
-edge(1765, 1766).
-edge(1766, 1767).
-edge(1767, 1768).
-edge(1768, 1769).
-edge(1769, 1770).
-edge(1770, 1771).
-edge(1771, 1772).
-edge(1772, 1773).
-edge(1773, 1774).
-edge(1774, 1775).
-edge(1775, 1776).
-edge(1776, 1777).
-edge(1777, 1778).
-edge(1778, 1779).
-edge(1779, 1780).
-edge(1780, 1781).
-edge(1781, 1782).
-edge(1782, 1783).
-edge(1783, 1784).
-edge(1784, 1785).
-edge(1785, 1786).
-edge(1786, 1787).
-edge(1787, 1788).
-edge(1788, 1789).
-edge(1789, 1790).
-edge(1790, 1791).
-edge(1791, 1792).
-edge(1792, 1793).
-edge(1793, 1794).
-edge(1794, 1795).
-edge(1795, 1796).
-edge(1796, 1797).
-edge(1797, 1798).
-edge(1798, 1799).
-edge(1799, 1800).
-edge(1800, 1801).
-edge(1801, 1802).
-edge(1802, 1803).
-edge(1803, 1804).
-edge(1804, 1805).
-edge(1805, 1806).
-edge(1806, 1807).
-edge(1807, 1808).
-edge(1808, 1809).
-edge(1809, 1810).
-edge(1810, 1811).
-edge(1811, 1812).
-edge(1812, 1813).
-edge(1813, 1814).
-edge(1814, 1815).
-edge(1815, 1816).
-edge(1816, 1817).
-edge(1817, 1818).
-edge(1818, 1819).
-edge(1819, 1820).
-edge(1820, 1821).
-edge(1821, 1822).
-edge(1822, 1823).
-edge(1823, 1824).
-edge(1824, 1825).
-edge(1825, 1826).
-edge(1826, 1827).
-edge(1827, 1828).
-edge(1828, 1829).
-edge(1829, 1830).
-edge(1830, 1831).
-edge(1831, 1832).
-edge(1832, 1833).
-edge(1833, 1834).
-edge(1834, 1835).
-edge(1835, 1836).
-edge(1836, 1837).
-edge(1837, 1838).
-edge(1838, 1839).
-edge(1839, 1840).
-edge(1840, 1841).
-edge(1841, 1842).
-edge(1842, 1843).
-edge(1843, 1844).
-edge(1844, 1845).
-edge(1845, 1846).
-edge(1846, 1847).
-edge(1847, 1848).
-edge(1848, 1849).
-edge(1849, 1850).
-edge(1850, 1851).
-edge(1851, 1852).
-edge(1852, 1853).
-edge(1853, 1854).
-edge(1854, 1855).
-edge(1855, 1856).
-edge(1856, 1857).
-edge(1857, 1858).
-edge(1858, 1859).
-edge(1859, 1860).
-edge(1860, 1861).
-edge(1861, 1862).
-edge(1862, 1863).
-edge(1863, 1864).
-edge(1864, 1865).
-edge(1865, 1866).
-edge(1866, 1867).
-edge(1867, 1868).
-edge(1868, 1869).
-edge(1869, 1870).
-edge(1870, 1871).
-edge(1871, 1872).
-edge(1872, 1873).
-edge(1873, 1874).
-edge(1874, 1875).
-edge(1875, 1876).
-edge(1876, 1877).
-edge(1877, 1878).
-edge(1878, 1879).
-edge(1879, 1880).
-edge(1880, 1881).
-edge(1881, 1882).
-edge(1882, 1883).
-edge(1883, 1884).
-edge(1884, 1885).
-edge(1885, 1886).
-edge(1886, 1887).
-edge(1887, 1888).
-edge(1888, 1889).
-edge(1889, 1890).
-edge(1890, 1891).
-edge(1891, 1892).
-edge(1892, 1893).
-edge(1893, 1894).
-edge(1894, 1895).
-edge(1895, 1896).
-edge(1896, 1897).
-edge(1897, 1898).
-edge(1898, 1899).
-edge(1899, 1900).
-edge(1900, 1901).
-edge(1901, 1902).
-edge(1902, 1903).
-edge(1903, 1904).
-edge(1904, 1905).
-edge(1905, 1906).
-edge(1906, 1907).
-edge(1907, 1908).
-edge(1908, 1909).
-edge(1909, 1910).
-edge(1910, 1911).
-edge(1911, 1912).
-edge(1912, 1913).
-edge(1913, 1914).
-edge(1914, 1915).
-edge(1915, 1916).
-edge(1916, 1917).
-edge(1917, 1918).
-edge(1918, 1919).
-edge(1919, 1920).
-edge(1920, 1921).
-edge(1921, 1922).
-edge(1922, 1923).
-edge(1923, 1924).
-edge(1924, 1925).
-edge(1925, 1926).
-edge(1926, 1927).
-edge(1927, 1928).
-edge(1928, 1929).
-edge(1929, 1930).
-edge(1930, 1931).
-edge(1931, 1932).
-edge(1932, 1933).
-edge(1933, 1934).
-edge(1934, 1935).
-edge(1935, 1936).
-edge(1936, 1937).
-edge(1937, 1938).
-edge(1938, 1939).
-edge(1939, 1940).
-edge(1940, 1941).
-edge(1941, 1942).
-edge(1942, 1943).
-edge(1943, 1944).
-edge(1944, 1945).
-edge(1945, 1946).
-edge(1946, 1947).
-edge(1947, 1948).
-edge(1948, 1949).
-edge(1949, 1950).
-edge(1950, 1951).
-edge(1951, 1952).
-edge(1952, 1953).
-edge(1953, 1954).
-edge(1954, 1955).
-edge(1955, 1956).
-edge(1956, 1957).
-edge(1957, 1958).
-edge(1958, 1959).
-edge(1959, 1960).
-edge(1960, 1961).
-edge(1961, 1962).
-edge(1962, 1963).
-edge(1963, 1964).
-edge(1964, 1965).
-edge(1965, 1966).
-edge(1966, 1967).
-edge(1967, 1968).
-edge(1968, 1969).
-edge(1969, 1970).
-edge(1970, 1971).
-edge(1971, 1972).
-edge(1972, 1973).
-edge(1973, 1974).
-edge(1974, 1975).
-edge(1975, 1976).
-edge(1976, 1977).
-edge(1977, 1978).
-edge(1978, 1979).
-edge(1979, 1980).
-edge(1980, 1981).
-edge(1981, 1982).
-edge(1982, 1983).
-edge(1983, 1984).
-edge(1984, 1985).
-edge(1985, 1986).
-edge(1986, 1987).
-edge(1987, 1988).
-edge(1988, 1989).
-edge(1989, 1990).
-edge(1990, 1991).
-edge(1991, 1992).
-edge(1992, 1993).
-edge(1993, 1994).
-edge(1994, 1995).
-edge(1995, 1996).
-edge(1996, 1997).
-edge(1997, 1998).
-edge(1998, 1999).
-edge(1999, 2000).
-edge(2000, 2001).
-edge(2001, 2002).
-edge(2002, 2003).
-edge(2003, 2004).
-edge(2004, 2005).
-edge(2005, 2006).
-edge(2006, 2007).
-edge(2007, 2008).
-edge(2008, 2009).
-edge(2009, 2010).
-edge(2010, 2011).
-edge(2011, 2012).
-edge(2012, 2013).
-edge(2013, 2014).
-edge(2014, 2015).
-edge(2015, 2016).
-edge(2016, 2017).
-edge(2017, 2018).
-edge(2018, 2019).
-edge(2019, 2020).
-edge(2020, 2021).
-edge(2021, 2022).
-edge(2022, 2023).
-edge(2023, 2024).
-edge(2024, 2025).
-edge(2025, 2026).
-edge(2026, 2027).
-edge(2027, 2028).
-edge(2028, 2029).
-edge(2029, 2030).
-edge(2030, 2031).
-edge(2031, 2032).
-edge(2032, 2033).
-edge(2033, 2034).
-edge(2034, 2035).
-edge(2035, 2036).
-edge(2036, 2037).
-edge(2037, 2038).
-edge(2038, 2039).
-edge(2039, 2040).
-edge(2040, 2041).
-edge(2041, 2042).
-edge(2042, 2043).
-edge(2043, 2044).
-edge(2044, 2045).
-edge(2045, 2046).
-edge(2046, 2047).
-edge(2047, 2048).
-edge(2048, 2049).
-edge(2049, 2050).
-edge(2050, 2051).
-edge(2051, 2052).
-edge(2052, 2053).
-edge(2053, 2054).
-edge(2054, 2055).
-edge(2055, 2056).
-edge(2056, 2057).
-edge(2057, 2058).
-edge(2058, 2059).
-edge(2059, 2060).
-edge(2060, 2061).
-edge(2061, 2062).
-edge(2062, 2063).
-edge(2063, 2064).
-edge(2064, 2065).
-edge(2065, 2066).
-edge(2066, 2067).
-edge(2067, 2068).
-edge(2068, 2069).
-edge(2069, 2070).
-edge(2070, 2071).
-edge(2071, 2072).
-edge(2072, 2073).
-edge(2073, 2074).
-edge(2074, 2075).
-edge(2075, 2076).
-edge(2076, 2077).
-edge(2077, 2078).
-edge(2078, 2079).
-edge(2079, 2080).
-edge(2080, 2081).
-edge(2081, 2082).
-edge(2082, 2083).
-edge(2083, 2084).
-edge(2084, 2085).
-edge(2085, 2086).
-edge(2086, 2087).
-edge(2087, 2088).
-edge(2088, 2089).
-edge(2089, 2090).
-edge(2090, 2091).
-edge(2091, 2092).
-edge(2092, 2093).
-edge(2093, 2094).
-edge(2094, 2095).
-edge(2095, 2096).
-edge(2096, 2097).
-edge(2097, 2098).
-edge(2098, 2099).
-edge(2099, 2100).
-edge(2100, 2101).
-edge(2101, 2102).
-edge(2102, 2103).
-edge(2103, 2104).
-edge(2104, 2105).
-edge(2105, 2106).
-edge(2106, 2107).
-edge(2107, 2108).
-edge(2108, 2109).
-edge(2109, 2110).
-edge(2110, 2111).
-edge(2111, 2112).
-edge(2112, 2113).
-edge(2113, 2114).
-edge(2114, 2115).
-edge(2115, 2116).
-edge(2116, 2117).
-edge(2117, 2118).
-edge(2118, 2119).
-edge(2119, 2120).
-edge(2120, 2121).
-edge(2121, 2122).
-edge(2122, 2123).
-edge(2123, 2124).
-edge(2124, 2125).
-edge(2125, 2126).
-edge(2126, 2127).
-edge(2127, 2128).
-edge(2128, 2129).
-edge(2129, 2130).
-edge(2130, 2131).
-edge(2131, 2132).
-edge(2132, 2133).
-edge(2133, 2134).
-edge(2134, 2135).
-edge(2135, 2136).
-edge(2136, 2137).
-edge(2137, 2138).
-edge(2138, 2139).
-edge(2139, 2140).
-edge(2140, 2141).
-edge(2141, 2142).
-edge(2142, 2143).
-edge(2143, 2144).
-edge(2144, 2145).
-edge(2145, 2146).
-edge(2146, 2147).
-edge(2147, 2148).
-edge(2148, 2149).
-edge(2149, 2150).
-edge(2150, 2151).
-edge(2151, 2152).
-edge(2152, 2153).
-edge(2153, 2154).
-edge(2154, 2155).
-edge(2155, 2156).
-edge(2156, 2157).
-edge(2157, 2158).
-edge(2158, 2159).
-edge(2159, 2160).
-edge(2160, 2161).
-edge(2161, 2162).
-edge(2162, 2163).
-edge(2163, 2164).
-edge(2164, 2165).
-edge(2165, 2166).
-edge(2166, 2167).
-edge(2167, 2168).
-edge(2168, 2169).
-edge(2169, 2170).
-edge(2170, 2171).
-edge(2171, 2172).
-edge(2172, 2173).
-edge(2173, 2174).
-edge(2174, 2175).
-edge(2175, 2176).
-edge(2176, 2177).
-edge(2177, 2178).
-edge(2178, 2179).
-edge(2179, 2180).
-edge(2180, 2181).
-edge(2181, 2182).
-edge(2182, 2183).
-edge(2183, 2184).
-edge(2184, 2185).
-edge(2185, 2186).
-edge(2186, 2187).
-edge(2187, 2188).
-edge(2188, 2189).
-edge(2189, 2190).
-edge(2190, 2191).
-edge(2191, 2192).
-edge(2192, 2193).
-edge(2193, 2194).
-edge(2194, 2195).
-edge(2195, 2196).
-edge(2196, 2197).
-edge(2197, 2198).
-edge(2198, 2199).
-edge(2199, 2200).
-edge(2200, 2201).
-edge(2201, 2202).
-edge(2202, 2203).
-edge(2203, 2204).
-edge(2204, 2205).
-edge(2205, 2206).
-edge(2206, 2207).
-edge(2207, 2208).
-edge(2208, 2209).
-edge(2209, 2210).
-edge(2210, 2211).
-edge(2211, 2212).
-edge(2212, 2213).
-edge(2213, 2214).
-edge(2214, 2215).
-edge(2215, 2216).
-edge(2216, 2217).
-edge(2217, 2218).
-edge(2218, 2219).
-edge(2219, 2220).
-edge(2220, 2221).
-edge(2221, 2222).
-edge(2222, 2223).
-edge(2223, 2224).
-edge(2224, 2225).
-edge(2225, 2226).
-edge(2226, 2227).
-edge(2227, 2228).
-edge(2228, 2229).
-edge(2229, 2230).
-edge(2230, 2231).
-edge(2231, 2232).
-edge(2232, 2233).
-edge(2233, 2234).
-edge(2234, 2235).
-edge(2235, 2236).
-edge(2236, 2237).
-edge(2237, 2238).
-edge(2238, 2239).
-edge(2239, 2240).
-edge(2240, 2241).
-edge(2241, 2242).
-edge(2242, 2243).
-edge(2243, 2244).
-edge(2244, 2245).
-edge(2245, 2246).
-edge(2246, 2247).
-edge(2247, 2248).
-edge(2248, 2249).
-edge(2249, 2250).
-edge(2250, 2251).
-edge(2251, 2252).
-edge(2252, 2253).
-edge(2253, 2254).
-edge(2254, 2255).
-edge(2255, 2256).
-edge(2256, 2257).
-edge(2257, 2258).
-edge(2258, 2259).
-edge(2259, 2260).
-edge(2260, 2261).
-edge(2261, 2262).
-edge(2262, 2263).
-edge(2263, 2264).
-edge(2264, 2265).
-edge(2265, 2266).
-edge(2266, 2267).
-edge(2267, 2268).
-edge(2268, 2269).
-edge(2269, 2270).
-edge(2270, 2271).
-edge(2271, 2272).
-edge(2272, 2273).
-edge(2273, 2274).
-edge(2274, 2275).
-edge(2275, 2276).
-edge(2276, 2277).
-edge(2277, 2278).
-edge(2278, 2279).
-edge(2279, 2280).
-edge(2280, 2281).
-edge(2281, 2282).
-edge(2282, 2283).
-edge(2283, 2284).
-edge(2284, 2285).
-edge(2285, 2286).
-edge(2286, 2287).
-edge(2287, 2288).
-edge(2288, 2289).
-edge(2289, 2290).
-edge(2290, 2291).
-edge(2291, 2292).
-edge(2292, 2293).
-edge(2293, 2294).
-edge(2294, 2295).
-edge(2295, 2296).
-edge(2296, 2297).
-edge(2297, 2298).
-edge(2298, 2299).
-edge(2299, 2300).
-edge(2300, 2301).
-edge(2301, 2302).
-edge(2302, 2303).
-edge(2303, 2304).
-edge(2304, 2305).
-edge(2305, 2306).
-edge(2306, 2307).
-edge(2307, 2308).
-edge(2308, 2309).
-edge(2309, 2310).
-edge(2310, 2311).
-edge(2311, 2312).
-edge(2312, 2313).
-edge(2313, 2314).
-edge(2314, 2315).
-edge(2315, 2316).
-edge(2316, 2317).
-edge(2317, 2318).
-edge(2318, 2319).
-edge(2319, 2320).
-edge(2320, 2321).
-edge(2321, 2322).
-edge(2322, 2323).
-edge(2323, 2324).
-edge(2324, 2325).
-edge(2325, 2326).
-edge(2326, 2327).
-edge(2327, 2328).
-edge(2328, 2329).
-edge(2329, 2330).
-edge(2330, 2331).
-edge(2331, 2332).
-edge(2332, 2333).
-edge(2333, 2334).
-edge(2334, 2335).
-edge(2335, 2336).
-edge(2336, 2337).
-edge(2337, 2338).
-edge(2338, 2339).
-edge(2339, 2340).
-edge(2340, 2341).
-edge(2341, 2342).
-edge(2342, 2343).
-edge(2343, 2344).
-edge(2344, 2345).
-edge(2345, 2346).
-edge(2346, 2347).
-edge(2347, 2348).
-edge(2348, 2349).
-edge(2349, 2350).
-edge(2350, 2351).
-edge(2351, 2352).
-edge(2352, 2353).
-edge(2353, 2354).
-edge(2354, 2355).
-edge(2355, 2356).
-edge(2356, 2357).
-edge(2357, 2358).
-edge(2358, 2359).
-edge(2359, 2360).
-edge(2360, 2361).
-edge(2361, 2362).
-edge(2362, 2363).
-edge(2363, 2364).
-edge(2364, 2365).
-edge(2365, 2366).
-edge(2366, 2367).
-edge(2367, 2368).
-edge(2368, 2369).
-edge(2369, 2370).
-edge(2370, 2371).
-edge(2371, 2372).
-edge(2372, 2373).
-edge(2373, 2374).
-edge(2374, 2375).
-edge(2375, 2376).
-edge(2376, 2377).
-edge(2377, 2378).
-edge(2378, 2379).
-edge(2379, 2380).
-edge(2380, 2381).
-edge(2381, 2382).
-edge(2382, 2383).
-edge(2383, 2384).
-edge(2384, 2385).
-edge(2385, 2386).
-edge(2386, 2387).
-edge(2387, 2388).
-edge(2388, 2389).
-edge(2389, 2390).
-edge(2390, 2391).
-edge(2391, 2392).
-edge(2392, 2393).
-edge(2393, 2394).
-edge(2394, 2395).
-edge(2395, 2396).
-edge(2396, 2397).
-edge(2397, 2398).
-edge(2398, 2399).
-edge(2399, 2400).
-edge(2400, 2401).
-edge(2401, 2402).
-edge(2402, 2403).
-edge(2403, 2404).
-edge(2404, 2405).
-edge(2405, 2406).
-edge(2406, 2407).
-edge(2407, 2408).
-edge(2408, 2409).
-edge(2409, 2410).
-edge(2410, 2411).
-edge(2411, 2412).
-edge(2412, 2413).
-edge(2413, 2414).
-edge(2414, 2415).
-edge(2415, 2416).
-edge(2416, 2417).
-edge(2417, 2418).
-edge(2418, 2419).
-edge(2419, 2420).
-edge(2420, 2421).
-edge(2421, 2422).
-edge(2422, 2423).
-edge(2423, 2424).
-edge(2424, 2425).
-edge(2425, 2426).
-edge(2426, 2427).
-edge(2427, 2428).
-edge(2428, 2429).
-edge(2429, 2430).
-edge(2430, 2431).
-edge(2431, 2432).
-edge(2432, 2433).
-edge(2433, 2434).
-edge(2434, 2435).
-edge(2435, 2436).
-edge(2436, 2437).
-edge(2437, 2438).
-edge(2438, 2439).
-edge(2439, 2440).
-edge(2440, 2441).
-edge(2441, 2442).
-edge(2442, 2443).
-edge(2443, 2444).
-edge(2444, 2445).
-edge(2445, 2446).
-edge(2446, 2447).
-edge(2447, 2448).
-edge(2448, 2449).
-edge(2449, 2450).
-edge(2450, 2451).
-edge(2451, 2452).
-edge(2452, 2453).
-edge(2453, 2454).
-edge(2454, 2455).
-edge(2455, 2456).
-edge(2456, 2457).
-edge(2457, 2458).
-edge(2458, 2459).
-edge(2459, 2460).
-edge(2460, 2461).
-edge(2461, 2462).
-edge(2462, 2463).
-edge(2463, 2464).
-edge(2464, 2465).
-edge(2465, 2466).
-edge(2466, 2467).
-edge(2467, 2468).
-edge(2468, 2469).
-edge(2469, 2470).
-edge(2470, 2471).
-edge(2471, 2472).
-edge(2472, 2473).
-edge(2473, 2474).
-edge(2474, 2475).
-edge(2475, 2476).
-edge(2476, 2477).
-edge(2477, 2478).
-edge(2478, 2479).
-edge(2479, 2480).
-edge(2480, 2481).
-edge(2481, 2482).
-edge(2482, 2483).
-edge(2483, 2484).
-edge(2484, 2485).
-edge(2485, 2486).
-edge(2486, 2487).
-edge(2487, 2488).
-edge(2488, 2489).
-edge(2489, 2490).
-edge(2490, 2491).
-edge(2491, 2492).
-edge(2492, 2493).
-edge(2493, 2494).
-edge(2494, 2495).
-edge(2495, 2496).
-edge(2496, 2497).
-edge(2497, 2498).
-edge(2498, 2499).
-edge(2499, 2500).
-edge(2500, 2501).
-edge(2501, 2502).
-edge(2502, 2503).
-edge(2503, 2504).
-edge(2504, 2505).
-edge(2505, 2506).
-edge(2506, 2507).
-edge(2507, 2508).
-edge(2508, 2509).
-edge(2509, 2510).
-edge(2510, 2511).
-edge(2511, 2512).
-edge(2512, 2513).
-edge(2513, 2514).
-edge(2514, 2515).
-edge(2515, 2516).
-edge(2516, 2517).
-edge(2517, 2518).
-edge(2518, 2519).
-edge(2519, 2520).
-edge(2520, 2521).
-edge(2521, 2522).
-edge(2522, 2523).
-edge(2523, 2524).
-edge(2524, 2525).
-edge(2525, 2526).
-edge(2526, 2527).
-edge(2527, 2528).
-edge(2528, 2529).
-edge(2529, 2530).
-edge(2530, 2531).
-edge(2531, 2532).
-edge(2532, 2533).
-edge(2533, 2534).
-edge(2534, 2535).
-edge(2535, 2536).
-edge(2536, 2537).
-edge(2537, 2538).
-edge(2538, 2539).
-edge(2539, 2540).
-edge(2540, 2541).
-edge(2541, 2542).
-edge(2542, 2543).
-edge(2543, 2544).
-edge(2544, 2545).
-edge(2545, 2546).
-edge(2546, 2547).
-edge(2547, 2548).
-edge(2548, 2549).
-edge(2549, 2550).
-edge(2550, 2551).
-edge(2551, 2552).
-edge(2552, 2553).
-edge(2553, 2554).
-edge(2554, 2555).
-edge(2555, 2556).
-edge(2556, 2557).
-edge(2557, 2558).
-edge(2558, 2559).
-edge(2559, 2560).
-edge(2560, 2561).
-edge(2561, 2562).
-edge(2562, 2563).
-edge(2563, 2564).
-edge(2564, 2565).
-edge(2565, 2566).
-edge(2566, 2567).
-edge(2567, 2568).
-edge(2568, 2569).
-edge(2569, 2570).
-edge(2570, 2571).
-edge(2571, 2572).
-edge(2572, 2573).
-edge(2573, 2574).
-edge(2574, 2575).
-edge(2575, 2576).
-edge(2576, 2577).
-edge(2577, 2578).
-edge(2578, 2579).
-edge(2579, 2580).
-edge(2580, 2581).
-edge(2581, 2582).
-edge(2582, 2583).
-edge(2583, 2584).
-edge(2584, 2585).
-edge(2585, 2586).
-edge(2586, 2587).
-edge(2587, 2588).
-edge(2588, 2589).
-edge(2589, 2590).
-edge(2590, 2591).
-edge(2591, 2592).
-edge(2592, 2593).
-edge(2593, 2594).
-edge(2594, 2595).
-edge(2595, 2596).
-edge(2596, 2597).
-edge(2597, 2598).
-edge(2598, 2599).
-edge(2599, 2600).
-edge(2600, 2601).
-edge(2601, 2602).
-edge(2602, 2603).
-edge(2603, 2604).
-edge(2604, 2605).
-edge(2605, 2606).
-edge(2606, 2607).
-edge(2607, 2608).
-edge(2608, 2609).
-edge(2609, 2610).
-edge(2610, 2611).
-edge(2611, 2612).
-edge(2612, 2613).
-edge(2613, 2614).
-edge(2614, 2615).
-edge(2615, 2616).
-edge(2616, 2617).
-edge(2617, 2618).
-edge(2618, 2619).
-edge(2619, 2620).
-edge(2620, 2621).
-edge(2621, 2622).
-edge(2622, 2623).
-edge(2623, 2624).
-edge(2624, 2625).
-edge(2625, 2626).
-edge(2626, 2627).
-edge(2627, 2628).
-edge(2628, 2629).
-edge(2629, 2630).
-edge(2630, 2631).
-edge(2631, 2632).
-edge(2632, 2633).
-edge(2633, 2634).
-edge(2634, 2635).
-edge(2635, 2636).
-edge(2636, 2637).
-edge(2637, 2638).
-edge(2638, 2639).
-edge(2639, 2640).
-edge(2640, 2641).
-edge(2641, 2642).
-edge(2642, 2643).
-edge(2643, 2644).
-edge(2644, 2645).
-edge(2645, 2646).
-edge(2646, 2647).
-edge(2647, 2648).
-edge(2648, 2649).
-edge(2649, 2650).
-edge(2650, 2651).
-edge(2651, 2652).
-edge(2652, 2653).
-edge(2653, 2654).
-edge(2654, 2655).
-edge(2655, 2656).
-edge(2656, 2657).
-edge(2657, 2658).
-edge(2658, 2659).
-edge(2659, 2660).
-edge(2660, 2661).
-edge(2661, 2662).
-edge(2662, 2663).
-edge(2663, 2664).
-edge(2664, 2665).
-edge(2665, 2666).
-edge(2666, 2667).
-edge(2667, 2668).
-edge(2668, 2669).
-edge(2669, 2670).
-edge(2670, 2671).
-edge(2671, 2672).
-edge(2672, 2673).
-edge(2673, 2674).
-edge(2674, 2675).
-edge(2675, 2676).
-edge(2676, 2677).
-edge(2677, 2678).
-edge(2678, 2679).
-edge(2679, 2680).
-edge(2680, 2681).
-edge(2681, 2682).
-edge(2682, 2683).
-edge(2683, 2684).
-edge(2684, 2685).
-edge(2685, 2686).
-edge(2686, 2687).
-edge(2687, 2688).
-edge(2688, 2689).
-edge(2689, 2690).
-edge(2690, 2691).
-edge(2691, 2692).
-edge(2692, 2693).
-edge(2693, 2694).
-edge(2694, 2695).
-edge(2695, 2696).
-edge(2696, 2697).
-edge(2697, 2698).
-edge(2698, 2699).
-edge(2699, 2700).
-edge(2700, 2701).
-edge(2701, 2702).
-edge(2702, 2703).
-edge(2703, 2704).
-edge(2704, 2705).
-edge(2705, 2706).
-edge(2706, 2707).
-edge(2707, 2708).
-edge(2708, 2709).
-edge(2709, 2710).
-edge(2710, 2711).
-edge(2711, 2712).
-edge(2712, 2713).
-edge(2713, 2714).
-edge(2714, 2715).
-edge(2715, 2716).
-edge(2716, 2717).
-edge(2717, 2718).
-edge(2718, 2719).
-edge(2719, 2720).
-edge(2720, 2721).
-edge(2721, 2722).
-edge(2722, 2723).
-edge(2723, 2724).
-edge(2724, 2725).
-edge(2725, 2726).
-edge(2726, 2727).
-edge(2727, 2728).
-edge(2728, 2729).
-edge(2729, 2730).
-edge(2730, 2731).
-edge(2731, 2732).
-edge(2732, 2733).
-edge(2733, 2734).
-edge(2734, 2735).
-edge(2735, 2736).
-edge(2736, 2737).
-edge(2737, 2738).
-edge(2738, 2739).
-edge(2739, 2740).
-edge(2740, 2741).
-edge(2741, 2742).
-edge(2742, 2743).
-edge(2743, 2744).
-edge(2744, 2745).
-edge(2745, 2746).
-edge(2746, 2747).
-edge(2747, 2748).
-edge(2748, 2749).
-edge(2749, 2750).
-edge(2750, 2751).
-edge(2751, 2752).
-edge(2752, 2753).
-edge(2753, 2754).
-edge(2754, 2755).
-edge(2755, 2756).
-edge(2756, 2757).
-edge(2757, 2758).
-edge(2758, 2759).
-edge(2759, 2760).
-edge(2760, 2761).
-edge(2761, 2762).
-edge(2762, 2763).
-edge(2763, 2764).
-edge(2764, 2765).
-edge(2765, 2766).
-edge(2766, 2767).
-edge(2767, 2768).
-edge(2768, 2769).
-edge(2769, 2770).
-edge(2770, 2771).
-edge(2771, 2772).
-edge(2772, 2773).
-edge(2773, 2774).
-edge(2774, 2775).
-edge(2775, 2776).
-edge(2776, 2777).
-edge(2777, 2778).
-edge(2778, 2779).
-edge(2779, 2780).
-edge(2780, 2781).
-edge(2781, 2782).
-edge(2782, 2783).
-edge(2783, 2784).
-edge(2784, 2785).
-edge(2785, 2786).
-edge(2786, 2787).
-edge(2787, 2788).
-edge(2788, 2789).
-edge(2789, 2790).
-edge(2790, 2791).
-edge(2791, 2792).
-edge(2792, 2793).
-edge(2793, 2794).
-edge(2794, 2795).
-edge(2795, 2796).
-edge(2796, 2797).
-edge(2797, 2798).
-edge(2798, 2799).
-edge(2799, 2800).
-edge(2800, 2801).
-edge(2801, 2802).
-edge(2802, 2803).
-edge(2803, 2804).
-edge(2804, 2805).
-edge(2805, 2806).
-edge(2806, 2807).
-edge(2807, 2808).
-edge(2808, 2809).
-edge(2809, 2810).
-edge(2810, 2811).
-edge(2811, 2812).
-edge(2812, 2813).
-edge(2813, 2814).
-edge(2814, 2815).
-edge(2815, 2816).
-edge(2816, 2817).
-edge(2817, 2818).
-edge(2818, 2819).
-edge(2819, 2820).
-edge(2820, 2821).
-edge(2821, 2822).
-edge(2822, 2823).
-edge(2823, 2824).
-edge(2824, 2825).
-edge(2825, 2826).
-edge(2826, 2827).
-edge(2827, 2828).
-edge(2828, 2829).
-edge(2829, 2830).
-edge(2830, 2831).
-edge(2831, 2832).
-edge(2832, 2833).
-edge(2833, 2834).
-edge(2834, 2835).
-edge(2835, 2836).
-edge(2836, 2837).
-edge(2837, 2838).
-edge(2838, 2839).
-edge(2839, 2840).
-edge(2840, 2841).
-edge(2841, 2842).
-edge(2842, 2843).
-edge(2843, 2844).
-edge(2844, 2845).
-edge(2845, 2846).
-edge(2846, 2847).
-edge(2847, 2848).
-edge(2848, 2849).
-edge(2849, 2850).
-edge(2850, 2851).
-edge(2851, 2852).
-edge(2852, 2853).
-edge(2853, 2854).
-edge(2854, 2855).
-edge(2855, 2856).
-edge(2856, 2857).
-edge(2857, 2858).
-edge(2858, 2859).
-edge(2859, 2860).
-edge(2860, 2861).
-edge(2861, 2862).
-edge(2862, 2863).
-edge(2863, 2864).
-edge(2864, 2865).
-edge(2865, 2866).
-edge(2866, 2867).
-edge(2867, 2868).
-edge(2868, 2869).
-edge(2869, 2870).
-edge(2870, 2871).
-edge(2871, 2872).
-edge(2872, 2873).
-edge(2873, 2874).
-edge(2874, 2875).
-edge(2875, 2876).
-edge(2876, 2877).
-edge(2877, 2878).
-edge(2878, 2879).
-edge(2879, 2880).
-edge(2880, 2881).
-edge(2881, 2882).
-edge(2882, 2883).
-edge(2883, 2884).
-edge(2884, 2885).
-edge(2885, 2886).
-edge(2886, 2887).
-edge(2887, 2888).
-edge(2888, 2889).
-edge(2889, 2890).
-edge(2890, 2891).
-edge(2891, 2892).
-edge(2892, 2893).
-edge(2893, 2894).
-edge(2894, 2895).
-edge(2895, 2896).
-edge(2896, 2897).
-edge(2897, 2898).
-edge(2898, 2899).
-edge(2899, 2900).
-edge(2900, 2901).
-edge(2901, 2902).
-edge(2902, 2903).
-edge(2903, 2904).
-edge(2904, 2905).
-edge(2905, 2906).
-edge(2906, 2907).
-edge(2907, 2908).
-edge(2908, 2909).
-edge(2909, 2910).
-edge(2910, 2911).
-edge(2911, 2912).
-edge(2912, 2913).
-edge(2913, 2914).
-edge(2914, 2915).
-edge(2915, 2916).
-edge(2916, 2917).
-edge(2917, 2918).
-edge(2918, 2919).
-edge(2919, 2920).
-edge(2920, 2921).
-edge(2921, 2922).
-edge(2922, 2923).
-edge(2923, 2924).
-edge(2924, 2925).
-edge(2925, 2926).
-edge(2926, 2927).
-edge(2927, 2928).
-edge(2928, 2929).
-edge(2929, 2930).
-edge(2930, 2931).
-edge(2931, 2932).
-edge(2932, 2933).
-edge(2933, 2934).
-edge(2934, 2935).
-edge(2935, 2936).
-edge(2936, 2937).
-edge(2937, 2938).
-edge(2938, 2939).
-edge(2939, 2940).
-edge(2940, 2941).
-edge(2941, 2942).
-edge(2942, 2943).
-edge(2943, 2944).
-edge(2944, 2945).
-edge(2945, 2946).
-edge(2946, 2947).
-edge(2947, 2948).
-edge(2948, 2949).
-edge(2949, 2950).
-edge(2950, 2951).
-edge(2951, 2952).
-edge(2952, 2953).
-edge(2953, 2954).
-edge(2954, 2955).
-edge(2955, 2956).
-edge(2956, 2957).
-edge(2957, 2958).
-edge(2958, 2959).
-edge(2959, 2960).
-edge(2960, 2961).
-edge(2961, 2962).
-edge(2962, 2963).
-edge(2963, 2964).
-edge(2964, 2965).
-edge(2965, 2966).
-edge(2966, 2967).
-edge(2967, 2968).
-edge(2968, 2969).
-edge(2969, 2970).
-edge(2970, 2971).
-edge(2971, 2972).
-edge(2972, 2973).
-edge(2973, 2974).
-edge(2974, 2975).
-edge(2975, 2976).
-edge(2976, 2977).
-edge(2977, 2978).
-edge(2978, 2979).
-edge(2979, 2980).
-edge(2980, 2981).
-edge(2981, 2982).
-edge(2982, 2983).
-edge(2983, 2984).
-edge(2984, 2985).
-edge(2985, 2986).
-edge(2986, 2987).
-edge(2987, 2988).
-edge(2988, 2989).
-edge(2989, 2990).
-edge(2990, 2991).
-edge(2991, 2992).
-edge(2992, 2993).
-edge(2993, 2994).
-edge(2994, 2995).
-edge(2995, 2996).
-edge(2996, 2997).
-edge(2997, 2998).
-edge(2998, 2999).
-edge(2999, 3000).
-edge(3000, 3001).
-edge(3001, 3002).
-edge(3002, 3003).
-edge(3003, 3004).
-edge(3004, 3005).
-edge(3005, 3006).
-edge(3006, 3007).
-edge(3007, 3008).
-edge(3008, 3009).
-edge(3009, 3010).
-edge(3010, 3011).
-edge(3011, 3012).
-edge(3012, 3013).
-edge(3013, 3014).
-edge(3014, 3015).
-edge(3015, 3016).
-edge(3016, 3017).
-edge(3017, 3018).
-edge(3018, 3019).
-edge(3019, 3020).
-edge(3020, 3021).
-edge(3021, 3022).
-edge(3022, 3023).
-edge(3023, 3024).
-edge(3024, 3025).
-edge(3025, 3026).
-edge(3026, 3027).
-edge(3027, 3028).
-edge(3028, 3029).
-edge(3029, 3030).
-edge(3030, 3031).
-edge(3031, 3032).
-edge(3032, 3033).
-edge(3033, 3034).
-edge(3034, 3035).
-edge(3035, 3036).
-edge(3036, 3037).
-edge(3037, 3038).
-edge(3038, 3039).
-edge(3039, 3040).
-edge(3040, 3041).
-edge(3041, 3042).
-edge(3042, 3043).
-edge(3043, 3044).
-edge(3044, 3045).
-edge(3045, 3046).
-edge(3046, 3047).
-edge(3047, 3048).
-edge(3048, 3049).
-edge(3049, 3050).
-edge(3050, 3051).
-edge(3051, 3052).
-edge(3052, 3053).
-edge(3053, 3054).
-edge(3054, 3055).
-edge(3055, 3056).
-edge(3056, 3057).
-edge(3057, 3058).
-edge(3058, 3059).
-edge(3059, 3060).
-edge(3060, 3061).
-edge(3061, 3062).
-edge(3062, 3063).
-edge(3063, 3064).
-edge(3064, 3065).
-edge(3065, 3066).
-edge(3066, 3067).
-edge(3067, 3068).
-edge(3068, 3069).
-edge(3069, 3070).
-edge(3070, 3071).
-edge(3071, 3072).
-edge(3072, 3073).
-edge(3073, 3074).
-edge(3074, 3075).
-edge(3075, 3076).
-edge(3076, 3077).
-edge(3077, 3078).
-edge(3078, 3079).
-edge(3079, 3080).
-edge(3080, 3081).
-edge(3081, 3082).
-edge(3082, 3083).
-edge(3083, 3084).
-edge(3084, 3085).
-edge(3085, 3086).
-edge(3086, 3087).
-edge(3087, 3088).
-edge(3088, 3089).
-edge(3089, 3090).
-edge(3090, 3091).
-edge(3091, 3092).
-edge(3092, 3093).
-edge(3093, 3094).
-edge(3094, 3095).
-edge(3095, 3096).
-edge(3096, 3097).
-edge(3097, 3098).
-edge(3098, 3099).
-edge(3099, 3100).
-edge(3100, 3101).
-edge(3101, 3102).
-edge(3102, 3103).
-edge(3103, 3104).
-edge(3104, 3105).
-edge(3105, 3106).
-edge(3106, 3107).
-edge(3107, 3108).
-edge(3108, 3109).
-edge(3109, 3110).
-edge(3110, 3111).
-edge(3111, 3112).
-edge(3112, 3113).
-edge(3113, 3114).
-edge(3114, 3115).
-edge(3115, 3116).
-edge(3116, 3117).
-edge(3117, 3118).
-edge(3118, 3119).
-edge(3119, 3120).
-edge(3120, 3121).
-edge(3121, 3122).
-edge(3122, 3123).
-edge(3123, 3124).
-edge(3124, 3125).
-edge(3125, 3126).
-edge(3126, 3127).
-edge(3127, 3128).
-edge(3128, 3129).
-edge(3129, 3130).
-edge(3130, 3131).
-edge(3131, 3132).
-edge(3132, 3133).
-edge(3133, 3134).
-edge(3134, 3135).
-edge(3135, 3136).
-edge(3136, 3137).
-edge(3137, 3138).
-edge(3138, 3139).
-edge(3139, 3140).
-edge(3140, 3141).
-edge(3141, 3142).
-edge(3142, 3143).
-edge(3143, 3144).
-edge(3144, 3145).
-edge(3145, 3146).
-edge(3146, 3147).
-edge(3147, 3148).
-edge(3148, 3149).
-edge(3149, 3150).
-edge(3150, 3151).
-edge(3151, 3152).
-edge(3152, 3153).
-edge(3153, 3154).
-edge(3154, 3155).
-edge(3155, 3156).
-edge(3156, 3157).
-edge(3157, 3158).
-edge(3158, 3159).
-edge(3159, 3160).
-edge(3160, 3161).
-edge(3161, 3162).
-edge(3162, 3163).
-edge(3163, 3164).
-edge(3164, 3165).
-edge(3165, 3166).
-edge(3166, 3167).
-edge(3167, 3168).
-edge(3168, 3169).
-edge(3169, 3170).
-edge(3170, 3171).
-edge(3171, 3172).
-edge(3172, 3173).
-edge(3173, 3174).
-edge(3174, 3175).
-edge(3175, 3176).
-edge(3176, 3177).
-edge(3177, 3178).
-edge(3178, 3179).
-edge(3179, 3180).
-edge(3180, 3181).
-edge(3181, 3182).
-edge(3182, 3183).
-edge(3183, 3184).
-edge(3184, 3185).
-edge(3185, 3186).
-edge(3186, 3187).
-edge(3187, 3188).
-edge(3188, 3189).
-edge(3189, 3190).
-edge(3190, 3191).
-edge(3191, 3192).
-edge(3192, 3193).
-edge(3193, 3194).
-edge(3194, 3195).
-edge(3195, 3196).
-edge(3196, 3197).
-edge(3197, 3198).
-edge(3198, 3199).
-edge(3199, 3200).
-edge(3200, 3201).
-edge(3201, 3202).
-edge(3202, 3203).
-edge(3203, 3204).
-edge(3204, 3205).
-edge(3205, 3206).
-edge(3206, 3207).
-edge(3207, 3208).
-edge(3208, 3209).
-edge(3209, 3210).
-edge(3210, 3211).
-edge(3211, 3212).
-edge(3212, 3213).
-edge(3213, 3214).
-edge(3214, 3215).
-edge(3215, 3216).
-edge(3216, 3217).
-edge(3217, 3218).
-edge(3218, 3219).
-edge(3219, 3220).
-edge(3220, 3221).
-edge(3221, 3222).
-edge(3222, 3223).
-edge(3223, 3224).
-edge(3224, 3225).
-edge(3225, 3226).
-edge(3226, 3227).
-edge(3227, 3228).
-edge(3228, 3229).
-edge(3229, 3230).
-edge(3230, 3231).
-edge(3231, 3232).
-edge(3232, 3233).
-edge(3233, 3234).
-edge(3234, 3235).
-edge(3235, 3236).
-edge(3236, 3237).
-edge(3237, 3238).
-edge(3238, 3239).
-edge(3239, 3240).
-edge(3240, 3241).
-edge(3241, 3242).
-edge(3242, 3243).
-edge(3243, 3244).
-edge(3244, 3245).
-edge(3245, 3246).
-edge(3246, 3247).
-edge(3247, 3248).
-edge(3248, 3249).
-edge(3249, 3250).
-edge(3250, 3251).
-edge(3251, 3252).
-edge(3252, 3253).
-edge(3253, 3254).
-edge(3254, 3255).
-edge(3255, 3256).
-edge(3256, 3257).
-edge(3257, 3258).
-edge(3258, 3259).
-edge(3259, 3260).
-edge(3260, 3261).
-edge(3261, 3262).
-edge(3262, 3263).
-edge(3263, 3264).
-edge(3264, 3265).
-edge(3265, 3266).
-edge(3266, 3267).
-edge(3267, 3268).
-edge(3268, 3269).
-edge(3269, 3270).
-edge(3270, 3271).
-edge(3271, 3272).
-edge(3272, 3273).
-edge(3273, 3274).
-edge(3274, 3275).
-edge(3275, 3276).
-edge(3276, 3277).
-edge(3277, 3278).
-edge(3278, 3279).
-edge(3279, 3280).
-edge(3280, 3281).
-edge(3281, 3282).
-edge(3282, 3283).
-edge(3283, 3284).
-edge(3284, 3285).
-edge(3285, 3286).
-edge(3286, 3287).
-edge(3287, 3288).
-edge(3288, 3289).
-edge(3289, 3290).
-edge(3290, 3291).
-edge(3291, 3292).
-edge(3292, 3293).
-edge(3293, 3294).
-edge(3294, 3295).
-edge(3295, 3296).
-edge(3296, 3297).
-edge(3297, 3298).
-edge(3298, 3299).
-edge(3299, 3300).
-edge(3300, 3301).
-edge(3301, 3302).
-edge(3302, 3303).
-edge(3303, 3304).
-edge(3304, 3305).
-edge(3305, 3306).
-edge(3306, 3307).
-edge(3307, 3308).
-edge(3308, 3309).
-edge(3309, 3310).
-edge(3310, 3311).
-edge(3311, 3312).
-edge(3312, 3313).
-edge(3313, 3314).
-edge(3314, 3315).
-edge(3315, 3316).
-edge(3316, 3317).
-edge(3317, 3318).
-edge(3318, 3319).
-edge(3319, 3320).
-edge(3320, 3321).
-edge(3321, 3322).
-edge(3322, 3323).
-edge(3323, 3324).
-edge(3324, 3325).
-edge(3325, 3326).
-edge(3326, 3327).
-edge(3327, 3328).
-edge(3328, 3329).
-edge(3329, 3330).
-edge(3330, 3331).
-edge(3331, 3332).
-edge(3332, 3333).
-edge(3333, 3334).
-edge(3334, 3335).
-edge(3335, 3336).
-edge(3336, 3337).
-edge(3337, 3338).
-edge(3338, 3339).
-edge(3339, 3340).
-edge(3340, 3341).
-edge(3341, 3342).
-edge(3342, 3343).
-edge(3343, 3344).
-edge(3344, 3345).
-edge(3345, 3346).
-edge(3346, 3347).
-edge(3347, 3348).
-edge(3348, 3349).
-edge(3349, 3350).
-edge(3350, 3351).
-edge(3351, 3352).
-edge(3352, 3353).
-edge(3353, 3354).
-edge(3354, 3355).
-edge(3355, 3356).
-edge(3356, 3357).
-edge(3357, 3358).
-edge(3358, 3359).
-edge(3359, 3360).
-edge(3360, 3361).
-edge(3361, 3362).
-edge(3362, 3363).
-edge(3363, 3364).
-edge(3364, 3365).
-edge(3365, 3366).
-edge(3366, 3367).
-edge(3367, 3368).
-edge(3368, 3369).
-edge(3369, 3370).
-edge(3370, 3371).
-edge(3371, 3372).
-edge(3372, 3373).
-edge(3373, 3374).
-edge(3374, 3375).
-edge(3375, 3376).
-edge(3376, 3377).
-edge(3377, 3378).
-edge(3378, 3379).
-edge(3379, 3380).
-edge(3380, 3381).
-edge(3381, 3382).
-edge(3382, 3383).
-edge(3383, 3384).
-edge(3384, 3385).
-edge(3385, 3386).
-edge(3386, 3387).
-edge(3387, 3388).
-edge(3388, 3389).
-edge(3389, 3390).
-edge(3390, 3391).
-edge(3391, 3392).
-edge(3392, 3393).
-edge(3393, 3394).
-edge(3394, 3395).
-edge(3395, 3396).
-edge(3396, 3397).
-edge(3397, 3398).
-edge(3398, 3399).
-edge(3399, 3400).
-edge(3400, 3401).
-edge(3401, 3402).
-edge(3402, 3403).
-edge(3403, 3404).
-edge(3404, 3405).
-edge(3405, 3406).
-edge(3406, 3407).
-edge(3407, 3408).
-edge(3408, 3409).
-edge(3409, 3410).
-edge(3410, 3411).
-edge(3411, 3412).
-edge(3412, 3413).
-edge(3413, 3414).
-edge(3414, 3415).
-edge(3415, 3416).
-edge(3416, 3417).
-edge(3417, 3418).
-edge(3418, 3419).
-edge(3419, 3420).
-edge(3420, 3421).
-edge(3421, 3422).
-edge(3422, 3423).
-edge(3423, 3424).
-edge(3424, 3425).
-edge(3425, 3426).
-edge(3426, 3427).
-edge(3427, 3428).
-edge(3428, 3429).
-edge(3429, 3430).
-edge(3430, 3431).
-edge(3431, 3432).
-edge(3432, 3433).
-edge(3433, 3434).
-edge(3434, 3435).
-edge(3435, 3436).
-edge(3436, 3437).
-edge(3437, 3438).
-edge(3438, 3439).
-edge(3439, 3440).
-edge(3440, 3441).
-edge(3441, 3442).
-edge(3442, 3443).
-edge(3443, 3444).
-edge(3444, 3445).
-edge(3445, 3446).
-edge(3446, 3447).
-edge(3447, 3448).
-edge(3448, 3449).
-edge(3449, 3450).
-edge(3450, 3451).
-edge(3451, 3452).
-edge(3452, 3453).
-edge(3453, 3454).
-edge(3454, 3455).
-edge(3455, 3456).
-edge(3456, 3457).
-edge(3457, 3458).
-edge(3458, 3459).
-edge(3459, 3460).
-edge(3460, 3461).
-edge(3461, 3462).
-edge(3462, 3463).
-edge(3463, 3464).
-edge(3464, 3465).
-edge(3465, 3466).
-edge(3466, 3467).
-edge(3467, 3468).
-edge(3468, 3469).
-edge(3469, 3470).
-edge(3470, 3471).
-edge(3471, 3472).
-edge(3472, 3473).
-edge(3473, 3474).
-edge(3474, 3475).
-edge(3475, 3476).
-edge(3476, 3477).
-edge(3477, 3478).
-edge(3478, 3479).
-edge(3479, 3480).
-edge(3480, 3481).
-edge(3481, 3482).
-edge(3482, 3483).
-edge(3483, 3484).
-edge(3484, 3485).
-edge(3485, 3486).
-edge(3486, 3487).
-edge(3487, 3488).
-edge(3488, 3489).
-edge(3489, 3490).
-edge(3490, 3491).
-edge(3491, 3492).
-edge(3492, 3493).
-edge(3493, 3494).
-edge(3494, 3495).
-edge(3495, 3496).
-edge(3496, 3497).
-edge(3497, 3498).
-edge(3498, 3499).
-edge(3499, 3500).
-edge(3500, 3501).
-edge(3501, 3502).
-edge(3502, 3503).
-edge(3503, 3504).
-edge(3504, 3505).
-edge(3505, 3506).
-edge(3506, 3507).
-edge(3507, 3508).
-edge(3508, 3509).
-edge(3509, 3510).
-edge(3510, 3511).
-edge(3511, 3512).
-edge(3512, 3513).
-edge(3513, 3514).
-edge(3514, 3515).
-edge(3515, 3516).
-edge(3516, 3517).
-edge(3517, 3518).
-edge(3518, 3519).
-edge(3519, 3520).
-edge(3520, 3521).
-edge(3521, 3522).
-edge(3522, 3523).
-edge(3523, 3524).
-edge(3524, 3525).
-edge(3525, 3526).
-edge(3526, 3527).
-edge(3527, 3528).
-edge(3528, 3529).
-edge(3529, 3530).
-edge(3530, 3531).
-edge(3531, 3532).
-edge(3532, 3533).
-edge(3533, 3534).
-edge(3534, 3535).
-edge(3535, 3536).
-edge(3536, 3537).
-edge(3537, 3538).
-edge(3538, 3539).
-edge(3539, 3540).
-edge(3540, 3541).
-edge(3541, 3542).
-edge(3542, 3543).
-edge(3543, 3544).
-edge(3544, 3545).
-edge(3545, 3546).
-edge(3546, 3547).
-edge(3547, 3548).
-edge(3548, 3549).
-edge(3549, 3550).
-edge(3550, 3551).
-edge(3551, 3552).
-edge(3552, 3553).
-edge(3553, 3554).
-edge(3554, 3555).
-edge(3555, 3556).
-edge(3556, 3557).
-edge(3557, 3558).
-edge(3558, 3559).
-edge(3559, 3560).
-edge(3560, 3561).
-edge(3561, 3562).
-edge(3562, 3563).
-edge(3563, 3564).
-edge(3564, 3565).
-edge(3565, 3566).
-edge(3566, 3567).
-edge(3567, 3568).
-edge(3568, 3569).
-edge(3569, 3570).
-edge(3570, 3571).
-edge(3571, 3572).
-edge(3572, 3573).
-edge(3573, 3574).
-edge(3574, 3575).
-edge(3575, 3576).
-edge(3576, 3577).
-edge(3577, 3578).
-edge(3578, 3579).
-edge(3579, 3580).
-edge(3580, 3581).
-edge(3581, 3582).
-edge(3582, 3583).
-edge(3583, 3584).
-edge(3584, 3585).
-edge(3585, 3586).
-edge(3586, 3587).
-edge(3587, 3588).
-edge(3588, 3589).
-edge(3589, 3590).
-edge(3590, 3591).
-edge(3591, 3592).
-edge(3592, 3593).
-edge(3593, 3594).
-edge(3594, 3595).
-edge(3595, 3596).
-edge(3596, 3597).
-edge(3597, 3598).
-edge(3598, 3599).
-edge(3599, 3600).
-edge(3600, 3601).
-edge(3601, 3602).
-edge(3602, 3603).
-edge(3603, 3604).
-edge(3604, 3605).
-edge(3605, 3606).
-edge(3606, 3607).
-edge(3607, 3608).
-edge(3608, 3609).
-edge(3609, 3610).
-edge(3610, 3611).
-edge(3611, 3612).
-edge(3612, 3613).
-edge(3613, 3614).
-edge(3614, 3615).
-edge(3615, 3616).
-edge(3616, 3617).
-edge(3617, 3618).
-edge(3618, 3619).
-edge(3619, 3620).
-edge(3620, 3621).
-edge(3621, 3622).
-edge(3622, 3623).
-edge(3623, 3624).
-edge(3624, 3625).
-edge(3625, 3626).
-edge(3626, 3627).
-edge(3627, 3628).
-edge(3628, 3629).
-edge(3629, 3630).
-edge(3630, 3631).
-edge(3631, 3632).
-edge(3632, 3633).
-edge(3633, 3634).
-edge(3634, 3635).
-edge(3635, 3636).
-edge(3636, 3637).
-edge(3637, 3638).
-edge(3638, 3639).
-edge(3639, 3640).
-edge(3640, 3641).
-edge(3641, 3642).
-edge(3642, 3643).
-edge(3643, 3644).
-edge(3644, 3645).
-edge(3645, 3646).
-edge(3646, 3647).
-edge(3647, 3648).
-edge(3648, 3649).
-edge(3649, 3650).
-edge(3650, 3651).
-edge(3651, 3652).
-edge(3652, 3653).
-edge(3653, 3654).
-edge(3654, 3655).
-edge(3655, 3656).
-edge(3656, 3657).
-edge(3657, 3658).
-edge(3658, 3659).
-edge(3659, 3660).
-edge(3660, 3661).
-edge(3661, 3662).
-edge(3662, 3663).
-edge(3663, 3664).
-edge(3664, 3665).
-edge(3665, 3666).
-edge(3666, 3667).
-edge(3667, 3668).
-edge(3668, 3669).
-edge(3669, 3670).
-edge(3670, 3671).
-edge(3671, 3672).
-edge(3672, 3673).
-edge(3673, 3674).
-edge(3674, 3675).
-edge(3675, 3676).
-edge(3676, 3677).
-edge(3677, 3678).
-edge(3678, 3679).
-edge(3679, 3680).
-edge(3680, 3681).
-edge(3681, 3682).
-edge(3682, 3683).
-edge(3683, 3684).
-edge(3684, 3685).
-edge(3685, 3686).
-edge(3686, 3687).
-edge(3687, 3688).
-edge(3688, 3689).
-edge(3689, 3690).
-edge(3690, 3691).
-edge(3691, 3692).
-edge(3692, 3693).
-edge(3693, 3694).
-edge(3694, 3695).
-edge(3695, 3696).
-edge(3696, 3697).
-edge(3697, 3698).
-edge(3698, 3699).
-edge(3699, 3700).
-edge(3700, 3701).
-edge(3701, 3702).
-edge(3702, 3703).
-edge(3703, 3704).
-edge(3704, 3705).
-edge(3705, 3706).
-edge(3706, 3707).
-edge(3707, 3708).
-edge(3708, 3709).
-edge(3709, 3710).
-edge(3710, 3711).
-edge(3711, 3712).
-edge(3712, 3713).
-edge(3713, 3714).
-edge(3714, 3715).
-edge(3715, 3716).
-edge(3716, 3717).
-edge(3717, 3718).
-edge(3718, 3719).
-edge(3719, 3720).
-edge(3720, 3721).
-edge(3721, 3722).
-edge(3722, 3723).
-edge(3723, 3724).
-edge(3724, 3725).
-edge(3725, 3726).
-edge(3726, 3727).
-edge(3727, 3728).
-edge(3728, 3729).
-edge(3729, 3730).
-edge(3730, 3731).
-edge(3731, 3732).
-edge(3732, 3733).
-edge(3733, 3734).
-edge(3734, 3735).
-edge(3735, 3736).
-edge(3736, 3737).
-edge(3737, 3738).
-edge(3738, 3739).
-edge(3739, 3740).
-edge(3740, 3741).
-edge(3741, 3742).
-edge(3742, 3743).
-edge(3743, 3744).
-edge(3744, 3745).
-edge(3745, 3746).
-edge(3746, 3747).
-edge(3747, 3748).
-edge(3748, 3749).
-edge(3749, 3750).
-edge(3750, 3751).
-edge(3751, 3752).
-edge(3752, 3753).
-edge(3753, 3754).
-edge(3754, 3755).
-edge(3755, 3756).
-edge(3756, 3757).
-edge(3757, 3758).
-edge(3758, 3759).
-edge(3759, 3760).
-edge(3760, 3761).
-edge(3761, 3762).
-edge(3762, 3763).
-edge(3763, 3764).
-edge(3764, 3765).
-edge(3765, 3766).
-edge(3766, 3767).
-edge(3767, 3768).
-edge(3768, 3769).
-edge(3769, 3770).
-edge(3770, 3771).
-edge(3771, 3772).
-edge(3772, 3773).
-edge(3773, 3774).
-edge(3774, 3775).
-edge(3775, 3776).
-edge(3776, 3777).
-edge(3777, 3778).
-edge(3778, 3779).
-edge(3779, 3780).
-edge(3780, 3781).
-edge(3781, 3782).
-edge(3782, 3783).
-edge(3783, 3784).
-edge(3784, 3785).
-edge(3785, 3786).
-edge(3786, 3787).
-edge(3787, 3788).
-edge(3788, 3789).
-edge(3789, 3790).
-edge(3790, 3791).
-edge(3791, 3792).
-edge(3792, 3793).
-edge(3793, 3794).
-edge(3794, 3795).
-edge(3795, 3796).
-edge(3796, 3797).
-edge(3797, 3798).
-edge(3798, 3799).
-edge(3799, 3800).
-edge(3800, 3801).
-edge(3801, 3802).
-edge(3802, 3803).
-edge(3803, 3804).
-edge(3804, 3805).
-edge(3805, 3806).
-edge(3806, 3807).
-edge(3807, 3808).
-edge(3808, 3809).
-edge(3809, 3810).
-edge(3810, 3811).
-edge(3811, 3812).
-edge(3812, 3813).
-edge(3813, 3814).
-edge(3814, 3815).
-edge(3815, 3816).
-edge(3816, 3817).
-edge(3817, 3818).
-edge(3818, 3819).
-edge(3819, 3820).
-edge(3820, 3821).
-edge(3821, 3822).
-edge(3822, 3823).
-edge(3823, 3824).
-edge(3824, 3825).
-edge(3825, 3826).
-edge(3826, 3827).
-edge(3827, 3828).
-edge(3828, 3829).
-edge(3829, 3830).
-edge(3830, 3831).
-edge(3831, 3832).
-edge(3832, 3833).
-edge(3833, 3834).
-edge(3834, 3835).
-edge(3835, 3836).
-edge(3836, 3837).
-edge(3837, 3838).
-edge(3838, 3839).
-edge(3839, 3840).
-edge(3840, 3841).
-edge(3841, 3842).
-edge(3842, 3843).
-edge(3843, 3844).
-edge(3844, 3845).
-edge(3845, 3846).
-edge(3846, 3847).
-edge(3847, 3848).
-edge(3848, 3849).
-edge(3849, 3850).
-edge(3850, 3851).
-edge(3851, 3852).
-edge(3852, 3853).
-edge(3853, 3854).
-edge(3854, 3855).
-edge(3855, 3856).
-edge(3856, 3857).
-edge(3857, 3858).
-edge(3858, 3859).
-edge(3859, 3860).
-edge(3860, 3861).
-edge(3861, 3862).
-edge(3862, 3863).
-edge(3863, 3864).
-edge(3864, 3865).
-edge(3865, 3866).
-edge(3866, 3867).
-edge(3867, 3868).
-edge(3868, 3869).
-edge(3869, 3870).
-edge(3870, 3871).
-edge(3871, 3872).
-edge(3872, 3873).
-edge(3873, 3874).
-edge(3874, 3875).
-edge(3875, 3876).
-edge(3876, 3877).
-edge(3877, 3878).
-edge(3878, 3879).
-edge(3879, 3880).
-edge(3880, 3881).
-edge(3881, 3882).
-edge(3882, 3883).
-edge(3883, 3884).
-edge(3884, 3885).
-edge(3885, 3886).
-edge(3886, 3887).
-edge(3887, 3888).
-edge(3888, 3889).
-edge(3889, 3890).
-edge(3890, 3891).
-edge(3891, 3892).
-edge(3892, 3893).
-edge(3893, 3894).
-edge(3894, 3895).
-edge(3895, 3896).
-edge(3896, 3897).
-edge(3897, 3898).
-edge(3898, 3899).
-edge(3899, 3900).
-edge(3900, 3901).
-edge(3901, 3902).
-edge(3902, 3903).
-edge(3903, 3904).
-edge(3904, 3905).
-edge(3905, 3906).
-edge(3906, 3907).
-edge(3907, 3908).
-edge(3908, 3909).
-edge(3909, 3910).
-edge(3910, 3911).
-edge(3911, 3912).
-edge(3912, 3913).
-edge(3913, 3914).
-edge(3914, 3915).
-edge(3915, 3916).
-edge(3916, 3917).
-edge(3917, 3918).
-edge(3918, 3919).
-edge(3919, 3920).
-edge(3920, 3921).
-edge(3921, 3922).
-edge(3922, 3923).
-edge(3923, 3924).
-edge(3924, 3925).
-edge(3925, 3926).
-edge(3926, 3927).
-edge(3927, 3928).
-edge(3928, 3929).
-edge(3929, 3930).
-edge(3930, 3931).
-edge(3931, 3932).
-edge(3932, 3933).
-edge(3933, 3934).
-edge(3934, 3935).
-edge(3935, 3936).
-edge(3936, 3937).
-edge(3937, 3938).
-edge(3938, 3939).
-edge(3939, 3940).
-edge(3940, 3941).
-edge(3941, 3942).
-edge(3942, 3943).
-edge(3943, 3944).
-edge(3944, 3945).
-edge(3945, 3946).
-edge(3946, 3947).
-edge(3947, 3948).
-edge(3948, 3949).
-edge(3949, 3950).
-edge(3950, 3951).
-edge(3951, 3952).
-edge(3952, 3953).
-edge(3953, 3954).
-edge(3954, 3955).
-edge(3955, 3956).
-edge(3956, 3957).
-edge(3957, 3958).
-edge(3958, 3959).
-edge(3959, 3960).
-edge(3960, 3961).
-edge(3961, 3962).
-edge(3962, 3963).
-edge(3963, 3964).
-edge(3964, 3965).
-edge(3965, 3966).
-edge(3966, 3967).
-edge(3967, 3968).
-edge(3968, 3969).
-edge(3969, 3970).
-edge(3970, 3971).
-edge(3971, 3972).
-edge(3972, 3973).
-edge(3973, 3974).
-edge(3974, 3975).
-edge(3975, 3976).
-edge(3976, 3977).
-edge(3977, 3978).
-edge(3978, 3979).
-edge(3979, 3980).
-edge(3980, 3981).
-edge(3981, 3982).
-edge(3982, 3983).
-edge(3983, 3984).
-edge(3984, 3985).
-edge(3985, 3986).
-edge(3986, 3987).
-edge(3987, 3988).
-edge(3988, 3989).
-edge(3989, 3990).
-edge(3990, 3991).
-edge(3991, 3992).
-edge(3992, 3993).
-edge(3993, 3994).
-edge(3994, 3995).
-edge(3995, 3996).
-edge(3996, 3997).
-edge(3997, 3998).
-edge(3998, 3999).
-edge(3999, 4000).
-edge(4000, 4001).
-edge(4001, 4002).
-edge(4002, 4003).
-edge(4003, 4004).
-edge(4004, 4005).
-edge(4005, 4006).
-edge(4006, 4007).
-edge(4007, 4008).
-edge(4008, 4009).
-edge(4009, 4010).
-edge(4010, 4011).
-edge(4011, 4012).
-edge(4012, 4013).
-edge(4013, 4014).
-edge(4014, 4015).
-edge(4015, 4016).
-edge(4016, 4017).
-edge(4017, 4018).
-edge(4018, 4019).
-edge(4019, 4020).
-edge(4020, 4021).
-edge(4021, 4022).
-edge(4022, 4023).
-edge(4023, 4024).
-edge(4024, 4025).
-edge(4025, 4026).
-edge(4026, 4027).
-edge(4027, 4028).
-edge(4028, 4029).
-edge(4029, 4030).
-edge(4030, 4031).
-edge(4031, 4032).
-edge(4032, 4033).
-edge(4033, 4034).
-edge(4034, 4035).
-edge(4035, 4036).
-edge(4036, 4037).
-edge(4037, 4038).
-edge(4038, 4039).
-edge(4039, 4040).
-edge(4040, 4041).
-edge(4041, 4042).
-edge(4042, 4043).
-edge(4043, 4044).
-edge(4044, 4045).
-edge(4045, 4046).
-edge(4046, 4047).
-edge(4047, 4048).
-edge(4048, 4049).
-edge(4049, 4050).
-edge(4050, 4051).
-edge(4051, 4052).
-edge(4052, 4053).
-edge(4053, 4054).
-edge(4054, 4055).
-edge(4055, 4056).
-edge(4056, 4057).
-edge(4057, 4058).
-edge(4058, 4059).
-edge(4059, 4060).
-edge(4060, 4061).
-edge(4061, 4062).
-edge(4062, 4063).
-edge(4063, 4064).
-edge(4064, 4065).
-edge(4065, 4066).
-edge(4066, 4067).
-edge(4067, 4068).
-edge(4068, 4069).
-edge(4069, 4070).
-edge(4070, 4071).
-edge(4071, 4072).
-edge(4072, 4073).
-edge(4073, 4074).
-edge(4074, 4075).
-edge(4075, 4076).
-edge(4076, 4077).
-edge(4077, 4078).
-edge(4078, 4079).
-edge(4079, 4080).
-edge(4080, 4081).
-edge(4081, 4082).
-edge(4082, 4083).
-edge(4083, 4084).
-edge(4084, 4085).
-edge(4085, 4086).
-edge(4086, 4087).
-edge(4087, 4088).
-edge(4088, 4089).
-edge(4089, 4090).
-edge(4090, 4091).
-edge(4091, 4092).
-edge(4092, 4093).
-edge(4093, 4094).
-edge(4094, 4095).
-edge(4095, 4096).
-edge(4096, 4097).
-edge(4097, 4098).
-edge(4098, 4099).
-edge(4099, 4100).
-edge(4100, 4101).
-edge(4101, 4102).
-edge(4102, 4103).
-edge(4103, 4104).
-edge(4104, 4105).
-edge(4105, 4106).
-edge(4106, 4107).
-edge(4107, 4108).
-edge(4108, 4109).
-edge(4109, 4110).
-edge(4110, 4111).
-edge(4111, 4112).
-edge(4112, 4113).
-edge(4113, 4114).
-edge(4114, 4115).
-edge(4115, 4116).
-edge(4116, 4117).
-edge(4117, 4118).
-edge(4118, 4119).
-edge(4119, 4120).
-edge(4120, 4121).
-edge(4121, 4122).
-edge(4122, 4123).
-edge(4123, 4124).
-edge(4124, 4125).
-edge(4125, 4126).
-edge(4126, 4127).
-edge(4127, 4128).
-edge(4128, 4129).
-edge(4129, 4130).
-edge(4130, 4131).
-edge(4131, 4132).
-edge(4132, 4133).
-edge(4133, 4134).
-edge(4134, 4135).
-edge(4135, 4136).
-edge(4136, 4137).
-edge(4137, 4138).
-edge(4138, 4139).
-edge(4139, 4140).
-edge(4140, 4141).
-edge(4141, 4142).
-edge(4142, 4143).
-edge(4143, 4144).
-edge(4144, 4145).
-edge(4145, 4146).
-edge(4146, 4147).
-edge(4147, 4148).
-edge(4148, 4149).
-edge(4149, 4150).
-edge(4150, 4151).
-edge(4151, 4152).
-edge(4152, 4153).
-edge(4153, 4154).
-edge(4154, 4155).
-edge(4155, 4156).
-edge(4156, 4157).
-edge(4157, 4158).
-edge(4158, 4159).
-edge(4159, 4160).
-edge(4160, 4161).
-edge(4161, 4162).
-edge(4162, 4163).
-edge(4163, 4164).
-edge(4164, 4165).
-edge(4165, 4166).
-edge(4166, 4167).
-edge(4167, 4168).
-edge(4168, 4169).
-edge(4169, 4170).
-edge(4170, 4171).
-edge(4171, 4172).
-edge(4172, 4173).
-edge(4173, 4174).
-edge(4174, 4175).
-edge(4175, 4176).
-edge(4176, 4177).
-edge(4177, 4178).
-edge(4178, 4179).
-edge(4179, 4180).
-edge(4180, 4181).
-edge(4181, 4182).
-edge(4182, 4183).
-edge(4183, 4184).
-edge(4184, 4185).
-edge(4185, 4186).
-edge(4186, 4187).
-edge(4187, 4188).
-edge(4188, 4189).
-edge(4189, 4190).
-edge(4190, 4191).
-edge(4191, 4192).
-edge(4192, 4193).
-edge(4193, 4194).
-edge(4194, 4195).
-edge(4195, 4196).
-edge(4196, 4197).
-edge(4197, 4198).
-edge(4198, 4199).
-edge(4199, 4200).
-edge(4200, 4201).
-edge(4201, 4202).
-edge(4202, 4203).
-edge(4203, 4204).
-edge(4204, 4205).
-edge(4205, 4206).
-edge(4206, 4207).
-edge(4207, 4208).
-edge(4208, 4209).
-edge(4209, 4210).
-edge(4210, 4211).
-edge(4211, 4212).
-edge(4212, 4213).
-edge(4213, 4214).
-edge(4214, 4215).
-edge(4215, 4216).
-edge(4216, 4217).
-edge(4217, 4218).
-edge(4218, 4219).
-edge(4219, 4220).
-edge(4220, 4221).
-edge(4221, 4222).
-edge(4222, 4223).
-edge(4223, 4224).
-edge(4224, 4225).
-edge(4225, 4226).
-edge(4226, 4227).
-edge(4227, 4228).
-edge(4228, 4229).
-edge(4229, 4230).
-edge(4230, 4231).
-edge(4231, 4232).
-edge(4232, 4233).
-edge(4233, 4234).
-edge(4234, 4235).
-edge(4235, 4236).
-edge(4236, 4237).
-edge(4237, 4238).
-edge(4238, 4239).
-edge(4239, 4240).
-edge(4240, 4241).
-edge(4241, 4242).
-edge(4242, 4243).
-edge(4243, 4244).
-edge(4244, 4245).
-edge(4245, 4246).
-edge(4246, 4247).
-edge(4247, 4248).
-edge(4248, 4249).
-edge(4249, 4250).
-edge(4250, 4251).
-edge(4251, 4252).
-edge(4252, 4253).
-edge(4253, 4254).
-edge(4254, 4255).
-edge(4255, 4256).
-edge(4256, 4257).
-edge(4257, 4258).
-edge(4258, 4259).
-edge(4259, 4260).
-edge(4260, 4261).
-edge(4261, 4262).
-edge(4262, 4263).
-edge(4263, 4264).
-edge(4264, 4265).
-edge(4265, 4266).
-edge(4266, 4267).
-edge(4267, 4268).
-edge(4268, 4269).
-edge(4269, 4270).
-edge(4270, 4271).
-edge(4271, 4272).
-edge(4272, 4273).
-edge(4273, 4274).
-edge(4274, 4275).
-edge(4275, 4276).
-edge(4276, 4277).
-edge(4277, 4278).
-edge(4278, 4279).
-edge(4279, 4280).
-edge(4280, 4281).
-edge(4281, 4282).
-edge(4282, 4283).
-edge(4283, 4284).
-edge(4284, 4285).
-edge(4285, 4286).
-edge(4286, 4287).
-edge(4287, 4288).
-edge(4288, 4289).
-edge(4289, 4290).
-edge(4290, 4291).
-edge(4291, 4292).
-edge(4292, 4293).
-edge(4293, 4294).
-edge(4294, 4295).
-edge(4295, 4296).
-edge(4296, 4297).
-edge(4297, 4298).
-edge(4298, 4299).
-edge(4299, 4300).
-edge(4300, 4301).
-edge(4301, 4302).
-edge(4302, 4303).
-edge(4303, 4304).
-edge(4304, 4305).
-edge(4305, 4306).
-edge(4306, 4307).
-edge(4307, 4308).
-edge(4308, 4309).
-edge(4309, 4310).
-edge(4310, 4311).
-edge(4311, 4312).
-edge(4312, 4313).
-edge(4313, 4314).
-edge(4314, 4315).
-edge(4315, 4316).
-edge(4316, 4317).
-edge(4317, 4318).
-edge(4318, 4319).
-edge(4319, 4320).
-edge(4320, 4321).
-edge(4321, 4322).
-edge(4322, 4323).
-edge(4323, 4324).
-edge(4324, 4325).
-edge(4325, 4326).
-edge(4326, 4327).
-edge(4327, 4328).
-edge(4328, 4329).
-edge(4329, 4330).
-edge(4330, 4331).
-edge(4331, 4332).
-edge(4332, 4333).
-edge(4333, 4334).
-edge(4334, 4335).
-edge(4335, 4336).
-edge(4336, 4337).
-edge(4337, 4338).
-edge(4338, 4339).
-edge(4339, 4340).
-edge(4340, 4341).
-edge(4341, 4342).
-edge(4342, 4343).
-edge(4343, 4344).
-edge(4344, 4345).
-edge(4345, 4346).
-edge(4346, 4347).
-edge(4347, 4348).
-edge(4348, 4349).
-edge(4349, 4350).
-edge(4350, 4351).
-edge(4351, 4352).
-edge(4352, 4353).
-edge(4353, 4354).
-edge(4354, 4355).
-edge(4355, 4356).
-edge(4356, 4357).
-edge(4357, 4358).
-edge(4358, 4359).
-edge(4359, 4360).
-edge(4360, 4361).
-edge(4361, 4362).
-edge(4362, 4363).
-edge(4363, 4364).
-edge(4364, 4365).
-edge(4365, 4366).
-edge(4366, 4367).
-edge(4367, 4368).
-edge(4368, 4369).
-edge(4369, 4370).
-edge(4370, 4371).
-edge(4371, 4372).
-edge(4372, 4373).
-edge(4373, 4374).
-edge(4374, 4375).
-edge(4375, 4376).
-edge(4376, 4377).
-edge(4377, 4378).
-edge(4378, 4379).
-edge(4379, 4380).
-edge(4380, 4381).
-edge(4381, 4382).
-edge(4382, 4383).
-edge(4383, 4384).
-edge(4384, 4385).
-edge(4385, 4386).
-edge(4386, 4387).
-edge(4387, 4388).
-edge(4388, 4389).
-edge(4389, 4390).
-edge(4390, 4391).
-edge(4391, 4392).
-edge(4392, 4393).
-edge(4393, 4394).
-edge(4394, 4395).
-edge(4395, 4396).
-edge(4396, 4397).
-edge(4397, 4398).
-edge(4398, 4399).
-edge(4399, 4400).
-edge(4400, 4401).
-edge(4401, 4402).
-edge(4402, 4403).
-edge(4403, 4404).
-edge(4404, 4405).
-edge(4405, 4406).
-edge(4406, 4407).
-edge(4407, 4408).
-edge(4408, 4409).
-edge(4409, 4410).
-edge(4410, 4411).
-edge(4411, 4412).
-edge(4412, 4413).
-edge(4413, 4414).
-edge(4414, 4415).
-edge(4415, 4416).
-edge(4416, 4417).
-edge(4417, 4418).
-edge(4418, 4419).
-edge(4419, 4420).
-edge(4420, 4421).
-edge(4421, 4422).
-edge(4422, 4423).
-edge(4423, 4424).
-edge(4424, 4425).
-edge(4425, 4426).
-edge(4426, 4427).
-edge(4427, 4428).
-edge(4428, 4429).
-edge(4429, 4430).
-edge(4430, 4431).
-edge(4431, 4432).
-edge(4432, 4433).
-edge(4433, 4434).
-edge(4434, 4435).
-edge(4435, 4436).
-edge(4436, 4437).
-edge(4437, 4438).
-edge(4438, 4439).
-edge(4439, 4440).
-edge(4440, 4441).
-edge(4441, 4442).
-edge(4442, 4443).
-edge(4443, 4444).
-edge(4444, 4445).
-edge(4445, 4446).
-edge(4446, 4447).
-edge(4447, 4448).
-edge(4448, 4449).
-edge(4449, 4450).
-edge(4450, 4451).
-edge(4451, 4452).
-edge(4452, 4453).
-edge(4453, 4454).
-edge(4454, 4455).
-edge(4455, 4456).
-edge(4456, 4457).
-edge(4457, 4458).
-edge(4458, 4459).
-edge(4459, 4460).
-edge(4460, 4461).
-edge(4461, 4462).
-edge(4462, 4463).
-edge(4463, 4464).
-edge(4464, 4465).
-edge(4465, 4466).
-edge(4466, 4467).
-edge(4467, 4468).
-edge(4468, 4469).
-edge(4469, 4470).
-edge(4470, 4471).
-edge(4471, 4472).
-edge(4472, 4473).
-edge(4473, 4474).
-edge(4474, 4475).
-edge(4475, 4476).
-edge(4476, 4477).
-edge(4477, 4478).
-edge(4478, 4479).
-edge(4479, 4480).
-edge(4480, 4481).
-edge(4481, 4482).
-edge(4482, 4483).
-edge(4483, 4484).
-edge(4484, 4485).
-edge(4485, 4486).
-edge(4486, 4487).
-edge(4487, 4488).
-edge(4488, 4489).
-edge(4489, 4490).
-edge(4490, 4491).
-edge(4491, 4492).
-edge(4492, 4493).
-edge(4493, 4494).
-edge(4494, 4495).
-edge(4495, 4496).
-edge(4496, 4497).
-edge(4497, 4498).
-edge(4498, 4499).
-edge(4499, 4500).
-edge(4500, 4501).
-edge(4501, 4502).
-edge(4502, 4503).
-edge(4503, 4504).
-edge(4504, 4505).
-edge(4505, 4506).
-edge(4506, 4507).
-edge(4507, 4508).
-edge(4508, 4509).
-edge(4509, 4510).
-edge(4510, 4511).
-edge(4511, 4512).
-edge(4512, 4513).
-edge(4513, 4514).
-edge(4514, 4515).
-edge(4515, 4516).
-edge(4516, 4517).
-edge(4517, 4518).
-edge(4518, 4519).
-edge(4519, 4520).
-edge(4520, 4521).
-edge(4521, 4522).
-edge(4522, 4523).
-edge(4523, 4524).
-edge(4524, 4525).
-edge(4525, 4526).
-edge(4526, 4527).
-edge(4527, 4528).
-edge(4528, 4529).
-edge(4529, 4530).
-edge(4530, 4531).
-edge(4531, 4532).
-edge(4532, 4533).
-edge(4533, 4534).
-edge(4534, 4535).
-edge(4535, 4536).
-edge(4536, 4537).
-edge(4537, 4538).
-edge(4538, 4539).
-edge(4539, 4540).
-edge(4540, 4541).
-edge(4541, 4542).
-edge(4542, 4543).
-edge(4543, 4544).
-edge(4544, 4545).
-edge(4545, 4546).
-edge(4546, 4547).
-edge(4547, 4548).
-edge(4548, 4549).
-edge(4549, 4550).
-edge(4550, 4551).
-edge(4551, 4552).
-edge(4552, 4553).
-edge(4553, 4554).
-edge(4554, 4555).
-edge(4555, 4556).
-edge(4556, 4557).
-edge(4557, 4558).
-edge(4558, 4559).
-edge(4559, 4560).
-edge(4560, 4561).
-edge(4561, 4562).
-edge(4562, 4563).
-edge(4563, 4564).
-edge(4564, 4565).
-edge(4565, 4566).
-edge(4566, 4567).
-edge(4567, 4568).
-edge(4568, 4569).
-edge(4569, 4570).
-edge(4570, 4571).
-edge(4571, 4572).
-edge(4572, 4573).
-edge(4573, 4574).
-edge(4574, 4575).
-edge(4575, 4576).
-edge(4576, 4577).
-edge(4577, 4578).
-edge(4578, 4579).
-edge(4579, 4580).
-edge(4580, 4581).
-edge(4581, 4582).
-edge(4582, 4583).
-edge(4583, 4584).
-edge(4584, 4585).
-edge(4585, 4586).
-edge(4586, 4587).
-edge(4587, 4588).
-edge(4588, 4589).
-edge(4589, 4590).
-edge(4590, 4591).
-edge(4591, 4592).
-edge(4592, 4593).
-edge(4593, 4594).
-edge(4594, 4595).
-edge(4595, 4596).
-edge(4596, 4597).
-edge(4597, 4598).
-edge(4598, 4599).
-edge(4599, 4600).
-edge(4600, 4601).
-edge(4601, 4602).
-edge(4602, 4603).
-edge(4603, 4604).
-edge(4604, 4605).
-edge(4605, 4606).
-edge(4606, 4607).
-edge(4607, 4608).
-edge(4608, 4609).
-edge(4609, 4610).
-edge(4610, 4611).
-edge(4611, 4612).
-edge(4612, 4613).
-edge(4613, 4614).
-edge(4614, 4615).
-edge(4615, 4616).
-edge(4616, 4617).
-edge(4617, 4618).
-edge(4618, 4619).
-edge(4619, 4620).
-edge(4620, 4621).
-edge(4621, 4622).
-edge(4622, 4623).
-edge(4623, 4624).
-edge(4624, 4625).
-edge(4625, 4626).
-edge(4626, 4627).
-edge(4627, 4628).
-edge(4628, 4629).
-edge(4629, 4630).
-edge(4630, 4631).
-edge(4631, 4632).
-edge(4632, 4633).
-edge(4633, 4634).
-edge(4634, 4635).
-edge(4635, 4636).
-edge(4636, 4637).
-edge(4637, 4638).
-edge(4638, 4639).
-edge(4639, 4640).
-edge(4640, 4641).
-edge(4641, 4642).
-edge(4642, 4643).
-edge(4643, 4644).
-edge(4644, 4645).
-edge(4645, 4646).
-edge(4646, 4647).
-edge(4647, 4648).
-edge(4648, 4649).
-edge(4649, 4650).
-edge(4650, 4651).
-edge(4651, 4652).
-edge(4652, 4653).
-edge(4653, 4654).
-edge(4654, 4655).
-edge(4655, 4656).
-edge(4656, 4657).
-edge(4657, 4658).
-edge(4658, 4659).
-edge(4659, 4660).
-edge(4660, 4661).
-edge(4661, 4662).
-edge(4662, 4663).
-edge(4663, 4664).
-edge(4664, 4665).
-edge(4665, 4666).
-edge(4666, 4667).
-edge(4667, 4668).
-edge(4668, 4669).
-edge(4669, 4670).
-edge(4670, 4671).
-edge(4671, 4672).
-edge(4672, 4673).
-edge(4673, 4674).
-edge(4674, 4675).
-edge(4675, 4676).
-edge(4676, 4677).
-edge(4677, 4678).
-edge(4678, 4679).
-edge(4679, 4680).
-edge(4680, 4681).
-edge(4681, 4682).
-edge(4682, 4683).
-edge(4683, 4684).
-edge(4684, 4685).
-edge(4685, 4686).
-edge(4686, 4687).
-edge(4687, 4688).
-edge(4688, 4689).
-edge(4689, 4690).
-edge(4690, 4691).
-edge(4691, 4692).
-edge(4692, 4693).
-edge(4693, 4694).
-edge(4694, 4695).
-edge(4695, 4696).
-edge(4696, 4697).
-edge(4697, 4698).
-edge(4698, 4699).
-edge(4699, 4700).
-edge(4700, 4701).
-edge(4701, 4702).
-edge(4702, 4703).
-edge(4703, 4704).
-edge(4704, 4705).
-edge(4705, 4706).
-edge(4706, 4707).
-edge(4707, 4708).
-edge(4708, 4709).
-edge(4709, 4710).
-edge(4710, 4711).
-edge(4711, 4712).
-edge(4712, 4713).
-edge(4713, 4714).
-edge(4714, 4715).
-edge(4715, 4716).
-edge(4716, 4717).
-edge(4717, 4718).
-edge(4718, 4719).
-edge(4719, 4720).
-edge(4720, 4721).
-edge(4721, 4722).
-edge(4722, 4723).
-edge(4723, 4724).
-edge(4724, 4725).
-edge(4725, 4726).
-edge(4726, 4727).
-edge(4727, 4728).
-edge(4728, 4729).
-edge(4729, 4730).
-edge(4730, 4731).
-edge(4731, 4732).
-edge(4732, 4733).
-edge(4733, 4734).
-edge(4734, 4735).
-edge(4735, 4736).
-edge(4736, 4737).
-edge(4737, 4738).
-edge(4738, 4739).
-edge(4739, 4740).
-edge(4740, 4741).
-edge(4741, 4742).
-edge(4742, 4743).
-edge(4743, 4744).
-edge(4744, 4745).
-edge(4745, 4746).
-edge(4746, 4747).
-edge(4747, 4748).
-edge(4748, 4749).
-edge(4749, 4750).
-edge(4750, 4751).
-edge(4751, 4752).
-edge(4752, 4753).
-edge(4753, 4754).
-edge(4754, 4755).
-edge(4755, 4756).
-edge(4756, 4757).
-edge(4757, 4758).
-edge(4758, 4759).
-edge(4759, 4760).
-edge(4760, 4761).
-edge(4761, 4762).
-edge(4762, 4763).
-edge(4763, 4764).
-edge(4764, 4765).
-edge(4765, 4766).
-edge(4766, 4767).
-edge(4767, 4768).
-edge(4768, 4769).
-edge(4769, 4770).
-edge(4770, 4771).
-edge(4771, 4772).
-edge(4772, 4773).
-edge(4773, 4774).
-edge(4774, 4775).
-edge(4775, 4776).
-edge(4776, 4777).
-edge(4777, 4778).
-edge(4778, 4779).
-edge(4779, 4780).
-edge(4780, 4781).
-edge(4781, 4782).
-edge(4782, 4783).
-edge(4783, 4784).
-edge(4784, 4785).
-edge(4785, 4786).
-edge(4786, 4787).
-edge(4787, 4788).
-edge(4788, 4789).
-edge(4789, 4790).
-edge(4790, 4791).
-edge(4791, 4792).
-edge(4792, 4793).
-edge(4793, 4794).
-edge(4794, 4795).
-edge(4795, 4796).
-edge(4796, 4797).
-edge(4797, 4798).
-edge(4798, 4799).
-edge(4799, 4800).
-edge(4800, 4801).
-edge(4801, 4802).
-edge(4802, 4803).
-edge(4803, 4804).
-edge(4804, 4805).
-edge(4805, 4806).
-edge(4806, 4807).
-edge(4807, 4808).
-edge(4808, 4809).
-edge(4809, 4810).
-edge(4810, 4811).
-edge(4811, 4812).
-edge(4812, 4813).
-edge(4813, 4814).
-edge(4814, 4815).
-edge(4815, 4816).
-edge(4816, 4817).
-edge(4817, 4818).
-edge(4818, 4819).
-edge(4819, 4820).
-edge(4820, 4821).
-edge(4821, 4822).
-edge(4822, 4823).
-edge(4823, 4824).
-edge(4824, 4825).
-edge(4825, 4826).
-edge(4826, 4827).
-edge(4827, 4828).
-edge(4828, 4829).
-edge(4829, 4830).
-edge(4830, 4831).
-edge(4831, 4832).
-edge(4832, 4833).
-edge(4833, 4834).
-edge(4834, 4835).
-edge(4835, 4836).
-edge(4836, 4837).
-edge(4837, 4838).
-edge(4838, 4839).
-edge(4839, 4840).
-edge(4840, 4841).
-edge(4841, 4842).
-edge(4842, 4843).
-edge(4843, 4844).
-edge(4844, 4845).
-edge(4845, 4846).
-edge(4846, 4847).
-edge(4847, 4848).
-edge(4848, 4849).
-edge(4849, 4850).
-edge(4850, 4851).
-edge(4851, 4852).
-edge(4852, 4853).
-edge(4853, 4854).
-edge(4854, 4855).
-edge(4855, 4856).
-edge(4856, 4857).
-edge(4857, 4858).
-edge(4858, 4859).
-edge(4859, 4860).
-edge(4860, 4861).
-edge(4861, 4862).
-edge(4862, 4863).
-edge(4863, 4864).
-edge(4864, 4865).
-edge(4865, 4866).
-edge(4866, 4867).
-edge(4867, 4868).
-edge(4868, 4869).
-edge(4869, 4870).
-edge(4870, 4871).
-edge(4871, 4872).
-edge(4872, 4873).
-edge(4873, 4874).
-edge(4874, 4875).
-edge(4875, 4876).
-edge(4876, 4877).
-edge(4877, 4878).
-edge(4878, 4879).
-edge(4879, 4880).
-edge(4880, 4881).
-edge(4881, 4882).
-edge(4882, 4883).
-edge(4883, 4884).
-edge(4884, 4885).
-edge(4885, 4886).
-edge(4886, 4887).
-edge(4887, 4888).
-edge(4888, 4889).
-edge(4889, 4890).
-edge(4890, 4891).
-edge(4891, 4892).
-edge(4892, 4893).
-edge(4893, 4894).
-edge(4894, 4895).
-edge(4895, 4896).
-edge(4896, 4897).
-edge(4897, 4898).
-edge(4898, 4899).
-edge(4899, 4900).
-edge(4900, 4901).
-edge(4901, 4902).
-edge(4902, 4903).
-edge(4903, 4904).
-edge(4904, 4905).
-edge(4905, 4906).
-edge(4906, 4907).
-edge(4907, 4908).
-edge(4908, 4909).
-edge(4909, 4910).
-edge(4910, 4911).
-edge(4911, 4912).
-edge(4912, 4913).
-edge(4913, 4914).
-edge(4914, 4915).
-edge(4915, 4916).
-edge(4916, 4917).
-edge(4917, 4918).
-edge(4918, 4919).
-edge(4919, 4920).
-edge(4920, 4921).
-edge(4921, 4922).
-edge(4922, 4923).
-edge(4923, 4924).
-edge(4924, 4925).
-edge(4925, 4926).
-edge(4926, 4927).
-edge(4927, 4928).
-edge(4928, 4929).
-edge(4929, 4930).
-edge(4930, 4931).
-edge(4931, 4932).
-edge(4932, 4933).
-edge(4933, 4934).
-edge(4934, 4935).
-edge(4935, 4936).
-edge(4936, 4937).
-edge(4937, 4938).
-edge(4938, 4939).
-edge(4939, 4940).
-edge(4940, 4941).
-edge(4941, 4942).
-edge(4942, 4943).
-edge(4943, 4944).
-edge(4944, 4945).
-edge(4945, 4946).
-edge(4946, 4947).
-edge(4947, 4948).
-edge(4948, 4949).
-edge(4949, 4950).
-edge(4950, 4951).
-edge(4951, 4952).
-edge(4952, 4953).
-edge(4953, 4954).
-edge(4954, 4955).
-edge(4955, 4956).
-edge(4956, 4957).
-edge(4957, 4958).
-edge(4958, 4959).
-edge(4959, 4960).
-edge(4960, 4961).
-edge(4961, 4962).
-edge(4962, 4963).
-edge(4963, 4964).
-edge(4964, 4965).
-edge(4965, 4966).
-edge(4966, 4967).
-edge(4967, 4968).
-edge(4968, 4969).
-edge(4969, 4970).
-edge(4970, 4971).
-edge(4971, 4972).
-edge(4972, 4973).
-edge(4973, 4974).
-edge(4974, 4975).
-edge(4975, 4976).
-edge(4976, 4977).
-edge(4977, 4978).
-edge(4978, 4979).
-edge(4979, 4980).
-edge(4980, 4981).
-edge(4981, 4982).
-edge(4982, 4983).
-edge(4983, 4984).
-edge(4984, 4985).
-edge(4985, 4986).
-edge(4986, 4987).
-edge(4987, 4988).
-edge(4988, 4989).
-edge(4989, 4990).
-edge(4990, 4991).
-edge(4991, 4992).
-edge(4992, 4993).
-edge(4993, 4994).
-edge(4994, 4995).
-edge(4995, 4996).
-edge(4996, 4997).
-edge(4997, 4998).
-edge(4998, 4999).
-edge(4999, 5000).
-edge(5000, 0).
@@ -1,20004 +0,0 @@
-% generate problem of size 10000
-p(1) :- p(0), q(1).
-q(1) :- p(0), q(0).
-p(2) :- p(1), q(2).
-q(2) :- p(1), q(1).
-p(3) :- p(2), q(3).
-q(3) :- p(2), q(2).
-p(4) :- p(3), q(4).
-q(4) :- p(3), q(3).
-p(5) :- p(4), q(5).
-q(5) :- p(4), q(4).
-p(6) :- p(5), q(6).
-q(6) :- p(5), q(5).
-p(7) :- p(6), q(7).
-q(7) :- p(6), q(6).
-p(8) :- p(7), q(8).
-q(8) :- p(7), q(7).
-p(9) :- p(8), q(9).
-q(9) :- p(8), q(8).
-p(10) :- p(9), q(10).
-q(10) :- p(9), q(9).
-p(11) :- p(10), q(11).
-q(11) :- p(10), q(10).
-p(12) :- p(11), q(12).
-q(12) :- p(11), q(11).
-p(13) :- p(12), q(13).
-q(13) :- p(12), q(12).
-p(14) :- p(13), q(14).
-q(14) :- p(13), q(13).
-p(15) :- p(14), q(15).
-q(15) :- p(14), q(14).
-p(16) :- p(15), q(16).
-q(16) :- p(15), q(15).
-p(17) :- p(16), q(17).
-q(17) :- p(16), q(16).
-p(18) :- p(17), q(18).
-q(18) :- p(17), q(17).
-p(19) :- p(18), q(19).
-q(19) :- p(18), q(18).
-p(20) :- p(19), q(20).
-q(20) :- p(19), q(19).
-p(21) :- p(20), q(21).
-q(21) :- p(20), q(20).
-p(22) :- p(21), q(22).
-q(22) :- p(21), q(21).
-p(23) :- p(22), q(23).
-q(23) :- p(22), q(22).
-p(24) :- p(23), q(24).
-q(24) :- p(23), q(23).
-p(25) :- p(24), q(25).
-q(25) :- p(24), q(24).
-p(26) :- p(25), q(26).
-q(26) :- p(25), q(25).
-p(27) :- p(26), q(27).
-q(27) :- p(26), q(26).
-p(28) :- p(27), q(28).
-q(28) :- p(27), q(27).
-p(29) :- p(28), q(29).
-q(29) :- p(28), q(28).
-p(30) :- p(29), q(30).
-q(30) :- p(29), q(29).
-p(31) :- p(30), q(31).
-q(31) :- p(30), q(30).
-p(32) :- p(31), q(32).
-q(32) :- p(31), q(31).
-p(33) :- p(32), q(33).
-q(33) :- p(32), q(32).
-p(34) :- p(33), q(34).
-q(34) :- p(33), q(33).
-p(35) :- p(34), q(35).
-q(35) :- p(34), q(34).
-p(36) :- p(35), q(36).
-q(36) :- p(35), q(35).
-p(37) :- p(36), q(37).
-q(37) :- p(36), q(36).
-p(38) :- p(37), q(38).
-q(38) :- p(37), q(37).
-p(39) :- p(38), q(39).
-q(39) :- p(38), q(38).
-p(40) :- p(39), q(40).
-q(40) :- p(39), q(39).
-p(41) :- p(40), q(41).
-q(41) :- p(40), q(40).
-p(42) :- p(41), q(42).
-q(42) :- p(41), q(41).
-p(43) :- p(42), q(43).
-q(43) :- p(42), q(42).
-p(44) :- p(43), q(44).
-q(44) :- p(43), q(43).
-p(45) :- p(44), q(45).
-q(45) :- p(44), q(44).
-p(46) :- p(45), q(46).
-q(46) :- p(45), q(45).
-p(47) :- p(46), q(47).
-q(47) :- p(46), q(46).
-p(48) :- p(47), q(48).
-q(48) :- p(47), q(47).
-p(49) :- p(48), q(49).
-q(49) :- p(48), q(48).
-p(50) :- p(49), q(50).
-q(50) :- p(49), q(49).
-p(51) :- p(50), q(51).
-q(51) :- p(50), q(50).
-p(52) :- p(51), q(52).
-q(52) :- p(51), q(51).
-p(53) :- p(52), q(53).
-q(53) :- p(52), q(52).
-p(54) :- p(53), q(54).
-q(54) :- p(53), q(53).
-p(55) :- p(54), q(55).
-q(55) :- p(54), q(54).
-p(56) :- p(55), q(56).
-q(56) :- p(55), q(55).
-p(57) :- p(56), q(57).
-q(57) :- p(56), q(56).
-p(58) :- p(57), q(58).
-q(58) :- p(57), q(57).
-p(59) :- p(58), q(59).
-q(59) :- p(58), q(58).
-p(60) :- p(59), q(60).
-q(60) :- p(59), q(59).
-p(61) :- p(60), q(61).
-q(61) :- p(60), q(60).
-p(62) :- p(61), q(62).
-q(62) :- p(61), q(61).
-p(63) :- p(62), q(63).
-q(63) :- p(62), q(62).
-p(64) :- p(63), q(64).
-q(64) :- p(63), q(63).
-p(65) :- p(64), q(65).
-q(65) :- p(64), q(64).
-p(66) :- p(65), q(66).
-q(66) :- p(65), q(65).
-p(67) :- p(66), q(67).
-q(67) :- p(66), q(66).
-p(68) :- p(67), q(68).
-q(68) :- p(67), q(67).
-p(69) :- p(68), q(69).
-q(69) :- p(68), q(68).
-p(70) :- p(69), q(70).
-q(70) :- p(69), q(69).
-p(71) :- p(70), q(71).
-q(71) :- p(70), q(70).
-p(72) :- p(71), q(72).
-q(72) :- p(71), q(71).
-p(73) :- p(72), q(73).
-q(73) :- p(72), q(72).
-p(74) :- p(73), q(74).
-q(74) :- p(73), q(73).
-p(75) :- p(74), q(75).
-q(75) :- p(74), q(74).
-p(76) :- p(75), q(76).
-q(76) :- p(75), q(75).
-p(77) :- p(76), q(77).
-q(77) :- p(76), q(76).
-p(78) :- p(77), q(78).
-q(78) :- p(77), q(77).
-p(79) :- p(78), q(79).
-q(79) :- p(78), q(78).
-p(80) :- p(79), q(80).
-q(80) :- p(79), q(79).
-p(81) :- p(80), q(81).
-q(81) :- p(80), q(80).
-p(82) :- p(81), q(82).
-q(82) :- p(81), q(81).
-p(83) :- p(82), q(83).
-q(83) :- p(82), q(82).
-p(84) :- p(83), q(84).
-q(84) :- p(83), q(83).
-p(85) :- p(84), q(85).
-q(85) :- p(84), q(84).
-p(86) :- p(85), q(86).
-q(86) :- p(85), q(85).
-p(87) :- p(86), q(87).
-q(87) :- p(86), q(86).
-p(88) :- p(87), q(88).
-q(88) :- p(87), q(87).
-p(89) :- p(88), q(89).
-q(89) :- p(88), q(88).
-p(90) :- p(89), q(90).
-q(90) :- p(89), q(89).
-p(91) :- p(90), q(91).
-q(91) :- p(90), q(90).
-p(92) :- p(91), q(92).
-q(92) :- p(91), q(91).
-p(93) :- p(92), q(93).
-q(93) :- p(92), q(92).
-p(94) :- p(93), q(94).
-q(94) :- p(93), q(93).
-p(95) :- p(94), q(95).
-q(95) :- p(94), q(94).
-p(96) :- p(95), q(96).
-q(96) :- p(95), q(95).
-p(97) :- p(96), q(97).
-q(97) :- p(96), q(96).
-p(98) :- p(97), q(98).
-q(98) :- p(97), q(97).
-p(99) :- p(98), q(99).
-q(99) :- p(98), q(98).
-p(100) :- p(99), q(100).
-q(100) :- p(99), q(99).
-p(101) :- p(100), q(101).
-q(101) :- p(100), q(100).
-p(102) :- p(101), q(102).
-q(102) :- p(101), q(101).
-p(103) :- p(102), q(103).
-q(103) :- p(102), q(102).
-p(104) :- p(103), q(104).
-q(104) :- p(103), q(103).
-p(105) :- p(104), q(105).
-q(105) :- p(104), q(104).
-p(106) :- p(105), q(106).
-q(106) :- p(105), q(105).
-p(107) :- p(106), q(107).
-q(107) :- p(106), q(106).
-p(108) :- p(107), q(108).
-q(108) :- p(107), q(107).
-p(109) :- p(108), q(109).
-q(109) :- p(108), q(108).
-p(110) :- p(109), q(110).
-q(110) :- p(109), q(109).
-p(111) :- p(110), q(111).
-q(111) :- p(110), q(110).
-p(112) :- p(111), q(112).
-q(112) :- p(111), q(111).
-p(113) :- p(112), q(113).
-q(113) :- p(112), q(112).
-p(114) :- p(113), q(114).
-q(114) :- p(113), q(113).
-p(115) :- p(114), q(115).
-q(115) :- p(114), q(114).
-p(116) :- p(115), q(116).
-q(116) :- p(115), q(115).
-p(117) :- p(116), q(117).
-q(117) :- p(116), q(116).
-p(118) :- p(117), q(118).
-q(118) :- p(117), q(117).
-p(119) :- p(118), q(119).
-q(119) :- p(118), q(118).
-p(120) :- p(119), q(120).
-q(120) :- p(119), q(119).
-p(121) :- p(120), q(121).
-q(121) :- p(120), q(120).
-p(122) :- p(121), q(122).
-q(122) :- p(121), q(121).
-p(123) :- p(122), q(123).
-q(123) :- p(122), q(122).
-p(124) :- p(123), q(124).
-q(124) :- p(123), q(123).
-p(125) :- p(124), q(125).
-q(125) :- p(124), q(124).
-p(126) :- p(125), q(126).
-q(126) :- p(125), q(125).
-p(127) :- p(126), q(127).
-q(127) :- p(126), q(126).
-p(128) :- p(127), q(128).
-q(128) :- p(127), q(127).
-p(129) :- p(128), q(129).
-q(129) :- p(128), q(128).
-p(130) :- p(129), q(130).
-q(130) :- p(129), q(129).
-p(131) :- p(130), q(131).
-q(131) :- p(130), q(130).
-p(132) :- p(131), q(132).
-q(132) :- p(131), q(131).
-p(133) :- p(132), q(133).
-q(133) :- p(132), q(132).
-p(134) :- p(133), q(134).
-q(134) :- p(133), q(133).
-p(135) :- p(134), q(135).
-q(135) :- p(134), q(134).
-p(136) :- p(135), q(136).
-q(136) :- p(135), q(135).
-p(137) :- p(136), q(137).
-q(137) :- p(136), q(136).
-p(138) :- p(137), q(138).
-q(138) :- p(137), q(137).
-p(139) :- p(138), q(139).
-q(139) :- p(138), q(138).
-p(140) :- p(139), q(140).
-q(140) :- p(139), q(139).
-p(141) :- p(140), q(141).
-q(141) :- p(140), q(140).
-p(142) :- p(141), q(142).
-q(142) :- p(141), q(141).
-p(143) :- p(142), q(143).
-q(143) :- p(142), q(142).
-p(144) :- p(143), q(144).
-q(144) :- p(143), q(143).
-p(145) :- p(144), q(145).
-q(145) :- p(144), q(144).
-p(146) :- p(145), q(146).
-q(146) :- p(145), q(145).
-p(147) :- p(146), q(147).
-q(147) :- p(146), q(146).
-p(148) :- p(147), q(148).
-q(148) :- p(147), q(147).
-p(149) :- p(148), q(149).
-q(149) :- p(148), q(148).
-p(150) :- p(149), q(150).
-q(150) :- p(149), q(149).
-p(151) :- p(150), q(151).
-q(151) :- p(150), q(150).
-p(152) :- p(151), q(152).
-q(152) :- p(151), q(151).
-p(153) :- p(152), q(153).
-q(153) :- p(152), q(152).
-p(154) :- p(153), q(154).
-q(154) :- p(153), q(153).
-p(155) :- p(154), q(155).
-q(155) :- p(154), q(154).
-p(156) :- p(155), q(156).
-q(156) :- p(155), q(155).
-p(157) :- p(156), q(157).
-q(157) :- p(156), q(156).
-p(158) :- p(157), q(158).
-q(158) :- p(157), q(157).
-p(159) :- p(158), q(159).
-q(159) :- p(158), q(158).
-p(160) :- p(159), q(160).
-q(160) :- p(159), q(159).
-p(161) :- p(160), q(161).
-q(161) :- p(160), q(160).
-p(162) :- p(161), q(162).
-q(162) :- p(161), q(161).
-p(163) :- p(162), q(163).
-q(163) :- p(162), q(162).
-p(164) :- p(163), q(164).
-q(164) :- p(163), q(163).
-p(165) :- p(164), q(165).
-q(165) :- p(164), q(164).
-p(166) :- p(165), q(166).
-q(166) :- p(165), q(165).
-p(167) :- p(166), q(167).
-q(167) :- p(166), q(166).
-p(168) :- p(167), q(168).
-q(168) :- p(167), q(167).
-p(169) :- p(168), q(169).
-q(169) :- p(168), q(168).
-p(170) :- p(169), q(170).
-q(170) :- p(169), q(169).
-p(171) :- p(170), q(171).
-q(171) :- p(170), q(170).
-p(172) :- p(171), q(172).
-q(172) :- p(171), q(171).
-p(173) :- p(172), q(173).
-q(173) :- p(172), q(172).
-p(174) :- p(173), q(174).
-q(174) :- p(173), q(173).
-p(175) :- p(174), q(175).
-q(175) :- p(174), q(174).
-p(176) :- p(175), q(176).
-q(176) :- p(175), q(175).
-p(177) :- p(176), q(177).
-q(177) :- p(176), q(176).
-p(178) :- p(177), q(178).
-q(178) :- p(177), q(177).
-p(179) :- p(178), q(179).
-q(179) :- p(178), q(178).
-p(180) :- p(179), q(180).
-q(180) :- p(179), q(179).
-p(181) :- p(180), q(181).
-q(181) :- p(180), q(180).
-p(182) :- p(181), q(182).
-q(182) :- p(181), q(181).
-p(183) :- p(182), q(183).
-q(183) :- p(182), q(182).
-p(184) :- p(183), q(184).
-q(184) :- p(183), q(183).
-p(185) :- p(184), q(185).
-q(185) :- p(184), q(184).
-p(186) :- p(185), q(186).
-q(186) :- p(185), q(185).
-p(187) :- p(186), q(187).
-q(187) :- p(186), q(186).
-p(188) :- p(187), q(188).
-q(188) :- p(187), q(187).
-p(189) :- p(188), q(189).
-q(189) :- p(188), q(188).
-p(190) :- p(189), q(190).
-q(190) :- p(189), q(189).
-p(191) :- p(190), q(191).
-q(191) :- p(190), q(190).
-p(192) :- p(191), q(192).
-q(192) :- p(191), q(191).
-p(193) :- p(192), q(193).
-q(193) :- p(192), q(192).
-p(194) :- p(193), q(194).
-q(194) :- p(193), q(193).
-p(195) :- p(194), q(195).
-q(195) :- p(194), q(194).
-p(196) :- p(195), q(196).
-q(196) :- p(195), q(195).
-p(197) :- p(196), q(197).
-q(197) :- p(196), q(196).
-p(198) :- p(197), q(198).
-q(198) :- p(197), q(197).
-p(199) :- p(198), q(199).
-q(199) :- p(198), q(198).
-p(200) :- p(199), q(200).
-q(200) :- p(199), q(199).
-p(201) :- p(200), q(201).
-q(201) :- p(200), q(200).
-p(202) :- p(201), q(202).
-q(202) :- p(201), q(201).
-p(203) :- p(202), q(203).
-q(203) :- p(202), q(202).
-p(204) :- p(203), q(204).
-q(204) :- p(203), q(203).
-p(205) :- p(204), q(205).
-q(205) :- p(204), q(204).
-p(206) :- p(205), q(206).
-q(206) :- p(205), q(205).
-p(207) :- p(206), q(207).
-q(207) :- p(206), q(206).
-p(208) :- p(207), q(208).
-q(208) :- p(207), q(207).
-p(209) :- p(208), q(209).
-q(209) :- p(208), q(208).
-p(210) :- p(209), q(210).
-q(210) :- p(209), q(209).
-p(211) :- p(210), q(211).
-q(211) :- p(210), q(210).
-p(212) :- p(211), q(212).
-q(212) :- p(211), q(211).
-p(213) :- p(212), q(213).
-q(213) :- p(212), q(212).
-p(214) :- p(213), q(214).
-q(214) :- p(213), q(213).
-p(215) :- p(214), q(215).
-q(215) :- p(214), q(214).
-p(216) :- p(215), q(216).
-q(216) :- p(215), q(215).
-p(217) :- p(216), q(217).
-q(217) :- p(216), q(216).
-p(218) :- p(217), q(218).
-q(218) :- p(217), q(217).
-p(219) :- p(218), q(219).
-q(219) :- p(218), q(218).
-p(220) :- p(219), q(220).
-q(220) :- p(219), q(219).
-p(221) :- p(220), q(221).
-q(221) :- p(220), q(220).
-p(222) :- p(221), q(222).
-q(222) :- p(221), q(221).
-p(223) :- p(222), q(223).
-q(223) :- p(222), q(222).
-p(224) :- p(223), q(224).
-q(224) :- p(223), q(223).
-p(225) :- p(224), q(225).
-q(225) :- p(224), q(224).
-p(226) :- p(225), q(226).
-q(226) :- p(225), q(225).
-p(227) :- p(226), q(227).
-q(227) :- p(226), q(226).
-p(228) :- p(227), q(228).
-q(228) :- p(227), q(227).
-p(229) :- p(228), q(229).
-q(229) :- p(228), q(228).
-p(230) :- p(229), q(230).
-q(230) :- p(229), q(229).
-p(231) :- p(230), q(231).
-q(231) :- p(230), q(230).
-p(232) :- p(231), q(232).
-q(232) :- p(231), q(231).
-p(233) :- p(232), q(233).
-q(233) :- p(232), q(232).
-p(234) :- p(233), q(234).
-q(234) :- p(233), q(233).
-p(235) :- p(234), q(235).
-q(235) :- p(234), q(234).
-p(236) :- p(235), q(236).
-q(236) :- p(235), q(235).
-p(237) :- p(236), q(237).
-q(237) :- p(236), q(236).
-p(238) :- p(237), q(238).
-q(238) :- p(237), q(237).
-p(239) :- p(238), q(239).
-q(239) :- p(238), q(238).
-p(240) :- p(239), q(240).
-q(240) :- p(239), q(239).
-p(241) :- p(240), q(241).
-q(241) :- p(240), q(240).
-p(242) :- p(241), q(242).
-q(242) :- p(241), q(241).
-p(243) :- p(242), q(243).
-q(243) :- p(242), q(242).
-p(244) :- p(243), q(244).
-q(244) :- p(243), q(243).
-p(245) :- p(244), q(245).
-q(245) :- p(244), q(244).
-p(246) :- p(245), q(246).
-q(246) :- p(245), q(245).
-p(247) :- p(246), q(247).
-q(247) :- p(246), q(246).
-p(248) :- p(247), q(248).
-q(248) :- p(247), q(247).
-p(249) :- p(248), q(249).
-q(249) :- p(248), q(248).
-p(250) :- p(249), q(250).
-q(250) :- p(249), q(249).
-p(251) :- p(250), q(251).
-q(251) :- p(250), q(250).
-p(252) :- p(251), q(252).
-q(252) :- p(251), q(251).
-p(253) :- p(252), q(253).
-q(253) :- p(252), q(252).
-p(254) :- p(253), q(254).
-q(254) :- p(253), q(253).
-p(255) :- p(254), q(255).
-q(255) :- p(254), q(254).
-p(256) :- p(255), q(256).
-q(256) :- p(255), q(255).
-p(257) :- p(256), q(257).
-q(257) :- p(256), q(256).
-p(258) :- p(257), q(258).
-q(258) :- p(257), q(257).
-p(259) :- p(258), q(259).
-q(259) :- p(258), q(258).
-p(260) :- p(259), q(260).
-q(260) :- p(259), q(259).
-p(261) :- p(260), q(261).
-q(261) :- p(260), q(260).
-p(262) :- p(261), q(262).
-q(262) :- p(261), q(261).
-p(263) :- p(262), q(263).
-q(263) :- p(262), q(262).
-p(264) :- p(263), q(264).
-q(264) :- p(263), q(263).
-p(265) :- p(264), q(265).
-q(265) :- p(264), q(264).
-p(266) :- p(265), q(266).
-q(266) :- p(265), q(265).
-p(267) :- p(266), q(267).
-q(267) :- p(266), q(266).
-p(268) :- p(267), q(268).
-q(268) :- p(267), q(267).
-p(269) :- p(268), q(269).
-q(269) :- p(268), q(268).
-p(270) :- p(269), q(270).
-q(270) :- p(269), q(269).
-p(271) :- p(270), q(271).
-q(271) :- p(270), q(270).
-p(272) :- p(271), q(272).
-q(272) :- p(271), q(271).
-p(273) :- p(272), q(273).
-q(273) :- p(272), q(272).
-p(274) :- p(273), q(274).
-q(274) :- p(273), q(273).
-p(275) :- p(274), q(275).
-q(275) :- p(274), q(274).
-p(276) :- p(275), q(276).
-q(276) :- p(275), q(275).
-p(277) :- p(276), q(277).
-q(277) :- p(276), q(276).
-p(278) :- p(277), q(278).
-q(278) :- p(277), q(277).
-p(279) :- p(278), q(279).
-q(279) :- p(278), q(278).
-p(280) :- p(279), q(280).
-q(280) :- p(279), q(279).
-p(281) :- p(280), q(281).
-q(281) :- p(280), q(280).
-p(282) :- p(281), q(282).
-q(282) :- p(281), q(281).
-p(283) :- p(282), q(283).
-q(283) :- p(282), q(282).
-p(284) :- p(283), q(284).
-q(284) :- p(283), q(283).
-p(285) :- p(284), q(285).
-q(285) :- p(284), q(284).
-p(286) :- p(285), q(286).
-q(286) :- p(285), q(285).
-p(287) :- p(286), q(287).
-q(287) :- p(286), q(286).
-p(288) :- p(287), q(288).
-q(288) :- p(287), q(287).
-p(289) :- p(288), q(289).
-q(289) :- p(288), q(288).
-p(290) :- p(289), q(290).
-q(290) :- p(289), q(289).
-p(291) :- p(290), q(291).
-q(291) :- p(290), q(290).
-p(292) :- p(291), q(292).
-q(292) :- p(291), q(291).
-p(293) :- p(292), q(293).
-q(293) :- p(292), q(292).
-p(294) :- p(293), q(294).
-q(294) :- p(293), q(293).
-p(295) :- p(294), q(295).
-q(295) :- p(294), q(294).
-p(296) :- p(295), q(296).
-q(296) :- p(295), q(295).
-p(297) :- p(296), q(297).
-q(297) :- p(296), q(296).
-p(298) :- p(297), q(298).
-q(298) :- p(297), q(297).
-p(299) :- p(298), q(299).
-q(299) :- p(298), q(298).
-p(300) :- p(299), q(300).
-q(300) :- p(299), q(299).
-p(301) :- p(300), q(301).
-q(301) :- p(300), q(300).
-p(302) :- p(301), q(302).
-q(302) :- p(301), q(301).
-p(303) :- p(302), q(303).
-q(303) :- p(302), q(302).
-p(304) :- p(303), q(304).
-q(304) :- p(303), q(303).
-p(305) :- p(304), q(305).
-q(305) :- p(304), q(304).
-p(306) :- p(305), q(306).
-q(306) :- p(305), q(305).
-p(307) :- p(306), q(307).
-q(307) :- p(306), q(306).
-p(308) :- p(307), q(308).
-q(308) :- p(307), q(307).
-p(309) :- p(308), q(309).
-q(309) :- p(308), q(308).
-p(310) :- p(309), q(310).
-q(310) :- p(309), q(309).
-p(311) :- p(310), q(311).
-q(311) :- p(310), q(310).
-p(312) :- p(311), q(312).
-q(312) :- p(311), q(311).
-p(313) :- p(312), q(313).
-q(313) :- p(312), q(312).
-p(314) :- p(313), q(314).
-q(314) :- p(313), q(313).
-p(315) :- p(314), q(315).
-q(315) :- p(314), q(314).
-p(316) :- p(315), q(316).
-q(316) :- p(315), q(315).
-p(317) :- p(316), q(317).
-q(317) :- p(316), q(316).
-p(318) :- p(317), q(318).
-q(318) :- p(317), q(317).
-p(319) :- p(318), q(319).
-q(319) :- p(318), q(318).
-p(320) :- p(319), q(320).
-q(320) :- p(319), q(319).
-p(321) :- p(320), q(321).
-q(321) :- p(320), q(320).
-p(322) :- p(321), q(322).
-q(322) :- p(321), q(321).
-p(323) :- p(322), q(323).
-q(323) :- p(322), q(322).
-p(324) :- p(323), q(324).
-q(324) :- p(323), q(323).
-p(325) :- p(324), q(325).
-q(325) :- p(324), q(324).
-p(326) :- p(325), q(326).
-q(326) :- p(325), q(325).
-p(327) :- p(326), q(327).
-q(327) :- p(326), q(326).
-p(328) :- p(327), q(328).
-q(328) :- p(327), q(327).
-p(329) :- p(328), q(329).
-q(329) :- p(328), q(328).
-p(330) :- p(329), q(330).
-q(330) :- p(329), q(329).
-p(331) :- p(330), q(331).
-q(331) :- p(330), q(330).
-p(332) :- p(331), q(332).
-q(332) :- p(331), q(331).
-p(333) :- p(332), q(333).
-q(333) :- p(332), q(332).
-p(334) :- p(333), q(334).
-q(334) :- p(333), q(333).
-p(335) :- p(334), q(335).
-q(335) :- p(334), q(334).
-p(336) :- p(335), q(336).
-q(336) :- p(335), q(335).
-p(337) :- p(336), q(337).
-q(337) :- p(336), q(336).
-p(338) :- p(337), q(338).
-q(338) :- p(337), q(337).
-p(339) :- p(338), q(339).
-q(339) :- p(338), q(338).
-p(340) :- p(339), q(340).
-q(340) :- p(339), q(339).
-p(341) :- p(340), q(341).
-q(341) :- p(340), q(340).
-p(342) :- p(341), q(342).
-q(342) :- p(341), q(341).
-p(343) :- p(342), q(343).
-q(343) :- p(342), q(342).
-p(344) :- p(343), q(344).
-q(344) :- p(343), q(343).
-p(345) :- p(344), q(345).
-q(345) :- p(344), q(344).
-p(346) :- p(345), q(346).
-q(346) :- p(345), q(345).
-p(347) :- p(346), q(347).
-q(347) :- p(346), q(346).
-p(348) :- p(347), q(348).
-q(348) :- p(347), q(347).
-p(349) :- p(348), q(349).
-q(349) :- p(348), q(348).
-p(350) :- p(349), q(350).
-q(350) :- p(349), q(349).
-p(351) :- p(350), q(351).
-q(351) :- p(350), q(350).
-p(352) :- p(351), q(352).
-q(352) :- p(351), q(351).
-p(353) :- p(352), q(353).
-q(353) :- p(352), q(352).
-p(354) :- p(353), q(354).
-q(354) :- p(353), q(353).
-p(355) :- p(354), q(355).
-q(355) :- p(354), q(354).
-p(356) :- p(355), q(356).
-q(356) :- p(355), q(355).
-p(357) :- p(356), q(357).
-q(357) :- p(356), q(356).
-p(358) :- p(357), q(358).
-q(358) :- p(357), q(357).
-p(359) :- p(358), q(359).
-q(359) :- p(358), q(358).
-p(360) :- p(359), q(360).
-q(360) :- p(359), q(359).
-p(361) :- p(360), q(361).
-q(361) :- p(360), q(360).
-p(362) :- p(361), q(362).
-q(362) :- p(361), q(361).
-p(363) :- p(362), q(363).
-q(363) :- p(362), q(362).
-p(364) :- p(363), q(364).
-q(364) :- p(363), q(363).
-p(365) :- p(364), q(365).
-q(365) :- p(364), q(364).
-p(366) :- p(365), q(366).
-q(366) :- p(365), q(365).
-p(367) :- p(366), q(367).
-q(367) :- p(366), q(366).
-p(368) :- p(367), q(368).
-q(368) :- p(367), q(367).
-p(369) :- p(368), q(369).
-q(369) :- p(368), q(368).
-p(370) :- p(369), q(370).
-q(370) :- p(369), q(369).
-p(371) :- p(370), q(371).
-q(371) :- p(370), q(370).
-p(372) :- p(371), q(372).
-q(372) :- p(371), q(371).
-p(373) :- p(372), q(373).
-q(373) :- p(372), q(372).
-p(374) :- p(373), q(374).
-q(374) :- p(373), q(373).
-p(375) :- p(374), q(375).
-q(375) :- p(374), q(374).
-p(376) :- p(375), q(376).
-q(376) :- p(375), q(375).
-p(377) :- p(376), q(377).
-q(377) :- p(376), q(376).
-p(378) :- p(377), q(378).
-q(378) :- p(377), q(377).
-p(379) :- p(378), q(379).
-q(379) :- p(378), q(378).
-p(380) :- p(379), q(380).
-q(380) :- p(379), q(379).
-p(381) :- p(380), q(381).
-q(381) :- p(380), q(380).
-p(382) :- p(381), q(382).
-q(382) :- p(381), q(381).
-p(383) :- p(382), q(383).
-q(383) :- p(382), q(382).
-p(384) :- p(383), q(384).
-q(384) :- p(383), q(383).
-p(385) :- p(384), q(385).
-q(385) :- p(384), q(384).
-p(386) :- p(385), q(386).
-q(386) :- p(385), q(385).
-p(387) :- p(386), q(387).
-q(387) :- p(386), q(386).
-p(388) :- p(387), q(388).
-q(388) :- p(387), q(387).
-p(389) :- p(388), q(389).
-q(389) :- p(388), q(388).
-p(390) :- p(389), q(390).
-q(390) :- p(389), q(389).
-p(391) :- p(390), q(391).
-q(391) :- p(390), q(390).
-p(392) :- p(391), q(392).
-q(392) :- p(391), q(391).
-p(393) :- p(392), q(393).
-q(393) :- p(392), q(392).
-p(394) :- p(393), q(394).
-q(394) :- p(393), q(393).
-p(395) :- p(394), q(395).
-q(395) :- p(394), q(394).
-p(396) :- p(395), q(396).
-q(396) :- p(395), q(395).
-p(397) :- p(396), q(397).
-q(397) :- p(396), q(396).
-p(398) :- p(397), q(398).
-q(398) :- p(397), q(397).
-p(399) :- p(398), q(399).
-q(399) :- p(398), q(398).
-p(400) :- p(399), q(400).
-q(400) :- p(399), q(399).
-p(401) :- p(400), q(401).
-q(401) :- p(400), q(400).
-p(402) :- p(401), q(402).
-q(402) :- p(401), q(401).
-p(403) :- p(402), q(403).
-q(403) :- p(402), q(402).
-p(404) :- p(403), q(404).
-q(404) :- p(403), q(403).
-p(405) :- p(404), q(405).
-q(405) :- p(404), q(404).
-p(406) :- p(405), q(406).
-q(406) :- p(405), q(405).
-p(407) :- p(406), q(407).
-q(407) :- p(406), q(406).
-p(408) :- p(407), q(408).
-q(408) :- p(407), q(407).
-p(409) :- p(408), q(409).
-q(409) :- p(408), q(408).
-p(410) :- p(409), q(410).
-q(410) :- p(409), q(409).
-p(411) :- p(410), q(411).
-q(411) :- p(410), q(410).
-p(412) :- p(411), q(412).
-q(412) :- p(411), q(411).
-p(413) :- p(412), q(413).
-q(413) :- p(412), q(412).
-p(414) :- p(413), q(414).
-q(414) :- p(413), q(413).
-p(415) :- p(414), q(415).
-q(415) :- p(414), q(414).
-p(416) :- p(415), q(416).
-q(416) :- p(415), q(415).
-p(417) :- p(416), q(417).
-q(417) :- p(416), q(416).
-p(418) :- p(417), q(418).
-q(418) :- p(417), q(417).
-p(419) :- p(418), q(419).
-q(419) :- p(418), q(418).
-p(420) :- p(419), q(420).
-q(420) :- p(419), q(419).
-p(421) :- p(420), q(421).
-q(421) :- p(420), q(420).
-p(422) :- p(421), q(422).
-q(422) :- p(421), q(421).
-p(423) :- p(422), q(423).
-q(423) :- p(422), q(422).
-p(424) :- p(423), q(424).
-q(424) :- p(423), q(423).
-p(425) :- p(424), q(425).
-q(425) :- p(424), q(424).
-p(426) :- p(425), q(426).
-q(426) :- p(425), q(425).
-p(427) :- p(426), q(427).
-q(427) :- p(426), q(426).
-p(428) :- p(427), q(428).
-q(428) :- p(427), q(427).
-p(429) :- p(428), q(429).
-q(429) :- p(428), q(428).
-p(430) :- p(429), q(430).
-q(430) :- p(429), q(429).
-p(431) :- p(430), q(431).
-q(431) :- p(430), q(430).
-p(432) :- p(431), q(432).
-q(432) :- p(431), q(431).
-p(433) :- p(432), q(433).
-q(433) :- p(432), q(432).
-p(434) :- p(433), q(434).
-q(434) :- p(433), q(433).
-p(435) :- p(434), q(435).
-q(435) :- p(434), q(434).
-p(436) :- p(435), q(436).
-q(436) :- p(435), q(435).
-p(437) :- p(436), q(437).
-q(437) :- p(436), q(436).
-p(438) :- p(437), q(438).
-q(438) :- p(437), q(437).
-p(439) :- p(438), q(439).
-q(439) :- p(438), q(438).
-p(440) :- p(439), q(440).
-q(440) :- p(439), q(439).
-p(441) :- p(440), q(441).
-q(441) :- p(440), q(440).
-p(442) :- p(441), q(442).
-q(442) :- p(441), q(441).
-p(443) :- p(442), q(443).
-q(443) :- p(442), q(442).
-p(444) :- p(443), q(444).
-q(444) :- p(443), q(443).
-p(445) :- p(444), q(445).
-q(445) :- p(444), q(444).
-p(446) :- p(445), q(446).
-q(446) :- p(445), q(445).
-p(447) :- p(446), q(447).
-q(447) :- p(446), q(446).
-p(448) :- p(447), q(448).
-q(448) :- p(447), q(447).
-p(449) :- p(448), q(449).
-q(449) :- p(448), q(448).
-p(450) :- p(449), q(450).
-q(450) :- p(449), q(449).
-p(451) :- p(450), q(451).
-q(451) :- p(450), q(450).
-p(452) :- p(451), q(452).
-q(452) :- p(451), q(451).
-p(453) :- p(452), q(453).
-q(453) :- p(452), q(452).
-p(454) :- p(453), q(454).
-q(454) :- p(453), q(453).
-p(455) :- p(454), q(455).
-q(455) :- p(454), q(454).
-p(456) :- p(455), q(456).
-q(456) :- p(455), q(455).
-p(457) :- p(456), q(457).
-q(457) :- p(456), q(456).
-p(458) :- p(457), q(458).
-q(458) :- p(457), q(457).
-p(459) :- p(458), q(459).
-q(459) :- p(458), q(458).
-p(460) :- p(459), q(460).
-q(460) :- p(459), q(459).
-p(461) :- p(460), q(461).
-q(461) :- p(460), q(460).
-p(462) :- p(461), q(462).
-q(462) :- p(461), q(461).
-p(463) :- p(462), q(463).
-q(463) :- p(462), q(462).
-p(464) :- p(463), q(464).
-q(464) :- p(463), q(463).
-p(465) :- p(464), q(465).
-q(465) :- p(464), q(464).
-p(466) :- p(465), q(466).
-q(466) :- p(465), q(465).
-p(467) :- p(466), q(467).
-q(467) :- p(466), q(466).
-p(468) :- p(467), q(468).
-q(468) :- p(467), q(467).
-p(469) :- p(468), q(469).
-q(469) :- p(468), q(468).
-p(470) :- p(469), q(470).
-q(470) :- p(469), q(469).
-p(471) :- p(470), q(471).
-q(471) :- p(470), q(470).
-p(472) :- p(471), q(472).
-q(472) :- p(471), q(471).
-p(473) :- p(472), q(473).
-q(473) :- p(472), q(472).
-p(474) :- p(473), q(474).
-q(474) :- p(473), q(473).
-p(475) :- p(474), q(475).
-q(475) :- p(474), q(474).
-p(476) :- p(475), q(476).
-q(476) :- p(475), q(475).
-p(477) :- p(476), q(477).
-q(477) :- p(476), q(476).
-p(478) :- p(477), q(478).
-q(478) :- p(477), q(477).
-p(479) :- p(478), q(479).
-q(479) :- p(478), q(478).
-p(480) :- p(479), q(480).
-q(480) :- p(479), q(479).
-p(481) :- p(480), q(481).
-q(481) :- p(480), q(480).
-p(482) :- p(481), q(482).
-q(482) :- p(481), q(481).
-p(483) :- p(482), q(483).
-q(483) :- p(482), q(482).
-p(484) :- p(483), q(484).
-q(484) :- p(483), q(483).
-p(485) :- p(484), q(485).
-q(485) :- p(484), q(484).
-p(486) :- p(485), q(486).
-q(486) :- p(485), q(485).
-p(487) :- p(486), q(487).
-q(487) :- p(486), q(486).
-p(488) :- p(487), q(488).
-q(488) :- p(487), q(487).
-p(489) :- p(488), q(489).
-q(489) :- p(488), q(488).
-p(490) :- p(489), q(490).
-q(490) :- p(489), q(489).
-p(491) :- p(490), q(491).
-q(491) :- p(490), q(490).
-p(492) :- p(491), q(492).
-q(492) :- p(491), q(491).
-p(493) :- p(492), q(493).
-q(493) :- p(492), q(492).
-p(494) :- p(493), q(494).
-q(494) :- p(493), q(493).
-p(495) :- p(494), q(495).
-q(495) :- p(494), q(494).
-p(496) :- p(495), q(496).
-q(496) :- p(495), q(495).
-p(497) :- p(496), q(497).
-q(497) :- p(496), q(496).
-p(498) :- p(497), q(498).
-q(498) :- p(497), q(497).
-p(499) :- p(498), q(499).
-q(499) :- p(498), q(498).
-p(500) :- p(499), q(500).
-q(500) :- p(499), q(499).
-p(501) :- p(500), q(501).
-q(501) :- p(500), q(500).
-p(502) :- p(501), q(502).
-q(502) :- p(501), q(501).
-p(503) :- p(502), q(503).
-q(503) :- p(502), q(502).
-p(504) :- p(503), q(504).
-q(504) :- p(503), q(503).
-p(505) :- p(504), q(505).
-q(505) :- p(504), q(504).
-p(506) :- p(505), q(506).
-q(506) :- p(505), q(505).
-p(507) :- p(506), q(507).
-q(507) :- p(506), q(506).
-p(508) :- p(507), q(508).
-q(508) :- p(507), q(507).
-p(509) :- p(508), q(509).
-q(509) :- p(508), q(508).
-p(510) :- p(509), q(510).
-q(510) :- p(509), q(509).
-p(511) :- p(510), q(511).
-q(511) :- p(510), q(510).
-p(512) :- p(511), q(512).
-q(512) :- p(511), q(511).
-p(513) :- p(512), q(513).
-q(513) :- p(512), q(512).
-p(514) :- p(513), q(514).
-q(514) :- p(513), q(513).
-p(515) :- p(514), q(515).
-q(515) :- p(514), q(514).
-p(516) :- p(515), q(516).
-q(516) :- p(515), q(515).
-p(517) :- p(516), q(517).
-q(517) :- p(516), q(516).
-p(518) :- p(517), q(518).
-q(518) :- p(517), q(517).
-p(519) :- p(518), q(519).
-q(519) :- p(518), q(518).
-p(520) :- p(519), q(520).
-q(520) :- p(519), q(519).
-p(521) :- p(520), q(521).
-q(521) :- p(520), q(520).
-p(522) :- p(521), q(522).
-q(522) :- p(521), q(521).
-p(523) :- p(522), q(523).
-q(523) :- p(522), q(522).
-p(524) :- p(523), q(524).
-q(524) :- p(523), q(523).
-p(525) :- p(524), q(525).
-q(525) :- p(524), q(524).
-p(526) :- p(525), q(526).
-q(526) :- p(525), q(525).
-p(527) :- p(526), q(527).
-q(527) :- p(526), q(526).
-p(528) :- p(527), q(528).
-q(528) :- p(527), q(527).
-p(529) :- p(528), q(529).
-q(529) :- p(528), q(528).
-p(530) :- p(529), q(530).
-q(530) :- p(529), q(529).
-p(531) :- p(530), q(531).
-q(531) :- p(530), q(530).
-p(532) :- p(531), q(532).
-q(532) :- p(531), q(531).
-p(533) :- p(532), q(533).
-q(533) :- p(532), q(532).
-p(534) :- p(533), q(534).
-q(534) :- p(533), q(533).
-p(535) :- p(534), q(535).
-q(535) :- p(534), q(534).
-p(536) :- p(535), q(536).
-q(536) :- p(535), q(535).
-p(537) :- p(536), q(537).
-q(537) :- p(536), q(536).
-p(538) :- p(537), q(538).
-q(538) :- p(537), q(537).
-p(539) :- p(538), q(539).
-q(539) :- p(538), q(538).
-p(540) :- p(539), q(540).
-q(540) :- p(539), q(539).
-p(541) :- p(540), q(541).
-q(541) :- p(540), q(540).
-p(542) :- p(541), q(542).
-q(542) :- p(541), q(541).
-p(543) :- p(542), q(543).
-q(543) :- p(542), q(542).
-p(544) :- p(543), q(544).
-q(544) :- p(543), q(543).
-p(545) :- p(544), q(545).
-q(545) :- p(544), q(544).
-p(546) :- p(545), q(546).
-q(546) :- p(545), q(545).
-p(547) :- p(546), q(547).
-q(547) :- p(546), q(546).
-p(548) :- p(547), q(548).
-q(548) :- p(547), q(547).
-p(549) :- p(548), q(549).
-q(549) :- p(548), q(548).
-p(550) :- p(549), q(550).
-q(550) :- p(549), q(549).
-p(551) :- p(550), q(551).
-q(551) :- p(550), q(550).
-p(552) :- p(551), q(552).
-q(552) :- p(551), q(551).
-p(553) :- p(552), q(553).
-q(553) :- p(552), q(552).
-p(554) :- p(553), q(554).
-q(554) :- p(553), q(553).
-p(555) :- p(554), q(555).
-q(555) :- p(554), q(554).
-p(556) :- p(555), q(556).
-q(556) :- p(555), q(555).
-p(557) :- p(556), q(557).
-q(557) :- p(556), q(556).
-p(558) :- p(557), q(558).
-q(558) :- p(557), q(557).
-p(559) :- p(558), q(559).
-q(559) :- p(558), q(558).
-p(560) :- p(559), q(560).
-q(560) :- p(559), q(559).
-p(561) :- p(560), q(561).
-q(561) :- p(560), q(560).
-p(562) :- p(561), q(562).
-q(562) :- p(561), q(561).
-p(563) :- p(562), q(563).
-q(563) :- p(562), q(562).
-p(564) :- p(563), q(564).
-q(564) :- p(563), q(563).
-p(565) :- p(564), q(565).
-q(565) :- p(564), q(564).
-p(566) :- p(565), q(566).
-q(566) :- p(565), q(565).
-p(567) :- p(566), q(567).
-q(567) :- p(566), q(566).
-p(568) :- p(567), q(568).
-q(568) :- p(567), q(567).
-p(569) :- p(568), q(569).
-q(569) :- p(568), q(568).
-p(570) :- p(569), q(570).
-q(570) :- p(569), q(569).
-p(571) :- p(570), q(571).
-q(571) :- p(570), q(570).
-p(572) :- p(571), q(572).
-q(572) :- p(571), q(571).
-p(573) :- p(572), q(573).
-q(573) :- p(572), q(572).
-p(574) :- p(573), q(574).
-q(574) :- p(573), q(573).
-p(575) :- p(574), q(575).
-q(575) :- p(574), q(574).
-p(576) :- p(575), q(576).
-q(576) :- p(575), q(575).
-p(577) :- p(576), q(577).
-q(577) :- p(576), q(576).
-p(578) :- p(577), q(578).
-q(578) :- p(577), q(577).
-p(579) :- p(578), q(579).
-q(579) :- p(578), q(578).
-p(580) :- p(579), q(580).
-q(580) :- p(579), q(579).
-p(581) :- p(580), q(581).
-q(581) :- p(580), q(580).
-p(582) :- p(581), q(582).
-q(582) :- p(581), q(581).
-p(583) :- p(582), q(583).
-q(583) :- p(582), q(582).
-p(584) :- p(583), q(584).
-q(584) :- p(583), q(583).
-p(585) :- p(584), q(585).
-q(585) :- p(584), q(584).
-p(586) :- p(585), q(586).
-q(586) :- p(585), q(585).
-p(587) :- p(586), q(587).
-q(587) :- p(586), q(586).
-p(588) :- p(587), q(588).
-q(588) :- p(587), q(587).
-p(589) :- p(588), q(589).
-q(589) :- p(588), q(588).
-p(590) :- p(589), q(590).
-q(590) :- p(589), q(589).
-p(591) :- p(590), q(591).
-q(591) :- p(590), q(590).
-p(592) :- p(591), q(592).
-q(592) :- p(591), q(591).
-p(593) :- p(592), q(593).
-q(593) :- p(592), q(592).
-p(594) :- p(593), q(594).
-q(594) :- p(593), q(593).
-p(595) :- p(594), q(595).
-q(595) :- p(594), q(594).
-p(596) :- p(595), q(596).
-q(596) :- p(595), q(595).
-p(597) :- p(596), q(597).
-q(597) :- p(596), q(596).
-p(598) :- p(597), q(598).
-q(598) :- p(597), q(597).
-p(599) :- p(598), q(599).
-q(599) :- p(598), q(598).
-p(600) :- p(599), q(600).
-q(600) :- p(599), q(599).
-p(601) :- p(600), q(601).
-q(601) :- p(600), q(600).
-p(602) :- p(601), q(602).
-q(602) :- p(601), q(601).
-p(603) :- p(602), q(603).
-q(603) :- p(602), q(602).
-p(604) :- p(603), q(604).
-q(604) :- p(603), q(603).
-p(605) :- p(604), q(605).
-q(605) :- p(604), q(604).
-p(606) :- p(605), q(606).
-q(606) :- p(605), q(605).
-p(607) :- p(606), q(607).
-q(607) :- p(606), q(606).
-p(608) :- p(607), q(608).
-q(608) :- p(607), q(607).
-p(609) :- p(608), q(609).
-q(609) :- p(608), q(608).
-p(610) :- p(609), q(610).
-q(610) :- p(609), q(609).
-p(611) :- p(610), q(611).
-q(611) :- p(610), q(610).
-p(612) :- p(611), q(612).
-q(612) :- p(611), q(611).
-p(613) :- p(612), q(613).
-q(613) :- p(612), q(612).
-p(614) :- p(613), q(614).
-q(614) :- p(613), q(613).
-p(615) :- p(614), q(615).
-q(615) :- p(614), q(614).
-p(616) :- p(615), q(616).
-q(616) :- p(615), q(615).
-p(617) :- p(616), q(617).
-q(617) :- p(616), q(616).
-p(618) :- p(617), q(618).
-q(618) :- p(617), q(617).
-p(619) :- p(618), q(619).
-q(619) :- p(618), q(618).
-p(620) :- p(619), q(620).
-q(620) :- p(619), q(619).
-p(621) :- p(620), q(621).
-q(621) :- p(620), q(620).
-p(622) :- p(621), q(622).
-q(622) :- p(621), q(621).
-p(623) :- p(622), q(623).
-q(623) :- p(622), q(622).
-p(624) :- p(623), q(624).
-q(624) :- p(623), q(623).
-p(625) :- p(624), q(625).
-q(625) :- p(624), q(624).
-p(626) :- p(625), q(626).
-q(626) :- p(625), q(625).
-p(627) :- p(626), q(627).
-q(627) :- p(626), q(626).
-p(628) :- p(627), q(628).
-q(628) :- p(627), q(627).
-p(629) :- p(628), q(629).
-q(629) :- p(628), q(628).
-p(630) :- p(629), q(630).
-q(630) :- p(629), q(629).
-p(631) :- p(630), q(631).
-q(631) :- p(630), q(630).
-p(632) :- p(631), q(632).
-q(632) :- p(631), q(631).
-p(633) :- p(632), q(633).
-q(633) :- p(632), q(632).
-p(634) :- p(633), q(634).
-q(634) :- p(633), q(633).
-p(635) :- p(634), q(635).
-q(635) :- p(634), q(634).
-p(636) :- p(635), q(636).
-q(636) :- p(635), q(635).
-p(637) :- p(636), q(637).
-q(637) :- p(636), q(636).
-p(638) :- p(637), q(638).
-q(638) :- p(637), q(637).
-p(639) :- p(638), q(639).
-q(639) :- p(638), q(638).
-p(640) :- p(639), q(640).
-q(640) :- p(639), q(639).
-p(641) :- p(640), q(641).
-q(641) :- p(640), q(640).
-p(642) :- p(641), q(642).
-q(642) :- p(641), q(641).
-p(643) :- p(642), q(643).
-q(643) :- p(642), q(642).
-p(644) :- p(643), q(644).
-q(644) :- p(643), q(643).
-p(645) :- p(644), q(645).
-q(645) :- p(644), q(644).
-p(646) :- p(645), q(646).
-q(646) :- p(645), q(645).
-p(647) :- p(646), q(647).
-q(647) :- p(646), q(646).
-p(648) :- p(647), q(648).
-q(648) :- p(647), q(647).
-p(649) :- p(648), q(649).
-q(649) :- p(648), q(648).
-p(650) :- p(649), q(650).
-q(650) :- p(649), q(649).
-p(651) :- p(650), q(651).
-q(651) :- p(650), q(650).
-p(652) :- p(651), q(652).
-q(652) :- p(651), q(651).
-p(653) :- p(652), q(653).
-q(653) :- p(652), q(652).
-p(654) :- p(653), q(654).
-q(654) :- p(653), q(653).
-p(655) :- p(654), q(655).
-q(655) :- p(654), q(654).
-p(656) :- p(655), q(656).
-q(656) :- p(655), q(655).
-p(657) :- p(656), q(657).
-q(657) :- p(656), q(656).
-p(658) :- p(657), q(658).
-q(658) :- p(657), q(657).
-p(659) :- p(658), q(659).
-q(659) :- p(658), q(658).
-p(660) :- p(659), q(660).
-q(660) :- p(659), q(659).
-p(661) :- p(660), q(661).
-q(661) :- p(660), q(660).
-p(662) :- p(661), q(662).
-q(662) :- p(661), q(661).
-p(663) :- p(662), q(663).
-q(663) :- p(662), q(662).
-p(664) :- p(663), q(664).
-q(664) :- p(663), q(663).
-p(665) :- p(664), q(665).
-q(665) :- p(664), q(664).
-p(666) :- p(665), q(666).
-q(666) :- p(665), q(665).
-p(667) :- p(666), q(667).
-q(667) :- p(666), q(666).
-p(668) :- p(667), q(668).
-q(668) :- p(667), q(667).
-p(669) :- p(668), q(669).
-q(669) :- p(668), q(668).
-p(670) :- p(669), q(670).
-q(670) :- p(669), q(669).
-p(671) :- p(670), q(671).
-q(671) :- p(670), q(670).
-p(672) :- p(671), q(672).
-q(672) :- p(671), q(671).
-p(673) :- p(672), q(673).
-q(673) :- p(672), q(672).
-p(674) :- p(673), q(674).
-q(674) :- p(673), q(673).
-p(675) :- p(674), q(675).
-q(675) :- p(674), q(674).
-p(676) :- p(675), q(676).
-q(676) :- p(675), q(675).
-p(677) :- p(676), q(677).
-q(677) :- p(676), q(676).
-p(678) :- p(677), q(678).
-q(678) :- p(677), q(677).
-p(679) :- p(678), q(679).
-q(679) :- p(678), q(678).
-p(680) :- p(679), q(680).
-q(680) :- p(679), q(679).
-p(681) :- p(680), q(681).
-q(681) :- p(680), q(680).
-p(682) :- p(681), q(682).
-q(682) :- p(681), q(681).
-p(683) :- p(682), q(683).
-q(683) :- p(682), q(682).
-p(684) :- p(683), q(684).
-q(684) :- p(683), q(683).
-p(685) :- p(684), q(685).
-q(685) :- p(684), q(684).
-p(686) :- p(685), q(686).
-q(686) :- p(685), q(685).
-p(687) :- p(686), q(687).
-q(687) :- p(686), q(686).
-p(688) :- p(687), q(688).
-q(688) :- p(687), q(687).
-p(689) :- p(688), q(689).
-q(689) :- p(688), q(688).
-p(690) :- p(689), q(690).
-q(690) :- p(689), q(689).
-p(691) :- p(690), q(691).
-q(691) :- p(690), q(690).
-p(692) :- p(691), q(692).
-q(692) :- p(691), q(691).
-p(693) :- p(692), q(693).
-q(693) :- p(692), q(692).
-p(694) :- p(693), q(694).
-q(694) :- p(693), q(693).
-p(695) :- p(694), q(695).
-q(695) :- p(694), q(694).
-p(696) :- p(695), q(696).
-q(696) :- p(695), q(695).
-p(697) :- p(696), q(697).
-q(697) :- p(696), q(696).
-p(698) :- p(697), q(698).
-q(698) :- p(697), q(697).
-p(699) :- p(698), q(699).
-q(699) :- p(698), q(698).
-p(700) :- p(699), q(700).
-q(700) :- p(699), q(699).
-p(701) :- p(700), q(701).
-q(701) :- p(700), q(700).
-p(702) :- p(701), q(702).
-q(702) :- p(701), q(701).
-p(703) :- p(702), q(703).
-q(703) :- p(702), q(702).
-p(704) :- p(703), q(704).
-q(704) :- p(703), q(703).
-p(705) :- p(704), q(705).
-q(705) :- p(704), q(704).
-p(706) :- p(705), q(706).
-q(706) :- p(705), q(705).
-p(707) :- p(706), q(707).
-q(707) :- p(706), q(706).
-p(708) :- p(707), q(708).
-q(708) :- p(707), q(707).
-p(709) :- p(708), q(709).
-q(709) :- p(708), q(708).
-p(710) :- p(709), q(710).
-q(710) :- p(709), q(709).
-p(711) :- p(710), q(711).
-q(711) :- p(710), q(710).
-p(712) :- p(711), q(712).
-q(712) :- p(711), q(711).
-p(713) :- p(712), q(713).
-q(713) :- p(712), q(712).
-p(714) :- p(713), q(714).
-q(714) :- p(713), q(713).
-p(715) :- p(714), q(715).
-q(715) :- p(714), q(714).
-p(716) :- p(715), q(716).
-q(716) :- p(715), q(715).
-p(717) :- p(716), q(717).
-q(717) :- p(716), q(716).
-p(718) :- p(717), q(718).
-q(718) :- p(717), q(717).
-p(719) :- p(718), q(719).
-q(719) :- p(718), q(718).
-p(720) :- p(719), q(720).
-q(720) :- p(719), q(719).
-p(721) :- p(720), q(721).
-q(721) :- p(720), q(720).
-p(722) :- p(721), q(722).
-q(722) :- p(721), q(721).
-p(723) :- p(722), q(723).
-q(723) :- p(722), q(722).
-p(724) :- p(723), q(724).
-q(724) :- p(723), q(723).
-p(725) :- p(724), q(725).
-q(725) :- p(724), q(724).
-p(726) :- p(725), q(726).
-q(726) :- p(725), q(725).
-p(727) :- p(726), q(727).
-q(727) :- p(726), q(726).
-p(728) :- p(727), q(728).
-q(728) :- p(727), q(727).
-p(729) :- p(728), q(729).
-q(729) :- p(728), q(728).
-p(730) :- p(729), q(730).
-q(730) :- p(729), q(729).
-p(731) :- p(730), q(731).
-q(731) :- p(730), q(730).
-p(732) :- p(731), q(732).
-q(732) :- p(731), q(731).
-p(733) :- p(732), q(733).
-q(733) :- p(732), q(732).
-p(734) :- p(733), q(734).
-q(734) :- p(733), q(733).
-p(735) :- p(734), q(735).
-q(735) :- p(734), q(734).
-p(736) :- p(735), q(736).
-q(736) :- p(735), q(735).
-p(737) :- p(736), q(737).
-q(737) :- p(736), q(736).
-p(738) :- p(737), q(738).
-q(738) :- p(737), q(737).
-p(739) :- p(738), q(739).
-q(739) :- p(738), q(738).
-p(740) :- p(739), q(740).
-q(740) :- p(739), q(739).
-p(741) :- p(740), q(741).
-q(741) :- p(740), q(740).
-p(742) :- p(741), q(742).
-q(742) :- p(741), q(741).
-p(743) :- p(742), q(743).
-q(743) :- p(742), q(742).
-p(744) :- p(743), q(744).
-q(744) :- p(743), q(743).
-p(745) :- p(744), q(745).
-q(745) :- p(744), q(744).
-p(746) :- p(745), q(746).
-q(746) :- p(745), q(745).
-p(747) :- p(746), q(747).
-q(747) :- p(746), q(746).
-p(748) :- p(747), q(748).
-q(748) :- p(747), q(747).
-p(749) :- p(748), q(749).
-q(749) :- p(748), q(748).
-p(750) :- p(749), q(750).
-q(750) :- p(749), q(749).
-p(751) :- p(750), q(751).
-q(751) :- p(750), q(750).
-p(752) :- p(751), q(752).
-q(752) :- p(751), q(751).
-p(753) :- p(752), q(753).
-q(753) :- p(752), q(752).
-p(754) :- p(753), q(754).
-q(754) :- p(753), q(753).
-p(755) :- p(754), q(755).
-q(755) :- p(754), q(754).
-p(756) :- p(755), q(756).
-q(756) :- p(755), q(755).
-p(757) :- p(756), q(757).
-q(757) :- p(756), q(756).
-p(758) :- p(757), q(758).
-q(758) :- p(757), q(757).
-p(759) :- p(758), q(759).
-q(759) :- p(758), q(758).
-p(760) :- p(759), q(760).
-q(760) :- p(759), q(759).
-p(761) :- p(760), q(761).
-q(761) :- p(760), q(760).
-p(762) :- p(761), q(762).
-q(762) :- p(761), q(761).
-p(763) :- p(762), q(763).
-q(763) :- p(762), q(762).
-p(764) :- p(763), q(764).
-q(764) :- p(763), q(763).
-p(765) :- p(764), q(765).
-q(765) :- p(764), q(764).
-p(766) :- p(765), q(766).
-q(766) :- p(765), q(765).
-p(767) :- p(766), q(767).
-q(767) :- p(766), q(766).
-p(768) :- p(767), q(768).
-q(768) :- p(767), q(767).
-p(769) :- p(768), q(769).
-q(769) :- p(768), q(768).
-p(770) :- p(769), q(770).
-q(770) :- p(769), q(769).
-p(771) :- p(770), q(771).
-q(771) :- p(770), q(770).
-p(772) :- p(771), q(772).
-q(772) :- p(771), q(771).
-p(773) :- p(772), q(773).
-q(773) :- p(772), q(772).
-p(774) :- p(773), q(774).
-q(774) :- p(773), q(773).
-p(775) :- p(774), q(775).
-q(775) :- p(774), q(774).
-p(776) :- p(775), q(776).
-q(776) :- p(775), q(775).
-p(777) :- p(776), q(777).
-q(777) :- p(776), q(776).
-p(778) :- p(777), q(778).
-q(778) :- p(777), q(777).
-p(779) :- p(778), q(779).
-q(779) :- p(778), q(778).
-p(780) :- p(779), q(780).
-q(780) :- p(779), q(779).
-p(781) :- p(780), q(781).
-q(781) :- p(780), q(780).
-p(782) :- p(781), q(782).
-q(782) :- p(781), q(781).
-p(783) :- p(782), q(783).
-q(783) :- p(782), q(782).
-p(784) :- p(783), q(784).
-q(784) :- p(783), q(783).
-p(785) :- p(784), q(785).
-q(785) :- p(784), q(784).
-p(786) :- p(785), q(786).
-q(786) :- p(785), q(785).
-p(787) :- p(786), q(787).
-q(787) :- p(786), q(786).
-p(788) :- p(787), q(788).
-q(788) :- p(787), q(787).
-p(789) :- p(788), q(789).
-q(789) :- p(788), q(788).
-p(790) :- p(789), q(790).
-q(790) :- p(789), q(789).
-p(791) :- p(790), q(791).
-q(791) :- p(790), q(790).
-p(792) :- p(791), q(792).
-q(792) :- p(791), q(791).
-p(793) :- p(792), q(793).
-q(793) :- p(792), q(792).
-p(794) :- p(793), q(794).
-q(794) :- p(793), q(793).
-p(795) :- p(794), q(795).
-q(795) :- p(794), q(794).
-p(796) :- p(795), q(796).
-q(796) :- p(795), q(795).
-p(797) :- p(796), q(797).
-q(797) :- p(796), q(796).
-p(798) :- p(797), q(798).
-q(798) :- p(797), q(797).
-p(799) :- p(798), q(799).
-q(799) :- p(798), q(798).
-p(800) :- p(799), q(800).
-q(800) :- p(799), q(799).
-p(801) :- p(800), q(801).
-q(801) :- p(800), q(800).
-p(802) :- p(801), q(802).
-q(802) :- p(801), q(801).
-p(803) :- p(802), q(803).
-q(803) :- p(802), q(802).
-p(804) :- p(803), q(804).
-q(804) :- p(803), q(803).
-p(805) :- p(804), q(805).
-q(805) :- p(804), q(804).
-p(806) :- p(805), q(806).
-q(806) :- p(805), q(805).
-p(807) :- p(806), q(807).
-q(807) :- p(806), q(806).
-p(808) :- p(807), q(808).
-q(808) :- p(807), q(807).
-p(809) :- p(808), q(809).
-q(809) :- p(808), q(808).
-p(810) :- p(809), q(810).
-q(810) :- p(809), q(809).
-p(811) :- p(810), q(811).
-q(811) :- p(810), q(810).
-p(812) :- p(811), q(812).
-q(812) :- p(811), q(811).
-p(813) :- p(812), q(813).
-q(813) :- p(812), q(812).
-p(814) :- p(813), q(814).
-q(814) :- p(813), q(813).
-p(815) :- p(814), q(815).
-q(815) :- p(814), q(814).
-p(816) :- p(815), q(816).
-q(816) :- p(815), q(815).
-p(817) :- p(816), q(817).
-q(817) :- p(816), q(816).
-p(818) :- p(817), q(818).
-q(818) :- p(817), q(817).
-p(819) :- p(818), q(819).
-q(819) :- p(818), q(818).
-p(820) :- p(819), q(820).
-q(820) :- p(819), q(819).
-p(821) :- p(820), q(821).
-q(821) :- p(820), q(820).
-p(822) :- p(821), q(822).
-q(822) :- p(821), q(821).
-p(823) :- p(822), q(823).
-q(823) :- p(822), q(822).
-p(824) :- p(823), q(824).
-q(824) :- p(823), q(823).
-p(825) :- p(824), q(825).
-q(825) :- p(824), q(824).
-p(826) :- p(825), q(826).
-q(826) :- p(825), q(825).
-p(827) :- p(826), q(827).
-q(827) :- p(826), q(826).
-p(828) :- p(827), q(828).
-q(828) :- p(827), q(827).
-p(829) :- p(828), q(829).
-q(829) :- p(828), q(828).
-p(830) :- p(829), q(830).
-q(830) :- p(829), q(829).
-p(831) :- p(830), q(831).
-q(831) :- p(830), q(830).
-p(832) :- p(831), q(832).
-q(832) :- p(831), q(831).
-p(833) :- p(832), q(833).
-q(833) :- p(832), q(832).
-p(834) :- p(833), q(834).
-q(834) :- p(833), q(833).
-p(835) :- p(834), q(835).
-q(835) :- p(834), q(834).
-p(836) :- p(835), q(836).
-q(836) :- p(835), q(835).
-p(837) :- p(836), q(837).
-q(837) :- p(836), q(836).
-p(838) :- p(837), q(838).
-q(838) :- p(837), q(837).
-p(839) :- p(838), q(839).
-q(839) :- p(838), q(838).
-p(840) :- p(839), q(840).
-q(840) :- p(839), q(839).
-p(841) :- p(840), q(841).
-q(841) :- p(840), q(840).
-p(842) :- p(841), q(842).
-q(842) :- p(841), q(841).
-p(843) :- p(842), q(843).
-q(843) :- p(842), q(842).
-p(844) :- p(843), q(844).
-q(844) :- p(843), q(843).
-p(845) :- p(844), q(845).
-q(845) :- p(844), q(844).
-p(846) :- p(845), q(846).
-q(846) :- p(845), q(845).
-p(847) :- p(846), q(847).
-q(847) :- p(846), q(846).
-p(848) :- p(847), q(848).
-q(848) :- p(847), q(847).
-p(849) :- p(848), q(849).
-q(849) :- p(848), q(848).
-p(850) :- p(849), q(850).
-q(850) :- p(849), q(849).
-p(851) :- p(850), q(851).
-q(851) :- p(850), q(850).
-p(852) :- p(851), q(852).
-q(852) :- p(851), q(851).
-p(853) :- p(852), q(853).
-q(853) :- p(852), q(852).
-p(854) :- p(853), q(854).
-q(854) :- p(853), q(853).
-p(855) :- p(854), q(855).
-q(855) :- p(854), q(854).
-p(856) :- p(855), q(856).
-q(856) :- p(855), q(855).
-p(857) :- p(856), q(857).
-q(857) :- p(856), q(856).
-p(858) :- p(857), q(858).
-q(858) :- p(857), q(857).
-p(859) :- p(858), q(859).
-q(859) :- p(858), q(858).
-p(860) :- p(859), q(860).
-q(860) :- p(859), q(859).
-p(861) :- p(860), q(861).
-q(861) :- p(860), q(860).
-p(862) :- p(861), q(862).
-q(862) :- p(861), q(861).
-p(863) :- p(862), q(863).
-q(863) :- p(862), q(862).
-p(864) :- p(863), q(864).
-q(864) :- p(863), q(863).
-p(865) :- p(864), q(865).
-q(865) :- p(864), q(864).
-p(866) :- p(865), q(866).
-q(866) :- p(865), q(865).
-p(867) :- p(866), q(867).
-q(867) :- p(866), q(866).
-p(868) :- p(867), q(868).
-q(868) :- p(867), q(867).
-p(869) :- p(868), q(869).
-q(869) :- p(868), q(868).
-p(870) :- p(869), q(870).
-q(870) :- p(869), q(869).
-p(871) :- p(870), q(871).
-q(871) :- p(870), q(870).
-p(872) :- p(871), q(872).
-q(872) :- p(871), q(871).
-p(873) :- p(872), q(873).
-q(873) :- p(872), q(872).
-p(874) :- p(873), q(874).
-q(874) :- p(873), q(873).
-p(875) :- p(874), q(875).
-q(875) :- p(874), q(874).
-p(876) :- p(875), q(876).
-q(876) :- p(875), q(875).
-p(877) :- p(876), q(877).
-q(877) :- p(876), q(876).
-p(878) :- p(877), q(878).
-q(878) :- p(877), q(877).
-p(879) :- p(878), q(879).
-q(879) :- p(878), q(878).
-p(880) :- p(879), q(880).
-q(880) :- p(879), q(879).
-p(881) :- p(880), q(881).
-q(881) :- p(880), q(880).
-p(882) :- p(881), q(882).
-q(882) :- p(881), q(881).
-p(883) :- p(882), q(883).
-q(883) :- p(882), q(882).
-p(884) :- p(883), q(884).
-q(884) :- p(883), q(883).
-p(885) :- p(884), q(885).
-q(885) :- p(884), q(884).
-p(886) :- p(885), q(886).
-q(886) :- p(885), q(885).
-p(887) :- p(886), q(887).
-q(887) :- p(886), q(886).
-p(888) :- p(887), q(888).
-q(888) :- p(887), q(887).
-p(889) :- p(888), q(889).
-q(889) :- p(888), q(888).
-p(890) :- p(889), q(890).
-q(890) :- p(889), q(889).
-p(891) :- p(890), q(891).
-q(891) :- p(890), q(890).
-p(892) :- p(891), q(892).
-q(892) :- p(891), q(891).
-p(893) :- p(892), q(893).
-q(893) :- p(892), q(892).
-p(894) :- p(893), q(894).
-q(894) :- p(893), q(893).
-p(895) :- p(894), q(895).
-q(895) :- p(894), q(894).
-p(896) :- p(895), q(896).
-q(896) :- p(895), q(895).
-p(897) :- p(896), q(897).
-q(897) :- p(896), q(896).
-p(898) :- p(897), q(898).
-q(898) :- p(897), q(897).
-p(899) :- p(898), q(899).
-q(899) :- p(898), q(898).
-p(900) :- p(899), q(900).
-q(900) :- p(899), q(899).
-p(901) :- p(900), q(901).
-q(901) :- p(900), q(900).
-p(902) :- p(901), q(902).
-q(902) :- p(901), q(901).
-p(903) :- p(902), q(903).
-q(903) :- p(902), q(902).
-p(904) :- p(903), q(904).
-q(904) :- p(903), q(903).
-p(905) :- p(904), q(905).
-q(905) :- p(904), q(904).
-p(906) :- p(905), q(906).
-q(906) :- p(905), q(905).
-p(907) :- p(906), q(907).
-q(907) :- p(906), q(906).
-p(908) :- p(907), q(908).
-q(908) :- p(907), q(907).
-p(909) :- p(908), q(909).
-q(909) :- p(908), q(908).
-p(910) :- p(909), q(910).
-q(910) :- p(909), q(909).
-p(911) :- p(910), q(911).
-q(911) :- p(910), q(910).
-p(912) :- p(911), q(912).
-q(912) :- p(911), q(911).
-p(913) :- p(912), q(913).
-q(913) :- p(912), q(912).
-p(914) :- p(913), q(914).
-q(914) :- p(913), q(913).
-p(915) :- p(914), q(915).
-q(915) :- p(914), q(914).
-p(916) :- p(915), q(916).
-q(916) :- p(915), q(915).
-p(917) :- p(916), q(917).
-q(917) :- p(916), q(916).
-p(918) :- p(917), q(918).
-q(918) :- p(917), q(917).
-p(919) :- p(918), q(919).
-q(919) :- p(918), q(918).
-p(920) :- p(919), q(920).
-q(920) :- p(919), q(919).
-p(921) :- p(920), q(921).
-q(921) :- p(920), q(920).
-p(922) :- p(921), q(922).
-q(922) :- p(921), q(921).
-p(923) :- p(922), q(923).
-q(923) :- p(922), q(922).
-p(924) :- p(923), q(924).
-q(924) :- p(923), q(923).
-p(925) :- p(924), q(925).
-q(925) :- p(924), q(924).
-p(926) :- p(925), q(926).
-q(926) :- p(925), q(925).
-p(927) :- p(926), q(927).
-q(927) :- p(926), q(926).
-p(928) :- p(927), q(928).
-q(928) :- p(927), q(927).
-p(929) :- p(928), q(929).
-q(929) :- p(928), q(928).
-p(930) :- p(929), q(930).
-q(930) :- p(929), q(929).
-p(931) :- p(930), q(931).
-q(931) :- p(930), q(930).
-p(932) :- p(931), q(932).
-q(932) :- p(931), q(931).
-p(933) :- p(932), q(933).
-q(933) :- p(932), q(932).
-p(934) :- p(933), q(934).
-q(934) :- p(933), q(933).
-p(935) :- p(934), q(935).
-q(935) :- p(934), q(934).
-p(936) :- p(935), q(936).
-q(936) :- p(935), q(935).
-p(937) :- p(936), q(937).
-q(937) :- p(936), q(936).
-p(938) :- p(937), q(938).
-q(938) :- p(937), q(937).
-p(939) :- p(938), q(939).
-q(939) :- p(938), q(938).
-p(940) :- p(939), q(940).
-q(940) :- p(939), q(939).
-p(941) :- p(940), q(941).
-q(941) :- p(940), q(940).
-p(942) :- p(941), q(942).
-q(942) :- p(941), q(941).
-p(943) :- p(942), q(943).
-q(943) :- p(942), q(942).
-p(944) :- p(943), q(944).
-q(944) :- p(943), q(943).
-p(945) :- p(944), q(945).
-q(945) :- p(944), q(944).
-p(946) :- p(945), q(946).
-q(946) :- p(945), q(945).
-p(947) :- p(946), q(947).
-q(947) :- p(946), q(946).
-p(948) :- p(947), q(948).
-q(948) :- p(947), q(947).
-p(949) :- p(948), q(949).
-q(949) :- p(948), q(948).
-p(950) :- p(949), q(950).
-q(950) :- p(949), q(949).
-p(951) :- p(950), q(951).
-q(951) :- p(950), q(950).
-p(952) :- p(951), q(952).
-q(952) :- p(951), q(951).
-p(953) :- p(952), q(953).
-q(953) :- p(952), q(952).
-p(954) :- p(953), q(954).
-q(954) :- p(953), q(953).
-p(955) :- p(954), q(955).
-q(955) :- p(954), q(954).
-p(956) :- p(955), q(956).
-q(956) :- p(955), q(955).
-p(957) :- p(956), q(957).
-q(957) :- p(956), q(956).
-p(958) :- p(957), q(958).
-q(958) :- p(957), q(957).
-p(959) :- p(958), q(959).
-q(959) :- p(958), q(958).
-p(960) :- p(959), q(960).
-q(960) :- p(959), q(959).
-p(961) :- p(960), q(961).
-q(961) :- p(960), q(960).
-p(962) :- p(961), q(962).
-q(962) :- p(961), q(961).
-p(963) :- p(962), q(963).
-q(963) :- p(962), q(962).
-p(964) :- p(963), q(964).
-q(964) :- p(963), q(963).
-p(965) :- p(964), q(965).
-q(965) :- p(964), q(964).
-p(966) :- p(965), q(966).
-q(966) :- p(965), q(965).
-p(967) :- p(966), q(967).
-q(967) :- p(966), q(966).
-p(968) :- p(967), q(968).
-q(968) :- p(967), q(967).
-p(969) :- p(968), q(969).
-q(969) :- p(968), q(968).
-p(970) :- p(969), q(970).
-q(970) :- p(969), q(969).
-p(971) :- p(970), q(971).
-q(971) :- p(970), q(970).
-p(972) :- p(971), q(972).
-q(972) :- p(971), q(971).
-p(973) :- p(972), q(973).
-q(973) :- p(972), q(972).
-p(974) :- p(973), q(974).
-q(974) :- p(973), q(973).
-p(975) :- p(974), q(975).
-q(975) :- p(974), q(974).
-p(976) :- p(975), q(976).
-q(976) :- p(975), q(975).
-p(977) :- p(976), q(977).
-q(977) :- p(976), q(976).
-p(978) :- p(977), q(978).
-q(978) :- p(977), q(977).
-p(979) :- p(978), q(979).
-q(979) :- p(978), q(978).
-p(980) :- p(979), q(980).
-q(980) :- p(979), q(979).
-p(981) :- p(980), q(981).
-q(981) :- p(980), q(980).
-p(982) :- p(981), q(982).
-q(982) :- p(981), q(981).
-p(983) :- p(982), q(983).
-q(983) :- p(982), q(982).
-p(984) :- p(983), q(984).
-q(984) :- p(983), q(983).
-p(985) :- p(984), q(985).
-q(985) :- p(984), q(984).
-p(986) :- p(985), q(986).
-q(986) :- p(985), q(985).
-p(987) :- p(986), q(987).
-q(987) :- p(986), q(986).
-p(988) :- p(987), q(988).
-q(988) :- p(987), q(987).
-p(989) :- p(988), q(989).
-q(989) :- p(988), q(988).
-p(990) :- p(989), q(990).
-q(990) :- p(989), q(989).
-p(991) :- p(990), q(991).
-q(991) :- p(990), q(990).
-p(992) :- p(991), q(992).
-q(992) :- p(991), q(991).
-p(993) :- p(992), q(993).
-q(993) :- p(992), q(992).
-p(994) :- p(993), q(994).
-q(994) :- p(993), q(993).
-p(995) :- p(994), q(995).
-q(995) :- p(994), q(994).
-p(996) :- p(995), q(996).
-q(996) :- p(995), q(995).
-p(997) :- p(996), q(997).
-q(997) :- p(996), q(996).
-p(998) :- p(997), q(998).
-q(998) :- p(997), q(997).
-p(999) :- p(998), q(999).
-q(999) :- p(998), q(998).
-p(1000) :- p(999), q(1000).
-q(1000) :- p(999), q(999).
-p(1001) :- p(1000), q(1001).
-q(1001) :- p(1000), q(1000).
-p(1002) :- p(1001), q(1002).
-q(1002) :- p(1001), q(1001).
-p(1003) :- p(1002), q(1003).
-q(1003) :- p(1002), q(1002).
-p(1004) :- p(1003), q(1004).
-q(1004) :- p(1003), q(1003).
-p(1005) :- p(1004), q(1005).
-q(1005) :- p(1004), q(1004).
-p(1006) :- p(1005), q(1006).
-q(1006) :- p(1005), q(1005).
-p(1007) :- p(1006), q(1007).
-q(1007) :- p(1006), q(1006).
-p(1008) :- p(1007), q(1008).
-q(1008) :- p(1007), q(1007).
-p(1009) :- p(1008), q(1009).
-q(1009) :- p(1008), q(1008).
-p(1010) :- p(1009), q(1010).
-q(1010) :- p(1009), q(1009).
-p(1011) :- p(1010), q(1011).
-q(1011) :- p(1010), q(1010).
-p(1012) :- p(1011), q(1012).
-q(1012) :- p(1011), q(1011).
-p(1013) :- p(1012), q(1013).
-q(1013) :- p(1012), q(1012).
-p(1014) :- p(1013), q(1014).
-q(1014) :- p(1013), q(1013).
-p(1015) :- p(1014), q(1015).
-q(1015) :- p(1014), q(1014).
-p(1016) :- p(1015), q(1016).
-q(1016) :- p(1015), q(1015).
-p(1017) :- p(1016), q(1017).
-q(1017) :- p(1016), q(1016).
-p(1018) :- p(1017), q(1018).
-q(1018) :- p(1017), q(1017).
-p(1019) :- p(1018), q(1019).
-q(1019) :- p(1018), q(1018).
-p(1020) :- p(1019), q(1020).
-q(1020) :- p(1019), q(1019).
-p(1021) :- p(1020), q(1021).
-q(1021) :- p(1020), q(1020).
-p(1022) :- p(1021), q(1022).
-q(1022) :- p(1021), q(1021).
-p(1023) :- p(1022), q(1023).
-q(1023) :- p(1022), q(1022).
-p(1024) :- p(1023), q(1024).
-q(1024) :- p(1023), q(1023).
-p(1025) :- p(1024), q(1025).
-q(1025) :- p(1024), q(1024).
-p(1026) :- p(1025), q(1026).
-q(1026) :- p(1025), q(1025).
-p(1027) :- p(1026), q(1027).
-q(1027) :- p(1026), q(1026).
-p(1028) :- p(1027), q(1028).
-q(1028) :- p(1027), q(1027).
-p(1029) :- p(1028), q(1029).
-q(1029) :- p(1028), q(1028).
-p(1030) :- p(1029), q(1030).
-q(1030) :- p(1029), q(1029).
-p(1031) :- p(1030), q(1031).
-q(1031) :- p(1030), q(1030).
-p(1032) :- p(1031), q(1032).
-q(1032) :- p(1031), q(1031).
-p(1033) :- p(1032), q(1033).
-q(1033) :- p(1032), q(1032).
-p(1034) :- p(1033), q(1034).
-q(1034) :- p(1033), q(1033).
-p(1035) :- p(1034), q(1035).
-q(1035) :- p(1034), q(1034).
-p(1036) :- p(1035), q(1036).
-q(1036) :- p(1035), q(1035).
-p(1037) :- p(1036), q(1037).
-q(1037) :- p(1036), q(1036).
-p(1038) :- p(1037), q(1038).
-q(1038) :- p(1037), q(1037).
-p(1039) :- p(1038), q(1039).
-q(1039) :- p(1038), q(1038).
-p(1040) :- p(1039), q(1040).
-q(1040) :- p(1039), q(1039).
-p(1041) :- p(1040), q(1041).
-q(1041) :- p(1040), q(1040).
-p(1042) :- p(1041), q(1042).
-q(1042) :- p(1041), q(1041).
-p(1043) :- p(1042), q(1043).
-q(1043) :- p(1042), q(1042).
-p(1044) :- p(1043), q(1044).
-q(1044) :- p(1043), q(1043).
-p(1045) :- p(1044), q(1045).
-q(1045) :- p(1044), q(1044).
-p(1046) :- p(1045), q(1046).
-q(1046) :- p(1045), q(1045).
-p(1047) :- p(1046), q(1047).
-q(1047) :- p(1046), q(1046).
-p(1048) :- p(1047), q(1048).
-q(1048) :- p(1047), q(1047).
-p(1049) :- p(1048), q(1049).
-q(1049) :- p(1048), q(1048).
-p(1050) :- p(1049), q(1050).
-q(1050) :- p(1049), q(1049).
-p(1051) :- p(1050), q(1051).
-q(1051) :- p(1050), q(1050).
-p(1052) :- p(1051), q(1052).
-q(1052) :- p(1051), q(1051).
-p(1053) :- p(1052), q(1053).
-q(1053) :- p(1052), q(1052).
-p(1054) :- p(1053), q(1054).
-q(1054) :- p(1053), q(1053).
-p(1055) :- p(1054), q(1055).
-q(1055) :- p(1054), q(1054).
-p(1056) :- p(1055), q(1056).
-q(1056) :- p(1055), q(1055).
-p(1057) :- p(1056), q(1057).
-q(1057) :- p(1056), q(1056).
-p(1058) :- p(1057), q(1058).
-q(1058) :- p(1057), q(1057).
-p(1059) :- p(1058), q(1059).
-q(1059) :- p(1058), q(1058).
-p(1060) :- p(1059), q(1060).
-q(1060) :- p(1059), q(1059).
-p(1061) :- p(1060), q(1061).
-q(1061) :- p(1060), q(1060).
-p(1062) :- p(1061), q(1062).
-q(1062) :- p(1061), q(1061).
-p(1063) :- p(1062), q(1063).
-q(1063) :- p(1062), q(1062).
-p(1064) :- p(1063), q(1064).
-q(1064) :- p(1063), q(1063).
-p(1065) :- p(1064), q(1065).
-q(1065) :- p(1064), q(1064).
-p(1066) :- p(1065), q(1066).
-q(1066) :- p(1065), q(1065).
-p(1067) :- p(1066), q(1067).
-q(1067) :- p(1066), q(1066).
-p(1068) :- p(1067), q(1068).
-q(1068) :- p(1067), q(1067).
-p(1069) :- p(1068), q(1069).
-q(1069) :- p(1068), q(1068).
-p(1070) :- p(1069), q(1070).
-q(1070) :- p(1069), q(1069).
-p(1071) :- p(1070), q(1071).
-q(1071) :- p(1070), q(1070).
-p(1072) :- p(1071), q(1072).
-q(1072) :- p(1071), q(1071).
-p(1073) :- p(1072), q(1073).
-q(1073) :- p(1072), q(1072).
-p(1074) :- p(1073), q(1074).
-q(1074) :- p(1073), q(1073).
-p(1075) :- p(1074), q(1075).
-q(1075) :- p(1074), q(1074).
-p(1076) :- p(1075), q(1076).
-q(1076) :- p(1075), q(1075).
-p(1077) :- p(1076), q(1077).
-q(1077) :- p(1076), q(1076).
-p(1078) :- p(1077), q(1078).
-q(1078) :- p(1077), q(1077).
-p(1079) :- p(1078), q(1079).
-q(1079) :- p(1078), q(1078).
-p(1080) :- p(1079), q(1080).
-q(1080) :- p(1079), q(1079).
-p(1081) :- p(1080), q(1081).
-q(1081) :- p(1080), q(1080).
-p(1082) :- p(1081), q(1082).
-q(1082) :- p(1081), q(1081).
-p(1083) :- p(1082), q(1083).
-q(1083) :- p(1082), q(1082).
-p(1084) :- p(1083), q(1084).
-q(1084) :- p(1083), q(1083).
-p(1085) :- p(1084), q(1085).
-q(1085) :- p(1084), q(1084).
-p(1086) :- p(1085), q(1086).
-q(1086) :- p(1085), q(1085).
-p(1087) :- p(1086), q(1087).
-q(1087) :- p(1086), q(1086).
-p(1088) :- p(1087), q(1088).
-q(1088) :- p(1087), q(1087).
-p(1089) :- p(1088), q(1089).
-q(1089) :- p(1088), q(1088).
-p(1090) :- p(1089), q(1090).
-q(1090) :- p(1089), q(1089).
-p(1091) :- p(1090), q(1091).
-q(1091) :- p(1090), q(1090).
-p(1092) :- p(1091), q(1092).
-q(1092) :- p(1091), q(1091).
-p(1093) :- p(1092), q(1093).
-q(1093) :- p(1092), q(1092).
-p(1094) :- p(1093), q(1094).
-q(1094) :- p(1093), q(1093).
-p(1095) :- p(1094), q(1095).
-q(1095) :- p(1094), q(1094).
-p(1096) :- p(1095), q(1096).
-q(1096) :- p(1095), q(1095).
-p(1097) :- p(1096), q(1097).
-q(1097) :- p(1096), q(1096).
-p(1098) :- p(1097), q(1098).
-q(1098) :- p(1097), q(1097).
-p(1099) :- p(1098), q(1099).
-q(1099) :- p(1098), q(1098).
-p(1100) :- p(1099), q(1100).
-q(1100) :- p(1099), q(1099).
-p(1101) :- p(1100), q(1101).
-q(1101) :- p(1100), q(1100).
-p(1102) :- p(1101), q(1102).
-q(1102) :- p(1101), q(1101).
-p(1103) :- p(1102), q(1103).
-q(1103) :- p(1102), q(1102).
-p(1104) :- p(1103), q(1104).
-q(1104) :- p(1103), q(1103).
-p(1105) :- p(1104), q(1105).
-q(1105) :- p(1104), q(1104).
-p(1106) :- p(1105), q(1106).
-q(1106) :- p(1105), q(1105).
-p(1107) :- p(1106), q(1107).
-q(1107) :- p(1106), q(1106).
-p(1108) :- p(1107), q(1108).
-q(1108) :- p(1107), q(1107).
-p(1109) :- p(1108), q(1109).
-q(1109) :- p(1108), q(1108).
-p(1110) :- p(1109), q(1110).
-q(1110) :- p(1109), q(1109).
-p(1111) :- p(1110), q(1111).
-q(1111) :- p(1110), q(1110).
-p(1112) :- p(1111), q(1112).
-q(1112) :- p(1111), q(1111).
-p(1113) :- p(1112), q(1113).
-q(1113) :- p(1112), q(1112).
-p(1114) :- p(1113), q(1114).
-q(1114) :- p(1113), q(1113).
-p(1115) :- p(1114), q(1115).
-q(1115) :- p(1114), q(1114).
-p(1116) :- p(1115), q(1116).
-q(1116) :- p(1115), q(1115).
-p(1117) :- p(1116), q(1117).
-q(1117) :- p(1116), q(1116).
-p(1118) :- p(1117), q(1118).
-q(1118) :- p(1117), q(1117).
-p(1119) :- p(1118), q(1119).
-q(1119) :- p(1118), q(1118).
-p(1120) :- p(1119), q(1120).
-q(1120) :- p(1119), q(1119).
-p(1121) :- p(1120), q(1121).
-q(1121) :- p(1120), q(1120).
-p(1122) :- p(1121), q(1122).
-q(1122) :- p(1121), q(1121).
-p(1123) :- p(1122), q(1123).
-q(1123) :- p(1122), q(1122).
-p(1124) :- p(1123), q(1124).
-q(1124) :- p(1123), q(1123).
-p(1125) :- p(1124), q(1125).
-q(1125) :- p(1124), q(1124).
-p(1126) :- p(1125), q(1126).
-q(1126) :- p(1125), q(1125).
-p(1127) :- p(1126), q(1127).
-q(1127) :- p(1126), q(1126).
-p(1128) :- p(1127), q(1128).
-q(1128) :- p(1127), q(1127).
-p(1129) :- p(1128), q(1129).
-q(1129) :- p(1128), q(1128).
-p(1130) :- p(1129), q(1130).
-q(1130) :- p(1129), q(1129).
-p(1131) :- p(1130), q(1131).
-q(1131) :- p(1130), q(1130).
-p(1132) :- p(1131), q(1132).
-q(1132) :- p(1131), q(1131).
-p(1133) :- p(1132), q(1133).
-q(1133) :- p(1132), q(1132).
-p(1134) :- p(1133), q(1134).
-q(1134) :- p(1133), q(1133).
-p(1135) :- p(1134), q(1135).
-q(1135) :- p(1134), q(1134).
-p(1136) :- p(1135), q(1136).
-q(1136) :- p(1135), q(1135).
-p(1137) :- p(1136), q(1137).
-q(1137) :- p(1136), q(1136).
-p(1138) :- p(1137), q(1138).
-q(1138) :- p(1137), q(1137).
-p(1139) :- p(1138), q(1139).
-q(1139) :- p(1138), q(1138).
-p(1140) :- p(1139), q(1140).
-q(1140) :- p(1139), q(1139).
-p(1141) :- p(1140), q(1141).
-q(1141) :- p(1140), q(1140).
-p(1142) :- p(1141), q(1142).
-q(1142) :- p(1141), q(1141).
-p(1143) :- p(1142), q(1143).
-q(1143) :- p(1142), q(1142).
-p(1144) :- p(1143), q(1144).
-q(1144) :- p(1143), q(1143).
-p(1145) :- p(1144), q(1145).
-q(1145) :- p(1144), q(1144).
-p(1146) :- p(1145), q(1146).
-q(1146) :- p(1145), q(1145).
-p(1147) :- p(1146), q(1147).
-q(1147) :- p(1146), q(1146).
-p(1148) :- p(1147), q(1148).
-q(1148) :- p(1147), q(1147).
-p(1149) :- p(1148), q(1149).
-q(1149) :- p(1148), q(1148).
-p(1150) :- p(1149), q(1150).
-q(1150) :- p(1149), q(1149).
-p(1151) :- p(1150), q(1151).
-q(1151) :- p(1150), q(1150).
-p(1152) :- p(1151), q(1152).
-q(1152) :- p(1151), q(1151).
-p(1153) :- p(1152), q(1153).
-q(1153) :- p(1152), q(1152).
-p(1154) :- p(1153), q(1154).
-q(1154) :- p(1153), q(1153).
-p(1155) :- p(1154), q(1155).
-q(1155) :- p(1154), q(1154).
-p(1156) :- p(1155), q(1156).
-q(1156) :- p(1155), q(1155).
-p(1157) :- p(1156), q(1157).
-q(1157) :- p(1156), q(1156).
-p(1158) :- p(1157), q(1158).
-q(1158) :- p(1157), q(1157).
-p(1159) :- p(1158), q(1159).
-q(1159) :- p(1158), q(1158).
-p(1160) :- p(1159), q(1160).
-q(1160) :- p(1159), q(1159).
-p(1161) :- p(1160), q(1161).
-q(1161) :- p(1160), q(1160).
-p(1162) :- p(1161), q(1162).
-q(1162) :- p(1161), q(1161).
-p(1163) :- p(1162), q(1163).
-q(1163) :- p(1162), q(1162).
-p(1164) :- p(1163), q(1164).
-q(1164) :- p(1163), q(1163).
-p(1165) :- p(1164), q(1165).
-q(1165) :- p(1164), q(1164).
-p(1166) :- p(1165), q(1166).
-q(1166) :- p(1165), q(1165).
-p(1167) :- p(1166), q(1167).
-q(1167) :- p(1166), q(1166).
-p(1168) :- p(1167), q(1168).
-q(1168) :- p(1167), q(1167).
-p(1169) :- p(1168), q(1169).
-q(1169) :- p(1168), q(1168).
-p(1170) :- p(1169), q(1170).
-q(1170) :- p(1169), q(1169).
-p(1171) :- p(1170), q(1171).
-q(1171) :- p(1170), q(1170).
-p(1172) :- p(1171), q(1172).
-q(1172) :- p(1171), q(1171).
-p(1173) :- p(1172), q(1173).
-q(1173) :- p(1172), q(1172).
-p(1174) :- p(1173), q(1174).
-q(1174) :- p(1173), q(1173).
-p(1175) :- p(1174), q(1175).
-q(1175) :- p(1174), q(1174).
-p(1176) :- p(1175), q(1176).
-q(1176) :- p(1175), q(1175).
-p(1177) :- p(1176), q(1177).
-q(1177) :- p(1176), q(1176).
-p(1178) :- p(1177), q(1178).
-q(1178) :- p(1177), q(1177).
-p(1179) :- p(1178), q(1179).
-q(1179) :- p(1178), q(1178).
-p(1180) :- p(1179), q(1180).
-q(1180) :- p(1179), q(1179).
-p(1181) :- p(1180), q(1181).
-q(1181) :- p(1180), q(1180).
-p(1182) :- p(1181), q(1182).
-q(1182) :- p(1181), q(1181).
-p(1183) :- p(1182), q(1183).
-q(1183) :- p(1182), q(1182).
-p(1184) :- p(1183), q(1184).
-q(1184) :- p(1183), q(1183).
-p(1185) :- p(1184), q(1185).
-q(1185) :- p(1184), q(1184).
-p(1186) :- p(1185), q(1186).
-q(1186) :- p(1185), q(1185).
-p(1187) :- p(1186), q(1187).
-q(1187) :- p(1186), q(1186).
-p(1188) :- p(1187), q(1188).
-q(1188) :- p(1187), q(1187).
-p(1189) :- p(1188), q(1189).
-q(1189) :- p(1188), q(1188).
-p(1190) :- p(1189), q(1190).
-q(1190) :- p(1189), q(1189).
-p(1191) :- p(1190), q(1191).
-q(1191) :- p(1190), q(1190).
-p(1192) :- p(1191), q(1192).
-q(1192) :- p(1191), q(1191).
-p(1193) :- p(1192), q(1193).
-q(1193) :- p(1192), q(1192).
-p(1194) :- p(1193), q(1194).
-q(1194) :- p(1193), q(1193).
-p(1195) :- p(1194), q(1195).
-q(1195) :- p(1194), q(1194).
-p(1196) :- p(1195), q(1196).
-q(1196) :- p(1195), q(1195).
-p(1197) :- p(1196), q(1197).
-q(1197) :- p(1196), q(1196).
-p(1198) :- p(1197), q(1198).
-q(1198) :- p(1197), q(1197).
-p(1199) :- p(1198), q(1199).
-q(1199) :- p(1198), q(1198).
-p(1200) :- p(1199), q(1200).
-q(1200) :- p(1199), q(1199).
-p(1201) :- p(1200), q(1201).
-q(1201) :- p(1200), q(1200).
-p(1202) :- p(1201), q(1202).
-q(1202) :- p(1201), q(1201).
-p(1203) :- p(1202), q(1203).
-q(1203) :- p(1202), q(1202).
-p(1204) :- p(1203), q(1204).
-q(1204) :- p(1203), q(1203).
-p(1205) :- p(1204), q(1205).
-q(1205) :- p(1204), q(1204).
-p(1206) :- p(1205), q(1206).
-q(1206) :- p(1205), q(1205).
-p(1207) :- p(1206), q(1207).
-q(1207) :- p(1206), q(1206).
-p(1208) :- p(1207), q(1208).
-q(1208) :- p(1207), q(1207).
-p(1209) :- p(1208), q(1209).
-q(1209) :- p(1208), q(1208).
-p(1210) :- p(1209), q(1210).
-q(1210) :- p(1209), q(1209).
-p(1211) :- p(1210), q(1211).
-q(1211) :- p(1210), q(1210).
-p(1212) :- p(1211), q(1212).
-q(1212) :- p(1211), q(1211).
-p(1213) :- p(1212), q(1213).
-q(1213) :- p(1212), q(1212).
-p(1214) :- p(1213), q(1214).
-q(1214) :- p(1213), q(1213).
-p(1215) :- p(1214), q(1215).
-q(1215) :- p(1214), q(1214).
-p(1216) :- p(1215), q(1216).
-q(1216) :- p(1215), q(1215).
-p(1217) :- p(1216), q(1217).
-q(1217) :- p(1216), q(1216).
-p(1218) :- p(1217), q(1218).
-q(1218) :- p(1217), q(1217).
-p(1219) :- p(1218), q(1219).
-q(1219) :- p(1218), q(1218).
-p(1220) :- p(1219), q(1220).
-q(1220) :- p(1219), q(1219).
-p(1221) :- p(1220), q(1221).
-q(1221) :- p(1220), q(1220).
-p(1222) :- p(1221), q(1222).
-q(1222) :- p(1221), q(1221).
-p(1223) :- p(1222), q(1223).
-q(1223) :- p(1222), q(1222).
-p(1224) :- p(1223), q(1224).
-q(1224) :- p(1223), q(1223).
-p(1225) :- p(1224), q(1225).
-q(1225) :- p(1224), q(1224).
-p(1226) :- p(1225), q(1226).
-q(1226) :- p(1225), q(1225).
-p(1227) :- p(1226), q(1227).
-q(1227) :- p(1226), q(1226).
-p(1228) :- p(1227), q(1228).
-q(1228) :- p(1227), q(1227).
-p(1229) :- p(1228), q(1229).
-q(1229) :- p(1228), q(1228).
-p(1230) :- p(1229), q(1230).
-q(1230) :- p(1229), q(1229).
-p(1231) :- p(1230), q(1231).
-q(1231) :- p(1230), q(1230).
-p(1232) :- p(1231), q(1232).
-q(1232) :- p(1231), q(1231).
-p(1233) :- p(1232), q(1233).
-q(1233) :- p(1232), q(1232).
-p(1234) :- p(1233), q(1234).
-q(1234) :- p(1233), q(1233).
-p(1235) :- p(1234), q(1235).
-q(1235) :- p(1234), q(1234).
-p(1236) :- p(1235), q(1236).
-q(1236) :- p(1235), q(1235).
-p(1237) :- p(1236), q(1237).
-q(1237) :- p(1236), q(1236).
-p(1238) :- p(1237), q(1238).
-q(1238) :- p(1237), q(1237).
-p(1239) :- p(1238), q(1239).
-q(1239) :- p(1238), q(1238).
-p(1240) :- p(1239), q(1240).
-q(1240) :- p(1239), q(1239).
-p(1241) :- p(1240), q(1241).
-q(1241) :- p(1240), q(1240).
-p(1242) :- p(1241), q(1242).
-q(1242) :- p(1241), q(1241).
-p(1243) :- p(1242), q(1243).
-q(1243) :- p(1242), q(1242).
-p(1244) :- p(1243), q(1244).
-q(1244) :- p(1243), q(1243).
-p(1245) :- p(1244), q(1245).
-q(1245) :- p(1244), q(1244).
-p(1246) :- p(1245), q(1246).
-q(1246) :- p(1245), q(1245).
-p(1247) :- p(1246), q(1247).
-q(1247) :- p(1246), q(1246).
-p(1248) :- p(1247), q(1248).
-q(1248) :- p(1247), q(1247).
-p(1249) :- p(1248), q(1249).
-q(1249) :- p(1248), q(1248).
-p(1250) :- p(1249), q(1250).
-q(1250) :- p(1249), q(1249).
-p(1251) :- p(1250), q(1251).
-q(1251) :- p(1250), q(1250).
-p(1252) :- p(1251), q(1252).
-q(1252) :- p(1251), q(1251).
-p(1253) :- p(1252), q(1253).
-q(1253) :- p(1252), q(1252).
-p(1254) :- p(1253), q(1254).
-q(1254) :- p(1253), q(1253).
-p(1255) :- p(1254), q(1255).
-q(1255) :- p(1254), q(1254).
-p(1256) :- p(1255), q(1256).
-q(1256) :- p(1255), q(1255).
-p(1257) :- p(1256), q(1257).
-q(1257) :- p(1256), q(1256).
-p(1258) :- p(1257), q(1258).
-q(1258) :- p(1257), q(1257).
-p(1259) :- p(1258), q(1259).
-q(1259) :- p(1258), q(1258).
-p(1260) :- p(1259), q(1260).
-q(1260) :- p(1259), q(1259).
-p(1261) :- p(1260), q(1261).
-q(1261) :- p(1260), q(1260).
-p(1262) :- p(1261), q(1262).
-q(1262) :- p(1261), q(1261).
-p(1263) :- p(1262), q(1263).
-q(1263) :- p(1262), q(1262).
-p(1264) :- p(1263), q(1264).
-q(1264) :- p(1263), q(1263).
-p(1265) :- p(1264), q(1265).
-q(1265) :- p(1264), q(1264).
-p(1266) :- p(1265), q(1266).
-q(1266) :- p(1265), q(1265).
-p(1267) :- p(1266), q(1267).
-q(1267) :- p(1266), q(1266).
-p(1268) :- p(1267), q(1268).
-q(1268) :- p(1267), q(1267).
-p(1269) :- p(1268), q(1269).
-q(1269) :- p(1268), q(1268).
-p(1270) :- p(1269), q(1270).
-q(1270) :- p(1269), q(1269).
-p(1271) :- p(1270), q(1271).
-q(1271) :- p(1270), q(1270).
-p(1272) :- p(1271), q(1272).
-q(1272) :- p(1271), q(1271).
-p(1273) :- p(1272), q(1273).
-q(1273) :- p(1272), q(1272).
-p(1274) :- p(1273), q(1274).
-q(1274) :- p(1273), q(1273).
-p(1275) :- p(1274), q(1275).
-q(1275) :- p(1274), q(1274).
-p(1276) :- p(1275), q(1276).
-q(1276) :- p(1275), q(1275).
-p(1277) :- p(1276), q(1277).
-q(1277) :- p(1276), q(1276).
-p(1278) :- p(1277), q(1278).
-q(1278) :- p(1277), q(1277).
-p(1279) :- p(1278), q(1279).
-q(1279) :- p(1278), q(1278).
-p(1280) :- p(1279), q(1280).
-q(1280) :- p(1279), q(1279).
-p(1281) :- p(1280), q(1281).
-q(1281) :- p(1280), q(1280).
-p(1282) :- p(1281), q(1282).
-q(1282) :- p(1281), q(1281).
-p(1283) :- p(1282), q(1283).
-q(1283) :- p(1282), q(1282).
-p(1284) :- p(1283), q(1284).
-q(1284) :- p(1283), q(1283).
-p(1285) :- p(1284), q(1285).
-q(1285) :- p(1284), q(1284).
-p(1286) :- p(1285), q(1286).
-q(1286) :- p(1285), q(1285).
-p(1287) :- p(1286), q(1287).
-q(1287) :- p(1286), q(1286).
-p(1288) :- p(1287), q(1288).
-q(1288) :- p(1287), q(1287).
-p(1289) :- p(1288), q(1289).
-q(1289) :- p(1288), q(1288).
-p(1290) :- p(1289), q(1290).
-q(1290) :- p(1289), q(1289).
-p(1291) :- p(1290), q(1291).
-q(1291) :- p(1290), q(1290).
-p(1292) :- p(1291), q(1292).
-q(1292) :- p(1291), q(1291).
-p(1293) :- p(1292), q(1293).
-q(1293) :- p(1292), q(1292).
-p(1294) :- p(1293), q(1294).
-q(1294) :- p(1293), q(1293).
-p(1295) :- p(1294), q(1295).
-q(1295) :- p(1294), q(1294).
-p(1296) :- p(1295), q(1296).
-q(1296) :- p(1295), q(1295).
-p(1297) :- p(1296), q(1297).
-q(1297) :- p(1296), q(1296).
-p(1298) :- p(1297), q(1298).
-q(1298) :- p(1297), q(1297).
-p(1299) :- p(1298), q(1299).
-q(1299) :- p(1298), q(1298).
-p(1300) :- p(1299), q(1300).
-q(1300) :- p(1299), q(1299).
-p(1301) :- p(1300), q(1301).
-q(1301) :- p(1300), q(1300).
-p(1302) :- p(1301), q(1302).
-q(1302) :- p(1301), q(1301).
-p(1303) :- p(1302), q(1303).
-q(1303) :- p(1302), q(1302).
-p(1304) :- p(1303), q(1304).
-q(1304) :- p(1303), q(1303).
-p(1305) :- p(1304), q(1305).
-q(1305) :- p(1304), q(1304).
-p(1306) :- p(1305), q(1306).
-q(1306) :- p(1305), q(1305).
-p(1307) :- p(1306), q(1307).
-q(1307) :- p(1306), q(1306).
-p(1308) :- p(1307), q(1308).
-q(1308) :- p(1307), q(1307).
-p(1309) :- p(1308), q(1309).
-q(1309) :- p(1308), q(1308).
-p(1310) :- p(1309), q(1310).
-q(1310) :- p(1309), q(1309).
-p(1311) :- p(1310), q(1311).
-q(1311) :- p(1310), q(1310).
-p(1312) :- p(1311), q(1312).
-q(1312) :- p(1311), q(1311).
-p(1313) :- p(1312), q(1313).
-q(1313) :- p(1312), q(1312).
-p(1314) :- p(1313), q(1314).
-q(1314) :- p(1313), q(1313).
-p(1315) :- p(1314), q(1315).
-q(1315) :- p(1314), q(1314).
-p(1316) :- p(1315), q(1316).
-q(1316) :- p(1315), q(1315).
-p(1317) :- p(1316), q(1317).
-q(1317) :- p(1316), q(1316).
-p(1318) :- p(1317), q(1318).
-q(1318) :- p(1317), q(1317).
-p(1319) :- p(1318), q(1319).
-q(1319) :- p(1318), q(1318).
-p(1320) :- p(1319), q(1320).
-q(1320) :- p(1319), q(1319).
-p(1321) :- p(1320), q(1321).
-q(1321) :- p(1320), q(1320).
-p(1322) :- p(1321), q(1322).
-q(1322) :- p(1321), q(1321).
-p(1323) :- p(1322), q(1323).
-q(1323) :- p(1322), q(1322).
-p(1324) :- p(1323), q(1324).
-q(1324) :- p(1323), q(1323).
-p(1325) :- p(1324), q(1325).
-q(1325) :- p(1324), q(1324).
-p(1326) :- p(1325), q(1326).
-q(1326) :- p(1325), q(1325).
-p(1327) :- p(1326), q(1327).
-q(1327) :- p(1326), q(1326).
-p(1328) :- p(1327), q(1328).
-q(1328) :- p(1327), q(1327).
-p(1329) :- p(1328), q(1329).
-q(1329) :- p(1328), q(1328).
-p(1330) :- p(1329), q(1330).
-q(1330) :- p(1329), q(1329).
-p(1331) :- p(1330), q(1331).
-q(1331) :- p(1330), q(1330).
-p(1332) :- p(1331), q(1332).
-q(1332) :- p(1331), q(1331).
-p(1333) :- p(1332), q(1333).
-q(1333) :- p(1332), q(1332).
-p(1334) :- p(1333), q(1334).
-q(1334) :- p(1333), q(1333).
-p(1335) :- p(1334), q(1335).
-q(1335) :- p(1334), q(1334).
-p(1336) :- p(1335), q(1336).
-q(1336) :- p(1335), q(1335).
-p(1337) :- p(1336), q(1337).
-q(1337) :- p(1336), q(1336).
-p(1338) :- p(1337), q(1338).
-q(1338) :- p(1337), q(1337).
-p(1339) :- p(1338), q(1339).
-q(1339) :- p(1338), q(1338).
-p(1340) :- p(1339), q(1340).
-q(1340) :- p(1339), q(1339).
-p(1341) :- p(1340), q(1341).
-q(1341) :- p(1340), q(1340).
-p(1342) :- p(1341), q(1342).
-q(1342) :- p(1341), q(1341).
-p(1343) :- p(1342), q(1343).
-q(1343) :- p(1342), q(1342).
-p(1344) :- p(1343), q(1344).
-q(1344) :- p(1343), q(1343).
-p(1345) :- p(1344), q(1345).
-q(1345) :- p(1344), q(1344).
-p(1346) :- p(1345), q(1346).
-q(1346) :- p(1345), q(1345).
-p(1347) :- p(1346), q(1347).
-q(1347) :- p(1346), q(1346).
-p(1348) :- p(1347), q(1348).
-q(1348) :- p(1347), q(1347).
-p(1349) :- p(1348), q(1349).
-q(1349) :- p(1348), q(1348).
-p(1350) :- p(1349), q(1350).
-q(1350) :- p(1349), q(1349).
-p(1351) :- p(1350), q(1351).
-q(1351) :- p(1350), q(1350).
-p(1352) :- p(1351), q(1352).
-q(1352) :- p(1351), q(1351).
-p(1353) :- p(1352), q(1353).
-q(1353) :- p(1352), q(1352).
-p(1354) :- p(1353), q(1354).
-q(1354) :- p(1353), q(1353).
-p(1355) :- p(1354), q(1355).
-q(1355) :- p(1354), q(1354).
-p(1356) :- p(1355), q(1356).
-q(1356) :- p(1355), q(1355).
-p(1357) :- p(1356), q(1357).
-q(1357) :- p(1356), q(1356).
-p(1358) :- p(1357), q(1358).
-q(1358) :- p(1357), q(1357).
-p(1359) :- p(1358), q(1359).
-q(1359) :- p(1358), q(1358).
-p(1360) :- p(1359), q(1360).
-q(1360) :- p(1359), q(1359).
-p(1361) :- p(1360), q(1361).
-q(1361) :- p(1360), q(1360).
-p(1362) :- p(1361), q(1362).
-q(1362) :- p(1361), q(1361).
-p(1363) :- p(1362), q(1363).
-q(1363) :- p(1362), q(1362).
-p(1364) :- p(1363), q(1364).
-q(1364) :- p(1363), q(1363).
-p(1365) :- p(1364), q(1365).
-q(1365) :- p(1364), q(1364).
-p(1366) :- p(1365), q(1366).
-q(1366) :- p(1365), q(1365).
-p(1367) :- p(1366), q(1367).
-q(1367) :- p(1366), q(1366).
-p(1368) :- p(1367), q(1368).
-q(1368) :- p(1367), q(1367).
-p(1369) :- p(1368), q(1369).
-q(1369) :- p(1368), q(1368).
-p(1370) :- p(1369), q(1370).
-q(1370) :- p(1369), q(1369).
-p(1371) :- p(1370), q(1371).
-q(1371) :- p(1370), q(1370).
-p(1372) :- p(1371), q(1372).
-q(1372) :- p(1371), q(1371).
-p(1373) :- p(1372), q(1373).
-q(1373) :- p(1372), q(1372).
-p(1374) :- p(1373), q(1374).
-q(1374) :- p(1373), q(1373).
-p(1375) :- p(1374), q(1375).
-q(1375) :- p(1374), q(1374).
-p(1376) :- p(1375), q(1376).
-q(1376) :- p(1375), q(1375).
-p(1377) :- p(1376), q(1377).
-q(1377) :- p(1376), q(1376).
-p(1378) :- p(1377), q(1378).
-q(1378) :- p(1377), q(1377).
-p(1379) :- p(1378), q(1379).
-q(1379) :- p(1378), q(1378).
-p(1380) :- p(1379), q(1380).
-q(1380) :- p(1379), q(1379).
-p(1381) :- p(1380), q(1381).
-q(1381) :- p(1380), q(1380).
-p(1382) :- p(1381), q(1382).
-q(1382) :- p(1381), q(1381).
-p(1383) :- p(1382), q(1383).
-q(1383) :- p(1382), q(1382).
-p(1384) :- p(1383), q(1384).
-q(1384) :- p(1383), q(1383).
-p(1385) :- p(1384), q(1385).
-q(1385) :- p(1384), q(1384).
-p(1386) :- p(1385), q(1386).
-q(1386) :- p(1385), q(1385).
-p(1387) :- p(1386), q(1387).
-q(1387) :- p(1386), q(1386).
-p(1388) :- p(1387), q(1388).
-q(1388) :- p(1387), q(1387).
-p(1389) :- p(1388), q(1389).
-q(1389) :- p(1388), q(1388).
-p(1390) :- p(1389), q(1390).
-q(1390) :- p(1389), q(1389).
-p(1391) :- p(1390), q(1391).
-q(1391) :- p(1390), q(1390).
-p(1392) :- p(1391), q(1392).
-q(1392) :- p(1391), q(1391).
-p(1393) :- p(1392), q(1393).
-q(1393) :- p(1392), q(1392).
-p(1394) :- p(1393), q(1394).
-q(1394) :- p(1393), q(1393).
-p(1395) :- p(1394), q(1395).
-q(1395) :- p(1394), q(1394).
-p(1396) :- p(1395), q(1396).
-q(1396) :- p(1395), q(1395).
-p(1397) :- p(1396), q(1397).
-q(1397) :- p(1396), q(1396).
-p(1398) :- p(1397), q(1398).
-q(1398) :- p(1397), q(1397).
-p(1399) :- p(1398), q(1399).
-q(1399) :- p(1398), q(1398).
-p(1400) :- p(1399), q(1400).
-q(1400) :- p(1399), q(1399).
-p(1401) :- p(1400), q(1401).
-q(1401) :- p(1400), q(1400).
-p(1402) :- p(1401), q(1402).
-q(1402) :- p(1401), q(1401).
-p(1403) :- p(1402), q(1403).
-q(1403) :- p(1402), q(1402).
-p(1404) :- p(1403), q(1404).
-q(1404) :- p(1403), q(1403).
-p(1405) :- p(1404), q(1405).
-q(1405) :- p(1404), q(1404).
-p(1406) :- p(1405), q(1406).
-q(1406) :- p(1405), q(1405).
-p(1407) :- p(1406), q(1407).
-q(1407) :- p(1406), q(1406).
-p(1408) :- p(1407), q(1408).
-q(1408) :- p(1407), q(1407).
-p(1409) :- p(1408), q(1409).
-q(1409) :- p(1408), q(1408).
-p(1410) :- p(1409), q(1410).
-q(1410) :- p(1409), q(1409).
-p(1411) :- p(1410), q(1411).
-q(1411) :- p(1410), q(1410).
-p(1412) :- p(1411), q(1412).
-q(1412) :- p(1411), q(1411).
-p(1413) :- p(1412), q(1413).
-q(1413) :- p(1412), q(1412).
-p(1414) :- p(1413), q(1414).
-q(1414) :- p(1413), q(1413).
-p(1415) :- p(1414), q(1415).
-q(1415) :- p(1414), q(1414).
-p(1416) :- p(1415), q(1416).
-q(1416) :- p(1415), q(1415).
-p(1417) :- p(1416), q(1417).
-q(1417) :- p(1416), q(1416).
-p(1418) :- p(1417), q(1418).
-q(1418) :- p(1417), q(1417).
-p(1419) :- p(1418), q(1419).
-q(1419) :- p(1418), q(1418).
-p(1420) :- p(1419), q(1420).
-q(1420) :- p(1419), q(1419).
-p(1421) :- p(1420), q(1421).
-q(1421) :- p(1420), q(1420).
-p(1422) :- p(1421), q(1422).
-q(1422) :- p(1421), q(1421).
-p(1423) :- p(1422), q(1423).
-q(1423) :- p(1422), q(1422).
-p(1424) :- p(1423), q(1424).
-q(1424) :- p(1423), q(1423).
-p(1425) :- p(1424), q(1425).
-q(1425) :- p(1424), q(1424).
-p(1426) :- p(1425), q(1426).
-q(1426) :- p(1425), q(1425).
-p(1427) :- p(1426), q(1427).
-q(1427) :- p(1426), q(1426).
-p(1428) :- p(1427), q(1428).
-q(1428) :- p(1427), q(1427).
-p(1429) :- p(1428), q(1429).
-q(1429) :- p(1428), q(1428).
-p(1430) :- p(1429), q(1430).
-q(1430) :- p(1429), q(1429).
-p(1431) :- p(1430), q(1431).
-q(1431) :- p(1430), q(1430).
-p(1432) :- p(1431), q(1432).
-q(1432) :- p(1431), q(1431).
-p(1433) :- p(1432), q(1433).
-q(1433) :- p(1432), q(1432).
-p(1434) :- p(1433), q(1434).
-q(1434) :- p(1433), q(1433).
-p(1435) :- p(1434), q(1435).
-q(1435) :- p(1434), q(1434).
-p(1436) :- p(1435), q(1436).
-q(1436) :- p(1435), q(1435).
-p(1437) :- p(1436), q(1437).
-q(1437) :- p(1436), q(1436).
-p(1438) :- p(1437), q(1438).
-q(1438) :- p(1437), q(1437).
-p(1439) :- p(1438), q(1439).
-q(1439) :- p(1438), q(1438).
-p(1440) :- p(1439), q(1440).
-q(1440) :- p(1439), q(1439).
-p(1441) :- p(1440), q(1441).
-q(1441) :- p(1440), q(1440).
-p(1442) :- p(1441), q(1442).
-q(1442) :- p(1441), q(1441).
-p(1443) :- p(1442), q(1443).
-q(1443) :- p(1442), q(1442).
-p(1444) :- p(1443), q(1444).
-q(1444) :- p(1443), q(1443).
-p(1445) :- p(1444), q(1445).
-q(1445) :- p(1444), q(1444).
-p(1446) :- p(1445), q(1446).
-q(1446) :- p(1445), q(1445).
-p(1447) :- p(1446), q(1447).
-q(1447) :- p(1446), q(1446).
-p(1448) :- p(1447), q(1448).
-q(1448) :- p(1447), q(1447).
-p(1449) :- p(1448), q(1449).
-q(1449) :- p(1448), q(1448).
-p(1450) :- p(1449), q(1450).
-q(1450) :- p(1449), q(1449).
-p(1451) :- p(1450), q(1451).
-q(1451) :- p(1450), q(1450).
-p(1452) :- p(1451), q(1452).
-q(1452) :- p(1451), q(1451).
-p(1453) :- p(1452), q(1453).
-q(1453) :- p(1452), q(1452).
-p(1454) :- p(1453), q(1454).
-q(1454) :- p(1453), q(1453).
-p(1455) :- p(1454), q(1455).
-q(1455) :- p(1454), q(1454).
-p(1456) :- p(1455), q(1456).
-q(1456) :- p(1455), q(1455).
-p(1457) :- p(1456), q(1457).
-q(1457) :- p(1456), q(1456).
-p(1458) :- p(1457), q(1458).
-q(1458) :- p(1457), q(1457).
-p(1459) :- p(1458), q(1459).
-q(1459) :- p(1458), q(1458).
-p(1460) :- p(1459), q(1460).
-q(1460) :- p(1459), q(1459).
-p(1461) :- p(1460), q(1461).
-q(1461) :- p(1460), q(1460).
-p(1462) :- p(1461), q(1462).
-q(1462) :- p(1461), q(1461).
-p(1463) :- p(1462), q(1463).
-q(1463) :- p(1462), q(1462).
-p(1464) :- p(1463), q(1464).
-q(1464) :- p(1463), q(1463).
-p(1465) :- p(1464), q(1465).
-q(1465) :- p(1464), q(1464).
-p(1466) :- p(1465), q(1466).
-q(1466) :- p(1465), q(1465).
-p(1467) :- p(1466), q(1467).
-q(1467) :- p(1466), q(1466).
-p(1468) :- p(1467), q(1468).
-q(1468) :- p(1467), q(1467).
-p(1469) :- p(1468), q(1469).
-q(1469) :- p(1468), q(1468).
-p(1470) :- p(1469), q(1470).
-q(1470) :- p(1469), q(1469).
-p(1471) :- p(1470), q(1471).
-q(1471) :- p(1470), q(1470).
-p(1472) :- p(1471), q(1472).
-q(1472) :- p(1471), q(1471).
-p(1473) :- p(1472), q(1473).
-q(1473) :- p(1472), q(1472).
-p(1474) :- p(1473), q(1474).
-q(1474) :- p(1473), q(1473).
-p(1475) :- p(1474), q(1475).
-q(1475) :- p(1474), q(1474).
-p(1476) :- p(1475), q(1476).
-q(1476) :- p(1475), q(1475).
-p(1477) :- p(1476), q(1477).
-q(1477) :- p(1476), q(1476).
-p(1478) :- p(1477), q(1478).
-q(1478) :- p(1477), q(1477).
-p(1479) :- p(1478), q(1479).
-q(1479) :- p(1478), q(1478).
-p(1480) :- p(1479), q(1480).
-q(1480) :- p(1479), q(1479).
-p(1481) :- p(1480), q(1481).
-q(1481) :- p(1480), q(1480).
-p(1482) :- p(1481), q(1482).
-q(1482) :- p(1481), q(1481).
-p(1483) :- p(1482), q(1483).
-q(1483) :- p(1482), q(1482).
-p(1484) :- p(1483), q(1484).
-q(1484) :- p(1483), q(1483).
-p(1485) :- p(1484), q(1485).
-q(1485) :- p(1484), q(1484).
-p(1486) :- p(1485), q(1486).
-q(1486) :- p(1485), q(1485).
-p(1487) :- p(1486), q(1487).
-q(1487) :- p(1486), q(1486).
-p(1488) :- p(1487), q(1488).
-q(1488) :- p(1487), q(1487).
-p(1489) :- p(1488), q(1489).
-q(1489) :- p(1488), q(1488).
-p(1490) :- p(1489), q(1490).
-q(1490) :- p(1489), q(1489).
-p(1491) :- p(1490), q(1491).
-q(1491) :- p(1490), q(1490).
-p(1492) :- p(1491), q(1492).
-q(1492) :- p(1491), q(1491).
-p(1493) :- p(1492), q(1493).
-q(1493) :- p(1492), q(1492).
-p(1494) :- p(1493), q(1494).
-q(1494) :- p(1493), q(1493).
-p(1495) :- p(1494), q(1495).
-q(1495) :- p(1494), q(1494).
-p(1496) :- p(1495), q(1496).
-q(1496) :- p(1495), q(1495).
-p(1497) :- p(1496), q(1497).
-q(1497) :- p(1496), q(1496).
-p(1498) :- p(1497), q(1498).
-q(1498) :- p(1497), q(1497).
-p(1499) :- p(1498), q(1499).
-q(1499) :- p(1498), q(1498).
-p(1500) :- p(1499), q(1500).
-q(1500) :- p(1499), q(1499).
-p(1501) :- p(1500), q(1501).
-q(1501) :- p(1500), q(1500).
-p(1502) :- p(1501), q(1502).
-q(1502) :- p(1501), q(1501).
-p(1503) :- p(1502), q(1503).
-q(1503) :- p(1502), q(1502).
-p(1504) :- p(1503), q(1504).
-q(1504) :- p(1503), q(1503).
-p(1505) :- p(1504), q(1505).
-q(1505) :- p(1504), q(1504).
-p(1506) :- p(1505), q(1506).
-q(1506) :- p(1505), q(1505).
-p(1507) :- p(1506), q(1507).
-q(1507) :- p(1506), q(1506).
-p(1508) :- p(1507), q(1508).
-q(1508) :- p(1507), q(1507).
-p(1509) :- p(1508), q(1509).
-q(1509) :- p(1508), q(1508).
-p(1510) :- p(1509), q(1510).
-q(1510) :- p(1509), q(1509).
-p(1511) :- p(1510), q(1511).
-q(1511) :- p(1510), q(1510).
-p(1512) :- p(1511), q(1512).
-q(1512) :- p(1511), q(1511).
-p(1513) :- p(1512), q(1513).
-q(1513) :- p(1512), q(1512).
-p(1514) :- p(1513), q(1514).
-q(1514) :- p(1513), q(1513).
-p(1515) :- p(1514), q(1515).
-q(1515) :- p(1514), q(1514).
-p(1516) :- p(1515), q(1516).
-q(1516) :- p(1515), q(1515).
-p(1517) :- p(1516), q(1517).
-q(1517) :- p(1516), q(1516).
-p(1518) :- p(1517), q(1518).
-q(1518) :- p(1517), q(1517).
-p(1519) :- p(1518), q(1519).
-q(1519) :- p(1518), q(1518).
-p(1520) :- p(1519), q(1520).
-q(1520) :- p(1519), q(1519).
-p(1521) :- p(1520), q(1521).
-q(1521) :- p(1520), q(1520).
-p(1522) :- p(1521), q(1522).
-q(1522) :- p(1521), q(1521).
-p(1523) :- p(1522), q(1523).
-q(1523) :- p(1522), q(1522).
-p(1524) :- p(1523), q(1524).
-q(1524) :- p(1523), q(1523).
-p(1525) :- p(1524), q(1525).
-q(1525) :- p(1524), q(1524).
-p(1526) :- p(1525), q(1526).
-q(1526) :- p(1525), q(1525).
-p(1527) :- p(1526), q(1527).
-q(1527) :- p(1526), q(1526).
-p(1528) :- p(1527), q(1528).
-q(1528) :- p(1527), q(1527).
-p(1529) :- p(1528), q(1529).
-q(1529) :- p(1528), q(1528).
-p(1530) :- p(1529), q(1530).
-q(1530) :- p(1529), q(1529).
-p(1531) :- p(1530), q(1531).
-q(1531) :- p(1530), q(1530).
-p(1532) :- p(1531), q(1532).
-q(1532) :- p(1531), q(1531).
-p(1533) :- p(1532), q(1533).
-q(1533) :- p(1532), q(1532).
-p(1534) :- p(1533), q(1534).
-q(1534) :- p(1533), q(1533).
-p(1535) :- p(1534), q(1535).
-q(1535) :- p(1534), q(1534).
-p(1536) :- p(1535), q(1536).
-q(1536) :- p(1535), q(1535).
-p(1537) :- p(1536), q(1537).
-q(1537) :- p(1536), q(1536).
-p(1538) :- p(1537), q(1538).
-q(1538) :- p(1537), q(1537).
-p(1539) :- p(1538), q(1539).
-q(1539) :- p(1538), q(1538).
-p(1540) :- p(1539), q(1540).
-q(1540) :- p(1539), q(1539).
-p(1541) :- p(1540), q(1541).
-q(1541) :- p(1540), q(1540).
-p(1542) :- p(1541), q(1542).
-q(1542) :- p(1541), q(1541).
-p(1543) :- p(1542), q(1543).
-q(1543) :- p(1542), q(1542).
-p(1544) :- p(1543), q(1544).
-q(1544) :- p(1543), q(1543).
-p(1545) :- p(1544), q(1545).
-q(1545) :- p(1544), q(1544).
-p(1546) :- p(1545), q(1546).
-q(1546) :- p(1545), q(1545).
-p(1547) :- p(1546), q(1547).
-q(1547) :- p(1546), q(1546).
-p(1548) :- p(1547), q(1548).
-q(1548) :- p(1547), q(1547).
-p(1549) :- p(1548), q(1549).
-q(1549) :- p(1548), q(1548).
-p(1550) :- p(1549), q(1550).
-q(1550) :- p(1549), q(1549).
-p(1551) :- p(1550), q(1551).
-q(1551) :- p(1550), q(1550).
-p(1552) :- p(1551), q(1552).
-q(1552) :- p(1551), q(1551).
-p(1553) :- p(1552), q(1553).
-q(1553) :- p(1552), q(1552).
-p(1554) :- p(1553), q(1554).
-q(1554) :- p(1553), q(1553).
-p(1555) :- p(1554), q(1555).
-q(1555) :- p(1554), q(1554).
-p(1556) :- p(1555), q(1556).
-q(1556) :- p(1555), q(1555).
-p(1557) :- p(1556), q(1557).
-q(1557) :- p(1556), q(1556).
-p(1558) :- p(1557), q(1558).
-q(1558) :- p(1557), q(1557).
-p(1559) :- p(1558), q(1559).
-q(1559) :- p(1558), q(1558).
-p(1560) :- p(1559), q(1560).
-q(1560) :- p(1559), q(1559).
-p(1561) :- p(1560), q(1561).
-q(1561) :- p(1560), q(1560).
-p(1562) :- p(1561), q(1562).
-q(1562) :- p(1561), q(1561).
-p(1563) :- p(1562), q(1563).
-q(1563) :- p(1562), q(1562).
-p(1564) :- p(1563), q(1564).
-q(1564) :- p(1563), q(1563).
-p(1565) :- p(1564), q(1565).
-q(1565) :- p(1564), q(1564).
-p(1566) :- p(1565), q(1566).
-q(1566) :- p(1565), q(1565).
-p(1567) :- p(1566), q(1567).
-q(1567) :- p(1566), q(1566).
-p(1568) :- p(1567), q(1568).
-q(1568) :- p(1567), q(1567).
-p(1569) :- p(1568), q(1569).
-q(1569) :- p(1568), q(1568).
-p(1570) :- p(1569), q(1570).
-q(1570) :- p(1569), q(1569).
-p(1571) :- p(1570), q(1571).
-q(1571) :- p(1570), q(1570).
-p(1572) :- p(1571), q(1572).
-q(1572) :- p(1571), q(1571).
-p(1573) :- p(1572), q(1573).
-q(1573) :- p(1572), q(1572).
-p(1574) :- p(1573), q(1574).
-q(1574) :- p(1573), q(1573).
-p(1575) :- p(1574), q(1575).
-q(1575) :- p(1574), q(1574).
-p(1576) :- p(1575), q(1576).
-q(1576) :- p(1575), q(1575).
-p(1577) :- p(1576), q(1577).
-q(1577) :- p(1576), q(1576).
-p(1578) :- p(1577), q(1578).
-q(1578) :- p(1577), q(1577).
-p(1579) :- p(1578), q(1579).
-q(1579) :- p(1578), q(1578).
-p(1580) :- p(1579), q(1580).
-q(1580) :- p(1579), q(1579).
-p(1581) :- p(1580), q(1581).
-q(1581) :- p(1580), q(1580).
-p(1582) :- p(1581), q(1582).
-q(1582) :- p(1581), q(1581).
-p(1583) :- p(1582), q(1583).
-q(1583) :- p(1582), q(1582).
-p(1584) :- p(1583), q(1584).
-q(1584) :- p(1583), q(1583).
-p(1585) :- p(1584), q(1585).
-q(1585) :- p(1584), q(1584).
-p(1586) :- p(1585), q(1586).
-q(1586) :- p(1585), q(1585).
-p(1587) :- p(1586), q(1587).
-q(1587) :- p(1586), q(1586).
-p(1588) :- p(1587), q(1588).
-q(1588) :- p(1587), q(1587).
-p(1589) :- p(1588), q(1589).
-q(1589) :- p(1588), q(1588).
-p(1590) :- p(1589), q(1590).
-q(1590) :- p(1589), q(1589).
-p(1591) :- p(1590), q(1591).
-q(1591) :- p(1590), q(1590).
-p(1592) :- p(1591), q(1592).
-q(1592) :- p(1591), q(1591).
-p(1593) :- p(1592), q(1593).
-q(1593) :- p(1592), q(1592).
-p(1594) :- p(1593), q(1594).
-q(1594) :- p(1593), q(1593).
-p(1595) :- p(1594), q(1595).
-q(1595) :- p(1594), q(1594).
-p(1596) :- p(1595), q(1596).
-q(1596) :- p(1595), q(1595).
-p(1597) :- p(1596), q(1597).
-q(1597) :- p(1596), q(1596).
-p(1598) :- p(1597), q(1598).
-q(1598) :- p(1597), q(1597).
-p(1599) :- p(1598), q(1599).
-q(1599) :- p(1598), q(1598).
-p(1600) :- p(1599), q(1600).
-q(1600) :- p(1599), q(1599).
-p(1601) :- p(1600), q(1601).
-q(1601) :- p(1600), q(1600).
-p(1602) :- p(1601), q(1602).
-q(1602) :- p(1601), q(1601).
-p(1603) :- p(1602), q(1603).
-q(1603) :- p(1602), q(1602).
-p(1604) :- p(1603), q(1604).
-q(1604) :- p(1603), q(1603).
-p(1605) :- p(1604), q(1605).
-q(1605) :- p(1604), q(1604).
-p(1606) :- p(1605), q(1606).
-q(1606) :- p(1605), q(1605).
-p(1607) :- p(1606), q(1607).
-q(1607) :- p(1606), q(1606).
-p(1608) :- p(1607), q(1608).
-q(1608) :- p(1607), q(1607).
-p(1609) :- p(1608), q(1609).
-q(1609) :- p(1608), q(1608).
-p(1610) :- p(1609), q(1610).
-q(1610) :- p(1609), q(1609).
-p(1611) :- p(1610), q(1611).
-q(1611) :- p(1610), q(1610).
-p(1612) :- p(1611), q(1612).
-q(1612) :- p(1611), q(1611).
-p(1613) :- p(1612), q(1613).
-q(1613) :- p(1612), q(1612).
-p(1614) :- p(1613), q(1614).
-q(1614) :- p(1613), q(1613).
-p(1615) :- p(1614), q(1615).
-q(1615) :- p(1614), q(1614).
-p(1616) :- p(1615), q(1616).
-q(1616) :- p(1615), q(1615).
-p(1617) :- p(1616), q(1617).
-q(1617) :- p(1616), q(1616).
-p(1618) :- p(1617), q(1618).
-q(1618) :- p(1617), q(1617).
-p(1619) :- p(1618), q(1619).
-q(1619) :- p(1618), q(1618).
-p(1620) :- p(1619), q(1620).
-q(1620) :- p(1619), q(1619).
-p(1621) :- p(1620), q(1621).
-q(1621) :- p(1620), q(1620).
-p(1622) :- p(1621), q(1622).
-q(1622) :- p(1621), q(1621).
-p(1623) :- p(1622), q(1623).
-q(1623) :- p(1622), q(1622).
-p(1624) :- p(1623), q(1624).
-q(1624) :- p(1623), q(1623).
-p(1625) :- p(1624), q(1625).
-q(1625) :- p(1624), q(1624).
-p(1626) :- p(1625), q(1626).
-q(1626) :- p(1625), q(1625).
-p(1627) :- p(1626), q(1627).
-q(1627) :- p(1626), q(1626).
-p(1628) :- p(1627), q(1628).
-q(1628) :- p(1627), q(1627).
-p(1629) :- p(1628), q(1629).
-q(1629) :- p(1628), q(1628).
-p(1630) :- p(1629), q(1630).
-q(1630) :- p(1629), q(1629).
-p(1631) :- p(1630), q(1631).
-q(1631) :- p(1630), q(1630).
-p(1632) :- p(1631), q(1632).
-q(1632) :- p(1631), q(1631).
-p(1633) :- p(1632), q(1633).
-q(1633) :- p(1632), q(1632).
-p(1634) :- p(1633), q(1634).
-q(1634) :- p(1633), q(1633).
-p(1635) :- p(1634), q(1635).
-q(1635) :- p(1634), q(1634).
-p(1636) :- p(1635), q(1636).
-q(1636) :- p(1635), q(1635).
-p(1637) :- p(1636), q(1637).
-q(1637) :- p(1636), q(1636).
-p(1638) :- p(1637), q(1638).
-q(1638) :- p(1637), q(1637).
-p(1639) :- p(1638), q(1639).
-q(1639) :- p(1638), q(1638).
-p(1640) :- p(1639), q(1640).
-q(1640) :- p(1639), q(1639).
-p(1641) :- p(1640), q(1641).
-q(1641) :- p(1640), q(1640).
-p(1642) :- p(1641), q(1642).
-q(1642) :- p(1641), q(1641).
-p(1643) :- p(1642), q(1643).
-q(1643) :- p(1642), q(1642).
-p(1644) :- p(1643), q(1644).
-q(1644) :- p(1643), q(1643).
-p(1645) :- p(1644), q(1645).
-q(1645) :- p(1644), q(1644).
-p(1646) :- p(1645), q(1646).
-q(1646) :- p(1645), q(1645).
-p(1647) :- p(1646), q(1647).
-q(1647) :- p(1646), q(1646).
-p(1648) :- p(1647), q(1648).
-q(1648) :- p(1647), q(1647).
-p(1649) :- p(1648), q(1649).
-q(1649) :- p(1648), q(1648).
-p(1650) :- p(1649), q(1650).
-q(1650) :- p(1649), q(1649).
-p(1651) :- p(1650), q(1651).
-q(1651) :- p(1650), q(1650).
-p(1652) :- p(1651), q(1652).
-q(1652) :- p(1651), q(1651).
-p(1653) :- p(1652), q(1653).
-q(1653) :- p(1652), q(1652).
-p(1654) :- p(1653), q(1654).
-q(1654) :- p(1653), q(1653).
-p(1655) :- p(1654), q(1655).
-q(1655) :- p(1654), q(1654).
-p(1656) :- p(1655), q(1656).
-q(1656) :- p(1655), q(1655).
-p(1657) :- p(1656), q(1657).
-q(1657) :- p(1656), q(1656).
-p(1658) :- p(1657), q(1658).
-q(1658) :- p(1657), q(1657).
-p(1659) :- p(1658), q(1659).
-q(1659) :- p(1658), q(1658).
-p(1660) :- p(1659), q(1660).
-q(1660) :- p(1659), q(1659).
-p(1661) :- p(1660), q(1661).
-q(1661) :- p(1660), q(1660).
-p(1662) :- p(1661), q(1662).
-q(1662) :- p(1661), q(1661).
-p(1663) :- p(1662), q(1663).
-q(1663) :- p(1662), q(1662).
-p(1664) :- p(1663), q(1664).
-q(1664) :- p(1663), q(1663).
-p(1665) :- p(1664), q(1665).
-q(1665) :- p(1664), q(1664).
-p(1666) :- p(1665), q(1666).
-q(1666) :- p(1665), q(1665).
-p(1667) :- p(1666), q(1667).
-q(1667) :- p(1666), q(1666).
-p(1668) :- p(1667), q(1668).
-q(1668) :- p(1667), q(1667).
-p(1669) :- p(1668), q(1669).
-q(1669) :- p(1668), q(1668).
-p(1670) :- p(1669), q(1670).
-q(1670) :- p(1669), q(1669).
-p(1671) :- p(1670), q(1671).
-q(1671) :- p(1670), q(1670).
-p(1672) :- p(1671), q(1672).
-q(1672) :- p(1671), q(1671).
-p(1673) :- p(1672), q(1673).
-q(1673) :- p(1672), q(1672).
-p(1674) :- p(1673), q(1674).
-q(1674) :- p(1673), q(1673).
-p(1675) :- p(1674), q(1675).
-q(1675) :- p(1674), q(1674).
-p(1676) :- p(1675), q(1676).
-q(1676) :- p(1675), q(1675).
-p(1677) :- p(1676), q(1677).
-q(1677) :- p(1676), q(1676).
-p(1678) :- p(1677), q(1678).
-q(1678) :- p(1677), q(1677).
-p(1679) :- p(1678), q(1679).
-q(1679) :- p(1678), q(1678).
-p(1680) :- p(1679), q(1680).
-q(1680) :- p(1679), q(1679).
-p(1681) :- p(1680), q(1681).
-q(1681) :- p(1680), q(1680).
-p(1682) :- p(1681), q(1682).
-q(1682) :- p(1681), q(1681).
-p(1683) :- p(1682), q(1683).
-q(1683) :- p(1682), q(1682).
-p(1684) :- p(1683), q(1684).
-q(1684) :- p(1683), q(1683).
-p(1685) :- p(1684), q(1685).
-q(1685) :- p(1684), q(1684).
-p(1686) :- p(1685), q(1686).
-q(1686) :- p(1685), q(1685).
-p(1687) :- p(1686), q(1687).
-q(1687) :- p(1686), q(1686).
-p(1688) :- p(1687), q(1688).
-q(1688) :- p(1687), q(1687).
-p(1689) :- p(1688), q(1689).
-q(1689) :- p(1688), q(1688).
-p(1690) :- p(1689), q(1690).
-q(1690) :- p(1689), q(1689).
-p(1691) :- p(1690), q(1691).
-q(1691) :- p(1690), q(1690).
-p(1692) :- p(1691), q(1692).
-q(1692) :- p(1691), q(1691).
-p(1693) :- p(1692), q(1693).
-q(1693) :- p(1692), q(1692).
-p(1694) :- p(1693), q(1694).
-q(1694) :- p(1693), q(1693).
-p(1695) :- p(1694), q(1695).
-q(1695) :- p(1694), q(1694).
-p(1696) :- p(1695), q(1696).
-q(1696) :- p(1695), q(1695).
-p(1697) :- p(1696), q(1697).
-q(1697) :- p(1696), q(1696).
-p(1698) :- p(1697), q(1698).
-q(1698) :- p(1697), q(1697).
-p(1699) :- p(1698), q(1699).
-q(1699) :- p(1698), q(1698).
-p(1700) :- p(1699), q(1700).
-q(1700) :- p(1699), q(1699).
-p(1701) :- p(1700), q(1701).
-q(1701) :- p(1700), q(1700).
-p(1702) :- p(1701), q(1702).
-q(1702) :- p(1701), q(1701).
-p(1703) :- p(1702), q(1703).
-q(1703) :- p(1702), q(1702).
-p(1704) :- p(1703), q(1704).
-q(1704) :- p(1703), q(1703).
-p(1705) :- p(1704), q(1705).
-q(1705) :- p(1704), q(1704).
-p(1706) :- p(1705), q(1706).
-q(1706) :- p(1705), q(1705).
-p(1707) :- p(1706), q(1707).
-q(1707) :- p(1706), q(1706).
-p(1708) :- p(1707), q(1708).
-q(1708) :- p(1707), q(1707).
-p(1709) :- p(1708), q(1709).
-q(1709) :- p(1708), q(1708).
-p(1710) :- p(1709), q(1710).
-q(1710) :- p(1709), q(1709).
-p(1711) :- p(1710), q(1711).
-q(1711) :- p(1710), q(1710).
-p(1712) :- p(1711), q(1712).
-q(1712) :- p(1711), q(1711).
-p(1713) :- p(1712), q(1713).
-q(1713) :- p(1712), q(1712).
-p(1714) :- p(1713), q(1714).
-q(1714) :- p(1713), q(1713).
-p(1715) :- p(1714), q(1715).
-q(1715) :- p(1714), q(1714).
-p(1716) :- p(1715), q(1716).
-q(1716) :- p(1715), q(1715).
-p(1717) :- p(1716), q(1717).
-q(1717) :- p(1716), q(1716).
-p(1718) :- p(1717), q(1718).
-q(1718) :- p(1717), q(1717).
-p(1719) :- p(1718), q(1719).
-q(1719) :- p(1718), q(1718).
-p(1720) :- p(1719), q(1720).
-q(1720) :- p(1719), q(1719).
-p(1721) :- p(1720), q(1721).
-q(1721) :- p(1720), q(1720).
-p(1722) :- p(1721), q(1722).
-q(1722) :- p(1721), q(1721).
-p(1723) :- p(1722), q(1723).
-q(1723) :- p(1722), q(1722).
-p(1724) :- p(1723), q(1724).
-q(1724) :- p(1723), q(1723).
-p(1725) :- p(1724), q(1725).
-q(1725) :- p(1724), q(1724).
-p(1726) :- p(1725), q(1726).
-q(1726) :- p(1725), q(1725).
-p(1727) :- p(1726), q(1727).
-q(1727) :- p(1726), q(1726).
-p(1728) :- p(1727), q(1728).
-q(1728) :- p(1727), q(1727).
-p(1729) :- p(1728), q(1729).
-q(1729) :- p(1728), q(1728).
-p(1730) :- p(1729), q(1730).
-q(1730) :- p(1729), q(1729).
-p(1731) :- p(1730), q(1731).
-q(1731) :- p(1730), q(1730).
-p(1732) :- p(1731), q(1732).
-q(1732) :- p(1731), q(1731).
-p(1733) :- p(1732), q(1733).
-q(1733) :- p(1732), q(1732).
-p(1734) :- p(1733), q(1734).
-q(1734) :- p(1733), q(1733).
-p(1735) :- p(1734), q(1735).
-q(1735) :- p(1734), q(1734).
-p(1736) :- p(1735), q(1736).
-q(1736) :- p(1735), q(1735).
-p(1737) :- p(1736), q(1737).
-q(1737) :- p(1736), q(1736).
-p(1738) :- p(1737), q(1738).
-q(1738) :- p(1737), q(1737).
-p(1739) :- p(1738), q(1739).
-q(1739) :- p(1738), q(1738).
-p(1740) :- p(1739), q(1740).
-q(1740) :- p(1739), q(1739).
-p(1741) :- p(1740), q(1741).
-q(1741) :- p(1740), q(1740).
-p(1742) :- p(1741), q(1742).
-q(1742) :- p(1741), q(1741).
-p(1743) :- p(1742), q(1743).
-q(1743) :- p(1742), q(1742).
-p(1744) :- p(1743), q(1744).
-q(1744) :- p(1743), q(1743).
-p(1745) :- p(1744), q(1745).
-q(1745) :- p(1744), q(1744).
-p(1746) :- p(1745), q(1746).
-q(1746) :- p(1745), q(1745).
-p(1747) :- p(1746), q(1747).
-q(1747) :- p(1746), q(1746).
-p(1748) :- p(1747), q(1748).
-q(1748) :- p(1747), q(1747).
-p(1749) :- p(1748), q(1749).
-q(1749) :- p(1748), q(1748).
-p(1750) :- p(1749), q(1750).
-q(1750) :- p(1749), q(1749).
-p(1751) :- p(1750), q(1751).
-q(1751) :- p(1750), q(1750).
-p(1752) :- p(1751), q(1752).
-q(1752) :- p(1751), q(1751).
-p(1753) :- p(1752), q(1753).
-q(1753) :- p(1752), q(1752).
-p(1754) :- p(1753), q(1754).
-q(1754) :- p(1753), q(1753).
-p(1755) :- p(1754), q(1755).
-q(1755) :- p(1754), q(1754).
-p(1756) :- p(1755), q(1756).
-q(1756) :- p(1755), q(1755).
-p(1757) :- p(1756), q(1757).
-q(1757) :- p(1756), q(1756).
-p(1758) :- p(1757), q(1758).
-q(1758) :- p(1757), q(1757).
-p(1759) :- p(1758), q(1759).
-q(1759) :- p(1758), q(1758).
-p(1760) :- p(1759), q(1760).
-q(1760) :- p(1759), q(1759).
-p(1761) :- p(1760), q(1761).
-q(1761) :- p(1760), q(1760).
-p(1762) :- p(1761), q(1762).
-q(1762) :- p(1761), q(1761).
-p(1763) :- p(1762), q(1763).
-q(1763) :- p(1762), q(1762).
-p(1764) :- p(1763), q(1764).
-q(1764) :- p(1763), q(1763).
-p(1765) :- p(1764), q(1765).
-q(1765) :- p(1764), q(1764).
-p(1766) :- p(1765), q(1766).
-q(1766) :- p(1765), q(1765).
-p(1767) :- p(1766), q(1767).
-q(1767) :- p(1766), q(1766).
-p(1768) :- p(1767), q(1768).
-q(1768) :- p(1767), q(1767).
-p(1769) :- p(1768), q(1769).
-q(1769) :- p(1768), q(1768).
-p(1770) :- p(1769), q(1770).
-q(1770) :- p(1769), q(1769).
-p(1771) :- p(1770), q(1771).
-q(1771) :- p(1770), q(1770).
-p(1772) :- p(1771), q(1772).
-q(1772) :- p(1771), q(1771).
-p(1773) :- p(1772), q(1773).
-q(1773) :- p(1772), q(1772).
-p(1774) :- p(1773), q(1774).
-q(1774) :- p(1773), q(1773).
-p(1775) :- p(1774), q(1775).
-q(1775) :- p(1774), q(1774).
-p(1776) :- p(1775), q(1776).
-q(1776) :- p(1775), q(1775).
-p(1777) :- p(1776), q(1777).
-q(1777) :- p(1776), q(1776).
-p(1778) :- p(1777), q(1778).
-q(1778) :- p(1777), q(1777).
-p(1779) :- p(1778), q(1779).
-q(1779) :- p(1778), q(1778).
-p(1780) :- p(1779), q(1780).
-q(1780) :- p(1779), q(1779).
-p(1781) :- p(1780), q(1781).
-q(1781) :- p(1780), q(1780).
-p(1782) :- p(1781), q(1782).
-q(1782) :- p(1781), q(1781).
-p(1783) :- p(1782), q(1783).
-q(1783) :- p(1782), q(1782).
-p(1784) :- p(1783), q(1784).
-q(1784) :- p(1783), q(1783).
-p(1785) :- p(1784), q(1785).
-q(1785) :- p(1784), q(1784).
-p(1786) :- p(1785), q(1786).
-q(1786) :- p(1785), q(1785).
-p(1787) :- p(1786), q(1787).
-q(1787) :- p(1786), q(1786).
-p(1788) :- p(1787), q(1788).
-q(1788) :- p(1787), q(1787).
-p(1789) :- p(1788), q(1789).
-q(1789) :- p(1788), q(1788).
-p(1790) :- p(1789), q(1790).
-q(1790) :- p(1789), q(1789).
-p(1791) :- p(1790), q(1791).
-q(1791) :- p(1790), q(1790).
-p(1792) :- p(1791), q(1792).
-q(1792) :- p(1791), q(1791).
-p(1793) :- p(1792), q(1793).
-q(1793) :- p(1792), q(1792).
-p(1794) :- p(1793), q(1794).
-q(1794) :- p(1793), q(1793).
-p(1795) :- p(1794), q(1795).
-q(1795) :- p(1794), q(1794).
-p(1796) :- p(1795), q(1796).
-q(1796) :- p(1795), q(1795).
-p(1797) :- p(1796), q(1797).
-q(1797) :- p(1796), q(1796).
-p(1798) :- p(1797), q(1798).
-q(1798) :- p(1797), q(1797).
-p(1799) :- p(1798), q(1799).
-q(1799) :- p(1798), q(1798).
-p(1800) :- p(1799), q(1800).
-q(1800) :- p(1799), q(1799).
-p(1801) :- p(1800), q(1801).
-q(1801) :- p(1800), q(1800).
-p(1802) :- p(1801), q(1802).
-q(1802) :- p(1801), q(1801).
-p(1803) :- p(1802), q(1803).
-q(1803) :- p(1802), q(1802).
-p(1804) :- p(1803), q(1804).
-q(1804) :- p(1803), q(1803).
-p(1805) :- p(1804), q(1805).
-q(1805) :- p(1804), q(1804).
-p(1806) :- p(1805), q(1806).
-q(1806) :- p(1805), q(1805).
-p(1807) :- p(1806), q(1807).
-q(1807) :- p(1806), q(1806).
-p(1808) :- p(1807), q(1808).
-q(1808) :- p(1807), q(1807).
-p(1809) :- p(1808), q(1809).
-q(1809) :- p(1808), q(1808).
-p(1810) :- p(1809), q(1810).
-q(1810) :- p(1809), q(1809).
-p(1811) :- p(1810), q(1811).
-q(1811) :- p(1810), q(1810).
-p(1812) :- p(1811), q(1812).
-q(1812) :- p(1811), q(1811).
-p(1813) :- p(1812), q(1813).
-q(1813) :- p(1812), q(1812).
-p(1814) :- p(1813), q(1814).
-q(1814) :- p(1813), q(1813).
-p(1815) :- p(1814), q(1815).
-q(1815) :- p(1814), q(1814).
-p(1816) :- p(1815), q(1816).
-q(1816) :- p(1815), q(1815).
-p(1817) :- p(1816), q(1817).
-q(1817) :- p(1816), q(1816).
-p(1818) :- p(1817), q(1818).
-q(1818) :- p(1817), q(1817).
-p(1819) :- p(1818), q(1819).
-q(1819) :- p(1818), q(1818).
-p(1820) :- p(1819), q(1820).
-q(1820) :- p(1819), q(1819).
-p(1821) :- p(1820), q(1821).
-q(1821) :- p(1820), q(1820).
-p(1822) :- p(1821), q(1822).
-q(1822) :- p(1821), q(1821).
-p(1823) :- p(1822), q(1823).
-q(1823) :- p(1822), q(1822).
-p(1824) :- p(1823), q(1824).
-q(1824) :- p(1823), q(1823).
-p(1825) :- p(1824), q(1825).
-q(1825) :- p(1824), q(1824).
-p(1826) :- p(1825), q(1826).
-q(1826) :- p(1825), q(1825).
-p(1827) :- p(1826), q(1827).
-q(1827) :- p(1826), q(1826).
-p(1828) :- p(1827), q(1828).
-q(1828) :- p(1827), q(1827).
-p(1829) :- p(1828), q(1829).
-q(1829) :- p(1828), q(1828).
-p(1830) :- p(1829), q(1830).
-q(1830) :- p(1829), q(1829).
-p(1831) :- p(1830), q(1831).
-q(1831) :- p(1830), q(1830).
-p(1832) :- p(1831), q(1832).
-q(1832) :- p(1831), q(1831).
-p(1833) :- p(1832), q(1833).
-q(1833) :- p(1832), q(1832).
-p(1834) :- p(1833), q(1834).
-q(1834) :- p(1833), q(1833).
-p(1835) :- p(1834), q(1835).
-q(1835) :- p(1834), q(1834).
-p(1836) :- p(1835), q(1836).
-q(1836) :- p(1835), q(1835).
-p(1837) :- p(1836), q(1837).
-q(1837) :- p(1836), q(1836).
-p(1838) :- p(1837), q(1838).
-q(1838) :- p(1837), q(1837).
-p(1839) :- p(1838), q(1839).
-q(1839) :- p(1838), q(1838).
-p(1840) :- p(1839), q(1840).
-q(1840) :- p(1839), q(1839).
-p(1841) :- p(1840), q(1841).
-q(1841) :- p(1840), q(1840).
-p(1842) :- p(1841), q(1842).
-q(1842) :- p(1841), q(1841).
-p(1843) :- p(1842), q(1843).
-q(1843) :- p(1842), q(1842).
-p(1844) :- p(1843), q(1844).
-q(1844) :- p(1843), q(1843).
-p(1845) :- p(1844), q(1845).
-q(1845) :- p(1844), q(1844).
-p(1846) :- p(1845), q(1846).
-q(1846) :- p(1845), q(1845).
-p(1847) :- p(1846), q(1847).
-q(1847) :- p(1846), q(1846).
-p(1848) :- p(1847), q(1848).
-q(1848) :- p(1847), q(1847).
-p(1849) :- p(1848), q(1849).
-q(1849) :- p(1848), q(1848).
-p(1850) :- p(1849), q(1850).
-q(1850) :- p(1849), q(1849).
-p(1851) :- p(1850), q(1851).
-q(1851) :- p(1850), q(1850).
-p(1852) :- p(1851), q(1852).
-q(1852) :- p(1851), q(1851).
-p(1853) :- p(1852), q(1853).
-q(1853) :- p(1852), q(1852).
-p(1854) :- p(1853), q(1854).
-q(1854) :- p(1853), q(1853).
-p(1855) :- p(1854), q(1855).
-q(1855) :- p(1854), q(1854).
-p(1856) :- p(1855), q(1856).
-q(1856) :- p(1855), q(1855).
-p(1857) :- p(1856), q(1857).
-q(1857) :- p(1856), q(1856).
-p(1858) :- p(1857), q(1858).
-q(1858) :- p(1857), q(1857).
-p(1859) :- p(1858), q(1859).
-q(1859) :- p(1858), q(1858).
-p(1860) :- p(1859), q(1860).
-q(1860) :- p(1859), q(1859).
-p(1861) :- p(1860), q(1861).
-q(1861) :- p(1860), q(1860).
-p(1862) :- p(1861), q(1862).
-q(1862) :- p(1861), q(1861).
-p(1863) :- p(1862), q(1863).
-q(1863) :- p(1862), q(1862).
-p(1864) :- p(1863), q(1864).
-q(1864) :- p(1863), q(1863).
-p(1865) :- p(1864), q(1865).
-q(1865) :- p(1864), q(1864).
-p(1866) :- p(1865), q(1866).
-q(1866) :- p(1865), q(1865).
-p(1867) :- p(1866), q(1867).
-q(1867) :- p(1866), q(1866).
-p(1868) :- p(1867), q(1868).
-q(1868) :- p(1867), q(1867).
-p(1869) :- p(1868), q(1869).
-q(1869) :- p(1868), q(1868).
-p(1870) :- p(1869), q(1870).
-q(1870) :- p(1869), q(1869).
-p(1871) :- p(1870), q(1871).
-q(1871) :- p(1870), q(1870).
-p(1872) :- p(1871), q(1872).
-q(1872) :- p(1871), q(1871).
-p(1873) :- p(1872), q(1873).
-q(1873) :- p(1872), q(1872).
-p(1874) :- p(1873), q(1874).
-q(1874) :- p(1873), q(1873).
-p(1875) :- p(1874), q(1875).
-q(1875) :- p(1874), q(1874).
-p(1876) :- p(1875), q(1876).
-q(1876) :- p(1875), q(1875).
-p(1877) :- p(1876), q(1877).
-q(1877) :- p(1876), q(1876).
-p(1878) :- p(1877), q(1878).
-q(1878) :- p(1877), q(1877).
-p(1879) :- p(1878), q(1879).
-q(1879) :- p(1878), q(1878).
-p(1880) :- p(1879), q(1880).
-q(1880) :- p(1879), q(1879).
-p(1881) :- p(1880), q(1881).
-q(1881) :- p(1880), q(1880).
-p(1882) :- p(1881), q(1882).
-q(1882) :- p(1881), q(1881).
-p(1883) :- p(1882), q(1883).
-q(1883) :- p(1882), q(1882).
-p(1884) :- p(1883), q(1884).
-q(1884) :- p(1883), q(1883).
-p(1885) :- p(1884), q(1885).
-q(1885) :- p(1884), q(1884).
-p(1886) :- p(1885), q(1886).
-q(1886) :- p(1885), q(1885).
-p(1887) :- p(1886), q(1887).
-q(1887) :- p(1886), q(1886).
-p(1888) :- p(1887), q(1888).
-q(1888) :- p(1887), q(1887).
-p(1889) :- p(1888), q(1889).
-q(1889) :- p(1888), q(1888).
-p(1890) :- p(1889), q(1890).
-q(1890) :- p(1889), q(1889).
-p(1891) :- p(1890), q(1891).
-q(1891) :- p(1890), q(1890).
-p(1892) :- p(1891), q(1892).
-q(1892) :- p(1891), q(1891).
-p(1893) :- p(1892), q(1893).
-q(1893) :- p(1892), q(1892).
-p(1894) :- p(1893), q(1894).
-q(1894) :- p(1893), q(1893).
-p(1895) :- p(1894), q(1895).
-q(1895) :- p(1894), q(1894).
-p(1896) :- p(1895), q(1896).
-q(1896) :- p(1895), q(1895).
-p(1897) :- p(1896), q(1897).
-q(1897) :- p(1896), q(1896).
-p(1898) :- p(1897), q(1898).
-q(1898) :- p(1897), q(1897).
-p(1899) :- p(1898), q(1899).
-q(1899) :- p(1898), q(1898).
-p(1900) :- p(1899), q(1900).
-q(1900) :- p(1899), q(1899).
-p(1901) :- p(1900), q(1901).
-q(1901) :- p(1900), q(1900).
-p(1902) :- p(1901), q(1902).
-q(1902) :- p(1901), q(1901).
-p(1903) :- p(1902), q(1903).
-q(1903) :- p(1902), q(1902).
-p(1904) :- p(1903), q(1904).
-q(1904) :- p(1903), q(1903).
-p(1905) :- p(1904), q(1905).
-q(1905) :- p(1904), q(1904).
-p(1906) :- p(1905), q(1906).
-q(1906) :- p(1905), q(1905).
-p(1907) :- p(1906), q(1907).
-q(1907) :- p(1906), q(1906).
-p(1908) :- p(1907), q(1908).
-q(1908) :- p(1907), q(1907).
-p(1909) :- p(1908), q(1909).
-q(1909) :- p(1908), q(1908).
-p(1910) :- p(1909), q(1910).
-q(1910) :- p(1909), q(1909).
-p(1911) :- p(1910), q(1911).
-q(1911) :- p(1910), q(1910).
-p(1912) :- p(1911), q(1912).
-q(1912) :- p(1911), q(1911).
-p(1913) :- p(1912), q(1913).
-q(1913) :- p(1912), q(1912).
-p(1914) :- p(1913), q(1914).
-q(1914) :- p(1913), q(1913).
-p(1915) :- p(1914), q(1915).
-q(1915) :- p(1914), q(1914).
-p(1916) :- p(1915), q(1916).
-q(1916) :- p(1915), q(1915).
-p(1917) :- p(1916), q(1917).
-q(1917) :- p(1916), q(1916).
-p(1918) :- p(1917), q(1918).
-q(1918) :- p(1917), q(1917).
-p(1919) :- p(1918), q(1919).
-q(1919) :- p(1918), q(1918).
-p(1920) :- p(1919), q(1920).
-q(1920) :- p(1919), q(1919).
-p(1921) :- p(1920), q(1921).
-q(1921) :- p(1920), q(1920).
-p(1922) :- p(1921), q(1922).
-q(1922) :- p(1921), q(1921).
-p(1923) :- p(1922), q(1923).
-q(1923) :- p(1922), q(1922).
-p(1924) :- p(1923), q(1924).
-q(1924) :- p(1923), q(1923).
-p(1925) :- p(1924), q(1925).
-q(1925) :- p(1924), q(1924).
-p(1926) :- p(1925), q(1926).
-q(1926) :- p(1925), q(1925).
-p(1927) :- p(1926), q(1927).
-q(1927) :- p(1926), q(1926).
-p(1928) :- p(1927), q(1928).
-q(1928) :- p(1927), q(1927).
-p(1929) :- p(1928), q(1929).
-q(1929) :- p(1928), q(1928).
-p(1930) :- p(1929), q(1930).
-q(1930) :- p(1929), q(1929).
-p(1931) :- p(1930), q(1931).
-q(1931) :- p(1930), q(1930).
-p(1932) :- p(1931), q(1932).
-q(1932) :- p(1931), q(1931).
-p(1933) :- p(1932), q(1933).
-q(1933) :- p(1932), q(1932).
-p(1934) :- p(1933), q(1934).
-q(1934) :- p(1933), q(1933).
-p(1935) :- p(1934), q(1935).
-q(1935) :- p(1934), q(1934).
-p(1936) :- p(1935), q(1936).
-q(1936) :- p(1935), q(1935).
-p(1937) :- p(1936), q(1937).
-q(1937) :- p(1936), q(1936).
-p(1938) :- p(1937), q(1938).
-q(1938) :- p(1937), q(1937).
-p(1939) :- p(1938), q(1939).
-q(1939) :- p(1938), q(1938).
-p(1940) :- p(1939), q(1940).
-q(1940) :- p(1939), q(1939).
-p(1941) :- p(1940), q(1941).
-q(1941) :- p(1940), q(1940).
-p(1942) :- p(1941), q(1942).
-q(1942) :- p(1941), q(1941).
-p(1943) :- p(1942), q(1943).
-q(1943) :- p(1942), q(1942).
-p(1944) :- p(1943), q(1944).
-q(1944) :- p(1943), q(1943).
-p(1945) :- p(1944), q(1945).
-q(1945) :- p(1944), q(1944).
-p(1946) :- p(1945), q(1946).
-q(1946) :- p(1945), q(1945).
-p(1947) :- p(1946), q(1947).
-q(1947) :- p(1946), q(1946).
-p(1948) :- p(1947), q(1948).
-q(1948) :- p(1947), q(1947).
-p(1949) :- p(1948), q(1949).
-q(1949) :- p(1948), q(1948).
-p(1950) :- p(1949), q(1950).
-q(1950) :- p(1949), q(1949).
-p(1951) :- p(1950), q(1951).
-q(1951) :- p(1950), q(1950).
-p(1952) :- p(1951), q(1952).
-q(1952) :- p(1951), q(1951).
-p(1953) :- p(1952), q(1953).
-q(1953) :- p(1952), q(1952).
-p(1954) :- p(1953), q(1954).
-q(1954) :- p(1953), q(1953).
-p(1955) :- p(1954), q(1955).
-q(1955) :- p(1954), q(1954).
-p(1956) :- p(1955), q(1956).
-q(1956) :- p(1955), q(1955).
-p(1957) :- p(1956), q(1957).
-q(1957) :- p(1956), q(1956).
-p(1958) :- p(1957), q(1958).
-q(1958) :- p(1957), q(1957).
-p(1959) :- p(1958), q(1959).
-q(1959) :- p(1958), q(1958).
-p(1960) :- p(1959), q(1960).
-q(1960) :- p(1959), q(1959).
-p(1961) :- p(1960), q(1961).
-q(1961) :- p(1960), q(1960).
-p(1962) :- p(1961), q(1962).
-q(1962) :- p(1961), q(1961).
-p(1963) :- p(1962), q(1963).
-q(1963) :- p(1962), q(1962).
-p(1964) :- p(1963), q(1964).
-q(1964) :- p(1963), q(1963).
-p(1965) :- p(1964), q(1965).
-q(1965) :- p(1964), q(1964).
-p(1966) :- p(1965), q(1966).
-q(1966) :- p(1965), q(1965).
-p(1967) :- p(1966), q(1967).
-q(1967) :- p(1966), q(1966).
-p(1968) :- p(1967), q(1968).
-q(1968) :- p(1967), q(1967).
-p(1969) :- p(1968), q(1969).
-q(1969) :- p(1968), q(1968).
-p(1970) :- p(1969), q(1970).
-q(1970) :- p(1969), q(1969).
-p(1971) :- p(1970), q(1971).
-q(1971) :- p(1970), q(1970).
-p(1972) :- p(1971), q(1972).
-q(1972) :- p(1971), q(1971).
-p(1973) :- p(1972), q(1973).
-q(1973) :- p(1972), q(1972).
-p(1974) :- p(1973), q(1974).
-q(1974) :- p(1973), q(1973).
-p(1975) :- p(1974), q(1975).
-q(1975) :- p(1974), q(1974).
-p(1976) :- p(1975), q(1976).
-q(1976) :- p(1975), q(1975).
-p(1977) :- p(1976), q(1977).
-q(1977) :- p(1976), q(1976).
-p(1978) :- p(1977), q(1978).
-q(1978) :- p(1977), q(1977).
-p(1979) :- p(1978), q(1979).
-q(1979) :- p(1978), q(1978).
-p(1980) :- p(1979), q(1980).
-q(1980) :- p(1979), q(1979).
-p(1981) :- p(1980), q(1981).
-q(1981) :- p(1980), q(1980).
-p(1982) :- p(1981), q(1982).
-q(1982) :- p(1981), q(1981).
-p(1983) :- p(1982), q(1983).
-q(1983) :- p(1982), q(1982).
-p(1984) :- p(1983), q(1984).
-q(1984) :- p(1983), q(1983).
-p(1985) :- p(1984), q(1985).
-q(1985) :- p(1984), q(1984).
-p(1986) :- p(1985), q(1986).
-q(1986) :- p(1985), q(1985).
-p(1987) :- p(1986), q(1987).
-q(1987) :- p(1986), q(1986).
-p(1988) :- p(1987), q(1988).
-q(1988) :- p(1987), q(1987).
-p(1989) :- p(1988), q(1989).
-q(1989) :- p(1988), q(1988).
-p(1990) :- p(1989), q(1990).
-q(1990) :- p(1989), q(1989).
-p(1991) :- p(1990), q(1991).
-q(1991) :- p(1990), q(1990).
-p(1992) :- p(1991), q(1992).
-q(1992) :- p(1991), q(1991).
-p(1993) :- p(1992), q(1993).
-q(1993) :- p(1992), q(1992).
-p(1994) :- p(1993), q(1994).
-q(1994) :- p(1993), q(1993).
-p(1995) :- p(1994), q(1995).
-q(1995) :- p(1994), q(1994).
-p(1996) :- p(1995), q(1996).
-q(1996) :- p(1995), q(1995).
-p(1997) :- p(1996), q(1997).
-q(1997) :- p(1996), q(1996).
-p(1998) :- p(1997), q(1998).
-q(1998) :- p(1997), q(1997).
-p(1999) :- p(1998), q(1999).
-q(1999) :- p(1998), q(1998).
-p(2000) :- p(1999), q(2000).
-q(2000) :- p(1999), q(1999).
-p(2001) :- p(2000), q(2001).
-q(2001) :- p(2000), q(2000).
-p(2002) :- p(2001), q(2002).
-q(2002) :- p(2001), q(2001).
-p(2003) :- p(2002), q(2003).
-q(2003) :- p(2002), q(2002).
-p(2004) :- p(2003), q(2004).
-q(2004) :- p(2003), q(2003).
-p(2005) :- p(2004), q(2005).
-q(2005) :- p(2004), q(2004).
-p(2006) :- p(2005), q(2006).
-q(2006) :- p(2005), q(2005).
-p(2007) :- p(2006), q(2007).
-q(2007) :- p(2006), q(2006).
-p(2008) :- p(2007), q(2008).
-q(2008) :- p(2007), q(2007).
-p(2009) :- p(2008), q(2009).
-q(2009) :- p(2008), q(2008).
-p(2010) :- p(2009), q(2010).
-q(2010) :- p(2009), q(2009).
-p(2011) :- p(2010), q(2011).
-q(2011) :- p(2010), q(2010).
-p(2012) :- p(2011), q(2012).
-q(2012) :- p(2011), q(2011).
-p(2013) :- p(2012), q(2013).
-q(2013) :- p(2012), q(2012).
-p(2014) :- p(2013), q(2014).
-q(2014) :- p(2013), q(2013).
-p(2015) :- p(2014), q(2015).
-q(2015) :- p(2014), q(2014).
-p(2016) :- p(2015), q(2016).
-q(2016) :- p(2015), q(2015).
-p(2017) :- p(2016), q(2017).
-q(2017) :- p(2016), q(2016).
-p(2018) :- p(2017), q(2018).
-q(2018) :- p(2017), q(2017).
-p(2019) :- p(2018), q(2019).
-q(2019) :- p(2018), q(2018).
-p(2020) :- p(2019), q(2020).
-q(2020) :- p(2019), q(2019).
-p(2021) :- p(2020), q(2021).
-q(2021) :- p(2020), q(2020).
-p(2022) :- p(2021), q(2022).
-q(2022) :- p(2021), q(2021).
-p(2023) :- p(2022), q(2023).
-q(2023) :- p(2022), q(2022).
-p(2024) :- p(2023), q(2024).
-q(2024) :- p(2023), q(2023).
-p(2025) :- p(2024), q(2025).
-q(2025) :- p(2024), q(2024).
-p(2026) :- p(2025), q(2026).
-q(2026) :- p(2025), q(2025).
-p(2027) :- p(2026), q(2027).
-q(2027) :- p(2026), q(2026).
-p(2028) :- p(2027), q(2028).
-q(2028) :- p(2027), q(2027).
-p(2029) :- p(2028), q(2029).
-q(2029) :- p(2028), q(2028).
-p(2030) :- p(2029), q(2030).
-q(2030) :- p(2029), q(2029).
-p(2031) :- p(2030), q(2031).
-q(2031) :- p(2030), q(2030).
-p(2032) :- p(2031), q(2032).
-q(2032) :- p(2031), q(2031).
-p(2033) :- p(2032), q(2033).
-q(2033) :- p(2032), q(2032).
-p(2034) :- p(2033), q(2034).
-q(2034) :- p(2033), q(2033).
-p(2035) :- p(2034), q(2035).
-q(2035) :- p(2034), q(2034).
-p(2036) :- p(2035), q(2036).
-q(2036) :- p(2035), q(2035).
-p(2037) :- p(2036), q(2037).
-q(2037) :- p(2036), q(2036).
-p(2038) :- p(2037), q(2038).
-q(2038) :- p(2037), q(2037).
-p(2039) :- p(2038), q(2039).
-q(2039) :- p(2038), q(2038).
-p(2040) :- p(2039), q(2040).
-q(2040) :- p(2039), q(2039).
-p(2041) :- p(2040), q(2041).
-q(2041) :- p(2040), q(2040).
-p(2042) :- p(2041), q(2042).
-q(2042) :- p(2041), q(2041).
-p(2043) :- p(2042), q(2043).
-q(2043) :- p(2042), q(2042).
-p(2044) :- p(2043), q(2044).
-q(2044) :- p(2043), q(2043).
-p(2045) :- p(2044), q(2045).
-q(2045) :- p(2044), q(2044).
-p(2046) :- p(2045), q(2046).
-q(2046) :- p(2045), q(2045).
-p(2047) :- p(2046), q(2047).
-q(2047) :- p(2046), q(2046).
-p(2048) :- p(2047), q(2048).
-q(2048) :- p(2047), q(2047).
-p(2049) :- p(2048), q(2049).
-q(2049) :- p(2048), q(2048).
-p(2050) :- p(2049), q(2050).
-q(2050) :- p(2049), q(2049).
-p(2051) :- p(2050), q(2051).
-q(2051) :- p(2050), q(2050).
-p(2052) :- p(2051), q(2052).
-q(2052) :- p(2051), q(2051).
-p(2053) :- p(2052), q(2053).
-q(2053) :- p(2052), q(2052).
-p(2054) :- p(2053), q(2054).
-q(2054) :- p(2053), q(2053).
-p(2055) :- p(2054), q(2055).
-q(2055) :- p(2054), q(2054).
-p(2056) :- p(2055), q(2056).
-q(2056) :- p(2055), q(2055).
-p(2057) :- p(2056), q(2057).
-q(2057) :- p(2056), q(2056).
-p(2058) :- p(2057), q(2058).
-q(2058) :- p(2057), q(2057).
-p(2059) :- p(2058), q(2059).
-q(2059) :- p(2058), q(2058).
-p(2060) :- p(2059), q(2060).
-q(2060) :- p(2059), q(2059).
-p(2061) :- p(2060), q(2061).
-q(2061) :- p(2060), q(2060).
-p(2062) :- p(2061), q(2062).
-q(2062) :- p(2061), q(2061).
-p(2063) :- p(2062), q(2063).
-q(2063) :- p(2062), q(2062).
-p(2064) :- p(2063), q(2064).
-q(2064) :- p(2063), q(2063).
-p(2065) :- p(2064), q(2065).
-q(2065) :- p(2064), q(2064).
-p(2066) :- p(2065), q(2066).
-q(2066) :- p(2065), q(2065).
-p(2067) :- p(2066), q(2067).
-q(2067) :- p(2066), q(2066).
-p(2068) :- p(2067), q(2068).
-q(2068) :- p(2067), q(2067).
-p(2069) :- p(2068), q(2069).
-q(2069) :- p(2068), q(2068).
-p(2070) :- p(2069), q(2070).
-q(2070) :- p(2069), q(2069).
-p(2071) :- p(2070), q(2071).
-q(2071) :- p(2070), q(2070).
-p(2072) :- p(2071), q(2072).
-q(2072) :- p(2071), q(2071).
-p(2073) :- p(2072), q(2073).
-q(2073) :- p(2072), q(2072).
-p(2074) :- p(2073), q(2074).
-q(2074) :- p(2073), q(2073).
-p(2075) :- p(2074), q(2075).
-q(2075) :- p(2074), q(2074).
-p(2076) :- p(2075), q(2076).
-q(2076) :- p(2075), q(2075).
-p(2077) :- p(2076), q(2077).
-q(2077) :- p(2076), q(2076).
-p(2078) :- p(2077), q(2078).
-q(2078) :- p(2077), q(2077).
-p(2079) :- p(2078), q(2079).
-q(2079) :- p(2078), q(2078).
-p(2080) :- p(2079), q(2080).
-q(2080) :- p(2079), q(2079).
-p(2081) :- p(2080), q(2081).
-q(2081) :- p(2080), q(2080).
-p(2082) :- p(2081), q(2082).
-q(2082) :- p(2081), q(2081).
-p(2083) :- p(2082), q(2083).
-q(2083) :- p(2082), q(2082).
-p(2084) :- p(2083), q(2084).
-q(2084) :- p(2083), q(2083).
-p(2085) :- p(2084), q(2085).
-q(2085) :- p(2084), q(2084).
-p(2086) :- p(2085), q(2086).
-q(2086) :- p(2085), q(2085).
-p(2087) :- p(2086), q(2087).
-q(2087) :- p(2086), q(2086).
-p(2088) :- p(2087), q(2088).
-q(2088) :- p(2087), q(2087).
-p(2089) :- p(2088), q(2089).
-q(2089) :- p(2088), q(2088).
-p(2090) :- p(2089), q(2090).
-q(2090) :- p(2089), q(2089).
-p(2091) :- p(2090), q(2091).
-q(2091) :- p(2090), q(2090).
-p(2092) :- p(2091), q(2092).
-q(2092) :- p(2091), q(2091).
-p(2093) :- p(2092), q(2093).
-q(2093) :- p(2092), q(2092).
-p(2094) :- p(2093), q(2094).
-q(2094) :- p(2093), q(2093).
-p(2095) :- p(2094), q(2095).
-q(2095) :- p(2094), q(2094).
-p(2096) :- p(2095), q(2096).
-q(2096) :- p(2095), q(2095).
-p(2097) :- p(2096), q(2097).
-q(2097) :- p(2096), q(2096).
-p(2098) :- p(2097), q(2098).
-q(2098) :- p(2097), q(2097).
-p(2099) :- p(2098), q(2099).
-q(2099) :- p(2098), q(2098).
-p(2100) :- p(2099), q(2100).
-q(2100) :- p(2099), q(2099).
-p(2101) :- p(2100), q(2101).
-q(2101) :- p(2100), q(2100).
-p(2102) :- p(2101), q(2102).
-q(2102) :- p(2101), q(2101).
-p(2103) :- p(2102), q(2103).
-q(2103) :- p(2102), q(2102).
-p(2104) :- p(2103), q(2104).
-q(2104) :- p(2103), q(2103).
-p(2105) :- p(2104), q(2105).
-q(2105) :- p(2104), q(2104).
-p(2106) :- p(2105), q(2106).
-q(2106) :- p(2105), q(2105).
-p(2107) :- p(2106), q(2107).
-q(2107) :- p(2106), q(2106).
-p(2108) :- p(2107), q(2108).
-q(2108) :- p(2107), q(2107).
-p(2109) :- p(2108), q(2109).
-q(2109) :- p(2108), q(2108).
-p(2110) :- p(2109), q(2110).
-q(2110) :- p(2109), q(2109).
-p(2111) :- p(2110), q(2111).
-q(2111) :- p(2110), q(2110).
-p(2112) :- p(2111), q(2112).
-q(2112) :- p(2111), q(2111).
-p(2113) :- p(2112), q(2113).
-q(2113) :- p(2112), q(2112).
-p(2114) :- p(2113), q(2114).
-q(2114) :- p(2113), q(2113).
-p(2115) :- p(2114), q(2115).
-q(2115) :- p(2114), q(2114).
-p(2116) :- p(2115), q(2116).
-q(2116) :- p(2115), q(2115).
-p(2117) :- p(2116), q(2117).
-q(2117) :- p(2116), q(2116).
-p(2118) :- p(2117), q(2118).
-q(2118) :- p(2117), q(2117).
-p(2119) :- p(2118), q(2119).
-q(2119) :- p(2118), q(2118).
-p(2120) :- p(2119), q(2120).
-q(2120) :- p(2119), q(2119).
-p(2121) :- p(2120), q(2121).
-q(2121) :- p(2120), q(2120).
-p(2122) :- p(2121), q(2122).
-q(2122) :- p(2121), q(2121).
-p(2123) :- p(2122), q(2123).
-q(2123) :- p(2122), q(2122).
-p(2124) :- p(2123), q(2124).
-q(2124) :- p(2123), q(2123).
-p(2125) :- p(2124), q(2125).
-q(2125) :- p(2124), q(2124).
-p(2126) :- p(2125), q(2126).
-q(2126) :- p(2125), q(2125).
-p(2127) :- p(2126), q(2127).
-q(2127) :- p(2126), q(2126).
-p(2128) :- p(2127), q(2128).
-q(2128) :- p(2127), q(2127).
-p(2129) :- p(2128), q(2129).
-q(2129) :- p(2128), q(2128).
-p(2130) :- p(2129), q(2130).
-q(2130) :- p(2129), q(2129).
-p(2131) :- p(2130), q(2131).
-q(2131) :- p(2130), q(2130).
-p(2132) :- p(2131), q(2132).
-q(2132) :- p(2131), q(2131).
-p(2133) :- p(2132), q(2133).
-q(2133) :- p(2132), q(2132).
-p(2134) :- p(2133), q(2134).
-q(2134) :- p(2133), q(2133).
-p(2135) :- p(2134), q(2135).
-q(2135) :- p(2134), q(2134).
-p(2136) :- p(2135), q(2136).
-q(2136) :- p(2135), q(2135).
-p(2137) :- p(2136), q(2137).
-q(2137) :- p(2136), q(2136).
-p(2138) :- p(2137), q(2138).
-q(2138) :- p(2137), q(2137).
-p(2139) :- p(2138), q(2139).
-q(2139) :- p(2138), q(2138).
-p(2140) :- p(2139), q(2140).
-q(2140) :- p(2139), q(2139).
-p(2141) :- p(2140), q(2141).
-q(2141) :- p(2140), q(2140).
-p(2142) :- p(2141), q(2142).
-q(2142) :- p(2141), q(2141).
-p(2143) :- p(2142), q(2143).
-q(2143) :- p(2142), q(2142).
-p(2144) :- p(2143), q(2144).
-q(2144) :- p(2143), q(2143).
-p(2145) :- p(2144), q(2145).
-q(2145) :- p(2144), q(2144).
-p(2146) :- p(2145), q(2146).
-q(2146) :- p(2145), q(2145).
-p(2147) :- p(2146), q(2147).
-q(2147) :- p(2146), q(2146).
-p(2148) :- p(2147), q(2148).
-q(2148) :- p(2147), q(2147).
-p(2149) :- p(2148), q(2149).
-q(2149) :- p(2148), q(2148).
-p(2150) :- p(2149), q(2150).
-q(2150) :- p(2149), q(2149).
-p(2151) :- p(2150), q(2151).
-q(2151) :- p(2150), q(2150).
-p(2152) :- p(2151), q(2152).
-q(2152) :- p(2151), q(2151).
-p(2153) :- p(2152), q(2153).
-q(2153) :- p(2152), q(2152).
-p(2154) :- p(2153), q(2154).
-q(2154) :- p(2153), q(2153).
-p(2155) :- p(2154), q(2155).
-q(2155) :- p(2154), q(2154).
-p(2156) :- p(2155), q(2156).
-q(2156) :- p(2155), q(2155).
-p(2157) :- p(2156), q(2157).
-q(2157) :- p(2156), q(2156).
-p(2158) :- p(2157), q(2158).
-q(2158) :- p(2157), q(2157).
-p(2159) :- p(2158), q(2159).
-q(2159) :- p(2158), q(2158).
-p(2160) :- p(2159), q(2160).
-q(2160) :- p(2159), q(2159).
-p(2161) :- p(2160), q(2161).
-q(2161) :- p(2160), q(2160).
-p(2162) :- p(2161), q(2162).
-q(2162) :- p(2161), q(2161).
-p(2163) :- p(2162), q(2163).
-q(2163) :- p(2162), q(2162).
-p(2164) :- p(2163), q(2164).
-q(2164) :- p(2163), q(2163).
-p(2165) :- p(2164), q(2165).
-q(2165) :- p(2164), q(2164).
-p(2166) :- p(2165), q(2166).
-q(2166) :- p(2165), q(2165).
-p(2167) :- p(2166), q(2167).
-q(2167) :- p(2166), q(2166).
-p(2168) :- p(2167), q(2168).
-q(2168) :- p(2167), q(2167).
-p(2169) :- p(2168), q(2169).
-q(2169) :- p(2168), q(2168).
-p(2170) :- p(2169), q(2170).
-q(2170) :- p(2169), q(2169).
-p(2171) :- p(2170), q(2171).
-q(2171) :- p(2170), q(2170).
-p(2172) :- p(2171), q(2172).
-q(2172) :- p(2171), q(2171).
-p(2173) :- p(2172), q(2173).
-q(2173) :- p(2172), q(2172).
-p(2174) :- p(2173), q(2174).
-q(2174) :- p(2173), q(2173).
-p(2175) :- p(2174), q(2175).
-q(2175) :- p(2174), q(2174).
-p(2176) :- p(2175), q(2176).
-q(2176) :- p(2175), q(2175).
-p(2177) :- p(2176), q(2177).
-q(2177) :- p(2176), q(2176).
-p(2178) :- p(2177), q(2178).
-q(2178) :- p(2177), q(2177).
-p(2179) :- p(2178), q(2179).
-q(2179) :- p(2178), q(2178).
-p(2180) :- p(2179), q(2180).
-q(2180) :- p(2179), q(2179).
-p(2181) :- p(2180), q(2181).
-q(2181) :- p(2180), q(2180).
-p(2182) :- p(2181), q(2182).
-q(2182) :- p(2181), q(2181).
-p(2183) :- p(2182), q(2183).
-q(2183) :- p(2182), q(2182).
-p(2184) :- p(2183), q(2184).
-q(2184) :- p(2183), q(2183).
-p(2185) :- p(2184), q(2185).
-q(2185) :- p(2184), q(2184).
-p(2186) :- p(2185), q(2186).
-q(2186) :- p(2185), q(2185).
-p(2187) :- p(2186), q(2187).
-q(2187) :- p(2186), q(2186).
-p(2188) :- p(2187), q(2188).
-q(2188) :- p(2187), q(2187).
-p(2189) :- p(2188), q(2189).
-q(2189) :- p(2188), q(2188).
-p(2190) :- p(2189), q(2190).
-q(2190) :- p(2189), q(2189).
-p(2191) :- p(2190), q(2191).
-q(2191) :- p(2190), q(2190).
-p(2192) :- p(2191), q(2192).
-q(2192) :- p(2191), q(2191).
-p(2193) :- p(2192), q(2193).
-q(2193) :- p(2192), q(2192).
-p(2194) :- p(2193), q(2194).
-q(2194) :- p(2193), q(2193).
-p(2195) :- p(2194), q(2195).
-q(2195) :- p(2194), q(2194).
-p(2196) :- p(2195), q(2196).
-q(2196) :- p(2195), q(2195).
-p(2197) :- p(2196), q(2197).
-q(2197) :- p(2196), q(2196).
-p(2198) :- p(2197), q(2198).
-q(2198) :- p(2197), q(2197).
-p(2199) :- p(2198), q(2199).
-q(2199) :- p(2198), q(2198).
-p(2200) :- p(2199), q(2200).
-q(2200) :- p(2199), q(2199).
-p(2201) :- p(2200), q(2201).
-q(2201) :- p(2200), q(2200).
-p(2202) :- p(2201), q(2202).
-q(2202) :- p(2201), q(2201).
-p(2203) :- p(2202), q(2203).
-q(2203) :- p(2202), q(2202).
-p(2204) :- p(2203), q(2204).
-q(2204) :- p(2203), q(2203).
-p(2205) :- p(2204), q(2205).
-q(2205) :- p(2204), q(2204).
-p(2206) :- p(2205), q(2206).
-q(2206) :- p(2205), q(2205).
-p(2207) :- p(2206), q(2207).
-q(2207) :- p(2206), q(2206).
-p(2208) :- p(2207), q(2208).
-q(2208) :- p(2207), q(2207).
-p(2209) :- p(2208), q(2209).
-q(2209) :- p(2208), q(2208).
-p(2210) :- p(2209), q(2210).
-q(2210) :- p(2209), q(2209).
-p(2211) :- p(2210), q(2211).
-q(2211) :- p(2210), q(2210).
-p(2212) :- p(2211), q(2212).
-q(2212) :- p(2211), q(2211).
-p(2213) :- p(2212), q(2213).
-q(2213) :- p(2212), q(2212).
-p(2214) :- p(2213), q(2214).
-q(2214) :- p(2213), q(2213).
-p(2215) :- p(2214), q(2215).
-q(2215) :- p(2214), q(2214).
-p(2216) :- p(2215), q(2216).
-q(2216) :- p(2215), q(2215).
-p(2217) :- p(2216), q(2217).
-q(2217) :- p(2216), q(2216).
-p(2218) :- p(2217), q(2218).
-q(2218) :- p(2217), q(2217).
-p(2219) :- p(2218), q(2219).
-q(2219) :- p(2218), q(2218).
-p(2220) :- p(2219), q(2220).
-q(2220) :- p(2219), q(2219).
-p(2221) :- p(2220), q(2221).
-q(2221) :- p(2220), q(2220).
-p(2222) :- p(2221), q(2222).
-q(2222) :- p(2221), q(2221).
-p(2223) :- p(2222), q(2223).
-q(2223) :- p(2222), q(2222).
-p(2224) :- p(2223), q(2224).
-q(2224) :- p(2223), q(2223).
-p(2225) :- p(2224), q(2225).
-q(2225) :- p(2224), q(2224).
-p(2226) :- p(2225), q(2226).
-q(2226) :- p(2225), q(2225).
-p(2227) :- p(2226), q(2227).
-q(2227) :- p(2226), q(2226).
-p(2228) :- p(2227), q(2228).
-q(2228) :- p(2227), q(2227).
-p(2229) :- p(2228), q(2229).
-q(2229) :- p(2228), q(2228).
-p(2230) :- p(2229), q(2230).
-q(2230) :- p(2229), q(2229).
-p(2231) :- p(2230), q(2231).
-q(2231) :- p(2230), q(2230).
-p(2232) :- p(2231), q(2232).
-q(2232) :- p(2231), q(2231).
-p(2233) :- p(2232), q(2233).
-q(2233) :- p(2232), q(2232).
-p(2234) :- p(2233), q(2234).
-q(2234) :- p(2233), q(2233).
-p(2235) :- p(2234), q(2235).
-q(2235) :- p(2234), q(2234).
-p(2236) :- p(2235), q(2236).
-q(2236) :- p(2235), q(2235).
-p(2237) :- p(2236), q(2237).
-q(2237) :- p(2236), q(2236).
-p(2238) :- p(2237), q(2238).
-q(2238) :- p(2237), q(2237).
-p(2239) :- p(2238), q(2239).
-q(2239) :- p(2238), q(2238).
-p(2240) :- p(2239), q(2240).
-q(2240) :- p(2239), q(2239).
-p(2241) :- p(2240), q(2241).
-q(2241) :- p(2240), q(2240).
-p(2242) :- p(2241), q(2242).
-q(2242) :- p(2241), q(2241).
-p(2243) :- p(2242), q(2243).
-q(2243) :- p(2242), q(2242).
-p(2244) :- p(2243), q(2244).
-q(2244) :- p(2243), q(2243).
-p(2245) :- p(2244), q(2245).
-q(2245) :- p(2244), q(2244).
-p(2246) :- p(2245), q(2246).
-q(2246) :- p(2245), q(2245).
-p(2247) :- p(2246), q(2247).
-q(2247) :- p(2246), q(2246).
-p(2248) :- p(2247), q(2248).
-q(2248) :- p(2247), q(2247).
-p(2249) :- p(2248), q(2249).
-q(2249) :- p(2248), q(2248).
-p(2250) :- p(2249), q(2250).
-q(2250) :- p(2249), q(2249).
-p(2251) :- p(2250), q(2251).
-q(2251) :- p(2250), q(2250).
-p(2252) :- p(2251), q(2252).
-q(2252) :- p(2251), q(2251).
-p(2253) :- p(2252), q(2253).
-q(2253) :- p(2252), q(2252).
-p(2254) :- p(2253), q(2254).
-q(2254) :- p(2253), q(2253).
-p(2255) :- p(2254), q(2255).
-q(2255) :- p(2254), q(2254).
-p(2256) :- p(2255), q(2256).
-q(2256) :- p(2255), q(2255).
-p(2257) :- p(2256), q(2257).
-q(2257) :- p(2256), q(2256).
-p(2258) :- p(2257), q(2258).
-q(2258) :- p(2257), q(2257).
-p(2259) :- p(2258), q(2259).
-q(2259) :- p(2258), q(2258).
-p(2260) :- p(2259), q(2260).
-q(2260) :- p(2259), q(2259).
-p(2261) :- p(2260), q(2261).
-q(2261) :- p(2260), q(2260).
-p(2262) :- p(2261), q(2262).
-q(2262) :- p(2261), q(2261).
-p(2263) :- p(2262), q(2263).
-q(2263) :- p(2262), q(2262).
-p(2264) :- p(2263), q(2264).
-q(2264) :- p(2263), q(2263).
-p(2265) :- p(2264), q(2265).
-q(2265) :- p(2264), q(2264).
-p(2266) :- p(2265), q(2266).
-q(2266) :- p(2265), q(2265).
-p(2267) :- p(2266), q(2267).
-q(2267) :- p(2266), q(2266).
-p(2268) :- p(2267), q(2268).
-q(2268) :- p(2267), q(2267).
-p(2269) :- p(2268), q(2269).
-q(2269) :- p(2268), q(2268).
-p(2270) :- p(2269), q(2270).
-q(2270) :- p(2269), q(2269).
-p(2271) :- p(2270), q(2271).
-q(2271) :- p(2270), q(2270).
-p(2272) :- p(2271), q(2272).
-q(2272) :- p(2271), q(2271).
-p(2273) :- p(2272), q(2273).
-q(2273) :- p(2272), q(2272).
-p(2274) :- p(2273), q(2274).
-q(2274) :- p(2273), q(2273).
-p(2275) :- p(2274), q(2275).
-q(2275) :- p(2274), q(2274).
-p(2276) :- p(2275), q(2276).
-q(2276) :- p(2275), q(2275).
-p(2277) :- p(2276), q(2277).
-q(2277) :- p(2276), q(2276).
-p(2278) :- p(2277), q(2278).
-q(2278) :- p(2277), q(2277).
-p(2279) :- p(2278), q(2279).
-q(2279) :- p(2278), q(2278).
-p(2280) :- p(2279), q(2280).
-q(2280) :- p(2279), q(2279).
-p(2281) :- p(2280), q(2281).
-q(2281) :- p(2280), q(2280).
-p(2282) :- p(2281), q(2282).
-q(2282) :- p(2281), q(2281).
-p(2283) :- p(2282), q(2283).
-q(2283) :- p(2282), q(2282).
-p(2284) :- p(2283), q(2284).
-q(2284) :- p(2283), q(2283).
-p(2285) :- p(2284), q(2285).
-q(2285) :- p(2284), q(2284).
-p(2286) :- p(2285), q(2286).
-q(2286) :- p(2285), q(2285).
-p(2287) :- p(2286), q(2287).
-q(2287) :- p(2286), q(2286).
-p(2288) :- p(2287), q(2288).
-q(2288) :- p(2287), q(2287).
-p(2289) :- p(2288), q(2289).
-q(2289) :- p(2288), q(2288).
-p(2290) :- p(2289), q(2290).
-q(2290) :- p(2289), q(2289).
-p(2291) :- p(2290), q(2291).
-q(2291) :- p(2290), q(2290).
-p(2292) :- p(2291), q(2292).
-q(2292) :- p(2291), q(2291).
-p(2293) :- p(2292), q(2293).
-q(2293) :- p(2292), q(2292).
-p(2294) :- p(2293), q(2294).
-q(2294) :- p(2293), q(2293).
-p(2295) :- p(2294), q(2295).
-q(2295) :- p(2294), q(2294).
-p(2296) :- p(2295), q(2296).
-q(2296) :- p(2295), q(2295).
-p(2297) :- p(2296), q(2297).
-q(2297) :- p(2296), q(2296).
-p(2298) :- p(2297), q(2298).
-q(2298) :- p(2297), q(2297).
-p(2299) :- p(2298), q(2299).
-q(2299) :- p(2298), q(2298).
-p(2300) :- p(2299), q(2300).
-q(2300) :- p(2299), q(2299).
-p(2301) :- p(2300), q(2301).
-q(2301) :- p(2300), q(2300).
-p(2302) :- p(2301), q(2302).
-q(2302) :- p(2301), q(2301).
-p(2303) :- p(2302), q(2303).
-q(2303) :- p(2302), q(2302).
-p(2304) :- p(2303), q(2304).
-q(2304) :- p(2303), q(2303).
-p(2305) :- p(2304), q(2305).
-q(2305) :- p(2304), q(2304).
-p(2306) :- p(2305), q(2306).
-q(2306) :- p(2305), q(2305).
-p(2307) :- p(2306), q(2307).
-q(2307) :- p(2306), q(2306).
-p(2308) :- p(2307), q(2308).
-q(2308) :- p(2307), q(2307).
-p(2309) :- p(2308), q(2309).
-q(2309) :- p(2308), q(2308).
-p(2310) :- p(2309), q(2310).
-q(2310) :- p(2309), q(2309).
-p(2311) :- p(2310), q(2311).
-q(2311) :- p(2310), q(2310).
-p(2312) :- p(2311), q(2312).
-q(2312) :- p(2311), q(2311).
-p(2313) :- p(2312), q(2313).
-q(2313) :- p(2312), q(2312).
-p(2314) :- p(2313), q(2314).
-q(2314) :- p(2313), q(2313).
-p(2315) :- p(2314), q(2315).
-q(2315) :- p(2314), q(2314).
-p(2316) :- p(2315), q(2316).
-q(2316) :- p(2315), q(2315).
-p(2317) :- p(2316), q(2317).
-q(2317) :- p(2316), q(2316).
-p(2318) :- p(2317), q(2318).
-q(2318) :- p(2317), q(2317).
-p(2319) :- p(2318), q(2319).
-q(2319) :- p(2318), q(2318).
-p(2320) :- p(2319), q(2320).
-q(2320) :- p(2319), q(2319).
-p(2321) :- p(2320), q(2321).
-q(2321) :- p(2320), q(2320).
-p(2322) :- p(2321), q(2322).
-q(2322) :- p(2321), q(2321).
-p(2323) :- p(2322), q(2323).
-q(2323) :- p(2322), q(2322).
-p(2324) :- p(2323), q(2324).
-q(2324) :- p(2323), q(2323).
-p(2325) :- p(2324), q(2325).
-q(2325) :- p(2324), q(2324).
-p(2326) :- p(2325), q(2326).
-q(2326) :- p(2325), q(2325).
-p(2327) :- p(2326), q(2327).
-q(2327) :- p(2326), q(2326).
-p(2328) :- p(2327), q(2328).
-q(2328) :- p(2327), q(2327).
-p(2329) :- p(2328), q(2329).
-q(2329) :- p(2328), q(2328).
-p(2330) :- p(2329), q(2330).
-q(2330) :- p(2329), q(2329).
-p(2331) :- p(2330), q(2331).
-q(2331) :- p(2330), q(2330).
-p(2332) :- p(2331), q(2332).
-q(2332) :- p(2331), q(2331).
-p(2333) :- p(2332), q(2333).
-q(2333) :- p(2332), q(2332).
-p(2334) :- p(2333), q(2334).
-q(2334) :- p(2333), q(2333).
-p(2335) :- p(2334), q(2335).
-q(2335) :- p(2334), q(2334).
-p(2336) :- p(2335), q(2336).
-q(2336) :- p(2335), q(2335).
-p(2337) :- p(2336), q(2337).
-q(2337) :- p(2336), q(2336).
-p(2338) :- p(2337), q(2338).
-q(2338) :- p(2337), q(2337).
-p(2339) :- p(2338), q(2339).
-q(2339) :- p(2338), q(2338).
-p(2340) :- p(2339), q(2340).
-q(2340) :- p(2339), q(2339).
-p(2341) :- p(2340), q(2341).
-q(2341) :- p(2340), q(2340).
-p(2342) :- p(2341), q(2342).
-q(2342) :- p(2341), q(2341).
-p(2343) :- p(2342), q(2343).
-q(2343) :- p(2342), q(2342).
-p(2344) :- p(2343), q(2344).
-q(2344) :- p(2343), q(2343).
-p(2345) :- p(2344), q(2345).
-q(2345) :- p(2344), q(2344).
-p(2346) :- p(2345), q(2346).
-q(2346) :- p(2345), q(2345).
-p(2347) :- p(2346), q(2347).
-q(2347) :- p(2346), q(2346).
-p(2348) :- p(2347), q(2348).
-q(2348) :- p(2347), q(2347).
-p(2349) :- p(2348), q(2349).
-q(2349) :- p(2348), q(2348).
-p(2350) :- p(2349), q(2350).
-q(2350) :- p(2349), q(2349).
-p(2351) :- p(2350), q(2351).
-q(2351) :- p(2350), q(2350).
-p(2352) :- p(2351), q(2352).
-q(2352) :- p(2351), q(2351).
-p(2353) :- p(2352), q(2353).
-q(2353) :- p(2352), q(2352).
-p(2354) :- p(2353), q(2354).
-q(2354) :- p(2353), q(2353).
-p(2355) :- p(2354), q(2355).
-q(2355) :- p(2354), q(2354).
-p(2356) :- p(2355), q(2356).
-q(2356) :- p(2355), q(2355).
-p(2357) :- p(2356), q(2357).
-q(2357) :- p(2356), q(2356).
-p(2358) :- p(2357), q(2358).
-q(2358) :- p(2357), q(2357).
-p(2359) :- p(2358), q(2359).
-q(2359) :- p(2358), q(2358).
-p(2360) :- p(2359), q(2360).
-q(2360) :- p(2359), q(2359).
-p(2361) :- p(2360), q(2361).
-q(2361) :- p(2360), q(2360).
-p(2362) :- p(2361), q(2362).
-q(2362) :- p(2361), q(2361).
-p(2363) :- p(2362), q(2363).
-q(2363) :- p(2362), q(2362).
-p(2364) :- p(2363), q(2364).
-q(2364) :- p(2363), q(2363).
-p(2365) :- p(2364), q(2365).
-q(2365) :- p(2364), q(2364).
-p(2366) :- p(2365), q(2366).
-q(2366) :- p(2365), q(2365).
-p(2367) :- p(2366), q(2367).
-q(2367) :- p(2366), q(2366).
-p(2368) :- p(2367), q(2368).
-q(2368) :- p(2367), q(2367).
-p(2369) :- p(2368), q(2369).
-q(2369) :- p(2368), q(2368).
-p(2370) :- p(2369), q(2370).
-q(2370) :- p(2369), q(2369).
-p(2371) :- p(2370), q(2371).
-q(2371) :- p(2370), q(2370).
-p(2372) :- p(2371), q(2372).
-q(2372) :- p(2371), q(2371).
-p(2373) :- p(2372), q(2373).
-q(2373) :- p(2372), q(2372).
-p(2374) :- p(2373), q(2374).
-q(2374) :- p(2373), q(2373).
-p(2375) :- p(2374), q(2375).
-q(2375) :- p(2374), q(2374).
-p(2376) :- p(2375), q(2376).
-q(2376) :- p(2375), q(2375).
-p(2377) :- p(2376), q(2377).
-q(2377) :- p(2376), q(2376).
-p(2378) :- p(2377), q(2378).
-q(2378) :- p(2377), q(2377).
-p(2379) :- p(2378), q(2379).
-q(2379) :- p(2378), q(2378).
-p(2380) :- p(2379), q(2380).
-q(2380) :- p(2379), q(2379).
-p(2381) :- p(2380), q(2381).
-q(2381) :- p(2380), q(2380).
-p(2382) :- p(2381), q(2382).
-q(2382) :- p(2381), q(2381).
-p(2383) :- p(2382), q(2383).
-q(2383) :- p(2382), q(2382).
-p(2384) :- p(2383), q(2384).
-q(2384) :- p(2383), q(2383).
-p(2385) :- p(2384), q(2385).
-q(2385) :- p(2384), q(2384).
-p(2386) :- p(2385), q(2386).
-q(2386) :- p(2385), q(2385).
-p(2387) :- p(2386), q(2387).
-q(2387) :- p(2386), q(2386).
-p(2388) :- p(2387), q(2388).
-q(2388) :- p(2387), q(2387).
-p(2389) :- p(2388), q(2389).
-q(2389) :- p(2388), q(2388).
-p(2390) :- p(2389), q(2390).
-q(2390) :- p(2389), q(2389).
-p(2391) :- p(2390), q(2391).
-q(2391) :- p(2390), q(2390).
-p(2392) :- p(2391), q(2392).
-q(2392) :- p(2391), q(2391).
-p(2393) :- p(2392), q(2393).
-q(2393) :- p(2392), q(2392).
-p(2394) :- p(2393), q(2394).
-q(2394) :- p(2393), q(2393).
-p(2395) :- p(2394), q(2395).
-q(2395) :- p(2394), q(2394).
-p(2396) :- p(2395), q(2396).
-q(2396) :- p(2395), q(2395).
-p(2397) :- p(2396), q(2397).
-q(2397) :- p(2396), q(2396).
-p(2398) :- p(2397), q(2398).
-q(2398) :- p(2397), q(2397).
-p(2399) :- p(2398), q(2399).
-q(2399) :- p(2398), q(2398).
-p(2400) :- p(2399), q(2400).
-q(2400) :- p(2399), q(2399).
-p(2401) :- p(2400), q(2401).
-q(2401) :- p(2400), q(2400).
-p(2402) :- p(2401), q(2402).
-q(2402) :- p(2401), q(2401).
-p(2403) :- p(2402), q(2403).
-q(2403) :- p(2402), q(2402).
-p(2404) :- p(2403), q(2404).
-q(2404) :- p(2403), q(2403).
-p(2405) :- p(2404), q(2405).
-q(2405) :- p(2404), q(2404).
-p(2406) :- p(2405), q(2406).
-q(2406) :- p(2405), q(2405).
-p(2407) :- p(2406), q(2407).
-q(2407) :- p(2406), q(2406).
-p(2408) :- p(2407), q(2408).
-q(2408) :- p(2407), q(2407).
-p(2409) :- p(2408), q(2409).
-q(2409) :- p(2408), q(2408).
-p(2410) :- p(2409), q(2410).
-q(2410) :- p(2409), q(2409).
-p(2411) :- p(2410), q(2411).
-q(2411) :- p(2410), q(2410).
-p(2412) :- p(2411), q(2412).
-q(2412) :- p(2411), q(2411).
-p(2413) :- p(2412), q(2413).
-q(2413) :- p(2412), q(2412).
-p(2414) :- p(2413), q(2414).
-q(2414) :- p(2413), q(2413).
-p(2415) :- p(2414), q(2415).
-q(2415) :- p(2414), q(2414).
-p(2416) :- p(2415), q(2416).
-q(2416) :- p(2415), q(2415).
-p(2417) :- p(2416), q(2417).
-q(2417) :- p(2416), q(2416).
-p(2418) :- p(2417), q(2418).
-q(2418) :- p(2417), q(2417).
-p(2419) :- p(2418), q(2419).
-q(2419) :- p(2418), q(2418).
-p(2420) :- p(2419), q(2420).
-q(2420) :- p(2419), q(2419).
-p(2421) :- p(2420), q(2421).
-q(2421) :- p(2420), q(2420).
-p(2422) :- p(2421), q(2422).
-q(2422) :- p(2421), q(2421).
-p(2423) :- p(2422), q(2423).
-q(2423) :- p(2422), q(2422).
-p(2424) :- p(2423), q(2424).
-q(2424) :- p(2423), q(2423).
-p(2425) :- p(2424), q(2425).
-q(2425) :- p(2424), q(2424).
-p(2426) :- p(2425), q(2426).
-q(2426) :- p(2425), q(2425).
-p(2427) :- p(2426), q(2427).
-q(2427) :- p(2426), q(2426).
-p(2428) :- p(2427), q(2428).
-q(2428) :- p(2427), q(2427).
-p(2429) :- p(2428), q(2429).
-q(2429) :- p(2428), q(2428).
-p(2430) :- p(2429), q(2430).
-q(2430) :- p(2429), q(2429).
-p(2431) :- p(2430), q(2431).
-q(2431) :- p(2430), q(2430).
-p(2432) :- p(2431), q(2432).
-q(2432) :- p(2431), q(2431).
-p(2433) :- p(2432), q(2433).
-q(2433) :- p(2432), q(2432).
-p(2434) :- p(2433), q(2434).
-q(2434) :- p(2433), q(2433).
-p(2435) :- p(2434), q(2435).
-q(2435) :- p(2434), q(2434).
-p(2436) :- p(2435), q(2436).
-q(2436) :- p(2435), q(2435).
-p(2437) :- p(2436), q(2437).
-q(2437) :- p(2436), q(2436).
-p(2438) :- p(2437), q(2438).
-q(2438) :- p(2437), q(2437).
-p(2439) :- p(2438), q(2439).
-q(2439) :- p(2438), q(2438).
-p(2440) :- p(2439), q(2440).
-q(2440) :- p(2439), q(2439).
-p(2441) :- p(2440), q(2441).
-q(2441) :- p(2440), q(2440).
-p(2442) :- p(2441), q(2442).
-q(2442) :- p(2441), q(2441).
-p(2443) :- p(2442), q(2443).
-q(2443) :- p(2442), q(2442).
-p(2444) :- p(2443), q(2444).
-q(2444) :- p(2443), q(2443).
-p(2445) :- p(2444), q(2445).
-q(2445) :- p(2444), q(2444).
-p(2446) :- p(2445), q(2446).
-q(2446) :- p(2445), q(2445).
-p(2447) :- p(2446), q(2447).
-q(2447) :- p(2446), q(2446).
-p(2448) :- p(2447), q(2448).
-q(2448) :- p(2447), q(2447).
-p(2449) :- p(2448), q(2449).
-q(2449) :- p(2448), q(2448).
-p(2450) :- p(2449), q(2450).
-q(2450) :- p(2449), q(2449).
-p(2451) :- p(2450), q(2451).
-q(2451) :- p(2450), q(2450).
-p(2452) :- p(2451), q(2452).
-q(2452) :- p(2451), q(2451).
-p(2453) :- p(2452), q(2453).
-q(2453) :- p(2452), q(2452).
-p(2454) :- p(2453), q(2454).
-q(2454) :- p(2453), q(2453).
-p(2455) :- p(2454), q(2455).
-q(2455) :- p(2454), q(2454).
-p(2456) :- p(2455), q(2456).
-q(2456) :- p(2455), q(2455).
-p(2457) :- p(2456), q(2457).
-q(2457) :- p(2456), q(2456).
-p(2458) :- p(2457), q(2458).
-q(2458) :- p(2457), q(2457).
-p(2459) :- p(2458), q(2459).
-q(2459) :- p(2458), q(2458).
-p(2460) :- p(2459), q(2460).
-q(2460) :- p(2459), q(2459).
-p(2461) :- p(2460), q(2461).
-q(2461) :- p(2460), q(2460).
-p(2462) :- p(2461), q(2462).
-q(2462) :- p(2461), q(2461).
-p(2463) :- p(2462), q(2463).
-q(2463) :- p(2462), q(2462).
-p(2464) :- p(2463), q(2464).
-q(2464) :- p(2463), q(2463).
-p(2465) :- p(2464), q(2465).
-q(2465) :- p(2464), q(2464).
-p(2466) :- p(2465), q(2466).
-q(2466) :- p(2465), q(2465).
-p(2467) :- p(2466), q(2467).
-q(2467) :- p(2466), q(2466).
-p(2468) :- p(2467), q(2468).
-q(2468) :- p(2467), q(2467).
-p(2469) :- p(2468), q(2469).
-q(2469) :- p(2468), q(2468).
-p(2470) :- p(2469), q(2470).
-q(2470) :- p(2469), q(2469).
-p(2471) :- p(2470), q(2471).
-q(2471) :- p(2470), q(2470).
-p(2472) :- p(2471), q(2472).
-q(2472) :- p(2471), q(2471).
-p(2473) :- p(2472), q(2473).
-q(2473) :- p(2472), q(2472).
-p(2474) :- p(2473), q(2474).
-q(2474) :- p(2473), q(2473).
-p(2475) :- p(2474), q(2475).
-q(2475) :- p(2474), q(2474).
-p(2476) :- p(2475), q(2476).
-q(2476) :- p(2475), q(2475).
-p(2477) :- p(2476), q(2477).
-q(2477) :- p(2476), q(2476).
-p(2478) :- p(2477), q(2478).
-q(2478) :- p(2477), q(2477).
-p(2479) :- p(2478), q(2479).
-q(2479) :- p(2478), q(2478).
-p(2480) :- p(2479), q(2480).
-q(2480) :- p(2479), q(2479).
-p(2481) :- p(2480), q(2481).
-q(2481) :- p(2480), q(2480).
-p(2482) :- p(2481), q(2482).
-q(2482) :- p(2481), q(2481).
-p(2483) :- p(2482), q(2483).
-q(2483) :- p(2482), q(2482).
-p(2484) :- p(2483), q(2484).
-q(2484) :- p(2483), q(2483).
-p(2485) :- p(2484), q(2485).
-q(2485) :- p(2484), q(2484).
-p(2486) :- p(2485), q(2486).
-q(2486) :- p(2485), q(2485).
-p(2487) :- p(2486), q(2487).
-q(2487) :- p(2486), q(2486).
-p(2488) :- p(2487), q(2488).
-q(2488) :- p(2487), q(2487).
-p(2489) :- p(2488), q(2489).
-q(2489) :- p(2488), q(2488).
-p(2490) :- p(2489), q(2490).
-q(2490) :- p(2489), q(2489).
-p(2491) :- p(2490), q(2491).
-q(2491) :- p(2490), q(2490).
-p(2492) :- p(2491), q(2492).
-q(2492) :- p(2491), q(2491).
-p(2493) :- p(2492), q(2493).
-q(2493) :- p(2492), q(2492).
-p(2494) :- p(2493), q(2494).
-q(2494) :- p(2493), q(2493).
-p(2495) :- p(2494), q(2495).
-q(2495) :- p(2494), q(2494).
-p(2496) :- p(2495), q(2496).
-q(2496) :- p(2495), q(2495).
-p(2497) :- p(2496), q(2497).
-q(2497) :- p(2496), q(2496).
-p(2498) :- p(2497), q(2498).
-q(2498) :- p(2497), q(2497).
-p(2499) :- p(2498), q(2499).
-q(2499) :- p(2498), q(2498).
-p(2500) :- p(2499), q(2500).
-q(2500) :- p(2499), q(2499).
-p(2501) :- p(2500), q(2501).
-q(2501) :- p(2500), q(2500).
-p(2502) :- p(2501), q(2502).
-q(2502) :- p(2501), q(2501).
-p(2503) :- p(2502), q(2503).
-q(2503) :- p(2502), q(2502).
-p(2504) :- p(2503), q(2504).
-q(2504) :- p(2503), q(2503).
-p(2505) :- p(2504), q(2505).
-q(2505) :- p(2504), q(2504).
-p(2506) :- p(2505), q(2506).
-q(2506) :- p(2505), q(2505).
-p(2507) :- p(2506), q(2507).
-q(2507) :- p(2506), q(2506).
-p(2508) :- p(2507), q(2508).
-q(2508) :- p(2507), q(2507).
-p(2509) :- p(2508), q(2509).
-q(2509) :- p(2508), q(2508).
-p(2510) :- p(2509), q(2510).
-q(2510) :- p(2509), q(2509).
-p(2511) :- p(2510), q(2511).
-q(2511) :- p(2510), q(2510).
-p(2512) :- p(2511), q(2512).
-q(2512) :- p(2511), q(2511).
-p(2513) :- p(2512), q(2513).
-q(2513) :- p(2512), q(2512).
-p(2514) :- p(2513), q(2514).
-q(2514) :- p(2513), q(2513).
-p(2515) :- p(2514), q(2515).
-q(2515) :- p(2514), q(2514).
-p(2516) :- p(2515), q(2516).
-q(2516) :- p(2515), q(2515).
-p(2517) :- p(2516), q(2517).
-q(2517) :- p(2516), q(2516).
-p(2518) :- p(2517), q(2518).
-q(2518) :- p(2517), q(2517).
-p(2519) :- p(2518), q(2519).
-q(2519) :- p(2518), q(2518).
-p(2520) :- p(2519), q(2520).
-q(2520) :- p(2519), q(2519).
-p(2521) :- p(2520), q(2521).
-q(2521) :- p(2520), q(2520).
-p(2522) :- p(2521), q(2522).
-q(2522) :- p(2521), q(2521).
-p(2523) :- p(2522), q(2523).
-q(2523) :- p(2522), q(2522).
-p(2524) :- p(2523), q(2524).
-q(2524) :- p(2523), q(2523).
-p(2525) :- p(2524), q(2525).
-q(2525) :- p(2524), q(2524).
-p(2526) :- p(2525), q(2526).
-q(2526) :- p(2525), q(2525).
-p(2527) :- p(2526), q(2527).
-q(2527) :- p(2526), q(2526).
-p(2528) :- p(2527), q(2528).
-q(2528) :- p(2527), q(2527).
-p(2529) :- p(2528), q(2529).
-q(2529) :- p(2528), q(2528).
-p(2530) :- p(2529), q(2530).
-q(2530) :- p(2529), q(2529).
-p(2531) :- p(2530), q(2531).
-q(2531) :- p(2530), q(2530).
-p(2532) :- p(2531), q(2532).
-q(2532) :- p(2531), q(2531).
-p(2533) :- p(2532), q(2533).
-q(2533) :- p(2532), q(2532).
-p(2534) :- p(2533), q(2534).
-q(2534) :- p(2533), q(2533).
-p(2535) :- p(2534), q(2535).
-q(2535) :- p(2534), q(2534).
-p(2536) :- p(2535), q(2536).
-q(2536) :- p(2535), q(2535).
-p(2537) :- p(2536), q(2537).
-q(2537) :- p(2536), q(2536).
-p(2538) :- p(2537), q(2538).
-q(2538) :- p(2537), q(2537).
-p(2539) :- p(2538), q(2539).
-q(2539) :- p(2538), q(2538).
-p(2540) :- p(2539), q(2540).
-q(2540) :- p(2539), q(2539).
-p(2541) :- p(2540), q(2541).
-q(2541) :- p(2540), q(2540).
-p(2542) :- p(2541), q(2542).
-q(2542) :- p(2541), q(2541).
-p(2543) :- p(2542), q(2543).
-q(2543) :- p(2542), q(2542).
-p(2544) :- p(2543), q(2544).
-q(2544) :- p(2543), q(2543).
-p(2545) :- p(2544), q(2545).
-q(2545) :- p(2544), q(2544).
-p(2546) :- p(2545), q(2546).
-q(2546) :- p(2545), q(2545).
-p(2547) :- p(2546), q(2547).
-q(2547) :- p(2546), q(2546).
-p(2548) :- p(2547), q(2548).
-q(2548) :- p(2547), q(2547).
-p(2549) :- p(2548), q(2549).
-q(2549) :- p(2548), q(2548).
-p(2550) :- p(2549), q(2550).
-q(2550) :- p(2549), q(2549).
-p(2551) :- p(2550), q(2551).
-q(2551) :- p(2550), q(2550).
-p(2552) :- p(2551), q(2552).
-q(2552) :- p(2551), q(2551).
-p(2553) :- p(2552), q(2553).
-q(2553) :- p(2552), q(2552).
-p(2554) :- p(2553), q(2554).
-q(2554) :- p(2553), q(2553).
-p(2555) :- p(2554), q(2555).
-q(2555) :- p(2554), q(2554).
-p(2556) :- p(2555), q(2556).
-q(2556) :- p(2555), q(2555).
-p(2557) :- p(2556), q(2557).
-q(2557) :- p(2556), q(2556).
-p(2558) :- p(2557), q(2558).
-q(2558) :- p(2557), q(2557).
-p(2559) :- p(2558), q(2559).
-q(2559) :- p(2558), q(2558).
-p(2560) :- p(2559), q(2560).
-q(2560) :- p(2559), q(2559).
-p(2561) :- p(2560), q(2561).
-q(2561) :- p(2560), q(2560).
-p(2562) :- p(2561), q(2562).
-q(2562) :- p(2561), q(2561).
-p(2563) :- p(2562), q(2563).
-q(2563) :- p(2562), q(2562).
-p(2564) :- p(2563), q(2564).
-q(2564) :- p(2563), q(2563).
-p(2565) :- p(2564), q(2565).
-q(2565) :- p(2564), q(2564).
-p(2566) :- p(2565), q(2566).
-q(2566) :- p(2565), q(2565).
-p(2567) :- p(2566), q(2567).
-q(2567) :- p(2566), q(2566).
-p(2568) :- p(2567), q(2568).
-q(2568) :- p(2567), q(2567).
-p(2569) :- p(2568), q(2569).
-q(2569) :- p(2568), q(2568).
-p(2570) :- p(2569), q(2570).
-q(2570) :- p(2569), q(2569).
-p(2571) :- p(2570), q(2571).
-q(2571) :- p(2570), q(2570).
-p(2572) :- p(2571), q(2572).
-q(2572) :- p(2571), q(2571).
-p(2573) :- p(2572), q(2573).
-q(2573) :- p(2572), q(2572).
-p(2574) :- p(2573), q(2574).
-q(2574) :- p(2573), q(2573).
-p(2575) :- p(2574), q(2575).
-q(2575) :- p(2574), q(2574).
-p(2576) :- p(2575), q(2576).
-q(2576) :- p(2575), q(2575).
-p(2577) :- p(2576), q(2577).
-q(2577) :- p(2576), q(2576).
-p(2578) :- p(2577), q(2578).
-q(2578) :- p(2577), q(2577).
-p(2579) :- p(2578), q(2579).
-q(2579) :- p(2578), q(2578).
-p(2580) :- p(2579), q(2580).
-q(2580) :- p(2579), q(2579).
-p(2581) :- p(2580), q(2581).
-q(2581) :- p(2580), q(2580).
-p(2582) :- p(2581), q(2582).
-q(2582) :- p(2581), q(2581).
-p(2583) :- p(2582), q(2583).
-q(2583) :- p(2582), q(2582).
-p(2584) :- p(2583), q(2584).
-q(2584) :- p(2583), q(2583).
-p(2585) :- p(2584), q(2585).
-q(2585) :- p(2584), q(2584).
-p(2586) :- p(2585), q(2586).
-q(2586) :- p(2585), q(2585).
-p(2587) :- p(2586), q(2587).
-q(2587) :- p(2586), q(2586).
-p(2588) :- p(2587), q(2588).
-q(2588) :- p(2587), q(2587).
-p(2589) :- p(2588), q(2589).
-q(2589) :- p(2588), q(2588).
-p(2590) :- p(2589), q(2590).
-q(2590) :- p(2589), q(2589).
-p(2591) :- p(2590), q(2591).
-q(2591) :- p(2590), q(2590).
-p(2592) :- p(2591), q(2592).
-q(2592) :- p(2591), q(2591).
-p(2593) :- p(2592), q(2593).
-q(2593) :- p(2592), q(2592).
-p(2594) :- p(2593), q(2594).
-q(2594) :- p(2593), q(2593).
-p(2595) :- p(2594), q(2595).
-q(2595) :- p(2594), q(2594).
-p(2596) :- p(2595), q(2596).
-q(2596) :- p(2595), q(2595).
-p(2597) :- p(2596), q(2597).
-q(2597) :- p(2596), q(2596).
-p(2598) :- p(2597), q(2598).
-q(2598) :- p(2597), q(2597).
-p(2599) :- p(2598), q(2599).
-q(2599) :- p(2598), q(2598).
-p(2600) :- p(2599), q(2600).
-q(2600) :- p(2599), q(2599).
-p(2601) :- p(2600), q(2601).
-q(2601) :- p(2600), q(2600).
-p(2602) :- p(2601), q(2602).
-q(2602) :- p(2601), q(2601).
-p(2603) :- p(2602), q(2603).
-q(2603) :- p(2602), q(2602).
-p(2604) :- p(2603), q(2604).
-q(2604) :- p(2603), q(2603).
-p(2605) :- p(2604), q(2605).
-q(2605) :- p(2604), q(2604).
-p(2606) :- p(2605), q(2606).
-q(2606) :- p(2605), q(2605).
-p(2607) :- p(2606), q(2607).
-q(2607) :- p(2606), q(2606).
-p(2608) :- p(2607), q(2608).
-q(2608) :- p(2607), q(2607).
-p(2609) :- p(2608), q(2609).
-q(2609) :- p(2608), q(2608).
-p(2610) :- p(2609), q(2610).
-q(2610) :- p(2609), q(2609).
-p(2611) :- p(2610), q(2611).
-q(2611) :- p(2610), q(2610).
-p(2612) :- p(2611), q(2612).
-q(2612) :- p(2611), q(2611).
-p(2613) :- p(2612), q(2613).
-q(2613) :- p(2612), q(2612).
-p(2614) :- p(2613), q(2614).
-q(2614) :- p(2613), q(2613).
-p(2615) :- p(2614), q(2615).
-q(2615) :- p(2614), q(2614).
-p(2616) :- p(2615), q(2616).
-q(2616) :- p(2615), q(2615).
-p(2617) :- p(2616), q(2617).
-q(2617) :- p(2616), q(2616).
-p(2618) :- p(2617), q(2618).
-q(2618) :- p(2617), q(2617).
-p(2619) :- p(2618), q(2619).
-q(2619) :- p(2618), q(2618).
-p(2620) :- p(2619), q(2620).
-q(2620) :- p(2619), q(2619).
-p(2621) :- p(2620), q(2621).
-q(2621) :- p(2620), q(2620).
-p(2622) :- p(2621), q(2622).
-q(2622) :- p(2621), q(2621).
-p(2623) :- p(2622), q(2623).
-q(2623) :- p(2622), q(2622).
-p(2624) :- p(2623), q(2624).
-q(2624) :- p(2623), q(2623).
-p(2625) :- p(2624), q(2625).
-q(2625) :- p(2624), q(2624).
-p(2626) :- p(2625), q(2626).
-q(2626) :- p(2625), q(2625).
-p(2627) :- p(2626), q(2627).
-q(2627) :- p(2626), q(2626).
-p(2628) :- p(2627), q(2628).
-q(2628) :- p(2627), q(2627).
-p(2629) :- p(2628), q(2629).
-q(2629) :- p(2628), q(2628).
-p(2630) :- p(2629), q(2630).
-q(2630) :- p(2629), q(2629).
-p(2631) :- p(2630), q(2631).
-q(2631) :- p(2630), q(2630).
-p(2632) :- p(2631), q(2632).
-q(2632) :- p(2631), q(2631).
-p(2633) :- p(2632), q(2633).
-q(2633) :- p(2632), q(2632).
-p(2634) :- p(2633), q(2634).
-q(2634) :- p(2633), q(2633).
-p(2635) :- p(2634), q(2635).
-q(2635) :- p(2634), q(2634).
-p(2636) :- p(2635), q(2636).
-q(2636) :- p(2635), q(2635).
-p(2637) :- p(2636), q(2637).
-q(2637) :- p(2636), q(2636).
-p(2638) :- p(2637), q(2638).
-q(2638) :- p(2637), q(2637).
-p(2639) :- p(2638), q(2639).
-q(2639) :- p(2638), q(2638).
-p(2640) :- p(2639), q(2640).
-q(2640) :- p(2639), q(2639).
-p(2641) :- p(2640), q(2641).
-q(2641) :- p(2640), q(2640).
-p(2642) :- p(2641), q(2642).
-q(2642) :- p(2641), q(2641).
-p(2643) :- p(2642), q(2643).
-q(2643) :- p(2642), q(2642).
-p(2644) :- p(2643), q(2644).
-q(2644) :- p(2643), q(2643).
-p(2645) :- p(2644), q(2645).
-q(2645) :- p(2644), q(2644).
-p(2646) :- p(2645), q(2646).
-q(2646) :- p(2645), q(2645).
-p(2647) :- p(2646), q(2647).
-q(2647) :- p(2646), q(2646).
-p(2648) :- p(2647), q(2648).
-q(2648) :- p(2647), q(2647).
-p(2649) :- p(2648), q(2649).
-q(2649) :- p(2648), q(2648).
-p(2650) :- p(2649), q(2650).
-q(2650) :- p(2649), q(2649).
-p(2651) :- p(2650), q(2651).
-q(2651) :- p(2650), q(2650).
-p(2652) :- p(2651), q(2652).
-q(2652) :- p(2651), q(2651).
-p(2653) :- p(2652), q(2653).
-q(2653) :- p(2652), q(2652).
-p(2654) :- p(2653), q(2654).
-q(2654) :- p(2653), q(2653).
-p(2655) :- p(2654), q(2655).
-q(2655) :- p(2654), q(2654).
-p(2656) :- p(2655), q(2656).
-q(2656) :- p(2655), q(2655).
-p(2657) :- p(2656), q(2657).
-q(2657) :- p(2656), q(2656).
-p(2658) :- p(2657), q(2658).
-q(2658) :- p(2657), q(2657).
-p(2659) :- p(2658), q(2659).
-q(2659) :- p(2658), q(2658).
-p(2660) :- p(2659), q(2660).
-q(2660) :- p(2659), q(2659).
-p(2661) :- p(2660), q(2661).
-q(2661) :- p(2660), q(2660).
-p(2662) :- p(2661), q(2662).
-q(2662) :- p(2661), q(2661).
-p(2663) :- p(2662), q(2663).
-q(2663) :- p(2662), q(2662).
-p(2664) :- p(2663), q(2664).
-q(2664) :- p(2663), q(2663).
-p(2665) :- p(2664), q(2665).
-q(2665) :- p(2664), q(2664).
-p(2666) :- p(2665), q(2666).
-q(2666) :- p(2665), q(2665).
-p(2667) :- p(2666), q(2667).
-q(2667) :- p(2666), q(2666).
-p(2668) :- p(2667), q(2668).
-q(2668) :- p(2667), q(2667).
-p(2669) :- p(2668), q(2669).
-q(2669) :- p(2668), q(2668).
-p(2670) :- p(2669), q(2670).
-q(2670) :- p(2669), q(2669).
-p(2671) :- p(2670), q(2671).
-q(2671) :- p(2670), q(2670).
-p(2672) :- p(2671), q(2672).
-q(2672) :- p(2671), q(2671).
-p(2673) :- p(2672), q(2673).
-q(2673) :- p(2672), q(2672).
-p(2674) :- p(2673), q(2674).
-q(2674) :- p(2673), q(2673).
-p(2675) :- p(2674), q(2675).
-q(2675) :- p(2674), q(2674).
-p(2676) :- p(2675), q(2676).
-q(2676) :- p(2675), q(2675).
-p(2677) :- p(2676), q(2677).
-q(2677) :- p(2676), q(2676).
-p(2678) :- p(2677), q(2678).
-q(2678) :- p(2677), q(2677).
-p(2679) :- p(2678), q(2679).
-q(2679) :- p(2678), q(2678).
-p(2680) :- p(2679), q(2680).
-q(2680) :- p(2679), q(2679).
-p(2681) :- p(2680), q(2681).
-q(2681) :- p(2680), q(2680).
-p(2682) :- p(2681), q(2682).
-q(2682) :- p(2681), q(2681).
-p(2683) :- p(2682), q(2683).
-q(2683) :- p(2682), q(2682).
-p(2684) :- p(2683), q(2684).
-q(2684) :- p(2683), q(2683).
-p(2685) :- p(2684), q(2685).
-q(2685) :- p(2684), q(2684).
-p(2686) :- p(2685), q(2686).
-q(2686) :- p(2685), q(2685).
-p(2687) :- p(2686), q(2687).
-q(2687) :- p(2686), q(2686).
-p(2688) :- p(2687), q(2688).
-q(2688) :- p(2687), q(2687).
-p(2689) :- p(2688), q(2689).
-q(2689) :- p(2688), q(2688).
-p(2690) :- p(2689), q(2690).
-q(2690) :- p(2689), q(2689).
-p(2691) :- p(2690), q(2691).
-q(2691) :- p(2690), q(2690).
-p(2692) :- p(2691), q(2692).
-q(2692) :- p(2691), q(2691).
-p(2693) :- p(2692), q(2693).
-q(2693) :- p(2692), q(2692).
-p(2694) :- p(2693), q(2694).
-q(2694) :- p(2693), q(2693).
-p(2695) :- p(2694), q(2695).
-q(2695) :- p(2694), q(2694).
-p(2696) :- p(2695), q(2696).
-q(2696) :- p(2695), q(2695).
-p(2697) :- p(2696), q(2697).
-q(2697) :- p(2696), q(2696).
-p(2698) :- p(2697), q(2698).
-q(2698) :- p(2697), q(2697).
-p(2699) :- p(2698), q(2699).
-q(2699) :- p(2698), q(2698).
-p(2700) :- p(2699), q(2700).
-q(2700) :- p(2699), q(2699).
-p(2701) :- p(2700), q(2701).
-q(2701) :- p(2700), q(2700).
-p(2702) :- p(2701), q(2702).
-q(2702) :- p(2701), q(2701).
-p(2703) :- p(2702), q(2703).
-q(2703) :- p(2702), q(2702).
-p(2704) :- p(2703), q(2704).
-q(2704) :- p(2703), q(2703).
-p(2705) :- p(2704), q(2705).
-q(2705) :- p(2704), q(2704).
-p(2706) :- p(2705), q(2706).
-q(2706) :- p(2705), q(2705).
-p(2707) :- p(2706), q(2707).
-q(2707) :- p(2706), q(2706).
-p(2708) :- p(2707), q(2708).
-q(2708) :- p(2707), q(2707).
-p(2709) :- p(2708), q(2709).
-q(2709) :- p(2708), q(2708).
-p(2710) :- p(2709), q(2710).
-q(2710) :- p(2709), q(2709).
-p(2711) :- p(2710), q(2711).
-q(2711) :- p(2710), q(2710).
-p(2712) :- p(2711), q(2712).
-q(2712) :- p(2711), q(2711).
-p(2713) :- p(2712), q(2713).
-q(2713) :- p(2712), q(2712).
-p(2714) :- p(2713), q(2714).
-q(2714) :- p(2713), q(2713).
-p(2715) :- p(2714), q(2715).
-q(2715) :- p(2714), q(2714).
-p(2716) :- p(2715), q(2716).
-q(2716) :- p(2715), q(2715).
-p(2717) :- p(2716), q(2717).
-q(2717) :- p(2716), q(2716).
-p(2718) :- p(2717), q(2718).
-q(2718) :- p(2717), q(2717).
-p(2719) :- p(2718), q(2719).
-q(2719) :- p(2718), q(2718).
-p(2720) :- p(2719), q(2720).
-q(2720) :- p(2719), q(2719).
-p(2721) :- p(2720), q(2721).
-q(2721) :- p(2720), q(2720).
-p(2722) :- p(2721), q(2722).
-q(2722) :- p(2721), q(2721).
-p(2723) :- p(2722), q(2723).
-q(2723) :- p(2722), q(2722).
-p(2724) :- p(2723), q(2724).
-q(2724) :- p(2723), q(2723).
-p(2725) :- p(2724), q(2725).
-q(2725) :- p(2724), q(2724).
-p(2726) :- p(2725), q(2726).
-q(2726) :- p(2725), q(2725).
-p(2727) :- p(2726), q(2727).
-q(2727) :- p(2726), q(2726).
-p(2728) :- p(2727), q(2728).
-q(2728) :- p(2727), q(2727).
-p(2729) :- p(2728), q(2729).
-q(2729) :- p(2728), q(2728).
-p(2730) :- p(2729), q(2730).
-q(2730) :- p(2729), q(2729).
-p(2731) :- p(2730), q(2731).
-q(2731) :- p(2730), q(2730).
-p(2732) :- p(2731), q(2732).
-q(2732) :- p(2731), q(2731).
-p(2733) :- p(2732), q(2733).
-q(2733) :- p(2732), q(2732).
-p(2734) :- p(2733), q(2734).
-q(2734) :- p(2733), q(2733).
-p(2735) :- p(2734), q(2735).
-q(2735) :- p(2734), q(2734).
-p(2736) :- p(2735), q(2736).
-q(2736) :- p(2735), q(2735).
-p(2737) :- p(2736), q(2737).
-q(2737) :- p(2736), q(2736).
-p(2738) :- p(2737), q(2738).
-q(2738) :- p(2737), q(2737).
-p(2739) :- p(2738), q(2739).
-q(2739) :- p(2738), q(2738).
-p(2740) :- p(2739), q(2740).
-q(2740) :- p(2739), q(2739).
-p(2741) :- p(2740), q(2741).
-q(2741) :- p(2740), q(2740).
-p(2742) :- p(2741), q(2742).
-q(2742) :- p(2741), q(2741).
-p(2743) :- p(2742), q(2743).
-q(2743) :- p(2742), q(2742).
-p(2744) :- p(2743), q(2744).
-q(2744) :- p(2743), q(2743).
-p(2745) :- p(2744), q(2745).
-q(2745) :- p(2744), q(2744).
-p(2746) :- p(2745), q(2746).
-q(2746) :- p(2745), q(2745).
-p(2747) :- p(2746), q(2747).
-q(2747) :- p(2746), q(2746).
-p(2748) :- p(2747), q(2748).
-q(2748) :- p(2747), q(2747).
-p(2749) :- p(2748), q(2749).
-q(2749) :- p(2748), q(2748).
-p(2750) :- p(2749), q(2750).
-q(2750) :- p(2749), q(2749).
-p(2751) :- p(2750), q(2751).
-q(2751) :- p(2750), q(2750).
-p(2752) :- p(2751), q(2752).
-q(2752) :- p(2751), q(2751).
-p(2753) :- p(2752), q(2753).
-q(2753) :- p(2752), q(2752).
-p(2754) :- p(2753), q(2754).
-q(2754) :- p(2753), q(2753).
-p(2755) :- p(2754), q(2755).
-q(2755) :- p(2754), q(2754).
-p(2756) :- p(2755), q(2756).
-q(2756) :- p(2755), q(2755).
-p(2757) :- p(2756), q(2757).
-q(2757) :- p(2756), q(2756).
-p(2758) :- p(2757), q(2758).
-q(2758) :- p(2757), q(2757).
-p(2759) :- p(2758), q(2759).
-q(2759) :- p(2758), q(2758).
-p(2760) :- p(2759), q(2760).
-q(2760) :- p(2759), q(2759).
-p(2761) :- p(2760), q(2761).
-q(2761) :- p(2760), q(2760).
-p(2762) :- p(2761), q(2762).
-q(2762) :- p(2761), q(2761).
-p(2763) :- p(2762), q(2763).
-q(2763) :- p(2762), q(2762).
-p(2764) :- p(2763), q(2764).
-q(2764) :- p(2763), q(2763).
-p(2765) :- p(2764), q(2765).
-q(2765) :- p(2764), q(2764).
-p(2766) :- p(2765), q(2766).
-q(2766) :- p(2765), q(2765).
-p(2767) :- p(2766), q(2767).
-q(2767) :- p(2766), q(2766).
-p(2768) :- p(2767), q(2768).
-q(2768) :- p(2767), q(2767).
-p(2769) :- p(2768), q(2769).
-q(2769) :- p(2768), q(2768).
-p(2770) :- p(2769), q(2770).
-q(2770) :- p(2769), q(2769).
-p(2771) :- p(2770), q(2771).
-q(2771) :- p(2770), q(2770).
-p(2772) :- p(2771), q(2772).
-q(2772) :- p(2771), q(2771).
-p(2773) :- p(2772), q(2773).
-q(2773) :- p(2772), q(2772).
-p(2774) :- p(2773), q(2774).
-q(2774) :- p(2773), q(2773).
-p(2775) :- p(2774), q(2775).
-q(2775) :- p(2774), q(2774).
-p(2776) :- p(2775), q(2776).
-q(2776) :- p(2775), q(2775).
-p(2777) :- p(2776), q(2777).
-q(2777) :- p(2776), q(2776).
-p(2778) :- p(2777), q(2778).
-q(2778) :- p(2777), q(2777).
-p(2779) :- p(2778), q(2779).
-q(2779) :- p(2778), q(2778).
-p(2780) :- p(2779), q(2780).
-q(2780) :- p(2779), q(2779).
-p(2781) :- p(2780), q(2781).
-q(2781) :- p(2780), q(2780).
-p(2782) :- p(2781), q(2782).
-q(2782) :- p(2781), q(2781).
-p(2783) :- p(2782), q(2783).
-q(2783) :- p(2782), q(2782).
-p(2784) :- p(2783), q(2784).
-q(2784) :- p(2783), q(2783).
-p(2785) :- p(2784), q(2785).
-q(2785) :- p(2784), q(2784).
-p(2786) :- p(2785), q(2786).
-q(2786) :- p(2785), q(2785).
-p(2787) :- p(2786), q(2787).
-q(2787) :- p(2786), q(2786).
-p(2788) :- p(2787), q(2788).
-q(2788) :- p(2787), q(2787).
-p(2789) :- p(2788), q(2789).
-q(2789) :- p(2788), q(2788).
-p(2790) :- p(2789), q(2790).
-q(2790) :- p(2789), q(2789).
-p(2791) :- p(2790), q(2791).
-q(2791) :- p(2790), q(2790).
-p(2792) :- p(2791), q(2792).
-q(2792) :- p(2791), q(2791).
-p(2793) :- p(2792), q(2793).
-q(2793) :- p(2792), q(2792).
-p(2794) :- p(2793), q(2794).
-q(2794) :- p(2793), q(2793).
-p(2795) :- p(2794), q(2795).
-q(2795) :- p(2794), q(2794).
-p(2796) :- p(2795), q(2796).
-q(2796) :- p(2795), q(2795).
-p(2797) :- p(2796), q(2797).
-q(2797) :- p(2796), q(2796).
-p(2798) :- p(2797), q(2798).
-q(2798) :- p(2797), q(2797).
-p(2799) :- p(2798), q(2799).
-q(2799) :- p(2798), q(2798).
-p(2800) :- p(2799), q(2800).
-q(2800) :- p(2799), q(2799).
-p(2801) :- p(2800), q(2801).
-q(2801) :- p(2800), q(2800).
-p(2802) :- p(2801), q(2802).
-q(2802) :- p(2801), q(2801).
-p(2803) :- p(2802), q(2803).
-q(2803) :- p(2802), q(2802).
-p(2804) :- p(2803), q(2804).
-q(2804) :- p(2803), q(2803).
-p(2805) :- p(2804), q(2805).
-q(2805) :- p(2804), q(2804).
-p(2806) :- p(2805), q(2806).
-q(2806) :- p(2805), q(2805).
-p(2807) :- p(2806), q(2807).
-q(2807) :- p(2806), q(2806).
-p(2808) :- p(2807), q(2808).
-q(2808) :- p(2807), q(2807).
-p(2809) :- p(2808), q(2809).
-q(2809) :- p(2808), q(2808).
-p(2810) :- p(2809), q(2810).
-q(2810) :- p(2809), q(2809).
-p(2811) :- p(2810), q(2811).
-q(2811) :- p(2810), q(2810).
-p(2812) :- p(2811), q(2812).
-q(2812) :- p(2811), q(2811).
-p(2813) :- p(2812), q(2813).
-q(2813) :- p(2812), q(2812).
-p(2814) :- p(2813), q(2814).
-q(2814) :- p(2813), q(2813).
-p(2815) :- p(2814), q(2815).
-q(2815) :- p(2814), q(2814).
-p(2816) :- p(2815), q(2816).
-q(2816) :- p(2815), q(2815).
-p(2817) :- p(2816), q(2817).
-q(2817) :- p(2816), q(2816).
-p(2818) :- p(2817), q(2818).
-q(2818) :- p(2817), q(2817).
-p(2819) :- p(2818), q(2819).
-q(2819) :- p(2818), q(2818).
-p(2820) :- p(2819), q(2820).
-q(2820) :- p(2819), q(2819).
-p(2821) :- p(2820), q(2821).
-q(2821) :- p(2820), q(2820).
-p(2822) :- p(2821), q(2822).
-q(2822) :- p(2821), q(2821).
-p(2823) :- p(2822), q(2823).
-q(2823) :- p(2822), q(2822).
-p(2824) :- p(2823), q(2824).
-q(2824) :- p(2823), q(2823).
-p(2825) :- p(2824), q(2825).
-q(2825) :- p(2824), q(2824).
-p(2826) :- p(2825), q(2826).
-q(2826) :- p(2825), q(2825).
-p(2827) :- p(2826), q(2827).
-q(2827) :- p(2826), q(2826).
-p(2828) :- p(2827), q(2828).
-q(2828) :- p(2827), q(2827).
-p(2829) :- p(2828), q(2829).
-q(2829) :- p(2828), q(2828).
-p(2830) :- p(2829), q(2830).
-q(2830) :- p(2829), q(2829).
-p(2831) :- p(2830), q(2831).
-q(2831) :- p(2830), q(2830).
-p(2832) :- p(2831), q(2832).
-q(2832) :- p(2831), q(2831).
-p(2833) :- p(2832), q(2833).
-q(2833) :- p(2832), q(2832).
-p(2834) :- p(2833), q(2834).
-q(2834) :- p(2833), q(2833).
-p(2835) :- p(2834), q(2835).
-q(2835) :- p(2834), q(2834).
-p(2836) :- p(2835), q(2836).
-q(2836) :- p(2835), q(2835).
-p(2837) :- p(2836), q(2837).
-q(2837) :- p(2836), q(2836).
-p(2838) :- p(2837), q(2838).
-q(2838) :- p(2837), q(2837).
-p(2839) :- p(2838), q(2839).
-q(2839) :- p(2838), q(2838).
-p(2840) :- p(2839), q(2840).
-q(2840) :- p(2839), q(2839).
-p(2841) :- p(2840), q(2841).
-q(2841) :- p(2840), q(2840).
-p(2842) :- p(2841), q(2842).
-q(2842) :- p(2841), q(2841).
-p(2843) :- p(2842), q(2843).
-q(2843) :- p(2842), q(2842).
-p(2844) :- p(2843), q(2844).
-q(2844) :- p(2843), q(2843).
-p(2845) :- p(2844), q(2845).
-q(2845) :- p(2844), q(2844).
-p(2846) :- p(2845), q(2846).
-q(2846) :- p(2845), q(2845).
-p(2847) :- p(2846), q(2847).
-q(2847) :- p(2846), q(2846).
-p(2848) :- p(2847), q(2848).
-q(2848) :- p(2847), q(2847).
-p(2849) :- p(2848), q(2849).
-q(2849) :- p(2848), q(2848).
-p(2850) :- p(2849), q(2850).
-q(2850) :- p(2849), q(2849).
-p(2851) :- p(2850), q(2851).
-q(2851) :- p(2850), q(2850).
-p(2852) :- p(2851), q(2852).
-q(2852) :- p(2851), q(2851).
-p(2853) :- p(2852), q(2853).
-q(2853) :- p(2852), q(2852).
-p(2854) :- p(2853), q(2854).
-q(2854) :- p(2853), q(2853).
-p(2855) :- p(2854), q(2855).
-q(2855) :- p(2854), q(2854).
-p(2856) :- p(2855), q(2856).
-q(2856) :- p(2855), q(2855).
-p(2857) :- p(2856), q(2857).
-q(2857) :- p(2856), q(2856).
-p(2858) :- p(2857), q(2858).
-q(2858) :- p(2857), q(2857).
-p(2859) :- p(2858), q(2859).
-q(2859) :- p(2858), q(2858).
-p(2860) :- p(2859), q(2860).
-q(2860) :- p(2859), q(2859).
-p(2861) :- p(2860), q(2861).
-q(2861) :- p(2860), q(2860).
-p(2862) :- p(2861), q(2862).
-q(2862) :- p(2861), q(2861).
-p(2863) :- p(2862), q(2863).
-q(2863) :- p(2862), q(2862).
-p(2864) :- p(2863), q(2864).
-q(2864) :- p(2863), q(2863).
-p(2865) :- p(2864), q(2865).
-q(2865) :- p(2864), q(2864).
-p(2866) :- p(2865), q(2866).
-q(2866) :- p(2865), q(2865).
-p(2867) :- p(2866), q(2867).
-q(2867) :- p(2866), q(2866).
-p(2868) :- p(2867), q(2868).
-q(2868) :- p(2867), q(2867).
-p(2869) :- p(2868), q(2869).
-q(2869) :- p(2868), q(2868).
-p(2870) :- p(2869), q(2870).
-q(2870) :- p(2869), q(2869).
-p(2871) :- p(2870), q(2871).
-q(2871) :- p(2870), q(2870).
-p(2872) :- p(2871), q(2872).
-q(2872) :- p(2871), q(2871).
-p(2873) :- p(2872), q(2873).
-q(2873) :- p(2872), q(2872).
-p(2874) :- p(2873), q(2874).
-q(2874) :- p(2873), q(2873).
-p(2875) :- p(2874), q(2875).
-q(2875) :- p(2874), q(2874).
-p(2876) :- p(2875), q(2876).
-q(2876) :- p(2875), q(2875).
-p(2877) :- p(2876), q(2877).
-q(2877) :- p(2876), q(2876).
-p(2878) :- p(2877), q(2878).
-q(2878) :- p(2877), q(2877).
-p(2879) :- p(2878), q(2879).
-q(2879) :- p(2878), q(2878).
-p(2880) :- p(2879), q(2880).
-q(2880) :- p(2879), q(2879).
-p(2881) :- p(2880), q(2881).
-q(2881) :- p(2880), q(2880).
-p(2882) :- p(2881), q(2882).
-q(2882) :- p(2881), q(2881).
-p(2883) :- p(2882), q(2883).
-q(2883) :- p(2882), q(2882).
-p(2884) :- p(2883), q(2884).
-q(2884) :- p(2883), q(2883).
-p(2885) :- p(2884), q(2885).
-q(2885) :- p(2884), q(2884).
-p(2886) :- p(2885), q(2886).
-q(2886) :- p(2885), q(2885).
-p(2887) :- p(2886), q(2887).
-q(2887) :- p(2886), q(2886).
-p(2888) :- p(2887), q(2888).
-q(2888) :- p(2887), q(2887).
-p(2889) :- p(2888), q(2889).
-q(2889) :- p(2888), q(2888).
-p(2890) :- p(2889), q(2890).
-q(2890) :- p(2889), q(2889).
-p(2891) :- p(2890), q(2891).
-q(2891) :- p(2890), q(2890).
-p(2892) :- p(2891), q(2892).
-q(2892) :- p(2891), q(2891).
-p(2893) :- p(2892), q(2893).
-q(2893) :- p(2892), q(2892).
-p(2894) :- p(2893), q(2894).
-q(2894) :- p(2893), q(2893).
-p(2895) :- p(2894), q(2895).
-q(2895) :- p(2894), q(2894).
-p(2896) :- p(2895), q(2896).
-q(2896) :- p(2895), q(2895).
-p(2897) :- p(2896), q(2897).
-q(2897) :- p(2896), q(2896).
-p(2898) :- p(2897), q(2898).
-q(2898) :- p(2897), q(2897).
-p(2899) :- p(2898), q(2899).
-q(2899) :- p(2898), q(2898).
-p(2900) :- p(2899), q(2900).
-q(2900) :- p(2899), q(2899).
-p(2901) :- p(2900), q(2901).
-q(2901) :- p(2900), q(2900).
-p(2902) :- p(2901), q(2902).
-q(2902) :- p(2901), q(2901).
-p(2903) :- p(2902), q(2903).
-q(2903) :- p(2902), q(2902).
-p(2904) :- p(2903), q(2904).
-q(2904) :- p(2903), q(2903).
-p(2905) :- p(2904), q(2905).
-q(2905) :- p(2904), q(2904).
-p(2906) :- p(2905), q(2906).
-q(2906) :- p(2905), q(2905).
-p(2907) :- p(2906), q(2907).
-q(2907) :- p(2906), q(2906).
-p(2908) :- p(2907), q(2908).
-q(2908) :- p(2907), q(2907).
-p(2909) :- p(2908), q(2909).
-q(2909) :- p(2908), q(2908).
-p(2910) :- p(2909), q(2910).
-q(2910) :- p(2909), q(2909).
-p(2911) :- p(2910), q(2911).
-q(2911) :- p(2910), q(2910).
-p(2912) :- p(2911), q(2912).
-q(2912) :- p(2911), q(2911).
-p(2913) :- p(2912), q(2913).
-q(2913) :- p(2912), q(2912).
-p(2914) :- p(2913), q(2914).
-q(2914) :- p(2913), q(2913).
-p(2915) :- p(2914), q(2915).
-q(2915) :- p(2914), q(2914).
-p(2916) :- p(2915), q(2916).
-q(2916) :- p(2915), q(2915).
-p(2917) :- p(2916), q(2917).
-q(2917) :- p(2916), q(2916).
-p(2918) :- p(2917), q(2918).
-q(2918) :- p(2917), q(2917).
-p(2919) :- p(2918), q(2919).
-q(2919) :- p(2918), q(2918).
-p(2920) :- p(2919), q(2920).
-q(2920) :- p(2919), q(2919).
-p(2921) :- p(2920), q(2921).
-q(2921) :- p(2920), q(2920).
-p(2922) :- p(2921), q(2922).
-q(2922) :- p(2921), q(2921).
-p(2923) :- p(2922), q(2923).
-q(2923) :- p(2922), q(2922).
-p(2924) :- p(2923), q(2924).
-q(2924) :- p(2923), q(2923).
-p(2925) :- p(2924), q(2925).
-q(2925) :- p(2924), q(2924).
-p(2926) :- p(2925), q(2926).
-q(2926) :- p(2925), q(2925).
-p(2927) :- p(2926), q(2927).
-q(2927) :- p(2926), q(2926).
-p(2928) :- p(2927), q(2928).
-q(2928) :- p(2927), q(2927).
-p(2929) :- p(2928), q(2929).
-q(2929) :- p(2928), q(2928).
-p(2930) :- p(2929), q(2930).
-q(2930) :- p(2929), q(2929).
-p(2931) :- p(2930), q(2931).
-q(2931) :- p(2930), q(2930).
-p(2932) :- p(2931), q(2932).
-q(2932) :- p(2931), q(2931).
-p(2933) :- p(2932), q(2933).
-q(2933) :- p(2932), q(2932).
-p(2934) :- p(2933), q(2934).
-q(2934) :- p(2933), q(2933).
-p(2935) :- p(2934), q(2935).
-q(2935) :- p(2934), q(2934).
-p(2936) :- p(2935), q(2936).
-q(2936) :- p(2935), q(2935).
-p(2937) :- p(2936), q(2937).
-q(2937) :- p(2936), q(2936).
-p(2938) :- p(2937), q(2938).
-q(2938) :- p(2937), q(2937).
-p(2939) :- p(2938), q(2939).
-q(2939) :- p(2938), q(2938).
-p(2940) :- p(2939), q(2940).
-q(2940) :- p(2939), q(2939).
-p(2941) :- p(2940), q(2941).
-q(2941) :- p(2940), q(2940).
-p(2942) :- p(2941), q(2942).
-q(2942) :- p(2941), q(2941).
-p(2943) :- p(2942), q(2943).
-q(2943) :- p(2942), q(2942).
-p(2944) :- p(2943), q(2944).
-q(2944) :- p(2943), q(2943).
-p(2945) :- p(2944), q(2945).
-q(2945) :- p(2944), q(2944).
-p(2946) :- p(2945), q(2946).
-q(2946) :- p(2945), q(2945).
-p(2947) :- p(2946), q(2947).
-q(2947) :- p(2946), q(2946).
-p(2948) :- p(2947), q(2948).
-q(2948) :- p(2947), q(2947).
-p(2949) :- p(2948), q(2949).
-q(2949) :- p(2948), q(2948).
-p(2950) :- p(2949), q(2950).
-q(2950) :- p(2949), q(2949).
-p(2951) :- p(2950), q(2951).
-q(2951) :- p(2950), q(2950).
-p(2952) :- p(2951), q(2952).
-q(2952) :- p(2951), q(2951).
-p(2953) :- p(2952), q(2953).
-q(2953) :- p(2952), q(2952).
-p(2954) :- p(2953), q(2954).
-q(2954) :- p(2953), q(2953).
-p(2955) :- p(2954), q(2955).
-q(2955) :- p(2954), q(2954).
-p(2956) :- p(2955), q(2956).
-q(2956) :- p(2955), q(2955).
-p(2957) :- p(2956), q(2957).
-q(2957) :- p(2956), q(2956).
-p(2958) :- p(2957), q(2958).
-q(2958) :- p(2957), q(2957).
-p(2959) :- p(2958), q(2959).
-q(2959) :- p(2958), q(2958).
-p(2960) :- p(2959), q(2960).
-q(2960) :- p(2959), q(2959).
-p(2961) :- p(2960), q(2961).
-q(2961) :- p(2960), q(2960).
-p(2962) :- p(2961), q(2962).
-q(2962) :- p(2961), q(2961).
-p(2963) :- p(2962), q(2963).
-q(2963) :- p(2962), q(2962).
-p(2964) :- p(2963), q(2964).
-q(2964) :- p(2963), q(2963).
-p(2965) :- p(2964), q(2965).
-q(2965) :- p(2964), q(2964).
-p(2966) :- p(2965), q(2966).
-q(2966) :- p(2965), q(2965).
-p(2967) :- p(2966), q(2967).
-q(2967) :- p(2966), q(2966).
-p(2968) :- p(2967), q(2968).
-q(2968) :- p(2967), q(2967).
-p(2969) :- p(2968), q(2969).
-q(2969) :- p(2968), q(2968).
-p(2970) :- p(2969), q(2970).
-q(2970) :- p(2969), q(2969).
-p(2971) :- p(2970), q(2971).
-q(2971) :- p(2970), q(2970).
-p(2972) :- p(2971), q(2972).
-q(2972) :- p(2971), q(2971).
-p(2973) :- p(2972), q(2973).
-q(2973) :- p(2972), q(2972).
-p(2974) :- p(2973), q(2974).
-q(2974) :- p(2973), q(2973).
-p(2975) :- p(2974), q(2975).
-q(2975) :- p(2974), q(2974).
-p(2976) :- p(2975), q(2976).
-q(2976) :- p(2975), q(2975).
-p(2977) :- p(2976), q(2977).
-q(2977) :- p(2976), q(2976).
-p(2978) :- p(2977), q(2978).
-q(2978) :- p(2977), q(2977).
-p(2979) :- p(2978), q(2979).
-q(2979) :- p(2978), q(2978).
-p(2980) :- p(2979), q(2980).
-q(2980) :- p(2979), q(2979).
-p(2981) :- p(2980), q(2981).
-q(2981) :- p(2980), q(2980).
-p(2982) :- p(2981), q(2982).
-q(2982) :- p(2981), q(2981).
-p(2983) :- p(2982), q(2983).
-q(2983) :- p(2982), q(2982).
-p(2984) :- p(2983), q(2984).
-q(2984) :- p(2983), q(2983).
-p(2985) :- p(2984), q(2985).
-q(2985) :- p(2984), q(2984).
-p(2986) :- p(2985), q(2986).
-q(2986) :- p(2985), q(2985).
-p(2987) :- p(2986), q(2987).
-q(2987) :- p(2986), q(2986).
-p(2988) :- p(2987), q(2988).
-q(2988) :- p(2987), q(2987).
-p(2989) :- p(2988), q(2989).
-q(2989) :- p(2988), q(2988).
-p(2990) :- p(2989), q(2990).
-q(2990) :- p(2989), q(2989).
-p(2991) :- p(2990), q(2991).
-q(2991) :- p(2990), q(2990).
-p(2992) :- p(2991), q(2992).
-q(2992) :- p(2991), q(2991).
-p(2993) :- p(2992), q(2993).
-q(2993) :- p(2992), q(2992).
-p(2994) :- p(2993), q(2994).
-q(2994) :- p(2993), q(2993).
-p(2995) :- p(2994), q(2995).
-q(2995) :- p(2994), q(2994).
-p(2996) :- p(2995), q(2996).
-q(2996) :- p(2995), q(2995).
-p(2997) :- p(2996), q(2997).
-q(2997) :- p(2996), q(2996).
-p(2998) :- p(2997), q(2998).
-q(2998) :- p(2997), q(2997).
-p(2999) :- p(2998), q(2999).
-q(2999) :- p(2998), q(2998).
-p(3000) :- p(2999), q(3000).
-q(3000) :- p(2999), q(2999).
-p(3001) :- p(3000), q(3001).
-q(3001) :- p(3000), q(3000).
-p(3002) :- p(3001), q(3002).
-q(3002) :- p(3001), q(3001).
-p(3003) :- p(3002), q(3003).
-q(3003) :- p(3002), q(3002).
-p(3004) :- p(3003), q(3004).
-q(3004) :- p(3003), q(3003).
-p(3005) :- p(3004), q(3005).
-q(3005) :- p(3004), q(3004).
-p(3006) :- p(3005), q(3006).
-q(3006) :- p(3005), q(3005).
-p(3007) :- p(3006), q(3007).
-q(3007) :- p(3006), q(3006).
-p(3008) :- p(3007), q(3008).
-q(3008) :- p(3007), q(3007).
-p(3009) :- p(3008), q(3009).
-q(3009) :- p(3008), q(3008).
-p(3010) :- p(3009), q(3010).
-q(3010) :- p(3009), q(3009).
-p(3011) :- p(3010), q(3011).
-q(3011) :- p(3010), q(3010).
-p(3012) :- p(3011), q(3012).
-q(3012) :- p(3011), q(3011).
-p(3013) :- p(3012), q(3013).
-q(3013) :- p(3012), q(3012).
-p(3014) :- p(3013), q(3014).
-q(3014) :- p(3013), q(3013).
-p(3015) :- p(3014), q(3015).
-q(3015) :- p(3014), q(3014).
-p(3016) :- p(3015), q(3016).
-q(3016) :- p(3015), q(3015).
-p(3017) :- p(3016), q(3017).
-q(3017) :- p(3016), q(3016).
-p(3018) :- p(3017), q(3018).
-q(3018) :- p(3017), q(3017).
-p(3019) :- p(3018), q(3019).
-q(3019) :- p(3018), q(3018).
-p(3020) :- p(3019), q(3020).
-q(3020) :- p(3019), q(3019).
-p(3021) :- p(3020), q(3021).
-q(3021) :- p(3020), q(3020).
-p(3022) :- p(3021), q(3022).
-q(3022) :- p(3021), q(3021).
-p(3023) :- p(3022), q(3023).
-q(3023) :- p(3022), q(3022).
-p(3024) :- p(3023), q(3024).
-q(3024) :- p(3023), q(3023).
-p(3025) :- p(3024), q(3025).
-q(3025) :- p(3024), q(3024).
-p(3026) :- p(3025), q(3026).
-q(3026) :- p(3025), q(3025).
-p(3027) :- p(3026), q(3027).
-q(3027) :- p(3026), q(3026).
-p(3028) :- p(3027), q(3028).
-q(3028) :- p(3027), q(3027).
-p(3029) :- p(3028), q(3029).
-q(3029) :- p(3028), q(3028).
-p(3030) :- p(3029), q(3030).
-q(3030) :- p(3029), q(3029).
-p(3031) :- p(3030), q(3031).
-q(3031) :- p(3030), q(3030).
-p(3032) :- p(3031), q(3032).
-q(3032) :- p(3031), q(3031).
-p(3033) :- p(3032), q(3033).
-q(3033) :- p(3032), q(3032).
-p(3034) :- p(3033), q(3034).
-q(3034) :- p(3033), q(3033).
-p(3035) :- p(3034), q(3035).
-q(3035) :- p(3034), q(3034).
-p(3036) :- p(3035), q(3036).
-q(3036) :- p(3035), q(3035).
-p(3037) :- p(3036), q(3037).
-q(3037) :- p(3036), q(3036).
-p(3038) :- p(3037), q(3038).
-q(3038) :- p(3037), q(3037).
-p(3039) :- p(3038), q(3039).
-q(3039) :- p(3038), q(3038).
-p(3040) :- p(3039), q(3040).
-q(3040) :- p(3039), q(3039).
-p(3041) :- p(3040), q(3041).
-q(3041) :- p(3040), q(3040).
-p(3042) :- p(3041), q(3042).
-q(3042) :- p(3041), q(3041).
-p(3043) :- p(3042), q(3043).
-q(3043) :- p(3042), q(3042).
-p(3044) :- p(3043), q(3044).
-q(3044) :- p(3043), q(3043).
-p(3045) :- p(3044), q(3045).
-q(3045) :- p(3044), q(3044).
-p(3046) :- p(3045), q(3046).
-q(3046) :- p(3045), q(3045).
-p(3047) :- p(3046), q(3047).
-q(3047) :- p(3046), q(3046).
-p(3048) :- p(3047), q(3048).
-q(3048) :- p(3047), q(3047).
-p(3049) :- p(3048), q(3049).
-q(3049) :- p(3048), q(3048).
-p(3050) :- p(3049), q(3050).
-q(3050) :- p(3049), q(3049).
-p(3051) :- p(3050), q(3051).
-q(3051) :- p(3050), q(3050).
-p(3052) :- p(3051), q(3052).
-q(3052) :- p(3051), q(3051).
-p(3053) :- p(3052), q(3053).
-q(3053) :- p(3052), q(3052).
-p(3054) :- p(3053), q(3054).
-q(3054) :- p(3053), q(3053).
-p(3055) :- p(3054), q(3055).
-q(3055) :- p(3054), q(3054).
-p(3056) :- p(3055), q(3056).
-q(3056) :- p(3055), q(3055).
-p(3057) :- p(3056), q(3057).
-q(3057) :- p(3056), q(3056).
-p(3058) :- p(3057), q(3058).
-q(3058) :- p(3057), q(3057).
-p(3059) :- p(3058), q(3059).
-q(3059) :- p(3058), q(3058).
-p(3060) :- p(3059), q(3060).
-q(3060) :- p(3059), q(3059).
-p(3061) :- p(3060), q(3061).
-q(3061) :- p(3060), q(3060).
-p(3062) :- p(3061), q(3062).
-q(3062) :- p(3061), q(3061).
-p(3063) :- p(3062), q(3063).
-q(3063) :- p(3062), q(3062).
-p(3064) :- p(3063), q(3064).
-q(3064) :- p(3063), q(3063).
-p(3065) :- p(3064), q(3065).
-q(3065) :- p(3064), q(3064).
-p(3066) :- p(3065), q(3066).
-q(3066) :- p(3065), q(3065).
-p(3067) :- p(3066), q(3067).
-q(3067) :- p(3066), q(3066).
-p(3068) :- p(3067), q(3068).
-q(3068) :- p(3067), q(3067).
-p(3069) :- p(3068), q(3069).
-q(3069) :- p(3068), q(3068).
-p(3070) :- p(3069), q(3070).
-q(3070) :- p(3069), q(3069).
-p(3071) :- p(3070), q(3071).
-q(3071) :- p(3070), q(3070).
-p(3072) :- p(3071), q(3072).
-q(3072) :- p(3071), q(3071).
-p(3073) :- p(3072), q(3073).
-q(3073) :- p(3072), q(3072).
-p(3074) :- p(3073), q(3074).
-q(3074) :- p(3073), q(3073).
-p(3075) :- p(3074), q(3075).
-q(3075) :- p(3074), q(3074).
-p(3076) :- p(3075), q(3076).
-q(3076) :- p(3075), q(3075).
-p(3077) :- p(3076), q(3077).
-q(3077) :- p(3076), q(3076).
-p(3078) :- p(3077), q(3078).
-q(3078) :- p(3077), q(3077).
-p(3079) :- p(3078), q(3079).
-q(3079) :- p(3078), q(3078).
-p(3080) :- p(3079), q(3080).
-q(3080) :- p(3079), q(3079).
-p(3081) :- p(3080), q(3081).
-q(3081) :- p(3080), q(3080).
-p(3082) :- p(3081), q(3082).
-q(3082) :- p(3081), q(3081).
-p(3083) :- p(3082), q(3083).
-q(3083) :- p(3082), q(3082).
-p(3084) :- p(3083), q(3084).
-q(3084) :- p(3083), q(3083).
-p(3085) :- p(3084), q(3085).
-q(3085) :- p(3084), q(3084).
-p(3086) :- p(3085), q(3086).
-q(3086) :- p(3085), q(3085).
-p(3087) :- p(3086), q(3087).
-q(3087) :- p(3086), q(3086).
-p(3088) :- p(3087), q(3088).
-q(3088) :- p(3087), q(3087).
-p(3089) :- p(3088), q(3089).
-q(3089) :- p(3088), q(3088).
-p(3090) :- p(3089), q(3090).
-q(3090) :- p(3089), q(3089).
-p(3091) :- p(3090), q(3091).
-q(3091) :- p(3090), q(3090).
-p(3092) :- p(3091), q(3092).
-q(3092) :- p(3091), q(3091).
-p(3093) :- p(3092), q(3093).
-q(3093) :- p(3092), q(3092).
-p(3094) :- p(3093), q(3094).
-q(3094) :- p(3093), q(3093).
-p(3095) :- p(3094), q(3095).
-q(3095) :- p(3094), q(3094).
-p(3096) :- p(3095), q(3096).
-q(3096) :- p(3095), q(3095).
-p(3097) :- p(3096), q(3097).
-q(3097) :- p(3096), q(3096).
-p(3098) :- p(3097), q(3098).
-q(3098) :- p(3097), q(3097).
-p(3099) :- p(3098), q(3099).
-q(3099) :- p(3098), q(3098).
-p(3100) :- p(3099), q(3100).
-q(3100) :- p(3099), q(3099).
-p(3101) :- p(3100), q(3101).
-q(3101) :- p(3100), q(3100).
-p(3102) :- p(3101), q(3102).
-q(3102) :- p(3101), q(3101).
-p(3103) :- p(3102), q(3103).
-q(3103) :- p(3102), q(3102).
-p(3104) :- p(3103), q(3104).
-q(3104) :- p(3103), q(3103).
-p(3105) :- p(3104), q(3105).
-q(3105) :- p(3104), q(3104).
-p(3106) :- p(3105), q(3106).
-q(3106) :- p(3105), q(3105).
-p(3107) :- p(3106), q(3107).
-q(3107) :- p(3106), q(3106).
-p(3108) :- p(3107), q(3108).
-q(3108) :- p(3107), q(3107).
-p(3109) :- p(3108), q(3109).
-q(3109) :- p(3108), q(3108).
-p(3110) :- p(3109), q(3110).
-q(3110) :- p(3109), q(3109).
-p(3111) :- p(3110), q(3111).
-q(3111) :- p(3110), q(3110).
-p(3112) :- p(3111), q(3112).
-q(3112) :- p(3111), q(3111).
-p(3113) :- p(3112), q(3113).
-q(3113) :- p(3112), q(3112).
-p(3114) :- p(3113), q(3114).
-q(3114) :- p(3113), q(3113).
-p(3115) :- p(3114), q(3115).
-q(3115) :- p(3114), q(3114).
-p(3116) :- p(3115), q(3116).
-q(3116) :- p(3115), q(3115).
-p(3117) :- p(3116), q(3117).
-q(3117) :- p(3116), q(3116).
-p(3118) :- p(3117), q(3118).
-q(3118) :- p(3117), q(3117).
-p(3119) :- p(3118), q(3119).
-q(3119) :- p(3118), q(3118).
-p(3120) :- p(3119), q(3120).
-q(3120) :- p(3119), q(3119).
-p(3121) :- p(3120), q(3121).
-q(3121) :- p(3120), q(3120).
-p(3122) :- p(3121), q(3122).
-q(3122) :- p(3121), q(3121).
-p(3123) :- p(3122), q(3123).
-q(3123) :- p(3122), q(3122).
-p(3124) :- p(3123), q(3124).
-q(3124) :- p(3123), q(3123).
-p(3125) :- p(3124), q(3125).
-q(3125) :- p(3124), q(3124).
-p(3126) :- p(3125), q(3126).
-q(3126) :- p(3125), q(3125).
-p(3127) :- p(3126), q(3127).
-q(3127) :- p(3126), q(3126).
-p(3128) :- p(3127), q(3128).
-q(3128) :- p(3127), q(3127).
-p(3129) :- p(3128), q(3129).
-q(3129) :- p(3128), q(3128).
-p(3130) :- p(3129), q(3130).
-q(3130) :- p(3129), q(3129).
-p(3131) :- p(3130), q(3131).
-q(3131) :- p(3130), q(3130).
-p(3132) :- p(3131), q(3132).
-q(3132) :- p(3131), q(3131).
-p(3133) :- p(3132), q(3133).
-q(3133) :- p(3132), q(3132).
-p(3134) :- p(3133), q(3134).
-q(3134) :- p(3133), q(3133).
-p(3135) :- p(3134), q(3135).
-q(3135) :- p(3134), q(3134).
-p(3136) :- p(3135), q(3136).
-q(3136) :- p(3135), q(3135).
-p(3137) :- p(3136), q(3137).
-q(3137) :- p(3136), q(3136).
-p(3138) :- p(3137), q(3138).
-q(3138) :- p(3137), q(3137).
-p(3139) :- p(3138), q(3139).
-q(3139) :- p(3138), q(3138).
-p(3140) :- p(3139), q(3140).
-q(3140) :- p(3139), q(3139).
-p(3141) :- p(3140), q(3141).
-q(3141) :- p(3140), q(3140).
-p(3142) :- p(3141), q(3142).
-q(3142) :- p(3141), q(3141).
-p(3143) :- p(3142), q(3143).
-q(3143) :- p(3142), q(3142).
-p(3144) :- p(3143), q(3144).
-q(3144) :- p(3143), q(3143).
-p(3145) :- p(3144), q(3145).
-q(3145) :- p(3144), q(3144).
-p(3146) :- p(3145), q(3146).
-q(3146) :- p(3145), q(3145).
-p(3147) :- p(3146), q(3147).
-q(3147) :- p(3146), q(3146).
-p(3148) :- p(3147), q(3148).
-q(3148) :- p(3147), q(3147).
-p(3149) :- p(3148), q(3149).
-q(3149) :- p(3148), q(3148).
-p(3150) :- p(3149), q(3150).
-q(3150) :- p(3149), q(3149).
-p(3151) :- p(3150), q(3151).
-q(3151) :- p(3150), q(3150).
-p(3152) :- p(3151), q(3152).
-q(3152) :- p(3151), q(3151).
-p(3153) :- p(3152), q(3153).
-q(3153) :- p(3152), q(3152).
-p(3154) :- p(3153), q(3154).
-q(3154) :- p(3153), q(3153).
-p(3155) :- p(3154), q(3155).
-q(3155) :- p(3154), q(3154).
-p(3156) :- p(3155), q(3156).
-q(3156) :- p(3155), q(3155).
-p(3157) :- p(3156), q(3157).
-q(3157) :- p(3156), q(3156).
-p(3158) :- p(3157), q(3158).
-q(3158) :- p(3157), q(3157).
-p(3159) :- p(3158), q(3159).
-q(3159) :- p(3158), q(3158).
-p(3160) :- p(3159), q(3160).
-q(3160) :- p(3159), q(3159).
-p(3161) :- p(3160), q(3161).
-q(3161) :- p(3160), q(3160).
-p(3162) :- p(3161), q(3162).
-q(3162) :- p(3161), q(3161).
-p(3163) :- p(3162), q(3163).
-q(3163) :- p(3162), q(3162).
-p(3164) :- p(3163), q(3164).
-q(3164) :- p(3163), q(3163).
-p(3165) :- p(3164), q(3165).
-q(3165) :- p(3164), q(3164).
-p(3166) :- p(3165), q(3166).
-q(3166) :- p(3165), q(3165).
-p(3167) :- p(3166), q(3167).
-q(3167) :- p(3166), q(3166).
-p(3168) :- p(3167), q(3168).
-q(3168) :- p(3167), q(3167).
-p(3169) :- p(3168), q(3169).
-q(3169) :- p(3168), q(3168).
-p(3170) :- p(3169), q(3170).
-q(3170) :- p(3169), q(3169).
-p(3171) :- p(3170), q(3171).
-q(3171) :- p(3170), q(3170).
-p(3172) :- p(3171), q(3172).
-q(3172) :- p(3171), q(3171).
-p(3173) :- p(3172), q(3173).
-q(3173) :- p(3172), q(3172).
-p(3174) :- p(3173), q(3174).
-q(3174) :- p(3173), q(3173).
-p(3175) :- p(3174), q(3175).
-q(3175) :- p(3174), q(3174).
-p(3176) :- p(3175), q(3176).
-q(3176) :- p(3175), q(3175).
-p(3177) :- p(3176), q(3177).
-q(3177) :- p(3176), q(3176).
-p(3178) :- p(3177), q(3178).
-q(3178) :- p(3177), q(3177).
-p(3179) :- p(3178), q(3179).
-q(3179) :- p(3178), q(3178).
-p(3180) :- p(3179), q(3180).
-q(3180) :- p(3179), q(3179).
-p(3181) :- p(3180), q(3181).
-q(3181) :- p(3180), q(3180).
-p(3182) :- p(3181), q(3182).
-q(3182) :- p(3181), q(3181).
-p(3183) :- p(3182), q(3183).
-q(3183) :- p(3182), q(3182).
-p(3184) :- p(3183), q(3184).
-q(3184) :- p(3183), q(3183).
-p(3185) :- p(3184), q(3185).
-q(3185) :- p(3184), q(3184).
-p(3186) :- p(3185), q(3186).
-q(3186) :- p(3185), q(3185).
-p(3187) :- p(3186), q(3187).
-q(3187) :- p(3186), q(3186).
-p(3188) :- p(3187), q(3188).
-q(3188) :- p(3187), q(3187).
-p(3189) :- p(3188), q(3189).
-q(3189) :- p(3188), q(3188).
-p(3190) :- p(3189), q(3190).
-q(3190) :- p(3189), q(3189).
-p(3191) :- p(3190), q(3191).
-q(3191) :- p(3190), q(3190).
-p(3192) :- p(3191), q(3192).
-q(3192) :- p(3191), q(3191).
-p(3193) :- p(3192), q(3193).
-q(3193) :- p(3192), q(3192).
-p(3194) :- p(3193), q(3194).
-q(3194) :- p(3193), q(3193).
-p(3195) :- p(3194), q(3195).
-q(3195) :- p(3194), q(3194).
-p(3196) :- p(3195), q(3196).
-q(3196) :- p(3195), q(3195).
-p(3197) :- p(3196), q(3197).
-q(3197) :- p(3196), q(3196).
-p(3198) :- p(3197), q(3198).
-q(3198) :- p(3197), q(3197).
-p(3199) :- p(3198), q(3199).
-q(3199) :- p(3198), q(3198).
-p(3200) :- p(3199), q(3200).
-q(3200) :- p(3199), q(3199).
-p(3201) :- p(3200), q(3201).
-q(3201) :- p(3200), q(3200).
-p(3202) :- p(3201), q(3202).
-q(3202) :- p(3201), q(3201).
-p(3203) :- p(3202), q(3203).
-q(3203) :- p(3202), q(3202).
-p(3204) :- p(3203), q(3204).
-q(3204) :- p(3203), q(3203).
-p(3205) :- p(3204), q(3205).
-q(3205) :- p(3204), q(3204).
-p(3206) :- p(3205), q(3206).
-q(3206) :- p(3205), q(3205).
-p(3207) :- p(3206), q(3207).
-q(3207) :- p(3206), q(3206).
-p(3208) :- p(3207), q(3208).
-q(3208) :- p(3207), q(3207).
-p(3209) :- p(3208), q(3209).
-q(3209) :- p(3208), q(3208).
-p(3210) :- p(3209), q(3210).
-q(3210) :- p(3209), q(3209).
-p(3211) :- p(3210), q(3211).
-q(3211) :- p(3210), q(3210).
-p(3212) :- p(3211), q(3212).
-q(3212) :- p(3211), q(3211).
-p(3213) :- p(3212), q(3213).
-q(3213) :- p(3212), q(3212).
-p(3214) :- p(3213), q(3214).
-q(3214) :- p(3213), q(3213).
-p(3215) :- p(3214), q(3215).
-q(3215) :- p(3214), q(3214).
-p(3216) :- p(3215), q(3216).
-q(3216) :- p(3215), q(3215).
-p(3217) :- p(3216), q(3217).
-q(3217) :- p(3216), q(3216).
-p(3218) :- p(3217), q(3218).
-q(3218) :- p(3217), q(3217).
-p(3219) :- p(3218), q(3219).
-q(3219) :- p(3218), q(3218).
-p(3220) :- p(3219), q(3220).
-q(3220) :- p(3219), q(3219).
-p(3221) :- p(3220), q(3221).
-q(3221) :- p(3220), q(3220).
-p(3222) :- p(3221), q(3222).
-q(3222) :- p(3221), q(3221).
-p(3223) :- p(3222), q(3223).
-q(3223) :- p(3222), q(3222).
-p(3224) :- p(3223), q(3224).
-q(3224) :- p(3223), q(3223).
-p(3225) :- p(3224), q(3225).
-q(3225) :- p(3224), q(3224).
-p(3226) :- p(3225), q(3226).
-q(3226) :- p(3225), q(3225).
-p(3227) :- p(3226), q(3227).
-q(3227) :- p(3226), q(3226).
-p(3228) :- p(3227), q(3228).
-q(3228) :- p(3227), q(3227).
-p(3229) :- p(3228), q(3229).
-q(3229) :- p(3228), q(3228).
-p(3230) :- p(3229), q(3230).
-q(3230) :- p(3229), q(3229).
-p(3231) :- p(3230), q(3231).
-q(3231) :- p(3230), q(3230).
-p(3232) :- p(3231), q(3232).
-q(3232) :- p(3231), q(3231).
-p(3233) :- p(3232), q(3233).
-q(3233) :- p(3232), q(3232).
-p(3234) :- p(3233), q(3234).
-q(3234) :- p(3233), q(3233).
-p(3235) :- p(3234), q(3235).
-q(3235) :- p(3234), q(3234).
-p(3236) :- p(3235), q(3236).
-q(3236) :- p(3235), q(3235).
-p(3237) :- p(3236), q(3237).
-q(3237) :- p(3236), q(3236).
-p(3238) :- p(3237), q(3238).
-q(3238) :- p(3237), q(3237).
-p(3239) :- p(3238), q(3239).
-q(3239) :- p(3238), q(3238).
-p(3240) :- p(3239), q(3240).
-q(3240) :- p(3239), q(3239).
-p(3241) :- p(3240), q(3241).
-q(3241) :- p(3240), q(3240).
-p(3242) :- p(3241), q(3242).
-q(3242) :- p(3241), q(3241).
-p(3243) :- p(3242), q(3243).
-q(3243) :- p(3242), q(3242).
-p(3244) :- p(3243), q(3244).
-q(3244) :- p(3243), q(3243).
-p(3245) :- p(3244), q(3245).
-q(3245) :- p(3244), q(3244).
-p(3246) :- p(3245), q(3246).
-q(3246) :- p(3245), q(3245).
-p(3247) :- p(3246), q(3247).
-q(3247) :- p(3246), q(3246).
-p(3248) :- p(3247), q(3248).
-q(3248) :- p(3247), q(3247).
-p(3249) :- p(3248), q(3249).
-q(3249) :- p(3248), q(3248).
-p(3250) :- p(3249), q(3250).
-q(3250) :- p(3249), q(3249).
-p(3251) :- p(3250), q(3251).
-q(3251) :- p(3250), q(3250).
-p(3252) :- p(3251), q(3252).
-q(3252) :- p(3251), q(3251).
-p(3253) :- p(3252), q(3253).
-q(3253) :- p(3252), q(3252).
-p(3254) :- p(3253), q(3254).
-q(3254) :- p(3253), q(3253).
-p(3255) :- p(3254), q(3255).
-q(3255) :- p(3254), q(3254).
-p(3256) :- p(3255), q(3256).
-q(3256) :- p(3255), q(3255).
-p(3257) :- p(3256), q(3257).
-q(3257) :- p(3256), q(3256).
-p(3258) :- p(3257), q(3258).
-q(3258) :- p(3257), q(3257).
-p(3259) :- p(3258), q(3259).
-q(3259) :- p(3258), q(3258).
-p(3260) :- p(3259), q(3260).
-q(3260) :- p(3259), q(3259).
-p(3261) :- p(3260), q(3261).
-q(3261) :- p(3260), q(3260).
-p(3262) :- p(3261), q(3262).
-q(3262) :- p(3261), q(3261).
-p(3263) :- p(3262), q(3263).
-q(3263) :- p(3262), q(3262).
-p(3264) :- p(3263), q(3264).
-q(3264) :- p(3263), q(3263).
-p(3265) :- p(3264), q(3265).
-q(3265) :- p(3264), q(3264).
-p(3266) :- p(3265), q(3266).
-q(3266) :- p(3265), q(3265).
-p(3267) :- p(3266), q(3267).
-q(3267) :- p(3266), q(3266).
-p(3268) :- p(3267), q(3268).
-q(3268) :- p(3267), q(3267).
-p(3269) :- p(3268), q(3269).
-q(3269) :- p(3268), q(3268).
-p(3270) :- p(3269), q(3270).
-q(3270) :- p(3269), q(3269).
-p(3271) :- p(3270), q(3271).
-q(3271) :- p(3270), q(3270).
-p(3272) :- p(3271), q(3272).
-q(3272) :- p(3271), q(3271).
-p(3273) :- p(3272), q(3273).
-q(3273) :- p(3272), q(3272).
-p(3274) :- p(3273), q(3274).
-q(3274) :- p(3273), q(3273).
-p(3275) :- p(3274), q(3275).
-q(3275) :- p(3274), q(3274).
-p(3276) :- p(3275), q(3276).
-q(3276) :- p(3275), q(3275).
-p(3277) :- p(3276), q(3277).
-q(3277) :- p(3276), q(3276).
-p(3278) :- p(3277), q(3278).
-q(3278) :- p(3277), q(3277).
-p(3279) :- p(3278), q(3279).
-q(3279) :- p(3278), q(3278).
-p(3280) :- p(3279), q(3280).
-q(3280) :- p(3279), q(3279).
-p(3281) :- p(3280), q(3281).
-q(3281) :- p(3280), q(3280).
-p(3282) :- p(3281), q(3282).
-q(3282) :- p(3281), q(3281).
-p(3283) :- p(3282), q(3283).
-q(3283) :- p(3282), q(3282).
-p(3284) :- p(3283), q(3284).
-q(3284) :- p(3283), q(3283).
-p(3285) :- p(3284), q(3285).
-q(3285) :- p(3284), q(3284).
-p(3286) :- p(3285), q(3286).
-q(3286) :- p(3285), q(3285).
-p(3287) :- p(3286), q(3287).
-q(3287) :- p(3286), q(3286).
-p(3288) :- p(3287), q(3288).
-q(3288) :- p(3287), q(3287).
-p(3289) :- p(3288), q(3289).
-q(3289) :- p(3288), q(3288).
-p(3290) :- p(3289), q(3290).
-q(3290) :- p(3289), q(3289).
-p(3291) :- p(3290), q(3291).
-q(3291) :- p(3290), q(3290).
-p(3292) :- p(3291), q(3292).
-q(3292) :- p(3291), q(3291).
-p(3293) :- p(3292), q(3293).
-q(3293) :- p(3292), q(3292).
-p(3294) :- p(3293), q(3294).
-q(3294) :- p(3293), q(3293).
-p(3295) :- p(3294), q(3295).
-q(3295) :- p(3294), q(3294).
-p(3296) :- p(3295), q(3296).
-q(3296) :- p(3295), q(3295).
-p(3297) :- p(3296), q(3297).
-q(3297) :- p(3296), q(3296).
-p(3298) :- p(3297), q(3298).
-q(3298) :- p(3297), q(3297).
-p(3299) :- p(3298), q(3299).
-q(3299) :- p(3298), q(3298).
-p(3300) :- p(3299), q(3300).
-q(3300) :- p(3299), q(3299).
-p(3301) :- p(3300), q(3301).
-q(3301) :- p(3300), q(3300).
-p(3302) :- p(3301), q(3302).
-q(3302) :- p(3301), q(3301).
-p(3303) :- p(3302), q(3303).
-q(3303) :- p(3302), q(3302).
-p(3304) :- p(3303), q(3304).
-q(3304) :- p(3303), q(3303).
-p(3305) :- p(3304), q(3305).
-q(3305) :- p(3304), q(3304).
-p(3306) :- p(3305), q(3306).
-q(3306) :- p(3305), q(3305).
-p(3307) :- p(3306), q(3307).
-q(3307) :- p(3306), q(3306).
-p(3308) :- p(3307), q(3308).
-q(3308) :- p(3307), q(3307).
-p(3309) :- p(3308), q(3309).
-q(3309) :- p(3308), q(3308).
-p(3310) :- p(3309), q(3310).
-q(3310) :- p(3309), q(3309).
-p(3311) :- p(3310), q(3311).
-q(3311) :- p(3310), q(3310).
-p(3312) :- p(3311), q(3312).
-q(3312) :- p(3311), q(3311).
-p(3313) :- p(3312), q(3313).
-q(3313) :- p(3312), q(3312).
-p(3314) :- p(3313), q(3314).
-q(3314) :- p(3313), q(3313).
-p(3315) :- p(3314), q(3315).
-q(3315) :- p(3314), q(3314).
-p(3316) :- p(3315), q(3316).
-q(3316) :- p(3315), q(3315).
-p(3317) :- p(3316), q(3317).
-q(3317) :- p(3316), q(3316).
-p(3318) :- p(3317), q(3318).
-q(3318) :- p(3317), q(3317).
-p(3319) :- p(3318), q(3319).
-q(3319) :- p(3318), q(3318).
-p(3320) :- p(3319), q(3320).
-q(3320) :- p(3319), q(3319).
-p(3321) :- p(3320), q(3321).
-q(3321) :- p(3320), q(3320).
-p(3322) :- p(3321), q(3322).
-q(3322) :- p(3321), q(3321).
-p(3323) :- p(3322), q(3323).
-q(3323) :- p(3322), q(3322).
-p(3324) :- p(3323), q(3324).
-q(3324) :- p(3323), q(3323).
-p(3325) :- p(3324), q(3325).
-q(3325) :- p(3324), q(3324).
-p(3326) :- p(3325), q(3326).
-q(3326) :- p(3325), q(3325).
-p(3327) :- p(3326), q(3327).
-q(3327) :- p(3326), q(3326).
-p(3328) :- p(3327), q(3328).
-q(3328) :- p(3327), q(3327).
-p(3329) :- p(3328), q(3329).
-q(3329) :- p(3328), q(3328).
-p(3330) :- p(3329), q(3330).
-q(3330) :- p(3329), q(3329).
-p(3331) :- p(3330), q(3331).
-q(3331) :- p(3330), q(3330).
-p(3332) :- p(3331), q(3332).
-q(3332) :- p(3331), q(3331).
-p(3333) :- p(3332), q(3333).
-q(3333) :- p(3332), q(3332).
-p(3334) :- p(3333), q(3334).
-q(3334) :- p(3333), q(3333).
-p(3335) :- p(3334), q(3335).
-q(3335) :- p(3334), q(3334).
-p(3336) :- p(3335), q(3336).
-q(3336) :- p(3335), q(3335).
-p(3337) :- p(3336), q(3337).
-q(3337) :- p(3336), q(3336).
-p(3338) :- p(3337), q(3338).
-q(3338) :- p(3337), q(3337).
-p(3339) :- p(3338), q(3339).
-q(3339) :- p(3338), q(3338).
-p(3340) :- p(3339), q(3340).
-q(3340) :- p(3339), q(3339).
-p(3341) :- p(3340), q(3341).
-q(3341) :- p(3340), q(3340).
-p(3342) :- p(3341), q(3342).
-q(3342) :- p(3341), q(3341).
-p(3343) :- p(3342), q(3343).
-q(3343) :- p(3342), q(3342).
-p(3344) :- p(3343), q(3344).
-q(3344) :- p(3343), q(3343).
-p(3345) :- p(3344), q(3345).
-q(3345) :- p(3344), q(3344).
-p(3346) :- p(3345), q(3346).
-q(3346) :- p(3345), q(3345).
-p(3347) :- p(3346), q(3347).
-q(3347) :- p(3346), q(3346).
-p(3348) :- p(3347), q(3348).
-q(3348) :- p(3347), q(3347).
-p(3349) :- p(3348), q(3349).
-q(3349) :- p(3348), q(3348).
-p(3350) :- p(3349), q(3350).
-q(3350) :- p(3349), q(3349).
-p(3351) :- p(3350), q(3351).
-q(3351) :- p(3350), q(3350).
-p(3352) :- p(3351), q(3352).
-q(3352) :- p(3351), q(3351).
-p(3353) :- p(3352), q(3353).
-q(3353) :- p(3352), q(3352).
-p(3354) :- p(3353), q(3354).
-q(3354) :- p(3353), q(3353).
-p(3355) :- p(3354), q(3355).
-q(3355) :- p(3354), q(3354).
-p(3356) :- p(3355), q(3356).
-q(3356) :- p(3355), q(3355).
-p(3357) :- p(3356), q(3357).
-q(3357) :- p(3356), q(3356).
-p(3358) :- p(3357), q(3358).
-q(3358) :- p(3357), q(3357).
-p(3359) :- p(3358), q(3359).
-q(3359) :- p(3358), q(3358).
-p(3360) :- p(3359), q(3360).
-q(3360) :- p(3359), q(3359).
-p(3361) :- p(3360), q(3361).
-q(3361) :- p(3360), q(3360).
-p(3362) :- p(3361), q(3362).
-q(3362) :- p(3361), q(3361).
-p(3363) :- p(3362), q(3363).
-q(3363) :- p(3362), q(3362).
-p(3364) :- p(3363), q(3364).
-q(3364) :- p(3363), q(3363).
-p(3365) :- p(3364), q(3365).
-q(3365) :- p(3364), q(3364).
-p(3366) :- p(3365), q(3366).
-q(3366) :- p(3365), q(3365).
-p(3367) :- p(3366), q(3367).
-q(3367) :- p(3366), q(3366).
-p(3368) :- p(3367), q(3368).
-q(3368) :- p(3367), q(3367).
-p(3369) :- p(3368), q(3369).
-q(3369) :- p(3368), q(3368).
-p(3370) :- p(3369), q(3370).
-q(3370) :- p(3369), q(3369).
-p(3371) :- p(3370), q(3371).
-q(3371) :- p(3370), q(3370).
-p(3372) :- p(3371), q(3372).
-q(3372) :- p(3371), q(3371).
-p(3373) :- p(3372), q(3373).
-q(3373) :- p(3372), q(3372).
-p(3374) :- p(3373), q(3374).
-q(3374) :- p(3373), q(3373).
-p(3375) :- p(3374), q(3375).
-q(3375) :- p(3374), q(3374).
-p(3376) :- p(3375), q(3376).
-q(3376) :- p(3375), q(3375).
-p(3377) :- p(3376), q(3377).
-q(3377) :- p(3376), q(3376).
-p(3378) :- p(3377), q(3378).
-q(3378) :- p(3377), q(3377).
-p(3379) :- p(3378), q(3379).
-q(3379) :- p(3378), q(3378).
-p(3380) :- p(3379), q(3380).
-q(3380) :- p(3379), q(3379).
-p(3381) :- p(3380), q(3381).
-q(3381) :- p(3380), q(3380).
-p(3382) :- p(3381), q(3382).
-q(3382) :- p(3381), q(3381).
-p(3383) :- p(3382), q(3383).
-q(3383) :- p(3382), q(3382).
-p(3384) :- p(3383), q(3384).
-q(3384) :- p(3383), q(3383).
-p(3385) :- p(3384), q(3385).
-q(3385) :- p(3384), q(3384).
-p(3386) :- p(3385), q(3386).
-q(3386) :- p(3385), q(3385).
-p(3387) :- p(3386), q(3387).
-q(3387) :- p(3386), q(3386).
-p(3388) :- p(3387), q(3388).
-q(3388) :- p(3387), q(3387).
-p(3389) :- p(3388), q(3389).
-q(3389) :- p(3388), q(3388).
-p(3390) :- p(3389), q(3390).
-q(3390) :- p(3389), q(3389).
-p(3391) :- p(3390), q(3391).
-q(3391) :- p(3390), q(3390).
-p(3392) :- p(3391), q(3392).
-q(3392) :- p(3391), q(3391).
-p(3393) :- p(3392), q(3393).
-q(3393) :- p(3392), q(3392).
-p(3394) :- p(3393), q(3394).
-q(3394) :- p(3393), q(3393).
-p(3395) :- p(3394), q(3395).
-q(3395) :- p(3394), q(3394).
-p(3396) :- p(3395), q(3396).
-q(3396) :- p(3395), q(3395).
-p(3397) :- p(3396), q(3397).
-q(3397) :- p(3396), q(3396).
-p(3398) :- p(3397), q(3398).
-q(3398) :- p(3397), q(3397).
-p(3399) :- p(3398), q(3399).
-q(3399) :- p(3398), q(3398).
-p(3400) :- p(3399), q(3400).
-q(3400) :- p(3399), q(3399).
-p(3401) :- p(3400), q(3401).
-q(3401) :- p(3400), q(3400).
-p(3402) :- p(3401), q(3402).
-q(3402) :- p(3401), q(3401).
-p(3403) :- p(3402), q(3403).
-q(3403) :- p(3402), q(3402).
-p(3404) :- p(3403), q(3404).
-q(3404) :- p(3403), q(3403).
-p(3405) :- p(3404), q(3405).
-q(3405) :- p(3404), q(3404).
-p(3406) :- p(3405), q(3406).
-q(3406) :- p(3405), q(3405).
-p(3407) :- p(3406), q(3407).
-q(3407) :- p(3406), q(3406).
-p(3408) :- p(3407), q(3408).
-q(3408) :- p(3407), q(3407).
-p(3409) :- p(3408), q(3409).
-q(3409) :- p(3408), q(3408).
-p(3410) :- p(3409), q(3410).
-q(3410) :- p(3409), q(3409).
-p(3411) :- p(3410), q(3411).
-q(3411) :- p(3410), q(3410).
-p(3412) :- p(3411), q(3412).
-q(3412) :- p(3411), q(3411).
-p(3413) :- p(3412), q(3413).
-q(3413) :- p(3412), q(3412).
-p(3414) :- p(3413), q(3414).
-q(3414) :- p(3413), q(3413).
-p(3415) :- p(3414), q(3415).
-q(3415) :- p(3414), q(3414).
-p(3416) :- p(3415), q(3416).
-q(3416) :- p(3415), q(3415).
-p(3417) :- p(3416), q(3417).
-q(3417) :- p(3416), q(3416).
-p(3418) :- p(3417), q(3418).
-q(3418) :- p(3417), q(3417).
-p(3419) :- p(3418), q(3419).
-q(3419) :- p(3418), q(3418).
-p(3420) :- p(3419), q(3420).
-q(3420) :- p(3419), q(3419).
-p(3421) :- p(3420), q(3421).
-q(3421) :- p(3420), q(3420).
-p(3422) :- p(3421), q(3422).
-q(3422) :- p(3421), q(3421).
-p(3423) :- p(3422), q(3423).
-q(3423) :- p(3422), q(3422).
-p(3424) :- p(3423), q(3424).
-q(3424) :- p(3423), q(3423).
-p(3425) :- p(3424), q(3425).
-q(3425) :- p(3424), q(3424).
-p(3426) :- p(3425), q(3426).
-q(3426) :- p(3425), q(3425).
-p(3427) :- p(3426), q(3427).
-q(3427) :- p(3426), q(3426).
-p(3428) :- p(3427), q(3428).
-q(3428) :- p(3427), q(3427).
-p(3429) :- p(3428), q(3429).
-q(3429) :- p(3428), q(3428).
-p(3430) :- p(3429), q(3430).
-q(3430) :- p(3429), q(3429).
-p(3431) :- p(3430), q(3431).
-q(3431) :- p(3430), q(3430).
-p(3432) :- p(3431), q(3432).
-q(3432) :- p(3431), q(3431).
-p(3433) :- p(3432), q(3433).
-q(3433) :- p(3432), q(3432).
-p(3434) :- p(3433), q(3434).
-q(3434) :- p(3433), q(3433).
-p(3435) :- p(3434), q(3435).
-q(3435) :- p(3434), q(3434).
-p(3436) :- p(3435), q(3436).
-q(3436) :- p(3435), q(3435).
-p(3437) :- p(3436), q(3437).
-q(3437) :- p(3436), q(3436).
-p(3438) :- p(3437), q(3438).
-q(3438) :- p(3437), q(3437).
-p(3439) :- p(3438), q(3439).
-q(3439) :- p(3438), q(3438).
-p(3440) :- p(3439), q(3440).
-q(3440) :- p(3439), q(3439).
-p(3441) :- p(3440), q(3441).
-q(3441) :- p(3440), q(3440).
-p(3442) :- p(3441), q(3442).
-q(3442) :- p(3441), q(3441).
-p(3443) :- p(3442), q(3443).
-q(3443) :- p(3442), q(3442).
-p(3444) :- p(3443), q(3444).
-q(3444) :- p(3443), q(3443).
-p(3445) :- p(3444), q(3445).
-q(3445) :- p(3444), q(3444).
-p(3446) :- p(3445), q(3446).
-q(3446) :- p(3445), q(3445).
-p(3447) :- p(3446), q(3447).
-q(3447) :- p(3446), q(3446).
-p(3448) :- p(3447), q(3448).
-q(3448) :- p(3447), q(3447).
-p(3449) :- p(3448), q(3449).
-q(3449) :- p(3448), q(3448).
-p(3450) :- p(3449), q(3450).
-q(3450) :- p(3449), q(3449).
-p(3451) :- p(3450), q(3451).
-q(3451) :- p(3450), q(3450).
-p(3452) :- p(3451), q(3452).
-q(3452) :- p(3451), q(3451).
-p(3453) :- p(3452), q(3453).
-q(3453) :- p(3452), q(3452).
-p(3454) :- p(3453), q(3454).
-q(3454) :- p(3453), q(3453).
-p(3455) :- p(3454), q(3455).
-q(3455) :- p(3454), q(3454).
-p(3456) :- p(3455), q(3456).
-q(3456) :- p(3455), q(3455).
-p(3457) :- p(3456), q(3457).
-q(3457) :- p(3456), q(3456).
-p(3458) :- p(3457), q(3458).
-q(3458) :- p(3457), q(3457).
-p(3459) :- p(3458), q(3459).
-q(3459) :- p(3458), q(3458).
-p(3460) :- p(3459), q(3460).
-q(3460) :- p(3459), q(3459).
-p(3461) :- p(3460), q(3461).
-q(3461) :- p(3460), q(3460).
-p(3462) :- p(3461), q(3462).
-q(3462) :- p(3461), q(3461).
-p(3463) :- p(3462), q(3463).
-q(3463) :- p(3462), q(3462).
-p(3464) :- p(3463), q(3464).
-q(3464) :- p(3463), q(3463).
-p(3465) :- p(3464), q(3465).
-q(3465) :- p(3464), q(3464).
-p(3466) :- p(3465), q(3466).
-q(3466) :- p(3465), q(3465).
-p(3467) :- p(3466), q(3467).
-q(3467) :- p(3466), q(3466).
-p(3468) :- p(3467), q(3468).
-q(3468) :- p(3467), q(3467).
-p(3469) :- p(3468), q(3469).
-q(3469) :- p(3468), q(3468).
-p(3470) :- p(3469), q(3470).
-q(3470) :- p(3469), q(3469).
-p(3471) :- p(3470), q(3471).
-q(3471) :- p(3470), q(3470).
-p(3472) :- p(3471), q(3472).
-q(3472) :- p(3471), q(3471).
-p(3473) :- p(3472), q(3473).
-q(3473) :- p(3472), q(3472).
-p(3474) :- p(3473), q(3474).
-q(3474) :- p(3473), q(3473).
-p(3475) :- p(3474), q(3475).
-q(3475) :- p(3474), q(3474).
-p(3476) :- p(3475), q(3476).
-q(3476) :- p(3475), q(3475).
-p(3477) :- p(3476), q(3477).
-q(3477) :- p(3476), q(3476).
-p(3478) :- p(3477), q(3478).
-q(3478) :- p(3477), q(3477).
-p(3479) :- p(3478), q(3479).
-q(3479) :- p(3478), q(3478).
-p(3480) :- p(3479), q(3480).
-q(3480) :- p(3479), q(3479).
-p(3481) :- p(3480), q(3481).
-q(3481) :- p(3480), q(3480).
-p(3482) :- p(3481), q(3482).
-q(3482) :- p(3481), q(3481).
-p(3483) :- p(3482), q(3483).
-q(3483) :- p(3482), q(3482).
-p(3484) :- p(3483), q(3484).
-q(3484) :- p(3483), q(3483).
-p(3485) :- p(3484), q(3485).
-q(3485) :- p(3484), q(3484).
-p(3486) :- p(3485), q(3486).
-q(3486) :- p(3485), q(3485).
-p(3487) :- p(3486), q(3487).
-q(3487) :- p(3486), q(3486).
-p(3488) :- p(3487), q(3488).
-q(3488) :- p(3487), q(3487).
-p(3489) :- p(3488), q(3489).
-q(3489) :- p(3488), q(3488).
-p(3490) :- p(3489), q(3490).
-q(3490) :- p(3489), q(3489).
-p(3491) :- p(3490), q(3491).
-q(3491) :- p(3490), q(3490).
-p(3492) :- p(3491), q(3492).
-q(3492) :- p(3491), q(3491).
-p(3493) :- p(3492), q(3493).
-q(3493) :- p(3492), q(3492).
-p(3494) :- p(3493), q(3494).
-q(3494) :- p(3493), q(3493).
-p(3495) :- p(3494), q(3495).
-q(3495) :- p(3494), q(3494).
-p(3496) :- p(3495), q(3496).
-q(3496) :- p(3495), q(3495).
-p(3497) :- p(3496), q(3497).
-q(3497) :- p(3496), q(3496).
-p(3498) :- p(3497), q(3498).
-q(3498) :- p(3497), q(3497).
-p(3499) :- p(3498), q(3499).
-q(3499) :- p(3498), q(3498).
-p(3500) :- p(3499), q(3500).
-q(3500) :- p(3499), q(3499).
-p(3501) :- p(3500), q(3501).
-q(3501) :- p(3500), q(3500).
-p(3502) :- p(3501), q(3502).
-q(3502) :- p(3501), q(3501).
-p(3503) :- p(3502), q(3503).
-q(3503) :- p(3502), q(3502).
-p(3504) :- p(3503), q(3504).
-q(3504) :- p(3503), q(3503).
-p(3505) :- p(3504), q(3505).
-q(3505) :- p(3504), q(3504).
-p(3506) :- p(3505), q(3506).
-q(3506) :- p(3505), q(3505).
-p(3507) :- p(3506), q(3507).
-q(3507) :- p(3506), q(3506).
-p(3508) :- p(3507), q(3508).
-q(3508) :- p(3507), q(3507).
-p(3509) :- p(3508), q(3509).
-q(3509) :- p(3508), q(3508).
-p(3510) :- p(3509), q(3510).
-q(3510) :- p(3509), q(3509).
-p(3511) :- p(3510), q(3511).
-q(3511) :- p(3510), q(3510).
-p(3512) :- p(3511), q(3512).
-q(3512) :- p(3511), q(3511).
-p(3513) :- p(3512), q(3513).
-q(3513) :- p(3512), q(3512).
-p(3514) :- p(3513), q(3514).
-q(3514) :- p(3513), q(3513).
-p(3515) :- p(3514), q(3515).
-q(3515) :- p(3514), q(3514).
-p(3516) :- p(3515), q(3516).
-q(3516) :- p(3515), q(3515).
-p(3517) :- p(3516), q(3517).
-q(3517) :- p(3516), q(3516).
-p(3518) :- p(3517), q(3518).
-q(3518) :- p(3517), q(3517).
-p(3519) :- p(3518), q(3519).
-q(3519) :- p(3518), q(3518).
-p(3520) :- p(3519), q(3520).
-q(3520) :- p(3519), q(3519).
-p(3521) :- p(3520), q(3521).
-q(3521) :- p(3520), q(3520).
-p(3522) :- p(3521), q(3522).
-q(3522) :- p(3521), q(3521).
-p(3523) :- p(3522), q(3523).
-q(3523) :- p(3522), q(3522).
-p(3524) :- p(3523), q(3524).
-q(3524) :- p(3523), q(3523).
-p(3525) :- p(3524), q(3525).
-q(3525) :- p(3524), q(3524).
-p(3526) :- p(3525), q(3526).
-q(3526) :- p(3525), q(3525).
-p(3527) :- p(3526), q(3527).
-q(3527) :- p(3526), q(3526).
-p(3528) :- p(3527), q(3528).
-q(3528) :- p(3527), q(3527).
-p(3529) :- p(3528), q(3529).
-q(3529) :- p(3528), q(3528).
-p(3530) :- p(3529), q(3530).
-q(3530) :- p(3529), q(3529).
-p(3531) :- p(3530), q(3531).
-q(3531) :- p(3530), q(3530).
-p(3532) :- p(3531), q(3532).
-q(3532) :- p(3531), q(3531).
-p(3533) :- p(3532), q(3533).
-q(3533) :- p(3532), q(3532).
-p(3534) :- p(3533), q(3534).
-q(3534) :- p(3533), q(3533).
-p(3535) :- p(3534), q(3535).
-q(3535) :- p(3534), q(3534).
-p(3536) :- p(3535), q(3536).
-q(3536) :- p(3535), q(3535).
-p(3537) :- p(3536), q(3537).
-q(3537) :- p(3536), q(3536).
-p(3538) :- p(3537), q(3538).
-q(3538) :- p(3537), q(3537).
-p(3539) :- p(3538), q(3539).
-q(3539) :- p(3538), q(3538).
-p(3540) :- p(3539), q(3540).
-q(3540) :- p(3539), q(3539).
-p(3541) :- p(3540), q(3541).
-q(3541) :- p(3540), q(3540).
-p(3542) :- p(3541), q(3542).
-q(3542) :- p(3541), q(3541).
-p(3543) :- p(3542), q(3543).
-q(3543) :- p(3542), q(3542).
-p(3544) :- p(3543), q(3544).
-q(3544) :- p(3543), q(3543).
-p(3545) :- p(3544), q(3545).
-q(3545) :- p(3544), q(3544).
-p(3546) :- p(3545), q(3546).
-q(3546) :- p(3545), q(3545).
-p(3547) :- p(3546), q(3547).
-q(3547) :- p(3546), q(3546).
-p(3548) :- p(3547), q(3548).
-q(3548) :- p(3547), q(3547).
-p(3549) :- p(3548), q(3549).
-q(3549) :- p(3548), q(3548).
-p(3550) :- p(3549), q(3550).
-q(3550) :- p(3549), q(3549).
-p(3551) :- p(3550), q(3551).
-q(3551) :- p(3550), q(3550).
-p(3552) :- p(3551), q(3552).
-q(3552) :- p(3551), q(3551).
-p(3553) :- p(3552), q(3553).
-q(3553) :- p(3552), q(3552).
-p(3554) :- p(3553), q(3554).
-q(3554) :- p(3553), q(3553).
-p(3555) :- p(3554), q(3555).
-q(3555) :- p(3554), q(3554).
-p(3556) :- p(3555), q(3556).
-q(3556) :- p(3555), q(3555).
-p(3557) :- p(3556), q(3557).
-q(3557) :- p(3556), q(3556).
-p(3558) :- p(3557), q(3558).
-q(3558) :- p(3557), q(3557).
-p(3559) :- p(3558), q(3559).
-q(3559) :- p(3558), q(3558).
-p(3560) :- p(3559), q(3560).
-q(3560) :- p(3559), q(3559).
-p(3561) :- p(3560), q(3561).
-q(3561) :- p(3560), q(3560).
-p(3562) :- p(3561), q(3562).
-q(3562) :- p(3561), q(3561).
-p(3563) :- p(3562), q(3563).
-q(3563) :- p(3562), q(3562).
-p(3564) :- p(3563), q(3564).
-q(3564) :- p(3563), q(3563).
-p(3565) :- p(3564), q(3565).
-q(3565) :- p(3564), q(3564).
-p(3566) :- p(3565), q(3566).
-q(3566) :- p(3565), q(3565).
-p(3567) :- p(3566), q(3567).
-q(3567) :- p(3566), q(3566).
-p(3568) :- p(3567), q(3568).
-q(3568) :- p(3567), q(3567).
-p(3569) :- p(3568), q(3569).
-q(3569) :- p(3568), q(3568).
-p(3570) :- p(3569), q(3570).
-q(3570) :- p(3569), q(3569).
-p(3571) :- p(3570), q(3571).
-q(3571) :- p(3570), q(3570).
-p(3572) :- p(3571), q(3572).
-q(3572) :- p(3571), q(3571).
-p(3573) :- p(3572), q(3573).
-q(3573) :- p(3572), q(3572).
-p(3574) :- p(3573), q(3574).
-q(3574) :- p(3573), q(3573).
-p(3575) :- p(3574), q(3575).
-q(3575) :- p(3574), q(3574).
-p(3576) :- p(3575), q(3576).
-q(3576) :- p(3575), q(3575).
-p(3577) :- p(3576), q(3577).
-q(3577) :- p(3576), q(3576).
-p(3578) :- p(3577), q(3578).
-q(3578) :- p(3577), q(3577).
-p(3579) :- p(3578), q(3579).
-q(3579) :- p(3578), q(3578).
-p(3580) :- p(3579), q(3580).
-q(3580) :- p(3579), q(3579).
-p(3581) :- p(3580), q(3581).
-q(3581) :- p(3580), q(3580).
-p(3582) :- p(3581), q(3582).
-q(3582) :- p(3581), q(3581).
-p(3583) :- p(3582), q(3583).
-q(3583) :- p(3582), q(3582).
-p(3584) :- p(3583), q(3584).
-q(3584) :- p(3583), q(3583).
-p(3585) :- p(3584), q(3585).
-q(3585) :- p(3584), q(3584).
-p(3586) :- p(3585), q(3586).
-q(3586) :- p(3585), q(3585).
-p(3587) :- p(3586), q(3587).
-q(3587) :- p(3586), q(3586).
-p(3588) :- p(3587), q(3588).
-q(3588) :- p(3587), q(3587).
-p(3589) :- p(3588), q(3589).
-q(3589) :- p(3588), q(3588).
-p(3590) :- p(3589), q(3590).
-q(3590) :- p(3589), q(3589).
-p(3591) :- p(3590), q(3591).
-q(3591) :- p(3590), q(3590).
-p(3592) :- p(3591), q(3592).
-q(3592) :- p(3591), q(3591).
-p(3593) :- p(3592), q(3593).
-q(3593) :- p(3592), q(3592).
-p(3594) :- p(3593), q(3594).
-q(3594) :- p(3593), q(3593).
-p(3595) :- p(3594), q(3595).
-q(3595) :- p(3594), q(3594).
-p(3596) :- p(3595), q(3596).
-q(3596) :- p(3595), q(3595).
-p(3597) :- p(3596), q(3597).
-q(3597) :- p(3596), q(3596).
-p(3598) :- p(3597), q(3598).
-q(3598) :- p(3597), q(3597).
-p(3599) :- p(3598), q(3599).
-q(3599) :- p(3598), q(3598).
-p(3600) :- p(3599), q(3600).
-q(3600) :- p(3599), q(3599).
-p(3601) :- p(3600), q(3601).
-q(3601) :- p(3600), q(3600).
-p(3602) :- p(3601), q(3602).
-q(3602) :- p(3601), q(3601).
-p(3603) :- p(3602), q(3603).
-q(3603) :- p(3602), q(3602).
-p(3604) :- p(3603), q(3604).
-q(3604) :- p(3603), q(3603).
-p(3605) :- p(3604), q(3605).
-q(3605) :- p(3604), q(3604).
-p(3606) :- p(3605), q(3606).
-q(3606) :- p(3605), q(3605).
-p(3607) :- p(3606), q(3607).
-q(3607) :- p(3606), q(3606).
-p(3608) :- p(3607), q(3608).
-q(3608) :- p(3607), q(3607).
-p(3609) :- p(3608), q(3609).
-q(3609) :- p(3608), q(3608).
-p(3610) :- p(3609), q(3610).
-q(3610) :- p(3609), q(3609).
-p(3611) :- p(3610), q(3611).
-q(3611) :- p(3610), q(3610).
-p(3612) :- p(3611), q(3612).
-q(3612) :- p(3611), q(3611).
-p(3613) :- p(3612), q(3613).
-q(3613) :- p(3612), q(3612).
-p(3614) :- p(3613), q(3614).
-q(3614) :- p(3613), q(3613).
-p(3615) :- p(3614), q(3615).
-q(3615) :- p(3614), q(3614).
-p(3616) :- p(3615), q(3616).
-q(3616) :- p(3615), q(3615).
-p(3617) :- p(3616), q(3617).
-q(3617) :- p(3616), q(3616).
-p(3618) :- p(3617), q(3618).
-q(3618) :- p(3617), q(3617).
-p(3619) :- p(3618), q(3619).
-q(3619) :- p(3618), q(3618).
-p(3620) :- p(3619), q(3620).
-q(3620) :- p(3619), q(3619).
-p(3621) :- p(3620), q(3621).
-q(3621) :- p(3620), q(3620).
-p(3622) :- p(3621), q(3622).
-q(3622) :- p(3621), q(3621).
-p(3623) :- p(3622), q(3623).
-q(3623) :- p(3622), q(3622).
-p(3624) :- p(3623), q(3624).
-q(3624) :- p(3623), q(3623).
-p(3625) :- p(3624), q(3625).
-q(3625) :- p(3624), q(3624).
-p(3626) :- p(3625), q(3626).
-q(3626) :- p(3625), q(3625).
-p(3627) :- p(3626), q(3627).
-q(3627) :- p(3626), q(3626).
-p(3628) :- p(3627), q(3628).
-q(3628) :- p(3627), q(3627).
-p(3629) :- p(3628), q(3629).
-q(3629) :- p(3628), q(3628).
-p(3630) :- p(3629), q(3630).
-q(3630) :- p(3629), q(3629).
-p(3631) :- p(3630), q(3631).
-q(3631) :- p(3630), q(3630).
-p(3632) :- p(3631), q(3632).
-q(3632) :- p(3631), q(3631).
-p(3633) :- p(3632), q(3633).
-q(3633) :- p(3632), q(3632).
-p(3634) :- p(3633), q(3634).
-q(3634) :- p(3633), q(3633).
-p(3635) :- p(3634), q(3635).
-q(3635) :- p(3634), q(3634).
-p(3636) :- p(3635), q(3636).
-q(3636) :- p(3635), q(3635).
-p(3637) :- p(3636), q(3637).
-q(3637) :- p(3636), q(3636).
-p(3638) :- p(3637), q(3638).
-q(3638) :- p(3637), q(3637).
-p(3639) :- p(3638), q(3639).
-q(3639) :- p(3638), q(3638).
-p(3640) :- p(3639), q(3640).
-q(3640) :- p(3639), q(3639).
-p(3641) :- p(3640), q(3641).
-q(3641) :- p(3640), q(3640).
-p(3642) :- p(3641), q(3642).
-q(3642) :- p(3641), q(3641).
-p(3643) :- p(3642), q(3643).
-q(3643) :- p(3642), q(3642).
-p(3644) :- p(3643), q(3644).
-q(3644) :- p(3643), q(3643).
-p(3645) :- p(3644), q(3645).
-q(3645) :- p(3644), q(3644).
-p(3646) :- p(3645), q(3646).
-q(3646) :- p(3645), q(3645).
-p(3647) :- p(3646), q(3647).
-q(3647) :- p(3646), q(3646).
-p(3648) :- p(3647), q(3648).
-q(3648) :- p(3647), q(3647).
-p(3649) :- p(3648), q(3649).
-q(3649) :- p(3648), q(3648).
-p(3650) :- p(3649), q(3650).
-q(3650) :- p(3649), q(3649).
-p(3651) :- p(3650), q(3651).
-q(3651) :- p(3650), q(3650).
-p(3652) :- p(3651), q(3652).
-q(3652) :- p(3651), q(3651).
-p(3653) :- p(3652), q(3653).
-q(3653) :- p(3652), q(3652).
-p(3654) :- p(3653), q(3654).
-q(3654) :- p(3653), q(3653).
-p(3655) :- p(3654), q(3655).
-q(3655) :- p(3654), q(3654).
-p(3656) :- p(3655), q(3656).
-q(3656) :- p(3655), q(3655).
-p(3657) :- p(3656), q(3657).
-q(3657) :- p(3656), q(3656).
-p(3658) :- p(3657), q(3658).
-q(3658) :- p(3657), q(3657).
-p(3659) :- p(3658), q(3659).
-q(3659) :- p(3658), q(3658).
-p(3660) :- p(3659), q(3660).
-q(3660) :- p(3659), q(3659).
-p(3661) :- p(3660), q(3661).
-q(3661) :- p(3660), q(3660).
-p(3662) :- p(3661), q(3662).
-q(3662) :- p(3661), q(3661).
-p(3663) :- p(3662), q(3663).
-q(3663) :- p(3662), q(3662).
-p(3664) :- p(3663), q(3664).
-q(3664) :- p(3663), q(3663).
-p(3665) :- p(3664), q(3665).
-q(3665) :- p(3664), q(3664).
-p(3666) :- p(3665), q(3666).
-q(3666) :- p(3665), q(3665).
-p(3667) :- p(3666), q(3667).
-q(3667) :- p(3666), q(3666).
-p(3668) :- p(3667), q(3668).
-q(3668) :- p(3667), q(3667).
-p(3669) :- p(3668), q(3669).
-q(3669) :- p(3668), q(3668).
-p(3670) :- p(3669), q(3670).
-q(3670) :- p(3669), q(3669).
-p(3671) :- p(3670), q(3671).
-q(3671) :- p(3670), q(3670).
-p(3672) :- p(3671), q(3672).
-q(3672) :- p(3671), q(3671).
-p(3673) :- p(3672), q(3673).
-q(3673) :- p(3672), q(3672).
-p(3674) :- p(3673), q(3674).
-q(3674) :- p(3673), q(3673).
-p(3675) :- p(3674), q(3675).
-q(3675) :- p(3674), q(3674).
-p(3676) :- p(3675), q(3676).
-q(3676) :- p(3675), q(3675).
-p(3677) :- p(3676), q(3677).
-q(3677) :- p(3676), q(3676).
-p(3678) :- p(3677), q(3678).
-q(3678) :- p(3677), q(3677).
-p(3679) :- p(3678), q(3679).
-q(3679) :- p(3678), q(3678).
-p(3680) :- p(3679), q(3680).
-q(3680) :- p(3679), q(3679).
-p(3681) :- p(3680), q(3681).
-q(3681) :- p(3680), q(3680).
-p(3682) :- p(3681), q(3682).
-q(3682) :- p(3681), q(3681).
-p(3683) :- p(3682), q(3683).
-q(3683) :- p(3682), q(3682).
-p(3684) :- p(3683), q(3684).
-q(3684) :- p(3683), q(3683).
-p(3685) :- p(3684), q(3685).
-q(3685) :- p(3684), q(3684).
-p(3686) :- p(3685), q(3686).
-q(3686) :- p(3685), q(3685).
-p(3687) :- p(3686), q(3687).
-q(3687) :- p(3686), q(3686).
-p(3688) :- p(3687), q(3688).
-q(3688) :- p(3687), q(3687).
-p(3689) :- p(3688), q(3689).
-q(3689) :- p(3688), q(3688).
-p(3690) :- p(3689), q(3690).
-q(3690) :- p(3689), q(3689).
-p(3691) :- p(3690), q(3691).
-q(3691) :- p(3690), q(3690).
-p(3692) :- p(3691), q(3692).
-q(3692) :- p(3691), q(3691).
-p(3693) :- p(3692), q(3693).
-q(3693) :- p(3692), q(3692).
-p(3694) :- p(3693), q(3694).
-q(3694) :- p(3693), q(3693).
-p(3695) :- p(3694), q(3695).
-q(3695) :- p(3694), q(3694).
-p(3696) :- p(3695), q(3696).
-q(3696) :- p(3695), q(3695).
-p(3697) :- p(3696), q(3697).
-q(3697) :- p(3696), q(3696).
-p(3698) :- p(3697), q(3698).
-q(3698) :- p(3697), q(3697).
-p(3699) :- p(3698), q(3699).
-q(3699) :- p(3698), q(3698).
-p(3700) :- p(3699), q(3700).
-q(3700) :- p(3699), q(3699).
-p(3701) :- p(3700), q(3701).
-q(3701) :- p(3700), q(3700).
-p(3702) :- p(3701), q(3702).
-q(3702) :- p(3701), q(3701).
-p(3703) :- p(3702), q(3703).
-q(3703) :- p(3702), q(3702).
-p(3704) :- p(3703), q(3704).
-q(3704) :- p(3703), q(3703).
-p(3705) :- p(3704), q(3705).
-q(3705) :- p(3704), q(3704).
-p(3706) :- p(3705), q(3706).
-q(3706) :- p(3705), q(3705).
-p(3707) :- p(3706), q(3707).
-q(3707) :- p(3706), q(3706).
-p(3708) :- p(3707), q(3708).
-q(3708) :- p(3707), q(3707).
-p(3709) :- p(3708), q(3709).
-q(3709) :- p(3708), q(3708).
-p(3710) :- p(3709), q(3710).
-q(3710) :- p(3709), q(3709).
-p(3711) :- p(3710), q(3711).
-q(3711) :- p(3710), q(3710).
-p(3712) :- p(3711), q(3712).
-q(3712) :- p(3711), q(3711).
-p(3713) :- p(3712), q(3713).
-q(3713) :- p(3712), q(3712).
-p(3714) :- p(3713), q(3714).
-q(3714) :- p(3713), q(3713).
-p(3715) :- p(3714), q(3715).
-q(3715) :- p(3714), q(3714).
-p(3716) :- p(3715), q(3716).
-q(3716) :- p(3715), q(3715).
-p(3717) :- p(3716), q(3717).
-q(3717) :- p(3716), q(3716).
-p(3718) :- p(3717), q(3718).
-q(3718) :- p(3717), q(3717).
-p(3719) :- p(3718), q(3719).
-q(3719) :- p(3718), q(3718).
-p(3720) :- p(3719), q(3720).
-q(3720) :- p(3719), q(3719).
-p(3721) :- p(3720), q(3721).
-q(3721) :- p(3720), q(3720).
-p(3722) :- p(3721), q(3722).
-q(3722) :- p(3721), q(3721).
-p(3723) :- p(3722), q(3723).
-q(3723) :- p(3722), q(3722).
-p(3724) :- p(3723), q(3724).
-q(3724) :- p(3723), q(3723).
-p(3725) :- p(3724), q(3725).
-q(3725) :- p(3724), q(3724).
-p(3726) :- p(3725), q(3726).
-q(3726) :- p(3725), q(3725).
-p(3727) :- p(3726), q(3727).
-q(3727) :- p(3726), q(3726).
-p(3728) :- p(3727), q(3728).
-q(3728) :- p(3727), q(3727).
-p(3729) :- p(3728), q(3729).
-q(3729) :- p(3728), q(3728).
-p(3730) :- p(3729), q(3730).
-q(3730) :- p(3729), q(3729).
-p(3731) :- p(3730), q(3731).
-q(3731) :- p(3730), q(3730).
-p(3732) :- p(3731), q(3732).
-q(3732) :- p(3731), q(3731).
-p(3733) :- p(3732), q(3733).
-q(3733) :- p(3732), q(3732).
-p(3734) :- p(3733), q(3734).
-q(3734) :- p(3733), q(3733).
-p(3735) :- p(3734), q(3735).
-q(3735) :- p(3734), q(3734).
-p(3736) :- p(3735), q(3736).
-q(3736) :- p(3735), q(3735).
-p(3737) :- p(3736), q(3737).
-q(3737) :- p(3736), q(3736).
-p(3738) :- p(3737), q(3738).
-q(3738) :- p(3737), q(3737).
-p(3739) :- p(3738), q(3739).
-q(3739) :- p(3738), q(3738).
-p(3740) :- p(3739), q(3740).
-q(3740) :- p(3739), q(3739).
-p(3741) :- p(3740), q(3741).
-q(3741) :- p(3740), q(3740).
-p(3742) :- p(3741), q(3742).
-q(3742) :- p(3741), q(3741).
-p(3743) :- p(3742), q(3743).
-q(3743) :- p(3742), q(3742).
-p(3744) :- p(3743), q(3744).
-q(3744) :- p(3743), q(3743).
-p(3745) :- p(3744), q(3745).
-q(3745) :- p(3744), q(3744).
-p(3746) :- p(3745), q(3746).
-q(3746) :- p(3745), q(3745).
-p(3747) :- p(3746), q(3747).
-q(3747) :- p(3746), q(3746).
-p(3748) :- p(3747), q(3748).
-q(3748) :- p(3747), q(3747).
-p(3749) :- p(3748), q(3749).
-q(3749) :- p(3748), q(3748).
-p(3750) :- p(3749), q(3750).
-q(3750) :- p(3749), q(3749).
-p(3751) :- p(3750), q(3751).
-q(3751) :- p(3750), q(3750).
-p(3752) :- p(3751), q(3752).
-q(3752) :- p(3751), q(3751).
-p(3753) :- p(3752), q(3753).
-q(3753) :- p(3752), q(3752).
-p(3754) :- p(3753), q(3754).
-q(3754) :- p(3753), q(3753).
-p(3755) :- p(3754), q(3755).
-q(3755) :- p(3754), q(3754).
-p(3756) :- p(3755), q(3756).
-q(3756) :- p(3755), q(3755).
-p(3757) :- p(3756), q(3757).
-q(3757) :- p(3756), q(3756).
-p(3758) :- p(3757), q(3758).
-q(3758) :- p(3757), q(3757).
-p(3759) :- p(3758), q(3759).
-q(3759) :- p(3758), q(3758).
-p(3760) :- p(3759), q(3760).
-q(3760) :- p(3759), q(3759).
-p(3761) :- p(3760), q(3761).
-q(3761) :- p(3760), q(3760).
-p(3762) :- p(3761), q(3762).
-q(3762) :- p(3761), q(3761).
-p(3763) :- p(3762), q(3763).
-q(3763) :- p(3762), q(3762).
-p(3764) :- p(3763), q(3764).
-q(3764) :- p(3763), q(3763).
-p(3765) :- p(3764), q(3765).
-q(3765) :- p(3764), q(3764).
-p(3766) :- p(3765), q(3766).
-q(3766) :- p(3765), q(3765).
-p(3767) :- p(3766), q(3767).
-q(3767) :- p(3766), q(3766).
-p(3768) :- p(3767), q(3768).
-q(3768) :- p(3767), q(3767).
-p(3769) :- p(3768), q(3769).
-q(3769) :- p(3768), q(3768).
-p(3770) :- p(3769), q(3770).
-q(3770) :- p(3769), q(3769).
-p(3771) :- p(3770), q(3771).
-q(3771) :- p(3770), q(3770).
-p(3772) :- p(3771), q(3772).
-q(3772) :- p(3771), q(3771).
-p(3773) :- p(3772), q(3773).
-q(3773) :- p(3772), q(3772).
-p(3774) :- p(3773), q(3774).
-q(3774) :- p(3773), q(3773).
-p(3775) :- p(3774), q(3775).
-q(3775) :- p(3774), q(3774).
-p(3776) :- p(3775), q(3776).
-q(3776) :- p(3775), q(3775).
-p(3777) :- p(3776), q(3777).
-q(3777) :- p(3776), q(3776).
-p(3778) :- p(3777), q(3778).
-q(3778) :- p(3777), q(3777).
-p(3779) :- p(3778), q(3779).
-q(3779) :- p(3778), q(3778).
-p(3780) :- p(3779), q(3780).
-q(3780) :- p(3779), q(3779).
-p(3781) :- p(3780), q(3781).
-q(3781) :- p(3780), q(3780).
-p(3782) :- p(3781), q(3782).
-q(3782) :- p(3781), q(3781).
-p(3783) :- p(3782), q(3783).
-q(3783) :- p(3782), q(3782).
-p(3784) :- p(3783), q(3784).
-q(3784) :- p(3783), q(3783).
-p(3785) :- p(3784), q(3785).
-q(3785) :- p(3784), q(3784).
-p(3786) :- p(3785), q(3786).
-q(3786) :- p(3785), q(3785).
-p(3787) :- p(3786), q(3787).
-q(3787) :- p(3786), q(3786).
-p(3788) :- p(3787), q(3788).
-q(3788) :- p(3787), q(3787).
-p(3789) :- p(3788), q(3789).
-q(3789) :- p(3788), q(3788).
-p(3790) :- p(3789), q(3790).
-q(3790) :- p(3789), q(3789).
-p(3791) :- p(3790), q(3791).
-q(3791) :- p(3790), q(3790).
-p(3792) :- p(3791), q(3792).
-q(3792) :- p(3791), q(3791).
-p(3793) :- p(3792), q(3793).
-q(3793) :- p(3792), q(3792).
-p(3794) :- p(3793), q(3794).
-q(3794) :- p(3793), q(3793).
-p(3795) :- p(3794), q(3795).
-q(3795) :- p(3794), q(3794).
-p(3796) :- p(3795), q(3796).
-q(3796) :- p(3795), q(3795).
-p(3797) :- p(3796), q(3797).
-q(3797) :- p(3796), q(3796).
-p(3798) :- p(3797), q(3798).
-q(3798) :- p(3797), q(3797).
-p(3799) :- p(3798), q(3799).
-q(3799) :- p(3798), q(3798).
-p(3800) :- p(3799), q(3800).
-q(3800) :- p(3799), q(3799).
-p(3801) :- p(3800), q(3801).
-q(3801) :- p(3800), q(3800).
-p(3802) :- p(3801), q(3802).
-q(3802) :- p(3801), q(3801).
-p(3803) :- p(3802), q(3803).
-q(3803) :- p(3802), q(3802).
-p(3804) :- p(3803), q(3804).
-q(3804) :- p(3803), q(3803).
-p(3805) :- p(3804), q(3805).
-q(3805) :- p(3804), q(3804).
-p(3806) :- p(3805), q(3806).
-q(3806) :- p(3805), q(3805).
-p(3807) :- p(3806), q(3807).
-q(3807) :- p(3806), q(3806).
-p(3808) :- p(3807), q(3808).
-q(3808) :- p(3807), q(3807).
-p(3809) :- p(3808), q(3809).
-q(3809) :- p(3808), q(3808).
-p(3810) :- p(3809), q(3810).
-q(3810) :- p(3809), q(3809).
-p(3811) :- p(3810), q(3811).
-q(3811) :- p(3810), q(3810).
-p(3812) :- p(3811), q(3812).
-q(3812) :- p(3811), q(3811).
-p(3813) :- p(3812), q(3813).
-q(3813) :- p(3812), q(3812).
-p(3814) :- p(3813), q(3814).
-q(3814) :- p(3813), q(3813).
-p(3815) :- p(3814), q(3815).
-q(3815) :- p(3814), q(3814).
-p(3816) :- p(3815), q(3816).
-q(3816) :- p(3815), q(3815).
-p(3817) :- p(3816), q(3817).
-q(3817) :- p(3816), q(3816).
-p(3818) :- p(3817), q(3818).
-q(3818) :- p(3817), q(3817).
-p(3819) :- p(3818), q(3819).
-q(3819) :- p(3818), q(3818).
-p(3820) :- p(3819), q(3820).
-q(3820) :- p(3819), q(3819).
-p(3821) :- p(3820), q(3821).
-q(3821) :- p(3820), q(3820).
-p(3822) :- p(3821), q(3822).
-q(3822) :- p(3821), q(3821).
-p(3823) :- p(3822), q(3823).
-q(3823) :- p(3822), q(3822).
-p(3824) :- p(3823), q(3824).
-q(3824) :- p(3823), q(3823).
-p(3825) :- p(3824), q(3825).
-q(3825) :- p(3824), q(3824).
-p(3826) :- p(3825), q(3826).
-q(3826) :- p(3825), q(3825).
-p(3827) :- p(3826), q(3827).
-q(3827) :- p(3826), q(3826).
-p(3828) :- p(3827), q(3828).
-q(3828) :- p(3827), q(3827).
-p(3829) :- p(3828), q(3829).
-q(3829) :- p(3828), q(3828).
-p(3830) :- p(3829), q(3830).
-q(3830) :- p(3829), q(3829).
-p(3831) :- p(3830), q(3831).
-q(3831) :- p(3830), q(3830).
-p(3832) :- p(3831), q(3832).
-q(3832) :- p(3831), q(3831).
-p(3833) :- p(3832), q(3833).
-q(3833) :- p(3832), q(3832).
-p(3834) :- p(3833), q(3834).
-q(3834) :- p(3833), q(3833).
-p(3835) :- p(3834), q(3835).
-q(3835) :- p(3834), q(3834).
-p(3836) :- p(3835), q(3836).
-q(3836) :- p(3835), q(3835).
-p(3837) :- p(3836), q(3837).
-q(3837) :- p(3836), q(3836).
-p(3838) :- p(3837), q(3838).
-q(3838) :- p(3837), q(3837).
-p(3839) :- p(3838), q(3839).
-q(3839) :- p(3838), q(3838).
-p(3840) :- p(3839), q(3840).
-q(3840) :- p(3839), q(3839).
-p(3841) :- p(3840), q(3841).
-q(3841) :- p(3840), q(3840).
-p(3842) :- p(3841), q(3842).
-q(3842) :- p(3841), q(3841).
-p(3843) :- p(3842), q(3843).
-q(3843) :- p(3842), q(3842).
-p(3844) :- p(3843), q(3844).
-q(3844) :- p(3843), q(3843).
-p(3845) :- p(3844), q(3845).
-q(3845) :- p(3844), q(3844).
-p(3846) :- p(3845), q(3846).
-q(3846) :- p(3845), q(3845).
-p(3847) :- p(3846), q(3847).
-q(3847) :- p(3846), q(3846).
-p(3848) :- p(3847), q(3848).
-q(3848) :- p(3847), q(3847).
-p(3849) :- p(3848), q(3849).
-q(3849) :- p(3848), q(3848).
-p(3850) :- p(3849), q(3850).
-q(3850) :- p(3849), q(3849).
-p(3851) :- p(3850), q(3851).
-q(3851) :- p(3850), q(3850).
-p(3852) :- p(3851), q(3852).
-q(3852) :- p(3851), q(3851).
-p(3853) :- p(3852), q(3853).
-q(3853) :- p(3852), q(3852).
-p(3854) :- p(3853), q(3854).
-q(3854) :- p(3853), q(3853).
-p(3855) :- p(3854), q(3855).
-q(3855) :- p(3854), q(3854).
-p(3856) :- p(3855), q(3856).
-q(3856) :- p(3855), q(3855).
-p(3857) :- p(3856), q(3857).
-q(3857) :- p(3856), q(3856).
-p(3858) :- p(3857), q(3858).
-q(3858) :- p(3857), q(3857).
-p(3859) :- p(3858), q(3859).
-q(3859) :- p(3858), q(3858).
-p(3860) :- p(3859), q(3860).
-q(3860) :- p(3859), q(3859).
-p(3861) :- p(3860), q(3861).
-q(3861) :- p(3860), q(3860).
-p(3862) :- p(3861), q(3862).
-q(3862) :- p(3861), q(3861).
-p(3863) :- p(3862), q(3863).
-q(3863) :- p(3862), q(3862).
-p(3864) :- p(3863), q(3864).
-q(3864) :- p(3863), q(3863).
-p(3865) :- p(3864), q(3865).
-q(3865) :- p(3864), q(3864).
-p(3866) :- p(3865), q(3866).
-q(3866) :- p(3865), q(3865).
-p(3867) :- p(3866), q(3867).
-q(3867) :- p(3866), q(3866).
-p(3868) :- p(3867), q(3868).
-q(3868) :- p(3867), q(3867).
-p(3869) :- p(3868), q(3869).
-q(3869) :- p(3868), q(3868).
-p(3870) :- p(3869), q(3870).
-q(3870) :- p(3869), q(3869).
-p(3871) :- p(3870), q(3871).
-q(3871) :- p(3870), q(3870).
-p(3872) :- p(3871), q(3872).
-q(3872) :- p(3871), q(3871).
-p(3873) :- p(3872), q(3873).
-q(3873) :- p(3872), q(3872).
-p(3874) :- p(3873), q(3874).
-q(3874) :- p(3873), q(3873).
-p(3875) :- p(3874), q(3875).
-q(3875) :- p(3874), q(3874).
-p(3876) :- p(3875), q(3876).
-q(3876) :- p(3875), q(3875).
-p(3877) :- p(3876), q(3877).
-q(3877) :- p(3876), q(3876).
-p(3878) :- p(3877), q(3878).
-q(3878) :- p(3877), q(3877).
-p(3879) :- p(3878), q(3879).
-q(3879) :- p(3878), q(3878).
-p(3880) :- p(3879), q(3880).
-q(3880) :- p(3879), q(3879).
-p(3881) :- p(3880), q(3881).
-q(3881) :- p(3880), q(3880).
-p(3882) :- p(3881), q(3882).
-q(3882) :- p(3881), q(3881).
-p(3883) :- p(3882), q(3883).
-q(3883) :- p(3882), q(3882).
-p(3884) :- p(3883), q(3884).
-q(3884) :- p(3883), q(3883).
-p(3885) :- p(3884), q(3885).
-q(3885) :- p(3884), q(3884).
-p(3886) :- p(3885), q(3886).
-q(3886) :- p(3885), q(3885).
-p(3887) :- p(3886), q(3887).
-q(3887) :- p(3886), q(3886).
-p(3888) :- p(3887), q(3888).
-q(3888) :- p(3887), q(3887).
-p(3889) :- p(3888), q(3889).
-q(3889) :- p(3888), q(3888).
-p(3890) :- p(3889), q(3890).
-q(3890) :- p(3889), q(3889).
-p(3891) :- p(3890), q(3891).
-q(3891) :- p(3890), q(3890).
-p(3892) :- p(3891), q(3892).
-q(3892) :- p(3891), q(3891).
-p(3893) :- p(3892), q(3893).
-q(3893) :- p(3892), q(3892).
-p(3894) :- p(3893), q(3894).
-q(3894) :- p(3893), q(3893).
-p(3895) :- p(3894), q(3895).
-q(3895) :- p(3894), q(3894).
-p(3896) :- p(3895), q(3896).
-q(3896) :- p(3895), q(3895).
-p(3897) :- p(3896), q(3897).
-q(3897) :- p(3896), q(3896).
-p(3898) :- p(3897), q(3898).
-q(3898) :- p(3897), q(3897).
-p(3899) :- p(3898), q(3899).
-q(3899) :- p(3898), q(3898).
-p(3900) :- p(3899), q(3900).
-q(3900) :- p(3899), q(3899).
-p(3901) :- p(3900), q(3901).
-q(3901) :- p(3900), q(3900).
-p(3902) :- p(3901), q(3902).
-q(3902) :- p(3901), q(3901).
-p(3903) :- p(3902), q(3903).
-q(3903) :- p(3902), q(3902).
-p(3904) :- p(3903), q(3904).
-q(3904) :- p(3903), q(3903).
-p(3905) :- p(3904), q(3905).
-q(3905) :- p(3904), q(3904).
-p(3906) :- p(3905), q(3906).
-q(3906) :- p(3905), q(3905).
-p(3907) :- p(3906), q(3907).
-q(3907) :- p(3906), q(3906).
-p(3908) :- p(3907), q(3908).
-q(3908) :- p(3907), q(3907).
-p(3909) :- p(3908), q(3909).
-q(3909) :- p(3908), q(3908).
-p(3910) :- p(3909), q(3910).
-q(3910) :- p(3909), q(3909).
-p(3911) :- p(3910), q(3911).
-q(3911) :- p(3910), q(3910).
-p(3912) :- p(3911), q(3912).
-q(3912) :- p(3911), q(3911).
-p(3913) :- p(3912), q(3913).
-q(3913) :- p(3912), q(3912).
-p(3914) :- p(3913), q(3914).
-q(3914) :- p(3913), q(3913).
-p(3915) :- p(3914), q(3915).
-q(3915) :- p(3914), q(3914).
-p(3916) :- p(3915), q(3916).
-q(3916) :- p(3915), q(3915).
-p(3917) :- p(3916), q(3917).
-q(3917) :- p(3916), q(3916).
-p(3918) :- p(3917), q(3918).
-q(3918) :- p(3917), q(3917).
-p(3919) :- p(3918), q(3919).
-q(3919) :- p(3918), q(3918).
-p(3920) :- p(3919), q(3920).
-q(3920) :- p(3919), q(3919).
-p(3921) :- p(3920), q(3921).
-q(3921) :- p(3920), q(3920).
-p(3922) :- p(3921), q(3922).
-q(3922) :- p(3921), q(3921).
-p(3923) :- p(3922), q(3923).
-q(3923) :- p(3922), q(3922).
-p(3924) :- p(3923), q(3924).
-q(3924) :- p(3923), q(3923).
-p(3925) :- p(3924), q(3925).
-q(3925) :- p(3924), q(3924).
-p(3926) :- p(3925), q(3926).
-q(3926) :- p(3925), q(3925).
-p(3927) :- p(3926), q(3927).
-q(3927) :- p(3926), q(3926).
-p(3928) :- p(3927), q(3928).
-q(3928) :- p(3927), q(3927).
-p(3929) :- p(3928), q(3929).
-q(3929) :- p(3928), q(3928).
-p(3930) :- p(3929), q(3930).
-q(3930) :- p(3929), q(3929).
-p(3931) :- p(3930), q(3931).
-q(3931) :- p(3930), q(3930).
-p(3932) :- p(3931), q(3932).
-q(3932) :- p(3931), q(3931).
-p(3933) :- p(3932), q(3933).
-q(3933) :- p(3932), q(3932).
-p(3934) :- p(3933), q(3934).
-q(3934) :- p(3933), q(3933).
-p(3935) :- p(3934), q(3935).
-q(3935) :- p(3934), q(3934).
-p(3936) :- p(3935), q(3936).
-q(3936) :- p(3935), q(3935).
-p(3937) :- p(3936), q(3937).
-q(3937) :- p(3936), q(3936).
-p(3938) :- p(3937), q(3938).
-q(3938) :- p(3937), q(3937).
-p(3939) :- p(3938), q(3939).
-q(3939) :- p(3938), q(3938).
-p(3940) :- p(3939), q(3940).
-q(3940) :- p(3939), q(3939).
-p(3941) :- p(3940), q(3941).
-q(3941) :- p(3940), q(3940).
-p(3942) :- p(3941), q(3942).
-q(3942) :- p(3941), q(3941).
-p(3943) :- p(3942), q(3943).
-q(3943) :- p(3942), q(3942).
-p(3944) :- p(3943), q(3944).
-q(3944) :- p(3943), q(3943).
-p(3945) :- p(3944), q(3945).
-q(3945) :- p(3944), q(3944).
-p(3946) :- p(3945), q(3946).
-q(3946) :- p(3945), q(3945).
-p(3947) :- p(3946), q(3947).
-q(3947) :- p(3946), q(3946).
-p(3948) :- p(3947), q(3948).
-q(3948) :- p(3947), q(3947).
-p(3949) :- p(3948), q(3949).
-q(3949) :- p(3948), q(3948).
-p(3950) :- p(3949), q(3950).
-q(3950) :- p(3949), q(3949).
-p(3951) :- p(3950), q(3951).
-q(3951) :- p(3950), q(3950).
-p(3952) :- p(3951), q(3952).
-q(3952) :- p(3951), q(3951).
-p(3953) :- p(3952), q(3953).
-q(3953) :- p(3952), q(3952).
-p(3954) :- p(3953), q(3954).
-q(3954) :- p(3953), q(3953).
-p(3955) :- p(3954), q(3955).
-q(3955) :- p(3954), q(3954).
-p(3956) :- p(3955), q(3956).
-q(3956) :- p(3955), q(3955).
-p(3957) :- p(3956), q(3957).
-q(3957) :- p(3956), q(3956).
-p(3958) :- p(3957), q(3958).
-q(3958) :- p(3957), q(3957).
-p(3959) :- p(3958), q(3959).
-q(3959) :- p(3958), q(3958).
-p(3960) :- p(3959), q(3960).
-q(3960) :- p(3959), q(3959).
-p(3961) :- p(3960), q(3961).
-q(3961) :- p(3960), q(3960).
-p(3962) :- p(3961), q(3962).
-q(3962) :- p(3961), q(3961).
-p(3963) :- p(3962), q(3963).
-q(3963) :- p(3962), q(3962).
-p(3964) :- p(3963), q(3964).
-q(3964) :- p(3963), q(3963).
-p(3965) :- p(3964), q(3965).
-q(3965) :- p(3964), q(3964).
-p(3966) :- p(3965), q(3966).
-q(3966) :- p(3965), q(3965).
-p(3967) :- p(3966), q(3967).
-q(3967) :- p(3966), q(3966).
-p(3968) :- p(3967), q(3968).
-q(3968) :- p(3967), q(3967).
-p(3969) :- p(3968), q(3969).
-q(3969) :- p(3968), q(3968).
-p(3970) :- p(3969), q(3970).
-q(3970) :- p(3969), q(3969).
-p(3971) :- p(3970), q(3971).
-q(3971) :- p(3970), q(3970).
-p(3972) :- p(3971), q(3972).
-q(3972) :- p(3971), q(3971).
-p(3973) :- p(3972), q(3973).
-q(3973) :- p(3972), q(3972).
-p(3974) :- p(3973), q(3974).
-q(3974) :- p(3973), q(3973).
-p(3975) :- p(3974), q(3975).
-q(3975) :- p(3974), q(3974).
-p(3976) :- p(3975), q(3976).
-q(3976) :- p(3975), q(3975).
-p(3977) :- p(3976), q(3977).
-q(3977) :- p(3976), q(3976).
-p(3978) :- p(3977), q(3978).
-q(3978) :- p(3977), q(3977).
-p(3979) :- p(3978), q(3979).
-q(3979) :- p(3978), q(3978).
-p(3980) :- p(3979), q(3980).
-q(3980) :- p(3979), q(3979).
-p(3981) :- p(3980), q(3981).
-q(3981) :- p(3980), q(3980).
-p(3982) :- p(3981), q(3982).
-q(3982) :- p(3981), q(3981).
-p(3983) :- p(3982), q(3983).
-q(3983) :- p(3982), q(3982).
-p(3984) :- p(3983), q(3984).
-q(3984) :- p(3983), q(3983).
-p(3985) :- p(3984), q(3985).
-q(3985) :- p(3984), q(3984).
-p(3986) :- p(3985), q(3986).
-q(3986) :- p(3985), q(3985).
-p(3987) :- p(3986), q(3987).
-q(3987) :- p(3986), q(3986).
-p(3988) :- p(3987), q(3988).
-q(3988) :- p(3987), q(3987).
-p(3989) :- p(3988), q(3989).
-q(3989) :- p(3988), q(3988).
-p(3990) :- p(3989), q(3990).
-q(3990) :- p(3989), q(3989).
-p(3991) :- p(3990), q(3991).
-q(3991) :- p(3990), q(3990).
-p(3992) :- p(3991), q(3992).
-q(3992) :- p(3991), q(3991).
-p(3993) :- p(3992), q(3993).
-q(3993) :- p(3992), q(3992).
-p(3994) :- p(3993), q(3994).
-q(3994) :- p(3993), q(3993).
-p(3995) :- p(3994), q(3995).
-q(3995) :- p(3994), q(3994).
-p(3996) :- p(3995), q(3996).
-q(3996) :- p(3995), q(3995).
-p(3997) :- p(3996), q(3997).
-q(3997) :- p(3996), q(3996).
-p(3998) :- p(3997), q(3998).
-q(3998) :- p(3997), q(3997).
-p(3999) :- p(3998), q(3999).
-q(3999) :- p(3998), q(3998).
-p(4000) :- p(3999), q(4000).
-q(4000) :- p(3999), q(3999).
-p(4001) :- p(4000), q(4001).
-q(4001) :- p(4000), q(4000).
-p(4002) :- p(4001), q(4002).
-q(4002) :- p(4001), q(4001).
-p(4003) :- p(4002), q(4003).
-q(4003) :- p(4002), q(4002).
-p(4004) :- p(4003), q(4004).
-q(4004) :- p(4003), q(4003).
-p(4005) :- p(4004), q(4005).
-q(4005) :- p(4004), q(4004).
-p(4006) :- p(4005), q(4006).
-q(4006) :- p(4005), q(4005).
-p(4007) :- p(4006), q(4007).
-q(4007) :- p(4006), q(4006).
-p(4008) :- p(4007), q(4008).
-q(4008) :- p(4007), q(4007).
-p(4009) :- p(4008), q(4009).
-q(4009) :- p(4008), q(4008).
-p(4010) :- p(4009), q(4010).
-q(4010) :- p(4009), q(4009).
-p(4011) :- p(4010), q(4011).
-q(4011) :- p(4010), q(4010).
-p(4012) :- p(4011), q(4012).
-q(4012) :- p(4011), q(4011).
-p(4013) :- p(4012), q(4013).
-q(4013) :- p(4012), q(4012).
-p(4014) :- p(4013), q(4014).
-q(4014) :- p(4013), q(4013).
-p(4015) :- p(4014), q(4015).
-q(4015) :- p(4014), q(4014).
-p(4016) :- p(4015), q(4016).
-q(4016) :- p(4015), q(4015).
-p(4017) :- p(4016), q(4017).
-q(4017) :- p(4016), q(4016).
-p(4018) :- p(4017), q(4018).
-q(4018) :- p(4017), q(4017).
-p(4019) :- p(4018), q(4019).
-q(4019) :- p(4018), q(4018).
-p(4020) :- p(4019), q(4020).
-q(4020) :- p(4019), q(4019).
-p(4021) :- p(4020), q(4021).
-q(4021) :- p(4020), q(4020).
-p(4022) :- p(4021), q(4022).
-q(4022) :- p(4021), q(4021).
-p(4023) :- p(4022), q(4023).
-q(4023) :- p(4022), q(4022).
-p(4024) :- p(4023), q(4024).
-q(4024) :- p(4023), q(4023).
-p(4025) :- p(4024), q(4025).
-q(4025) :- p(4024), q(4024).
-p(4026) :- p(4025), q(4026).
-q(4026) :- p(4025), q(4025).
-p(4027) :- p(4026), q(4027).
-q(4027) :- p(4026), q(4026).
-p(4028) :- p(4027), q(4028).
-q(4028) :- p(4027), q(4027).
-p(4029) :- p(4028), q(4029).
-q(4029) :- p(4028), q(4028).
-p(4030) :- p(4029), q(4030).
-q(4030) :- p(4029), q(4029).
-p(4031) :- p(4030), q(4031).
-q(4031) :- p(4030), q(4030).
-p(4032) :- p(4031), q(4032).
-q(4032) :- p(4031), q(4031).
-p(4033) :- p(4032), q(4033).
-q(4033) :- p(4032), q(4032).
-p(4034) :- p(4033), q(4034).
-q(4034) :- p(4033), q(4033).
-p(4035) :- p(4034), q(4035).
-q(4035) :- p(4034), q(4034).
-p(4036) :- p(4035), q(4036).
-q(4036) :- p(4035), q(4035).
-p(4037) :- p(4036), q(4037).
-q(4037) :- p(4036), q(4036).
-p(4038) :- p(4037), q(4038).
-q(4038) :- p(4037), q(4037).
-p(4039) :- p(4038), q(4039).
-q(4039) :- p(4038), q(4038).
-p(4040) :- p(4039), q(4040).
-q(4040) :- p(4039), q(4039).
-p(4041) :- p(4040), q(4041).
-q(4041) :- p(4040), q(4040).
-p(4042) :- p(4041), q(4042).
-q(4042) :- p(4041), q(4041).
-p(4043) :- p(4042), q(4043).
-q(4043) :- p(4042), q(4042).
-p(4044) :- p(4043), q(4044).
-q(4044) :- p(4043), q(4043).
-p(4045) :- p(4044), q(4045).
-q(4045) :- p(4044), q(4044).
-p(4046) :- p(4045), q(4046).
-q(4046) :- p(4045), q(4045).
-p(4047) :- p(4046), q(4047).
-q(4047) :- p(4046), q(4046).
-p(4048) :- p(4047), q(4048).
-q(4048) :- p(4047), q(4047).
-p(4049) :- p(4048), q(4049).
-q(4049) :- p(4048), q(4048).
-p(4050) :- p(4049), q(4050).
-q(4050) :- p(4049), q(4049).
-p(4051) :- p(4050), q(4051).
-q(4051) :- p(4050), q(4050).
-p(4052) :- p(4051), q(4052).
-q(4052) :- p(4051), q(4051).
-p(4053) :- p(4052), q(4053).
-q(4053) :- p(4052), q(4052).
-p(4054) :- p(4053), q(4054).
-q(4054) :- p(4053), q(4053).
-p(4055) :- p(4054), q(4055).
-q(4055) :- p(4054), q(4054).
-p(4056) :- p(4055), q(4056).
-q(4056) :- p(4055), q(4055).
-p(4057) :- p(4056), q(4057).
-q(4057) :- p(4056), q(4056).
-p(4058) :- p(4057), q(4058).
-q(4058) :- p(4057), q(4057).
-p(4059) :- p(4058), q(4059).
-q(4059) :- p(4058), q(4058).
-p(4060) :- p(4059), q(4060).
-q(4060) :- p(4059), q(4059).
-p(4061) :- p(4060), q(4061).
-q(4061) :- p(4060), q(4060).
-p(4062) :- p(4061), q(4062).
-q(4062) :- p(4061), q(4061).
-p(4063) :- p(4062), q(4063).
-q(4063) :- p(4062), q(4062).
-p(4064) :- p(4063), q(4064).
-q(4064) :- p(4063), q(4063).
-p(4065) :- p(4064), q(4065).
-q(4065) :- p(4064), q(4064).
-p(4066) :- p(4065), q(4066).
-q(4066) :- p(4065), q(4065).
-p(4067) :- p(4066), q(4067).
-q(4067) :- p(4066), q(4066).
-p(4068) :- p(4067), q(4068).
-q(4068) :- p(4067), q(4067).
-p(4069) :- p(4068), q(4069).
-q(4069) :- p(4068), q(4068).
-p(4070) :- p(4069), q(4070).
-q(4070) :- p(4069), q(4069).
-p(4071) :- p(4070), q(4071).
-q(4071) :- p(4070), q(4070).
-p(4072) :- p(4071), q(4072).
-q(4072) :- p(4071), q(4071).
-p(4073) :- p(4072), q(4073).
-q(4073) :- p(4072), q(4072).
-p(4074) :- p(4073), q(4074).
-q(4074) :- p(4073), q(4073).
-p(4075) :- p(4074), q(4075).
-q(4075) :- p(4074), q(4074).
-p(4076) :- p(4075), q(4076).
-q(4076) :- p(4075), q(4075).
-p(4077) :- p(4076), q(4077).
-q(4077) :- p(4076), q(4076).
-p(4078) :- p(4077), q(4078).
-q(4078) :- p(4077), q(4077).
-p(4079) :- p(4078), q(4079).
-q(4079) :- p(4078), q(4078).
-p(4080) :- p(4079), q(4080).
-q(4080) :- p(4079), q(4079).
-p(4081) :- p(4080), q(4081).
-q(4081) :- p(4080), q(4080).
-p(4082) :- p(4081), q(4082).
-q(4082) :- p(4081), q(4081).
-p(4083) :- p(4082), q(4083).
-q(4083) :- p(4082), q(4082).
-p(4084) :- p(4083), q(4084).
-q(4084) :- p(4083), q(4083).
-p(4085) :- p(4084), q(4085).
-q(4085) :- p(4084), q(4084).
-p(4086) :- p(4085), q(4086).
-q(4086) :- p(4085), q(4085).
-p(4087) :- p(4086), q(4087).
-q(4087) :- p(4086), q(4086).
-p(4088) :- p(4087), q(4088).
-q(4088) :- p(4087), q(4087).
-p(4089) :- p(4088), q(4089).
-q(4089) :- p(4088), q(4088).
-p(4090) :- p(4089), q(4090).
-q(4090) :- p(4089), q(4089).
-p(4091) :- p(4090), q(4091).
-q(4091) :- p(4090), q(4090).
-p(4092) :- p(4091), q(4092).
-q(4092) :- p(4091), q(4091).
-p(4093) :- p(4092), q(4093).
-q(4093) :- p(4092), q(4092).
-p(4094) :- p(4093), q(4094).
-q(4094) :- p(4093), q(4093).
-p(4095) :- p(4094), q(4095).
-q(4095) :- p(4094), q(4094).
-p(4096) :- p(4095), q(4096).
-q(4096) :- p(4095), q(4095).
-p(4097) :- p(4096), q(4097).
-q(4097) :- p(4096), q(4096).
-p(4098) :- p(4097), q(4098).
-q(4098) :- p(4097), q(4097).
-p(4099) :- p(4098), q(4099).
-q(4099) :- p(4098), q(4098).
-p(4100) :- p(4099), q(4100).
-q(4100) :- p(4099), q(4099).
-p(4101) :- p(4100), q(4101).
-q(4101) :- p(4100), q(4100).
-p(4102) :- p(4101), q(4102).
-q(4102) :- p(4101), q(4101).
-p(4103) :- p(4102), q(4103).
-q(4103) :- p(4102), q(4102).
-p(4104) :- p(4103), q(4104).
-q(4104) :- p(4103), q(4103).
-p(4105) :- p(4104), q(4105).
-q(4105) :- p(4104), q(4104).
-p(4106) :- p(4105), q(4106).
-q(4106) :- p(4105), q(4105).
-p(4107) :- p(4106), q(4107).
-q(4107) :- p(4106), q(4106).
-p(4108) :- p(4107), q(4108).
-q(4108) :- p(4107), q(4107).
-p(4109) :- p(4108), q(4109).
-q(4109) :- p(4108), q(4108).
-p(4110) :- p(4109), q(4110).
-q(4110) :- p(4109), q(4109).
-p(4111) :- p(4110), q(4111).
-q(4111) :- p(4110), q(4110).
-p(4112) :- p(4111), q(4112).
-q(4112) :- p(4111), q(4111).
-p(4113) :- p(4112), q(4113).
-q(4113) :- p(4112), q(4112).
-p(4114) :- p(4113), q(4114).
-q(4114) :- p(4113), q(4113).
-p(4115) :- p(4114), q(4115).
-q(4115) :- p(4114), q(4114).
-p(4116) :- p(4115), q(4116).
-q(4116) :- p(4115), q(4115).
-p(4117) :- p(4116), q(4117).
-q(4117) :- p(4116), q(4116).
-p(4118) :- p(4117), q(4118).
-q(4118) :- p(4117), q(4117).
-p(4119) :- p(4118), q(4119).
-q(4119) :- p(4118), q(4118).
-p(4120) :- p(4119), q(4120).
-q(4120) :- p(4119), q(4119).
-p(4121) :- p(4120), q(4121).
-q(4121) :- p(4120), q(4120).
-p(4122) :- p(4121), q(4122).
-q(4122) :- p(4121), q(4121).
-p(4123) :- p(4122), q(4123).
-q(4123) :- p(4122), q(4122).
-p(4124) :- p(4123), q(4124).
-q(4124) :- p(4123), q(4123).
-p(4125) :- p(4124), q(4125).
-q(4125) :- p(4124), q(4124).
-p(4126) :- p(4125), q(4126).
-q(4126) :- p(4125), q(4125).
-p(4127) :- p(4126), q(4127).
-q(4127) :- p(4126), q(4126).
-p(4128) :- p(4127), q(4128).
-q(4128) :- p(4127), q(4127).
-p(4129) :- p(4128), q(4129).
-q(4129) :- p(4128), q(4128).
-p(4130) :- p(4129), q(4130).
-q(4130) :- p(4129), q(4129).
-p(4131) :- p(4130), q(4131).
-q(4131) :- p(4130), q(4130).
-p(4132) :- p(4131), q(4132).
-q(4132) :- p(4131), q(4131).
-p(4133) :- p(4132), q(4133).
-q(4133) :- p(4132), q(4132).
-p(4134) :- p(4133), q(4134).
-q(4134) :- p(4133), q(4133).
-p(4135) :- p(4134), q(4135).
-q(4135) :- p(4134), q(4134).
-p(4136) :- p(4135), q(4136).
-q(4136) :- p(4135), q(4135).
-p(4137) :- p(4136), q(4137).
-q(4137) :- p(4136), q(4136).
-p(4138) :- p(4137), q(4138).
-q(4138) :- p(4137), q(4137).
-p(4139) :- p(4138), q(4139).
-q(4139) :- p(4138), q(4138).
-p(4140) :- p(4139), q(4140).
-q(4140) :- p(4139), q(4139).
-p(4141) :- p(4140), q(4141).
-q(4141) :- p(4140), q(4140).
-p(4142) :- p(4141), q(4142).
-q(4142) :- p(4141), q(4141).
-p(4143) :- p(4142), q(4143).
-q(4143) :- p(4142), q(4142).
-p(4144) :- p(4143), q(4144).
-q(4144) :- p(4143), q(4143).
-p(4145) :- p(4144), q(4145).
-q(4145) :- p(4144), q(4144).
-p(4146) :- p(4145), q(4146).
-q(4146) :- p(4145), q(4145).
-p(4147) :- p(4146), q(4147).
-q(4147) :- p(4146), q(4146).
-p(4148) :- p(4147), q(4148).
-q(4148) :- p(4147), q(4147).
-p(4149) :- p(4148), q(4149).
-q(4149) :- p(4148), q(4148).
-p(4150) :- p(4149), q(4150).
-q(4150) :- p(4149), q(4149).
-p(4151) :- p(4150), q(4151).
-q(4151) :- p(4150), q(4150).
-p(4152) :- p(4151), q(4152).
-q(4152) :- p(4151), q(4151).
-p(4153) :- p(4152), q(4153).
-q(4153) :- p(4152), q(4152).
-p(4154) :- p(4153), q(4154).
-q(4154) :- p(4153), q(4153).
-p(4155) :- p(4154), q(4155).
-q(4155) :- p(4154), q(4154).
-p(4156) :- p(4155), q(4156).
-q(4156) :- p(4155), q(4155).
-p(4157) :- p(4156), q(4157).
-q(4157) :- p(4156), q(4156).
-p(4158) :- p(4157), q(4158).
-q(4158) :- p(4157), q(4157).
-p(4159) :- p(4158), q(4159).
-q(4159) :- p(4158), q(4158).
-p(4160) :- p(4159), q(4160).
-q(4160) :- p(4159), q(4159).
-p(4161) :- p(4160), q(4161).
-q(4161) :- p(4160), q(4160).
-p(4162) :- p(4161), q(4162).
-q(4162) :- p(4161), q(4161).
-p(4163) :- p(4162), q(4163).
-q(4163) :- p(4162), q(4162).
-p(4164) :- p(4163), q(4164).
-q(4164) :- p(4163), q(4163).
-p(4165) :- p(4164), q(4165).
-q(4165) :- p(4164), q(4164).
-p(4166) :- p(4165), q(4166).
-q(4166) :- p(4165), q(4165).
-p(4167) :- p(4166), q(4167).
-q(4167) :- p(4166), q(4166).
-p(4168) :- p(4167), q(4168).
-q(4168) :- p(4167), q(4167).
-p(4169) :- p(4168), q(4169).
-q(4169) :- p(4168), q(4168).
-p(4170) :- p(4169), q(4170).
-q(4170) :- p(4169), q(4169).
-p(4171) :- p(4170), q(4171).
-q(4171) :- p(4170), q(4170).
-p(4172) :- p(4171), q(4172).
-q(4172) :- p(4171), q(4171).
-p(4173) :- p(4172), q(4173).
-q(4173) :- p(4172), q(4172).
-p(4174) :- p(4173), q(4174).
-q(4174) :- p(4173), q(4173).
-p(4175) :- p(4174), q(4175).
-q(4175) :- p(4174), q(4174).
-p(4176) :- p(4175), q(4176).
-q(4176) :- p(4175), q(4175).
-p(4177) :- p(4176), q(4177).
-q(4177) :- p(4176), q(4176).
-p(4178) :- p(4177), q(4178).
-q(4178) :- p(4177), q(4177).
-p(4179) :- p(4178), q(4179).
-q(4179) :- p(4178), q(4178).
-p(4180) :- p(4179), q(4180).
-q(4180) :- p(4179), q(4179).
-p(4181) :- p(4180), q(4181).
-q(4181) :- p(4180), q(4180).
-p(4182) :- p(4181), q(4182).
-q(4182) :- p(4181), q(4181).
-p(4183) :- p(4182), q(4183).
-q(4183) :- p(4182), q(4182).
-p(4184) :- p(4183), q(4184).
-q(4184) :- p(4183), q(4183).
-p(4185) :- p(4184), q(4185).
-q(4185) :- p(4184), q(4184).
-p(4186) :- p(4185), q(4186).
-q(4186) :- p(4185), q(4185).
-p(4187) :- p(4186), q(4187).
-q(4187) :- p(4186), q(4186).
-p(4188) :- p(4187), q(4188).
-q(4188) :- p(4187), q(4187).
-p(4189) :- p(4188), q(4189).
-q(4189) :- p(4188), q(4188).
-p(4190) :- p(4189), q(4190).
-q(4190) :- p(4189), q(4189).
-p(4191) :- p(4190), q(4191).
-q(4191) :- p(4190), q(4190).
-p(4192) :- p(4191), q(4192).
-q(4192) :- p(4191), q(4191).
-p(4193) :- p(4192), q(4193).
-q(4193) :- p(4192), q(4192).
-p(4194) :- p(4193), q(4194).
-q(4194) :- p(4193), q(4193).
-p(4195) :- p(4194), q(4195).
-q(4195) :- p(4194), q(4194).
-p(4196) :- p(4195), q(4196).
-q(4196) :- p(4195), q(4195).
-p(4197) :- p(4196), q(4197).
-q(4197) :- p(4196), q(4196).
-p(4198) :- p(4197), q(4198).
-q(4198) :- p(4197), q(4197).
-p(4199) :- p(4198), q(4199).
-q(4199) :- p(4198), q(4198).
-p(4200) :- p(4199), q(4200).
-q(4200) :- p(4199), q(4199).
-p(4201) :- p(4200), q(4201).
-q(4201) :- p(4200), q(4200).
-p(4202) :- p(4201), q(4202).
-q(4202) :- p(4201), q(4201).
-p(4203) :- p(4202), q(4203).
-q(4203) :- p(4202), q(4202).
-p(4204) :- p(4203), q(4204).
-q(4204) :- p(4203), q(4203).
-p(4205) :- p(4204), q(4205).
-q(4205) :- p(4204), q(4204).
-p(4206) :- p(4205), q(4206).
-q(4206) :- p(4205), q(4205).
-p(4207) :- p(4206), q(4207).
-q(4207) :- p(4206), q(4206).
-p(4208) :- p(4207), q(4208).
-q(4208) :- p(4207), q(4207).
-p(4209) :- p(4208), q(4209).
-q(4209) :- p(4208), q(4208).
-p(4210) :- p(4209), q(4210).
-q(4210) :- p(4209), q(4209).
-p(4211) :- p(4210), q(4211).
-q(4211) :- p(4210), q(4210).
-p(4212) :- p(4211), q(4212).
-q(4212) :- p(4211), q(4211).
-p(4213) :- p(4212), q(4213).
-q(4213) :- p(4212), q(4212).
-p(4214) :- p(4213), q(4214).
-q(4214) :- p(4213), q(4213).
-p(4215) :- p(4214), q(4215).
-q(4215) :- p(4214), q(4214).
-p(4216) :- p(4215), q(4216).
-q(4216) :- p(4215), q(4215).
-p(4217) :- p(4216), q(4217).
-q(4217) :- p(4216), q(4216).
-p(4218) :- p(4217), q(4218).
-q(4218) :- p(4217), q(4217).
-p(4219) :- p(4218), q(4219).
-q(4219) :- p(4218), q(4218).
-p(4220) :- p(4219), q(4220).
-q(4220) :- p(4219), q(4219).
-p(4221) :- p(4220), q(4221).
-q(4221) :- p(4220), q(4220).
-p(4222) :- p(4221), q(4222).
-q(4222) :- p(4221), q(4221).
-p(4223) :- p(4222), q(4223).
-q(4223) :- p(4222), q(4222).
-p(4224) :- p(4223), q(4224).
-q(4224) :- p(4223), q(4223).
-p(4225) :- p(4224), q(4225).
-q(4225) :- p(4224), q(4224).
-p(4226) :- p(4225), q(4226).
-q(4226) :- p(4225), q(4225).
-p(4227) :- p(4226), q(4227).
-q(4227) :- p(4226), q(4226).
-p(4228) :- p(4227), q(4228).
-q(4228) :- p(4227), q(4227).
-p(4229) :- p(4228), q(4229).
-q(4229) :- p(4228), q(4228).
-p(4230) :- p(4229), q(4230).
-q(4230) :- p(4229), q(4229).
-p(4231) :- p(4230), q(4231).
-q(4231) :- p(4230), q(4230).
-p(4232) :- p(4231), q(4232).
-q(4232) :- p(4231), q(4231).
-p(4233) :- p(4232), q(4233).
-q(4233) :- p(4232), q(4232).
-p(4234) :- p(4233), q(4234).
-q(4234) :- p(4233), q(4233).
-p(4235) :- p(4234), q(4235).
-q(4235) :- p(4234), q(4234).
-p(4236) :- p(4235), q(4236).
-q(4236) :- p(4235), q(4235).
-p(4237) :- p(4236), q(4237).
-q(4237) :- p(4236), q(4236).
-p(4238) :- p(4237), q(4238).
-q(4238) :- p(4237), q(4237).
-p(4239) :- p(4238), q(4239).
-q(4239) :- p(4238), q(4238).
-p(4240) :- p(4239), q(4240).
-q(4240) :- p(4239), q(4239).
-p(4241) :- p(4240), q(4241).
-q(4241) :- p(4240), q(4240).
-p(4242) :- p(4241), q(4242).
-q(4242) :- p(4241), q(4241).
-p(4243) :- p(4242), q(4243).
-q(4243) :- p(4242), q(4242).
-p(4244) :- p(4243), q(4244).
-q(4244) :- p(4243), q(4243).
-p(4245) :- p(4244), q(4245).
-q(4245) :- p(4244), q(4244).
-p(4246) :- p(4245), q(4246).
-q(4246) :- p(4245), q(4245).
-p(4247) :- p(4246), q(4247).
-q(4247) :- p(4246), q(4246).
-p(4248) :- p(4247), q(4248).
-q(4248) :- p(4247), q(4247).
-p(4249) :- p(4248), q(4249).
-q(4249) :- p(4248), q(4248).
-p(4250) :- p(4249), q(4250).
-q(4250) :- p(4249), q(4249).
-p(4251) :- p(4250), q(4251).
-q(4251) :- p(4250), q(4250).
-p(4252) :- p(4251), q(4252).
-q(4252) :- p(4251), q(4251).
-p(4253) :- p(4252), q(4253).
-q(4253) :- p(4252), q(4252).
-p(4254) :- p(4253), q(4254).
-q(4254) :- p(4253), q(4253).
-p(4255) :- p(4254), q(4255).
-q(4255) :- p(4254), q(4254).
-p(4256) :- p(4255), q(4256).
-q(4256) :- p(4255), q(4255).
-p(4257) :- p(4256), q(4257).
-q(4257) :- p(4256), q(4256).
-p(4258) :- p(4257), q(4258).
-q(4258) :- p(4257), q(4257).
-p(4259) :- p(4258), q(4259).
-q(4259) :- p(4258), q(4258).
-p(4260) :- p(4259), q(4260).
-q(4260) :- p(4259), q(4259).
-p(4261) :- p(4260), q(4261).
-q(4261) :- p(4260), q(4260).
-p(4262) :- p(4261), q(4262).
-q(4262) :- p(4261), q(4261).
-p(4263) :- p(4262), q(4263).
-q(4263) :- p(4262), q(4262).
-p(4264) :- p(4263), q(4264).
-q(4264) :- p(4263), q(4263).
-p(4265) :- p(4264), q(4265).
-q(4265) :- p(4264), q(4264).
-p(4266) :- p(4265), q(4266).
-q(4266) :- p(4265), q(4265).
-p(4267) :- p(4266), q(4267).
-q(4267) :- p(4266), q(4266).
-p(4268) :- p(4267), q(4268).
-q(4268) :- p(4267), q(4267).
-p(4269) :- p(4268), q(4269).
-q(4269) :- p(4268), q(4268).
-p(4270) :- p(4269), q(4270).
-q(4270) :- p(4269), q(4269).
-p(4271) :- p(4270), q(4271).
-q(4271) :- p(4270), q(4270).
-p(4272) :- p(4271), q(4272).
-q(4272) :- p(4271), q(4271).
-p(4273) :- p(4272), q(4273).
-q(4273) :- p(4272), q(4272).
-p(4274) :- p(4273), q(4274).
-q(4274) :- p(4273), q(4273).
-p(4275) :- p(4274), q(4275).
-q(4275) :- p(4274), q(4274).
-p(4276) :- p(4275), q(4276).
-q(4276) :- p(4275), q(4275).
-p(4277) :- p(4276), q(4277).
-q(4277) :- p(4276), q(4276).
-p(4278) :- p(4277), q(4278).
-q(4278) :- p(4277), q(4277).
-p(4279) :- p(4278), q(4279).
-q(4279) :- p(4278), q(4278).
-p(4280) :- p(4279), q(4280).
-q(4280) :- p(4279), q(4279).
-p(4281) :- p(4280), q(4281).
-q(4281) :- p(4280), q(4280).
-p(4282) :- p(4281), q(4282).
-q(4282) :- p(4281), q(4281).
-p(4283) :- p(4282), q(4283).
-q(4283) :- p(4282), q(4282).
-p(4284) :- p(4283), q(4284).
-q(4284) :- p(4283), q(4283).
-p(4285) :- p(4284), q(4285).
-q(4285) :- p(4284), q(4284).
-p(4286) :- p(4285), q(4286).
-q(4286) :- p(4285), q(4285).
-p(4287) :- p(4286), q(4287).
-q(4287) :- p(4286), q(4286).
-p(4288) :- p(4287), q(4288).
-q(4288) :- p(4287), q(4287).
-p(4289) :- p(4288), q(4289).
-q(4289) :- p(4288), q(4288).
-p(4290) :- p(4289), q(4290).
-q(4290) :- p(4289), q(4289).
-p(4291) :- p(4290), q(4291).
-q(4291) :- p(4290), q(4290).
-p(4292) :- p(4291), q(4292).
-q(4292) :- p(4291), q(4291).
-p(4293) :- p(4292), q(4293).
-q(4293) :- p(4292), q(4292).
-p(4294) :- p(4293), q(4294).
-q(4294) :- p(4293), q(4293).
-p(4295) :- p(4294), q(4295).
-q(4295) :- p(4294), q(4294).
-p(4296) :- p(4295), q(4296).
-q(4296) :- p(4295), q(4295).
-p(4297) :- p(4296), q(4297).
-q(4297) :- p(4296), q(4296).
-p(4298) :- p(4297), q(4298).
-q(4298) :- p(4297), q(4297).
-p(4299) :- p(4298), q(4299).
-q(4299) :- p(4298), q(4298).
-p(4300) :- p(4299), q(4300).
-q(4300) :- p(4299), q(4299).
-p(4301) :- p(4300), q(4301).
-q(4301) :- p(4300), q(4300).
-p(4302) :- p(4301), q(4302).
-q(4302) :- p(4301), q(4301).
-p(4303) :- p(4302), q(4303).
-q(4303) :- p(4302), q(4302).
-p(4304) :- p(4303), q(4304).
-q(4304) :- p(4303), q(4303).
-p(4305) :- p(4304), q(4305).
-q(4305) :- p(4304), q(4304).
-p(4306) :- p(4305), q(4306).
-q(4306) :- p(4305), q(4305).
-p(4307) :- p(4306), q(4307).
-q(4307) :- p(4306), q(4306).
-p(4308) :- p(4307), q(4308).
-q(4308) :- p(4307), q(4307).
-p(4309) :- p(4308), q(4309).
-q(4309) :- p(4308), q(4308).
-p(4310) :- p(4309), q(4310).
-q(4310) :- p(4309), q(4309).
-p(4311) :- p(4310), q(4311).
-q(4311) :- p(4310), q(4310).
-p(4312) :- p(4311), q(4312).
-q(4312) :- p(4311), q(4311).
-p(4313) :- p(4312), q(4313).
-q(4313) :- p(4312), q(4312).
-p(4314) :- p(4313), q(4314).
-q(4314) :- p(4313), q(4313).
-p(4315) :- p(4314), q(4315).
-q(4315) :- p(4314), q(4314).
-p(4316) :- p(4315), q(4316).
-q(4316) :- p(4315), q(4315).
-p(4317) :- p(4316), q(4317).
-q(4317) :- p(4316), q(4316).
-p(4318) :- p(4317), q(4318).
-q(4318) :- p(4317), q(4317).
-p(4319) :- p(4318), q(4319).
-q(4319) :- p(4318), q(4318).
-p(4320) :- p(4319), q(4320).
-q(4320) :- p(4319), q(4319).
-p(4321) :- p(4320), q(4321).
-q(4321) :- p(4320), q(4320).
-p(4322) :- p(4321), q(4322).
-q(4322) :- p(4321), q(4321).
-p(4323) :- p(4322), q(4323).
-q(4323) :- p(4322), q(4322).
-p(4324) :- p(4323), q(4324).
-q(4324) :- p(4323), q(4323).
-p(4325) :- p(4324), q(4325).
-q(4325) :- p(4324), q(4324).
-p(4326) :- p(4325), q(4326).
-q(4326) :- p(4325), q(4325).
-p(4327) :- p(4326), q(4327).
-q(4327) :- p(4326), q(4326).
-p(4328) :- p(4327), q(4328).
-q(4328) :- p(4327), q(4327).
-p(4329) :- p(4328), q(4329).
-q(4329) :- p(4328), q(4328).
-p(4330) :- p(4329), q(4330).
-q(4330) :- p(4329), q(4329).
-p(4331) :- p(4330), q(4331).
-q(4331) :- p(4330), q(4330).
-p(4332) :- p(4331), q(4332).
-q(4332) :- p(4331), q(4331).
-p(4333) :- p(4332), q(4333).
-q(4333) :- p(4332), q(4332).
-p(4334) :- p(4333), q(4334).
-q(4334) :- p(4333), q(4333).
-p(4335) :- p(4334), q(4335).
-q(4335) :- p(4334), q(4334).
-p(4336) :- p(4335), q(4336).
-q(4336) :- p(4335), q(4335).
-p(4337) :- p(4336), q(4337).
-q(4337) :- p(4336), q(4336).
-p(4338) :- p(4337), q(4338).
-q(4338) :- p(4337), q(4337).
-p(4339) :- p(4338), q(4339).
-q(4339) :- p(4338), q(4338).
-p(4340) :- p(4339), q(4340).
-q(4340) :- p(4339), q(4339).
-p(4341) :- p(4340), q(4341).
-q(4341) :- p(4340), q(4340).
-p(4342) :- p(4341), q(4342).
-q(4342) :- p(4341), q(4341).
-p(4343) :- p(4342), q(4343).
-q(4343) :- p(4342), q(4342).
-p(4344) :- p(4343), q(4344).
-q(4344) :- p(4343), q(4343).
-p(4345) :- p(4344), q(4345).
-q(4345) :- p(4344), q(4344).
-p(4346) :- p(4345), q(4346).
-q(4346) :- p(4345), q(4345).
-p(4347) :- p(4346), q(4347).
-q(4347) :- p(4346), q(4346).
-p(4348) :- p(4347), q(4348).
-q(4348) :- p(4347), q(4347).
-p(4349) :- p(4348), q(4349).
-q(4349) :- p(4348), q(4348).
-p(4350) :- p(4349), q(4350).
-q(4350) :- p(4349), q(4349).
-p(4351) :- p(4350), q(4351).
-q(4351) :- p(4350), q(4350).
-p(4352) :- p(4351), q(4352).
-q(4352) :- p(4351), q(4351).
-p(4353) :- p(4352), q(4353).
-q(4353) :- p(4352), q(4352).
-p(4354) :- p(4353), q(4354).
-q(4354) :- p(4353), q(4353).
-p(4355) :- p(4354), q(4355).
-q(4355) :- p(4354), q(4354).
-p(4356) :- p(4355), q(4356).
-q(4356) :- p(4355), q(4355).
-p(4357) :- p(4356), q(4357).
-q(4357) :- p(4356), q(4356).
-p(4358) :- p(4357), q(4358).
-q(4358) :- p(4357), q(4357).
-p(4359) :- p(4358), q(4359).
-q(4359) :- p(4358), q(4358).
-p(4360) :- p(4359), q(4360).
-q(4360) :- p(4359), q(4359).
-p(4361) :- p(4360), q(4361).
-q(4361) :- p(4360), q(4360).
-p(4362) :- p(4361), q(4362).
-q(4362) :- p(4361), q(4361).
-p(4363) :- p(4362), q(4363).
-q(4363) :- p(4362), q(4362).
-p(4364) :- p(4363), q(4364).
-q(4364) :- p(4363), q(4363).
-p(4365) :- p(4364), q(4365).
-q(4365) :- p(4364), q(4364).
-p(4366) :- p(4365), q(4366).
-q(4366) :- p(4365), q(4365).
-p(4367) :- p(4366), q(4367).
-q(4367) :- p(4366), q(4366).
-p(4368) :- p(4367), q(4368).
-q(4368) :- p(4367), q(4367).
-p(4369) :- p(4368), q(4369).
-q(4369) :- p(4368), q(4368).
-p(4370) :- p(4369), q(4370).
-q(4370) :- p(4369), q(4369).
-p(4371) :- p(4370), q(4371).
-q(4371) :- p(4370), q(4370).
-p(4372) :- p(4371), q(4372).
-q(4372) :- p(4371), q(4371).
-p(4373) :- p(4372), q(4373).
-q(4373) :- p(4372), q(4372).
-p(4374) :- p(4373), q(4374).
-q(4374) :- p(4373), q(4373).
-p(4375) :- p(4374), q(4375).
-q(4375) :- p(4374), q(4374).
-p(4376) :- p(4375), q(4376).
-q(4376) :- p(4375), q(4375).
-p(4377) :- p(4376), q(4377).
-q(4377) :- p(4376), q(4376).
-p(4378) :- p(4377), q(4378).
-q(4378) :- p(4377), q(4377).
-p(4379) :- p(4378), q(4379).
-q(4379) :- p(4378), q(4378).
-p(4380) :- p(4379), q(4380).
-q(4380) :- p(4379), q(4379).
-p(4381) :- p(4380), q(4381).
-q(4381) :- p(4380), q(4380).
-p(4382) :- p(4381), q(4382).
-q(4382) :- p(4381), q(4381).
-p(4383) :- p(4382), q(4383).
-q(4383) :- p(4382), q(4382).
-p(4384) :- p(4383), q(4384).
-q(4384) :- p(4383), q(4383).
-p(4385) :- p(4384), q(4385).
-q(4385) :- p(4384), q(4384).
-p(4386) :- p(4385), q(4386).
-q(4386) :- p(4385), q(4385).
-p(4387) :- p(4386), q(4387).
-q(4387) :- p(4386), q(4386).
-p(4388) :- p(4387), q(4388).
-q(4388) :- p(4387), q(4387).
-p(4389) :- p(4388), q(4389).
-q(4389) :- p(4388), q(4388).
-p(4390) :- p(4389), q(4390).
-q(4390) :- p(4389), q(4389).
-p(4391) :- p(4390), q(4391).
-q(4391) :- p(4390), q(4390).
-p(4392) :- p(4391), q(4392).
-q(4392) :- p(4391), q(4391).
-p(4393) :- p(4392), q(4393).
-q(4393) :- p(4392), q(4392).
-p(4394) :- p(4393), q(4394).
-q(4394) :- p(4393), q(4393).
-p(4395) :- p(4394), q(4395).
-q(4395) :- p(4394), q(4394).
-p(4396) :- p(4395), q(4396).
-q(4396) :- p(4395), q(4395).
-p(4397) :- p(4396), q(4397).
-q(4397) :- p(4396), q(4396).
-p(4398) :- p(4397), q(4398).
-q(4398) :- p(4397), q(4397).
-p(4399) :- p(4398), q(4399).
-q(4399) :- p(4398), q(4398).
-p(4400) :- p(4399), q(4400).
-q(4400) :- p(4399), q(4399).
-p(4401) :- p(4400), q(4401).
-q(4401) :- p(4400), q(4400).
-p(4402) :- p(4401), q(4402).
-q(4402) :- p(4401), q(4401).
-p(4403) :- p(4402), q(4403).
-q(4403) :- p(4402), q(4402).
-p(4404) :- p(4403), q(4404).
-q(4404) :- p(4403), q(4403).
-p(4405) :- p(4404), q(4405).
-q(4405) :- p(4404), q(4404).
-p(4406) :- p(4405), q(4406).
-q(4406) :- p(4405), q(4405).
-p(4407) :- p(4406), q(4407).
-q(4407) :- p(4406), q(4406).
-p(4408) :- p(4407), q(4408).
-q(4408) :- p(4407), q(4407).
-p(4409) :- p(4408), q(4409).
-q(4409) :- p(4408), q(4408).
-p(4410) :- p(4409), q(4410).
-q(4410) :- p(4409), q(4409).
-p(4411) :- p(4410), q(4411).
-q(4411) :- p(4410), q(4410).
-p(4412) :- p(4411), q(4412).
-q(4412) :- p(4411), q(4411).
-p(4413) :- p(4412), q(4413).
-q(4413) :- p(4412), q(4412).
-p(4414) :- p(4413), q(4414).
-q(4414) :- p(4413), q(4413).
-p(4415) :- p(4414), q(4415).
-q(4415) :- p(4414), q(4414).
-p(4416) :- p(4415), q(4416).
-q(4416) :- p(4415), q(4415).
-p(4417) :- p(4416), q(4417).
-q(4417) :- p(4416), q(4416).
-p(4418) :- p(4417), q(4418).
-q(4418) :- p(4417), q(4417).
-p(4419) :- p(4418), q(4419).
-q(4419) :- p(4418), q(4418).
-p(4420) :- p(4419), q(4420).
-q(4420) :- p(4419), q(4419).
-p(4421) :- p(4420), q(4421).
-q(4421) :- p(4420), q(4420).
-p(4422) :- p(4421), q(4422).
-q(4422) :- p(4421), q(4421).
-p(4423) :- p(4422), q(4423).
-q(4423) :- p(4422), q(4422).
-p(4424) :- p(4423), q(4424).
-q(4424) :- p(4423), q(4423).
-p(4425) :- p(4424), q(4425).
-q(4425) :- p(4424), q(4424).
-p(4426) :- p(4425), q(4426).
-q(4426) :- p(4425), q(4425).
-p(4427) :- p(4426), q(4427).
-q(4427) :- p(4426), q(4426).
-p(4428) :- p(4427), q(4428).
-q(4428) :- p(4427), q(4427).
-p(4429) :- p(4428), q(4429).
-q(4429) :- p(4428), q(4428).
-p(4430) :- p(4429), q(4430).
-q(4430) :- p(4429), q(4429).
-p(4431) :- p(4430), q(4431).
-q(4431) :- p(4430), q(4430).
-p(4432) :- p(4431), q(4432).
-q(4432) :- p(4431), q(4431).
-p(4433) :- p(4432), q(4433).
-q(4433) :- p(4432), q(4432).
-p(4434) :- p(4433), q(4434).
-q(4434) :- p(4433), q(4433).
-p(4435) :- p(4434), q(4435).
-q(4435) :- p(4434), q(4434).
-p(4436) :- p(4435), q(4436).
-q(4436) :- p(4435), q(4435).
-p(4437) :- p(4436), q(4437).
-q(4437) :- p(4436), q(4436).
-p(4438) :- p(4437), q(4438).
-q(4438) :- p(4437), q(4437).
-p(4439) :- p(4438), q(4439).
-q(4439) :- p(4438), q(4438).
-p(4440) :- p(4439), q(4440).
-q(4440) :- p(4439), q(4439).
-p(4441) :- p(4440), q(4441).
-q(4441) :- p(4440), q(4440).
-p(4442) :- p(4441), q(4442).
-q(4442) :- p(4441), q(4441).
-p(4443) :- p(4442), q(4443).
-q(4443) :- p(4442), q(4442).
-p(4444) :- p(4443), q(4444).
-q(4444) :- p(4443), q(4443).
-p(4445) :- p(4444), q(4445).
-q(4445) :- p(4444), q(4444).
-p(4446) :- p(4445), q(4446).
-q(4446) :- p(4445), q(4445).
-p(4447) :- p(4446), q(4447).
-q(4447) :- p(4446), q(4446).
-p(4448) :- p(4447), q(4448).
-q(4448) :- p(4447), q(4447).
-p(4449) :- p(4448), q(4449).
-q(4449) :- p(4448), q(4448).
-p(4450) :- p(4449), q(4450).
-q(4450) :- p(4449), q(4449).
-p(4451) :- p(4450), q(4451).
-q(4451) :- p(4450), q(4450).
-p(4452) :- p(4451), q(4452).
-q(4452) :- p(4451), q(4451).
-p(4453) :- p(4452), q(4453).
-q(4453) :- p(4452), q(4452).
-p(4454) :- p(4453), q(4454).
-q(4454) :- p(4453), q(4453).
-p(4455) :- p(4454), q(4455).
-q(4455) :- p(4454), q(4454).
-p(4456) :- p(4455), q(4456).
-q(4456) :- p(4455), q(4455).
-p(4457) :- p(4456), q(4457).
-q(4457) :- p(4456), q(4456).
-p(4458) :- p(4457), q(4458).
-q(4458) :- p(4457), q(4457).
-p(4459) :- p(4458), q(4459).
-q(4459) :- p(4458), q(4458).
-p(4460) :- p(4459), q(4460).
-q(4460) :- p(4459), q(4459).
-p(4461) :- p(4460), q(4461).
-q(4461) :- p(4460), q(4460).
-p(4462) :- p(4461), q(4462).
-q(4462) :- p(4461), q(4461).
-p(4463) :- p(4462), q(4463).
-q(4463) :- p(4462), q(4462).
-p(4464) :- p(4463), q(4464).
-q(4464) :- p(4463), q(4463).
-p(4465) :- p(4464), q(4465).
-q(4465) :- p(4464), q(4464).
-p(4466) :- p(4465), q(4466).
-q(4466) :- p(4465), q(4465).
-p(4467) :- p(4466), q(4467).
-q(4467) :- p(4466), q(4466).
-p(4468) :- p(4467), q(4468).
-q(4468) :- p(4467), q(4467).
-p(4469) :- p(4468), q(4469).
-q(4469) :- p(4468), q(4468).
-p(4470) :- p(4469), q(4470).
-q(4470) :- p(4469), q(4469).
-p(4471) :- p(4470), q(4471).
-q(4471) :- p(4470), q(4470).
-p(4472) :- p(4471), q(4472).
-q(4472) :- p(4471), q(4471).
-p(4473) :- p(4472), q(4473).
-q(4473) :- p(4472), q(4472).
-p(4474) :- p(4473), q(4474).
-q(4474) :- p(4473), q(4473).
-p(4475) :- p(4474), q(4475).
-q(4475) :- p(4474), q(4474).
-p(4476) :- p(4475), q(4476).
-q(4476) :- p(4475), q(4475).
-p(4477) :- p(4476), q(4477).
-q(4477) :- p(4476), q(4476).
-p(4478) :- p(4477), q(4478).
-q(4478) :- p(4477), q(4477).
-p(4479) :- p(4478), q(4479).
-q(4479) :- p(4478), q(4478).
-p(4480) :- p(4479), q(4480).
-q(4480) :- p(4479), q(4479).
-p(4481) :- p(4480), q(4481).
-q(4481) :- p(4480), q(4480).
-p(4482) :- p(4481), q(4482).
-q(4482) :- p(4481), q(4481).
-p(4483) :- p(4482), q(4483).
-q(4483) :- p(4482), q(4482).
-p(4484) :- p(4483), q(4484).
-q(4484) :- p(4483), q(4483).
-p(4485) :- p(4484), q(4485).
-q(4485) :- p(4484), q(4484).
-p(4486) :- p(4485), q(4486).
-q(4486) :- p(4485), q(4485).
-p(4487) :- p(4486), q(4487).
-q(4487) :- p(4486), q(4486).
-p(4488) :- p(4487), q(4488).
-q(4488) :- p(4487), q(4487).
-p(4489) :- p(4488), q(4489).
-q(4489) :- p(4488), q(4488).
-p(4490) :- p(4489), q(4490).
-q(4490) :- p(4489), q(4489).
-p(4491) :- p(4490), q(4491).
-q(4491) :- p(4490), q(4490).
-p(4492) :- p(4491), q(4492).
-q(4492) :- p(4491), q(4491).
-p(4493) :- p(4492), q(4493).
-q(4493) :- p(4492), q(4492).
-p(4494) :- p(4493), q(4494).
-q(4494) :- p(4493), q(4493).
-p(4495) :- p(4494), q(4495).
-q(4495) :- p(4494), q(4494).
-p(4496) :- p(4495), q(4496).
-q(4496) :- p(4495), q(4495).
-p(4497) :- p(4496), q(4497).
-q(4497) :- p(4496), q(4496).
-p(4498) :- p(4497), q(4498).
-q(4498) :- p(4497), q(4497).
-p(4499) :- p(4498), q(4499).
-q(4499) :- p(4498), q(4498).
-p(4500) :- p(4499), q(4500).
-q(4500) :- p(4499), q(4499).
-p(4501) :- p(4500), q(4501).
-q(4501) :- p(4500), q(4500).
-p(4502) :- p(4501), q(4502).
-q(4502) :- p(4501), q(4501).
-p(4503) :- p(4502), q(4503).
-q(4503) :- p(4502), q(4502).
-p(4504) :- p(4503), q(4504).
-q(4504) :- p(4503), q(4503).
-p(4505) :- p(4504), q(4505).
-q(4505) :- p(4504), q(4504).
-p(4506) :- p(4505), q(4506).
-q(4506) :- p(4505), q(4505).
-p(4507) :- p(4506), q(4507).
-q(4507) :- p(4506), q(4506).
-p(4508) :- p(4507), q(4508).
-q(4508) :- p(4507), q(4507).
-p(4509) :- p(4508), q(4509).
-q(4509) :- p(4508), q(4508).
-p(4510) :- p(4509), q(4510).
-q(4510) :- p(4509), q(4509).
-p(4511) :- p(4510), q(4511).
-q(4511) :- p(4510), q(4510).
-p(4512) :- p(4511), q(4512).
-q(4512) :- p(4511), q(4511).
-p(4513) :- p(4512), q(4513).
-q(4513) :- p(4512), q(4512).
-p(4514) :- p(4513), q(4514).
-q(4514) :- p(4513), q(4513).
-p(4515) :- p(4514), q(4515).
-q(4515) :- p(4514), q(4514).
-p(4516) :- p(4515), q(4516).
-q(4516) :- p(4515), q(4515).
-p(4517) :- p(4516), q(4517).
-q(4517) :- p(4516), q(4516).
-p(4518) :- p(4517), q(4518).
-q(4518) :- p(4517), q(4517).
-p(4519) :- p(4518), q(4519).
-q(4519) :- p(4518), q(4518).
-p(4520) :- p(4519), q(4520).
-q(4520) :- p(4519), q(4519).
-p(4521) :- p(4520), q(4521).
-q(4521) :- p(4520), q(4520).
-p(4522) :- p(4521), q(4522).
-q(4522) :- p(4521), q(4521).
-p(4523) :- p(4522), q(4523).
-q(4523) :- p(4522), q(4522).
-p(4524) :- p(4523), q(4524).
-q(4524) :- p(4523), q(4523).
-p(4525) :- p(4524), q(4525).
-q(4525) :- p(4524), q(4524).
-p(4526) :- p(4525), q(4526).
-q(4526) :- p(4525), q(4525).
-p(4527) :- p(4526), q(4527).
-q(4527) :- p(4526), q(4526).
-p(4528) :- p(4527), q(4528).
-q(4528) :- p(4527), q(4527).
-p(4529) :- p(4528), q(4529).
-q(4529) :- p(4528), q(4528).
-p(4530) :- p(4529), q(4530).
-q(4530) :- p(4529), q(4529).
-p(4531) :- p(4530), q(4531).
-q(4531) :- p(4530), q(4530).
-p(4532) :- p(4531), q(4532).
-q(4532) :- p(4531), q(4531).
-p(4533) :- p(4532), q(4533).
-q(4533) :- p(4532), q(4532).
-p(4534) :- p(4533), q(4534).
-q(4534) :- p(4533), q(4533).
-p(4535) :- p(4534), q(4535).
-q(4535) :- p(4534), q(4534).
-p(4536) :- p(4535), q(4536).
-q(4536) :- p(4535), q(4535).
-p(4537) :- p(4536), q(4537).
-q(4537) :- p(4536), q(4536).
-p(4538) :- p(4537), q(4538).
-q(4538) :- p(4537), q(4537).
-p(4539) :- p(4538), q(4539).
-q(4539) :- p(4538), q(4538).
-p(4540) :- p(4539), q(4540).
-q(4540) :- p(4539), q(4539).
-p(4541) :- p(4540), q(4541).
-q(4541) :- p(4540), q(4540).
-p(4542) :- p(4541), q(4542).
-q(4542) :- p(4541), q(4541).
-p(4543) :- p(4542), q(4543).
-q(4543) :- p(4542), q(4542).
-p(4544) :- p(4543), q(4544).
-q(4544) :- p(4543), q(4543).
-p(4545) :- p(4544), q(4545).
-q(4545) :- p(4544), q(4544).
-p(4546) :- p(4545), q(4546).
-q(4546) :- p(4545), q(4545).
-p(4547) :- p(4546), q(4547).
-q(4547) :- p(4546), q(4546).
-p(4548) :- p(4547), q(4548).
-q(4548) :- p(4547), q(4547).
-p(4549) :- p(4548), q(4549).
-q(4549) :- p(4548), q(4548).
-p(4550) :- p(4549), q(4550).
-q(4550) :- p(4549), q(4549).
-p(4551) :- p(4550), q(4551).
-q(4551) :- p(4550), q(4550).
-p(4552) :- p(4551), q(4552).
-q(4552) :- p(4551), q(4551).
-p(4553) :- p(4552), q(4553).
-q(4553) :- p(4552), q(4552).
-p(4554) :- p(4553), q(4554).
-q(4554) :- p(4553), q(4553).
-p(4555) :- p(4554), q(4555).
-q(4555) :- p(4554), q(4554).
-p(4556) :- p(4555), q(4556).
-q(4556) :- p(4555), q(4555).
-p(4557) :- p(4556), q(4557).
-q(4557) :- p(4556), q(4556).
-p(4558) :- p(4557), q(4558).
-q(4558) :- p(4557), q(4557).
-p(4559) :- p(4558), q(4559).
-q(4559) :- p(4558), q(4558).
-p(4560) :- p(4559), q(4560).
-q(4560) :- p(4559), q(4559).
-p(4561) :- p(4560), q(4561).
-q(4561) :- p(4560), q(4560).
-p(4562) :- p(4561), q(4562).
-q(4562) :- p(4561), q(4561).
-p(4563) :- p(4562), q(4563).
-q(4563) :- p(4562), q(4562).
-p(4564) :- p(4563), q(4564).
-q(4564) :- p(4563), q(4563).
-p(4565) :- p(4564), q(4565).
-q(4565) :- p(4564), q(4564).
-p(4566) :- p(4565), q(4566).
-q(4566) :- p(4565), q(4565).
-p(4567) :- p(4566), q(4567).
-q(4567) :- p(4566), q(4566).
-p(4568) :- p(4567), q(4568).
-q(4568) :- p(4567), q(4567).
-p(4569) :- p(4568), q(4569).
-q(4569) :- p(4568), q(4568).
-p(4570) :- p(4569), q(4570).
-q(4570) :- p(4569), q(4569).
-p(4571) :- p(4570), q(4571).
-q(4571) :- p(4570), q(4570).
-p(4572) :- p(4571), q(4572).
-q(4572) :- p(4571), q(4571).
-p(4573) :- p(4572), q(4573).
-q(4573) :- p(4572), q(4572).
-p(4574) :- p(4573), q(4574).
-q(4574) :- p(4573), q(4573).
-p(4575) :- p(4574), q(4575).
-q(4575) :- p(4574), q(4574).
-p(4576) :- p(4575), q(4576).
-q(4576) :- p(4575), q(4575).
-p(4577) :- p(4576), q(4577).
-q(4577) :- p(4576), q(4576).
-p(4578) :- p(4577), q(4578).
-q(4578) :- p(4577), q(4577).
-p(4579) :- p(4578), q(4579).
-q(4579) :- p(4578), q(4578).
-p(4580) :- p(4579), q(4580).
-q(4580) :- p(4579), q(4579).
-p(4581) :- p(4580), q(4581).
-q(4581) :- p(4580), q(4580).
-p(4582) :- p(4581), q(4582).
-q(4582) :- p(4581), q(4581).
-p(4583) :- p(4582), q(4583).
-q(4583) :- p(4582), q(4582).
-p(4584) :- p(4583), q(4584).
-q(4584) :- p(4583), q(4583).
-p(4585) :- p(4584), q(4585).
-q(4585) :- p(4584), q(4584).
-p(4586) :- p(4585), q(4586).
-q(4586) :- p(4585), q(4585).
-p(4587) :- p(4586), q(4587).
-q(4587) :- p(4586), q(4586).
-p(4588) :- p(4587), q(4588).
-q(4588) :- p(4587), q(4587).
-p(4589) :- p(4588), q(4589).
-q(4589) :- p(4588), q(4588).
-p(4590) :- p(4589), q(4590).
-q(4590) :- p(4589), q(4589).
-p(4591) :- p(4590), q(4591).
-q(4591) :- p(4590), q(4590).
-p(4592) :- p(4591), q(4592).
-q(4592) :- p(4591), q(4591).
-p(4593) :- p(4592), q(4593).
-q(4593) :- p(4592), q(4592).
-p(4594) :- p(4593), q(4594).
-q(4594) :- p(4593), q(4593).
-p(4595) :- p(4594), q(4595).
-q(4595) :- p(4594), q(4594).
-p(4596) :- p(4595), q(4596).
-q(4596) :- p(4595), q(4595).
-p(4597) :- p(4596), q(4597).
-q(4597) :- p(4596), q(4596).
-p(4598) :- p(4597), q(4598).
-q(4598) :- p(4597), q(4597).
-p(4599) :- p(4598), q(4599).
-q(4599) :- p(4598), q(4598).
-p(4600) :- p(4599), q(4600).
-q(4600) :- p(4599), q(4599).
-p(4601) :- p(4600), q(4601).
-q(4601) :- p(4600), q(4600).
-p(4602) :- p(4601), q(4602).
-q(4602) :- p(4601), q(4601).
-p(4603) :- p(4602), q(4603).
-q(4603) :- p(4602), q(4602).
-p(4604) :- p(4603), q(4604).
-q(4604) :- p(4603), q(4603).
-p(4605) :- p(4604), q(4605).
-q(4605) :- p(4604), q(4604).
-p(4606) :- p(4605), q(4606).
-q(4606) :- p(4605), q(4605).
-p(4607) :- p(4606), q(4607).
-q(4607) :- p(4606), q(4606).
-p(4608) :- p(4607), q(4608).
-q(4608) :- p(4607), q(4607).
-p(4609) :- p(4608), q(4609).
-q(4609) :- p(4608), q(4608).
-p(4610) :- p(4609), q(4610).
-q(4610) :- p(4609), q(4609).
-p(4611) :- p(4610), q(4611).
-q(4611) :- p(4610), q(4610).
-p(4612) :- p(4611), q(4612).
-q(4612) :- p(4611), q(4611).
-p(4613) :- p(4612), q(4613).
-q(4613) :- p(4612), q(4612).
-p(4614) :- p(4613), q(4614).
-q(4614) :- p(4613), q(4613).
-p(4615) :- p(4614), q(4615).
-q(4615) :- p(4614), q(4614).
-p(4616) :- p(4615), q(4616).
-q(4616) :- p(4615), q(4615).
-p(4617) :- p(4616), q(4617).
-q(4617) :- p(4616), q(4616).
-p(4618) :- p(4617), q(4618).
-q(4618) :- p(4617), q(4617).
-p(4619) :- p(4618), q(4619).
-q(4619) :- p(4618), q(4618).
-p(4620) :- p(4619), q(4620).
-q(4620) :- p(4619), q(4619).
-p(4621) :- p(4620), q(4621).
-q(4621) :- p(4620), q(4620).
-p(4622) :- p(4621), q(4622).
-q(4622) :- p(4621), q(4621).
-p(4623) :- p(4622), q(4623).
-q(4623) :- p(4622), q(4622).
-p(4624) :- p(4623), q(4624).
-q(4624) :- p(4623), q(4623).
-p(4625) :- p(4624), q(4625).
-q(4625) :- p(4624), q(4624).
-p(4626) :- p(4625), q(4626).
-q(4626) :- p(4625), q(4625).
-p(4627) :- p(4626), q(4627).
-q(4627) :- p(4626), q(4626).
-p(4628) :- p(4627), q(4628).
-q(4628) :- p(4627), q(4627).
-p(4629) :- p(4628), q(4629).
-q(4629) :- p(4628), q(4628).
-p(4630) :- p(4629), q(4630).
-q(4630) :- p(4629), q(4629).
-p(4631) :- p(4630), q(4631).
-q(4631) :- p(4630), q(4630).
-p(4632) :- p(4631), q(4632).
-q(4632) :- p(4631), q(4631).
-p(4633) :- p(4632), q(4633).
-q(4633) :- p(4632), q(4632).
-p(4634) :- p(4633), q(4634).
-q(4634) :- p(4633), q(4633).
-p(4635) :- p(4634), q(4635).
-q(4635) :- p(4634), q(4634).
-p(4636) :- p(4635), q(4636).
-q(4636) :- p(4635), q(4635).
-p(4637) :- p(4636), q(4637).
-q(4637) :- p(4636), q(4636).
-p(4638) :- p(4637), q(4638).
-q(4638) :- p(4637), q(4637).
-p(4639) :- p(4638), q(4639).
-q(4639) :- p(4638), q(4638).
-p(4640) :- p(4639), q(4640).
-q(4640) :- p(4639), q(4639).
-p(4641) :- p(4640), q(4641).
-q(4641) :- p(4640), q(4640).
-p(4642) :- p(4641), q(4642).
-q(4642) :- p(4641), q(4641).
-p(4643) :- p(4642), q(4643).
-q(4643) :- p(4642), q(4642).
-p(4644) :- p(4643), q(4644).
-q(4644) :- p(4643), q(4643).
-p(4645) :- p(4644), q(4645).
-q(4645) :- p(4644), q(4644).
-p(4646) :- p(4645), q(4646).
-q(4646) :- p(4645), q(4645).
-p(4647) :- p(4646), q(4647).
-q(4647) :- p(4646), q(4646).
-p(4648) :- p(4647), q(4648).
-q(4648) :- p(4647), q(4647).
-p(4649) :- p(4648), q(4649).
-q(4649) :- p(4648), q(4648).
-p(4650) :- p(4649), q(4650).
-q(4650) :- p(4649), q(4649).
-p(4651) :- p(4650), q(4651).
-q(4651) :- p(4650), q(4650).
-p(4652) :- p(4651), q(4652).
-q(4652) :- p(4651), q(4651).
-p(4653) :- p(4652), q(4653).
-q(4653) :- p(4652), q(4652).
-p(4654) :- p(4653), q(4654).
-q(4654) :- p(4653), q(4653).
-p(4655) :- p(4654), q(4655).
-q(4655) :- p(4654), q(4654).
-p(4656) :- p(4655), q(4656).
-q(4656) :- p(4655), q(4655).
-p(4657) :- p(4656), q(4657).
-q(4657) :- p(4656), q(4656).
-p(4658) :- p(4657), q(4658).
-q(4658) :- p(4657), q(4657).
-p(4659) :- p(4658), q(4659).
-q(4659) :- p(4658), q(4658).
-p(4660) :- p(4659), q(4660).
-q(4660) :- p(4659), q(4659).
-p(4661) :- p(4660), q(4661).
-q(4661) :- p(4660), q(4660).
-p(4662) :- p(4661), q(4662).
-q(4662) :- p(4661), q(4661).
-p(4663) :- p(4662), q(4663).
-q(4663) :- p(4662), q(4662).
-p(4664) :- p(4663), q(4664).
-q(4664) :- p(4663), q(4663).
-p(4665) :- p(4664), q(4665).
-q(4665) :- p(4664), q(4664).
-p(4666) :- p(4665), q(4666).
-q(4666) :- p(4665), q(4665).
-p(4667) :- p(4666), q(4667).
-q(4667) :- p(4666), q(4666).
-p(4668) :- p(4667), q(4668).
-q(4668) :- p(4667), q(4667).
-p(4669) :- p(4668), q(4669).
-q(4669) :- p(4668), q(4668).
-p(4670) :- p(4669), q(4670).
-q(4670) :- p(4669), q(4669).
-p(4671) :- p(4670), q(4671).
-q(4671) :- p(4670), q(4670).
-p(4672) :- p(4671), q(4672).
-q(4672) :- p(4671), q(4671).
-p(4673) :- p(4672), q(4673).
-q(4673) :- p(4672), q(4672).
-p(4674) :- p(4673), q(4674).
-q(4674) :- p(4673), q(4673).
-p(4675) :- p(4674), q(4675).
-q(4675) :- p(4674), q(4674).
-p(4676) :- p(4675), q(4676).
-q(4676) :- p(4675), q(4675).
-p(4677) :- p(4676), q(4677).
-q(4677) :- p(4676), q(4676).
-p(4678) :- p(4677), q(4678).
-q(4678) :- p(4677), q(4677).
-p(4679) :- p(4678), q(4679).
-q(4679) :- p(4678), q(4678).
-p(4680) :- p(4679), q(4680).
-q(4680) :- p(4679), q(4679).
-p(4681) :- p(4680), q(4681).
-q(4681) :- p(4680), q(4680).
-p(4682) :- p(4681), q(4682).
-q(4682) :- p(4681), q(4681).
-p(4683) :- p(4682), q(4683).
-q(4683) :- p(4682), q(4682).
-p(4684) :- p(4683), q(4684).
-q(4684) :- p(4683), q(4683).
-p(4685) :- p(4684), q(4685).
-q(4685) :- p(4684), q(4684).
-p(4686) :- p(4685), q(4686).
-q(4686) :- p(4685), q(4685).
-p(4687) :- p(4686), q(4687).
-q(4687) :- p(4686), q(4686).
-p(4688) :- p(4687), q(4688).
-q(4688) :- p(4687), q(4687).
-p(4689) :- p(4688), q(4689).
-q(4689) :- p(4688), q(4688).
-p(4690) :- p(4689), q(4690).
-q(4690) :- p(4689), q(4689).
-p(4691) :- p(4690), q(4691).
-q(4691) :- p(4690), q(4690).
-p(4692) :- p(4691), q(4692).
-q(4692) :- p(4691), q(4691).
-p(4693) :- p(4692), q(4693).
-q(4693) :- p(4692), q(4692).
-p(4694) :- p(4693), q(4694).
-q(4694) :- p(4693), q(4693).
-p(4695) :- p(4694), q(4695).
-q(4695) :- p(4694), q(4694).
-p(4696) :- p(4695), q(4696).
-q(4696) :- p(4695), q(4695).
-p(4697) :- p(4696), q(4697).
-q(4697) :- p(4696), q(4696).
-p(4698) :- p(4697), q(4698).
-q(4698) :- p(4697), q(4697).
-p(4699) :- p(4698), q(4699).
-q(4699) :- p(4698), q(4698).
-p(4700) :- p(4699), q(4700).
-q(4700) :- p(4699), q(4699).
-p(4701) :- p(4700), q(4701).
-q(4701) :- p(4700), q(4700).
-p(4702) :- p(4701), q(4702).
-q(4702) :- p(4701), q(4701).
-p(4703) :- p(4702), q(4703).
-q(4703) :- p(4702), q(4702).
-p(4704) :- p(4703), q(4704).
-q(4704) :- p(4703), q(4703).
-p(4705) :- p(4704), q(4705).
-q(4705) :- p(4704), q(4704).
-p(4706) :- p(4705), q(4706).
-q(4706) :- p(4705), q(4705).
-p(4707) :- p(4706), q(4707).
-q(4707) :- p(4706), q(4706).
-p(4708) :- p(4707), q(4708).
-q(4708) :- p(4707), q(4707).
-p(4709) :- p(4708), q(4709).
-q(4709) :- p(4708), q(4708).
-p(4710) :- p(4709), q(4710).
-q(4710) :- p(4709), q(4709).
-p(4711) :- p(4710), q(4711).
-q(4711) :- p(4710), q(4710).
-p(4712) :- p(4711), q(4712).
-q(4712) :- p(4711), q(4711).
-p(4713) :- p(4712), q(4713).
-q(4713) :- p(4712), q(4712).
-p(4714) :- p(4713), q(4714).
-q(4714) :- p(4713), q(4713).
-p(4715) :- p(4714), q(4715).
-q(4715) :- p(4714), q(4714).
-p(4716) :- p(4715), q(4716).
-q(4716) :- p(4715), q(4715).
-p(4717) :- p(4716), q(4717).
-q(4717) :- p(4716), q(4716).
-p(4718) :- p(4717), q(4718).
-q(4718) :- p(4717), q(4717).
-p(4719) :- p(4718), q(4719).
-q(4719) :- p(4718), q(4718).
-p(4720) :- p(4719), q(4720).
-q(4720) :- p(4719), q(4719).
-p(4721) :- p(4720), q(4721).
-q(4721) :- p(4720), q(4720).
-p(4722) :- p(4721), q(4722).
-q(4722) :- p(4721), q(4721).
-p(4723) :- p(4722), q(4723).
-q(4723) :- p(4722), q(4722).
-p(4724) :- p(4723), q(4724).
-q(4724) :- p(4723), q(4723).
-p(4725) :- p(4724), q(4725).
-q(4725) :- p(4724), q(4724).
-p(4726) :- p(4725), q(4726).
-q(4726) :- p(4725), q(4725).
-p(4727) :- p(4726), q(4727).
-q(4727) :- p(4726), q(4726).
-p(4728) :- p(4727), q(4728).
-q(4728) :- p(4727), q(4727).
-p(4729) :- p(4728), q(4729).
-q(4729) :- p(4728), q(4728).
-p(4730) :- p(4729), q(4730).
-q(4730) :- p(4729), q(4729).
-p(4731) :- p(4730), q(4731).
-q(4731) :- p(4730), q(4730).
-p(4732) :- p(4731), q(4732).
-q(4732) :- p(4731), q(4731).
-p(4733) :- p(4732), q(4733).
-q(4733) :- p(4732), q(4732).
-p(4734) :- p(4733), q(4734).
-q(4734) :- p(4733), q(4733).
-p(4735) :- p(4734), q(4735).
-q(4735) :- p(4734), q(4734).
-p(4736) :- p(4735), q(4736).
-q(4736) :- p(4735), q(4735).
-p(4737) :- p(4736), q(4737).
-q(4737) :- p(4736), q(4736).
-p(4738) :- p(4737), q(4738).
-q(4738) :- p(4737), q(4737).
-p(4739) :- p(4738), q(4739).
-q(4739) :- p(4738), q(4738).
-p(4740) :- p(4739), q(4740).
-q(4740) :- p(4739), q(4739).
-p(4741) :- p(4740), q(4741).
-q(4741) :- p(4740), q(4740).
-p(4742) :- p(4741), q(4742).
-q(4742) :- p(4741), q(4741).
-p(4743) :- p(4742), q(4743).
-q(4743) :- p(4742), q(4742).
-p(4744) :- p(4743), q(4744).
-q(4744) :- p(4743), q(4743).
-p(4745) :- p(4744), q(4745).
-q(4745) :- p(4744), q(4744).
-p(4746) :- p(4745), q(4746).
-q(4746) :- p(4745), q(4745).
-p(4747) :- p(4746), q(4747).
-q(4747) :- p(4746), q(4746).
-p(4748) :- p(4747), q(4748).
-q(4748) :- p(4747), q(4747).
-p(4749) :- p(4748), q(4749).
-q(4749) :- p(4748), q(4748).
-p(4750) :- p(4749), q(4750).
-q(4750) :- p(4749), q(4749).
-p(4751) :- p(4750), q(4751).
-q(4751) :- p(4750), q(4750).
-p(4752) :- p(4751), q(4752).
-q(4752) :- p(4751), q(4751).
-p(4753) :- p(4752), q(4753).
-q(4753) :- p(4752), q(4752).
-p(4754) :- p(4753), q(4754).
-q(4754) :- p(4753), q(4753).
-p(4755) :- p(4754), q(4755).
-q(4755) :- p(4754), q(4754).
-p(4756) :- p(4755), q(4756).
-q(4756) :- p(4755), q(4755).
-p(4757) :- p(4756), q(4757).
-q(4757) :- p(4756), q(4756).
-p(4758) :- p(4757), q(4758).
-q(4758) :- p(4757), q(4757).
-p(4759) :- p(4758), q(4759).
-q(4759) :- p(4758), q(4758).
-p(4760) :- p(4759), q(4760).
-q(4760) :- p(4759), q(4759).
-p(4761) :- p(4760), q(4761).
-q(4761) :- p(4760), q(4760).
-p(4762) :- p(4761), q(4762).
-q(4762) :- p(4761), q(4761).
-p(4763) :- p(4762), q(4763).
-q(4763) :- p(4762), q(4762).
-p(4764) :- p(4763), q(4764).
-q(4764) :- p(4763), q(4763).
-p(4765) :- p(4764), q(4765).
-q(4765) :- p(4764), q(4764).
-p(4766) :- p(4765), q(4766).
-q(4766) :- p(4765), q(4765).
-p(4767) :- p(4766), q(4767).
-q(4767) :- p(4766), q(4766).
-p(4768) :- p(4767), q(4768).
-q(4768) :- p(4767), q(4767).
-p(4769) :- p(4768), q(4769).
-q(4769) :- p(4768), q(4768).
-p(4770) :- p(4769), q(4770).
-q(4770) :- p(4769), q(4769).
-p(4771) :- p(4770), q(4771).
-q(4771) :- p(4770), q(4770).
-p(4772) :- p(4771), q(4772).
-q(4772) :- p(4771), q(4771).
-p(4773) :- p(4772), q(4773).
-q(4773) :- p(4772), q(4772).
-p(4774) :- p(4773), q(4774).
-q(4774) :- p(4773), q(4773).
-p(4775) :- p(4774), q(4775).
-q(4775) :- p(4774), q(4774).
-p(4776) :- p(4775), q(4776).
-q(4776) :- p(4775), q(4775).
-p(4777) :- p(4776), q(4777).
-q(4777) :- p(4776), q(4776).
-p(4778) :- p(4777), q(4778).
-q(4778) :- p(4777), q(4777).
-p(4779) :- p(4778), q(4779).
-q(4779) :- p(4778), q(4778).
-p(4780) :- p(4779), q(4780).
-q(4780) :- p(4779), q(4779).
-p(4781) :- p(4780), q(4781).
-q(4781) :- p(4780), q(4780).
-p(4782) :- p(4781), q(4782).
-q(4782) :- p(4781), q(4781).
-p(4783) :- p(4782), q(4783).
-q(4783) :- p(4782), q(4782).
-p(4784) :- p(4783), q(4784).
-q(4784) :- p(4783), q(4783).
-p(4785) :- p(4784), q(4785).
-q(4785) :- p(4784), q(4784).
-p(4786) :- p(4785), q(4786).
-q(4786) :- p(4785), q(4785).
-p(4787) :- p(4786), q(4787).
-q(4787) :- p(4786), q(4786).
-p(4788) :- p(4787), q(4788).
-q(4788) :- p(4787), q(4787).
-p(4789) :- p(4788), q(4789).
-q(4789) :- p(4788), q(4788).
-p(4790) :- p(4789), q(4790).
-q(4790) :- p(4789), q(4789).
-p(4791) :- p(4790), q(4791).
-q(4791) :- p(4790), q(4790).
-p(4792) :- p(4791), q(4792).
-q(4792) :- p(4791), q(4791).
-p(4793) :- p(4792), q(4793).
-q(4793) :- p(4792), q(4792).
-p(4794) :- p(4793), q(4794).
-q(4794) :- p(4793), q(4793).
-p(4795) :- p(4794), q(4795).
-q(4795) :- p(4794), q(4794).
-p(4796) :- p(4795), q(4796).
-q(4796) :- p(4795), q(4795).
-p(4797) :- p(4796), q(4797).
-q(4797) :- p(4796), q(4796).
-p(4798) :- p(4797), q(4798).
-q(4798) :- p(4797), q(4797).
-p(4799) :- p(4798), q(4799).
-q(4799) :- p(4798), q(4798).
-p(4800) :- p(4799), q(4800).
-q(4800) :- p(4799), q(4799).
-p(4801) :- p(4800), q(4801).
-q(4801) :- p(4800), q(4800).
-p(4802) :- p(4801), q(4802).
-q(4802) :- p(4801), q(4801).
-p(4803) :- p(4802), q(4803).
-q(4803) :- p(4802), q(4802).
-p(4804) :- p(4803), q(4804).
-q(4804) :- p(4803), q(4803).
-p(4805) :- p(4804), q(4805).
-q(4805) :- p(4804), q(4804).
-p(4806) :- p(4805), q(4806).
-q(4806) :- p(4805), q(4805).
-p(4807) :- p(4806), q(4807).
-q(4807) :- p(4806), q(4806).
-p(4808) :- p(4807), q(4808).
-q(4808) :- p(4807), q(4807).
-p(4809) :- p(4808), q(4809).
-q(4809) :- p(4808), q(4808).
-p(4810) :- p(4809), q(4810).
-q(4810) :- p(4809), q(4809).
-p(4811) :- p(4810), q(4811).
-q(4811) :- p(4810), q(4810).
-p(4812) :- p(4811), q(4812).
-q(4812) :- p(4811), q(4811).
-p(4813) :- p(4812), q(4813).
-q(4813) :- p(4812), q(4812).
-p(4814) :- p(4813), q(4814).
-q(4814) :- p(4813), q(4813).
-p(4815) :- p(4814), q(4815).
-q(4815) :- p(4814), q(4814).
-p(4816) :- p(4815), q(4816).
-q(4816) :- p(4815), q(4815).
-p(4817) :- p(4816), q(4817).
-q(4817) :- p(4816), q(4816).
-p(4818) :- p(4817), q(4818).
-q(4818) :- p(4817), q(4817).
-p(4819) :- p(4818), q(4819).
-q(4819) :- p(4818), q(4818).
-p(4820) :- p(4819), q(4820).
-q(4820) :- p(4819), q(4819).
-p(4821) :- p(4820), q(4821).
-q(4821) :- p(4820), q(4820).
-p(4822) :- p(4821), q(4822).
-q(4822) :- p(4821), q(4821).
-p(4823) :- p(4822), q(4823).
-q(4823) :- p(4822), q(4822).
-p(4824) :- p(4823), q(4824).
-q(4824) :- p(4823), q(4823).
-p(4825) :- p(4824), q(4825).
-q(4825) :- p(4824), q(4824).
-p(4826) :- p(4825), q(4826).
-q(4826) :- p(4825), q(4825).
-p(4827) :- p(4826), q(4827).
-q(4827) :- p(4826), q(4826).
-p(4828) :- p(4827), q(4828).
-q(4828) :- p(4827), q(4827).
-p(4829) :- p(4828), q(4829).
-q(4829) :- p(4828), q(4828).
-p(4830) :- p(4829), q(4830).
-q(4830) :- p(4829), q(4829).
-p(4831) :- p(4830), q(4831).
-q(4831) :- p(4830), q(4830).
-p(4832) :- p(4831), q(4832).
-q(4832) :- p(4831), q(4831).
-p(4833) :- p(4832), q(4833).
-q(4833) :- p(4832), q(4832).
-p(4834) :- p(4833), q(4834).
-q(4834) :- p(4833), q(4833).
-p(4835) :- p(4834), q(4835).
-q(4835) :- p(4834), q(4834).
-p(4836) :- p(4835), q(4836).
-q(4836) :- p(4835), q(4835).
-p(4837) :- p(4836), q(4837).
-q(4837) :- p(4836), q(4836).
-p(4838) :- p(4837), q(4838).
-q(4838) :- p(4837), q(4837).
-p(4839) :- p(4838), q(4839).
-q(4839) :- p(4838), q(4838).
-p(4840) :- p(4839), q(4840).
-q(4840) :- p(4839), q(4839).
-p(4841) :- p(4840), q(4841).
-q(4841) :- p(4840), q(4840).
-p(4842) :- p(4841), q(4842).
-q(4842) :- p(4841), q(4841).
-p(4843) :- p(4842), q(4843).
-q(4843) :- p(4842), q(4842).
-p(4844) :- p(4843), q(4844).
-q(4844) :- p(4843), q(4843).
-p(4845) :- p(4844), q(4845).
-q(4845) :- p(4844), q(4844).
-p(4846) :- p(4845), q(4846).
-q(4846) :- p(4845), q(4845).
-p(4847) :- p(4846), q(4847).
-q(4847) :- p(4846), q(4846).
-p(4848) :- p(4847), q(4848).
-q(4848) :- p(4847), q(4847).
-p(4849) :- p(4848), q(4849).
-q(4849) :- p(4848), q(4848).
-p(4850) :- p(4849), q(4850).
-q(4850) :- p(4849), q(4849).
-p(4851) :- p(4850), q(4851).
-q(4851) :- p(4850), q(4850).
-p(4852) :- p(4851), q(4852).
-q(4852) :- p(4851), q(4851).
-p(4853) :- p(4852), q(4853).
-q(4853) :- p(4852), q(4852).
-p(4854) :- p(4853), q(4854).
-q(4854) :- p(4853), q(4853).
-p(4855) :- p(4854), q(4855).
-q(4855) :- p(4854), q(4854).
-p(4856) :- p(4855), q(4856).
-q(4856) :- p(4855), q(4855).
-p(4857) :- p(4856), q(4857).
-q(4857) :- p(4856), q(4856).
-p(4858) :- p(4857), q(4858).
-q(4858) :- p(4857), q(4857).
-p(4859) :- p(4858), q(4859).
-q(4859) :- p(4858), q(4858).
-p(4860) :- p(4859), q(4860).
-q(4860) :- p(4859), q(4859).
-p(4861) :- p(4860), q(4861).
-q(4861) :- p(4860), q(4860).
-p(4862) :- p(4861), q(4862).
-q(4862) :- p(4861), q(4861).
-p(4863) :- p(4862), q(4863).
-q(4863) :- p(4862), q(4862).
-p(4864) :- p(4863), q(4864).
-q(4864) :- p(4863), q(4863).
-p(4865) :- p(4864), q(4865).
-q(4865) :- p(4864), q(4864).
-p(4866) :- p(4865), q(4866).
-q(4866) :- p(4865), q(4865).
-p(4867) :- p(4866), q(4867).
-q(4867) :- p(4866), q(4866).
-p(4868) :- p(4867), q(4868).
-q(4868) :- p(4867), q(4867).
-p(4869) :- p(4868), q(4869).
-q(4869) :- p(4868), q(4868).
-p(4870) :- p(4869), q(4870).
-q(4870) :- p(4869), q(4869).
-p(4871) :- p(4870), q(4871).
-q(4871) :- p(4870), q(4870).
-p(4872) :- p(4871), q(4872).
-q(4872) :- p(4871), q(4871).
-p(4873) :- p(4872), q(4873).
-q(4873) :- p(4872), q(4872).
-p(4874) :- p(4873), q(4874).
-q(4874) :- p(4873), q(4873).
-p(4875) :- p(4874), q(4875).
-q(4875) :- p(4874), q(4874).
-p(4876) :- p(4875), q(4876).
-q(4876) :- p(4875), q(4875).
-p(4877) :- p(4876), q(4877).
-q(4877) :- p(4876), q(4876).
-p(4878) :- p(4877), q(4878).
-q(4878) :- p(4877), q(4877).
-p(4879) :- p(4878), q(4879).
-q(4879) :- p(4878), q(4878).
-p(4880) :- p(4879), q(4880).
-q(4880) :- p(4879), q(4879).
-p(4881) :- p(4880), q(4881).
-q(4881) :- p(4880), q(4880).
-p(4882) :- p(4881), q(4882).
-q(4882) :- p(4881), q(4881).
-p(4883) :- p(4882), q(4883).
-q(4883) :- p(4882), q(4882).
-p(4884) :- p(4883), q(4884).
-q(4884) :- p(4883), q(4883).
-p(4885) :- p(4884), q(4885).
-q(4885) :- p(4884), q(4884).
-p(4886) :- p(4885), q(4886).
-q(4886) :- p(4885), q(4885).
-p(4887) :- p(4886), q(4887).
-q(4887) :- p(4886), q(4886).
-p(4888) :- p(4887), q(4888).
-q(4888) :- p(4887), q(4887).
-p(4889) :- p(4888), q(4889).
-q(4889) :- p(4888), q(4888).
-p(4890) :- p(4889), q(4890).
-q(4890) :- p(4889), q(4889).
-p(4891) :- p(4890), q(4891).
-q(4891) :- p(4890), q(4890).
-p(4892) :- p(4891), q(4892).
-q(4892) :- p(4891), q(4891).
-p(4893) :- p(4892), q(4893).
-q(4893) :- p(4892), q(4892).
-p(4894) :- p(4893), q(4894).
-q(4894) :- p(4893), q(4893).
-p(4895) :- p(4894), q(4895).
-q(4895) :- p(4894), q(4894).
-p(4896) :- p(4895), q(4896).
-q(4896) :- p(4895), q(4895).
-p(4897) :- p(4896), q(4897).
-q(4897) :- p(4896), q(4896).
-p(4898) :- p(4897), q(4898).
-q(4898) :- p(4897), q(4897).
-p(4899) :- p(4898), q(4899).
-q(4899) :- p(4898), q(4898).
-p(4900) :- p(4899), q(4900).
-q(4900) :- p(4899), q(4899).
-p(4901) :- p(4900), q(4901).
-q(4901) :- p(4900), q(4900).
-p(4902) :- p(4901), q(4902).
-q(4902) :- p(4901), q(4901).
-p(4903) :- p(4902), q(4903).
-q(4903) :- p(4902), q(4902).
-p(4904) :- p(4903), q(4904).
-q(4904) :- p(4903), q(4903).
-p(4905) :- p(4904), q(4905).
-q(4905) :- p(4904), q(4904).
-p(4906) :- p(4905), q(4906).
-q(4906) :- p(4905), q(4905).
-p(4907) :- p(4906), q(4907).
-q(4907) :- p(4906), q(4906).
-p(4908) :- p(4907), q(4908).
-q(4908) :- p(4907), q(4907).
-p(4909) :- p(4908), q(4909).
-q(4909) :- p(4908), q(4908).
-p(4910) :- p(4909), q(4910).
-q(4910) :- p(4909), q(4909).
-p(4911) :- p(4910), q(4911).
-q(4911) :- p(4910), q(4910).
-p(4912) :- p(4911), q(4912).
-q(4912) :- p(4911), q(4911).
-p(4913) :- p(4912), q(4913).
-q(4913) :- p(4912), q(4912).
-p(4914) :- p(4913), q(4914).
-q(4914) :- p(4913), q(4913).
-p(4915) :- p(4914), q(4915).
-q(4915) :- p(4914), q(4914).
-p(4916) :- p(4915), q(4916).
-q(4916) :- p(4915), q(4915).
-p(4917) :- p(4916), q(4917).
-q(4917) :- p(4916), q(4916).
-p(4918) :- p(4917), q(4918).
-q(4918) :- p(4917), q(4917).
-p(4919) :- p(4918), q(4919).
-q(4919) :- p(4918), q(4918).
-p(4920) :- p(4919), q(4920).
-q(4920) :- p(4919), q(4919).
-p(4921) :- p(4920), q(4921).
-q(4921) :- p(4920), q(4920).
-p(4922) :- p(4921), q(4922).
-q(4922) :- p(4921), q(4921).
-p(4923) :- p(4922), q(4923).
-q(4923) :- p(4922), q(4922).
-p(4924) :- p(4923), q(4924).
-q(4924) :- p(4923), q(4923).
-p(4925) :- p(4924), q(4925).
-q(4925) :- p(4924), q(4924).
-p(4926) :- p(4925), q(4926).
-q(4926) :- p(4925), q(4925).
-p(4927) :- p(4926), q(4927).
-q(4927) :- p(4926), q(4926).
-p(4928) :- p(4927), q(4928).
-q(4928) :- p(4927), q(4927).
-p(4929) :- p(4928), q(4929).
-q(4929) :- p(4928), q(4928).
-p(4930) :- p(4929), q(4930).
-q(4930) :- p(4929), q(4929).
-p(4931) :- p(4930), q(4931).
-q(4931) :- p(4930), q(4930).
-p(4932) :- p(4931), q(4932).
-q(4932) :- p(4931), q(4931).
-p(4933) :- p(4932), q(4933).
-q(4933) :- p(4932), q(4932).
-p(4934) :- p(4933), q(4934).
-q(4934) :- p(4933), q(4933).
-p(4935) :- p(4934), q(4935).
-q(4935) :- p(4934), q(4934).
-p(4936) :- p(4935), q(4936).
-q(4936) :- p(4935), q(4935).
-p(4937) :- p(4936), q(4937).
-q(4937) :- p(4936), q(4936).
-p(4938) :- p(4937), q(4938).
-q(4938) :- p(4937), q(4937).
-p(4939) :- p(4938), q(4939).
-q(4939) :- p(4938), q(4938).
-p(4940) :- p(4939), q(4940).
-q(4940) :- p(4939), q(4939).
-p(4941) :- p(4940), q(4941).
-q(4941) :- p(4940), q(4940).
-p(4942) :- p(4941), q(4942).
-q(4942) :- p(4941), q(4941).
-p(4943) :- p(4942), q(4943).
-q(4943) :- p(4942), q(4942).
-p(4944) :- p(4943), q(4944).
-q(4944) :- p(4943), q(4943).
-p(4945) :- p(4944), q(4945).
-q(4945) :- p(4944), q(4944).
-p(4946) :- p(4945), q(4946).
-q(4946) :- p(4945), q(4945).
-p(4947) :- p(4946), q(4947).
-q(4947) :- p(4946), q(4946).
-p(4948) :- p(4947), q(4948).
-q(4948) :- p(4947), q(4947).
-p(4949) :- p(4948), q(4949).
-q(4949) :- p(4948), q(4948).
-p(4950) :- p(4949), q(4950).
-q(4950) :- p(4949), q(4949).
-p(4951) :- p(4950), q(4951).
-q(4951) :- p(4950), q(4950).
-p(4952) :- p(4951), q(4952).
-q(4952) :- p(4951), q(4951).
-p(4953) :- p(4952), q(4953).
-q(4953) :- p(4952), q(4952).
-p(4954) :- p(4953), q(4954).
-q(4954) :- p(4953), q(4953).
-p(4955) :- p(4954), q(4955).
-q(4955) :- p(4954), q(4954).
-p(4956) :- p(4955), q(4956).
-q(4956) :- p(4955), q(4955).
-p(4957) :- p(4956), q(4957).
-q(4957) :- p(4956), q(4956).
-p(4958) :- p(4957), q(4958).
-q(4958) :- p(4957), q(4957).
-p(4959) :- p(4958), q(4959).
-q(4959) :- p(4958), q(4958).
-p(4960) :- p(4959), q(4960).
-q(4960) :- p(4959), q(4959).
-p(4961) :- p(4960), q(4961).
-q(4961) :- p(4960), q(4960).
-p(4962) :- p(4961), q(4962).
-q(4962) :- p(4961), q(4961).
-p(4963) :- p(4962), q(4963).
-q(4963) :- p(4962), q(4962).
-p(4964) :- p(4963), q(4964).
-q(4964) :- p(4963), q(4963).
-p(4965) :- p(4964), q(4965).
-q(4965) :- p(4964), q(4964).
-p(4966) :- p(4965), q(4966).
-q(4966) :- p(4965), q(4965).
-p(4967) :- p(4966), q(4967).
-q(4967) :- p(4966), q(4966).
-p(4968) :- p(4967), q(4968).
-q(4968) :- p(4967), q(4967).
-p(4969) :- p(4968), q(4969).
-q(4969) :- p(4968), q(4968).
-p(4970) :- p(4969), q(4970).
-q(4970) :- p(4969), q(4969).
-p(4971) :- p(4970), q(4971).
-q(4971) :- p(4970), q(4970).
-p(4972) :- p(4971), q(4972).
-q(4972) :- p(4971), q(4971).
-p(4973) :- p(4972), q(4973).
-q(4973) :- p(4972), q(4972).
-p(4974) :- p(4973), q(4974).
-q(4974) :- p(4973), q(4973).
-p(4975) :- p(4974), q(4975).
-q(4975) :- p(4974), q(4974).
-p(4976) :- p(4975), q(4976).
-q(4976) :- p(4975), q(4975).
-p(4977) :- p(4976), q(4977).
-q(4977) :- p(4976), q(4976).
-p(4978) :- p(4977), q(4978).
-q(4978) :- p(4977), q(4977).
-p(4979) :- p(4978), q(4979).
-q(4979) :- p(4978), q(4978).
-p(4980) :- p(4979), q(4980).
-q(4980) :- p(4979), q(4979).
-p(4981) :- p(4980), q(4981).
-q(4981) :- p(4980), q(4980).
-p(4982) :- p(4981), q(4982).
-q(4982) :- p(4981), q(4981).
-p(4983) :- p(4982), q(4983).
-q(4983) :- p(4982), q(4982).
-p(4984) :- p(4983), q(4984).
-q(4984) :- p(4983), q(4983).
-p(4985) :- p(4984), q(4985).
-q(4985) :- p(4984), q(4984).
-p(4986) :- p(4985), q(4986).
-q(4986) :- p(4985), q(4985).
-p(4987) :- p(4986), q(4987).
-q(4987) :- p(4986), q(4986).
-p(4988) :- p(4987), q(4988).
-q(4988) :- p(4987), q(4987).
-p(4989) :- p(4988), q(4989).
-q(4989) :- p(4988), q(4988).
-p(4990) :- p(4989), q(4990).
-q(4990) :- p(4989), q(4989).
-p(4991) :- p(4990), q(4991).
-q(4991) :- p(4990), q(4990).
-p(4992) :- p(4991), q(4992).
-q(4992) :- p(4991), q(4991).
-p(4993) :- p(4992), q(4993).
-q(4993) :- p(4992), q(4992).
-p(4994) :- p(4993), q(4994).
-q(4994) :- p(4993), q(4993).
-p(4995) :- p(4994), q(4995).
-q(4995) :- p(4994), q(4994).
-p(4996) :- p(4995), q(4996).
-q(4996) :- p(4995), q(4995).
-p(4997) :- p(4996), q(4997).
-q(4997) :- p(4996), q(4996).
-p(4998) :- p(4997), q(4998).
-q(4998) :- p(4997), q(4997).
-p(4999) :- p(4998), q(4999).
-q(4999) :- p(4998), q(4998).
-p(5000) :- p(4999), q(5000).
-q(5000) :- p(4999), q(4999).
-p(5001) :- p(5000), q(5001).
-q(5001) :- p(5000), q(5000).
-p(5002) :- p(5001), q(5002).
-q(5002) :- p(5001), q(5001).
-p(5003) :- p(5002), q(5003).
-q(5003) :- p(5002), q(5002).
-p(5004) :- p(5003), q(5004).
-q(5004) :- p(5003), q(5003).
-p(5005) :- p(5004), q(5005).
-q(5005) :- p(5004), q(5004).
-p(5006) :- p(5005), q(5006).
-q(5006) :- p(5005), q(5005).
-p(5007) :- p(5006), q(5007).
-q(5007) :- p(5006), q(5006).
-p(5008) :- p(5007), q(5008).
-q(5008) :- p(5007), q(5007).
-p(5009) :- p(5008), q(5009).
-q(5009) :- p(5008), q(5008).
-p(5010) :- p(5009), q(5010).
-q(5010) :- p(5009), q(5009).
-p(5011) :- p(5010), q(5011).
-q(5011) :- p(5010), q(5010).
-p(5012) :- p(5011), q(5012).
-q(5012) :- p(5011), q(5011).
-p(5013) :- p(5012), q(5013).
-q(5013) :- p(5012), q(5012).
-p(5014) :- p(5013), q(5014).
-q(5014) :- p(5013), q(5013).
-p(5015) :- p(5014), q(5015).
-q(5015) :- p(5014), q(5014).
-p(5016) :- p(5015), q(5016).
-q(5016) :- p(5015), q(5015).
-p(5017) :- p(5016), q(5017).
-q(5017) :- p(5016), q(5016).
-p(5018) :- p(5017), q(5018).
-q(5018) :- p(5017), q(5017).
-p(5019) :- p(5018), q(5019).
-q(5019) :- p(5018), q(5018).
-p(5020) :- p(5019), q(5020).
-q(5020) :- p(5019), q(5019).
-p(5021) :- p(5020), q(5021).
-q(5021) :- p(5020), q(5020).
-p(5022) :- p(5021), q(5022).
-q(5022) :- p(5021), q(5021).
-p(5023) :- p(5022), q(5023).
-q(5023) :- p(5022), q(5022).
-p(5024) :- p(5023), q(5024).
-q(5024) :- p(5023), q(5023).
-p(5025) :- p(5024), q(5025).
-q(5025) :- p(5024), q(5024).
-p(5026) :- p(5025), q(5026).
-q(5026) :- p(5025), q(5025).
-p(5027) :- p(5026), q(5027).
-q(5027) :- p(5026), q(5026).
-p(5028) :- p(5027), q(5028).
-q(5028) :- p(5027), q(5027).
-p(5029) :- p(5028), q(5029).
-q(5029) :- p(5028), q(5028).
-p(5030) :- p(5029), q(5030).
-q(5030) :- p(5029), q(5029).
-p(5031) :- p(5030), q(5031).
-q(5031) :- p(5030), q(5030).
-p(5032) :- p(5031), q(5032).
-q(5032) :- p(5031), q(5031).
-p(5033) :- p(5032), q(5033).
-q(5033) :- p(5032), q(5032).
-p(5034) :- p(5033), q(5034).
-q(5034) :- p(5033), q(5033).
-p(5035) :- p(5034), q(5035).
-q(5035) :- p(5034), q(5034).
-p(5036) :- p(5035), q(5036).
-q(5036) :- p(5035), q(5035).
-p(5037) :- p(5036), q(5037).
-q(5037) :- p(5036), q(5036).
-p(5038) :- p(5037), q(5038).
-q(5038) :- p(5037), q(5037).
-p(5039) :- p(5038), q(5039).
-q(5039) :- p(5038), q(5038).
-p(5040) :- p(5039), q(5040).
-q(5040) :- p(5039), q(5039).
-p(5041) :- p(5040), q(5041).
-q(5041) :- p(5040), q(5040).
-p(5042) :- p(5041), q(5042).
-q(5042) :- p(5041), q(5041).
-p(5043) :- p(5042), q(5043).
-q(5043) :- p(5042), q(5042).
-p(5044) :- p(5043), q(5044).
-q(5044) :- p(5043), q(5043).
-p(5045) :- p(5044), q(5045).
-q(5045) :- p(5044), q(5044).
-p(5046) :- p(5045), q(5046).
-q(5046) :- p(5045), q(5045).
-p(5047) :- p(5046), q(5047).
-q(5047) :- p(5046), q(5046).
-p(5048) :- p(5047), q(5048).
-q(5048) :- p(5047), q(5047).
-p(5049) :- p(5048), q(5049).
-q(5049) :- p(5048), q(5048).
-p(5050) :- p(5049), q(5050).
-q(5050) :- p(5049), q(5049).
-p(5051) :- p(5050), q(5051).
-q(5051) :- p(5050), q(5050).
-p(5052) :- p(5051), q(5052).
-q(5052) :- p(5051), q(5051).
-p(5053) :- p(5052), q(5053).
-q(5053) :- p(5052), q(5052).
-p(5054) :- p(5053), q(5054).
-q(5054) :- p(5053), q(5053).
-p(5055) :- p(5054), q(5055).
-q(5055) :- p(5054), q(5054).
-p(5056) :- p(5055), q(5056).
-q(5056) :- p(5055), q(5055).
-p(5057) :- p(5056), q(5057).
-q(5057) :- p(5056), q(5056).
-p(5058) :- p(5057), q(5058).
-q(5058) :- p(5057), q(5057).
-p(5059) :- p(5058), q(5059).
-q(5059) :- p(5058), q(5058).
-p(5060) :- p(5059), q(5060).
-q(5060) :- p(5059), q(5059).
-p(5061) :- p(5060), q(5061).
-q(5061) :- p(5060), q(5060).
-p(5062) :- p(5061), q(5062).
-q(5062) :- p(5061), q(5061).
-p(5063) :- p(5062), q(5063).
-q(5063) :- p(5062), q(5062).
-p(5064) :- p(5063), q(5064).
-q(5064) :- p(5063), q(5063).
-p(5065) :- p(5064), q(5065).
-q(5065) :- p(5064), q(5064).
-p(5066) :- p(5065), q(5066).
-q(5066) :- p(5065), q(5065).
-p(5067) :- p(5066), q(5067).
-q(5067) :- p(5066), q(5066).
-p(5068) :- p(5067), q(5068).
-q(5068) :- p(5067), q(5067).
-p(5069) :- p(5068), q(5069).
-q(5069) :- p(5068), q(5068).
-p(5070) :- p(5069), q(5070).
-q(5070) :- p(5069), q(5069).
-p(5071) :- p(5070), q(5071).
-q(5071) :- p(5070), q(5070).
-p(5072) :- p(5071), q(5072).
-q(5072) :- p(5071), q(5071).
-p(5073) :- p(5072), q(5073).
-q(5073) :- p(5072), q(5072).
-p(5074) :- p(5073), q(5074).
-q(5074) :- p(5073), q(5073).
-p(5075) :- p(5074), q(5075).
-q(5075) :- p(5074), q(5074).
-p(5076) :- p(5075), q(5076).
-q(5076) :- p(5075), q(5075).
-p(5077) :- p(5076), q(5077).
-q(5077) :- p(5076), q(5076).
-p(5078) :- p(5077), q(5078).
-q(5078) :- p(5077), q(5077).
-p(5079) :- p(5078), q(5079).
-q(5079) :- p(5078), q(5078).
-p(5080) :- p(5079), q(5080).
-q(5080) :- p(5079), q(5079).
-p(5081) :- p(5080), q(5081).
-q(5081) :- p(5080), q(5080).
-p(5082) :- p(5081), q(5082).
-q(5082) :- p(5081), q(5081).
-p(5083) :- p(5082), q(5083).
-q(5083) :- p(5082), q(5082).
-p(5084) :- p(5083), q(5084).
-q(5084) :- p(5083), q(5083).
-p(5085) :- p(5084), q(5085).
-q(5085) :- p(5084), q(5084).
-p(5086) :- p(5085), q(5086).
-q(5086) :- p(5085), q(5085).
-p(5087) :- p(5086), q(5087).
-q(5087) :- p(5086), q(5086).
-p(5088) :- p(5087), q(5088).
-q(5088) :- p(5087), q(5087).
-p(5089) :- p(5088), q(5089).
-q(5089) :- p(5088), q(5088).
-p(5090) :- p(5089), q(5090).
-q(5090) :- p(5089), q(5089).
-p(5091) :- p(5090), q(5091).
-q(5091) :- p(5090), q(5090).
-p(5092) :- p(5091), q(5092).
-q(5092) :- p(5091), q(5091).
-p(5093) :- p(5092), q(5093).
-q(5093) :- p(5092), q(5092).
-p(5094) :- p(5093), q(5094).
-q(5094) :- p(5093), q(5093).
-p(5095) :- p(5094), q(5095).
-q(5095) :- p(5094), q(5094).
-p(5096) :- p(5095), q(5096).
-q(5096) :- p(5095), q(5095).
-p(5097) :- p(5096), q(5097).
-q(5097) :- p(5096), q(5096).
-p(5098) :- p(5097), q(5098).
-q(5098) :- p(5097), q(5097).
-p(5099) :- p(5098), q(5099).
-q(5099) :- p(5098), q(5098).
-p(5100) :- p(5099), q(5100).
-q(5100) :- p(5099), q(5099).
-p(5101) :- p(5100), q(5101).
-q(5101) :- p(5100), q(5100).
-p(5102) :- p(5101), q(5102).
-q(5102) :- p(5101), q(5101).
-p(5103) :- p(5102), q(5103).
-q(5103) :- p(5102), q(5102).
-p(5104) :- p(5103), q(5104).
-q(5104) :- p(5103), q(5103).
-p(5105) :- p(5104), q(5105).
-q(5105) :- p(5104), q(5104).
-p(5106) :- p(5105), q(5106).
-q(5106) :- p(5105), q(5105).
-p(5107) :- p(5106), q(5107).
-q(5107) :- p(5106), q(5106).
-p(5108) :- p(5107), q(5108).
-q(5108) :- p(5107), q(5107).
-p(5109) :- p(5108), q(5109).
-q(5109) :- p(5108), q(5108).
-p(5110) :- p(5109), q(5110).
-q(5110) :- p(5109), q(5109).
-p(5111) :- p(5110), q(5111).
-q(5111) :- p(5110), q(5110).
-p(5112) :- p(5111), q(5112).
-q(5112) :- p(5111), q(5111).
-p(5113) :- p(5112), q(5113).
-q(5113) :- p(5112), q(5112).
-p(5114) :- p(5113), q(5114).
-q(5114) :- p(5113), q(5113).
-p(5115) :- p(5114), q(5115).
-q(5115) :- p(5114), q(5114).
-p(5116) :- p(5115), q(5116).
-q(5116) :- p(5115), q(5115).
-p(5117) :- p(5116), q(5117).
-q(5117) :- p(5116), q(5116).
-p(5118) :- p(5117), q(5118).
-q(5118) :- p(5117), q(5117).
-p(5119) :- p(5118), q(5119).
-q(5119) :- p(5118), q(5118).
-p(5120) :- p(5119), q(5120).
-q(5120) :- p(5119), q(5119).
-p(5121) :- p(5120), q(5121).
-q(5121) :- p(5120), q(5120).
-p(5122) :- p(5121), q(5122).
-q(5122) :- p(5121), q(5121).
-p(5123) :- p(5122), q(5123).
-q(5123) :- p(5122), q(5122).
-p(5124) :- p(5123), q(5124).
-q(5124) :- p(5123), q(5123).
-p(5125) :- p(5124), q(5125).
-q(5125) :- p(5124), q(5124).
-p(5126) :- p(5125), q(5126).
-q(5126) :- p(5125), q(5125).
-p(5127) :- p(5126), q(5127).
-q(5127) :- p(5126), q(5126).
-p(5128) :- p(5127), q(5128).
-q(5128) :- p(5127), q(5127).
-p(5129) :- p(5128), q(5129).
-q(5129) :- p(5128), q(5128).
-p(5130) :- p(5129), q(5130).
-q(5130) :- p(5129), q(5129).
-p(5131) :- p(5130), q(5131).
-q(5131) :- p(5130), q(5130).
-p(5132) :- p(5131), q(5132).
-q(5132) :- p(5131), q(5131).
-p(5133) :- p(5132), q(5133).
-q(5133) :- p(5132), q(5132).
-p(5134) :- p(5133), q(5134).
-q(5134) :- p(5133), q(5133).
-p(5135) :- p(5134), q(5135).
-q(5135) :- p(5134), q(5134).
-p(5136) :- p(5135), q(5136).
-q(5136) :- p(5135), q(5135).
-p(5137) :- p(5136), q(5137).
-q(5137) :- p(5136), q(5136).
-p(5138) :- p(5137), q(5138).
-q(5138) :- p(5137), q(5137).
-p(5139) :- p(5138), q(5139).
-q(5139) :- p(5138), q(5138).
-p(5140) :- p(5139), q(5140).
-q(5140) :- p(5139), q(5139).
-p(5141) :- p(5140), q(5141).
-q(5141) :- p(5140), q(5140).
-p(5142) :- p(5141), q(5142).
-q(5142) :- p(5141), q(5141).
-p(5143) :- p(5142), q(5143).
-q(5143) :- p(5142), q(5142).
-p(5144) :- p(5143), q(5144).
-q(5144) :- p(5143), q(5143).
-p(5145) :- p(5144), q(5145).
-q(5145) :- p(5144), q(5144).
-p(5146) :- p(5145), q(5146).
-q(5146) :- p(5145), q(5145).
-p(5147) :- p(5146), q(5147).
-q(5147) :- p(5146), q(5146).
-p(5148) :- p(5147), q(5148).
-q(5148) :- p(5147), q(5147).
-p(5149) :- p(5148), q(5149).
-q(5149) :- p(5148), q(5148).
-p(5150) :- p(5149), q(5150).
-q(5150) :- p(5149), q(5149).
-p(5151) :- p(5150), q(5151).
-q(5151) :- p(5150), q(5150).
-p(5152) :- p(5151), q(5152).
-q(5152) :- p(5151), q(5151).
-p(5153) :- p(5152), q(5153).
-q(5153) :- p(5152), q(5152).
-p(5154) :- p(5153), q(5154).
-q(5154) :- p(5153), q(5153).
-p(5155) :- p(5154), q(5155).
-q(5155) :- p(5154), q(5154).
-p(5156) :- p(5155), q(5156).
-q(5156) :- p(5155), q(5155).
-p(5157) :- p(5156), q(5157).
-q(5157) :- p(5156), q(5156).
-p(5158) :- p(5157), q(5158).
-q(5158) :- p(5157), q(5157).
-p(5159) :- p(5158), q(5159).
-q(5159) :- p(5158), q(5158).
-p(5160) :- p(5159), q(5160).
-q(5160) :- p(5159), q(5159).
-p(5161) :- p(5160), q(5161).
-q(5161) :- p(5160), q(5160).
-p(5162) :- p(5161), q(5162).
-q(5162) :- p(5161), q(5161).
-p(5163) :- p(5162), q(5163).
-q(5163) :- p(5162), q(5162).
-p(5164) :- p(5163), q(5164).
-q(5164) :- p(5163), q(5163).
-p(5165) :- p(5164), q(5165).
-q(5165) :- p(5164), q(5164).
-p(5166) :- p(5165), q(5166).
-q(5166) :- p(5165), q(5165).
-p(5167) :- p(5166), q(5167).
-q(5167) :- p(5166), q(5166).
-p(5168) :- p(5167), q(5168).
-q(5168) :- p(5167), q(5167).
-p(5169) :- p(5168), q(5169).
-q(5169) :- p(5168), q(5168).
-p(5170) :- p(5169), q(5170).
-q(5170) :- p(5169), q(5169).
-p(5171) :- p(5170), q(5171).
-q(5171) :- p(5170), q(5170).
-p(5172) :- p(5171), q(5172).
-q(5172) :- p(5171), q(5171).
-p(5173) :- p(5172), q(5173).
-q(5173) :- p(5172), q(5172).
-p(5174) :- p(5173), q(5174).
-q(5174) :- p(5173), q(5173).
-p(5175) :- p(5174), q(5175).
-q(5175) :- p(5174), q(5174).
-p(5176) :- p(5175), q(5176).
-q(5176) :- p(5175), q(5175).
-p(5177) :- p(5176), q(5177).
-q(5177) :- p(5176), q(5176).
-p(5178) :- p(5177), q(5178).
-q(5178) :- p(5177), q(5177).
-p(5179) :- p(5178), q(5179).
-q(5179) :- p(5178), q(5178).
-p(5180) :- p(5179), q(5180).
-q(5180) :- p(5179), q(5179).
-p(5181) :- p(5180), q(5181).
-q(5181) :- p(5180), q(5180).
-p(5182) :- p(5181), q(5182).
-q(5182) :- p(5181), q(5181).
-p(5183) :- p(5182), q(5183).
-q(5183) :- p(5182), q(5182).
-p(5184) :- p(5183), q(5184).
-q(5184) :- p(5183), q(5183).
-p(5185) :- p(5184), q(5185).
-q(5185) :- p(5184), q(5184).
-p(5186) :- p(5185), q(5186).
-q(5186) :- p(5185), q(5185).
-p(5187) :- p(5186), q(5187).
-q(5187) :- p(5186), q(5186).
-p(5188) :- p(5187), q(5188).
-q(5188) :- p(5187), q(5187).
-p(5189) :- p(5188), q(5189).
-q(5189) :- p(5188), q(5188).
-p(5190) :- p(5189), q(5190).
-q(5190) :- p(5189), q(5189).
-p(5191) :- p(5190), q(5191).
-q(5191) :- p(5190), q(5190).
-p(5192) :- p(5191), q(5192).
-q(5192) :- p(5191), q(5191).
-p(5193) :- p(5192), q(5193).
-q(5193) :- p(5192), q(5192).
-p(5194) :- p(5193), q(5194).
-q(5194) :- p(5193), q(5193).
-p(5195) :- p(5194), q(5195).
-q(5195) :- p(5194), q(5194).
-p(5196) :- p(5195), q(5196).
-q(5196) :- p(5195), q(5195).
-p(5197) :- p(5196), q(5197).
-q(5197) :- p(5196), q(5196).
-p(5198) :- p(5197), q(5198).
-q(5198) :- p(5197), q(5197).
-p(5199) :- p(5198), q(5199).
-q(5199) :- p(5198), q(5198).
-p(5200) :- p(5199), q(5200).
-q(5200) :- p(5199), q(5199).
-p(5201) :- p(5200), q(5201).
-q(5201) :- p(5200), q(5200).
-p(5202) :- p(5201), q(5202).
-q(5202) :- p(5201), q(5201).
-p(5203) :- p(5202), q(5203).
-q(5203) :- p(5202), q(5202).
-p(5204) :- p(5203), q(5204).
-q(5204) :- p(5203), q(5203).
-p(5205) :- p(5204), q(5205).
-q(5205) :- p(5204), q(5204).
-p(5206) :- p(5205), q(5206).
-q(5206) :- p(5205), q(5205).
-p(5207) :- p(5206), q(5207).
-q(5207) :- p(5206), q(5206).
-p(5208) :- p(5207), q(5208).
-q(5208) :- p(5207), q(5207).
-p(5209) :- p(5208), q(5209).
-q(5209) :- p(5208), q(5208).
-p(5210) :- p(5209), q(5210).
-q(5210) :- p(5209), q(5209).
-p(5211) :- p(5210), q(5211).
-q(5211) :- p(5210), q(5210).
-p(5212) :- p(5211), q(5212).
-q(5212) :- p(5211), q(5211).
-p(5213) :- p(5212), q(5213).
-q(5213) :- p(5212), q(5212).
-p(5214) :- p(5213), q(5214).
-q(5214) :- p(5213), q(5213).
-p(5215) :- p(5214), q(5215).
-q(5215) :- p(5214), q(5214).
-p(5216) :- p(5215), q(5216).
-q(5216) :- p(5215), q(5215).
-p(5217) :- p(5216), q(5217).
-q(5217) :- p(5216), q(5216).
-p(5218) :- p(5217), q(5218).
-q(5218) :- p(5217), q(5217).
-p(5219) :- p(5218), q(5219).
-q(5219) :- p(5218), q(5218).
-p(5220) :- p(5219), q(5220).
-q(5220) :- p(5219), q(5219).
-p(5221) :- p(5220), q(5221).
-q(5221) :- p(5220), q(5220).
-p(5222) :- p(5221), q(5222).
-q(5222) :- p(5221), q(5221).
-p(5223) :- p(5222), q(5223).
-q(5223) :- p(5222), q(5222).
-p(5224) :- p(5223), q(5224).
-q(5224) :- p(5223), q(5223).
-p(5225) :- p(5224), q(5225).
-q(5225) :- p(5224), q(5224).
-p(5226) :- p(5225), q(5226).
-q(5226) :- p(5225), q(5225).
-p(5227) :- p(5226), q(5227).
-q(5227) :- p(5226), q(5226).
-p(5228) :- p(5227), q(5228).
-q(5228) :- p(5227), q(5227).
-p(5229) :- p(5228), q(5229).
-q(5229) :- p(5228), q(5228).
-p(5230) :- p(5229), q(5230).
-q(5230) :- p(5229), q(5229).
-p(5231) :- p(5230), q(5231).
-q(5231) :- p(5230), q(5230).
-p(5232) :- p(5231), q(5232).
-q(5232) :- p(5231), q(5231).
-p(5233) :- p(5232), q(5233).
-q(5233) :- p(5232), q(5232).
-p(5234) :- p(5233), q(5234).
-q(5234) :- p(5233), q(5233).
-p(5235) :- p(5234), q(5235).
-q(5235) :- p(5234), q(5234).
-p(5236) :- p(5235), q(5236).
-q(5236) :- p(5235), q(5235).
-p(5237) :- p(5236), q(5237).
-q(5237) :- p(5236), q(5236).
-p(5238) :- p(5237), q(5238).
-q(5238) :- p(5237), q(5237).
-p(5239) :- p(5238), q(5239).
-q(5239) :- p(5238), q(5238).
-p(5240) :- p(5239), q(5240).
-q(5240) :- p(5239), q(5239).
-p(5241) :- p(5240), q(5241).
-q(5241) :- p(5240), q(5240).
-p(5242) :- p(5241), q(5242).
-q(5242) :- p(5241), q(5241).
-p(5243) :- p(5242), q(5243).
-q(5243) :- p(5242), q(5242).
-p(5244) :- p(5243), q(5244).
-q(5244) :- p(5243), q(5243).
-p(5245) :- p(5244), q(5245).
-q(5245) :- p(5244), q(5244).
-p(5246) :- p(5245), q(5246).
-q(5246) :- p(5245), q(5245).
-p(5247) :- p(5246), q(5247).
-q(5247) :- p(5246), q(5246).
-p(5248) :- p(5247), q(5248).
-q(5248) :- p(5247), q(5247).
-p(5249) :- p(5248), q(5249).
-q(5249) :- p(5248), q(5248).
-p(5250) :- p(5249), q(5250).
-q(5250) :- p(5249), q(5249).
-p(5251) :- p(5250), q(5251).
-q(5251) :- p(5250), q(5250).
-p(5252) :- p(5251), q(5252).
-q(5252) :- p(5251), q(5251).
-p(5253) :- p(5252), q(5253).
-q(5253) :- p(5252), q(5252).
-p(5254) :- p(5253), q(5254).
-q(5254) :- p(5253), q(5253).
-p(5255) :- p(5254), q(5255).
-q(5255) :- p(5254), q(5254).
-p(5256) :- p(5255), q(5256).
-q(5256) :- p(5255), q(5255).
-p(5257) :- p(5256), q(5257).
-q(5257) :- p(5256), q(5256).
-p(5258) :- p(5257), q(5258).
-q(5258) :- p(5257), q(5257).
-p(5259) :- p(5258), q(5259).
-q(5259) :- p(5258), q(5258).
-p(5260) :- p(5259), q(5260).
-q(5260) :- p(5259), q(5259).
-p(5261) :- p(5260), q(5261).
-q(5261) :- p(5260), q(5260).
-p(5262) :- p(5261), q(5262).
-q(5262) :- p(5261), q(5261).
-p(5263) :- p(5262), q(5263).
-q(5263) :- p(5262), q(5262).
-p(5264) :- p(5263), q(5264).
-q(5264) :- p(5263), q(5263).
-p(5265) :- p(5264), q(5265).
-q(5265) :- p(5264), q(5264).
-p(5266) :- p(5265), q(5266).
-q(5266) :- p(5265), q(5265).
-p(5267) :- p(5266), q(5267).
-q(5267) :- p(5266), q(5266).
-p(5268) :- p(5267), q(5268).
-q(5268) :- p(5267), q(5267).
-p(5269) :- p(5268), q(5269).
-q(5269) :- p(5268), q(5268).
-p(5270) :- p(5269), q(5270).
-q(5270) :- p(5269), q(5269).
-p(5271) :- p(5270), q(5271).
-q(5271) :- p(5270), q(5270).
-p(5272) :- p(5271), q(5272).
-q(5272) :- p(5271), q(5271).
-p(5273) :- p(5272), q(5273).
-q(5273) :- p(5272), q(5272).
-p(5274) :- p(5273), q(5274).
-q(5274) :- p(5273), q(5273).
-p(5275) :- p(5274), q(5275).
-q(5275) :- p(5274), q(5274).
-p(5276) :- p(5275), q(5276).
-q(5276) :- p(5275), q(5275).
-p(5277) :- p(5276), q(5277).
-q(5277) :- p(5276), q(5276).
-p(5278) :- p(5277), q(5278).
-q(5278) :- p(5277), q(5277).
-p(5279) :- p(5278), q(5279).
-q(5279) :- p(5278), q(5278).
-p(5280) :- p(5279), q(5280).
-q(5280) :- p(5279), q(5279).
-p(5281) :- p(5280), q(5281).
-q(5281) :- p(5280), q(5280).
-p(5282) :- p(5281), q(5282).
-q(5282) :- p(5281), q(5281).
-p(5283) :- p(5282), q(5283).
-q(5283) :- p(5282), q(5282).
-p(5284) :- p(5283), q(5284).
-q(5284) :- p(5283), q(5283).
-p(5285) :- p(5284), q(5285).
-q(5285) :- p(5284), q(5284).
-p(5286) :- p(5285), q(5286).
-q(5286) :- p(5285), q(5285).
-p(5287) :- p(5286), q(5287).
-q(5287) :- p(5286), q(5286).
-p(5288) :- p(5287), q(5288).
-q(5288) :- p(5287), q(5287).
-p(5289) :- p(5288), q(5289).
-q(5289) :- p(5288), q(5288).
-p(5290) :- p(5289), q(5290).
-q(5290) :- p(5289), q(5289).
-p(5291) :- p(5290), q(5291).
-q(5291) :- p(5290), q(5290).
-p(5292) :- p(5291), q(5292).
-q(5292) :- p(5291), q(5291).
-p(5293) :- p(5292), q(5293).
-q(5293) :- p(5292), q(5292).
-p(5294) :- p(5293), q(5294).
-q(5294) :- p(5293), q(5293).
-p(5295) :- p(5294), q(5295).
-q(5295) :- p(5294), q(5294).
-p(5296) :- p(5295), q(5296).
-q(5296) :- p(5295), q(5295).
-p(5297) :- p(5296), q(5297).
-q(5297) :- p(5296), q(5296).
-p(5298) :- p(5297), q(5298).
-q(5298) :- p(5297), q(5297).
-p(5299) :- p(5298), q(5299).
-q(5299) :- p(5298), q(5298).
-p(5300) :- p(5299), q(5300).
-q(5300) :- p(5299), q(5299).
-p(5301) :- p(5300), q(5301).
-q(5301) :- p(5300), q(5300).
-p(5302) :- p(5301), q(5302).
-q(5302) :- p(5301), q(5301).
-p(5303) :- p(5302), q(5303).
-q(5303) :- p(5302), q(5302).
-p(5304) :- p(5303), q(5304).
-q(5304) :- p(5303), q(5303).
-p(5305) :- p(5304), q(5305).
-q(5305) :- p(5304), q(5304).
-p(5306) :- p(5305), q(5306).
-q(5306) :- p(5305), q(5305).
-p(5307) :- p(5306), q(5307).
-q(5307) :- p(5306), q(5306).
-p(5308) :- p(5307), q(5308).
-q(5308) :- p(5307), q(5307).
-p(5309) :- p(5308), q(5309).
-q(5309) :- p(5308), q(5308).
-p(5310) :- p(5309), q(5310).
-q(5310) :- p(5309), q(5309).
-p(5311) :- p(5310), q(5311).
-q(5311) :- p(5310), q(5310).
-p(5312) :- p(5311), q(5312).
-q(5312) :- p(5311), q(5311).
-p(5313) :- p(5312), q(5313).
-q(5313) :- p(5312), q(5312).
-p(5314) :- p(5313), q(5314).
-q(5314) :- p(5313), q(5313).
-p(5315) :- p(5314), q(5315).
-q(5315) :- p(5314), q(5314).
-p(5316) :- p(5315), q(5316).
-q(5316) :- p(5315), q(5315).
-p(5317) :- p(5316), q(5317).
-q(5317) :- p(5316), q(5316).
-p(5318) :- p(5317), q(5318).
-q(5318) :- p(5317), q(5317).
-p(5319) :- p(5318), q(5319).
-q(5319) :- p(5318), q(5318).
-p(5320) :- p(5319), q(5320).
-q(5320) :- p(5319), q(5319).
-p(5321) :- p(5320), q(5321).
-q(5321) :- p(5320), q(5320).
-p(5322) :- p(5321), q(5322).
-q(5322) :- p(5321), q(5321).
-p(5323) :- p(5322), q(5323).
-q(5323) :- p(5322), q(5322).
-p(5324) :- p(5323), q(5324).
-q(5324) :- p(5323), q(5323).
-p(5325) :- p(5324), q(5325).
-q(5325) :- p(5324), q(5324).
-p(5326) :- p(5325), q(5326).
-q(5326) :- p(5325), q(5325).
-p(5327) :- p(5326), q(5327).
-q(5327) :- p(5326), q(5326).
-p(5328) :- p(5327), q(5328).
-q(5328) :- p(5327), q(5327).
-p(5329) :- p(5328), q(5329).
-q(5329) :- p(5328), q(5328).
-p(5330) :- p(5329), q(5330).
-q(5330) :- p(5329), q(5329).
-p(5331) :- p(5330), q(5331).
-q(5331) :- p(5330), q(5330).
-p(5332) :- p(5331), q(5332).
-q(5332) :- p(5331), q(5331).
-p(5333) :- p(5332), q(5333).
-q(5333) :- p(5332), q(5332).
-p(5334) :- p(5333), q(5334).
-q(5334) :- p(5333), q(5333).
-p(5335) :- p(5334), q(5335).
-q(5335) :- p(5334), q(5334).
-p(5336) :- p(5335), q(5336).
-q(5336) :- p(5335), q(5335).
-p(5337) :- p(5336), q(5337).
-q(5337) :- p(5336), q(5336).
-p(5338) :- p(5337), q(5338).
-q(5338) :- p(5337), q(5337).
-p(5339) :- p(5338), q(5339).
-q(5339) :- p(5338), q(5338).
-p(5340) :- p(5339), q(5340).
-q(5340) :- p(5339), q(5339).
-p(5341) :- p(5340), q(5341).
-q(5341) :- p(5340), q(5340).
-p(5342) :- p(5341), q(5342).
-q(5342) :- p(5341), q(5341).
-p(5343) :- p(5342), q(5343).
-q(5343) :- p(5342), q(5342).
-p(5344) :- p(5343), q(5344).
-q(5344) :- p(5343), q(5343).
-p(5345) :- p(5344), q(5345).
-q(5345) :- p(5344), q(5344).
-p(5346) :- p(5345), q(5346).
-q(5346) :- p(5345), q(5345).
-p(5347) :- p(5346), q(5347).
-q(5347) :- p(5346), q(5346).
-p(5348) :- p(5347), q(5348).
-q(5348) :- p(5347), q(5347).
-p(5349) :- p(5348), q(5349).
-q(5349) :- p(5348), q(5348).
-p(5350) :- p(5349), q(5350).
-q(5350) :- p(5349), q(5349).
-p(5351) :- p(5350), q(5351).
-q(5351) :- p(5350), q(5350).
-p(5352) :- p(5351), q(5352).
-q(5352) :- p(5351), q(5351).
-p(5353) :- p(5352), q(5353).
-q(5353) :- p(5352), q(5352).
-p(5354) :- p(5353), q(5354).
-q(5354) :- p(5353), q(5353).
-p(5355) :- p(5354), q(5355).
-q(5355) :- p(5354), q(5354).
-p(5356) :- p(5355), q(5356).
-q(5356) :- p(5355), q(5355).
-p(5357) :- p(5356), q(5357).
-q(5357) :- p(5356), q(5356).
-p(5358) :- p(5357), q(5358).
-q(5358) :- p(5357), q(5357).
-p(5359) :- p(5358), q(5359).
-q(5359) :- p(5358), q(5358).
-p(5360) :- p(5359), q(5360).
-q(5360) :- p(5359), q(5359).
-p(5361) :- p(5360), q(5361).
-q(5361) :- p(5360), q(5360).
-p(5362) :- p(5361), q(5362).
-q(5362) :- p(5361), q(5361).
-p(5363) :- p(5362), q(5363).
-q(5363) :- p(5362), q(5362).
-p(5364) :- p(5363), q(5364).
-q(5364) :- p(5363), q(5363).
-p(5365) :- p(5364), q(5365).
-q(5365) :- p(5364), q(5364).
-p(5366) :- p(5365), q(5366).
-q(5366) :- p(5365), q(5365).
-p(5367) :- p(5366), q(5367).
-q(5367) :- p(5366), q(5366).
-p(5368) :- p(5367), q(5368).
-q(5368) :- p(5367), q(5367).
-p(5369) :- p(5368), q(5369).
-q(5369) :- p(5368), q(5368).
-p(5370) :- p(5369), q(5370).
-q(5370) :- p(5369), q(5369).
-p(5371) :- p(5370), q(5371).
-q(5371) :- p(5370), q(5370).
-p(5372) :- p(5371), q(5372).
-q(5372) :- p(5371), q(5371).
-p(5373) :- p(5372), q(5373).
-q(5373) :- p(5372), q(5372).
-p(5374) :- p(5373), q(5374).
-q(5374) :- p(5373), q(5373).
-p(5375) :- p(5374), q(5375).
-q(5375) :- p(5374), q(5374).
-p(5376) :- p(5375), q(5376).
-q(5376) :- p(5375), q(5375).
-p(5377) :- p(5376), q(5377).
-q(5377) :- p(5376), q(5376).
-p(5378) :- p(5377), q(5378).
-q(5378) :- p(5377), q(5377).
-p(5379) :- p(5378), q(5379).
-q(5379) :- p(5378), q(5378).
-p(5380) :- p(5379), q(5380).
-q(5380) :- p(5379), q(5379).
-p(5381) :- p(5380), q(5381).
-q(5381) :- p(5380), q(5380).
-p(5382) :- p(5381), q(5382).
-q(5382) :- p(5381), q(5381).
-p(5383) :- p(5382), q(5383).
-q(5383) :- p(5382), q(5382).
-p(5384) :- p(5383), q(5384).
-q(5384) :- p(5383), q(5383).
-p(5385) :- p(5384), q(5385).
-q(5385) :- p(5384), q(5384).
-p(5386) :- p(5385), q(5386).
-q(5386) :- p(5385), q(5385).
-p(5387) :- p(5386), q(5387).
-q(5387) :- p(5386), q(5386).
-p(5388) :- p(5387), q(5388).
-q(5388) :- p(5387), q(5387).
-p(5389) :- p(5388), q(5389).
-q(5389) :- p(5388), q(5388).
-p(5390) :- p(5389), q(5390).
-q(5390) :- p(5389), q(5389).
-p(5391) :- p(5390), q(5391).
-q(5391) :- p(5390), q(5390).
-p(5392) :- p(5391), q(5392).
-q(5392) :- p(5391), q(5391).
-p(5393) :- p(5392), q(5393).
-q(5393) :- p(5392), q(5392).
-p(5394) :- p(5393), q(5394).
-q(5394) :- p(5393), q(5393).
-p(5395) :- p(5394), q(5395).
-q(5395) :- p(5394), q(5394).
-p(5396) :- p(5395), q(5396).
-q(5396) :- p(5395), q(5395).
-p(5397) :- p(5396), q(5397).
-q(5397) :- p(5396), q(5396).
-p(5398) :- p(5397), q(5398).
-q(5398) :- p(5397), q(5397).
-p(5399) :- p(5398), q(5399).
-q(5399) :- p(5398), q(5398).
-p(5400) :- p(5399), q(5400).
-q(5400) :- p(5399), q(5399).
-p(5401) :- p(5400), q(5401).
-q(5401) :- p(5400), q(5400).
-p(5402) :- p(5401), q(5402).
-q(5402) :- p(5401), q(5401).
-p(5403) :- p(5402), q(5403).
-q(5403) :- p(5402), q(5402).
-p(5404) :- p(5403), q(5404).
-q(5404) :- p(5403), q(5403).
-p(5405) :- p(5404), q(5405).
-q(5405) :- p(5404), q(5404).
-p(5406) :- p(5405), q(5406).
-q(5406) :- p(5405), q(5405).
-p(5407) :- p(5406), q(5407).
-q(5407) :- p(5406), q(5406).
-p(5408) :- p(5407), q(5408).
-q(5408) :- p(5407), q(5407).
-p(5409) :- p(5408), q(5409).
-q(5409) :- p(5408), q(5408).
-p(5410) :- p(5409), q(5410).
-q(5410) :- p(5409), q(5409).
-p(5411) :- p(5410), q(5411).
-q(5411) :- p(5410), q(5410).
-p(5412) :- p(5411), q(5412).
-q(5412) :- p(5411), q(5411).
-p(5413) :- p(5412), q(5413).
-q(5413) :- p(5412), q(5412).
-p(5414) :- p(5413), q(5414).
-q(5414) :- p(5413), q(5413).
-p(5415) :- p(5414), q(5415).
-q(5415) :- p(5414), q(5414).
-p(5416) :- p(5415), q(5416).
-q(5416) :- p(5415), q(5415).
-p(5417) :- p(5416), q(5417).
-q(5417) :- p(5416), q(5416).
-p(5418) :- p(5417), q(5418).
-q(5418) :- p(5417), q(5417).
-p(5419) :- p(5418), q(5419).
-q(5419) :- p(5418), q(5418).
-p(5420) :- p(5419), q(5420).
-q(5420) :- p(5419), q(5419).
-p(5421) :- p(5420), q(5421).
-q(5421) :- p(5420), q(5420).
-p(5422) :- p(5421), q(5422).
-q(5422) :- p(5421), q(5421).
-p(5423) :- p(5422), q(5423).
-q(5423) :- p(5422), q(5422).
-p(5424) :- p(5423), q(5424).
-q(5424) :- p(5423), q(5423).
-p(5425) :- p(5424), q(5425).
-q(5425) :- p(5424), q(5424).
-p(5426) :- p(5425), q(5426).
-q(5426) :- p(5425), q(5425).
-p(5427) :- p(5426), q(5427).
-q(5427) :- p(5426), q(5426).
-p(5428) :- p(5427), q(5428).
-q(5428) :- p(5427), q(5427).
-p(5429) :- p(5428), q(5429).
-q(5429) :- p(5428), q(5428).
-p(5430) :- p(5429), q(5430).
-q(5430) :- p(5429), q(5429).
-p(5431) :- p(5430), q(5431).
-q(5431) :- p(5430), q(5430).
-p(5432) :- p(5431), q(5432).
-q(5432) :- p(5431), q(5431).
-p(5433) :- p(5432), q(5433).
-q(5433) :- p(5432), q(5432).
-p(5434) :- p(5433), q(5434).
-q(5434) :- p(5433), q(5433).
-p(5435) :- p(5434), q(5435).
-q(5435) :- p(5434), q(5434).
-p(5436) :- p(5435), q(5436).
-q(5436) :- p(5435), q(5435).
-p(5437) :- p(5436), q(5437).
-q(5437) :- p(5436), q(5436).
-p(5438) :- p(5437), q(5438).
-q(5438) :- p(5437), q(5437).
-p(5439) :- p(5438), q(5439).
-q(5439) :- p(5438), q(5438).
-p(5440) :- p(5439), q(5440).
-q(5440) :- p(5439), q(5439).
-p(5441) :- p(5440), q(5441).
-q(5441) :- p(5440), q(5440).
-p(5442) :- p(5441), q(5442).
-q(5442) :- p(5441), q(5441).
-p(5443) :- p(5442), q(5443).
-q(5443) :- p(5442), q(5442).
-p(5444) :- p(5443), q(5444).
-q(5444) :- p(5443), q(5443).
-p(5445) :- p(5444), q(5445).
-q(5445) :- p(5444), q(5444).
-p(5446) :- p(5445), q(5446).
-q(5446) :- p(5445), q(5445).
-p(5447) :- p(5446), q(5447).
-q(5447) :- p(5446), q(5446).
-p(5448) :- p(5447), q(5448).
-q(5448) :- p(5447), q(5447).
-p(5449) :- p(5448), q(5449).
-q(5449) :- p(5448), q(5448).
-p(5450) :- p(5449), q(5450).
-q(5450) :- p(5449), q(5449).
-p(5451) :- p(5450), q(5451).
-q(5451) :- p(5450), q(5450).
-p(5452) :- p(5451), q(5452).
-q(5452) :- p(5451), q(5451).
-p(5453) :- p(5452), q(5453).
-q(5453) :- p(5452), q(5452).
-p(5454) :- p(5453), q(5454).
-q(5454) :- p(5453), q(5453).
-p(5455) :- p(5454), q(5455).
-q(5455) :- p(5454), q(5454).
-p(5456) :- p(5455), q(5456).
-q(5456) :- p(5455), q(5455).
-p(5457) :- p(5456), q(5457).
-q(5457) :- p(5456), q(5456).
-p(5458) :- p(5457), q(5458).
-q(5458) :- p(5457), q(5457).
-p(5459) :- p(5458), q(5459).
-q(5459) :- p(5458), q(5458).
-p(5460) :- p(5459), q(5460).
-q(5460) :- p(5459), q(5459).
-p(5461) :- p(5460), q(5461).
-q(5461) :- p(5460), q(5460).
-p(5462) :- p(5461), q(5462).
-q(5462) :- p(5461), q(5461).
-p(5463) :- p(5462), q(5463).
-q(5463) :- p(5462), q(5462).
-p(5464) :- p(5463), q(5464).
-q(5464) :- p(5463), q(5463).
-p(5465) :- p(5464), q(5465).
-q(5465) :- p(5464), q(5464).
-p(5466) :- p(5465), q(5466).
-q(5466) :- p(5465), q(5465).
-p(5467) :- p(5466), q(5467).
-q(5467) :- p(5466), q(5466).
-p(5468) :- p(5467), q(5468).
-q(5468) :- p(5467), q(5467).
-p(5469) :- p(5468), q(5469).
-q(5469) :- p(5468), q(5468).
-p(5470) :- p(5469), q(5470).
-q(5470) :- p(5469), q(5469).
-p(5471) :- p(5470), q(5471).
-q(5471) :- p(5470), q(5470).
-p(5472) :- p(5471), q(5472).
-q(5472) :- p(5471), q(5471).
-p(5473) :- p(5472), q(5473).
-q(5473) :- p(5472), q(5472).
-p(5474) :- p(5473), q(5474).
-q(5474) :- p(5473), q(5473).
-p(5475) :- p(5474), q(5475).
-q(5475) :- p(5474), q(5474).
-p(5476) :- p(5475), q(5476).
-q(5476) :- p(5475), q(5475).
-p(5477) :- p(5476), q(5477).
-q(5477) :- p(5476), q(5476).
-p(5478) :- p(5477), q(5478).
-q(5478) :- p(5477), q(5477).
-p(5479) :- p(5478), q(5479).
-q(5479) :- p(5478), q(5478).
-p(5480) :- p(5479), q(5480).
-q(5480) :- p(5479), q(5479).
-p(5481) :- p(5480), q(5481).
-q(5481) :- p(5480), q(5480).
-p(5482) :- p(5481), q(5482).
-q(5482) :- p(5481), q(5481).
-p(5483) :- p(5482), q(5483).
-q(5483) :- p(5482), q(5482).
-p(5484) :- p(5483), q(5484).
-q(5484) :- p(5483), q(5483).
-p(5485) :- p(5484), q(5485).
-q(5485) :- p(5484), q(5484).
-p(5486) :- p(5485), q(5486).
-q(5486) :- p(5485), q(5485).
-p(5487) :- p(5486), q(5487).
-q(5487) :- p(5486), q(5486).
-p(5488) :- p(5487), q(5488).
-q(5488) :- p(5487), q(5487).
-p(5489) :- p(5488), q(5489).
-q(5489) :- p(5488), q(5488).
-p(5490) :- p(5489), q(5490).
-q(5490) :- p(5489), q(5489).
-p(5491) :- p(5490), q(5491).
-q(5491) :- p(5490), q(5490).
-p(5492) :- p(5491), q(5492).
-q(5492) :- p(5491), q(5491).
-p(5493) :- p(5492), q(5493).
-q(5493) :- p(5492), q(5492).
-p(5494) :- p(5493), q(5494).
-q(5494) :- p(5493), q(5493).
-p(5495) :- p(5494), q(5495).
-q(5495) :- p(5494), q(5494).
-p(5496) :- p(5495), q(5496).
-q(5496) :- p(5495), q(5495).
-p(5497) :- p(5496), q(5497).
-q(5497) :- p(5496), q(5496).
-p(5498) :- p(5497), q(5498).
-q(5498) :- p(5497), q(5497).
-p(5499) :- p(5498), q(5499).
-q(5499) :- p(5498), q(5498).
-p(5500) :- p(5499), q(5500).
-q(5500) :- p(5499), q(5499).
-p(5501) :- p(5500), q(5501).
-q(5501) :- p(5500), q(5500).
-p(5502) :- p(5501), q(5502).
-q(5502) :- p(5501), q(5501).
-p(5503) :- p(5502), q(5503).
-q(5503) :- p(5502), q(5502).
-p(5504) :- p(5503), q(5504).
-q(5504) :- p(5503), q(5503).
-p(5505) :- p(5504), q(5505).
-q(5505) :- p(5504), q(5504).
-p(5506) :- p(5505), q(5506).
-q(5506) :- p(5505), q(5505).
-p(5507) :- p(5506), q(5507).
-q(5507) :- p(5506), q(5506).
-p(5508) :- p(5507), q(5508).
-q(5508) :- p(5507), q(5507).
-p(5509) :- p(5508), q(5509).
-q(5509) :- p(5508), q(5508).
-p(5510) :- p(5509), q(5510).
-q(5510) :- p(5509), q(5509).
-p(5511) :- p(5510), q(5511).
-q(5511) :- p(5510), q(5510).
-p(5512) :- p(5511), q(5512).
-q(5512) :- p(5511), q(5511).
-p(5513) :- p(5512), q(5513).
-q(5513) :- p(5512), q(5512).
-p(5514) :- p(5513), q(5514).
-q(5514) :- p(5513), q(5513).
-p(5515) :- p(5514), q(5515).
-q(5515) :- p(5514), q(5514).
-p(5516) :- p(5515), q(5516).
-q(5516) :- p(5515), q(5515).
-p(5517) :- p(5516), q(5517).
-q(5517) :- p(5516), q(5516).
-p(5518) :- p(5517), q(5518).
-q(5518) :- p(5517), q(5517).
-p(5519) :- p(5518), q(5519).
-q(5519) :- p(5518), q(5518).
-p(5520) :- p(5519), q(5520).
-q(5520) :- p(5519), q(5519).
-p(5521) :- p(5520), q(5521).
-q(5521) :- p(5520), q(5520).
-p(5522) :- p(5521), q(5522).
-q(5522) :- p(5521), q(5521).
-p(5523) :- p(5522), q(5523).
-q(5523) :- p(5522), q(5522).
-p(5524) :- p(5523), q(5524).
-q(5524) :- p(5523), q(5523).
-p(5525) :- p(5524), q(5525).
-q(5525) :- p(5524), q(5524).
-p(5526) :- p(5525), q(5526).
-q(5526) :- p(5525), q(5525).
-p(5527) :- p(5526), q(5527).
-q(5527) :- p(5526), q(5526).
-p(5528) :- p(5527), q(5528).
-q(5528) :- p(5527), q(5527).
-p(5529) :- p(5528), q(5529).
-q(5529) :- p(5528), q(5528).
-p(5530) :- p(5529), q(5530).
-q(5530) :- p(5529), q(5529).
-p(5531) :- p(5530), q(5531).
-q(5531) :- p(5530), q(5530).
-p(5532) :- p(5531), q(5532).
-q(5532) :- p(5531), q(5531).
-p(5533) :- p(5532), q(5533).
-q(5533) :- p(5532), q(5532).
-p(5534) :- p(5533), q(5534).
-q(5534) :- p(5533), q(5533).
-p(5535) :- p(5534), q(5535).
-q(5535) :- p(5534), q(5534).
-p(5536) :- p(5535), q(5536).
-q(5536) :- p(5535), q(5535).
-p(5537) :- p(5536), q(5537).
-q(5537) :- p(5536), q(5536).
-p(5538) :- p(5537), q(5538).
-q(5538) :- p(5537), q(5537).
-p(5539) :- p(5538), q(5539).
-q(5539) :- p(5538), q(5538).
-p(5540) :- p(5539), q(5540).
-q(5540) :- p(5539), q(5539).
-p(5541) :- p(5540), q(5541).
-q(5541) :- p(5540), q(5540).
-p(5542) :- p(5541), q(5542).
-q(5542) :- p(5541), q(5541).
-p(5543) :- p(5542), q(5543).
-q(5543) :- p(5542), q(5542).
-p(5544) :- p(5543), q(5544).
-q(5544) :- p(5543), q(5543).
-p(5545) :- p(5544), q(5545).
-q(5545) :- p(5544), q(5544).
-p(5546) :- p(5545), q(5546).
-q(5546) :- p(5545), q(5545).
-p(5547) :- p(5546), q(5547).
-q(5547) :- p(5546), q(5546).
-p(5548) :- p(5547), q(5548).
-q(5548) :- p(5547), q(5547).
-p(5549) :- p(5548), q(5549).
-q(5549) :- p(5548), q(5548).
-p(5550) :- p(5549), q(5550).
-q(5550) :- p(5549), q(5549).
-p(5551) :- p(5550), q(5551).
-q(5551) :- p(5550), q(5550).
-p(5552) :- p(5551), q(5552).
-q(5552) :- p(5551), q(5551).
-p(5553) :- p(5552), q(5553).
-q(5553) :- p(5552), q(5552).
-p(5554) :- p(5553), q(5554).
-q(5554) :- p(5553), q(5553).
-p(5555) :- p(5554), q(5555).
-q(5555) :- p(5554), q(5554).
-p(5556) :- p(5555), q(5556).
-q(5556) :- p(5555), q(5555).
-p(5557) :- p(5556), q(5557).
-q(5557) :- p(5556), q(5556).
-p(5558) :- p(5557), q(5558).
-q(5558) :- p(5557), q(5557).
-p(5559) :- p(5558), q(5559).
-q(5559) :- p(5558), q(5558).
-p(5560) :- p(5559), q(5560).
-q(5560) :- p(5559), q(5559).
-p(5561) :- p(5560), q(5561).
-q(5561) :- p(5560), q(5560).
-p(5562) :- p(5561), q(5562).
-q(5562) :- p(5561), q(5561).
-p(5563) :- p(5562), q(5563).
-q(5563) :- p(5562), q(5562).
-p(5564) :- p(5563), q(5564).
-q(5564) :- p(5563), q(5563).
-p(5565) :- p(5564), q(5565).
-q(5565) :- p(5564), q(5564).
-p(5566) :- p(5565), q(5566).
-q(5566) :- p(5565), q(5565).
-p(5567) :- p(5566), q(5567).
-q(5567) :- p(5566), q(5566).
-p(5568) :- p(5567), q(5568).
-q(5568) :- p(5567), q(5567).
-p(5569) :- p(5568), q(5569).
-q(5569) :- p(5568), q(5568).
-p(5570) :- p(5569), q(5570).
-q(5570) :- p(5569), q(5569).
-p(5571) :- p(5570), q(5571).
-q(5571) :- p(5570), q(5570).
-p(5572) :- p(5571), q(5572).
-q(5572) :- p(5571), q(5571).
-p(5573) :- p(5572), q(5573).
-q(5573) :- p(5572), q(5572).
-p(5574) :- p(5573), q(5574).
-q(5574) :- p(5573), q(5573).
-p(5575) :- p(5574), q(5575).
-q(5575) :- p(5574), q(5574).
-p(5576) :- p(5575), q(5576).
-q(5576) :- p(5575), q(5575).
-p(5577) :- p(5576), q(5577).
-q(5577) :- p(5576), q(5576).
-p(5578) :- p(5577), q(5578).
-q(5578) :- p(5577), q(5577).
-p(5579) :- p(5578), q(5579).
-q(5579) :- p(5578), q(5578).
-p(5580) :- p(5579), q(5580).
-q(5580) :- p(5579), q(5579).
-p(5581) :- p(5580), q(5581).
-q(5581) :- p(5580), q(5580).
-p(5582) :- p(5581), q(5582).
-q(5582) :- p(5581), q(5581).
-p(5583) :- p(5582), q(5583).
-q(5583) :- p(5582), q(5582).
-p(5584) :- p(5583), q(5584).
-q(5584) :- p(5583), q(5583).
-p(5585) :- p(5584), q(5585).
-q(5585) :- p(5584), q(5584).
-p(5586) :- p(5585), q(5586).
-q(5586) :- p(5585), q(5585).
-p(5587) :- p(5586), q(5587).
-q(5587) :- p(5586), q(5586).
-p(5588) :- p(5587), q(5588).
-q(5588) :- p(5587), q(5587).
-p(5589) :- p(5588), q(5589).
-q(5589) :- p(5588), q(5588).
-p(5590) :- p(5589), q(5590).
-q(5590) :- p(5589), q(5589).
-p(5591) :- p(5590), q(5591).
-q(5591) :- p(5590), q(5590).
-p(5592) :- p(5591), q(5592).
-q(5592) :- p(5591), q(5591).
-p(5593) :- p(5592), q(5593).
-q(5593) :- p(5592), q(5592).
-p(5594) :- p(5593), q(5594).
-q(5594) :- p(5593), q(5593).
-p(5595) :- p(5594), q(5595).
-q(5595) :- p(5594), q(5594).
-p(5596) :- p(5595), q(5596).
-q(5596) :- p(5595), q(5595).
-p(5597) :- p(5596), q(5597).
-q(5597) :- p(5596), q(5596).
-p(5598) :- p(5597), q(5598).
-q(5598) :- p(5597), q(5597).
-p(5599) :- p(5598), q(5599).
-q(5599) :- p(5598), q(5598).
-p(5600) :- p(5599), q(5600).
-q(5600) :- p(5599), q(5599).
-p(5601) :- p(5600), q(5601).
-q(5601) :- p(5600), q(5600).
-p(5602) :- p(5601), q(5602).
-q(5602) :- p(5601), q(5601).
-p(5603) :- p(5602), q(5603).
-q(5603) :- p(5602), q(5602).
-p(5604) :- p(5603), q(5604).
-q(5604) :- p(5603), q(5603).
-p(5605) :- p(5604), q(5605).
-q(5605) :- p(5604), q(5604).
-p(5606) :- p(5605), q(5606).
-q(5606) :- p(5605), q(5605).
-p(5607) :- p(5606), q(5607).
-q(5607) :- p(5606), q(5606).
-p(5608) :- p(5607), q(5608).
-q(5608) :- p(5607), q(5607).
-p(5609) :- p(5608), q(5609).
-q(5609) :- p(5608), q(5608).
-p(5610) :- p(5609), q(5610).
-q(5610) :- p(5609), q(5609).
-p(5611) :- p(5610), q(5611).
-q(5611) :- p(5610), q(5610).
-p(5612) :- p(5611), q(5612).
-q(5612) :- p(5611), q(5611).
-p(5613) :- p(5612), q(5613).
-q(5613) :- p(5612), q(5612).
-p(5614) :- p(5613), q(5614).
-q(5614) :- p(5613), q(5613).
-p(5615) :- p(5614), q(5615).
-q(5615) :- p(5614), q(5614).
-p(5616) :- p(5615), q(5616).
-q(5616) :- p(5615), q(5615).
-p(5617) :- p(5616), q(5617).
-q(5617) :- p(5616), q(5616).
-p(5618) :- p(5617), q(5618).
-q(5618) :- p(5617), q(5617).
-p(5619) :- p(5618), q(5619).
-q(5619) :- p(5618), q(5618).
-p(5620) :- p(5619), q(5620).
-q(5620) :- p(5619), q(5619).
-p(5621) :- p(5620), q(5621).
-q(5621) :- p(5620), q(5620).
-p(5622) :- p(5621), q(5622).
-q(5622) :- p(5621), q(5621).
-p(5623) :- p(5622), q(5623).
-q(5623) :- p(5622), q(5622).
-p(5624) :- p(5623), q(5624).
-q(5624) :- p(5623), q(5623).
-p(5625) :- p(5624), q(5625).
-q(5625) :- p(5624), q(5624).
-p(5626) :- p(5625), q(5626).
-q(5626) :- p(5625), q(5625).
-p(5627) :- p(5626), q(5627).
-q(5627) :- p(5626), q(5626).
-p(5628) :- p(5627), q(5628).
-q(5628) :- p(5627), q(5627).
-p(5629) :- p(5628), q(5629).
-q(5629) :- p(5628), q(5628).
-p(5630) :- p(5629), q(5630).
-q(5630) :- p(5629), q(5629).
-p(5631) :- p(5630), q(5631).
-q(5631) :- p(5630), q(5630).
-p(5632) :- p(5631), q(5632).
-q(5632) :- p(5631), q(5631).
-p(5633) :- p(5632), q(5633).
-q(5633) :- p(5632), q(5632).
-p(5634) :- p(5633), q(5634).
-q(5634) :- p(5633), q(5633).
-p(5635) :- p(5634), q(5635).
-q(5635) :- p(5634), q(5634).
-p(5636) :- p(5635), q(5636).
-q(5636) :- p(5635), q(5635).
-p(5637) :- p(5636), q(5637).
-q(5637) :- p(5636), q(5636).
-p(5638) :- p(5637), q(5638).
-q(5638) :- p(5637), q(5637).
-p(5639) :- p(5638), q(5639).
-q(5639) :- p(5638), q(5638).
-p(5640) :- p(5639), q(5640).
-q(5640) :- p(5639), q(5639).
-p(5641) :- p(5640), q(5641).
-q(5641) :- p(5640), q(5640).
-p(5642) :- p(5641), q(5642).
-q(5642) :- p(5641), q(5641).
-p(5643) :- p(5642), q(5643).
-q(5643) :- p(5642), q(5642).
-p(5644) :- p(5643), q(5644).
-q(5644) :- p(5643), q(5643).
-p(5645) :- p(5644), q(5645).
-q(5645) :- p(5644), q(5644).
-p(5646) :- p(5645), q(5646).
-q(5646) :- p(5645), q(5645).
-p(5647) :- p(5646), q(5647).
-q(5647) :- p(5646), q(5646).
-p(5648) :- p(5647), q(5648).
-q(5648) :- p(5647), q(5647).
-p(5649) :- p(5648), q(5649).
-q(5649) :- p(5648), q(5648).
-p(5650) :- p(5649), q(5650).
-q(5650) :- p(5649), q(5649).
-p(5651) :- p(5650), q(5651).
-q(5651) :- p(5650), q(5650).
-p(5652) :- p(5651), q(5652).
-q(5652) :- p(5651), q(5651).
-p(5653) :- p(5652), q(5653).
-q(5653) :- p(5652), q(5652).
-p(5654) :- p(5653), q(5654).
-q(5654) :- p(5653), q(5653).
-p(5655) :- p(5654), q(5655).
-q(5655) :- p(5654), q(5654).
-p(5656) :- p(5655), q(5656).
-q(5656) :- p(5655), q(5655).
-p(5657) :- p(5656), q(5657).
-q(5657) :- p(5656), q(5656).
-p(5658) :- p(5657), q(5658).
-q(5658) :- p(5657), q(5657).
-p(5659) :- p(5658), q(5659).
-q(5659) :- p(5658), q(5658).
-p(5660) :- p(5659), q(5660).
-q(5660) :- p(5659), q(5659).
-p(5661) :- p(5660), q(5661).
-q(5661) :- p(5660), q(5660).
-p(5662) :- p(5661), q(5662).
-q(5662) :- p(5661), q(5661).
-p(5663) :- p(5662), q(5663).
-q(5663) :- p(5662), q(5662).
-p(5664) :- p(5663), q(5664).
-q(5664) :- p(5663), q(5663).
-p(5665) :- p(5664), q(5665).
-q(5665) :- p(5664), q(5664).
-p(5666) :- p(5665), q(5666).
-q(5666) :- p(5665), q(5665).
-p(5667) :- p(5666), q(5667).
-q(5667) :- p(5666), q(5666).
-p(5668) :- p(5667), q(5668).
-q(5668) :- p(5667), q(5667).
-p(5669) :- p(5668), q(5669).
-q(5669) :- p(5668), q(5668).
-p(5670) :- p(5669), q(5670).
-q(5670) :- p(5669), q(5669).
-p(5671) :- p(5670), q(5671).
-q(5671) :- p(5670), q(5670).
-p(5672) :- p(5671), q(5672).
-q(5672) :- p(5671), q(5671).
-p(5673) :- p(5672), q(5673).
-q(5673) :- p(5672), q(5672).
-p(5674) :- p(5673), q(5674).
-q(5674) :- p(5673), q(5673).
-p(5675) :- p(5674), q(5675).
-q(5675) :- p(5674), q(5674).
-p(5676) :- p(5675), q(5676).
-q(5676) :- p(5675), q(5675).
-p(5677) :- p(5676), q(5677).
-q(5677) :- p(5676), q(5676).
-p(5678) :- p(5677), q(5678).
-q(5678) :- p(5677), q(5677).
-p(5679) :- p(5678), q(5679).
-q(5679) :- p(5678), q(5678).
-p(5680) :- p(5679), q(5680).
-q(5680) :- p(5679), q(5679).
-p(5681) :- p(5680), q(5681).
-q(5681) :- p(5680), q(5680).
-p(5682) :- p(5681), q(5682).
-q(5682) :- p(5681), q(5681).
-p(5683) :- p(5682), q(5683).
-q(5683) :- p(5682), q(5682).
-p(5684) :- p(5683), q(5684).
-q(5684) :- p(5683), q(5683).
-p(5685) :- p(5684), q(5685).
-q(5685) :- p(5684), q(5684).
-p(5686) :- p(5685), q(5686).
-q(5686) :- p(5685), q(5685).
-p(5687) :- p(5686), q(5687).
-q(5687) :- p(5686), q(5686).
-p(5688) :- p(5687), q(5688).
-q(5688) :- p(5687), q(5687).
-p(5689) :- p(5688), q(5689).
-q(5689) :- p(5688), q(5688).
-p(5690) :- p(5689), q(5690).
-q(5690) :- p(5689), q(5689).
-p(5691) :- p(5690), q(5691).
-q(5691) :- p(5690), q(5690).
-p(5692) :- p(5691), q(5692).
-q(5692) :- p(5691), q(5691).
-p(5693) :- p(5692), q(5693).
-q(5693) :- p(5692), q(5692).
-p(5694) :- p(5693), q(5694).
-q(5694) :- p(5693), q(5693).
-p(5695) :- p(5694), q(5695).
-q(5695) :- p(5694), q(5694).
-p(5696) :- p(5695), q(5696).
-q(5696) :- p(5695), q(5695).
-p(5697) :- p(5696), q(5697).
-q(5697) :- p(5696), q(5696).
-p(5698) :- p(5697), q(5698).
-q(5698) :- p(5697), q(5697).
-p(5699) :- p(5698), q(5699).
-q(5699) :- p(5698), q(5698).
-p(5700) :- p(5699), q(5700).
-q(5700) :- p(5699), q(5699).
-p(5701) :- p(5700), q(5701).
-q(5701) :- p(5700), q(5700).
-p(5702) :- p(5701), q(5702).
-q(5702) :- p(5701), q(5701).
-p(5703) :- p(5702), q(5703).
-q(5703) :- p(5702), q(5702).
-p(5704) :- p(5703), q(5704).
-q(5704) :- p(5703), q(5703).
-p(5705) :- p(5704), q(5705).
-q(5705) :- p(5704), q(5704).
-p(5706) :- p(5705), q(5706).
-q(5706) :- p(5705), q(5705).
-p(5707) :- p(5706), q(5707).
-q(5707) :- p(5706), q(5706).
-p(5708) :- p(5707), q(5708).
-q(5708) :- p(5707), q(5707).
-p(5709) :- p(5708), q(5709).
-q(5709) :- p(5708), q(5708).
-p(5710) :- p(5709), q(5710).
-q(5710) :- p(5709), q(5709).
-p(5711) :- p(5710), q(5711).
-q(5711) :- p(5710), q(5710).
-p(5712) :- p(5711), q(5712).
-q(5712) :- p(5711), q(5711).
-p(5713) :- p(5712), q(5713).
-q(5713) :- p(5712), q(5712).
-p(5714) :- p(5713), q(5714).
-q(5714) :- p(5713), q(5713).
-p(5715) :- p(5714), q(5715).
-q(5715) :- p(5714), q(5714).
-p(5716) :- p(5715), q(5716).
-q(5716) :- p(5715), q(5715).
-p(5717) :- p(5716), q(5717).
-q(5717) :- p(5716), q(5716).
-p(5718) :- p(5717), q(5718).
-q(5718) :- p(5717), q(5717).
-p(5719) :- p(5718), q(5719).
-q(5719) :- p(5718), q(5718).
-p(5720) :- p(5719), q(5720).
-q(5720) :- p(5719), q(5719).
-p(5721) :- p(5720), q(5721).
-q(5721) :- p(5720), q(5720).
-p(5722) :- p(5721), q(5722).
-q(5722) :- p(5721), q(5721).
-p(5723) :- p(5722), q(5723).
-q(5723) :- p(5722), q(5722).
-p(5724) :- p(5723), q(5724).
-q(5724) :- p(5723), q(5723).
-p(5725) :- p(5724), q(5725).
-q(5725) :- p(5724), q(5724).
-p(5726) :- p(5725), q(5726).
-q(5726) :- p(5725), q(5725).
-p(5727) :- p(5726), q(5727).
-q(5727) :- p(5726), q(5726).
-p(5728) :- p(5727), q(5728).
-q(5728) :- p(5727), q(5727).
-p(5729) :- p(5728), q(5729).
-q(5729) :- p(5728), q(5728).
-p(5730) :- p(5729), q(5730).
-q(5730) :- p(5729), q(5729).
-p(5731) :- p(5730), q(5731).
-q(5731) :- p(5730), q(5730).
-p(5732) :- p(5731), q(5732).
-q(5732) :- p(5731), q(5731).
-p(5733) :- p(5732), q(5733).
-q(5733) :- p(5732), q(5732).
-p(5734) :- p(5733), q(5734).
-q(5734) :- p(5733), q(5733).
-p(5735) :- p(5734), q(5735).
-q(5735) :- p(5734), q(5734).
-p(5736) :- p(5735), q(5736).
-q(5736) :- p(5735), q(5735).
-p(5737) :- p(5736), q(5737).
-q(5737) :- p(5736), q(5736).
-p(5738) :- p(5737), q(5738).
-q(5738) :- p(5737), q(5737).
-p(5739) :- p(5738), q(5739).
-q(5739) :- p(5738), q(5738).
-p(5740) :- p(5739), q(5740).
-q(5740) :- p(5739), q(5739).
-p(5741) :- p(5740), q(5741).
-q(5741) :- p(5740), q(5740).
-p(5742) :- p(5741), q(5742).
-q(5742) :- p(5741), q(5741).
-p(5743) :- p(5742), q(5743).
-q(5743) :- p(5742), q(5742).
-p(5744) :- p(5743), q(5744).
-q(5744) :- p(5743), q(5743).
-p(5745) :- p(5744), q(5745).
-q(5745) :- p(5744), q(5744).
-p(5746) :- p(5745), q(5746).
-q(5746) :- p(5745), q(5745).
-p(5747) :- p(5746), q(5747).
-q(5747) :- p(5746), q(5746).
-p(5748) :- p(5747), q(5748).
-q(5748) :- p(5747), q(5747).
-p(5749) :- p(5748), q(5749).
-q(5749) :- p(5748), q(5748).
-p(5750) :- p(5749), q(5750).
-q(5750) :- p(5749), q(5749).
-p(5751) :- p(5750), q(5751).
-q(5751) :- p(5750), q(5750).
-p(5752) :- p(5751), q(5752).
-q(5752) :- p(5751), q(5751).
-p(5753) :- p(5752), q(5753).
-q(5753) :- p(5752), q(5752).
-p(5754) :- p(5753), q(5754).
-q(5754) :- p(5753), q(5753).
-p(5755) :- p(5754), q(5755).
-q(5755) :- p(5754), q(5754).
-p(5756) :- p(5755), q(5756).
-q(5756) :- p(5755), q(5755).
-p(5757) :- p(5756), q(5757).
-q(5757) :- p(5756), q(5756).
-p(5758) :- p(5757), q(5758).
-q(5758) :- p(5757), q(5757).
-p(5759) :- p(5758), q(5759).
-q(5759) :- p(5758), q(5758).
-p(5760) :- p(5759), q(5760).
-q(5760) :- p(5759), q(5759).
-p(5761) :- p(5760), q(5761).
-q(5761) :- p(5760), q(5760).
-p(5762) :- p(5761), q(5762).
-q(5762) :- p(5761), q(5761).
-p(5763) :- p(5762), q(5763).
-q(5763) :- p(5762), q(5762).
-p(5764) :- p(5763), q(5764).
-q(5764) :- p(5763), q(5763).
-p(5765) :- p(5764), q(5765).
-q(5765) :- p(5764), q(5764).
-p(5766) :- p(5765), q(5766).
-q(5766) :- p(5765), q(5765).
-p(5767) :- p(5766), q(5767).
-q(5767) :- p(5766), q(5766).
-p(5768) :- p(5767), q(5768).
-q(5768) :- p(5767), q(5767).
-p(5769) :- p(5768), q(5769).
-q(5769) :- p(5768), q(5768).
-p(5770) :- p(5769), q(5770).
-q(5770) :- p(5769), q(5769).
-p(5771) :- p(5770), q(5771).
-q(5771) :- p(5770), q(5770).
-p(5772) :- p(5771), q(5772).
-q(5772) :- p(5771), q(5771).
-p(5773) :- p(5772), q(5773).
-q(5773) :- p(5772), q(5772).
-p(5774) :- p(5773), q(5774).
-q(5774) :- p(5773), q(5773).
-p(5775) :- p(5774), q(5775).
-q(5775) :- p(5774), q(5774).
-p(5776) :- p(5775), q(5776).
-q(5776) :- p(5775), q(5775).
-p(5777) :- p(5776), q(5777).
-q(5777) :- p(5776), q(5776).
-p(5778) :- p(5777), q(5778).
-q(5778) :- p(5777), q(5777).
-p(5779) :- p(5778), q(5779).
-q(5779) :- p(5778), q(5778).
-p(5780) :- p(5779), q(5780).
-q(5780) :- p(5779), q(5779).
-p(5781) :- p(5780), q(5781).
-q(5781) :- p(5780), q(5780).
-p(5782) :- p(5781), q(5782).
-q(5782) :- p(5781), q(5781).
-p(5783) :- p(5782), q(5783).
-q(5783) :- p(5782), q(5782).
-p(5784) :- p(5783), q(5784).
-q(5784) :- p(5783), q(5783).
-p(5785) :- p(5784), q(5785).
-q(5785) :- p(5784), q(5784).
-p(5786) :- p(5785), q(5786).
-q(5786) :- p(5785), q(5785).
-p(5787) :- p(5786), q(5787).
-q(5787) :- p(5786), q(5786).
-p(5788) :- p(5787), q(5788).
-q(5788) :- p(5787), q(5787).
-p(5789) :- p(5788), q(5789).
-q(5789) :- p(5788), q(5788).
-p(5790) :- p(5789), q(5790).
-q(5790) :- p(5789), q(5789).
-p(5791) :- p(5790), q(5791).
-q(5791) :- p(5790), q(5790).
-p(5792) :- p(5791), q(5792).
-q(5792) :- p(5791), q(5791).
-p(5793) :- p(5792), q(5793).
-q(5793) :- p(5792), q(5792).
-p(5794) :- p(5793), q(5794).
-q(5794) :- p(5793), q(5793).
-p(5795) :- p(5794), q(5795).
-q(5795) :- p(5794), q(5794).
-p(5796) :- p(5795), q(5796).
-q(5796) :- p(5795), q(5795).
-p(5797) :- p(5796), q(5797).
-q(5797) :- p(5796), q(5796).
-p(5798) :- p(5797), q(5798).
-q(5798) :- p(5797), q(5797).
-p(5799) :- p(5798), q(5799).
-q(5799) :- p(5798), q(5798).
-p(5800) :- p(5799), q(5800).
-q(5800) :- p(5799), q(5799).
-p(5801) :- p(5800), q(5801).
-q(5801) :- p(5800), q(5800).
-p(5802) :- p(5801), q(5802).
-q(5802) :- p(5801), q(5801).
-p(5803) :- p(5802), q(5803).
-q(5803) :- p(5802), q(5802).
-p(5804) :- p(5803), q(5804).
-q(5804) :- p(5803), q(5803).
-p(5805) :- p(5804), q(5805).
-q(5805) :- p(5804), q(5804).
-p(5806) :- p(5805), q(5806).
-q(5806) :- p(5805), q(5805).
-p(5807) :- p(5806), q(5807).
-q(5807) :- p(5806), q(5806).
-p(5808) :- p(5807), q(5808).
-q(5808) :- p(5807), q(5807).
-p(5809) :- p(5808), q(5809).
-q(5809) :- p(5808), q(5808).
-p(5810) :- p(5809), q(5810).
-q(5810) :- p(5809), q(5809).
-p(5811) :- p(5810), q(5811).
-q(5811) :- p(5810), q(5810).
-p(5812) :- p(5811), q(5812).
-q(5812) :- p(5811), q(5811).
-p(5813) :- p(5812), q(5813).
-q(5813) :- p(5812), q(5812).
-p(5814) :- p(5813), q(5814).
-q(5814) :- p(5813), q(5813).
-p(5815) :- p(5814), q(5815).
-q(5815) :- p(5814), q(5814).
-p(5816) :- p(5815), q(5816).
-q(5816) :- p(5815), q(5815).
-p(5817) :- p(5816), q(5817).
-q(5817) :- p(5816), q(5816).
-p(5818) :- p(5817), q(5818).
-q(5818) :- p(5817), q(5817).
-p(5819) :- p(5818), q(5819).
-q(5819) :- p(5818), q(5818).
-p(5820) :- p(5819), q(5820).
-q(5820) :- p(5819), q(5819).
-p(5821) :- p(5820), q(5821).
-q(5821) :- p(5820), q(5820).
-p(5822) :- p(5821), q(5822).
-q(5822) :- p(5821), q(5821).
-p(5823) :- p(5822), q(5823).
-q(5823) :- p(5822), q(5822).
-p(5824) :- p(5823), q(5824).
-q(5824) :- p(5823), q(5823).
-p(5825) :- p(5824), q(5825).
-q(5825) :- p(5824), q(5824).
-p(5826) :- p(5825), q(5826).
-q(5826) :- p(5825), q(5825).
-p(5827) :- p(5826), q(5827).
-q(5827) :- p(5826), q(5826).
-p(5828) :- p(5827), q(5828).
-q(5828) :- p(5827), q(5827).
-p(5829) :- p(5828), q(5829).
-q(5829) :- p(5828), q(5828).
-p(5830) :- p(5829), q(5830).
-q(5830) :- p(5829), q(5829).
-p(5831) :- p(5830), q(5831).
-q(5831) :- p(5830), q(5830).
-p(5832) :- p(5831), q(5832).
-q(5832) :- p(5831), q(5831).
-p(5833) :- p(5832), q(5833).
-q(5833) :- p(5832), q(5832).
-p(5834) :- p(5833), q(5834).
-q(5834) :- p(5833), q(5833).
-p(5835) :- p(5834), q(5835).
-q(5835) :- p(5834), q(5834).
-p(5836) :- p(5835), q(5836).
-q(5836) :- p(5835), q(5835).
-p(5837) :- p(5836), q(5837).
-q(5837) :- p(5836), q(5836).
-p(5838) :- p(5837), q(5838).
-q(5838) :- p(5837), q(5837).
-p(5839) :- p(5838), q(5839).
-q(5839) :- p(5838), q(5838).
-p(5840) :- p(5839), q(5840).
-q(5840) :- p(5839), q(5839).
-p(5841) :- p(5840), q(5841).
-q(5841) :- p(5840), q(5840).
-p(5842) :- p(5841), q(5842).
-q(5842) :- p(5841), q(5841).
-p(5843) :- p(5842), q(5843).
-q(5843) :- p(5842), q(5842).
-p(5844) :- p(5843), q(5844).
-q(5844) :- p(5843), q(5843).
-p(5845) :- p(5844), q(5845).
-q(5845) :- p(5844), q(5844).
-p(5846) :- p(5845), q(5846).
-q(5846) :- p(5845), q(5845).
-p(5847) :- p(5846), q(5847).
-q(5847) :- p(5846), q(5846).
-p(5848) :- p(5847), q(5848).
-q(5848) :- p(5847), q(5847).
-p(5849) :- p(5848), q(5849).
-q(5849) :- p(5848), q(5848).
-p(5850) :- p(5849), q(5850).
-q(5850) :- p(5849), q(5849).
-p(5851) :- p(5850), q(5851).
-q(5851) :- p(5850), q(5850).
-p(5852) :- p(5851), q(5852).
-q(5852) :- p(5851), q(5851).
-p(5853) :- p(5852), q(5853).
-q(5853) :- p(5852), q(5852).
-p(5854) :- p(5853), q(5854).
-q(5854) :- p(5853), q(5853).
-p(5855) :- p(5854), q(5855).
-q(5855) :- p(5854), q(5854).
-p(5856) :- p(5855), q(5856).
-q(5856) :- p(5855), q(5855).
-p(5857) :- p(5856), q(5857).
-q(5857) :- p(5856), q(5856).
-p(5858) :- p(5857), q(5858).
-q(5858) :- p(5857), q(5857).
-p(5859) :- p(5858), q(5859).
-q(5859) :- p(5858), q(5858).
-p(5860) :- p(5859), q(5860).
-q(5860) :- p(5859), q(5859).
-p(5861) :- p(5860), q(5861).
-q(5861) :- p(5860), q(5860).
-p(5862) :- p(5861), q(5862).
-q(5862) :- p(5861), q(5861).
-p(5863) :- p(5862), q(5863).
-q(5863) :- p(5862), q(5862).
-p(5864) :- p(5863), q(5864).
-q(5864) :- p(5863), q(5863).
-p(5865) :- p(5864), q(5865).
-q(5865) :- p(5864), q(5864).
-p(5866) :- p(5865), q(5866).
-q(5866) :- p(5865), q(5865).
-p(5867) :- p(5866), q(5867).
-q(5867) :- p(5866), q(5866).
-p(5868) :- p(5867), q(5868).
-q(5868) :- p(5867), q(5867).
-p(5869) :- p(5868), q(5869).
-q(5869) :- p(5868), q(5868).
-p(5870) :- p(5869), q(5870).
-q(5870) :- p(5869), q(5869).
-p(5871) :- p(5870), q(5871).
-q(5871) :- p(5870), q(5870).
-p(5872) :- p(5871), q(5872).
-q(5872) :- p(5871), q(5871).
-p(5873) :- p(5872), q(5873).
-q(5873) :- p(5872), q(5872).
-p(5874) :- p(5873), q(5874).
-q(5874) :- p(5873), q(5873).
-p(5875) :- p(5874), q(5875).
-q(5875) :- p(5874), q(5874).
-p(5876) :- p(5875), q(5876).
-q(5876) :- p(5875), q(5875).
-p(5877) :- p(5876), q(5877).
-q(5877) :- p(5876), q(5876).
-p(5878) :- p(5877), q(5878).
-q(5878) :- p(5877), q(5877).
-p(5879) :- p(5878), q(5879).
-q(5879) :- p(5878), q(5878).
-p(5880) :- p(5879), q(5880).
-q(5880) :- p(5879), q(5879).
-p(5881) :- p(5880), q(5881).
-q(5881) :- p(5880), q(5880).
-p(5882) :- p(5881), q(5882).
-q(5882) :- p(5881), q(5881).
-p(5883) :- p(5882), q(5883).
-q(5883) :- p(5882), q(5882).
-p(5884) :- p(5883), q(5884).
-q(5884) :- p(5883), q(5883).
-p(5885) :- p(5884), q(5885).
-q(5885) :- p(5884), q(5884).
-p(5886) :- p(5885), q(5886).
-q(5886) :- p(5885), q(5885).
-p(5887) :- p(5886), q(5887).
-q(5887) :- p(5886), q(5886).
-p(5888) :- p(5887), q(5888).
-q(5888) :- p(5887), q(5887).
-p(5889) :- p(5888), q(5889).
-q(5889) :- p(5888), q(5888).
-p(5890) :- p(5889), q(5890).
-q(5890) :- p(5889), q(5889).
-p(5891) :- p(5890), q(5891).
-q(5891) :- p(5890), q(5890).
-p(5892) :- p(5891), q(5892).
-q(5892) :- p(5891), q(5891).
-p(5893) :- p(5892), q(5893).
-q(5893) :- p(5892), q(5892).
-p(5894) :- p(5893), q(5894).
-q(5894) :- p(5893), q(5893).
-p(5895) :- p(5894), q(5895).
-q(5895) :- p(5894), q(5894).
-p(5896) :- p(5895), q(5896).
-q(5896) :- p(5895), q(5895).
-p(5897) :- p(5896), q(5897).
-q(5897) :- p(5896), q(5896).
-p(5898) :- p(5897), q(5898).
-q(5898) :- p(5897), q(5897).
-p(5899) :- p(5898), q(5899).
-q(5899) :- p(5898), q(5898).
-p(5900) :- p(5899), q(5900).
-q(5900) :- p(5899), q(5899).
-p(5901) :- p(5900), q(5901).
-q(5901) :- p(5900), q(5900).
-p(5902) :- p(5901), q(5902).
-q(5902) :- p(5901), q(5901).
-p(5903) :- p(5902), q(5903).
-q(5903) :- p(5902), q(5902).
-p(5904) :- p(5903), q(5904).
-q(5904) :- p(5903), q(5903).
-p(5905) :- p(5904), q(5905).
-q(5905) :- p(5904), q(5904).
-p(5906) :- p(5905), q(5906).
-q(5906) :- p(5905), q(5905).
-p(5907) :- p(5906), q(5907).
-q(5907) :- p(5906), q(5906).
-p(5908) :- p(5907), q(5908).
-q(5908) :- p(5907), q(5907).
-p(5909) :- p(5908), q(5909).
-q(5909) :- p(5908), q(5908).
-p(5910) :- p(5909), q(5910).
-q(5910) :- p(5909), q(5909).
-p(5911) :- p(5910), q(5911).
-q(5911) :- p(5910), q(5910).
-p(5912) :- p(5911), q(5912).
-q(5912) :- p(5911), q(5911).
-p(5913) :- p(5912), q(5913).
-q(5913) :- p(5912), q(5912).
-p(5914) :- p(5913), q(5914).
-q(5914) :- p(5913), q(5913).
-p(5915) :- p(5914), q(5915).
-q(5915) :- p(5914), q(5914).
-p(5916) :- p(5915), q(5916).
-q(5916) :- p(5915), q(5915).
-p(5917) :- p(5916), q(5917).
-q(5917) :- p(5916), q(5916).
-p(5918) :- p(5917), q(5918).
-q(5918) :- p(5917), q(5917).
-p(5919) :- p(5918), q(5919).
-q(5919) :- p(5918), q(5918).
-p(5920) :- p(5919), q(5920).
-q(5920) :- p(5919), q(5919).
-p(5921) :- p(5920), q(5921).
-q(5921) :- p(5920), q(5920).
-p(5922) :- p(5921), q(5922).
-q(5922) :- p(5921), q(5921).
-p(5923) :- p(5922), q(5923).
-q(5923) :- p(5922), q(5922).
-p(5924) :- p(5923), q(5924).
-q(5924) :- p(5923), q(5923).
-p(5925) :- p(5924), q(5925).
-q(5925) :- p(5924), q(5924).
-p(5926) :- p(5925), q(5926).
-q(5926) :- p(5925), q(5925).
-p(5927) :- p(5926), q(5927).
-q(5927) :- p(5926), q(5926).
-p(5928) :- p(5927), q(5928).
-q(5928) :- p(5927), q(5927).
-p(5929) :- p(5928), q(5929).
-q(5929) :- p(5928), q(5928).
-p(5930) :- p(5929), q(5930).
-q(5930) :- p(5929), q(5929).
-p(5931) :- p(5930), q(5931).
-q(5931) :- p(5930), q(5930).
-p(5932) :- p(5931), q(5932).
-q(5932) :- p(5931), q(5931).
-p(5933) :- p(5932), q(5933).
-q(5933) :- p(5932), q(5932).
-p(5934) :- p(5933), q(5934).
-q(5934) :- p(5933), q(5933).
-p(5935) :- p(5934), q(5935).
-q(5935) :- p(5934), q(5934).
-p(5936) :- p(5935), q(5936).
-q(5936) :- p(5935), q(5935).
-p(5937) :- p(5936), q(5937).
-q(5937) :- p(5936), q(5936).
-p(5938) :- p(5937), q(5938).
-q(5938) :- p(5937), q(5937).
-p(5939) :- p(5938), q(5939).
-q(5939) :- p(5938), q(5938).
-p(5940) :- p(5939), q(5940).
-q(5940) :- p(5939), q(5939).
-p(5941) :- p(5940), q(5941).
-q(5941) :- p(5940), q(5940).
-p(5942) :- p(5941), q(5942).
-q(5942) :- p(5941), q(5941).
-p(5943) :- p(5942), q(5943).
-q(5943) :- p(5942), q(5942).
-p(5944) :- p(5943), q(5944).
-q(5944) :- p(5943), q(5943).
-p(5945) :- p(5944), q(5945).
-q(5945) :- p(5944), q(5944).
-p(5946) :- p(5945), q(5946).
-q(5946) :- p(5945), q(5945).
-p(5947) :- p(5946), q(5947).
-q(5947) :- p(5946), q(5946).
-p(5948) :- p(5947), q(5948).
-q(5948) :- p(5947), q(5947).
-p(5949) :- p(5948), q(5949).
-q(5949) :- p(5948), q(5948).
-p(5950) :- p(5949), q(5950).
-q(5950) :- p(5949), q(5949).
-p(5951) :- p(5950), q(5951).
-q(5951) :- p(5950), q(5950).
-p(5952) :- p(5951), q(5952).
-q(5952) :- p(5951), q(5951).
-p(5953) :- p(5952), q(5953).
-q(5953) :- p(5952), q(5952).
-p(5954) :- p(5953), q(5954).
-q(5954) :- p(5953), q(5953).
-p(5955) :- p(5954), q(5955).
-q(5955) :- p(5954), q(5954).
-p(5956) :- p(5955), q(5956).
-q(5956) :- p(5955), q(5955).
-p(5957) :- p(5956), q(5957).
-q(5957) :- p(5956), q(5956).
-p(5958) :- p(5957), q(5958).
-q(5958) :- p(5957), q(5957).
-p(5959) :- p(5958), q(5959).
-q(5959) :- p(5958), q(5958).
-p(5960) :- p(5959), q(5960).
-q(5960) :- p(5959), q(5959).
-p(5961) :- p(5960), q(5961).
-q(5961) :- p(5960), q(5960).
-p(5962) :- p(5961), q(5962).
-q(5962) :- p(5961), q(5961).
-p(5963) :- p(5962), q(5963).
-q(5963) :- p(5962), q(5962).
-p(5964) :- p(5963), q(5964).
-q(5964) :- p(5963), q(5963).
-p(5965) :- p(5964), q(5965).
-q(5965) :- p(5964), q(5964).
-p(5966) :- p(5965), q(5966).
-q(5966) :- p(5965), q(5965).
-p(5967) :- p(5966), q(5967).
-q(5967) :- p(5966), q(5966).
-p(5968) :- p(5967), q(5968).
-q(5968) :- p(5967), q(5967).
-p(5969) :- p(5968), q(5969).
-q(5969) :- p(5968), q(5968).
-p(5970) :- p(5969), q(5970).
-q(5970) :- p(5969), q(5969).
-p(5971) :- p(5970), q(5971).
-q(5971) :- p(5970), q(5970).
-p(5972) :- p(5971), q(5972).
-q(5972) :- p(5971), q(5971).
-p(5973) :- p(5972), q(5973).
-q(5973) :- p(5972), q(5972).
-p(5974) :- p(5973), q(5974).
-q(5974) :- p(5973), q(5973).
-p(5975) :- p(5974), q(5975).
-q(5975) :- p(5974), q(5974).
-p(5976) :- p(5975), q(5976).
-q(5976) :- p(5975), q(5975).
-p(5977) :- p(5976), q(5977).
-q(5977) :- p(5976), q(5976).
-p(5978) :- p(5977), q(5978).
-q(5978) :- p(5977), q(5977).
-p(5979) :- p(5978), q(5979).
-q(5979) :- p(5978), q(5978).
-p(5980) :- p(5979), q(5980).
-q(5980) :- p(5979), q(5979).
-p(5981) :- p(5980), q(5981).
-q(5981) :- p(5980), q(5980).
-p(5982) :- p(5981), q(5982).
-q(5982) :- p(5981), q(5981).
-p(5983) :- p(5982), q(5983).
-q(5983) :- p(5982), q(5982).
-p(5984) :- p(5983), q(5984).
-q(5984) :- p(5983), q(5983).
-p(5985) :- p(5984), q(5985).
-q(5985) :- p(5984), q(5984).
-p(5986) :- p(5985), q(5986).
-q(5986) :- p(5985), q(5985).
-p(5987) :- p(5986), q(5987).
-q(5987) :- p(5986), q(5986).
-p(5988) :- p(5987), q(5988).
-q(5988) :- p(5987), q(5987).
-p(5989) :- p(5988), q(5989).
-q(5989) :- p(5988), q(5988).
-p(5990) :- p(5989), q(5990).
-q(5990) :- p(5989), q(5989).
-p(5991) :- p(5990), q(5991).
-q(5991) :- p(5990), q(5990).
-p(5992) :- p(5991), q(5992).
-q(5992) :- p(5991), q(5991).
-p(5993) :- p(5992), q(5993).
-q(5993) :- p(5992), q(5992).
-p(5994) :- p(5993), q(5994).
-q(5994) :- p(5993), q(5993).
-p(5995) :- p(5994), q(5995).
-q(5995) :- p(5994), q(5994).
-p(5996) :- p(5995), q(5996).
-q(5996) :- p(5995), q(5995).
-p(5997) :- p(5996), q(5997).
-q(5997) :- p(5996), q(5996).
-p(5998) :- p(5997), q(5998).
-q(5998) :- p(5997), q(5997).
-p(5999) :- p(5998), q(5999).
-q(5999) :- p(5998), q(5998).
-p(6000) :- p(5999), q(6000).
-q(6000) :- p(5999), q(5999).
-p(6001) :- p(6000), q(6001).
-q(6001) :- p(6000), q(6000).
-p(6002) :- p(6001), q(6002).
-q(6002) :- p(6001), q(6001).
-p(6003) :- p(6002), q(6003).
-q(6003) :- p(6002), q(6002).
-p(6004) :- p(6003), q(6004).
-q(6004) :- p(6003), q(6003).
-p(6005) :- p(6004), q(6005).
-q(6005) :- p(6004), q(6004).
-p(6006) :- p(6005), q(6006).
-q(6006) :- p(6005), q(6005).
-p(6007) :- p(6006), q(6007).
-q(6007) :- p(6006), q(6006).
-p(6008) :- p(6007), q(6008).
-q(6008) :- p(6007), q(6007).
-p(6009) :- p(6008), q(6009).
-q(6009) :- p(6008), q(6008).
-p(6010) :- p(6009), q(6010).
-q(6010) :- p(6009), q(6009).
-p(6011) :- p(6010), q(6011).
-q(6011) :- p(6010), q(6010).
-p(6012) :- p(6011), q(6012).
-q(6012) :- p(6011), q(6011).
-p(6013) :- p(6012), q(6013).
-q(6013) :- p(6012), q(6012).
-p(6014) :- p(6013), q(6014).
-q(6014) :- p(6013), q(6013).
-p(6015) :- p(6014), q(6015).
-q(6015) :- p(6014), q(6014).
-p(6016) :- p(6015), q(6016).
-q(6016) :- p(6015), q(6015).
-p(6017) :- p(6016), q(6017).
-q(6017) :- p(6016), q(6016).
-p(6018) :- p(6017), q(6018).
-q(6018) :- p(6017), q(6017).
-p(6019) :- p(6018), q(6019).
-q(6019) :- p(6018), q(6018).
-p(6020) :- p(6019), q(6020).
-q(6020) :- p(6019), q(6019).
-p(6021) :- p(6020), q(6021).
-q(6021) :- p(6020), q(6020).
-p(6022) :- p(6021), q(6022).
-q(6022) :- p(6021), q(6021).
-p(6023) :- p(6022), q(6023).
-q(6023) :- p(6022), q(6022).
-p(6024) :- p(6023), q(6024).
-q(6024) :- p(6023), q(6023).
-p(6025) :- p(6024), q(6025).
-q(6025) :- p(6024), q(6024).
-p(6026) :- p(6025), q(6026).
-q(6026) :- p(6025), q(6025).
-p(6027) :- p(6026), q(6027).
-q(6027) :- p(6026), q(6026).
-p(6028) :- p(6027), q(6028).
-q(6028) :- p(6027), q(6027).
-p(6029) :- p(6028), q(6029).
-q(6029) :- p(6028), q(6028).
-p(6030) :- p(6029), q(6030).
-q(6030) :- p(6029), q(6029).
-p(6031) :- p(6030), q(6031).
-q(6031) :- p(6030), q(6030).
-p(6032) :- p(6031), q(6032).
-q(6032) :- p(6031), q(6031).
-p(6033) :- p(6032), q(6033).
-q(6033) :- p(6032), q(6032).
-p(6034) :- p(6033), q(6034).
-q(6034) :- p(6033), q(6033).
-p(6035) :- p(6034), q(6035).
-q(6035) :- p(6034), q(6034).
-p(6036) :- p(6035), q(6036).
-q(6036) :- p(6035), q(6035).
-p(6037) :- p(6036), q(6037).
-q(6037) :- p(6036), q(6036).
-p(6038) :- p(6037), q(6038).
-q(6038) :- p(6037), q(6037).
-p(6039) :- p(6038), q(6039).
-q(6039) :- p(6038), q(6038).
-p(6040) :- p(6039), q(6040).
-q(6040) :- p(6039), q(6039).
-p(6041) :- p(6040), q(6041).
-q(6041) :- p(6040), q(6040).
-p(6042) :- p(6041), q(6042).
-q(6042) :- p(6041), q(6041).
-p(6043) :- p(6042), q(6043).
-q(6043) :- p(6042), q(6042).
-p(6044) :- p(6043), q(6044).
-q(6044) :- p(6043), q(6043).
-p(6045) :- p(6044), q(6045).
-q(6045) :- p(6044), q(6044).
-p(6046) :- p(6045), q(6046).
-q(6046) :- p(6045), q(6045).
-p(6047) :- p(6046), q(6047).
-q(6047) :- p(6046), q(6046).
-p(6048) :- p(6047), q(6048).
-q(6048) :- p(6047), q(6047).
-p(6049) :- p(6048), q(6049).
-q(6049) :- p(6048), q(6048).
-p(6050) :- p(6049), q(6050).
-q(6050) :- p(6049), q(6049).
-p(6051) :- p(6050), q(6051).
-q(6051) :- p(6050), q(6050).
-p(6052) :- p(6051), q(6052).
-q(6052) :- p(6051), q(6051).
-p(6053) :- p(6052), q(6053).
-q(6053) :- p(6052), q(6052).
-p(6054) :- p(6053), q(6054).
-q(6054) :- p(6053), q(6053).
-p(6055) :- p(6054), q(6055).
-q(6055) :- p(6054), q(6054).
-p(6056) :- p(6055), q(6056).
-q(6056) :- p(6055), q(6055).
-p(6057) :- p(6056), q(6057).
-q(6057) :- p(6056), q(6056).
-p(6058) :- p(6057), q(6058).
-q(6058) :- p(6057), q(6057).
-p(6059) :- p(6058), q(6059).
-q(6059) :- p(6058), q(6058).
-p(6060) :- p(6059), q(6060).
-q(6060) :- p(6059), q(6059).
-p(6061) :- p(6060), q(6061).
-q(6061) :- p(6060), q(6060).
-p(6062) :- p(6061), q(6062).
-q(6062) :- p(6061), q(6061).
-p(6063) :- p(6062), q(6063).
-q(6063) :- p(6062), q(6062).
-p(6064) :- p(6063), q(6064).
-q(6064) :- p(6063), q(6063).
-p(6065) :- p(6064), q(6065).
-q(6065) :- p(6064), q(6064).
-p(6066) :- p(6065), q(6066).
-q(6066) :- p(6065), q(6065).
-p(6067) :- p(6066), q(6067).
-q(6067) :- p(6066), q(6066).
-p(6068) :- p(6067), q(6068).
-q(6068) :- p(6067), q(6067).
-p(6069) :- p(6068), q(6069).
-q(6069) :- p(6068), q(6068).
-p(6070) :- p(6069), q(6070).
-q(6070) :- p(6069), q(6069).
-p(6071) :- p(6070), q(6071).
-q(6071) :- p(6070), q(6070).
-p(6072) :- p(6071), q(6072).
-q(6072) :- p(6071), q(6071).
-p(6073) :- p(6072), q(6073).
-q(6073) :- p(6072), q(6072).
-p(6074) :- p(6073), q(6074).
-q(6074) :- p(6073), q(6073).
-p(6075) :- p(6074), q(6075).
-q(6075) :- p(6074), q(6074).
-p(6076) :- p(6075), q(6076).
-q(6076) :- p(6075), q(6075).
-p(6077) :- p(6076), q(6077).
-q(6077) :- p(6076), q(6076).
-p(6078) :- p(6077), q(6078).
-q(6078) :- p(6077), q(6077).
-p(6079) :- p(6078), q(6079).
-q(6079) :- p(6078), q(6078).
-p(6080) :- p(6079), q(6080).
-q(6080) :- p(6079), q(6079).
-p(6081) :- p(6080), q(6081).
-q(6081) :- p(6080), q(6080).
-p(6082) :- p(6081), q(6082).
-q(6082) :- p(6081), q(6081).
-p(6083) :- p(6082), q(6083).
-q(6083) :- p(6082), q(6082).
-p(6084) :- p(6083), q(6084).
-q(6084) :- p(6083), q(6083).
-p(6085) :- p(6084), q(6085).
-q(6085) :- p(6084), q(6084).
-p(6086) :- p(6085), q(6086).
-q(6086) :- p(6085), q(6085).
-p(6087) :- p(6086), q(6087).
-q(6087) :- p(6086), q(6086).
-p(6088) :- p(6087), q(6088).
-q(6088) :- p(6087), q(6087).
-p(6089) :- p(6088), q(6089).
-q(6089) :- p(6088), q(6088).
-p(6090) :- p(6089), q(6090).
-q(6090) :- p(6089), q(6089).
-p(6091) :- p(6090), q(6091).
-q(6091) :- p(6090), q(6090).
-p(6092) :- p(6091), q(6092).
-q(6092) :- p(6091), q(6091).
-p(6093) :- p(6092), q(6093).
-q(6093) :- p(6092), q(6092).
-p(6094) :- p(6093), q(6094).
-q(6094) :- p(6093), q(6093).
-p(6095) :- p(6094), q(6095).
-q(6095) :- p(6094), q(6094).
-p(6096) :- p(6095), q(6096).
-q(6096) :- p(6095), q(6095).
-p(6097) :- p(6096), q(6097).
-q(6097) :- p(6096), q(6096).
-p(6098) :- p(6097), q(6098).
-q(6098) :- p(6097), q(6097).
-p(6099) :- p(6098), q(6099).
-q(6099) :- p(6098), q(6098).
-p(6100) :- p(6099), q(6100).
-q(6100) :- p(6099), q(6099).
-p(6101) :- p(6100), q(6101).
-q(6101) :- p(6100), q(6100).
-p(6102) :- p(6101), q(6102).
-q(6102) :- p(6101), q(6101).
-p(6103) :- p(6102), q(6103).
-q(6103) :- p(6102), q(6102).
-p(6104) :- p(6103), q(6104).
-q(6104) :- p(6103), q(6103).
-p(6105) :- p(6104), q(6105).
-q(6105) :- p(6104), q(6104).
-p(6106) :- p(6105), q(6106).
-q(6106) :- p(6105), q(6105).
-p(6107) :- p(6106), q(6107).
-q(6107) :- p(6106), q(6106).
-p(6108) :- p(6107), q(6108).
-q(6108) :- p(6107), q(6107).
-p(6109) :- p(6108), q(6109).
-q(6109) :- p(6108), q(6108).
-p(6110) :- p(6109), q(6110).
-q(6110) :- p(6109), q(6109).
-p(6111) :- p(6110), q(6111).
-q(6111) :- p(6110), q(6110).
-p(6112) :- p(6111), q(6112).
-q(6112) :- p(6111), q(6111).
-p(6113) :- p(6112), q(6113).
-q(6113) :- p(6112), q(6112).
-p(6114) :- p(6113), q(6114).
-q(6114) :- p(6113), q(6113).
-p(6115) :- p(6114), q(6115).
-q(6115) :- p(6114), q(6114).
-p(6116) :- p(6115), q(6116).
-q(6116) :- p(6115), q(6115).
-p(6117) :- p(6116), q(6117).
-q(6117) :- p(6116), q(6116).
-p(6118) :- p(6117), q(6118).
-q(6118) :- p(6117), q(6117).
-p(6119) :- p(6118), q(6119).
-q(6119) :- p(6118), q(6118).
-p(6120) :- p(6119), q(6120).
-q(6120) :- p(6119), q(6119).
-p(6121) :- p(6120), q(6121).
-q(6121) :- p(6120), q(6120).
-p(6122) :- p(6121), q(6122).
-q(6122) :- p(6121), q(6121).
-p(6123) :- p(6122), q(6123).
-q(6123) :- p(6122), q(6122).
-p(6124) :- p(6123), q(6124).
-q(6124) :- p(6123), q(6123).
-p(6125) :- p(6124), q(6125).
-q(6125) :- p(6124), q(6124).
-p(6126) :- p(6125), q(6126).
-q(6126) :- p(6125), q(6125).
-p(6127) :- p(6126), q(6127).
-q(6127) :- p(6126), q(6126).
-p(6128) :- p(6127), q(6128).
-q(6128) :- p(6127), q(6127).
-p(6129) :- p(6128), q(6129).
-q(6129) :- p(6128), q(6128).
-p(6130) :- p(6129), q(6130).
-q(6130) :- p(6129), q(6129).
-p(6131) :- p(6130), q(6131).
-q(6131) :- p(6130), q(6130).
-p(6132) :- p(6131), q(6132).
-q(6132) :- p(6131), q(6131).
-p(6133) :- p(6132), q(6133).
-q(6133) :- p(6132), q(6132).
-p(6134) :- p(6133), q(6134).
-q(6134) :- p(6133), q(6133).
-p(6135) :- p(6134), q(6135).
-q(6135) :- p(6134), q(6134).
-p(6136) :- p(6135), q(6136).
-q(6136) :- p(6135), q(6135).
-p(6137) :- p(6136), q(6137).
-q(6137) :- p(6136), q(6136).
-p(6138) :- p(6137), q(6138).
-q(6138) :- p(6137), q(6137).
-p(6139) :- p(6138), q(6139).
-q(6139) :- p(6138), q(6138).
-p(6140) :- p(6139), q(6140).
-q(6140) :- p(6139), q(6139).
-p(6141) :- p(6140), q(6141).
-q(6141) :- p(6140), q(6140).
-p(6142) :- p(6141), q(6142).
-q(6142) :- p(6141), q(6141).
-p(6143) :- p(6142), q(6143).
-q(6143) :- p(6142), q(6142).
-p(6144) :- p(6143), q(6144).
-q(6144) :- p(6143), q(6143).
-p(6145) :- p(6144), q(6145).
-q(6145) :- p(6144), q(6144).
-p(6146) :- p(6145), q(6146).
-q(6146) :- p(6145), q(6145).
-p(6147) :- p(6146), q(6147).
-q(6147) :- p(6146), q(6146).
-p(6148) :- p(6147), q(6148).
-q(6148) :- p(6147), q(6147).
-p(6149) :- p(6148), q(6149).
-q(6149) :- p(6148), q(6148).
-p(6150) :- p(6149), q(6150).
-q(6150) :- p(6149), q(6149).
-p(6151) :- p(6150), q(6151).
-q(6151) :- p(6150), q(6150).
-p(6152) :- p(6151), q(6152).
-q(6152) :- p(6151), q(6151).
-p(6153) :- p(6152), q(6153).
-q(6153) :- p(6152), q(6152).
-p(6154) :- p(6153), q(6154).
-q(6154) :- p(6153), q(6153).
-p(6155) :- p(6154), q(6155).
-q(6155) :- p(6154), q(6154).
-p(6156) :- p(6155), q(6156).
-q(6156) :- p(6155), q(6155).
-p(6157) :- p(6156), q(6157).
-q(6157) :- p(6156), q(6156).
-p(6158) :- p(6157), q(6158).
-q(6158) :- p(6157), q(6157).
-p(6159) :- p(6158), q(6159).
-q(6159) :- p(6158), q(6158).
-p(6160) :- p(6159), q(6160).
-q(6160) :- p(6159), q(6159).
-p(6161) :- p(6160), q(6161).
-q(6161) :- p(6160), q(6160).
-p(6162) :- p(6161), q(6162).
-q(6162) :- p(6161), q(6161).
-p(6163) :- p(6162), q(6163).
-q(6163) :- p(6162), q(6162).
-p(6164) :- p(6163), q(6164).
-q(6164) :- p(6163), q(6163).
-p(6165) :- p(6164), q(6165).
-q(6165) :- p(6164), q(6164).
-p(6166) :- p(6165), q(6166).
-q(6166) :- p(6165), q(6165).
-p(6167) :- p(6166), q(6167).
-q(6167) :- p(6166), q(6166).
-p(6168) :- p(6167), q(6168).
-q(6168) :- p(6167), q(6167).
-p(6169) :- p(6168), q(6169).
-q(6169) :- p(6168), q(6168).
-p(6170) :- p(6169), q(6170).
-q(6170) :- p(6169), q(6169).
-p(6171) :- p(6170), q(6171).
-q(6171) :- p(6170), q(6170).
-p(6172) :- p(6171), q(6172).
-q(6172) :- p(6171), q(6171).
-p(6173) :- p(6172), q(6173).
-q(6173) :- p(6172), q(6172).
-p(6174) :- p(6173), q(6174).
-q(6174) :- p(6173), q(6173).
-p(6175) :- p(6174), q(6175).
-q(6175) :- p(6174), q(6174).
-p(6176) :- p(6175), q(6176).
-q(6176) :- p(6175), q(6175).
-p(6177) :- p(6176), q(6177).
-q(6177) :- p(6176), q(6176).
-p(6178) :- p(6177), q(6178).
-q(6178) :- p(6177), q(6177).
-p(6179) :- p(6178), q(6179).
-q(6179) :- p(6178), q(6178).
-p(6180) :- p(6179), q(6180).
-q(6180) :- p(6179), q(6179).
-p(6181) :- p(6180), q(6181).
-q(6181) :- p(6180), q(6180).
-p(6182) :- p(6181), q(6182).
-q(6182) :- p(6181), q(6181).
-p(6183) :- p(6182), q(6183).
-q(6183) :- p(6182), q(6182).
-p(6184) :- p(6183), q(6184).
-q(6184) :- p(6183), q(6183).
-p(6185) :- p(6184), q(6185).
-q(6185) :- p(6184), q(6184).
-p(6186) :- p(6185), q(6186).
-q(6186) :- p(6185), q(6185).
-p(6187) :- p(6186), q(6187).
-q(6187) :- p(6186), q(6186).
-p(6188) :- p(6187), q(6188).
-q(6188) :- p(6187), q(6187).
-p(6189) :- p(6188), q(6189).
-q(6189) :- p(6188), q(6188).
-p(6190) :- p(6189), q(6190).
-q(6190) :- p(6189), q(6189).
-p(6191) :- p(6190), q(6191).
-q(6191) :- p(6190), q(6190).
-p(6192) :- p(6191), q(6192).
-q(6192) :- p(6191), q(6191).
-p(6193) :- p(6192), q(6193).
-q(6193) :- p(6192), q(6192).
-p(6194) :- p(6193), q(6194).
-q(6194) :- p(6193), q(6193).
-p(6195) :- p(6194), q(6195).
-q(6195) :- p(6194), q(6194).
-p(6196) :- p(6195), q(6196).
-q(6196) :- p(6195), q(6195).
-p(6197) :- p(6196), q(6197).
-q(6197) :- p(6196), q(6196).
-p(6198) :- p(6197), q(6198).
-q(6198) :- p(6197), q(6197).
-p(6199) :- p(6198), q(6199).
-q(6199) :- p(6198), q(6198).
-p(6200) :- p(6199), q(6200).
-q(6200) :- p(6199), q(6199).
-p(6201) :- p(6200), q(6201).
-q(6201) :- p(6200), q(6200).
-p(6202) :- p(6201), q(6202).
-q(6202) :- p(6201), q(6201).
-p(6203) :- p(6202), q(6203).
-q(6203) :- p(6202), q(6202).
-p(6204) :- p(6203), q(6204).
-q(6204) :- p(6203), q(6203).
-p(6205) :- p(6204), q(6205).
-q(6205) :- p(6204), q(6204).
-p(6206) :- p(6205), q(6206).
-q(6206) :- p(6205), q(6205).
-p(6207) :- p(6206), q(6207).
-q(6207) :- p(6206), q(6206).
-p(6208) :- p(6207), q(6208).
-q(6208) :- p(6207), q(6207).
-p(6209) :- p(6208), q(6209).
-q(6209) :- p(6208), q(6208).
-p(6210) :- p(6209), q(6210).
-q(6210) :- p(6209), q(6209).
-p(6211) :- p(6210), q(6211).
-q(6211) :- p(6210), q(6210).
-p(6212) :- p(6211), q(6212).
-q(6212) :- p(6211), q(6211).
-p(6213) :- p(6212), q(6213).
-q(6213) :- p(6212), q(6212).
-p(6214) :- p(6213), q(6214).
-q(6214) :- p(6213), q(6213).
-p(6215) :- p(6214), q(6215).
-q(6215) :- p(6214), q(6214).
-p(6216) :- p(6215), q(6216).
-q(6216) :- p(6215), q(6215).
-p(6217) :- p(6216), q(6217).
-q(6217) :- p(6216), q(6216).
-p(6218) :- p(6217), q(6218).
-q(6218) :- p(6217), q(6217).
-p(6219) :- p(6218), q(6219).
-q(6219) :- p(6218), q(6218).
-p(6220) :- p(6219), q(6220).
-q(6220) :- p(6219), q(6219).
-p(6221) :- p(6220), q(6221).
-q(6221) :- p(6220), q(6220).
-p(6222) :- p(6221), q(6222).
-q(6222) :- p(6221), q(6221).
-p(6223) :- p(6222), q(6223).
-q(6223) :- p(6222), q(6222).
-p(6224) :- p(6223), q(6224).
-q(6224) :- p(6223), q(6223).
-p(6225) :- p(6224), q(6225).
-q(6225) :- p(6224), q(6224).
-p(6226) :- p(6225), q(6226).
-q(6226) :- p(6225), q(6225).
-p(6227) :- p(6226), q(6227).
-q(6227) :- p(6226), q(6226).
-p(6228) :- p(6227), q(6228).
-q(6228) :- p(6227), q(6227).
-p(6229) :- p(6228), q(6229).
-q(6229) :- p(6228), q(6228).
-p(6230) :- p(6229), q(6230).
-q(6230) :- p(6229), q(6229).
-p(6231) :- p(6230), q(6231).
-q(6231) :- p(6230), q(6230).
-p(6232) :- p(6231), q(6232).
-q(6232) :- p(6231), q(6231).
-p(6233) :- p(6232), q(6233).
-q(6233) :- p(6232), q(6232).
-p(6234) :- p(6233), q(6234).
-q(6234) :- p(6233), q(6233).
-p(6235) :- p(6234), q(6235).
-q(6235) :- p(6234), q(6234).
-p(6236) :- p(6235), q(6236).
-q(6236) :- p(6235), q(6235).
-p(6237) :- p(6236), q(6237).
-q(6237) :- p(6236), q(6236).
-p(6238) :- p(6237), q(6238).
-q(6238) :- p(6237), q(6237).
-p(6239) :- p(6238), q(6239).
-q(6239) :- p(6238), q(6238).
-p(6240) :- p(6239), q(6240).
-q(6240) :- p(6239), q(6239).
-p(6241) :- p(6240), q(6241).
-q(6241) :- p(6240), q(6240).
-p(6242) :- p(6241), q(6242).
-q(6242) :- p(6241), q(6241).
-p(6243) :- p(6242), q(6243).
-q(6243) :- p(6242), q(6242).
-p(6244) :- p(6243), q(6244).
-q(6244) :- p(6243), q(6243).
-p(6245) :- p(6244), q(6245).
-q(6245) :- p(6244), q(6244).
-p(6246) :- p(6245), q(6246).
-q(6246) :- p(6245), q(6245).
-p(6247) :- p(6246), q(6247).
-q(6247) :- p(6246), q(6246).
-p(6248) :- p(6247), q(6248).
-q(6248) :- p(6247), q(6247).
-p(6249) :- p(6248), q(6249).
-q(6249) :- p(6248), q(6248).
-p(6250) :- p(6249), q(6250).
-q(6250) :- p(6249), q(6249).
-p(6251) :- p(6250), q(6251).
-q(6251) :- p(6250), q(6250).
-p(6252) :- p(6251), q(6252).
-q(6252) :- p(6251), q(6251).
-p(6253) :- p(6252), q(6253).
-q(6253) :- p(6252), q(6252).
-p(6254) :- p(6253), q(6254).
-q(6254) :- p(6253), q(6253).
-p(6255) :- p(6254), q(6255).
-q(6255) :- p(6254), q(6254).
-p(6256) :- p(6255), q(6256).
-q(6256) :- p(6255), q(6255).
-p(6257) :- p(6256), q(6257).
-q(6257) :- p(6256), q(6256).
-p(6258) :- p(6257), q(6258).
-q(6258) :- p(6257), q(6257).
-p(6259) :- p(6258), q(6259).
-q(6259) :- p(6258), q(6258).
-p(6260) :- p(6259), q(6260).
-q(6260) :- p(6259), q(6259).
-p(6261) :- p(6260), q(6261).
-q(6261) :- p(6260), q(6260).
-p(6262) :- p(6261), q(6262).
-q(6262) :- p(6261), q(6261).
-p(6263) :- p(6262), q(6263).
-q(6263) :- p(6262), q(6262).
-p(6264) :- p(6263), q(6264).
-q(6264) :- p(6263), q(6263).
-p(6265) :- p(6264), q(6265).
-q(6265) :- p(6264), q(6264).
-p(6266) :- p(6265), q(6266).
-q(6266) :- p(6265), q(6265).
-p(6267) :- p(6266), q(6267).
-q(6267) :- p(6266), q(6266).
-p(6268) :- p(6267), q(6268).
-q(6268) :- p(6267), q(6267).
-p(6269) :- p(6268), q(6269).
-q(6269) :- p(6268), q(6268).
-p(6270) :- p(6269), q(6270).
-q(6270) :- p(6269), q(6269).
-p(6271) :- p(6270), q(6271).
-q(6271) :- p(6270), q(6270).
-p(6272) :- p(6271), q(6272).
-q(6272) :- p(6271), q(6271).
-p(6273) :- p(6272), q(6273).
-q(6273) :- p(6272), q(6272).
-p(6274) :- p(6273), q(6274).
-q(6274) :- p(6273), q(6273).
-p(6275) :- p(6274), q(6275).
-q(6275) :- p(6274), q(6274).
-p(6276) :- p(6275), q(6276).
-q(6276) :- p(6275), q(6275).
-p(6277) :- p(6276), q(6277).
-q(6277) :- p(6276), q(6276).
-p(6278) :- p(6277), q(6278).
-q(6278) :- p(6277), q(6277).
-p(6279) :- p(6278), q(6279).
-q(6279) :- p(6278), q(6278).
-p(6280) :- p(6279), q(6280).
-q(6280) :- p(6279), q(6279).
-p(6281) :- p(6280), q(6281).
-q(6281) :- p(6280), q(6280).
-p(6282) :- p(6281), q(6282).
-q(6282) :- p(6281), q(6281).
-p(6283) :- p(6282), q(6283).
-q(6283) :- p(6282), q(6282).
-p(6284) :- p(6283), q(6284).
-q(6284) :- p(6283), q(6283).
-p(6285) :- p(6284), q(6285).
-q(6285) :- p(6284), q(6284).
-p(6286) :- p(6285), q(6286).
-q(6286) :- p(6285), q(6285).
-p(6287) :- p(6286), q(6287).
-q(6287) :- p(6286), q(6286).
-p(6288) :- p(6287), q(6288).
-q(6288) :- p(6287), q(6287).
-p(6289) :- p(6288), q(6289).
-q(6289) :- p(6288), q(6288).
-p(6290) :- p(6289), q(6290).
-q(6290) :- p(6289), q(6289).
-p(6291) :- p(6290), q(6291).
-q(6291) :- p(6290), q(6290).
-p(6292) :- p(6291), q(6292).
-q(6292) :- p(6291), q(6291).
-p(6293) :- p(6292), q(6293).
-q(6293) :- p(6292), q(6292).
-p(6294) :- p(6293), q(6294).
-q(6294) :- p(6293), q(6293).
-p(6295) :- p(6294), q(6295).
-q(6295) :- p(6294), q(6294).
-p(6296) :- p(6295), q(6296).
-q(6296) :- p(6295), q(6295).
-p(6297) :- p(6296), q(6297).
-q(6297) :- p(6296), q(6296).
-p(6298) :- p(6297), q(6298).
-q(6298) :- p(6297), q(6297).
-p(6299) :- p(6298), q(6299).
-q(6299) :- p(6298), q(6298).
-p(6300) :- p(6299), q(6300).
-q(6300) :- p(6299), q(6299).
-p(6301) :- p(6300), q(6301).
-q(6301) :- p(6300), q(6300).
-p(6302) :- p(6301), q(6302).
-q(6302) :- p(6301), q(6301).
-p(6303) :- p(6302), q(6303).
-q(6303) :- p(6302), q(6302).
-p(6304) :- p(6303), q(6304).
-q(6304) :- p(6303), q(6303).
-p(6305) :- p(6304), q(6305).
-q(6305) :- p(6304), q(6304).
-p(6306) :- p(6305), q(6306).
-q(6306) :- p(6305), q(6305).
-p(6307) :- p(6306), q(6307).
-q(6307) :- p(6306), q(6306).
-p(6308) :- p(6307), q(6308).
-q(6308) :- p(6307), q(6307).
-p(6309) :- p(6308), q(6309).
-q(6309) :- p(6308), q(6308).
-p(6310) :- p(6309), q(6310).
-q(6310) :- p(6309), q(6309).
-p(6311) :- p(6310), q(6311).
-q(6311) :- p(6310), q(6310).
-p(6312) :- p(6311), q(6312).
-q(6312) :- p(6311), q(6311).
-p(6313) :- p(6312), q(6313).
-q(6313) :- p(6312), q(6312).
-p(6314) :- p(6313), q(6314).
-q(6314) :- p(6313), q(6313).
-p(6315) :- p(6314), q(6315).
-q(6315) :- p(6314), q(6314).
-p(6316) :- p(6315), q(6316).
-q(6316) :- p(6315), q(6315).
-p(6317) :- p(6316), q(6317).
-q(6317) :- p(6316), q(6316).
-p(6318) :- p(6317), q(6318).
-q(6318) :- p(6317), q(6317).
-p(6319) :- p(6318), q(6319).
-q(6319) :- p(6318), q(6318).
-p(6320) :- p(6319), q(6320).
-q(6320) :- p(6319), q(6319).
-p(6321) :- p(6320), q(6321).
-q(6321) :- p(6320), q(6320).
-p(6322) :- p(6321), q(6322).
-q(6322) :- p(6321), q(6321).
-p(6323) :- p(6322), q(6323).
-q(6323) :- p(6322), q(6322).
-p(6324) :- p(6323), q(6324).
-q(6324) :- p(6323), q(6323).
-p(6325) :- p(6324), q(6325).
-q(6325) :- p(6324), q(6324).
-p(6326) :- p(6325), q(6326).
-q(6326) :- p(6325), q(6325).
-p(6327) :- p(6326), q(6327).
-q(6327) :- p(6326), q(6326).
-p(6328) :- p(6327), q(6328).
-q(6328) :- p(6327), q(6327).
-p(6329) :- p(6328), q(6329).
-q(6329) :- p(6328), q(6328).
-p(6330) :- p(6329), q(6330).
-q(6330) :- p(6329), q(6329).
-p(6331) :- p(6330), q(6331).
-q(6331) :- p(6330), q(6330).
-p(6332) :- p(6331), q(6332).
-q(6332) :- p(6331), q(6331).
-p(6333) :- p(6332), q(6333).
-q(6333) :- p(6332), q(6332).
-p(6334) :- p(6333), q(6334).
-q(6334) :- p(6333), q(6333).
-p(6335) :- p(6334), q(6335).
-q(6335) :- p(6334), q(6334).
-p(6336) :- p(6335), q(6336).
-q(6336) :- p(6335), q(6335).
-p(6337) :- p(6336), q(6337).
-q(6337) :- p(6336), q(6336).
-p(6338) :- p(6337), q(6338).
-q(6338) :- p(6337), q(6337).
-p(6339) :- p(6338), q(6339).
-q(6339) :- p(6338), q(6338).
-p(6340) :- p(6339), q(6340).
-q(6340) :- p(6339), q(6339).
-p(6341) :- p(6340), q(6341).
-q(6341) :- p(6340), q(6340).
-p(6342) :- p(6341), q(6342).
-q(6342) :- p(6341), q(6341).
-p(6343) :- p(6342), q(6343).
-q(6343) :- p(6342), q(6342).
-p(6344) :- p(6343), q(6344).
-q(6344) :- p(6343), q(6343).
-p(6345) :- p(6344), q(6345).
-q(6345) :- p(6344), q(6344).
-p(6346) :- p(6345), q(6346).
-q(6346) :- p(6345), q(6345).
-p(6347) :- p(6346), q(6347).
-q(6347) :- p(6346), q(6346).
-p(6348) :- p(6347), q(6348).
-q(6348) :- p(6347), q(6347).
-p(6349) :- p(6348), q(6349).
-q(6349) :- p(6348), q(6348).
-p(6350) :- p(6349), q(6350).
-q(6350) :- p(6349), q(6349).
-p(6351) :- p(6350), q(6351).
-q(6351) :- p(6350), q(6350).
-p(6352) :- p(6351), q(6352).
-q(6352) :- p(6351), q(6351).
-p(6353) :- p(6352), q(6353).
-q(6353) :- p(6352), q(6352).
-p(6354) :- p(6353), q(6354).
-q(6354) :- p(6353), q(6353).
-p(6355) :- p(6354), q(6355).
-q(6355) :- p(6354), q(6354).
-p(6356) :- p(6355), q(6356).
-q(6356) :- p(6355), q(6355).
-p(6357) :- p(6356), q(6357).
-q(6357) :- p(6356), q(6356).
-p(6358) :- p(6357), q(6358).
-q(6358) :- p(6357), q(6357).
-p(6359) :- p(6358), q(6359).
-q(6359) :- p(6358), q(6358).
-p(6360) :- p(6359), q(6360).
-q(6360) :- p(6359), q(6359).
-p(6361) :- p(6360), q(6361).
-q(6361) :- p(6360), q(6360).
-p(6362) :- p(6361), q(6362).
-q(6362) :- p(6361), q(6361).
-p(6363) :- p(6362), q(6363).
-q(6363) :- p(6362), q(6362).
-p(6364) :- p(6363), q(6364).
-q(6364) :- p(6363), q(6363).
-p(6365) :- p(6364), q(6365).
-q(6365) :- p(6364), q(6364).
-p(6366) :- p(6365), q(6366).
-q(6366) :- p(6365), q(6365).
-p(6367) :- p(6366), q(6367).
-q(6367) :- p(6366), q(6366).
-p(6368) :- p(6367), q(6368).
-q(6368) :- p(6367), q(6367).
-p(6369) :- p(6368), q(6369).
-q(6369) :- p(6368), q(6368).
-p(6370) :- p(6369), q(6370).
-q(6370) :- p(6369), q(6369).
-p(6371) :- p(6370), q(6371).
-q(6371) :- p(6370), q(6370).
-p(6372) :- p(6371), q(6372).
-q(6372) :- p(6371), q(6371).
-p(6373) :- p(6372), q(6373).
-q(6373) :- p(6372), q(6372).
-p(6374) :- p(6373), q(6374).
-q(6374) :- p(6373), q(6373).
-p(6375) :- p(6374), q(6375).
-q(6375) :- p(6374), q(6374).
-p(6376) :- p(6375), q(6376).
-q(6376) :- p(6375), q(6375).
-p(6377) :- p(6376), q(6377).
-q(6377) :- p(6376), q(6376).
-p(6378) :- p(6377), q(6378).
-q(6378) :- p(6377), q(6377).
-p(6379) :- p(6378), q(6379).
-q(6379) :- p(6378), q(6378).
-p(6380) :- p(6379), q(6380).
-q(6380) :- p(6379), q(6379).
-p(6381) :- p(6380), q(6381).
-q(6381) :- p(6380), q(6380).
-p(6382) :- p(6381), q(6382).
-q(6382) :- p(6381), q(6381).
-p(6383) :- p(6382), q(6383).
-q(6383) :- p(6382), q(6382).
-p(6384) :- p(6383), q(6384).
-q(6384) :- p(6383), q(6383).
-p(6385) :- p(6384), q(6385).
-q(6385) :- p(6384), q(6384).
-p(6386) :- p(6385), q(6386).
-q(6386) :- p(6385), q(6385).
-p(6387) :- p(6386), q(6387).
-q(6387) :- p(6386), q(6386).
-p(6388) :- p(6387), q(6388).
-q(6388) :- p(6387), q(6387).
-p(6389) :- p(6388), q(6389).
-q(6389) :- p(6388), q(6388).
-p(6390) :- p(6389), q(6390).
-q(6390) :- p(6389), q(6389).
-p(6391) :- p(6390), q(6391).
-q(6391) :- p(6390), q(6390).
-p(6392) :- p(6391), q(6392).
-q(6392) :- p(6391), q(6391).
-p(6393) :- p(6392), q(6393).
-q(6393) :- p(6392), q(6392).
-p(6394) :- p(6393), q(6394).
-q(6394) :- p(6393), q(6393).
-p(6395) :- p(6394), q(6395).
-q(6395) :- p(6394), q(6394).
-p(6396) :- p(6395), q(6396).
-q(6396) :- p(6395), q(6395).
-p(6397) :- p(6396), q(6397).
-q(6397) :- p(6396), q(6396).
-p(6398) :- p(6397), q(6398).
-q(6398) :- p(6397), q(6397).
-p(6399) :- p(6398), q(6399).
-q(6399) :- p(6398), q(6398).
-p(6400) :- p(6399), q(6400).
-q(6400) :- p(6399), q(6399).
-p(6401) :- p(6400), q(6401).
-q(6401) :- p(6400), q(6400).
-p(6402) :- p(6401), q(6402).
-q(6402) :- p(6401), q(6401).
-p(6403) :- p(6402), q(6403).
-q(6403) :- p(6402), q(6402).
-p(6404) :- p(6403), q(6404).
-q(6404) :- p(6403), q(6403).
-p(6405) :- p(6404), q(6405).
-q(6405) :- p(6404), q(6404).
-p(6406) :- p(6405), q(6406).
-q(6406) :- p(6405), q(6405).
-p(6407) :- p(6406), q(6407).
-q(6407) :- p(6406), q(6406).
-p(6408) :- p(6407), q(6408).
-q(6408) :- p(6407), q(6407).
-p(6409) :- p(6408), q(6409).
-q(6409) :- p(6408), q(6408).
-p(6410) :- p(6409), q(6410).
-q(6410) :- p(6409), q(6409).
-p(6411) :- p(6410), q(6411).
-q(6411) :- p(6410), q(6410).
-p(6412) :- p(6411), q(6412).
-q(6412) :- p(6411), q(6411).
-p(6413) :- p(6412), q(6413).
-q(6413) :- p(6412), q(6412).
-p(6414) :- p(6413), q(6414).
-q(6414) :- p(6413), q(6413).
-p(6415) :- p(6414), q(6415).
-q(6415) :- p(6414), q(6414).
-p(6416) :- p(6415), q(6416).
-q(6416) :- p(6415), q(6415).
-p(6417) :- p(6416), q(6417).
-q(6417) :- p(6416), q(6416).
-p(6418) :- p(6417), q(6418).
-q(6418) :- p(6417), q(6417).
-p(6419) :- p(6418), q(6419).
-q(6419) :- p(6418), q(6418).
-p(6420) :- p(6419), q(6420).
-q(6420) :- p(6419), q(6419).
-p(6421) :- p(6420), q(6421).
-q(6421) :- p(6420), q(6420).
-p(6422) :- p(6421), q(6422).
-q(6422) :- p(6421), q(6421).
-p(6423) :- p(6422), q(6423).
-q(6423) :- p(6422), q(6422).
-p(6424) :- p(6423), q(6424).
-q(6424) :- p(6423), q(6423).
-p(6425) :- p(6424), q(6425).
-q(6425) :- p(6424), q(6424).
-p(6426) :- p(6425), q(6426).
-q(6426) :- p(6425), q(6425).
-p(6427) :- p(6426), q(6427).
-q(6427) :- p(6426), q(6426).
-p(6428) :- p(6427), q(6428).
-q(6428) :- p(6427), q(6427).
-p(6429) :- p(6428), q(6429).
-q(6429) :- p(6428), q(6428).
-p(6430) :- p(6429), q(6430).
-q(6430) :- p(6429), q(6429).
-p(6431) :- p(6430), q(6431).
-q(6431) :- p(6430), q(6430).
-p(6432) :- p(6431), q(6432).
-q(6432) :- p(6431), q(6431).
-p(6433) :- p(6432), q(6433).
-q(6433) :- p(6432), q(6432).
-p(6434) :- p(6433), q(6434).
-q(6434) :- p(6433), q(6433).
-p(6435) :- p(6434), q(6435).
-q(6435) :- p(6434), q(6434).
-p(6436) :- p(6435), q(6436).
-q(6436) :- p(6435), q(6435).
-p(6437) :- p(6436), q(6437).
-q(6437) :- p(6436), q(6436).
-p(6438) :- p(6437), q(6438).
-q(6438) :- p(6437), q(6437).
-p(6439) :- p(6438), q(6439).
-q(6439) :- p(6438), q(6438).
-p(6440) :- p(6439), q(6440).
-q(6440) :- p(6439), q(6439).
-p(6441) :- p(6440), q(6441).
-q(6441) :- p(6440), q(6440).
-p(6442) :- p(6441), q(6442).
-q(6442) :- p(6441), q(6441).
-p(6443) :- p(6442), q(6443).
-q(6443) :- p(6442), q(6442).
-p(6444) :- p(6443), q(6444).
-q(6444) :- p(6443), q(6443).
-p(6445) :- p(6444), q(6445).
-q(6445) :- p(6444), q(6444).
-p(6446) :- p(6445), q(6446).
-q(6446) :- p(6445), q(6445).
-p(6447) :- p(6446), q(6447).
-q(6447) :- p(6446), q(6446).
-p(6448) :- p(6447), q(6448).
-q(6448) :- p(6447), q(6447).
-p(6449) :- p(6448), q(6449).
-q(6449) :- p(6448), q(6448).
-p(6450) :- p(6449), q(6450).
-q(6450) :- p(6449), q(6449).
-p(6451) :- p(6450), q(6451).
-q(6451) :- p(6450), q(6450).
-p(6452) :- p(6451), q(6452).
-q(6452) :- p(6451), q(6451).
-p(6453) :- p(6452), q(6453).
-q(6453) :- p(6452), q(6452).
-p(6454) :- p(6453), q(6454).
-q(6454) :- p(6453), q(6453).
-p(6455) :- p(6454), q(6455).
-q(6455) :- p(6454), q(6454).
-p(6456) :- p(6455), q(6456).
-q(6456) :- p(6455), q(6455).
-p(6457) :- p(6456), q(6457).
-q(6457) :- p(6456), q(6456).
-p(6458) :- p(6457), q(6458).
-q(6458) :- p(6457), q(6457).
-p(6459) :- p(6458), q(6459).
-q(6459) :- p(6458), q(6458).
-p(6460) :- p(6459), q(6460).
-q(6460) :- p(6459), q(6459).
-p(6461) :- p(6460), q(6461).
-q(6461) :- p(6460), q(6460).
-p(6462) :- p(6461), q(6462).
-q(6462) :- p(6461), q(6461).
-p(6463) :- p(6462), q(6463).
-q(6463) :- p(6462), q(6462).
-p(6464) :- p(6463), q(6464).
-q(6464) :- p(6463), q(6463).
-p(6465) :- p(6464), q(6465).
-q(6465) :- p(6464), q(6464).
-p(6466) :- p(6465), q(6466).
-q(6466) :- p(6465), q(6465).
-p(6467) :- p(6466), q(6467).
-q(6467) :- p(6466), q(6466).
-p(6468) :- p(6467), q(6468).
-q(6468) :- p(6467), q(6467).
-p(6469) :- p(6468), q(6469).
-q(6469) :- p(6468), q(6468).
-p(6470) :- p(6469), q(6470).
-q(6470) :- p(6469), q(6469).
-p(6471) :- p(6470), q(6471).
-q(6471) :- p(6470), q(6470).
-p(6472) :- p(6471), q(6472).
-q(6472) :- p(6471), q(6471).
-p(6473) :- p(6472), q(6473).
-q(6473) :- p(6472), q(6472).
-p(6474) :- p(6473), q(6474).
-q(6474) :- p(6473), q(6473).
-p(6475) :- p(6474), q(6475).
-q(6475) :- p(6474), q(6474).
-p(6476) :- p(6475), q(6476).
-q(6476) :- p(6475), q(6475).
-p(6477) :- p(6476), q(6477).
-q(6477) :- p(6476), q(6476).
-p(6478) :- p(6477), q(6478).
-q(6478) :- p(6477), q(6477).
-p(6479) :- p(6478), q(6479).
-q(6479) :- p(6478), q(6478).
-p(6480) :- p(6479), q(6480).
-q(6480) :- p(6479), q(6479).
-p(6481) :- p(6480), q(6481).
-q(6481) :- p(6480), q(6480).
-p(6482) :- p(6481), q(6482).
-q(6482) :- p(6481), q(6481).
-p(6483) :- p(6482), q(6483).
-q(6483) :- p(6482), q(6482).
-p(6484) :- p(6483), q(6484).
-q(6484) :- p(6483), q(6483).
-p(6485) :- p(6484), q(6485).
-q(6485) :- p(6484), q(6484).
-p(6486) :- p(6485), q(6486).
-q(6486) :- p(6485), q(6485).
-p(6487) :- p(6486), q(6487).
-q(6487) :- p(6486), q(6486).
-p(6488) :- p(6487), q(6488).
-q(6488) :- p(6487), q(6487).
-p(6489) :- p(6488), q(6489).
-q(6489) :- p(6488), q(6488).
-p(6490) :- p(6489), q(6490).
-q(6490) :- p(6489), q(6489).
-p(6491) :- p(6490), q(6491).
-q(6491) :- p(6490), q(6490).
-p(6492) :- p(6491), q(6492).
-q(6492) :- p(6491), q(6491).
-p(6493) :- p(6492), q(6493).
-q(6493) :- p(6492), q(6492).
-p(6494) :- p(6493), q(6494).
-q(6494) :- p(6493), q(6493).
-p(6495) :- p(6494), q(6495).
-q(6495) :- p(6494), q(6494).
-p(6496) :- p(6495), q(6496).
-q(6496) :- p(6495), q(6495).
-p(6497) :- p(6496), q(6497).
-q(6497) :- p(6496), q(6496).
-p(6498) :- p(6497), q(6498).
-q(6498) :- p(6497), q(6497).
-p(6499) :- p(6498), q(6499).
-q(6499) :- p(6498), q(6498).
-p(6500) :- p(6499), q(6500).
-q(6500) :- p(6499), q(6499).
-p(6501) :- p(6500), q(6501).
-q(6501) :- p(6500), q(6500).
-p(6502) :- p(6501), q(6502).
-q(6502) :- p(6501), q(6501).
-p(6503) :- p(6502), q(6503).
-q(6503) :- p(6502), q(6502).
-p(6504) :- p(6503), q(6504).
-q(6504) :- p(6503), q(6503).
-p(6505) :- p(6504), q(6505).
-q(6505) :- p(6504), q(6504).
-p(6506) :- p(6505), q(6506).
-q(6506) :- p(6505), q(6505).
-p(6507) :- p(6506), q(6507).
-q(6507) :- p(6506), q(6506).
-p(6508) :- p(6507), q(6508).
-q(6508) :- p(6507), q(6507).
-p(6509) :- p(6508), q(6509).
-q(6509) :- p(6508), q(6508).
-p(6510) :- p(6509), q(6510).
-q(6510) :- p(6509), q(6509).
-p(6511) :- p(6510), q(6511).
-q(6511) :- p(6510), q(6510).
-p(6512) :- p(6511), q(6512).
-q(6512) :- p(6511), q(6511).
-p(6513) :- p(6512), q(6513).
-q(6513) :- p(6512), q(6512).
-p(6514) :- p(6513), q(6514).
-q(6514) :- p(6513), q(6513).
-p(6515) :- p(6514), q(6515).
-q(6515) :- p(6514), q(6514).
-p(6516) :- p(6515), q(6516).
-q(6516) :- p(6515), q(6515).
-p(6517) :- p(6516), q(6517).
-q(6517) :- p(6516), q(6516).
-p(6518) :- p(6517), q(6518).
-q(6518) :- p(6517), q(6517).
-p(6519) :- p(6518), q(6519).
-q(6519) :- p(6518), q(6518).
-p(6520) :- p(6519), q(6520).
-q(6520) :- p(6519), q(6519).
-p(6521) :- p(6520), q(6521).
-q(6521) :- p(6520), q(6520).
-p(6522) :- p(6521), q(6522).
-q(6522) :- p(6521), q(6521).
-p(6523) :- p(6522), q(6523).
-q(6523) :- p(6522), q(6522).
-p(6524) :- p(6523), q(6524).
-q(6524) :- p(6523), q(6523).
-p(6525) :- p(6524), q(6525).
-q(6525) :- p(6524), q(6524).
-p(6526) :- p(6525), q(6526).
-q(6526) :- p(6525), q(6525).
-p(6527) :- p(6526), q(6527).
-q(6527) :- p(6526), q(6526).
-p(6528) :- p(6527), q(6528).
-q(6528) :- p(6527), q(6527).
-p(6529) :- p(6528), q(6529).
-q(6529) :- p(6528), q(6528).
-p(6530) :- p(6529), q(6530).
-q(6530) :- p(6529), q(6529).
-p(6531) :- p(6530), q(6531).
-q(6531) :- p(6530), q(6530).
-p(6532) :- p(6531), q(6532).
-q(6532) :- p(6531), q(6531).
-p(6533) :- p(6532), q(6533).
-q(6533) :- p(6532), q(6532).
-p(6534) :- p(6533), q(6534).
-q(6534) :- p(6533), q(6533).
-p(6535) :- p(6534), q(6535).
-q(6535) :- p(6534), q(6534).
-p(6536) :- p(6535), q(6536).
-q(6536) :- p(6535), q(6535).
-p(6537) :- p(6536), q(6537).
-q(6537) :- p(6536), q(6536).
-p(6538) :- p(6537), q(6538).
-q(6538) :- p(6537), q(6537).
-p(6539) :- p(6538), q(6539).
-q(6539) :- p(6538), q(6538).
-p(6540) :- p(6539), q(6540).
-q(6540) :- p(6539), q(6539).
-p(6541) :- p(6540), q(6541).
-q(6541) :- p(6540), q(6540).
-p(6542) :- p(6541), q(6542).
-q(6542) :- p(6541), q(6541).
-p(6543) :- p(6542), q(6543).
-q(6543) :- p(6542), q(6542).
-p(6544) :- p(6543), q(6544).
-q(6544) :- p(6543), q(6543).
-p(6545) :- p(6544), q(6545).
-q(6545) :- p(6544), q(6544).
-p(6546) :- p(6545), q(6546).
-q(6546) :- p(6545), q(6545).
-p(6547) :- p(6546), q(6547).
-q(6547) :- p(6546), q(6546).
-p(6548) :- p(6547), q(6548).
-q(6548) :- p(6547), q(6547).
-p(6549) :- p(6548), q(6549).
-q(6549) :- p(6548), q(6548).
-p(6550) :- p(6549), q(6550).
-q(6550) :- p(6549), q(6549).
-p(6551) :- p(6550), q(6551).
-q(6551) :- p(6550), q(6550).
-p(6552) :- p(6551), q(6552).
-q(6552) :- p(6551), q(6551).
-p(6553) :- p(6552), q(6553).
-q(6553) :- p(6552), q(6552).
-p(6554) :- p(6553), q(6554).
-q(6554) :- p(6553), q(6553).
-p(6555) :- p(6554), q(6555).
-q(6555) :- p(6554), q(6554).
-p(6556) :- p(6555), q(6556).
-q(6556) :- p(6555), q(6555).
-p(6557) :- p(6556), q(6557).
-q(6557) :- p(6556), q(6556).
-p(6558) :- p(6557), q(6558).
-q(6558) :- p(6557), q(6557).
-p(6559) :- p(6558), q(6559).
-q(6559) :- p(6558), q(6558).
-p(6560) :- p(6559), q(6560).
-q(6560) :- p(6559), q(6559).
-p(6561) :- p(6560), q(6561).
-q(6561) :- p(6560), q(6560).
-p(6562) :- p(6561), q(6562).
-q(6562) :- p(6561), q(6561).
-p(6563) :- p(6562), q(6563).
-q(6563) :- p(6562), q(6562).
-p(6564) :- p(6563), q(6564).
-q(6564) :- p(6563), q(6563).
-p(6565) :- p(6564), q(6565).
-q(6565) :- p(6564), q(6564).
-p(6566) :- p(6565), q(6566).
-q(6566) :- p(6565), q(6565).
-p(6567) :- p(6566), q(6567).
-q(6567) :- p(6566), q(6566).
-p(6568) :- p(6567), q(6568).
-q(6568) :- p(6567), q(6567).
-p(6569) :- p(6568), q(6569).
-q(6569) :- p(6568), q(6568).
-p(6570) :- p(6569), q(6570).
-q(6570) :- p(6569), q(6569).
-p(6571) :- p(6570), q(6571).
-q(6571) :- p(6570), q(6570).
-p(6572) :- p(6571), q(6572).
-q(6572) :- p(6571), q(6571).
-p(6573) :- p(6572), q(6573).
-q(6573) :- p(6572), q(6572).
-p(6574) :- p(6573), q(6574).
-q(6574) :- p(6573), q(6573).
-p(6575) :- p(6574), q(6575).
-q(6575) :- p(6574), q(6574).
-p(6576) :- p(6575), q(6576).
-q(6576) :- p(6575), q(6575).
-p(6577) :- p(6576), q(6577).
-q(6577) :- p(6576), q(6576).
-p(6578) :- p(6577), q(6578).
-q(6578) :- p(6577), q(6577).
-p(6579) :- p(6578), q(6579).
-q(6579) :- p(6578), q(6578).
-p(6580) :- p(6579), q(6580).
-q(6580) :- p(6579), q(6579).
-p(6581) :- p(6580), q(6581).
-q(6581) :- p(6580), q(6580).
-p(6582) :- p(6581), q(6582).
-q(6582) :- p(6581), q(6581).
-p(6583) :- p(6582), q(6583).
-q(6583) :- p(6582), q(6582).
-p(6584) :- p(6583), q(6584).
-q(6584) :- p(6583), q(6583).
-p(6585) :- p(6584), q(6585).
-q(6585) :- p(6584), q(6584).
-p(6586) :- p(6585), q(6586).
-q(6586) :- p(6585), q(6585).
-p(6587) :- p(6586), q(6587).
-q(6587) :- p(6586), q(6586).
-p(6588) :- p(6587), q(6588).
-q(6588) :- p(6587), q(6587).
-p(6589) :- p(6588), q(6589).
-q(6589) :- p(6588), q(6588).
-p(6590) :- p(6589), q(6590).
-q(6590) :- p(6589), q(6589).
-p(6591) :- p(6590), q(6591).
-q(6591) :- p(6590), q(6590).
-p(6592) :- p(6591), q(6592).
-q(6592) :- p(6591), q(6591).
-p(6593) :- p(6592), q(6593).
-q(6593) :- p(6592), q(6592).
-p(6594) :- p(6593), q(6594).
-q(6594) :- p(6593), q(6593).
-p(6595) :- p(6594), q(6595).
-q(6595) :- p(6594), q(6594).
-p(6596) :- p(6595), q(6596).
-q(6596) :- p(6595), q(6595).
-p(6597) :- p(6596), q(6597).
-q(6597) :- p(6596), q(6596).
-p(6598) :- p(6597), q(6598).
-q(6598) :- p(6597), q(6597).
-p(6599) :- p(6598), q(6599).
-q(6599) :- p(6598), q(6598).
-p(6600) :- p(6599), q(6600).
-q(6600) :- p(6599), q(6599).
-p(6601) :- p(6600), q(6601).
-q(6601) :- p(6600), q(6600).
-p(6602) :- p(6601), q(6602).
-q(6602) :- p(6601), q(6601).
-p(6603) :- p(6602), q(6603).
-q(6603) :- p(6602), q(6602).
-p(6604) :- p(6603), q(6604).
-q(6604) :- p(6603), q(6603).
-p(6605) :- p(6604), q(6605).
-q(6605) :- p(6604), q(6604).
-p(6606) :- p(6605), q(6606).
-q(6606) :- p(6605), q(6605).
-p(6607) :- p(6606), q(6607).
-q(6607) :- p(6606), q(6606).
-p(6608) :- p(6607), q(6608).
-q(6608) :- p(6607), q(6607).
-p(6609) :- p(6608), q(6609).
-q(6609) :- p(6608), q(6608).
-p(6610) :- p(6609), q(6610).
-q(6610) :- p(6609), q(6609).
-p(6611) :- p(6610), q(6611).
-q(6611) :- p(6610), q(6610).
-p(6612) :- p(6611), q(6612).
-q(6612) :- p(6611), q(6611).
-p(6613) :- p(6612), q(6613).
-q(6613) :- p(6612), q(6612).
-p(6614) :- p(6613), q(6614).
-q(6614) :- p(6613), q(6613).
-p(6615) :- p(6614), q(6615).
-q(6615) :- p(6614), q(6614).
-p(6616) :- p(6615), q(6616).
-q(6616) :- p(6615), q(6615).
-p(6617) :- p(6616), q(6617).
-q(6617) :- p(6616), q(6616).
-p(6618) :- p(6617), q(6618).
-q(6618) :- p(6617), q(6617).
-p(6619) :- p(6618), q(6619).
-q(6619) :- p(6618), q(6618).
-p(6620) :- p(6619), q(6620).
-q(6620) :- p(6619), q(6619).
-p(6621) :- p(6620), q(6621).
-q(6621) :- p(6620), q(6620).
-p(6622) :- p(6621), q(6622).
-q(6622) :- p(6621), q(6621).
-p(6623) :- p(6622), q(6623).
-q(6623) :- p(6622), q(6622).
-p(6624) :- p(6623), q(6624).
-q(6624) :- p(6623), q(6623).
-p(6625) :- p(6624), q(6625).
-q(6625) :- p(6624), q(6624).
-p(6626) :- p(6625), q(6626).
-q(6626) :- p(6625), q(6625).
-p(6627) :- p(6626), q(6627).
-q(6627) :- p(6626), q(6626).
-p(6628) :- p(6627), q(6628).
-q(6628) :- p(6627), q(6627).
-p(6629) :- p(6628), q(6629).
-q(6629) :- p(6628), q(6628).
-p(6630) :- p(6629), q(6630).
-q(6630) :- p(6629), q(6629).
-p(6631) :- p(6630), q(6631).
-q(6631) :- p(6630), q(6630).
-p(6632) :- p(6631), q(6632).
-q(6632) :- p(6631), q(6631).
-p(6633) :- p(6632), q(6633).
-q(6633) :- p(6632), q(6632).
-p(6634) :- p(6633), q(6634).
-q(6634) :- p(6633), q(6633).
-p(6635) :- p(6634), q(6635).
-q(6635) :- p(6634), q(6634).
-p(6636) :- p(6635), q(6636).
-q(6636) :- p(6635), q(6635).
-p(6637) :- p(6636), q(6637).
-q(6637) :- p(6636), q(6636).
-p(6638) :- p(6637), q(6638).
-q(6638) :- p(6637), q(6637).
-p(6639) :- p(6638), q(6639).
-q(6639) :- p(6638), q(6638).
-p(6640) :- p(6639), q(6640).
-q(6640) :- p(6639), q(6639).
-p(6641) :- p(6640), q(6641).
-q(6641) :- p(6640), q(6640).
-p(6642) :- p(6641), q(6642).
-q(6642) :- p(6641), q(6641).
-p(6643) :- p(6642), q(6643).
-q(6643) :- p(6642), q(6642).
-p(6644) :- p(6643), q(6644).
-q(6644) :- p(6643), q(6643).
-p(6645) :- p(6644), q(6645).
-q(6645) :- p(6644), q(6644).
-p(6646) :- p(6645), q(6646).
-q(6646) :- p(6645), q(6645).
-p(6647) :- p(6646), q(6647).
-q(6647) :- p(6646), q(6646).
-p(6648) :- p(6647), q(6648).
-q(6648) :- p(6647), q(6647).
-p(6649) :- p(6648), q(6649).
-q(6649) :- p(6648), q(6648).
-p(6650) :- p(6649), q(6650).
-q(6650) :- p(6649), q(6649).
-p(6651) :- p(6650), q(6651).
-q(6651) :- p(6650), q(6650).
-p(6652) :- p(6651), q(6652).
-q(6652) :- p(6651), q(6651).
-p(6653) :- p(6652), q(6653).
-q(6653) :- p(6652), q(6652).
-p(6654) :- p(6653), q(6654).
-q(6654) :- p(6653), q(6653).
-p(6655) :- p(6654), q(6655).
-q(6655) :- p(6654), q(6654).
-p(6656) :- p(6655), q(6656).
-q(6656) :- p(6655), q(6655).
-p(6657) :- p(6656), q(6657).
-q(6657) :- p(6656), q(6656).
-p(6658) :- p(6657), q(6658).
-q(6658) :- p(6657), q(6657).
-p(6659) :- p(6658), q(6659).
-q(6659) :- p(6658), q(6658).
-p(6660) :- p(6659), q(6660).
-q(6660) :- p(6659), q(6659).
-p(6661) :- p(6660), q(6661).
-q(6661) :- p(6660), q(6660).
-p(6662) :- p(6661), q(6662).
-q(6662) :- p(6661), q(6661).
-p(6663) :- p(6662), q(6663).
-q(6663) :- p(6662), q(6662).
-p(6664) :- p(6663), q(6664).
-q(6664) :- p(6663), q(6663).
-p(6665) :- p(6664), q(6665).
-q(6665) :- p(6664), q(6664).
-p(6666) :- p(6665), q(6666).
-q(6666) :- p(6665), q(6665).
-p(6667) :- p(6666), q(6667).
-q(6667) :- p(6666), q(6666).
-p(6668) :- p(6667), q(6668).
-q(6668) :- p(6667), q(6667).
-p(6669) :- p(6668), q(6669).
-q(6669) :- p(6668), q(6668).
-p(6670) :- p(6669), q(6670).
-q(6670) :- p(6669), q(6669).
-p(6671) :- p(6670), q(6671).
-q(6671) :- p(6670), q(6670).
-p(6672) :- p(6671), q(6672).
-q(6672) :- p(6671), q(6671).
-p(6673) :- p(6672), q(6673).
-q(6673) :- p(6672), q(6672).
-p(6674) :- p(6673), q(6674).
-q(6674) :- p(6673), q(6673).
-p(6675) :- p(6674), q(6675).
-q(6675) :- p(6674), q(6674).
-p(6676) :- p(6675), q(6676).
-q(6676) :- p(6675), q(6675).
-p(6677) :- p(6676), q(6677).
-q(6677) :- p(6676), q(6676).
-p(6678) :- p(6677), q(6678).
-q(6678) :- p(6677), q(6677).
-p(6679) :- p(6678), q(6679).
-q(6679) :- p(6678), q(6678).
-p(6680) :- p(6679), q(6680).
-q(6680) :- p(6679), q(6679).
-p(6681) :- p(6680), q(6681).
-q(6681) :- p(6680), q(6680).
-p(6682) :- p(6681), q(6682).
-q(6682) :- p(6681), q(6681).
-p(6683) :- p(6682), q(6683).
-q(6683) :- p(6682), q(6682).
-p(6684) :- p(6683), q(6684).
-q(6684) :- p(6683), q(6683).
-p(6685) :- p(6684), q(6685).
-q(6685) :- p(6684), q(6684).
-p(6686) :- p(6685), q(6686).
-q(6686) :- p(6685), q(6685).
-p(6687) :- p(6686), q(6687).
-q(6687) :- p(6686), q(6686).
-p(6688) :- p(6687), q(6688).
-q(6688) :- p(6687), q(6687).
-p(6689) :- p(6688), q(6689).
-q(6689) :- p(6688), q(6688).
-p(6690) :- p(6689), q(6690).
-q(6690) :- p(6689), q(6689).
-p(6691) :- p(6690), q(6691).
-q(6691) :- p(6690), q(6690).
-p(6692) :- p(6691), q(6692).
-q(6692) :- p(6691), q(6691).
-p(6693) :- p(6692), q(6693).
-q(6693) :- p(6692), q(6692).
-p(6694) :- p(6693), q(6694).
-q(6694) :- p(6693), q(6693).
-p(6695) :- p(6694), q(6695).
-q(6695) :- p(6694), q(6694).
-p(6696) :- p(6695), q(6696).
-q(6696) :- p(6695), q(6695).
-p(6697) :- p(6696), q(6697).
-q(6697) :- p(6696), q(6696).
-p(6698) :- p(6697), q(6698).
-q(6698) :- p(6697), q(6697).
-p(6699) :- p(6698), q(6699).
-q(6699) :- p(6698), q(6698).
-p(6700) :- p(6699), q(6700).
-q(6700) :- p(6699), q(6699).
-p(6701) :- p(6700), q(6701).
-q(6701) :- p(6700), q(6700).
-p(6702) :- p(6701), q(6702).
-q(6702) :- p(6701), q(6701).
-p(6703) :- p(6702), q(6703).
-q(6703) :- p(6702), q(6702).
-p(6704) :- p(6703), q(6704).
-q(6704) :- p(6703), q(6703).
-p(6705) :- p(6704), q(6705).
-q(6705) :- p(6704), q(6704).
-p(6706) :- p(6705), q(6706).
-q(6706) :- p(6705), q(6705).
-p(6707) :- p(6706), q(6707).
-q(6707) :- p(6706), q(6706).
-p(6708) :- p(6707), q(6708).
-q(6708) :- p(6707), q(6707).
-p(6709) :- p(6708), q(6709).
-q(6709) :- p(6708), q(6708).
-p(6710) :- p(6709), q(6710).
-q(6710) :- p(6709), q(6709).
-p(6711) :- p(6710), q(6711).
-q(6711) :- p(6710), q(6710).
-p(6712) :- p(6711), q(6712).
-q(6712) :- p(6711), q(6711).
-p(6713) :- p(6712), q(6713).
-q(6713) :- p(6712), q(6712).
-p(6714) :- p(6713), q(6714).
-q(6714) :- p(6713), q(6713).
-p(6715) :- p(6714), q(6715).
-q(6715) :- p(6714), q(6714).
-p(6716) :- p(6715), q(6716).
-q(6716) :- p(6715), q(6715).
-p(6717) :- p(6716), q(6717).
-q(6717) :- p(6716), q(6716).
-p(6718) :- p(6717), q(6718).
-q(6718) :- p(6717), q(6717).
-p(6719) :- p(6718), q(6719).
-q(6719) :- p(6718), q(6718).
-p(6720) :- p(6719), q(6720).
-q(6720) :- p(6719), q(6719).
-p(6721) :- p(6720), q(6721).
-q(6721) :- p(6720), q(6720).
-p(6722) :- p(6721), q(6722).
-q(6722) :- p(6721), q(6721).
-p(6723) :- p(6722), q(6723).
-q(6723) :- p(6722), q(6722).
-p(6724) :- p(6723), q(6724).
-q(6724) :- p(6723), q(6723).
-p(6725) :- p(6724), q(6725).
-q(6725) :- p(6724), q(6724).
-p(6726) :- p(6725), q(6726).
-q(6726) :- p(6725), q(6725).
-p(6727) :- p(6726), q(6727).
-q(6727) :- p(6726), q(6726).
-p(6728) :- p(6727), q(6728).
-q(6728) :- p(6727), q(6727).
-p(6729) :- p(6728), q(6729).
-q(6729) :- p(6728), q(6728).
-p(6730) :- p(6729), q(6730).
-q(6730) :- p(6729), q(6729).
-p(6731) :- p(6730), q(6731).
-q(6731) :- p(6730), q(6730).
-p(6732) :- p(6731), q(6732).
-q(6732) :- p(6731), q(6731).
-p(6733) :- p(6732), q(6733).
-q(6733) :- p(6732), q(6732).
-p(6734) :- p(6733), q(6734).
-q(6734) :- p(6733), q(6733).
-p(6735) :- p(6734), q(6735).
-q(6735) :- p(6734), q(6734).
-p(6736) :- p(6735), q(6736).
-q(6736) :- p(6735), q(6735).
-p(6737) :- p(6736), q(6737).
-q(6737) :- p(6736), q(6736).
-p(6738) :- p(6737), q(6738).
-q(6738) :- p(6737), q(6737).
-p(6739) :- p(6738), q(6739).
-q(6739) :- p(6738), q(6738).
-p(6740) :- p(6739), q(6740).
-q(6740) :- p(6739), q(6739).
-p(6741) :- p(6740), q(6741).
-q(6741) :- p(6740), q(6740).
-p(6742) :- p(6741), q(6742).
-q(6742) :- p(6741), q(6741).
-p(6743) :- p(6742), q(6743).
-q(6743) :- p(6742), q(6742).
-p(6744) :- p(6743), q(6744).
-q(6744) :- p(6743), q(6743).
-p(6745) :- p(6744), q(6745).
-q(6745) :- p(6744), q(6744).
-p(6746) :- p(6745), q(6746).
-q(6746) :- p(6745), q(6745).
-p(6747) :- p(6746), q(6747).
-q(6747) :- p(6746), q(6746).
-p(6748) :- p(6747), q(6748).
-q(6748) :- p(6747), q(6747).
-p(6749) :- p(6748), q(6749).
-q(6749) :- p(6748), q(6748).
-p(6750) :- p(6749), q(6750).
-q(6750) :- p(6749), q(6749).
-p(6751) :- p(6750), q(6751).
-q(6751) :- p(6750), q(6750).
-p(6752) :- p(6751), q(6752).
-q(6752) :- p(6751), q(6751).
-p(6753) :- p(6752), q(6753).
-q(6753) :- p(6752), q(6752).
-p(6754) :- p(6753), q(6754).
-q(6754) :- p(6753), q(6753).
-p(6755) :- p(6754), q(6755).
-q(6755) :- p(6754), q(6754).
-p(6756) :- p(6755), q(6756).
-q(6756) :- p(6755), q(6755).
-p(6757) :- p(6756), q(6757).
-q(6757) :- p(6756), q(6756).
-p(6758) :- p(6757), q(6758).
-q(6758) :- p(6757), q(6757).
-p(6759) :- p(6758), q(6759).
-q(6759) :- p(6758), q(6758).
-p(6760) :- p(6759), q(6760).
-q(6760) :- p(6759), q(6759).
-p(6761) :- p(6760), q(6761).
-q(6761) :- p(6760), q(6760).
-p(6762) :- p(6761), q(6762).
-q(6762) :- p(6761), q(6761).
-p(6763) :- p(6762), q(6763).
-q(6763) :- p(6762), q(6762).
-p(6764) :- p(6763), q(6764).
-q(6764) :- p(6763), q(6763).
-p(6765) :- p(6764), q(6765).
-q(6765) :- p(6764), q(6764).
-p(6766) :- p(6765), q(6766).
-q(6766) :- p(6765), q(6765).
-p(6767) :- p(6766), q(6767).
-q(6767) :- p(6766), q(6766).
-p(6768) :- p(6767), q(6768).
-q(6768) :- p(6767), q(6767).
-p(6769) :- p(6768), q(6769).
-q(6769) :- p(6768), q(6768).
-p(6770) :- p(6769), q(6770).
-q(6770) :- p(6769), q(6769).
-p(6771) :- p(6770), q(6771).
-q(6771) :- p(6770), q(6770).
-p(6772) :- p(6771), q(6772).
-q(6772) :- p(6771), q(6771).
-p(6773) :- p(6772), q(6773).
-q(6773) :- p(6772), q(6772).
-p(6774) :- p(6773), q(6774).
-q(6774) :- p(6773), q(6773).
-p(6775) :- p(6774), q(6775).
-q(6775) :- p(6774), q(6774).
-p(6776) :- p(6775), q(6776).
-q(6776) :- p(6775), q(6775).
-p(6777) :- p(6776), q(6777).
-q(6777) :- p(6776), q(6776).
-p(6778) :- p(6777), q(6778).
-q(6778) :- p(6777), q(6777).
-p(6779) :- p(6778), q(6779).
-q(6779) :- p(6778), q(6778).
-p(6780) :- p(6779), q(6780).
-q(6780) :- p(6779), q(6779).
-p(6781) :- p(6780), q(6781).
-q(6781) :- p(6780), q(6780).
-p(6782) :- p(6781), q(6782).
-q(6782) :- p(6781), q(6781).
-p(6783) :- p(6782), q(6783).
-q(6783) :- p(6782), q(6782).
-p(6784) :- p(6783), q(6784).
-q(6784) :- p(6783), q(6783).
-p(6785) :- p(6784), q(6785).
-q(6785) :- p(6784), q(6784).
-p(6786) :- p(6785), q(6786).
-q(6786) :- p(6785), q(6785).
-p(6787) :- p(6786), q(6787).
-q(6787) :- p(6786), q(6786).
-p(6788) :- p(6787), q(6788).
-q(6788) :- p(6787), q(6787).
-p(6789) :- p(6788), q(6789).
-q(6789) :- p(6788), q(6788).
-p(6790) :- p(6789), q(6790).
-q(6790) :- p(6789), q(6789).
-p(6791) :- p(6790), q(6791).
-q(6791) :- p(6790), q(6790).
-p(6792) :- p(6791), q(6792).
-q(6792) :- p(6791), q(6791).
-p(6793) :- p(6792), q(6793).
-q(6793) :- p(6792), q(6792).
-p(6794) :- p(6793), q(6794).
-q(6794) :- p(6793), q(6793).
-p(6795) :- p(6794), q(6795).
-q(6795) :- p(6794), q(6794).
-p(6796) :- p(6795), q(6796).
-q(6796) :- p(6795), q(6795).
-p(6797) :- p(6796), q(6797).
-q(6797) :- p(6796), q(6796).
-p(6798) :- p(6797), q(6798).
-q(6798) :- p(6797), q(6797).
-p(6799) :- p(6798), q(6799).
-q(6799) :- p(6798), q(6798).
-p(6800) :- p(6799), q(6800).
-q(6800) :- p(6799), q(6799).
-p(6801) :- p(6800), q(6801).
-q(6801) :- p(6800), q(6800).
-p(6802) :- p(6801), q(6802).
-q(6802) :- p(6801), q(6801).
-p(6803) :- p(6802), q(6803).
-q(6803) :- p(6802), q(6802).
-p(6804) :- p(6803), q(6804).
-q(6804) :- p(6803), q(6803).
-p(6805) :- p(6804), q(6805).
-q(6805) :- p(6804), q(6804).
-p(6806) :- p(6805), q(6806).
-q(6806) :- p(6805), q(6805).
-p(6807) :- p(6806), q(6807).
-q(6807) :- p(6806), q(6806).
-p(6808) :- p(6807), q(6808).
-q(6808) :- p(6807), q(6807).
-p(6809) :- p(6808), q(6809).
-q(6809) :- p(6808), q(6808).
-p(6810) :- p(6809), q(6810).
-q(6810) :- p(6809), q(6809).
-p(6811) :- p(6810), q(6811).
-q(6811) :- p(6810), q(6810).
-p(6812) :- p(6811), q(6812).
-q(6812) :- p(6811), q(6811).
-p(6813) :- p(6812), q(6813).
-q(6813) :- p(6812), q(6812).
-p(6814) :- p(6813), q(6814).
-q(6814) :- p(6813), q(6813).
-p(6815) :- p(6814), q(6815).
-q(6815) :- p(6814), q(6814).
-p(6816) :- p(6815), q(6816).
-q(6816) :- p(6815), q(6815).
-p(6817) :- p(6816), q(6817).
-q(6817) :- p(6816), q(6816).
-p(6818) :- p(6817), q(6818).
-q(6818) :- p(6817), q(6817).
-p(6819) :- p(6818), q(6819).
-q(6819) :- p(6818), q(6818).
-p(6820) :- p(6819), q(6820).
-q(6820) :- p(6819), q(6819).
-p(6821) :- p(6820), q(6821).
-q(6821) :- p(6820), q(6820).
-p(6822) :- p(6821), q(6822).
-q(6822) :- p(6821), q(6821).
-p(6823) :- p(6822), q(6823).
-q(6823) :- p(6822), q(6822).
-p(6824) :- p(6823), q(6824).
-q(6824) :- p(6823), q(6823).
-p(6825) :- p(6824), q(6825).
-q(6825) :- p(6824), q(6824).
-p(6826) :- p(6825), q(6826).
-q(6826) :- p(6825), q(6825).
-p(6827) :- p(6826), q(6827).
-q(6827) :- p(6826), q(6826).
-p(6828) :- p(6827), q(6828).
-q(6828) :- p(6827), q(6827).
-p(6829) :- p(6828), q(6829).
-q(6829) :- p(6828), q(6828).
-p(6830) :- p(6829), q(6830).
-q(6830) :- p(6829), q(6829).
-p(6831) :- p(6830), q(6831).
-q(6831) :- p(6830), q(6830).
-p(6832) :- p(6831), q(6832).
-q(6832) :- p(6831), q(6831).
-p(6833) :- p(6832), q(6833).
-q(6833) :- p(6832), q(6832).
-p(6834) :- p(6833), q(6834).
-q(6834) :- p(6833), q(6833).
-p(6835) :- p(6834), q(6835).
-q(6835) :- p(6834), q(6834).
-p(6836) :- p(6835), q(6836).
-q(6836) :- p(6835), q(6835).
-p(6837) :- p(6836), q(6837).
-q(6837) :- p(6836), q(6836).
-p(6838) :- p(6837), q(6838).
-q(6838) :- p(6837), q(6837).
-p(6839) :- p(6838), q(6839).
-q(6839) :- p(6838), q(6838).
-p(6840) :- p(6839), q(6840).
-q(6840) :- p(6839), q(6839).
-p(6841) :- p(6840), q(6841).
-q(6841) :- p(6840), q(6840).
-p(6842) :- p(6841), q(6842).
-q(6842) :- p(6841), q(6841).
-p(6843) :- p(6842), q(6843).
-q(6843) :- p(6842), q(6842).
-p(6844) :- p(6843), q(6844).
-q(6844) :- p(6843), q(6843).
-p(6845) :- p(6844), q(6845).
-q(6845) :- p(6844), q(6844).
-p(6846) :- p(6845), q(6846).
-q(6846) :- p(6845), q(6845).
-p(6847) :- p(6846), q(6847).
-q(6847) :- p(6846), q(6846).
-p(6848) :- p(6847), q(6848).
-q(6848) :- p(6847), q(6847).
-p(6849) :- p(6848), q(6849).
-q(6849) :- p(6848), q(6848).
-p(6850) :- p(6849), q(6850).
-q(6850) :- p(6849), q(6849).
-p(6851) :- p(6850), q(6851).
-q(6851) :- p(6850), q(6850).
-p(6852) :- p(6851), q(6852).
-q(6852) :- p(6851), q(6851).
-p(6853) :- p(6852), q(6853).
-q(6853) :- p(6852), q(6852).
-p(6854) :- p(6853), q(6854).
-q(6854) :- p(6853), q(6853).
-p(6855) :- p(6854), q(6855).
-q(6855) :- p(6854), q(6854).
-p(6856) :- p(6855), q(6856).
-q(6856) :- p(6855), q(6855).
-p(6857) :- p(6856), q(6857).
-q(6857) :- p(6856), q(6856).
-p(6858) :- p(6857), q(6858).
-q(6858) :- p(6857), q(6857).
-p(6859) :- p(6858), q(6859).
-q(6859) :- p(6858), q(6858).
-p(6860) :- p(6859), q(6860).
-q(6860) :- p(6859), q(6859).
-p(6861) :- p(6860), q(6861).
-q(6861) :- p(6860), q(6860).
-p(6862) :- p(6861), q(6862).
-q(6862) :- p(6861), q(6861).
-p(6863) :- p(6862), q(6863).
-q(6863) :- p(6862), q(6862).
-p(6864) :- p(6863), q(6864).
-q(6864) :- p(6863), q(6863).
-p(6865) :- p(6864), q(6865).
-q(6865) :- p(6864), q(6864).
-p(6866) :- p(6865), q(6866).
-q(6866) :- p(6865), q(6865).
-p(6867) :- p(6866), q(6867).
-q(6867) :- p(6866), q(6866).
-p(6868) :- p(6867), q(6868).
-q(6868) :- p(6867), q(6867).
-p(6869) :- p(6868), q(6869).
-q(6869) :- p(6868), q(6868).
-p(6870) :- p(6869), q(6870).
-q(6870) :- p(6869), q(6869).
-p(6871) :- p(6870), q(6871).
-q(6871) :- p(6870), q(6870).
-p(6872) :- p(6871), q(6872).
-q(6872) :- p(6871), q(6871).
-p(6873) :- p(6872), q(6873).
-q(6873) :- p(6872), q(6872).
-p(6874) :- p(6873), q(6874).
-q(6874) :- p(6873), q(6873).
-p(6875) :- p(6874), q(6875).
-q(6875) :- p(6874), q(6874).
-p(6876) :- p(6875), q(6876).
-q(6876) :- p(6875), q(6875).
-p(6877) :- p(6876), q(6877).
-q(6877) :- p(6876), q(6876).
-p(6878) :- p(6877), q(6878).
-q(6878) :- p(6877), q(6877).
-p(6879) :- p(6878), q(6879).
-q(6879) :- p(6878), q(6878).
-p(6880) :- p(6879), q(6880).
-q(6880) :- p(6879), q(6879).
-p(6881) :- p(6880), q(6881).
-q(6881) :- p(6880), q(6880).
-p(6882) :- p(6881), q(6882).
-q(6882) :- p(6881), q(6881).
-p(6883) :- p(6882), q(6883).
-q(6883) :- p(6882), q(6882).
-p(6884) :- p(6883), q(6884).
-q(6884) :- p(6883), q(6883).
-p(6885) :- p(6884), q(6885).
-q(6885) :- p(6884), q(6884).
-p(6886) :- p(6885), q(6886).
-q(6886) :- p(6885), q(6885).
-p(6887) :- p(6886), q(6887).
-q(6887) :- p(6886), q(6886).
-p(6888) :- p(6887), q(6888).
-q(6888) :- p(6887), q(6887).
-p(6889) :- p(6888), q(6889).
-q(6889) :- p(6888), q(6888).
-p(6890) :- p(6889), q(6890).
-q(6890) :- p(6889), q(6889).
-p(6891) :- p(6890), q(6891).
-q(6891) :- p(6890), q(6890).
-p(6892) :- p(6891), q(6892).
-q(6892) :- p(6891), q(6891).
-p(6893) :- p(6892), q(6893).
-q(6893) :- p(6892), q(6892).
-p(6894) :- p(6893), q(6894).
-q(6894) :- p(6893), q(6893).
-p(6895) :- p(6894), q(6895).
-q(6895) :- p(6894), q(6894).
-p(6896) :- p(6895), q(6896).
-q(6896) :- p(6895), q(6895).
-p(6897) :- p(6896), q(6897).
-q(6897) :- p(6896), q(6896).
-p(6898) :- p(6897), q(6898).
-q(6898) :- p(6897), q(6897).
-p(6899) :- p(6898), q(6899).
-q(6899) :- p(6898), q(6898).
-p(6900) :- p(6899), q(6900).
-q(6900) :- p(6899), q(6899).
-p(6901) :- p(6900), q(6901).
-q(6901) :- p(6900), q(6900).
-p(6902) :- p(6901), q(6902).
-q(6902) :- p(6901), q(6901).
-p(6903) :- p(6902), q(6903).
-q(6903) :- p(6902), q(6902).
-p(6904) :- p(6903), q(6904).
-q(6904) :- p(6903), q(6903).
-p(6905) :- p(6904), q(6905).
-q(6905) :- p(6904), q(6904).
-p(6906) :- p(6905), q(6906).
-q(6906) :- p(6905), q(6905).
-p(6907) :- p(6906), q(6907).
-q(6907) :- p(6906), q(6906).
-p(6908) :- p(6907), q(6908).
-q(6908) :- p(6907), q(6907).
-p(6909) :- p(6908), q(6909).
-q(6909) :- p(6908), q(6908).
-p(6910) :- p(6909), q(6910).
-q(6910) :- p(6909), q(6909).
-p(6911) :- p(6910), q(6911).
-q(6911) :- p(6910), q(6910).
-p(6912) :- p(6911), q(6912).
-q(6912) :- p(6911), q(6911).
-p(6913) :- p(6912), q(6913).
-q(6913) :- p(6912), q(6912).
-p(6914) :- p(6913), q(6914).
-q(6914) :- p(6913), q(6913).
-p(6915) :- p(6914), q(6915).
-q(6915) :- p(6914), q(6914).
-p(6916) :- p(6915), q(6916).
-q(6916) :- p(6915), q(6915).
-p(6917) :- p(6916), q(6917).
-q(6917) :- p(6916), q(6916).
-p(6918) :- p(6917), q(6918).
-q(6918) :- p(6917), q(6917).
-p(6919) :- p(6918), q(6919).
-q(6919) :- p(6918), q(6918).
-p(6920) :- p(6919), q(6920).
-q(6920) :- p(6919), q(6919).
-p(6921) :- p(6920), q(6921).
-q(6921) :- p(6920), q(6920).
-p(6922) :- p(6921), q(6922).
-q(6922) :- p(6921), q(6921).
-p(6923) :- p(6922), q(6923).
-q(6923) :- p(6922), q(6922).
-p(6924) :- p(6923), q(6924).
-q(6924) :- p(6923), q(6923).
-p(6925) :- p(6924), q(6925).
-q(6925) :- p(6924), q(6924).
-p(6926) :- p(6925), q(6926).
-q(6926) :- p(6925), q(6925).
-p(6927) :- p(6926), q(6927).
-q(6927) :- p(6926), q(6926).
-p(6928) :- p(6927), q(6928).
-q(6928) :- p(6927), q(6927).
-p(6929) :- p(6928), q(6929).
-q(6929) :- p(6928), q(6928).
-p(6930) :- p(6929), q(6930).
-q(6930) :- p(6929), q(6929).
-p(6931) :- p(6930), q(6931).
-q(6931) :- p(6930), q(6930).
-p(6932) :- p(6931), q(6932).
-q(6932) :- p(6931), q(6931).
-p(6933) :- p(6932), q(6933).
-q(6933) :- p(6932), q(6932).
-p(6934) :- p(6933), q(6934).
-q(6934) :- p(6933), q(6933).
-p(6935) :- p(6934), q(6935).
-q(6935) :- p(6934), q(6934).
-p(6936) :- p(6935), q(6936).
-q(6936) :- p(6935), q(6935).
-p(6937) :- p(6936), q(6937).
-q(6937) :- p(6936), q(6936).
-p(6938) :- p(6937), q(6938).
-q(6938) :- p(6937), q(6937).
-p(6939) :- p(6938), q(6939).
-q(6939) :- p(6938), q(6938).
-p(6940) :- p(6939), q(6940).
-q(6940) :- p(6939), q(6939).
-p(6941) :- p(6940), q(6941).
-q(6941) :- p(6940), q(6940).
-p(6942) :- p(6941), q(6942).
-q(6942) :- p(6941), q(6941).
-p(6943) :- p(6942), q(6943).
-q(6943) :- p(6942), q(6942).
-p(6944) :- p(6943), q(6944).
-q(6944) :- p(6943), q(6943).
-p(6945) :- p(6944), q(6945).
-q(6945) :- p(6944), q(6944).
-p(6946) :- p(6945), q(6946).
-q(6946) :- p(6945), q(6945).
-p(6947) :- p(6946), q(6947).
-q(6947) :- p(6946), q(6946).
-p(6948) :- p(6947), q(6948).
-q(6948) :- p(6947), q(6947).
-p(6949) :- p(6948), q(6949).
-q(6949) :- p(6948), q(6948).
-p(6950) :- p(6949), q(6950).
-q(6950) :- p(6949), q(6949).
-p(6951) :- p(6950), q(6951).
-q(6951) :- p(6950), q(6950).
-p(6952) :- p(6951), q(6952).
-q(6952) :- p(6951), q(6951).
-p(6953) :- p(6952), q(6953).
-q(6953) :- p(6952), q(6952).
-p(6954) :- p(6953), q(6954).
-q(6954) :- p(6953), q(6953).
-p(6955) :- p(6954), q(6955).
-q(6955) :- p(6954), q(6954).
-p(6956) :- p(6955), q(6956).
-q(6956) :- p(6955), q(6955).
-p(6957) :- p(6956), q(6957).
-q(6957) :- p(6956), q(6956).
-p(6958) :- p(6957), q(6958).
-q(6958) :- p(6957), q(6957).
-p(6959) :- p(6958), q(6959).
-q(6959) :- p(6958), q(6958).
-p(6960) :- p(6959), q(6960).
-q(6960) :- p(6959), q(6959).
-p(6961) :- p(6960), q(6961).
-q(6961) :- p(6960), q(6960).
-p(6962) :- p(6961), q(6962).
-q(6962) :- p(6961), q(6961).
-p(6963) :- p(6962), q(6963).
-q(6963) :- p(6962), q(6962).
-p(6964) :- p(6963), q(6964).
-q(6964) :- p(6963), q(6963).
-p(6965) :- p(6964), q(6965).
-q(6965) :- p(6964), q(6964).
-p(6966) :- p(6965), q(6966).
-q(6966) :- p(6965), q(6965).
-p(6967) :- p(6966), q(6967).
-q(6967) :- p(6966), q(6966).
-p(6968) :- p(6967), q(6968).
-q(6968) :- p(6967), q(6967).
-p(6969) :- p(6968), q(6969).
-q(6969) :- p(6968), q(6968).
-p(6970) :- p(6969), q(6970).
-q(6970) :- p(6969), q(6969).
-p(6971) :- p(6970), q(6971).
-q(6971) :- p(6970), q(6970).
-p(6972) :- p(6971), q(6972).
-q(6972) :- p(6971), q(6971).
-p(6973) :- p(6972), q(6973).
-q(6973) :- p(6972), q(6972).
-p(6974) :- p(6973), q(6974).
-q(6974) :- p(6973), q(6973).
-p(6975) :- p(6974), q(6975).
-q(6975) :- p(6974), q(6974).
-p(6976) :- p(6975), q(6976).
-q(6976) :- p(6975), q(6975).
-p(6977) :- p(6976), q(6977).
-q(6977) :- p(6976), q(6976).
-p(6978) :- p(6977), q(6978).
-q(6978) :- p(6977), q(6977).
-p(6979) :- p(6978), q(6979).
-q(6979) :- p(6978), q(6978).
-p(6980) :- p(6979), q(6980).
-q(6980) :- p(6979), q(6979).
-p(6981) :- p(6980), q(6981).
-q(6981) :- p(6980), q(6980).
-p(6982) :- p(6981), q(6982).
-q(6982) :- p(6981), q(6981).
-p(6983) :- p(6982), q(6983).
-q(6983) :- p(6982), q(6982).
-p(6984) :- p(6983), q(6984).
-q(6984) :- p(6983), q(6983).
-p(6985) :- p(6984), q(6985).
-q(6985) :- p(6984), q(6984).
-p(6986) :- p(6985), q(6986).
-q(6986) :- p(6985), q(6985).
-p(6987) :- p(6986), q(6987).
-q(6987) :- p(6986), q(6986).
-p(6988) :- p(6987), q(6988).
-q(6988) :- p(6987), q(6987).
-p(6989) :- p(6988), q(6989).
-q(6989) :- p(6988), q(6988).
-p(6990) :- p(6989), q(6990).
-q(6990) :- p(6989), q(6989).
-p(6991) :- p(6990), q(6991).
-q(6991) :- p(6990), q(6990).
-p(6992) :- p(6991), q(6992).
-q(6992) :- p(6991), q(6991).
-p(6993) :- p(6992), q(6993).
-q(6993) :- p(6992), q(6992).
-p(6994) :- p(6993), q(6994).
-q(6994) :- p(6993), q(6993).
-p(6995) :- p(6994), q(6995).
-q(6995) :- p(6994), q(6994).
-p(6996) :- p(6995), q(6996).
-q(6996) :- p(6995), q(6995).
-p(6997) :- p(6996), q(6997).
-q(6997) :- p(6996), q(6996).
-p(6998) :- p(6997), q(6998).
-q(6998) :- p(6997), q(6997).
-p(6999) :- p(6998), q(6999).
-q(6999) :- p(6998), q(6998).
-p(7000) :- p(6999), q(7000).
-q(7000) :- p(6999), q(6999).
-p(7001) :- p(7000), q(7001).
-q(7001) :- p(7000), q(7000).
-p(7002) :- p(7001), q(7002).
-q(7002) :- p(7001), q(7001).
-p(7003) :- p(7002), q(7003).
-q(7003) :- p(7002), q(7002).
-p(7004) :- p(7003), q(7004).
-q(7004) :- p(7003), q(7003).
-p(7005) :- p(7004), q(7005).
-q(7005) :- p(7004), q(7004).
-p(7006) :- p(7005), q(7006).
-q(7006) :- p(7005), q(7005).
-p(7007) :- p(7006), q(7007).
-q(7007) :- p(7006), q(7006).
-p(7008) :- p(7007), q(7008).
-q(7008) :- p(7007), q(7007).
-p(7009) :- p(7008), q(7009).
-q(7009) :- p(7008), q(7008).
-p(7010) :- p(7009), q(7010).
-q(7010) :- p(7009), q(7009).
-p(7011) :- p(7010), q(7011).
-q(7011) :- p(7010), q(7010).
-p(7012) :- p(7011), q(7012).
-q(7012) :- p(7011), q(7011).
-p(7013) :- p(7012), q(7013).
-q(7013) :- p(7012), q(7012).
-p(7014) :- p(7013), q(7014).
-q(7014) :- p(7013), q(7013).
-p(7015) :- p(7014), q(7015).
-q(7015) :- p(7014), q(7014).
-p(7016) :- p(7015), q(7016).
-q(7016) :- p(7015), q(7015).
-p(7017) :- p(7016), q(7017).
-q(7017) :- p(7016), q(7016).
-p(7018) :- p(7017), q(7018).
-q(7018) :- p(7017), q(7017).
-p(7019) :- p(7018), q(7019).
-q(7019) :- p(7018), q(7018).
-p(7020) :- p(7019), q(7020).
-q(7020) :- p(7019), q(7019).
-p(7021) :- p(7020), q(7021).
-q(7021) :- p(7020), q(7020).
-p(7022) :- p(7021), q(7022).
-q(7022) :- p(7021), q(7021).
-p(7023) :- p(7022), q(7023).
-q(7023) :- p(7022), q(7022).
-p(7024) :- p(7023), q(7024).
-q(7024) :- p(7023), q(7023).
-p(7025) :- p(7024), q(7025).
-q(7025) :- p(7024), q(7024).
-p(7026) :- p(7025), q(7026).
-q(7026) :- p(7025), q(7025).
-p(7027) :- p(7026), q(7027).
-q(7027) :- p(7026), q(7026).
-p(7028) :- p(7027), q(7028).
-q(7028) :- p(7027), q(7027).
-p(7029) :- p(7028), q(7029).
-q(7029) :- p(7028), q(7028).
-p(7030) :- p(7029), q(7030).
-q(7030) :- p(7029), q(7029).
-p(7031) :- p(7030), q(7031).
-q(7031) :- p(7030), q(7030).
-p(7032) :- p(7031), q(7032).
-q(7032) :- p(7031), q(7031).
-p(7033) :- p(7032), q(7033).
-q(7033) :- p(7032), q(7032).
-p(7034) :- p(7033), q(7034).
-q(7034) :- p(7033), q(7033).
-p(7035) :- p(7034), q(7035).
-q(7035) :- p(7034), q(7034).
-p(7036) :- p(7035), q(7036).
-q(7036) :- p(7035), q(7035).
-p(7037) :- p(7036), q(7037).
-q(7037) :- p(7036), q(7036).
-p(7038) :- p(7037), q(7038).
-q(7038) :- p(7037), q(7037).
-p(7039) :- p(7038), q(7039).
-q(7039) :- p(7038), q(7038).
-p(7040) :- p(7039), q(7040).
-q(7040) :- p(7039), q(7039).
-p(7041) :- p(7040), q(7041).
-q(7041) :- p(7040), q(7040).
-p(7042) :- p(7041), q(7042).
-q(7042) :- p(7041), q(7041).
-p(7043) :- p(7042), q(7043).
-q(7043) :- p(7042), q(7042).
-p(7044) :- p(7043), q(7044).
-q(7044) :- p(7043), q(7043).
-p(7045) :- p(7044), q(7045).
-q(7045) :- p(7044), q(7044).
-p(7046) :- p(7045), q(7046).
-q(7046) :- p(7045), q(7045).
-p(7047) :- p(7046), q(7047).
-q(7047) :- p(7046), q(7046).
-p(7048) :- p(7047), q(7048).
-q(7048) :- p(7047), q(7047).
-p(7049) :- p(7048), q(7049).
-q(7049) :- p(7048), q(7048).
-p(7050) :- p(7049), q(7050).
-q(7050) :- p(7049), q(7049).
-p(7051) :- p(7050), q(7051).
-q(7051) :- p(7050), q(7050).
-p(7052) :- p(7051), q(7052).
-q(7052) :- p(7051), q(7051).
-p(7053) :- p(7052), q(7053).
-q(7053) :- p(7052), q(7052).
-p(7054) :- p(7053), q(7054).
-q(7054) :- p(7053), q(7053).
-p(7055) :- p(7054), q(7055).
-q(7055) :- p(7054), q(7054).
-p(7056) :- p(7055), q(7056).
-q(7056) :- p(7055), q(7055).
-p(7057) :- p(7056), q(7057).
-q(7057) :- p(7056), q(7056).
-p(7058) :- p(7057), q(7058).
-q(7058) :- p(7057), q(7057).
-p(7059) :- p(7058), q(7059).
-q(7059) :- p(7058), q(7058).
-p(7060) :- p(7059), q(7060).
-q(7060) :- p(7059), q(7059).
-p(7061) :- p(7060), q(7061).
-q(7061) :- p(7060), q(7060).
-p(7062) :- p(7061), q(7062).
-q(7062) :- p(7061), q(7061).
-p(7063) :- p(7062), q(7063).
-q(7063) :- p(7062), q(7062).
-p(7064) :- p(7063), q(7064).
-q(7064) :- p(7063), q(7063).
-p(7065) :- p(7064), q(7065).
-q(7065) :- p(7064), q(7064).
-p(7066) :- p(7065), q(7066).
-q(7066) :- p(7065), q(7065).
-p(7067) :- p(7066), q(7067).
-q(7067) :- p(7066), q(7066).
-p(7068) :- p(7067), q(7068).
-q(7068) :- p(7067), q(7067).
-p(7069) :- p(7068), q(7069).
-q(7069) :- p(7068), q(7068).
-p(7070) :- p(7069), q(7070).
-q(7070) :- p(7069), q(7069).
-p(7071) :- p(7070), q(7071).
-q(7071) :- p(7070), q(7070).
-p(7072) :- p(7071), q(7072).
-q(7072) :- p(7071), q(7071).
-p(7073) :- p(7072), q(7073).
-q(7073) :- p(7072), q(7072).
-p(7074) :- p(7073), q(7074).
-q(7074) :- p(7073), q(7073).
-p(7075) :- p(7074), q(7075).
-q(7075) :- p(7074), q(7074).
-p(7076) :- p(7075), q(7076).
-q(7076) :- p(7075), q(7075).
-p(7077) :- p(7076), q(7077).
-q(7077) :- p(7076), q(7076).
-p(7078) :- p(7077), q(7078).
-q(7078) :- p(7077), q(7077).
-p(7079) :- p(7078), q(7079).
-q(7079) :- p(7078), q(7078).
-p(7080) :- p(7079), q(7080).
-q(7080) :- p(7079), q(7079).
-p(7081) :- p(7080), q(7081).
-q(7081) :- p(7080), q(7080).
-p(7082) :- p(7081), q(7082).
-q(7082) :- p(7081), q(7081).
-p(7083) :- p(7082), q(7083).
-q(7083) :- p(7082), q(7082).
-p(7084) :- p(7083), q(7084).
-q(7084) :- p(7083), q(7083).
-p(7085) :- p(7084), q(7085).
-q(7085) :- p(7084), q(7084).
-p(7086) :- p(7085), q(7086).
-q(7086) :- p(7085), q(7085).
-p(7087) :- p(7086), q(7087).
-q(7087) :- p(7086), q(7086).
-p(7088) :- p(7087), q(7088).
-q(7088) :- p(7087), q(7087).
-p(7089) :- p(7088), q(7089).
-q(7089) :- p(7088), q(7088).
-p(7090) :- p(7089), q(7090).
-q(7090) :- p(7089), q(7089).
-p(7091) :- p(7090), q(7091).
-q(7091) :- p(7090), q(7090).
-p(7092) :- p(7091), q(7092).
-q(7092) :- p(7091), q(7091).
-p(7093) :- p(7092), q(7093).
-q(7093) :- p(7092), q(7092).
-p(7094) :- p(7093), q(7094).
-q(7094) :- p(7093), q(7093).
-p(7095) :- p(7094), q(7095).
-q(7095) :- p(7094), q(7094).
-p(7096) :- p(7095), q(7096).
-q(7096) :- p(7095), q(7095).
-p(7097) :- p(7096), q(7097).
-q(7097) :- p(7096), q(7096).
-p(7098) :- p(7097), q(7098).
-q(7098) :- p(7097), q(7097).
-p(7099) :- p(7098), q(7099).
-q(7099) :- p(7098), q(7098).
-p(7100) :- p(7099), q(7100).
-q(7100) :- p(7099), q(7099).
-p(7101) :- p(7100), q(7101).
-q(7101) :- p(7100), q(7100).
-p(7102) :- p(7101), q(7102).
-q(7102) :- p(7101), q(7101).
-p(7103) :- p(7102), q(7103).
-q(7103) :- p(7102), q(7102).
-p(7104) :- p(7103), q(7104).
-q(7104) :- p(7103), q(7103).
-p(7105) :- p(7104), q(7105).
-q(7105) :- p(7104), q(7104).
-p(7106) :- p(7105), q(7106).
-q(7106) :- p(7105), q(7105).
-p(7107) :- p(7106), q(7107).
-q(7107) :- p(7106), q(7106).
-p(7108) :- p(7107), q(7108).
-q(7108) :- p(7107), q(7107).
-p(7109) :- p(7108), q(7109).
-q(7109) :- p(7108), q(7108).
-p(7110) :- p(7109), q(7110).
-q(7110) :- p(7109), q(7109).
-p(7111) :- p(7110), q(7111).
-q(7111) :- p(7110), q(7110).
-p(7112) :- p(7111), q(7112).
-q(7112) :- p(7111), q(7111).
-p(7113) :- p(7112), q(7113).
-q(7113) :- p(7112), q(7112).
-p(7114) :- p(7113), q(7114).
-q(7114) :- p(7113), q(7113).
-p(7115) :- p(7114), q(7115).
-q(7115) :- p(7114), q(7114).
-p(7116) :- p(7115), q(7116).
-q(7116) :- p(7115), q(7115).
-p(7117) :- p(7116), q(7117).
-q(7117) :- p(7116), q(7116).
-p(7118) :- p(7117), q(7118).
-q(7118) :- p(7117), q(7117).
-p(7119) :- p(7118), q(7119).
-q(7119) :- p(7118), q(7118).
-p(7120) :- p(7119), q(7120).
-q(7120) :- p(7119), q(7119).
-p(7121) :- p(7120), q(7121).
-q(7121) :- p(7120), q(7120).
-p(7122) :- p(7121), q(7122).
-q(7122) :- p(7121), q(7121).
-p(7123) :- p(7122), q(7123).
-q(7123) :- p(7122), q(7122).
-p(7124) :- p(7123), q(7124).
-q(7124) :- p(7123), q(7123).
-p(7125) :- p(7124), q(7125).
-q(7125) :- p(7124), q(7124).
-p(7126) :- p(7125), q(7126).
-q(7126) :- p(7125), q(7125).
-p(7127) :- p(7126), q(7127).
-q(7127) :- p(7126), q(7126).
-p(7128) :- p(7127), q(7128).
-q(7128) :- p(7127), q(7127).
-p(7129) :- p(7128), q(7129).
-q(7129) :- p(7128), q(7128).
-p(7130) :- p(7129), q(7130).
-q(7130) :- p(7129), q(7129).
-p(7131) :- p(7130), q(7131).
-q(7131) :- p(7130), q(7130).
-p(7132) :- p(7131), q(7132).
-q(7132) :- p(7131), q(7131).
-p(7133) :- p(7132), q(7133).
-q(7133) :- p(7132), q(7132).
-p(7134) :- p(7133), q(7134).
-q(7134) :- p(7133), q(7133).
-p(7135) :- p(7134), q(7135).
-q(7135) :- p(7134), q(7134).
-p(7136) :- p(7135), q(7136).
-q(7136) :- p(7135), q(7135).
-p(7137) :- p(7136), q(7137).
-q(7137) :- p(7136), q(7136).
-p(7138) :- p(7137), q(7138).
-q(7138) :- p(7137), q(7137).
-p(7139) :- p(7138), q(7139).
-q(7139) :- p(7138), q(7138).
-p(7140) :- p(7139), q(7140).
-q(7140) :- p(7139), q(7139).
-p(7141) :- p(7140), q(7141).
-q(7141) :- p(7140), q(7140).
-p(7142) :- p(7141), q(7142).
-q(7142) :- p(7141), q(7141).
-p(7143) :- p(7142), q(7143).
-q(7143) :- p(7142), q(7142).
-p(7144) :- p(7143), q(7144).
-q(7144) :- p(7143), q(7143).
-p(7145) :- p(7144), q(7145).
-q(7145) :- p(7144), q(7144).
-p(7146) :- p(7145), q(7146).
-q(7146) :- p(7145), q(7145).
-p(7147) :- p(7146), q(7147).
-q(7147) :- p(7146), q(7146).
-p(7148) :- p(7147), q(7148).
-q(7148) :- p(7147), q(7147).
-p(7149) :- p(7148), q(7149).
-q(7149) :- p(7148), q(7148).
-p(7150) :- p(7149), q(7150).
-q(7150) :- p(7149), q(7149).
-p(7151) :- p(7150), q(7151).
-q(7151) :- p(7150), q(7150).
-p(7152) :- p(7151), q(7152).
-q(7152) :- p(7151), q(7151).
-p(7153) :- p(7152), q(7153).
-q(7153) :- p(7152), q(7152).
-p(7154) :- p(7153), q(7154).
-q(7154) :- p(7153), q(7153).
-p(7155) :- p(7154), q(7155).
-q(7155) :- p(7154), q(7154).
-p(7156) :- p(7155), q(7156).
-q(7156) :- p(7155), q(7155).
-p(7157) :- p(7156), q(7157).
-q(7157) :- p(7156), q(7156).
-p(7158) :- p(7157), q(7158).
-q(7158) :- p(7157), q(7157).
-p(7159) :- p(7158), q(7159).
-q(7159) :- p(7158), q(7158).
-p(7160) :- p(7159), q(7160).
-q(7160) :- p(7159), q(7159).
-p(7161) :- p(7160), q(7161).
-q(7161) :- p(7160), q(7160).
-p(7162) :- p(7161), q(7162).
-q(7162) :- p(7161), q(7161).
-p(7163) :- p(7162), q(7163).
-q(7163) :- p(7162), q(7162).
-p(7164) :- p(7163), q(7164).
-q(7164) :- p(7163), q(7163).
-p(7165) :- p(7164), q(7165).
-q(7165) :- p(7164), q(7164).
-p(7166) :- p(7165), q(7166).
-q(7166) :- p(7165), q(7165).
-p(7167) :- p(7166), q(7167).
-q(7167) :- p(7166), q(7166).
-p(7168) :- p(7167), q(7168).
-q(7168) :- p(7167), q(7167).
-p(7169) :- p(7168), q(7169).
-q(7169) :- p(7168), q(7168).
-p(7170) :- p(7169), q(7170).
-q(7170) :- p(7169), q(7169).
-p(7171) :- p(7170), q(7171).
-q(7171) :- p(7170), q(7170).
-p(7172) :- p(7171), q(7172).
-q(7172) :- p(7171), q(7171).
-p(7173) :- p(7172), q(7173).
-q(7173) :- p(7172), q(7172).
-p(7174) :- p(7173), q(7174).
-q(7174) :- p(7173), q(7173).
-p(7175) :- p(7174), q(7175).
-q(7175) :- p(7174), q(7174).
-p(7176) :- p(7175), q(7176).
-q(7176) :- p(7175), q(7175).
-p(7177) :- p(7176), q(7177).
-q(7177) :- p(7176), q(7176).
-p(7178) :- p(7177), q(7178).
-q(7178) :- p(7177), q(7177).
-p(7179) :- p(7178), q(7179).
-q(7179) :- p(7178), q(7178).
-p(7180) :- p(7179), q(7180).
-q(7180) :- p(7179), q(7179).
-p(7181) :- p(7180), q(7181).
-q(7181) :- p(7180), q(7180).
-p(7182) :- p(7181), q(7182).
-q(7182) :- p(7181), q(7181).
-p(7183) :- p(7182), q(7183).
-q(7183) :- p(7182), q(7182).
-p(7184) :- p(7183), q(7184).
-q(7184) :- p(7183), q(7183).
-p(7185) :- p(7184), q(7185).
-q(7185) :- p(7184), q(7184).
-p(7186) :- p(7185), q(7186).
-q(7186) :- p(7185), q(7185).
-p(7187) :- p(7186), q(7187).
-q(7187) :- p(7186), q(7186).
-p(7188) :- p(7187), q(7188).
-q(7188) :- p(7187), q(7187).
-p(7189) :- p(7188), q(7189).
-q(7189) :- p(7188), q(7188).
-p(7190) :- p(7189), q(7190).
-q(7190) :- p(7189), q(7189).
-p(7191) :- p(7190), q(7191).
-q(7191) :- p(7190), q(7190).
-p(7192) :- p(7191), q(7192).
-q(7192) :- p(7191), q(7191).
-p(7193) :- p(7192), q(7193).
-q(7193) :- p(7192), q(7192).
-p(7194) :- p(7193), q(7194).
-q(7194) :- p(7193), q(7193).
-p(7195) :- p(7194), q(7195).
-q(7195) :- p(7194), q(7194).
-p(7196) :- p(7195), q(7196).
-q(7196) :- p(7195), q(7195).
-p(7197) :- p(7196), q(7197).
-q(7197) :- p(7196), q(7196).
-p(7198) :- p(7197), q(7198).
-q(7198) :- p(7197), q(7197).
-p(7199) :- p(7198), q(7199).
-q(7199) :- p(7198), q(7198).
-p(7200) :- p(7199), q(7200).
-q(7200) :- p(7199), q(7199).
-p(7201) :- p(7200), q(7201).
-q(7201) :- p(7200), q(7200).
-p(7202) :- p(7201), q(7202).
-q(7202) :- p(7201), q(7201).
-p(7203) :- p(7202), q(7203).
-q(7203) :- p(7202), q(7202).
-p(7204) :- p(7203), q(7204).
-q(7204) :- p(7203), q(7203).
-p(7205) :- p(7204), q(7205).
-q(7205) :- p(7204), q(7204).
-p(7206) :- p(7205), q(7206).
-q(7206) :- p(7205), q(7205).
-p(7207) :- p(7206), q(7207).
-q(7207) :- p(7206), q(7206).
-p(7208) :- p(7207), q(7208).
-q(7208) :- p(7207), q(7207).
-p(7209) :- p(7208), q(7209).
-q(7209) :- p(7208), q(7208).
-p(7210) :- p(7209), q(7210).
-q(7210) :- p(7209), q(7209).
-p(7211) :- p(7210), q(7211).
-q(7211) :- p(7210), q(7210).
-p(7212) :- p(7211), q(7212).
-q(7212) :- p(7211), q(7211).
-p(7213) :- p(7212), q(7213).
-q(7213) :- p(7212), q(7212).
-p(7214) :- p(7213), q(7214).
-q(7214) :- p(7213), q(7213).
-p(7215) :- p(7214), q(7215).
-q(7215) :- p(7214), q(7214).
-p(7216) :- p(7215), q(7216).
-q(7216) :- p(7215), q(7215).
-p(7217) :- p(7216), q(7217).
-q(7217) :- p(7216), q(7216).
-p(7218) :- p(7217), q(7218).
-q(7218) :- p(7217), q(7217).
-p(7219) :- p(7218), q(7219).
-q(7219) :- p(7218), q(7218).
-p(7220) :- p(7219), q(7220).
-q(7220) :- p(7219), q(7219).
-p(7221) :- p(7220), q(7221).
-q(7221) :- p(7220), q(7220).
-p(7222) :- p(7221), q(7222).
-q(7222) :- p(7221), q(7221).
-p(7223) :- p(7222), q(7223).
-q(7223) :- p(7222), q(7222).
-p(7224) :- p(7223), q(7224).
-q(7224) :- p(7223), q(7223).
-p(7225) :- p(7224), q(7225).
-q(7225) :- p(7224), q(7224).
-p(7226) :- p(7225), q(7226).
-q(7226) :- p(7225), q(7225).
-p(7227) :- p(7226), q(7227).
-q(7227) :- p(7226), q(7226).
-p(7228) :- p(7227), q(7228).
-q(7228) :- p(7227), q(7227).
-p(7229) :- p(7228), q(7229).
-q(7229) :- p(7228), q(7228).
-p(7230) :- p(7229), q(7230).
-q(7230) :- p(7229), q(7229).
-p(7231) :- p(7230), q(7231).
-q(7231) :- p(7230), q(7230).
-p(7232) :- p(7231), q(7232).
-q(7232) :- p(7231), q(7231).
-p(7233) :- p(7232), q(7233).
-q(7233) :- p(7232), q(7232).
-p(7234) :- p(7233), q(7234).
-q(7234) :- p(7233), q(7233).
-p(7235) :- p(7234), q(7235).
-q(7235) :- p(7234), q(7234).
-p(7236) :- p(7235), q(7236).
-q(7236) :- p(7235), q(7235).
-p(7237) :- p(7236), q(7237).
-q(7237) :- p(7236), q(7236).
-p(7238) :- p(7237), q(7238).
-q(7238) :- p(7237), q(7237).
-p(7239) :- p(7238), q(7239).
-q(7239) :- p(7238), q(7238).
-p(7240) :- p(7239), q(7240).
-q(7240) :- p(7239), q(7239).
-p(7241) :- p(7240), q(7241).
-q(7241) :- p(7240), q(7240).
-p(7242) :- p(7241), q(7242).
-q(7242) :- p(7241), q(7241).
-p(7243) :- p(7242), q(7243).
-q(7243) :- p(7242), q(7242).
-p(7244) :- p(7243), q(7244).
-q(7244) :- p(7243), q(7243).
-p(7245) :- p(7244), q(7245).
-q(7245) :- p(7244), q(7244).
-p(7246) :- p(7245), q(7246).
-q(7246) :- p(7245), q(7245).
-p(7247) :- p(7246), q(7247).
-q(7247) :- p(7246), q(7246).
-p(7248) :- p(7247), q(7248).
-q(7248) :- p(7247), q(7247).
-p(7249) :- p(7248), q(7249).
-q(7249) :- p(7248), q(7248).
-p(7250) :- p(7249), q(7250).
-q(7250) :- p(7249), q(7249).
-p(7251) :- p(7250), q(7251).
-q(7251) :- p(7250), q(7250).
-p(7252) :- p(7251), q(7252).
-q(7252) :- p(7251), q(7251).
-p(7253) :- p(7252), q(7253).
-q(7253) :- p(7252), q(7252).
-p(7254) :- p(7253), q(7254).
-q(7254) :- p(7253), q(7253).
-p(7255) :- p(7254), q(7255).
-q(7255) :- p(7254), q(7254).
-p(7256) :- p(7255), q(7256).
-q(7256) :- p(7255), q(7255).
-p(7257) :- p(7256), q(7257).
-q(7257) :- p(7256), q(7256).
-p(7258) :- p(7257), q(7258).
-q(7258) :- p(7257), q(7257).
-p(7259) :- p(7258), q(7259).
-q(7259) :- p(7258), q(7258).
-p(7260) :- p(7259), q(7260).
-q(7260) :- p(7259), q(7259).
-p(7261) :- p(7260), q(7261).
-q(7261) :- p(7260), q(7260).
-p(7262) :- p(7261), q(7262).
-q(7262) :- p(7261), q(7261).
-p(7263) :- p(7262), q(7263).
-q(7263) :- p(7262), q(7262).
-p(7264) :- p(7263), q(7264).
-q(7264) :- p(7263), q(7263).
-p(7265) :- p(7264), q(7265).
-q(7265) :- p(7264), q(7264).
-p(7266) :- p(7265), q(7266).
-q(7266) :- p(7265), q(7265).
-p(7267) :- p(7266), q(7267).
-q(7267) :- p(7266), q(7266).
-p(7268) :- p(7267), q(7268).
-q(7268) :- p(7267), q(7267).
-p(7269) :- p(7268), q(7269).
-q(7269) :- p(7268), q(7268).
-p(7270) :- p(7269), q(7270).
-q(7270) :- p(7269), q(7269).
-p(7271) :- p(7270), q(7271).
-q(7271) :- p(7270), q(7270).
-p(7272) :- p(7271), q(7272).
-q(7272) :- p(7271), q(7271).
-p(7273) :- p(7272), q(7273).
-q(7273) :- p(7272), q(7272).
-p(7274) :- p(7273), q(7274).
-q(7274) :- p(7273), q(7273).
-p(7275) :- p(7274), q(7275).
-q(7275) :- p(7274), q(7274).
-p(7276) :- p(7275), q(7276).
-q(7276) :- p(7275), q(7275).
-p(7277) :- p(7276), q(7277).
-q(7277) :- p(7276), q(7276).
-p(7278) :- p(7277), q(7278).
-q(7278) :- p(7277), q(7277).
-p(7279) :- p(7278), q(7279).
-q(7279) :- p(7278), q(7278).
-p(7280) :- p(7279), q(7280).
-q(7280) :- p(7279), q(7279).
-p(7281) :- p(7280), q(7281).
-q(7281) :- p(7280), q(7280).
-p(7282) :- p(7281), q(7282).
-q(7282) :- p(7281), q(7281).
-p(7283) :- p(7282), q(7283).
-q(7283) :- p(7282), q(7282).
-p(7284) :- p(7283), q(7284).
-q(7284) :- p(7283), q(7283).
-p(7285) :- p(7284), q(7285).
-q(7285) :- p(7284), q(7284).
-p(7286) :- p(7285), q(7286).
-q(7286) :- p(7285), q(7285).
-p(7287) :- p(7286), q(7287).
-q(7287) :- p(7286), q(7286).
-p(7288) :- p(7287), q(7288).
-q(7288) :- p(7287), q(7287).
-p(7289) :- p(7288), q(7289).
-q(7289) :- p(7288), q(7288).
-p(7290) :- p(7289), q(7290).
-q(7290) :- p(7289), q(7289).
-p(7291) :- p(7290), q(7291).
-q(7291) :- p(7290), q(7290).
-p(7292) :- p(7291), q(7292).
-q(7292) :- p(7291), q(7291).
-p(7293) :- p(7292), q(7293).
-q(7293) :- p(7292), q(7292).
-p(7294) :- p(7293), q(7294).
-q(7294) :- p(7293), q(7293).
-p(7295) :- p(7294), q(7295).
-q(7295) :- p(7294), q(7294).
-p(7296) :- p(7295), q(7296).
-q(7296) :- p(7295), q(7295).
-p(7297) :- p(7296), q(7297).
-q(7297) :- p(7296), q(7296).
-p(7298) :- p(7297), q(7298).
-q(7298) :- p(7297), q(7297).
-p(7299) :- p(7298), q(7299).
-q(7299) :- p(7298), q(7298).
-p(7300) :- p(7299), q(7300).
-q(7300) :- p(7299), q(7299).
-p(7301) :- p(7300), q(7301).
-q(7301) :- p(7300), q(7300).
-p(7302) :- p(7301), q(7302).
-q(7302) :- p(7301), q(7301).
-p(7303) :- p(7302), q(7303).
-q(7303) :- p(7302), q(7302).
-p(7304) :- p(7303), q(7304).
-q(7304) :- p(7303), q(7303).
-p(7305) :- p(7304), q(7305).
-q(7305) :- p(7304), q(7304).
-p(7306) :- p(7305), q(7306).
-q(7306) :- p(7305), q(7305).
-p(7307) :- p(7306), q(7307).
-q(7307) :- p(7306), q(7306).
-p(7308) :- p(7307), q(7308).
-q(7308) :- p(7307), q(7307).
-p(7309) :- p(7308), q(7309).
-q(7309) :- p(7308), q(7308).
-p(7310) :- p(7309), q(7310).
-q(7310) :- p(7309), q(7309).
-p(7311) :- p(7310), q(7311).
-q(7311) :- p(7310), q(7310).
-p(7312) :- p(7311), q(7312).
-q(7312) :- p(7311), q(7311).
-p(7313) :- p(7312), q(7313).
-q(7313) :- p(7312), q(7312).
-p(7314) :- p(7313), q(7314).
-q(7314) :- p(7313), q(7313).
-p(7315) :- p(7314), q(7315).
-q(7315) :- p(7314), q(7314).
-p(7316) :- p(7315), q(7316).
-q(7316) :- p(7315), q(7315).
-p(7317) :- p(7316), q(7317).
-q(7317) :- p(7316), q(7316).
-p(7318) :- p(7317), q(7318).
-q(7318) :- p(7317), q(7317).
-p(7319) :- p(7318), q(7319).
-q(7319) :- p(7318), q(7318).
-p(7320) :- p(7319), q(7320).
-q(7320) :- p(7319), q(7319).
-p(7321) :- p(7320), q(7321).
-q(7321) :- p(7320), q(7320).
-p(7322) :- p(7321), q(7322).
-q(7322) :- p(7321), q(7321).
-p(7323) :- p(7322), q(7323).
-q(7323) :- p(7322), q(7322).
-p(7324) :- p(7323), q(7324).
-q(7324) :- p(7323), q(7323).
-p(7325) :- p(7324), q(7325).
-q(7325) :- p(7324), q(7324).
-p(7326) :- p(7325), q(7326).
-q(7326) :- p(7325), q(7325).
-p(7327) :- p(7326), q(7327).
-q(7327) :- p(7326), q(7326).
-p(7328) :- p(7327), q(7328).
-q(7328) :- p(7327), q(7327).
-p(7329) :- p(7328), q(7329).
-q(7329) :- p(7328), q(7328).
-p(7330) :- p(7329), q(7330).
-q(7330) :- p(7329), q(7329).
-p(7331) :- p(7330), q(7331).
-q(7331) :- p(7330), q(7330).
-p(7332) :- p(7331), q(7332).
-q(7332) :- p(7331), q(7331).
-p(7333) :- p(7332), q(7333).
-q(7333) :- p(7332), q(7332).
-p(7334) :- p(7333), q(7334).
-q(7334) :- p(7333), q(7333).
-p(7335) :- p(7334), q(7335).
-q(7335) :- p(7334), q(7334).
-p(7336) :- p(7335), q(7336).
-q(7336) :- p(7335), q(7335).
-p(7337) :- p(7336), q(7337).
-q(7337) :- p(7336), q(7336).
-p(7338) :- p(7337), q(7338).
-q(7338) :- p(7337), q(7337).
-p(7339) :- p(7338), q(7339).
-q(7339) :- p(7338), q(7338).
-p(7340) :- p(7339), q(7340).
-q(7340) :- p(7339), q(7339).
-p(7341) :- p(7340), q(7341).
-q(7341) :- p(7340), q(7340).
-p(7342) :- p(7341), q(7342).
-q(7342) :- p(7341), q(7341).
-p(7343) :- p(7342), q(7343).
-q(7343) :- p(7342), q(7342).
-p(7344) :- p(7343), q(7344).
-q(7344) :- p(7343), q(7343).
-p(7345) :- p(7344), q(7345).
-q(7345) :- p(7344), q(7344).
-p(7346) :- p(7345), q(7346).
-q(7346) :- p(7345), q(7345).
-p(7347) :- p(7346), q(7347).
-q(7347) :- p(7346), q(7346).
-p(7348) :- p(7347), q(7348).
-q(7348) :- p(7347), q(7347).
-p(7349) :- p(7348), q(7349).
-q(7349) :- p(7348), q(7348).
-p(7350) :- p(7349), q(7350).
-q(7350) :- p(7349), q(7349).
-p(7351) :- p(7350), q(7351).
-q(7351) :- p(7350), q(7350).
-p(7352) :- p(7351), q(7352).
-q(7352) :- p(7351), q(7351).
-p(7353) :- p(7352), q(7353).
-q(7353) :- p(7352), q(7352).
-p(7354) :- p(7353), q(7354).
-q(7354) :- p(7353), q(7353).
-p(7355) :- p(7354), q(7355).
-q(7355) :- p(7354), q(7354).
-p(7356) :- p(7355), q(7356).
-q(7356) :- p(7355), q(7355).
-p(7357) :- p(7356), q(7357).
-q(7357) :- p(7356), q(7356).
-p(7358) :- p(7357), q(7358).
-q(7358) :- p(7357), q(7357).
-p(7359) :- p(7358), q(7359).
-q(7359) :- p(7358), q(7358).
-p(7360) :- p(7359), q(7360).
-q(7360) :- p(7359), q(7359).
-p(7361) :- p(7360), q(7361).
-q(7361) :- p(7360), q(7360).
-p(7362) :- p(7361), q(7362).
-q(7362) :- p(7361), q(7361).
-p(7363) :- p(7362), q(7363).
-q(7363) :- p(7362), q(7362).
-p(7364) :- p(7363), q(7364).
-q(7364) :- p(7363), q(7363).
-p(7365) :- p(7364), q(7365).
-q(7365) :- p(7364), q(7364).
-p(7366) :- p(7365), q(7366).
-q(7366) :- p(7365), q(7365).
-p(7367) :- p(7366), q(7367).
-q(7367) :- p(7366), q(7366).
-p(7368) :- p(7367), q(7368).
-q(7368) :- p(7367), q(7367).
-p(7369) :- p(7368), q(7369).
-q(7369) :- p(7368), q(7368).
-p(7370) :- p(7369), q(7370).
-q(7370) :- p(7369), q(7369).
-p(7371) :- p(7370), q(7371).
-q(7371) :- p(7370), q(7370).
-p(7372) :- p(7371), q(7372).
-q(7372) :- p(7371), q(7371).
-p(7373) :- p(7372), q(7373).
-q(7373) :- p(7372), q(7372).
-p(7374) :- p(7373), q(7374).
-q(7374) :- p(7373), q(7373).
-p(7375) :- p(7374), q(7375).
-q(7375) :- p(7374), q(7374).
-p(7376) :- p(7375), q(7376).
-q(7376) :- p(7375), q(7375).
-p(7377) :- p(7376), q(7377).
-q(7377) :- p(7376), q(7376).
-p(7378) :- p(7377), q(7378).
-q(7378) :- p(7377), q(7377).
-p(7379) :- p(7378), q(7379).
-q(7379) :- p(7378), q(7378).
-p(7380) :- p(7379), q(7380).
-q(7380) :- p(7379), q(7379).
-p(7381) :- p(7380), q(7381).
-q(7381) :- p(7380), q(7380).
-p(7382) :- p(7381), q(7382).
-q(7382) :- p(7381), q(7381).
-p(7383) :- p(7382), q(7383).
-q(7383) :- p(7382), q(7382).
-p(7384) :- p(7383), q(7384).
-q(7384) :- p(7383), q(7383).
-p(7385) :- p(7384), q(7385).
-q(7385) :- p(7384), q(7384).
-p(7386) :- p(7385), q(7386).
-q(7386) :- p(7385), q(7385).
-p(7387) :- p(7386), q(7387).
-q(7387) :- p(7386), q(7386).
-p(7388) :- p(7387), q(7388).
-q(7388) :- p(7387), q(7387).
-p(7389) :- p(7388), q(7389).
-q(7389) :- p(7388), q(7388).
-p(7390) :- p(7389), q(7390).
-q(7390) :- p(7389), q(7389).
-p(7391) :- p(7390), q(7391).
-q(7391) :- p(7390), q(7390).
-p(7392) :- p(7391), q(7392).
-q(7392) :- p(7391), q(7391).
-p(7393) :- p(7392), q(7393).
-q(7393) :- p(7392), q(7392).
-p(7394) :- p(7393), q(7394).
-q(7394) :- p(7393), q(7393).
-p(7395) :- p(7394), q(7395).
-q(7395) :- p(7394), q(7394).
-p(7396) :- p(7395), q(7396).
-q(7396) :- p(7395), q(7395).
-p(7397) :- p(7396), q(7397).
-q(7397) :- p(7396), q(7396).
-p(7398) :- p(7397), q(7398).
-q(7398) :- p(7397), q(7397).
-p(7399) :- p(7398), q(7399).
-q(7399) :- p(7398), q(7398).
-p(7400) :- p(7399), q(7400).
-q(7400) :- p(7399), q(7399).
-p(7401) :- p(7400), q(7401).
-q(7401) :- p(7400), q(7400).
-p(7402) :- p(7401), q(7402).
-q(7402) :- p(7401), q(7401).
-p(7403) :- p(7402), q(7403).
-q(7403) :- p(7402), q(7402).
-p(7404) :- p(7403), q(7404).
-q(7404) :- p(7403), q(7403).
-p(7405) :- p(7404), q(7405).
-q(7405) :- p(7404), q(7404).
-p(7406) :- p(7405), q(7406).
-q(7406) :- p(7405), q(7405).
-p(7407) :- p(7406), q(7407).
-q(7407) :- p(7406), q(7406).
-p(7408) :- p(7407), q(7408).
-q(7408) :- p(7407), q(7407).
-p(7409) :- p(7408), q(7409).
-q(7409) :- p(7408), q(7408).
-p(7410) :- p(7409), q(7410).
-q(7410) :- p(7409), q(7409).
-p(7411) :- p(7410), q(7411).
-q(7411) :- p(7410), q(7410).
-p(7412) :- p(7411), q(7412).
-q(7412) :- p(7411), q(7411).
-p(7413) :- p(7412), q(7413).
-q(7413) :- p(7412), q(7412).
-p(7414) :- p(7413), q(7414).
-q(7414) :- p(7413), q(7413).
-p(7415) :- p(7414), q(7415).
-q(7415) :- p(7414), q(7414).
-p(7416) :- p(7415), q(7416).
-q(7416) :- p(7415), q(7415).
-p(7417) :- p(7416), q(7417).
-q(7417) :- p(7416), q(7416).
-p(7418) :- p(7417), q(7418).
-q(7418) :- p(7417), q(7417).
-p(7419) :- p(7418), q(7419).
-q(7419) :- p(7418), q(7418).
-p(7420) :- p(7419), q(7420).
-q(7420) :- p(7419), q(7419).
-p(7421) :- p(7420), q(7421).
-q(7421) :- p(7420), q(7420).
-p(7422) :- p(7421), q(7422).
-q(7422) :- p(7421), q(7421).
-p(7423) :- p(7422), q(7423).
-q(7423) :- p(7422), q(7422).
-p(7424) :- p(7423), q(7424).
-q(7424) :- p(7423), q(7423).
-p(7425) :- p(7424), q(7425).
-q(7425) :- p(7424), q(7424).
-p(7426) :- p(7425), q(7426).
-q(7426) :- p(7425), q(7425).
-p(7427) :- p(7426), q(7427).
-q(7427) :- p(7426), q(7426).
-p(7428) :- p(7427), q(7428).
-q(7428) :- p(7427), q(7427).
-p(7429) :- p(7428), q(7429).
-q(7429) :- p(7428), q(7428).
-p(7430) :- p(7429), q(7430).
-q(7430) :- p(7429), q(7429).
-p(7431) :- p(7430), q(7431).
-q(7431) :- p(7430), q(7430).
-p(7432) :- p(7431), q(7432).
-q(7432) :- p(7431), q(7431).
-p(7433) :- p(7432), q(7433).
-q(7433) :- p(7432), q(7432).
-p(7434) :- p(7433), q(7434).
-q(7434) :- p(7433), q(7433).
-p(7435) :- p(7434), q(7435).
-q(7435) :- p(7434), q(7434).
-p(7436) :- p(7435), q(7436).
-q(7436) :- p(7435), q(7435).
-p(7437) :- p(7436), q(7437).
-q(7437) :- p(7436), q(7436).
-p(7438) :- p(7437), q(7438).
-q(7438) :- p(7437), q(7437).
-p(7439) :- p(7438), q(7439).
-q(7439) :- p(7438), q(7438).
-p(7440) :- p(7439), q(7440).
-q(7440) :- p(7439), q(7439).
-p(7441) :- p(7440), q(7441).
-q(7441) :- p(7440), q(7440).
-p(7442) :- p(7441), q(7442).
-q(7442) :- p(7441), q(7441).
-p(7443) :- p(7442), q(7443).
-q(7443) :- p(7442), q(7442).
-p(7444) :- p(7443), q(7444).
-q(7444) :- p(7443), q(7443).
-p(7445) :- p(7444), q(7445).
-q(7445) :- p(7444), q(7444).
-p(7446) :- p(7445), q(7446).
-q(7446) :- p(7445), q(7445).
-p(7447) :- p(7446), q(7447).
-q(7447) :- p(7446), q(7446).
-p(7448) :- p(7447), q(7448).
-q(7448) :- p(7447), q(7447).
-p(7449) :- p(7448), q(7449).
-q(7449) :- p(7448), q(7448).
-p(7450) :- p(7449), q(7450).
-q(7450) :- p(7449), q(7449).
-p(7451) :- p(7450), q(7451).
-q(7451) :- p(7450), q(7450).
-p(7452) :- p(7451), q(7452).
-q(7452) :- p(7451), q(7451).
-p(7453) :- p(7452), q(7453).
-q(7453) :- p(7452), q(7452).
-p(7454) :- p(7453), q(7454).
-q(7454) :- p(7453), q(7453).
-p(7455) :- p(7454), q(7455).
-q(7455) :- p(7454), q(7454).
-p(7456) :- p(7455), q(7456).
-q(7456) :- p(7455), q(7455).
-p(7457) :- p(7456), q(7457).
-q(7457) :- p(7456), q(7456).
-p(7458) :- p(7457), q(7458).
-q(7458) :- p(7457), q(7457).
-p(7459) :- p(7458), q(7459).
-q(7459) :- p(7458), q(7458).
-p(7460) :- p(7459), q(7460).
-q(7460) :- p(7459), q(7459).
-p(7461) :- p(7460), q(7461).
-q(7461) :- p(7460), q(7460).
-p(7462) :- p(7461), q(7462).
-q(7462) :- p(7461), q(7461).
-p(7463) :- p(7462), q(7463).
-q(7463) :- p(7462), q(7462).
-p(7464) :- p(7463), q(7464).
-q(7464) :- p(7463), q(7463).
-p(7465) :- p(7464), q(7465).
-q(7465) :- p(7464), q(7464).
-p(7466) :- p(7465), q(7466).
-q(7466) :- p(7465), q(7465).
-p(7467) :- p(7466), q(7467).
-q(7467) :- p(7466), q(7466).
-p(7468) :- p(7467), q(7468).
-q(7468) :- p(7467), q(7467).
-p(7469) :- p(7468), q(7469).
-q(7469) :- p(7468), q(7468).
-p(7470) :- p(7469), q(7470).
-q(7470) :- p(7469), q(7469).
-p(7471) :- p(7470), q(7471).
-q(7471) :- p(7470), q(7470).
-p(7472) :- p(7471), q(7472).
-q(7472) :- p(7471), q(7471).
-p(7473) :- p(7472), q(7473).
-q(7473) :- p(7472), q(7472).
-p(7474) :- p(7473), q(7474).
-q(7474) :- p(7473), q(7473).
-p(7475) :- p(7474), q(7475).
-q(7475) :- p(7474), q(7474).
-p(7476) :- p(7475), q(7476).
-q(7476) :- p(7475), q(7475).
-p(7477) :- p(7476), q(7477).
-q(7477) :- p(7476), q(7476).
-p(7478) :- p(7477), q(7478).
-q(7478) :- p(7477), q(7477).
-p(7479) :- p(7478), q(7479).
-q(7479) :- p(7478), q(7478).
-p(7480) :- p(7479), q(7480).
-q(7480) :- p(7479), q(7479).
-p(7481) :- p(7480), q(7481).
-q(7481) :- p(7480), q(7480).
-p(7482) :- p(7481), q(7482).
-q(7482) :- p(7481), q(7481).
-p(7483) :- p(7482), q(7483).
-q(7483) :- p(7482), q(7482).
-p(7484) :- p(7483), q(7484).
-q(7484) :- p(7483), q(7483).
-p(7485) :- p(7484), q(7485).
-q(7485) :- p(7484), q(7484).
-p(7486) :- p(7485), q(7486).
-q(7486) :- p(7485), q(7485).
-p(7487) :- p(7486), q(7487).
-q(7487) :- p(7486), q(7486).
-p(7488) :- p(7487), q(7488).
-q(7488) :- p(7487), q(7487).
-p(7489) :- p(7488), q(7489).
-q(7489) :- p(7488), q(7488).
-p(7490) :- p(7489), q(7490).
-q(7490) :- p(7489), q(7489).
-p(7491) :- p(7490), q(7491).
-q(7491) :- p(7490), q(7490).
-p(7492) :- p(7491), q(7492).
-q(7492) :- p(7491), q(7491).
-p(7493) :- p(7492), q(7493).
-q(7493) :- p(7492), q(7492).
-p(7494) :- p(7493), q(7494).
-q(7494) :- p(7493), q(7493).
-p(7495) :- p(7494), q(7495).
-q(7495) :- p(7494), q(7494).
-p(7496) :- p(7495), q(7496).
-q(7496) :- p(7495), q(7495).
-p(7497) :- p(7496), q(7497).
-q(7497) :- p(7496), q(7496).
-p(7498) :- p(7497), q(7498).
-q(7498) :- p(7497), q(7497).
-p(7499) :- p(7498), q(7499).
-q(7499) :- p(7498), q(7498).
-p(7500) :- p(7499), q(7500).
-q(7500) :- p(7499), q(7499).
-p(7501) :- p(7500), q(7501).
-q(7501) :- p(7500), q(7500).
-p(7502) :- p(7501), q(7502).
-q(7502) :- p(7501), q(7501).
-p(7503) :- p(7502), q(7503).
-q(7503) :- p(7502), q(7502).
-p(7504) :- p(7503), q(7504).
-q(7504) :- p(7503), q(7503).
-p(7505) :- p(7504), q(7505).
-q(7505) :- p(7504), q(7504).
-p(7506) :- p(7505), q(7506).
-q(7506) :- p(7505), q(7505).
-p(7507) :- p(7506), q(7507).
-q(7507) :- p(7506), q(7506).
-p(7508) :- p(7507), q(7508).
-q(7508) :- p(7507), q(7507).
-p(7509) :- p(7508), q(7509).
-q(7509) :- p(7508), q(7508).
-p(7510) :- p(7509), q(7510).
-q(7510) :- p(7509), q(7509).
-p(7511) :- p(7510), q(7511).
-q(7511) :- p(7510), q(7510).
-p(7512) :- p(7511), q(7512).
-q(7512) :- p(7511), q(7511).
-p(7513) :- p(7512), q(7513).
-q(7513) :- p(7512), q(7512).
-p(7514) :- p(7513), q(7514).
-q(7514) :- p(7513), q(7513).
-p(7515) :- p(7514), q(7515).
-q(7515) :- p(7514), q(7514).
-p(7516) :- p(7515), q(7516).
-q(7516) :- p(7515), q(7515).
-p(7517) :- p(7516), q(7517).
-q(7517) :- p(7516), q(7516).
-p(7518) :- p(7517), q(7518).
-q(7518) :- p(7517), q(7517).
-p(7519) :- p(7518), q(7519).
-q(7519) :- p(7518), q(7518).
-p(7520) :- p(7519), q(7520).
-q(7520) :- p(7519), q(7519).
-p(7521) :- p(7520), q(7521).
-q(7521) :- p(7520), q(7520).
-p(7522) :- p(7521), q(7522).
-q(7522) :- p(7521), q(7521).
-p(7523) :- p(7522), q(7523).
-q(7523) :- p(7522), q(7522).
-p(7524) :- p(7523), q(7524).
-q(7524) :- p(7523), q(7523).
-p(7525) :- p(7524), q(7525).
-q(7525) :- p(7524), q(7524).
-p(7526) :- p(7525), q(7526).
-q(7526) :- p(7525), q(7525).
-p(7527) :- p(7526), q(7527).
-q(7527) :- p(7526), q(7526).
-p(7528) :- p(7527), q(7528).
-q(7528) :- p(7527), q(7527).
-p(7529) :- p(7528), q(7529).
-q(7529) :- p(7528), q(7528).
-p(7530) :- p(7529), q(7530).
-q(7530) :- p(7529), q(7529).
-p(7531) :- p(7530), q(7531).
-q(7531) :- p(7530), q(7530).
-p(7532) :- p(7531), q(7532).
-q(7532) :- p(7531), q(7531).
-p(7533) :- p(7532), q(7533).
-q(7533) :- p(7532), q(7532).
-p(7534) :- p(7533), q(7534).
-q(7534) :- p(7533), q(7533).
-p(7535) :- p(7534), q(7535).
-q(7535) :- p(7534), q(7534).
-p(7536) :- p(7535), q(7536).
-q(7536) :- p(7535), q(7535).
-p(7537) :- p(7536), q(7537).
-q(7537) :- p(7536), q(7536).
-p(7538) :- p(7537), q(7538).
-q(7538) :- p(7537), q(7537).
-p(7539) :- p(7538), q(7539).
-q(7539) :- p(7538), q(7538).
-p(7540) :- p(7539), q(7540).
-q(7540) :- p(7539), q(7539).
-p(7541) :- p(7540), q(7541).
-q(7541) :- p(7540), q(7540).
-p(7542) :- p(7541), q(7542).
-q(7542) :- p(7541), q(7541).
-p(7543) :- p(7542), q(7543).
-q(7543) :- p(7542), q(7542).
-p(7544) :- p(7543), q(7544).
-q(7544) :- p(7543), q(7543).
-p(7545) :- p(7544), q(7545).
-q(7545) :- p(7544), q(7544).
-p(7546) :- p(7545), q(7546).
-q(7546) :- p(7545), q(7545).
-p(7547) :- p(7546), q(7547).
-q(7547) :- p(7546), q(7546).
-p(7548) :- p(7547), q(7548).
-q(7548) :- p(7547), q(7547).
-p(7549) :- p(7548), q(7549).
-q(7549) :- p(7548), q(7548).
-p(7550) :- p(7549), q(7550).
-q(7550) :- p(7549), q(7549).
-p(7551) :- p(7550), q(7551).
-q(7551) :- p(7550), q(7550).
-p(7552) :- p(7551), q(7552).
-q(7552) :- p(7551), q(7551).
-p(7553) :- p(7552), q(7553).
-q(7553) :- p(7552), q(7552).
-p(7554) :- p(7553), q(7554).
-q(7554) :- p(7553), q(7553).
-p(7555) :- p(7554), q(7555).
-q(7555) :- p(7554), q(7554).
-p(7556) :- p(7555), q(7556).
-q(7556) :- p(7555), q(7555).
-p(7557) :- p(7556), q(7557).
-q(7557) :- p(7556), q(7556).
-p(7558) :- p(7557), q(7558).
-q(7558) :- p(7557), q(7557).
-p(7559) :- p(7558), q(7559).
-q(7559) :- p(7558), q(7558).
-p(7560) :- p(7559), q(7560).
-q(7560) :- p(7559), q(7559).
-p(7561) :- p(7560), q(7561).
-q(7561) :- p(7560), q(7560).
-p(7562) :- p(7561), q(7562).
-q(7562) :- p(7561), q(7561).
-p(7563) :- p(7562), q(7563).
-q(7563) :- p(7562), q(7562).
-p(7564) :- p(7563), q(7564).
-q(7564) :- p(7563), q(7563).
-p(7565) :- p(7564), q(7565).
-q(7565) :- p(7564), q(7564).
-p(7566) :- p(7565), q(7566).
-q(7566) :- p(7565), q(7565).
-p(7567) :- p(7566), q(7567).
-q(7567) :- p(7566), q(7566).
-p(7568) :- p(7567), q(7568).
-q(7568) :- p(7567), q(7567).
-p(7569) :- p(7568), q(7569).
-q(7569) :- p(7568), q(7568).
-p(7570) :- p(7569), q(7570).
-q(7570) :- p(7569), q(7569).
-p(7571) :- p(7570), q(7571).
-q(7571) :- p(7570), q(7570).
-p(7572) :- p(7571), q(7572).
-q(7572) :- p(7571), q(7571).
-p(7573) :- p(7572), q(7573).
-q(7573) :- p(7572), q(7572).
-p(7574) :- p(7573), q(7574).
-q(7574) :- p(7573), q(7573).
-p(7575) :- p(7574), q(7575).
-q(7575) :- p(7574), q(7574).
-p(7576) :- p(7575), q(7576).
-q(7576) :- p(7575), q(7575).
-p(7577) :- p(7576), q(7577).
-q(7577) :- p(7576), q(7576).
-p(7578) :- p(7577), q(7578).
-q(7578) :- p(7577), q(7577).
-p(7579) :- p(7578), q(7579).
-q(7579) :- p(7578), q(7578).
-p(7580) :- p(7579), q(7580).
-q(7580) :- p(7579), q(7579).
-p(7581) :- p(7580), q(7581).
-q(7581) :- p(7580), q(7580).
-p(7582) :- p(7581), q(7582).
-q(7582) :- p(7581), q(7581).
-p(7583) :- p(7582), q(7583).
-q(7583) :- p(7582), q(7582).
-p(7584) :- p(7583), q(7584).
-q(7584) :- p(7583), q(7583).
-p(7585) :- p(7584), q(7585).
-q(7585) :- p(7584), q(7584).
-p(7586) :- p(7585), q(7586).
-q(7586) :- p(7585), q(7585).
-p(7587) :- p(7586), q(7587).
-q(7587) :- p(7586), q(7586).
-p(7588) :- p(7587), q(7588).
-q(7588) :- p(7587), q(7587).
-p(7589) :- p(7588), q(7589).
-q(7589) :- p(7588), q(7588).
-p(7590) :- p(7589), q(7590).
-q(7590) :- p(7589), q(7589).
-p(7591) :- p(7590), q(7591).
-q(7591) :- p(7590), q(7590).
-p(7592) :- p(7591), q(7592).
-q(7592) :- p(7591), q(7591).
-p(7593) :- p(7592), q(7593).
-q(7593) :- p(7592), q(7592).
-p(7594) :- p(7593), q(7594).
-q(7594) :- p(7593), q(7593).
-p(7595) :- p(7594), q(7595).
-q(7595) :- p(7594), q(7594).
-p(7596) :- p(7595), q(7596).
-q(7596) :- p(7595), q(7595).
-p(7597) :- p(7596), q(7597).
-q(7597) :- p(7596), q(7596).
-p(7598) :- p(7597), q(7598).
-q(7598) :- p(7597), q(7597).
-p(7599) :- p(7598), q(7599).
-q(7599) :- p(7598), q(7598).
-p(7600) :- p(7599), q(7600).
-q(7600) :- p(7599), q(7599).
-p(7601) :- p(7600), q(7601).
-q(7601) :- p(7600), q(7600).
-p(7602) :- p(7601), q(7602).
-q(7602) :- p(7601), q(7601).
-p(7603) :- p(7602), q(7603).
-q(7603) :- p(7602), q(7602).
-p(7604) :- p(7603), q(7604).
-q(7604) :- p(7603), q(7603).
-p(7605) :- p(7604), q(7605).
-q(7605) :- p(7604), q(7604).
-p(7606) :- p(7605), q(7606).
-q(7606) :- p(7605), q(7605).
-p(7607) :- p(7606), q(7607).
-q(7607) :- p(7606), q(7606).
-p(7608) :- p(7607), q(7608).
-q(7608) :- p(7607), q(7607).
-p(7609) :- p(7608), q(7609).
-q(7609) :- p(7608), q(7608).
-p(7610) :- p(7609), q(7610).
-q(7610) :- p(7609), q(7609).
-p(7611) :- p(7610), q(7611).
-q(7611) :- p(7610), q(7610).
-p(7612) :- p(7611), q(7612).
-q(7612) :- p(7611), q(7611).
-p(7613) :- p(7612), q(7613).
-q(7613) :- p(7612), q(7612).
-p(7614) :- p(7613), q(7614).
-q(7614) :- p(7613), q(7613).
-p(7615) :- p(7614), q(7615).
-q(7615) :- p(7614), q(7614).
-p(7616) :- p(7615), q(7616).
-q(7616) :- p(7615), q(7615).
-p(7617) :- p(7616), q(7617).
-q(7617) :- p(7616), q(7616).
-p(7618) :- p(7617), q(7618).
-q(7618) :- p(7617), q(7617).
-p(7619) :- p(7618), q(7619).
-q(7619) :- p(7618), q(7618).
-p(7620) :- p(7619), q(7620).
-q(7620) :- p(7619), q(7619).
-p(7621) :- p(7620), q(7621).
-q(7621) :- p(7620), q(7620).
-p(7622) :- p(7621), q(7622).
-q(7622) :- p(7621), q(7621).
-p(7623) :- p(7622), q(7623).
-q(7623) :- p(7622), q(7622).
-p(7624) :- p(7623), q(7624).
-q(7624) :- p(7623), q(7623).
-p(7625) :- p(7624), q(7625).
-q(7625) :- p(7624), q(7624).
-p(7626) :- p(7625), q(7626).
-q(7626) :- p(7625), q(7625).
-p(7627) :- p(7626), q(7627).
-q(7627) :- p(7626), q(7626).
-p(7628) :- p(7627), q(7628).
-q(7628) :- p(7627), q(7627).
-p(7629) :- p(7628), q(7629).
-q(7629) :- p(7628), q(7628).
-p(7630) :- p(7629), q(7630).
-q(7630) :- p(7629), q(7629).
-p(7631) :- p(7630), q(7631).
-q(7631) :- p(7630), q(7630).
-p(7632) :- p(7631), q(7632).
-q(7632) :- p(7631), q(7631).
-p(7633) :- p(7632), q(7633).
-q(7633) :- p(7632), q(7632).
-p(7634) :- p(7633), q(7634).
-q(7634) :- p(7633), q(7633).
-p(7635) :- p(7634), q(7635).
-q(7635) :- p(7634), q(7634).
-p(7636) :- p(7635), q(7636).
-q(7636) :- p(7635), q(7635).
-p(7637) :- p(7636), q(7637).
-q(7637) :- p(7636), q(7636).
-p(7638) :- p(7637), q(7638).
-q(7638) :- p(7637), q(7637).
-p(7639) :- p(7638), q(7639).
-q(7639) :- p(7638), q(7638).
-p(7640) :- p(7639), q(7640).
-q(7640) :- p(7639), q(7639).
-p(7641) :- p(7640), q(7641).
-q(7641) :- p(7640), q(7640).
-p(7642) :- p(7641), q(7642).
-q(7642) :- p(7641), q(7641).
-p(7643) :- p(7642), q(7643).
-q(7643) :- p(7642), q(7642).
-p(7644) :- p(7643), q(7644).
-q(7644) :- p(7643), q(7643).
-p(7645) :- p(7644), q(7645).
-q(7645) :- p(7644), q(7644).
-p(7646) :- p(7645), q(7646).
-q(7646) :- p(7645), q(7645).
-p(7647) :- p(7646), q(7647).
-q(7647) :- p(7646), q(7646).
-p(7648) :- p(7647), q(7648).
-q(7648) :- p(7647), q(7647).
-p(7649) :- p(7648), q(7649).
-q(7649) :- p(7648), q(7648).
-p(7650) :- p(7649), q(7650).
-q(7650) :- p(7649), q(7649).
-p(7651) :- p(7650), q(7651).
-q(7651) :- p(7650), q(7650).
-p(7652) :- p(7651), q(7652).
-q(7652) :- p(7651), q(7651).
-p(7653) :- p(7652), q(7653).
-q(7653) :- p(7652), q(7652).
-p(7654) :- p(7653), q(7654).
-q(7654) :- p(7653), q(7653).
-p(7655) :- p(7654), q(7655).
-q(7655) :- p(7654), q(7654).
-p(7656) :- p(7655), q(7656).
-q(7656) :- p(7655), q(7655).
-p(7657) :- p(7656), q(7657).
-q(7657) :- p(7656), q(7656).
-p(7658) :- p(7657), q(7658).
-q(7658) :- p(7657), q(7657).
-p(7659) :- p(7658), q(7659).
-q(7659) :- p(7658), q(7658).
-p(7660) :- p(7659), q(7660).
-q(7660) :- p(7659), q(7659).
-p(7661) :- p(7660), q(7661).
-q(7661) :- p(7660), q(7660).
-p(7662) :- p(7661), q(7662).
-q(7662) :- p(7661), q(7661).
-p(7663) :- p(7662), q(7663).
-q(7663) :- p(7662), q(7662).
-p(7664) :- p(7663), q(7664).
-q(7664) :- p(7663), q(7663).
-p(7665) :- p(7664), q(7665).
-q(7665) :- p(7664), q(7664).
-p(7666) :- p(7665), q(7666).
-q(7666) :- p(7665), q(7665).
-p(7667) :- p(7666), q(7667).
-q(7667) :- p(7666), q(7666).
-p(7668) :- p(7667), q(7668).
-q(7668) :- p(7667), q(7667).
-p(7669) :- p(7668), q(7669).
-q(7669) :- p(7668), q(7668).
-p(7670) :- p(7669), q(7670).
-q(7670) :- p(7669), q(7669).
-p(7671) :- p(7670), q(7671).
-q(7671) :- p(7670), q(7670).
-p(7672) :- p(7671), q(7672).
-q(7672) :- p(7671), q(7671).
-p(7673) :- p(7672), q(7673).
-q(7673) :- p(7672), q(7672).
-p(7674) :- p(7673), q(7674).
-q(7674) :- p(7673), q(7673).
-p(7675) :- p(7674), q(7675).
-q(7675) :- p(7674), q(7674).
-p(7676) :- p(7675), q(7676).
-q(7676) :- p(7675), q(7675).
-p(7677) :- p(7676), q(7677).
-q(7677) :- p(7676), q(7676).
-p(7678) :- p(7677), q(7678).
-q(7678) :- p(7677), q(7677).
-p(7679) :- p(7678), q(7679).
-q(7679) :- p(7678), q(7678).
-p(7680) :- p(7679), q(7680).
-q(7680) :- p(7679), q(7679).
-p(7681) :- p(7680), q(7681).
-q(7681) :- p(7680), q(7680).
-p(7682) :- p(7681), q(7682).
-q(7682) :- p(7681), q(7681).
-p(7683) :- p(7682), q(7683).
-q(7683) :- p(7682), q(7682).
-p(7684) :- p(7683), q(7684).
-q(7684) :- p(7683), q(7683).
-p(7685) :- p(7684), q(7685).
-q(7685) :- p(7684), q(7684).
-p(7686) :- p(7685), q(7686).
-q(7686) :- p(7685), q(7685).
-p(7687) :- p(7686), q(7687).
-q(7687) :- p(7686), q(7686).
-p(7688) :- p(7687), q(7688).
-q(7688) :- p(7687), q(7687).
-p(7689) :- p(7688), q(7689).
-q(7689) :- p(7688), q(7688).
-p(7690) :- p(7689), q(7690).
-q(7690) :- p(7689), q(7689).
-p(7691) :- p(7690), q(7691).
-q(7691) :- p(7690), q(7690).
-p(7692) :- p(7691), q(7692).
-q(7692) :- p(7691), q(7691).
-p(7693) :- p(7692), q(7693).
-q(7693) :- p(7692), q(7692).
-p(7694) :- p(7693), q(7694).
-q(7694) :- p(7693), q(7693).
-p(7695) :- p(7694), q(7695).
-q(7695) :- p(7694), q(7694).
-p(7696) :- p(7695), q(7696).
-q(7696) :- p(7695), q(7695).
-p(7697) :- p(7696), q(7697).
-q(7697) :- p(7696), q(7696).
-p(7698) :- p(7697), q(7698).
-q(7698) :- p(7697), q(7697).
-p(7699) :- p(7698), q(7699).
-q(7699) :- p(7698), q(7698).
-p(7700) :- p(7699), q(7700).
-q(7700) :- p(7699), q(7699).
-p(7701) :- p(7700), q(7701).
-q(7701) :- p(7700), q(7700).
-p(7702) :- p(7701), q(7702).
-q(7702) :- p(7701), q(7701).
-p(7703) :- p(7702), q(7703).
-q(7703) :- p(7702), q(7702).
-p(7704) :- p(7703), q(7704).
-q(7704) :- p(7703), q(7703).
-p(7705) :- p(7704), q(7705).
-q(7705) :- p(7704), q(7704).
-p(7706) :- p(7705), q(7706).
-q(7706) :- p(7705), q(7705).
-p(7707) :- p(7706), q(7707).
-q(7707) :- p(7706), q(7706).
-p(7708) :- p(7707), q(7708).
-q(7708) :- p(7707), q(7707).
-p(7709) :- p(7708), q(7709).
-q(7709) :- p(7708), q(7708).
-p(7710) :- p(7709), q(7710).
-q(7710) :- p(7709), q(7709).
-p(7711) :- p(7710), q(7711).
-q(7711) :- p(7710), q(7710).
-p(7712) :- p(7711), q(7712).
-q(7712) :- p(7711), q(7711).
-p(7713) :- p(7712), q(7713).
-q(7713) :- p(7712), q(7712).
-p(7714) :- p(7713), q(7714).
-q(7714) :- p(7713), q(7713).
-p(7715) :- p(7714), q(7715).
-q(7715) :- p(7714), q(7714).
-p(7716) :- p(7715), q(7716).
-q(7716) :- p(7715), q(7715).
-p(7717) :- p(7716), q(7717).
-q(7717) :- p(7716), q(7716).
-p(7718) :- p(7717), q(7718).
-q(7718) :- p(7717), q(7717).
-p(7719) :- p(7718), q(7719).
-q(7719) :- p(7718), q(7718).
-p(7720) :- p(7719), q(7720).
-q(7720) :- p(7719), q(7719).
-p(7721) :- p(7720), q(7721).
-q(7721) :- p(7720), q(7720).
-p(7722) :- p(7721), q(7722).
-q(7722) :- p(7721), q(7721).
-p(7723) :- p(7722), q(7723).
-q(7723) :- p(7722), q(7722).
-p(7724) :- p(7723), q(7724).
-q(7724) :- p(7723), q(7723).
-p(7725) :- p(7724), q(7725).
-q(7725) :- p(7724), q(7724).
-p(7726) :- p(7725), q(7726).
-q(7726) :- p(7725), q(7725).
-p(7727) :- p(7726), q(7727).
-q(7727) :- p(7726), q(7726).
-p(7728) :- p(7727), q(7728).
-q(7728) :- p(7727), q(7727).
-p(7729) :- p(7728), q(7729).
-q(7729) :- p(7728), q(7728).
-p(7730) :- p(7729), q(7730).
-q(7730) :- p(7729), q(7729).
-p(7731) :- p(7730), q(7731).
-q(7731) :- p(7730), q(7730).
-p(7732) :- p(7731), q(7732).
-q(7732) :- p(7731), q(7731).
-p(7733) :- p(7732), q(7733).
-q(7733) :- p(7732), q(7732).
-p(7734) :- p(7733), q(7734).
-q(7734) :- p(7733), q(7733).
-p(7735) :- p(7734), q(7735).
-q(7735) :- p(7734), q(7734).
-p(7736) :- p(7735), q(7736).
-q(7736) :- p(7735), q(7735).
-p(7737) :- p(7736), q(7737).
-q(7737) :- p(7736), q(7736).
-p(7738) :- p(7737), q(7738).
-q(7738) :- p(7737), q(7737).
-p(7739) :- p(7738), q(7739).
-q(7739) :- p(7738), q(7738).
-p(7740) :- p(7739), q(7740).
-q(7740) :- p(7739), q(7739).
-p(7741) :- p(7740), q(7741).
-q(7741) :- p(7740), q(7740).
-p(7742) :- p(7741), q(7742).
-q(7742) :- p(7741), q(7741).
-p(7743) :- p(7742), q(7743).
-q(7743) :- p(7742), q(7742).
-p(7744) :- p(7743), q(7744).
-q(7744) :- p(7743), q(7743).
-p(7745) :- p(7744), q(7745).
-q(7745) :- p(7744), q(7744).
-p(7746) :- p(7745), q(7746).
-q(7746) :- p(7745), q(7745).
-p(7747) :- p(7746), q(7747).
-q(7747) :- p(7746), q(7746).
-p(7748) :- p(7747), q(7748).
-q(7748) :- p(7747), q(7747).
-p(7749) :- p(7748), q(7749).
-q(7749) :- p(7748), q(7748).
-p(7750) :- p(7749), q(7750).
-q(7750) :- p(7749), q(7749).
-p(7751) :- p(7750), q(7751).
-q(7751) :- p(7750), q(7750).
-p(7752) :- p(7751), q(7752).
-q(7752) :- p(7751), q(7751).
-p(7753) :- p(7752), q(7753).
-q(7753) :- p(7752), q(7752).
-p(7754) :- p(7753), q(7754).
-q(7754) :- p(7753), q(7753).
-p(7755) :- p(7754), q(7755).
-q(7755) :- p(7754), q(7754).
-p(7756) :- p(7755), q(7756).
-q(7756) :- p(7755), q(7755).
-p(7757) :- p(7756), q(7757).
-q(7757) :- p(7756), q(7756).
-p(7758) :- p(7757), q(7758).
-q(7758) :- p(7757), q(7757).
-p(7759) :- p(7758), q(7759).
-q(7759) :- p(7758), q(7758).
-p(7760) :- p(7759), q(7760).
-q(7760) :- p(7759), q(7759).
-p(7761) :- p(7760), q(7761).
-q(7761) :- p(7760), q(7760).
-p(7762) :- p(7761), q(7762).
-q(7762) :- p(7761), q(7761).
-p(7763) :- p(7762), q(7763).
-q(7763) :- p(7762), q(7762).
-p(7764) :- p(7763), q(7764).
-q(7764) :- p(7763), q(7763).
-p(7765) :- p(7764), q(7765).
-q(7765) :- p(7764), q(7764).
-p(7766) :- p(7765), q(7766).
-q(7766) :- p(7765), q(7765).
-p(7767) :- p(7766), q(7767).
-q(7767) :- p(7766), q(7766).
-p(7768) :- p(7767), q(7768).
-q(7768) :- p(7767), q(7767).
-p(7769) :- p(7768), q(7769).
-q(7769) :- p(7768), q(7768).
-p(7770) :- p(7769), q(7770).
-q(7770) :- p(7769), q(7769).
-p(7771) :- p(7770), q(7771).
-q(7771) :- p(7770), q(7770).
-p(7772) :- p(7771), q(7772).
-q(7772) :- p(7771), q(7771).
-p(7773) :- p(7772), q(7773).
-q(7773) :- p(7772), q(7772).
-p(7774) :- p(7773), q(7774).
-q(7774) :- p(7773), q(7773).
-p(7775) :- p(7774), q(7775).
-q(7775) :- p(7774), q(7774).
-p(7776) :- p(7775), q(7776).
-q(7776) :- p(7775), q(7775).
-p(7777) :- p(7776), q(7777).
-q(7777) :- p(7776), q(7776).
-p(7778) :- p(7777), q(7778).
-q(7778) :- p(7777), q(7777).
-p(7779) :- p(7778), q(7779).
-q(7779) :- p(7778), q(7778).
-p(7780) :- p(7779), q(7780).
-q(7780) :- p(7779), q(7779).
-p(7781) :- p(7780), q(7781).
-q(7781) :- p(7780), q(7780).
-p(7782) :- p(7781), q(7782).
-q(7782) :- p(7781), q(7781).
-p(7783) :- p(7782), q(7783).
-q(7783) :- p(7782), q(7782).
-p(7784) :- p(7783), q(7784).
-q(7784) :- p(7783), q(7783).
-p(7785) :- p(7784), q(7785).
-q(7785) :- p(7784), q(7784).
-p(7786) :- p(7785), q(7786).
-q(7786) :- p(7785), q(7785).
-p(7787) :- p(7786), q(7787).
-q(7787) :- p(7786), q(7786).
-p(7788) :- p(7787), q(7788).
-q(7788) :- p(7787), q(7787).
-p(7789) :- p(7788), q(7789).
-q(7789) :- p(7788), q(7788).
-p(7790) :- p(7789), q(7790).
-q(7790) :- p(7789), q(7789).
-p(7791) :- p(7790), q(7791).
-q(7791) :- p(7790), q(7790).
-p(7792) :- p(7791), q(7792).
-q(7792) :- p(7791), q(7791).
-p(7793) :- p(7792), q(7793).
-q(7793) :- p(7792), q(7792).
-p(7794) :- p(7793), q(7794).
-q(7794) :- p(7793), q(7793).
-p(7795) :- p(7794), q(7795).
-q(7795) :- p(7794), q(7794).
-p(7796) :- p(7795), q(7796).
-q(7796) :- p(7795), q(7795).
-p(7797) :- p(7796), q(7797).
-q(7797) :- p(7796), q(7796).
-p(7798) :- p(7797), q(7798).
-q(7798) :- p(7797), q(7797).
-p(7799) :- p(7798), q(7799).
-q(7799) :- p(7798), q(7798).
-p(7800) :- p(7799), q(7800).
-q(7800) :- p(7799), q(7799).
-p(7801) :- p(7800), q(7801).
-q(7801) :- p(7800), q(7800).
-p(7802) :- p(7801), q(7802).
-q(7802) :- p(7801), q(7801).
-p(7803) :- p(7802), q(7803).
-q(7803) :- p(7802), q(7802).
-p(7804) :- p(7803), q(7804).
-q(7804) :- p(7803), q(7803).
-p(7805) :- p(7804), q(7805).
-q(7805) :- p(7804), q(7804).
-p(7806) :- p(7805), q(7806).
-q(7806) :- p(7805), q(7805).
-p(7807) :- p(7806), q(7807).
-q(7807) :- p(7806), q(7806).
-p(7808) :- p(7807), q(7808).
-q(7808) :- p(7807), q(7807).
-p(7809) :- p(7808), q(7809).
-q(7809) :- p(7808), q(7808).
-p(7810) :- p(7809), q(7810).
-q(7810) :- p(7809), q(7809).
-p(7811) :- p(7810), q(7811).
-q(7811) :- p(7810), q(7810).
-p(7812) :- p(7811), q(7812).
-q(7812) :- p(7811), q(7811).
-p(7813) :- p(7812), q(7813).
-q(7813) :- p(7812), q(7812).
-p(7814) :- p(7813), q(7814).
-q(7814) :- p(7813), q(7813).
-p(7815) :- p(7814), q(7815).
-q(7815) :- p(7814), q(7814).
-p(7816) :- p(7815), q(7816).
-q(7816) :- p(7815), q(7815).
-p(7817) :- p(7816), q(7817).
-q(7817) :- p(7816), q(7816).
-p(7818) :- p(7817), q(7818).
-q(7818) :- p(7817), q(7817).
-p(7819) :- p(7818), q(7819).
-q(7819) :- p(7818), q(7818).
-p(7820) :- p(7819), q(7820).
-q(7820) :- p(7819), q(7819).
-p(7821) :- p(7820), q(7821).
-q(7821) :- p(7820), q(7820).
-p(7822) :- p(7821), q(7822).
-q(7822) :- p(7821), q(7821).
-p(7823) :- p(7822), q(7823).
-q(7823) :- p(7822), q(7822).
-p(7824) :- p(7823), q(7824).
-q(7824) :- p(7823), q(7823).
-p(7825) :- p(7824), q(7825).
-q(7825) :- p(7824), q(7824).
-p(7826) :- p(7825), q(7826).
-q(7826) :- p(7825), q(7825).
-p(7827) :- p(7826), q(7827).
-q(7827) :- p(7826), q(7826).
-p(7828) :- p(7827), q(7828).
-q(7828) :- p(7827), q(7827).
-p(7829) :- p(7828), q(7829).
-q(7829) :- p(7828), q(7828).
-p(7830) :- p(7829), q(7830).
-q(7830) :- p(7829), q(7829).
-p(7831) :- p(7830), q(7831).
-q(7831) :- p(7830), q(7830).
-p(7832) :- p(7831), q(7832).
-q(7832) :- p(7831), q(7831).
-p(7833) :- p(7832), q(7833).
-q(7833) :- p(7832), q(7832).
-p(7834) :- p(7833), q(7834).
-q(7834) :- p(7833), q(7833).
-p(7835) :- p(7834), q(7835).
-q(7835) :- p(7834), q(7834).
-p(7836) :- p(7835), q(7836).
-q(7836) :- p(7835), q(7835).
-p(7837) :- p(7836), q(7837).
-q(7837) :- p(7836), q(7836).
-p(7838) :- p(7837), q(7838).
-q(7838) :- p(7837), q(7837).
-p(7839) :- p(7838), q(7839).
-q(7839) :- p(7838), q(7838).
-p(7840) :- p(7839), q(7840).
-q(7840) :- p(7839), q(7839).
-p(7841) :- p(7840), q(7841).
-q(7841) :- p(7840), q(7840).
-p(7842) :- p(7841), q(7842).
-q(7842) :- p(7841), q(7841).
-p(7843) :- p(7842), q(7843).
-q(7843) :- p(7842), q(7842).
-p(7844) :- p(7843), q(7844).
-q(7844) :- p(7843), q(7843).
-p(7845) :- p(7844), q(7845).
-q(7845) :- p(7844), q(7844).
-p(7846) :- p(7845), q(7846).
-q(7846) :- p(7845), q(7845).
-p(7847) :- p(7846), q(7847).
-q(7847) :- p(7846), q(7846).
-p(7848) :- p(7847), q(7848).
-q(7848) :- p(7847), q(7847).
-p(7849) :- p(7848), q(7849).
-q(7849) :- p(7848), q(7848).
-p(7850) :- p(7849), q(7850).
-q(7850) :- p(7849), q(7849).
-p(7851) :- p(7850), q(7851).
-q(7851) :- p(7850), q(7850).
-p(7852) :- p(7851), q(7852).
-q(7852) :- p(7851), q(7851).
-p(7853) :- p(7852), q(7853).
-q(7853) :- p(7852), q(7852).
-p(7854) :- p(7853), q(7854).
-q(7854) :- p(7853), q(7853).
-p(7855) :- p(7854), q(7855).
-q(7855) :- p(7854), q(7854).
-p(7856) :- p(7855), q(7856).
-q(7856) :- p(7855), q(7855).
-p(7857) :- p(7856), q(7857).
-q(7857) :- p(7856), q(7856).
-p(7858) :- p(7857), q(7858).
-q(7858) :- p(7857), q(7857).
-p(7859) :- p(7858), q(7859).
-q(7859) :- p(7858), q(7858).
-p(7860) :- p(7859), q(7860).
-q(7860) :- p(7859), q(7859).
-p(7861) :- p(7860), q(7861).
-q(7861) :- p(7860), q(7860).
-p(7862) :- p(7861), q(7862).
-q(7862) :- p(7861), q(7861).
-p(7863) :- p(7862), q(7863).
-q(7863) :- p(7862), q(7862).
-p(7864) :- p(7863), q(7864).
-q(7864) :- p(7863), q(7863).
-p(7865) :- p(7864), q(7865).
-q(7865) :- p(7864), q(7864).
-p(7866) :- p(7865), q(7866).
-q(7866) :- p(7865), q(7865).
-p(7867) :- p(7866), q(7867).
-q(7867) :- p(7866), q(7866).
-p(7868) :- p(7867), q(7868).
-q(7868) :- p(7867), q(7867).
-p(7869) :- p(7868), q(7869).
-q(7869) :- p(7868), q(7868).
-p(7870) :- p(7869), q(7870).
-q(7870) :- p(7869), q(7869).
-p(7871) :- p(7870), q(7871).
-q(7871) :- p(7870), q(7870).
-p(7872) :- p(7871), q(7872).
-q(7872) :- p(7871), q(7871).
-p(7873) :- p(7872), q(7873).
-q(7873) :- p(7872), q(7872).
-p(7874) :- p(7873), q(7874).
-q(7874) :- p(7873), q(7873).
-p(7875) :- p(7874), q(7875).
-q(7875) :- p(7874), q(7874).
-p(7876) :- p(7875), q(7876).
-q(7876) :- p(7875), q(7875).
-p(7877) :- p(7876), q(7877).
-q(7877) :- p(7876), q(7876).
-p(7878) :- p(7877), q(7878).
-q(7878) :- p(7877), q(7877).
-p(7879) :- p(7878), q(7879).
-q(7879) :- p(7878), q(7878).
-p(7880) :- p(7879), q(7880).
-q(7880) :- p(7879), q(7879).
-p(7881) :- p(7880), q(7881).
-q(7881) :- p(7880), q(7880).
-p(7882) :- p(7881), q(7882).
-q(7882) :- p(7881), q(7881).
-p(7883) :- p(7882), q(7883).
-q(7883) :- p(7882), q(7882).
-p(7884) :- p(7883), q(7884).
-q(7884) :- p(7883), q(7883).
-p(7885) :- p(7884), q(7885).
-q(7885) :- p(7884), q(7884).
-p(7886) :- p(7885), q(7886).
-q(7886) :- p(7885), q(7885).
-p(7887) :- p(7886), q(7887).
-q(7887) :- p(7886), q(7886).
-p(7888) :- p(7887), q(7888).
-q(7888) :- p(7887), q(7887).
-p(7889) :- p(7888), q(7889).
-q(7889) :- p(7888), q(7888).
-p(7890) :- p(7889), q(7890).
-q(7890) :- p(7889), q(7889).
-p(7891) :- p(7890), q(7891).
-q(7891) :- p(7890), q(7890).
-p(7892) :- p(7891), q(7892).
-q(7892) :- p(7891), q(7891).
-p(7893) :- p(7892), q(7893).
-q(7893) :- p(7892), q(7892).
-p(7894) :- p(7893), q(7894).
-q(7894) :- p(7893), q(7893).
-p(7895) :- p(7894), q(7895).
-q(7895) :- p(7894), q(7894).
-p(7896) :- p(7895), q(7896).
-q(7896) :- p(7895), q(7895).
-p(7897) :- p(7896), q(7897).
-q(7897) :- p(7896), q(7896).
-p(7898) :- p(7897), q(7898).
-q(7898) :- p(7897), q(7897).
-p(7899) :- p(7898), q(7899).
-q(7899) :- p(7898), q(7898).
-p(7900) :- p(7899), q(7900).
-q(7900) :- p(7899), q(7899).
-p(7901) :- p(7900), q(7901).
-q(7901) :- p(7900), q(7900).
-p(7902) :- p(7901), q(7902).
-q(7902) :- p(7901), q(7901).
-p(7903) :- p(7902), q(7903).
-q(7903) :- p(7902), q(7902).
-p(7904) :- p(7903), q(7904).
-q(7904) :- p(7903), q(7903).
-p(7905) :- p(7904), q(7905).
-q(7905) :- p(7904), q(7904).
-p(7906) :- p(7905), q(7906).
-q(7906) :- p(7905), q(7905).
-p(7907) :- p(7906), q(7907).
-q(7907) :- p(7906), q(7906).
-p(7908) :- p(7907), q(7908).
-q(7908) :- p(7907), q(7907).
-p(7909) :- p(7908), q(7909).
-q(7909) :- p(7908), q(7908).
-p(7910) :- p(7909), q(7910).
-q(7910) :- p(7909), q(7909).
-p(7911) :- p(7910), q(7911).
-q(7911) :- p(7910), q(7910).
-p(7912) :- p(7911), q(7912).
-q(7912) :- p(7911), q(7911).
-p(7913) :- p(7912), q(7913).
-q(7913) :- p(7912), q(7912).
-p(7914) :- p(7913), q(7914).
-q(7914) :- p(7913), q(7913).
-p(7915) :- p(7914), q(7915).
-q(7915) :- p(7914), q(7914).
-p(7916) :- p(7915), q(7916).
-q(7916) :- p(7915), q(7915).
-p(7917) :- p(7916), q(7917).
-q(7917) :- p(7916), q(7916).
-p(7918) :- p(7917), q(7918).
-q(7918) :- p(7917), q(7917).
-p(7919) :- p(7918), q(7919).
-q(7919) :- p(7918), q(7918).
-p(7920) :- p(7919), q(7920).
-q(7920) :- p(7919), q(7919).
-p(7921) :- p(7920), q(7921).
-q(7921) :- p(7920), q(7920).
-p(7922) :- p(7921), q(7922).
-q(7922) :- p(7921), q(7921).
-p(7923) :- p(7922), q(7923).
-q(7923) :- p(7922), q(7922).
-p(7924) :- p(7923), q(7924).
-q(7924) :- p(7923), q(7923).
-p(7925) :- p(7924), q(7925).
-q(7925) :- p(7924), q(7924).
-p(7926) :- p(7925), q(7926).
-q(7926) :- p(7925), q(7925).
-p(7927) :- p(7926), q(7927).
-q(7927) :- p(7926), q(7926).
-p(7928) :- p(7927), q(7928).
-q(7928) :- p(7927), q(7927).
-p(7929) :- p(7928), q(7929).
-q(7929) :- p(7928), q(7928).
-p(7930) :- p(7929), q(7930).
-q(7930) :- p(7929), q(7929).
-p(7931) :- p(7930), q(7931).
-q(7931) :- p(7930), q(7930).
-p(7932) :- p(7931), q(7932).
-q(7932) :- p(7931), q(7931).
-p(7933) :- p(7932), q(7933).
-q(7933) :- p(7932), q(7932).
-p(7934) :- p(7933), q(7934).
-q(7934) :- p(7933), q(7933).
-p(7935) :- p(7934), q(7935).
-q(7935) :- p(7934), q(7934).
-p(7936) :- p(7935), q(7936).
-q(7936) :- p(7935), q(7935).
-p(7937) :- p(7936), q(7937).
-q(7937) :- p(7936), q(7936).
-p(7938) :- p(7937), q(7938).
-q(7938) :- p(7937), q(7937).
-p(7939) :- p(7938), q(7939).
-q(7939) :- p(7938), q(7938).
-p(7940) :- p(7939), q(7940).
-q(7940) :- p(7939), q(7939).
-p(7941) :- p(7940), q(7941).
-q(7941) :- p(7940), q(7940).
-p(7942) :- p(7941), q(7942).
-q(7942) :- p(7941), q(7941).
-p(7943) :- p(7942), q(7943).
-q(7943) :- p(7942), q(7942).
-p(7944) :- p(7943), q(7944).
-q(7944) :- p(7943), q(7943).
-p(7945) :- p(7944), q(7945).
-q(7945) :- p(7944), q(7944).
-p(7946) :- p(7945), q(7946).
-q(7946) :- p(7945), q(7945).
-p(7947) :- p(7946), q(7947).
-q(7947) :- p(7946), q(7946).
-p(7948) :- p(7947), q(7948).
-q(7948) :- p(7947), q(7947).
-p(7949) :- p(7948), q(7949).
-q(7949) :- p(7948), q(7948).
-p(7950) :- p(7949), q(7950).
-q(7950) :- p(7949), q(7949).
-p(7951) :- p(7950), q(7951).
-q(7951) :- p(7950), q(7950).
-p(7952) :- p(7951), q(7952).
-q(7952) :- p(7951), q(7951).
-p(7953) :- p(7952), q(7953).
-q(7953) :- p(7952), q(7952).
-p(7954) :- p(7953), q(7954).
-q(7954) :- p(7953), q(7953).
-p(7955) :- p(7954), q(7955).
-q(7955) :- p(7954), q(7954).
-p(7956) :- p(7955), q(7956).
-q(7956) :- p(7955), q(7955).
-p(7957) :- p(7956), q(7957).
-q(7957) :- p(7956), q(7956).
-p(7958) :- p(7957), q(7958).
-q(7958) :- p(7957), q(7957).
-p(7959) :- p(7958), q(7959).
-q(7959) :- p(7958), q(7958).
-p(7960) :- p(7959), q(7960).
-q(7960) :- p(7959), q(7959).
-p(7961) :- p(7960), q(7961).
-q(7961) :- p(7960), q(7960).
-p(7962) :- p(7961), q(7962).
-q(7962) :- p(7961), q(7961).
-p(7963) :- p(7962), q(7963).
-q(7963) :- p(7962), q(7962).
-p(7964) :- p(7963), q(7964).
-q(7964) :- p(7963), q(7963).
-p(7965) :- p(7964), q(7965).
-q(7965) :- p(7964), q(7964).
-p(7966) :- p(7965), q(7966).
-q(7966) :- p(7965), q(7965).
-p(7967) :- p(7966), q(7967).
-q(7967) :- p(7966), q(7966).
-p(7968) :- p(7967), q(7968).
-q(7968) :- p(7967), q(7967).
-p(7969) :- p(7968), q(7969).
-q(7969) :- p(7968), q(7968).
-p(7970) :- p(7969), q(7970).
-q(7970) :- p(7969), q(7969).
-p(7971) :- p(7970), q(7971).
-q(7971) :- p(7970), q(7970).
-p(7972) :- p(7971), q(7972).
-q(7972) :- p(7971), q(7971).
-p(7973) :- p(7972), q(7973).
-q(7973) :- p(7972), q(7972).
-p(7974) :- p(7973), q(7974).
-q(7974) :- p(7973), q(7973).
-p(7975) :- p(7974), q(7975).
-q(7975) :- p(7974), q(7974).
-p(7976) :- p(7975), q(7976).
-q(7976) :- p(7975), q(7975).
-p(7977) :- p(7976), q(7977).
-q(7977) :- p(7976), q(7976).
-p(7978) :- p(7977), q(7978).
-q(7978) :- p(7977), q(7977).
-p(7979) :- p(7978), q(7979).
-q(7979) :- p(7978), q(7978).
-p(7980) :- p(7979), q(7980).
-q(7980) :- p(7979), q(7979).
-p(7981) :- p(7980), q(7981).
-q(7981) :- p(7980), q(7980).
-p(7982) :- p(7981), q(7982).
-q(7982) :- p(7981), q(7981).
-p(7983) :- p(7982), q(7983).
-q(7983) :- p(7982), q(7982).
-p(7984) :- p(7983), q(7984).
-q(7984) :- p(7983), q(7983).
-p(7985) :- p(7984), q(7985).
-q(7985) :- p(7984), q(7984).
-p(7986) :- p(7985), q(7986).
-q(7986) :- p(7985), q(7985).
-p(7987) :- p(7986), q(7987).
-q(7987) :- p(7986), q(7986).
-p(7988) :- p(7987), q(7988).
-q(7988) :- p(7987), q(7987).
-p(7989) :- p(7988), q(7989).
-q(7989) :- p(7988), q(7988).
-p(7990) :- p(7989), q(7990).
-q(7990) :- p(7989), q(7989).
-p(7991) :- p(7990), q(7991).
-q(7991) :- p(7990), q(7990).
-p(7992) :- p(7991), q(7992).
-q(7992) :- p(7991), q(7991).
-p(7993) :- p(7992), q(7993).
-q(7993) :- p(7992), q(7992).
-p(7994) :- p(7993), q(7994).
-q(7994) :- p(7993), q(7993).
-p(7995) :- p(7994), q(7995).
-q(7995) :- p(7994), q(7994).
-p(7996) :- p(7995), q(7996).
-q(7996) :- p(7995), q(7995).
-p(7997) :- p(7996), q(7997).
-q(7997) :- p(7996), q(7996).
-p(7998) :- p(7997), q(7998).
-q(7998) :- p(7997), q(7997).
-p(7999) :- p(7998), q(7999).
-q(7999) :- p(7998), q(7998).
-p(8000) :- p(7999), q(8000).
-q(8000) :- p(7999), q(7999).
-p(8001) :- p(8000), q(8001).
-q(8001) :- p(8000), q(8000).
-p(8002) :- p(8001), q(8002).
-q(8002) :- p(8001), q(8001).
-p(8003) :- p(8002), q(8003).
-q(8003) :- p(8002), q(8002).
-p(8004) :- p(8003), q(8004).
-q(8004) :- p(8003), q(8003).
-p(8005) :- p(8004), q(8005).
-q(8005) :- p(8004), q(8004).
-p(8006) :- p(8005), q(8006).
-q(8006) :- p(8005), q(8005).
-p(8007) :- p(8006), q(8007).
-q(8007) :- p(8006), q(8006).
-p(8008) :- p(8007), q(8008).
-q(8008) :- p(8007), q(8007).
-p(8009) :- p(8008), q(8009).
-q(8009) :- p(8008), q(8008).
-p(8010) :- p(8009), q(8010).
-q(8010) :- p(8009), q(8009).
-p(8011) :- p(8010), q(8011).
-q(8011) :- p(8010), q(8010).
-p(8012) :- p(8011), q(8012).
-q(8012) :- p(8011), q(8011).
-p(8013) :- p(8012), q(8013).
-q(8013) :- p(8012), q(8012).
-p(8014) :- p(8013), q(8014).
-q(8014) :- p(8013), q(8013).
-p(8015) :- p(8014), q(8015).
-q(8015) :- p(8014), q(8014).
-p(8016) :- p(8015), q(8016).
-q(8016) :- p(8015), q(8015).
-p(8017) :- p(8016), q(8017).
-q(8017) :- p(8016), q(8016).
-p(8018) :- p(8017), q(8018).
-q(8018) :- p(8017), q(8017).
-p(8019) :- p(8018), q(8019).
-q(8019) :- p(8018), q(8018).
-p(8020) :- p(8019), q(8020).
-q(8020) :- p(8019), q(8019).
-p(8021) :- p(8020), q(8021).
-q(8021) :- p(8020), q(8020).
-p(8022) :- p(8021), q(8022).
-q(8022) :- p(8021), q(8021).
-p(8023) :- p(8022), q(8023).
-q(8023) :- p(8022), q(8022).
-p(8024) :- p(8023), q(8024).
-q(8024) :- p(8023), q(8023).
-p(8025) :- p(8024), q(8025).
-q(8025) :- p(8024), q(8024).
-p(8026) :- p(8025), q(8026).
-q(8026) :- p(8025), q(8025).
-p(8027) :- p(8026), q(8027).
-q(8027) :- p(8026), q(8026).
-p(8028) :- p(8027), q(8028).
-q(8028) :- p(8027), q(8027).
-p(8029) :- p(8028), q(8029).
-q(8029) :- p(8028), q(8028).
-p(8030) :- p(8029), q(8030).
-q(8030) :- p(8029), q(8029).
-p(8031) :- p(8030), q(8031).
-q(8031) :- p(8030), q(8030).
-p(8032) :- p(8031), q(8032).
-q(8032) :- p(8031), q(8031).
-p(8033) :- p(8032), q(8033).
-q(8033) :- p(8032), q(8032).
-p(8034) :- p(8033), q(8034).
-q(8034) :- p(8033), q(8033).
-p(8035) :- p(8034), q(8035).
-q(8035) :- p(8034), q(8034).
-p(8036) :- p(8035), q(8036).
-q(8036) :- p(8035), q(8035).
-p(8037) :- p(8036), q(8037).
-q(8037) :- p(8036), q(8036).
-p(8038) :- p(8037), q(8038).
-q(8038) :- p(8037), q(8037).
-p(8039) :- p(8038), q(8039).
-q(8039) :- p(8038), q(8038).
-p(8040) :- p(8039), q(8040).
-q(8040) :- p(8039), q(8039).
-p(8041) :- p(8040), q(8041).
-q(8041) :- p(8040), q(8040).
-p(8042) :- p(8041), q(8042).
-q(8042) :- p(8041), q(8041).
-p(8043) :- p(8042), q(8043).
-q(8043) :- p(8042), q(8042).
-p(8044) :- p(8043), q(8044).
-q(8044) :- p(8043), q(8043).
-p(8045) :- p(8044), q(8045).
-q(8045) :- p(8044), q(8044).
-p(8046) :- p(8045), q(8046).
-q(8046) :- p(8045), q(8045).
-p(8047) :- p(8046), q(8047).
-q(8047) :- p(8046), q(8046).
-p(8048) :- p(8047), q(8048).
-q(8048) :- p(8047), q(8047).
-p(8049) :- p(8048), q(8049).
-q(8049) :- p(8048), q(8048).
-p(8050) :- p(8049), q(8050).
-q(8050) :- p(8049), q(8049).
-p(8051) :- p(8050), q(8051).
-q(8051) :- p(8050), q(8050).
-p(8052) :- p(8051), q(8052).
-q(8052) :- p(8051), q(8051).
-p(8053) :- p(8052), q(8053).
-q(8053) :- p(8052), q(8052).
-p(8054) :- p(8053), q(8054).
-q(8054) :- p(8053), q(8053).
-p(8055) :- p(8054), q(8055).
-q(8055) :- p(8054), q(8054).
-p(8056) :- p(8055), q(8056).
-q(8056) :- p(8055), q(8055).
-p(8057) :- p(8056), q(8057).
-q(8057) :- p(8056), q(8056).
-p(8058) :- p(8057), q(8058).
-q(8058) :- p(8057), q(8057).
-p(8059) :- p(8058), q(8059).
-q(8059) :- p(8058), q(8058).
-p(8060) :- p(8059), q(8060).
-q(8060) :- p(8059), q(8059).
-p(8061) :- p(8060), q(8061).
-q(8061) :- p(8060), q(8060).
-p(8062) :- p(8061), q(8062).
-q(8062) :- p(8061), q(8061).
-p(8063) :- p(8062), q(8063).
-q(8063) :- p(8062), q(8062).
-p(8064) :- p(8063), q(8064).
-q(8064) :- p(8063), q(8063).
-p(8065) :- p(8064), q(8065).
-q(8065) :- p(8064), q(8064).
-p(8066) :- p(8065), q(8066).
-q(8066) :- p(8065), q(8065).
-p(8067) :- p(8066), q(8067).
-q(8067) :- p(8066), q(8066).
-p(8068) :- p(8067), q(8068).
-q(8068) :- p(8067), q(8067).
-p(8069) :- p(8068), q(8069).
-q(8069) :- p(8068), q(8068).
-p(8070) :- p(8069), q(8070).
-q(8070) :- p(8069), q(8069).
-p(8071) :- p(8070), q(8071).
-q(8071) :- p(8070), q(8070).
-p(8072) :- p(8071), q(8072).
-q(8072) :- p(8071), q(8071).
-p(8073) :- p(8072), q(8073).
-q(8073) :- p(8072), q(8072).
-p(8074) :- p(8073), q(8074).
-q(8074) :- p(8073), q(8073).
-p(8075) :- p(8074), q(8075).
-q(8075) :- p(8074), q(8074).
-p(8076) :- p(8075), q(8076).
-q(8076) :- p(8075), q(8075).
-p(8077) :- p(8076), q(8077).
-q(8077) :- p(8076), q(8076).
-p(8078) :- p(8077), q(8078).
-q(8078) :- p(8077), q(8077).
-p(8079) :- p(8078), q(8079).
-q(8079) :- p(8078), q(8078).
-p(8080) :- p(8079), q(8080).
-q(8080) :- p(8079), q(8079).
-p(8081) :- p(8080), q(8081).
-q(8081) :- p(8080), q(8080).
-p(8082) :- p(8081), q(8082).
-q(8082) :- p(8081), q(8081).
-p(8083) :- p(8082), q(8083).
-q(8083) :- p(8082), q(8082).
-p(8084) :- p(8083), q(8084).
-q(8084) :- p(8083), q(8083).
-p(8085) :- p(8084), q(8085).
-q(8085) :- p(8084), q(8084).
-p(8086) :- p(8085), q(8086).
-q(8086) :- p(8085), q(8085).
-p(8087) :- p(8086), q(8087).
-q(8087) :- p(8086), q(8086).
-p(8088) :- p(8087), q(8088).
-q(8088) :- p(8087), q(8087).
-p(8089) :- p(8088), q(8089).
-q(8089) :- p(8088), q(8088).
-p(8090) :- p(8089), q(8090).
-q(8090) :- p(8089), q(8089).
-p(8091) :- p(8090), q(8091).
-q(8091) :- p(8090), q(8090).
-p(8092) :- p(8091), q(8092).
-q(8092) :- p(8091), q(8091).
-p(8093) :- p(8092), q(8093).
-q(8093) :- p(8092), q(8092).
-p(8094) :- p(8093), q(8094).
-q(8094) :- p(8093), q(8093).
-p(8095) :- p(8094), q(8095).
-q(8095) :- p(8094), q(8094).
-p(8096) :- p(8095), q(8096).
-q(8096) :- p(8095), q(8095).
-p(8097) :- p(8096), q(8097).
-q(8097) :- p(8096), q(8096).
-p(8098) :- p(8097), q(8098).
-q(8098) :- p(8097), q(8097).
-p(8099) :- p(8098), q(8099).
-q(8099) :- p(8098), q(8098).
-p(8100) :- p(8099), q(8100).
-q(8100) :- p(8099), q(8099).
-p(8101) :- p(8100), q(8101).
-q(8101) :- p(8100), q(8100).
-p(8102) :- p(8101), q(8102).
-q(8102) :- p(8101), q(8101).
-p(8103) :- p(8102), q(8103).
-q(8103) :- p(8102), q(8102).
-p(8104) :- p(8103), q(8104).
-q(8104) :- p(8103), q(8103).
-p(8105) :- p(8104), q(8105).
-q(8105) :- p(8104), q(8104).
-p(8106) :- p(8105), q(8106).
-q(8106) :- p(8105), q(8105).
-p(8107) :- p(8106), q(8107).
-q(8107) :- p(8106), q(8106).
-p(8108) :- p(8107), q(8108).
-q(8108) :- p(8107), q(8107).
-p(8109) :- p(8108), q(8109).
-q(8109) :- p(8108), q(8108).
-p(8110) :- p(8109), q(8110).
-q(8110) :- p(8109), q(8109).
-p(8111) :- p(8110), q(8111).
-q(8111) :- p(8110), q(8110).
-p(8112) :- p(8111), q(8112).
-q(8112) :- p(8111), q(8111).
-p(8113) :- p(8112), q(8113).
-q(8113) :- p(8112), q(8112).
-p(8114) :- p(8113), q(8114).
-q(8114) :- p(8113), q(8113).
-p(8115) :- p(8114), q(8115).
-q(8115) :- p(8114), q(8114).
-p(8116) :- p(8115), q(8116).
-q(8116) :- p(8115), q(8115).
-p(8117) :- p(8116), q(8117).
-q(8117) :- p(8116), q(8116).
-p(8118) :- p(8117), q(8118).
-q(8118) :- p(8117), q(8117).
-p(8119) :- p(8118), q(8119).
-q(8119) :- p(8118), q(8118).
-p(8120) :- p(8119), q(8120).
-q(8120) :- p(8119), q(8119).
-p(8121) :- p(8120), q(8121).
-q(8121) :- p(8120), q(8120).
-p(8122) :- p(8121), q(8122).
-q(8122) :- p(8121), q(8121).
-p(8123) :- p(8122), q(8123).
-q(8123) :- p(8122), q(8122).
-p(8124) :- p(8123), q(8124).
-q(8124) :- p(8123), q(8123).
-p(8125) :- p(8124), q(8125).
-q(8125) :- p(8124), q(8124).
-p(8126) :- p(8125), q(8126).
-q(8126) :- p(8125), q(8125).
-p(8127) :- p(8126), q(8127).
-q(8127) :- p(8126), q(8126).
-p(8128) :- p(8127), q(8128).
-q(8128) :- p(8127), q(8127).
-p(8129) :- p(8128), q(8129).
-q(8129) :- p(8128), q(8128).
-p(8130) :- p(8129), q(8130).
-q(8130) :- p(8129), q(8129).
-p(8131) :- p(8130), q(8131).
-q(8131) :- p(8130), q(8130).
-p(8132) :- p(8131), q(8132).
-q(8132) :- p(8131), q(8131).
-p(8133) :- p(8132), q(8133).
-q(8133) :- p(8132), q(8132).
-p(8134) :- p(8133), q(8134).
-q(8134) :- p(8133), q(8133).
-p(8135) :- p(8134), q(8135).
-q(8135) :- p(8134), q(8134).
-p(8136) :- p(8135), q(8136).
-q(8136) :- p(8135), q(8135).
-p(8137) :- p(8136), q(8137).
-q(8137) :- p(8136), q(8136).
-p(8138) :- p(8137), q(8138).
-q(8138) :- p(8137), q(8137).
-p(8139) :- p(8138), q(8139).
-q(8139) :- p(8138), q(8138).
-p(8140) :- p(8139), q(8140).
-q(8140) :- p(8139), q(8139).
-p(8141) :- p(8140), q(8141).
-q(8141) :- p(8140), q(8140).
-p(8142) :- p(8141), q(8142).
-q(8142) :- p(8141), q(8141).
-p(8143) :- p(8142), q(8143).
-q(8143) :- p(8142), q(8142).
-p(8144) :- p(8143), q(8144).
-q(8144) :- p(8143), q(8143).
-p(8145) :- p(8144), q(8145).
-q(8145) :- p(8144), q(8144).
-p(8146) :- p(8145), q(8146).
-q(8146) :- p(8145), q(8145).
-p(8147) :- p(8146), q(8147).
-q(8147) :- p(8146), q(8146).
-p(8148) :- p(8147), q(8148).
-q(8148) :- p(8147), q(8147).
-p(8149) :- p(8148), q(8149).
-q(8149) :- p(8148), q(8148).
-p(8150) :- p(8149), q(8150).
-q(8150) :- p(8149), q(8149).
-p(8151) :- p(8150), q(8151).
-q(8151) :- p(8150), q(8150).
-p(8152) :- p(8151), q(8152).
-q(8152) :- p(8151), q(8151).
-p(8153) :- p(8152), q(8153).
-q(8153) :- p(8152), q(8152).
-p(8154) :- p(8153), q(8154).
-q(8154) :- p(8153), q(8153).
-p(8155) :- p(8154), q(8155).
-q(8155) :- p(8154), q(8154).
-p(8156) :- p(8155), q(8156).
-q(8156) :- p(8155), q(8155).
-p(8157) :- p(8156), q(8157).
-q(8157) :- p(8156), q(8156).
-p(8158) :- p(8157), q(8158).
-q(8158) :- p(8157), q(8157).
-p(8159) :- p(8158), q(8159).
-q(8159) :- p(8158), q(8158).
-p(8160) :- p(8159), q(8160).
-q(8160) :- p(8159), q(8159).
-p(8161) :- p(8160), q(8161).
-q(8161) :- p(8160), q(8160).
-p(8162) :- p(8161), q(8162).
-q(8162) :- p(8161), q(8161).
-p(8163) :- p(8162), q(8163).
-q(8163) :- p(8162), q(8162).
-p(8164) :- p(8163), q(8164).
-q(8164) :- p(8163), q(8163).
-p(8165) :- p(8164), q(8165).
-q(8165) :- p(8164), q(8164).
-p(8166) :- p(8165), q(8166).
-q(8166) :- p(8165), q(8165).
-p(8167) :- p(8166), q(8167).
-q(8167) :- p(8166), q(8166).
-p(8168) :- p(8167), q(8168).
-q(8168) :- p(8167), q(8167).
-p(8169) :- p(8168), q(8169).
-q(8169) :- p(8168), q(8168).
-p(8170) :- p(8169), q(8170).
-q(8170) :- p(8169), q(8169).
-p(8171) :- p(8170), q(8171).
-q(8171) :- p(8170), q(8170).
-p(8172) :- p(8171), q(8172).
-q(8172) :- p(8171), q(8171).
-p(8173) :- p(8172), q(8173).
-q(8173) :- p(8172), q(8172).
-p(8174) :- p(8173), q(8174).
-q(8174) :- p(8173), q(8173).
-p(8175) :- p(8174), q(8175).
-q(8175) :- p(8174), q(8174).
-p(8176) :- p(8175), q(8176).
-q(8176) :- p(8175), q(8175).
-p(8177) :- p(8176), q(8177).
-q(8177) :- p(8176), q(8176).
-p(8178) :- p(8177), q(8178).
-q(8178) :- p(8177), q(8177).
-p(8179) :- p(8178), q(8179).
-q(8179) :- p(8178), q(8178).
-p(8180) :- p(8179), q(8180).
-q(8180) :- p(8179), q(8179).
-p(8181) :- p(8180), q(8181).
-q(8181) :- p(8180), q(8180).
-p(8182) :- p(8181), q(8182).
-q(8182) :- p(8181), q(8181).
-p(8183) :- p(8182), q(8183).
-q(8183) :- p(8182), q(8182).
-p(8184) :- p(8183), q(8184).
-q(8184) :- p(8183), q(8183).
-p(8185) :- p(8184), q(8185).
-q(8185) :- p(8184), q(8184).
-p(8186) :- p(8185), q(8186).
-q(8186) :- p(8185), q(8185).
-p(8187) :- p(8186), q(8187).
-q(8187) :- p(8186), q(8186).
-p(8188) :- p(8187), q(8188).
-q(8188) :- p(8187), q(8187).
-p(8189) :- p(8188), q(8189).
-q(8189) :- p(8188), q(8188).
-p(8190) :- p(8189), q(8190).
-q(8190) :- p(8189), q(8189).
-p(8191) :- p(8190), q(8191).
-q(8191) :- p(8190), q(8190).
-p(8192) :- p(8191), q(8192).
-q(8192) :- p(8191), q(8191).
-p(8193) :- p(8192), q(8193).
-q(8193) :- p(8192), q(8192).
-p(8194) :- p(8193), q(8194).
-q(8194) :- p(8193), q(8193).
-p(8195) :- p(8194), q(8195).
-q(8195) :- p(8194), q(8194).
-p(8196) :- p(8195), q(8196).
-q(8196) :- p(8195), q(8195).
-p(8197) :- p(8196), q(8197).
-q(8197) :- p(8196), q(8196).
-p(8198) :- p(8197), q(8198).
-q(8198) :- p(8197), q(8197).
-p(8199) :- p(8198), q(8199).
-q(8199) :- p(8198), q(8198).
-p(8200) :- p(8199), q(8200).
-q(8200) :- p(8199), q(8199).
-p(8201) :- p(8200), q(8201).
-q(8201) :- p(8200), q(8200).
-p(8202) :- p(8201), q(8202).
-q(8202) :- p(8201), q(8201).
-p(8203) :- p(8202), q(8203).
-q(8203) :- p(8202), q(8202).
-p(8204) :- p(8203), q(8204).
-q(8204) :- p(8203), q(8203).
-p(8205) :- p(8204), q(8205).
-q(8205) :- p(8204), q(8204).
-p(8206) :- p(8205), q(8206).
-q(8206) :- p(8205), q(8205).
-p(8207) :- p(8206), q(8207).
-q(8207) :- p(8206), q(8206).
-p(8208) :- p(8207), q(8208).
-q(8208) :- p(8207), q(8207).
-p(8209) :- p(8208), q(8209).
-q(8209) :- p(8208), q(8208).
-p(8210) :- p(8209), q(8210).
-q(8210) :- p(8209), q(8209).
-p(8211) :- p(8210), q(8211).
-q(8211) :- p(8210), q(8210).
-p(8212) :- p(8211), q(8212).
-q(8212) :- p(8211), q(8211).
-p(8213) :- p(8212), q(8213).
-q(8213) :- p(8212), q(8212).
-p(8214) :- p(8213), q(8214).
-q(8214) :- p(8213), q(8213).
-p(8215) :- p(8214), q(8215).
-q(8215) :- p(8214), q(8214).
-p(8216) :- p(8215), q(8216).
-q(8216) :- p(8215), q(8215).
-p(8217) :- p(8216), q(8217).
-q(8217) :- p(8216), q(8216).
-p(8218) :- p(8217), q(8218).
-q(8218) :- p(8217), q(8217).
-p(8219) :- p(8218), q(8219).
-q(8219) :- p(8218), q(8218).
-p(8220) :- p(8219), q(8220).
-q(8220) :- p(8219), q(8219).
-p(8221) :- p(8220), q(8221).
-q(8221) :- p(8220), q(8220).
-p(8222) :- p(8221), q(8222).
-q(8222) :- p(8221), q(8221).
-p(8223) :- p(8222), q(8223).
-q(8223) :- p(8222), q(8222).
-p(8224) :- p(8223), q(8224).
-q(8224) :- p(8223), q(8223).
-p(8225) :- p(8224), q(8225).
-q(8225) :- p(8224), q(8224).
-p(8226) :- p(8225), q(8226).
-q(8226) :- p(8225), q(8225).
-p(8227) :- p(8226), q(8227).
-q(8227) :- p(8226), q(8226).
-p(8228) :- p(8227), q(8228).
-q(8228) :- p(8227), q(8227).
-p(8229) :- p(8228), q(8229).
-q(8229) :- p(8228), q(8228).
-p(8230) :- p(8229), q(8230).
-q(8230) :- p(8229), q(8229).
-p(8231) :- p(8230), q(8231).
-q(8231) :- p(8230), q(8230).
-p(8232) :- p(8231), q(8232).
-q(8232) :- p(8231), q(8231).
-p(8233) :- p(8232), q(8233).
-q(8233) :- p(8232), q(8232).
-p(8234) :- p(8233), q(8234).
-q(8234) :- p(8233), q(8233).
-p(8235) :- p(8234), q(8235).
-q(8235) :- p(8234), q(8234).
-p(8236) :- p(8235), q(8236).
-q(8236) :- p(8235), q(8235).
-p(8237) :- p(8236), q(8237).
-q(8237) :- p(8236), q(8236).
-p(8238) :- p(8237), q(8238).
-q(8238) :- p(8237), q(8237).
-p(8239) :- p(8238), q(8239).
-q(8239) :- p(8238), q(8238).
-p(8240) :- p(8239), q(8240).
-q(8240) :- p(8239), q(8239).
-p(8241) :- p(8240), q(8241).
-q(8241) :- p(8240), q(8240).
-p(8242) :- p(8241), q(8242).
-q(8242) :- p(8241), q(8241).
-p(8243) :- p(8242), q(8243).
-q(8243) :- p(8242), q(8242).
-p(8244) :- p(8243), q(8244).
-q(8244) :- p(8243), q(8243).
-p(8245) :- p(8244), q(8245).
-q(8245) :- p(8244), q(8244).
-p(8246) :- p(8245), q(8246).
-q(8246) :- p(8245), q(8245).
-p(8247) :- p(8246), q(8247).
-q(8247) :- p(8246), q(8246).
-p(8248) :- p(8247), q(8248).
-q(8248) :- p(8247), q(8247).
-p(8249) :- p(8248), q(8249).
-q(8249) :- p(8248), q(8248).
-p(8250) :- p(8249), q(8250).
-q(8250) :- p(8249), q(8249).
-p(8251) :- p(8250), q(8251).
-q(8251) :- p(8250), q(8250).
-p(8252) :- p(8251), q(8252).
-q(8252) :- p(8251), q(8251).
-p(8253) :- p(8252), q(8253).
-q(8253) :- p(8252), q(8252).
-p(8254) :- p(8253), q(8254).
-q(8254) :- p(8253), q(8253).
-p(8255) :- p(8254), q(8255).
-q(8255) :- p(8254), q(8254).
-p(8256) :- p(8255), q(8256).
-q(8256) :- p(8255), q(8255).
-p(8257) :- p(8256), q(8257).
-q(8257) :- p(8256), q(8256).
-p(8258) :- p(8257), q(8258).
-q(8258) :- p(8257), q(8257).
-p(8259) :- p(8258), q(8259).
-q(8259) :- p(8258), q(8258).
-p(8260) :- p(8259), q(8260).
-q(8260) :- p(8259), q(8259).
-p(8261) :- p(8260), q(8261).
-q(8261) :- p(8260), q(8260).
-p(8262) :- p(8261), q(8262).
-q(8262) :- p(8261), q(8261).
-p(8263) :- p(8262), q(8263).
-q(8263) :- p(8262), q(8262).
-p(8264) :- p(8263), q(8264).
-q(8264) :- p(8263), q(8263).
-p(8265) :- p(8264), q(8265).
-q(8265) :- p(8264), q(8264).
-p(8266) :- p(8265), q(8266).
-q(8266) :- p(8265), q(8265).
-p(8267) :- p(8266), q(8267).
-q(8267) :- p(8266), q(8266).
-p(8268) :- p(8267), q(8268).
-q(8268) :- p(8267), q(8267).
-p(8269) :- p(8268), q(8269).
-q(8269) :- p(8268), q(8268).
-p(8270) :- p(8269), q(8270).
-q(8270) :- p(8269), q(8269).
-p(8271) :- p(8270), q(8271).
-q(8271) :- p(8270), q(8270).
-p(8272) :- p(8271), q(8272).
-q(8272) :- p(8271), q(8271).
-p(8273) :- p(8272), q(8273).
-q(8273) :- p(8272), q(8272).
-p(8274) :- p(8273), q(8274).
-q(8274) :- p(8273), q(8273).
-p(8275) :- p(8274), q(8275).
-q(8275) :- p(8274), q(8274).
-p(8276) :- p(8275), q(8276).
-q(8276) :- p(8275), q(8275).
-p(8277) :- p(8276), q(8277).
-q(8277) :- p(8276), q(8276).
-p(8278) :- p(8277), q(8278).
-q(8278) :- p(8277), q(8277).
-p(8279) :- p(8278), q(8279).
-q(8279) :- p(8278), q(8278).
-p(8280) :- p(8279), q(8280).
-q(8280) :- p(8279), q(8279).
-p(8281) :- p(8280), q(8281).
-q(8281) :- p(8280), q(8280).
-p(8282) :- p(8281), q(8282).
-q(8282) :- p(8281), q(8281).
-p(8283) :- p(8282), q(8283).
-q(8283) :- p(8282), q(8282).
-p(8284) :- p(8283), q(8284).
-q(8284) :- p(8283), q(8283).
-p(8285) :- p(8284), q(8285).
-q(8285) :- p(8284), q(8284).
-p(8286) :- p(8285), q(8286).
-q(8286) :- p(8285), q(8285).
-p(8287) :- p(8286), q(8287).
-q(8287) :- p(8286), q(8286).
-p(8288) :- p(8287), q(8288).
-q(8288) :- p(8287), q(8287).
-p(8289) :- p(8288), q(8289).
-q(8289) :- p(8288), q(8288).
-p(8290) :- p(8289), q(8290).
-q(8290) :- p(8289), q(8289).
-p(8291) :- p(8290), q(8291).
-q(8291) :- p(8290), q(8290).
-p(8292) :- p(8291), q(8292).
-q(8292) :- p(8291), q(8291).
-p(8293) :- p(8292), q(8293).
-q(8293) :- p(8292), q(8292).
-p(8294) :- p(8293), q(8294).
-q(8294) :- p(8293), q(8293).
-p(8295) :- p(8294), q(8295).
-q(8295) :- p(8294), q(8294).
-p(8296) :- p(8295), q(8296).
-q(8296) :- p(8295), q(8295).
-p(8297) :- p(8296), q(8297).
-q(8297) :- p(8296), q(8296).
-p(8298) :- p(8297), q(8298).
-q(8298) :- p(8297), q(8297).
-p(8299) :- p(8298), q(8299).
-q(8299) :- p(8298), q(8298).
-p(8300) :- p(8299), q(8300).
-q(8300) :- p(8299), q(8299).
-p(8301) :- p(8300), q(8301).
-q(8301) :- p(8300), q(8300).
-p(8302) :- p(8301), q(8302).
-q(8302) :- p(8301), q(8301).
-p(8303) :- p(8302), q(8303).
-q(8303) :- p(8302), q(8302).
-p(8304) :- p(8303), q(8304).
-q(8304) :- p(8303), q(8303).
-p(8305) :- p(8304), q(8305).
-q(8305) :- p(8304), q(8304).
-p(8306) :- p(8305), q(8306).
-q(8306) :- p(8305), q(8305).
-p(8307) :- p(8306), q(8307).
-q(8307) :- p(8306), q(8306).
-p(8308) :- p(8307), q(8308).
-q(8308) :- p(8307), q(8307).
-p(8309) :- p(8308), q(8309).
-q(8309) :- p(8308), q(8308).
-p(8310) :- p(8309), q(8310).
-q(8310) :- p(8309), q(8309).
-p(8311) :- p(8310), q(8311).
-q(8311) :- p(8310), q(8310).
-p(8312) :- p(8311), q(8312).
-q(8312) :- p(8311), q(8311).
-p(8313) :- p(8312), q(8313).
-q(8313) :- p(8312), q(8312).
-p(8314) :- p(8313), q(8314).
-q(8314) :- p(8313), q(8313).
-p(8315) :- p(8314), q(8315).
-q(8315) :- p(8314), q(8314).
-p(8316) :- p(8315), q(8316).
-q(8316) :- p(8315), q(8315).
-p(8317) :- p(8316), q(8317).
-q(8317) :- p(8316), q(8316).
-p(8318) :- p(8317), q(8318).
-q(8318) :- p(8317), q(8317).
-p(8319) :- p(8318), q(8319).
-q(8319) :- p(8318), q(8318).
-p(8320) :- p(8319), q(8320).
-q(8320) :- p(8319), q(8319).
-p(8321) :- p(8320), q(8321).
-q(8321) :- p(8320), q(8320).
-p(8322) :- p(8321), q(8322).
-q(8322) :- p(8321), q(8321).
-p(8323) :- p(8322), q(8323).
-q(8323) :- p(8322), q(8322).
-p(8324) :- p(8323), q(8324).
-q(8324) :- p(8323), q(8323).
-p(8325) :- p(8324), q(8325).
-q(8325) :- p(8324), q(8324).
-p(8326) :- p(8325), q(8326).
-q(8326) :- p(8325), q(8325).
-p(8327) :- p(8326), q(8327).
-q(8327) :- p(8326), q(8326).
-p(8328) :- p(8327), q(8328).
-q(8328) :- p(8327), q(8327).
-p(8329) :- p(8328), q(8329).
-q(8329) :- p(8328), q(8328).
-p(8330) :- p(8329), q(8330).
-q(8330) :- p(8329), q(8329).
-p(8331) :- p(8330), q(8331).
-q(8331) :- p(8330), q(8330).
-p(8332) :- p(8331), q(8332).
-q(8332) :- p(8331), q(8331).
-p(8333) :- p(8332), q(8333).
-q(8333) :- p(8332), q(8332).
-p(8334) :- p(8333), q(8334).
-q(8334) :- p(8333), q(8333).
-p(8335) :- p(8334), q(8335).
-q(8335) :- p(8334), q(8334).
-p(8336) :- p(8335), q(8336).
-q(8336) :- p(8335), q(8335).
-p(8337) :- p(8336), q(8337).
-q(8337) :- p(8336), q(8336).
-p(8338) :- p(8337), q(8338).
-q(8338) :- p(8337), q(8337).
-p(8339) :- p(8338), q(8339).
-q(8339) :- p(8338), q(8338).
-p(8340) :- p(8339), q(8340).
-q(8340) :- p(8339), q(8339).
-p(8341) :- p(8340), q(8341).
-q(8341) :- p(8340), q(8340).
-p(8342) :- p(8341), q(8342).
-q(8342) :- p(8341), q(8341).
-p(8343) :- p(8342), q(8343).
-q(8343) :- p(8342), q(8342).
-p(8344) :- p(8343), q(8344).
-q(8344) :- p(8343), q(8343).
-p(8345) :- p(8344), q(8345).
-q(8345) :- p(8344), q(8344).
-p(8346) :- p(8345), q(8346).
-q(8346) :- p(8345), q(8345).
-p(8347) :- p(8346), q(8347).
-q(8347) :- p(8346), q(8346).
-p(8348) :- p(8347), q(8348).
-q(8348) :- p(8347), q(8347).
-p(8349) :- p(8348), q(8349).
-q(8349) :- p(8348), q(8348).
-p(8350) :- p(8349), q(8350).
-q(8350) :- p(8349), q(8349).
-p(8351) :- p(8350), q(8351).
-q(8351) :- p(8350), q(8350).
-p(8352) :- p(8351), q(8352).
-q(8352) :- p(8351), q(8351).
-p(8353) :- p(8352), q(8353).
-q(8353) :- p(8352), q(8352).
-p(8354) :- p(8353), q(8354).
-q(8354) :- p(8353), q(8353).
-p(8355) :- p(8354), q(8355).
-q(8355) :- p(8354), q(8354).
-p(8356) :- p(8355), q(8356).
-q(8356) :- p(8355), q(8355).
-p(8357) :- p(8356), q(8357).
-q(8357) :- p(8356), q(8356).
-p(8358) :- p(8357), q(8358).
-q(8358) :- p(8357), q(8357).
-p(8359) :- p(8358), q(8359).
-q(8359) :- p(8358), q(8358).
-p(8360) :- p(8359), q(8360).
-q(8360) :- p(8359), q(8359).
-p(8361) :- p(8360), q(8361).
-q(8361) :- p(8360), q(8360).
-p(8362) :- p(8361), q(8362).
-q(8362) :- p(8361), q(8361).
-p(8363) :- p(8362), q(8363).
-q(8363) :- p(8362), q(8362).
-p(8364) :- p(8363), q(8364).
-q(8364) :- p(8363), q(8363).
-p(8365) :- p(8364), q(8365).
-q(8365) :- p(8364), q(8364).
-p(8366) :- p(8365), q(8366).
-q(8366) :- p(8365), q(8365).
-p(8367) :- p(8366), q(8367).
-q(8367) :- p(8366), q(8366).
-p(8368) :- p(8367), q(8368).
-q(8368) :- p(8367), q(8367).
-p(8369) :- p(8368), q(8369).
-q(8369) :- p(8368), q(8368).
-p(8370) :- p(8369), q(8370).
-q(8370) :- p(8369), q(8369).
-p(8371) :- p(8370), q(8371).
-q(8371) :- p(8370), q(8370).
-p(8372) :- p(8371), q(8372).
-q(8372) :- p(8371), q(8371).
-p(8373) :- p(8372), q(8373).
-q(8373) :- p(8372), q(8372).
-p(8374) :- p(8373), q(8374).
-q(8374) :- p(8373), q(8373).
-p(8375) :- p(8374), q(8375).
-q(8375) :- p(8374), q(8374).
-p(8376) :- p(8375), q(8376).
-q(8376) :- p(8375), q(8375).
-p(8377) :- p(8376), q(8377).
-q(8377) :- p(8376), q(8376).
-p(8378) :- p(8377), q(8378).
-q(8378) :- p(8377), q(8377).
-p(8379) :- p(8378), q(8379).
-q(8379) :- p(8378), q(8378).
-p(8380) :- p(8379), q(8380).
-q(8380) :- p(8379), q(8379).
-p(8381) :- p(8380), q(8381).
-q(8381) :- p(8380), q(8380).
-p(8382) :- p(8381), q(8382).
-q(8382) :- p(8381), q(8381).
-p(8383) :- p(8382), q(8383).
-q(8383) :- p(8382), q(8382).
-p(8384) :- p(8383), q(8384).
-q(8384) :- p(8383), q(8383).
-p(8385) :- p(8384), q(8385).
-q(8385) :- p(8384), q(8384).
-p(8386) :- p(8385), q(8386).
-q(8386) :- p(8385), q(8385).
-p(8387) :- p(8386), q(8387).
-q(8387) :- p(8386), q(8386).
-p(8388) :- p(8387), q(8388).
-q(8388) :- p(8387), q(8387).
-p(8389) :- p(8388), q(8389).
-q(8389) :- p(8388), q(8388).
-p(8390) :- p(8389), q(8390).
-q(8390) :- p(8389), q(8389).
-p(8391) :- p(8390), q(8391).
-q(8391) :- p(8390), q(8390).
-p(8392) :- p(8391), q(8392).
-q(8392) :- p(8391), q(8391).
-p(8393) :- p(8392), q(8393).
-q(8393) :- p(8392), q(8392).
-p(8394) :- p(8393), q(8394).
-q(8394) :- p(8393), q(8393).
-p(8395) :- p(8394), q(8395).
-q(8395) :- p(8394), q(8394).
-p(8396) :- p(8395), q(8396).
-q(8396) :- p(8395), q(8395).
-p(8397) :- p(8396), q(8397).
-q(8397) :- p(8396), q(8396).
-p(8398) :- p(8397), q(8398).
-q(8398) :- p(8397), q(8397).
-p(8399) :- p(8398), q(8399).
-q(8399) :- p(8398), q(8398).
-p(8400) :- p(8399), q(8400).
-q(8400) :- p(8399), q(8399).
-p(8401) :- p(8400), q(8401).
-q(8401) :- p(8400), q(8400).
-p(8402) :- p(8401), q(8402).
-q(8402) :- p(8401), q(8401).
-p(8403) :- p(8402), q(8403).
-q(8403) :- p(8402), q(8402).
-p(8404) :- p(8403), q(8404).
-q(8404) :- p(8403), q(8403).
-p(8405) :- p(8404), q(8405).
-q(8405) :- p(8404), q(8404).
-p(8406) :- p(8405), q(8406).
-q(8406) :- p(8405), q(8405).
-p(8407) :- p(8406), q(8407).
-q(8407) :- p(8406), q(8406).
-p(8408) :- p(8407), q(8408).
-q(8408) :- p(8407), q(8407).
-p(8409) :- p(8408), q(8409).
-q(8409) :- p(8408), q(8408).
-p(8410) :- p(8409), q(8410).
-q(8410) :- p(8409), q(8409).
-p(8411) :- p(8410), q(8411).
-q(8411) :- p(8410), q(8410).
-p(8412) :- p(8411), q(8412).
-q(8412) :- p(8411), q(8411).
-p(8413) :- p(8412), q(8413).
-q(8413) :- p(8412), q(8412).
-p(8414) :- p(8413), q(8414).
-q(8414) :- p(8413), q(8413).
-p(8415) :- p(8414), q(8415).
-q(8415) :- p(8414), q(8414).
-p(8416) :- p(8415), q(8416).
-q(8416) :- p(8415), q(8415).
-p(8417) :- p(8416), q(8417).
-q(8417) :- p(8416), q(8416).
-p(8418) :- p(8417), q(8418).
-q(8418) :- p(8417), q(8417).
-p(8419) :- p(8418), q(8419).
-q(8419) :- p(8418), q(8418).
-p(8420) :- p(8419), q(8420).
-q(8420) :- p(8419), q(8419).
-p(8421) :- p(8420), q(8421).
-q(8421) :- p(8420), q(8420).
-p(8422) :- p(8421), q(8422).
-q(8422) :- p(8421), q(8421).
-p(8423) :- p(8422), q(8423).
-q(8423) :- p(8422), q(8422).
-p(8424) :- p(8423), q(8424).
-q(8424) :- p(8423), q(8423).
-p(8425) :- p(8424), q(8425).
-q(8425) :- p(8424), q(8424).
-p(8426) :- p(8425), q(8426).
-q(8426) :- p(8425), q(8425).
-p(8427) :- p(8426), q(8427).
-q(8427) :- p(8426), q(8426).
-p(8428) :- p(8427), q(8428).
-q(8428) :- p(8427), q(8427).
-p(8429) :- p(8428), q(8429).
-q(8429) :- p(8428), q(8428).
-p(8430) :- p(8429), q(8430).
-q(8430) :- p(8429), q(8429).
-p(8431) :- p(8430), q(8431).
-q(8431) :- p(8430), q(8430).
-p(8432) :- p(8431), q(8432).
-q(8432) :- p(8431), q(8431).
-p(8433) :- p(8432), q(8433).
-q(8433) :- p(8432), q(8432).
-p(8434) :- p(8433), q(8434).
-q(8434) :- p(8433), q(8433).
-p(8435) :- p(8434), q(8435).
-q(8435) :- p(8434), q(8434).
-p(8436) :- p(8435), q(8436).
-q(8436) :- p(8435), q(8435).
-p(8437) :- p(8436), q(8437).
-q(8437) :- p(8436), q(8436).
-p(8438) :- p(8437), q(8438).
-q(8438) :- p(8437), q(8437).
-p(8439) :- p(8438), q(8439).
-q(8439) :- p(8438), q(8438).
-p(8440) :- p(8439), q(8440).
-q(8440) :- p(8439), q(8439).
-p(8441) :- p(8440), q(8441).
-q(8441) :- p(8440), q(8440).
-p(8442) :- p(8441), q(8442).
-q(8442) :- p(8441), q(8441).
-p(8443) :- p(8442), q(8443).
-q(8443) :- p(8442), q(8442).
-p(8444) :- p(8443), q(8444).
-q(8444) :- p(8443), q(8443).
-p(8445) :- p(8444), q(8445).
-q(8445) :- p(8444), q(8444).
-p(8446) :- p(8445), q(8446).
-q(8446) :- p(8445), q(8445).
-p(8447) :- p(8446), q(8447).
-q(8447) :- p(8446), q(8446).
-p(8448) :- p(8447), q(8448).
-q(8448) :- p(8447), q(8447).
-p(8449) :- p(8448), q(8449).
-q(8449) :- p(8448), q(8448).
-p(8450) :- p(8449), q(8450).
-q(8450) :- p(8449), q(8449).
-p(8451) :- p(8450), q(8451).
-q(8451) :- p(8450), q(8450).
-p(8452) :- p(8451), q(8452).
-q(8452) :- p(8451), q(8451).
-p(8453) :- p(8452), q(8453).
-q(8453) :- p(8452), q(8452).
-p(8454) :- p(8453), q(8454).
-q(8454) :- p(8453), q(8453).
-p(8455) :- p(8454), q(8455).
-q(8455) :- p(8454), q(8454).
-p(8456) :- p(8455), q(8456).
-q(8456) :- p(8455), q(8455).
-p(8457) :- p(8456), q(8457).
-q(8457) :- p(8456), q(8456).
-p(8458) :- p(8457), q(8458).
-q(8458) :- p(8457), q(8457).
-p(8459) :- p(8458), q(8459).
-q(8459) :- p(8458), q(8458).
-p(8460) :- p(8459), q(8460).
-q(8460) :- p(8459), q(8459).
-p(8461) :- p(8460), q(8461).
-q(8461) :- p(8460), q(8460).
-p(8462) :- p(8461), q(8462).
-q(8462) :- p(8461), q(8461).
-p(8463) :- p(8462), q(8463).
-q(8463) :- p(8462), q(8462).
-p(8464) :- p(8463), q(8464).
-q(8464) :- p(8463), q(8463).
-p(8465) :- p(8464), q(8465).
-q(8465) :- p(8464), q(8464).
-p(8466) :- p(8465), q(8466).
-q(8466) :- p(8465), q(8465).
-p(8467) :- p(8466), q(8467).
-q(8467) :- p(8466), q(8466).
-p(8468) :- p(8467), q(8468).
-q(8468) :- p(8467), q(8467).
-p(8469) :- p(8468), q(8469).
-q(8469) :- p(8468), q(8468).
-p(8470) :- p(8469), q(8470).
-q(8470) :- p(8469), q(8469).
-p(8471) :- p(8470), q(8471).
-q(8471) :- p(8470), q(8470).
-p(8472) :- p(8471), q(8472).
-q(8472) :- p(8471), q(8471).
-p(8473) :- p(8472), q(8473).
-q(8473) :- p(8472), q(8472).
-p(8474) :- p(8473), q(8474).
-q(8474) :- p(8473), q(8473).
-p(8475) :- p(8474), q(8475).
-q(8475) :- p(8474), q(8474).
-p(8476) :- p(8475), q(8476).
-q(8476) :- p(8475), q(8475).
-p(8477) :- p(8476), q(8477).
-q(8477) :- p(8476), q(8476).
-p(8478) :- p(8477), q(8478).
-q(8478) :- p(8477), q(8477).
-p(8479) :- p(8478), q(8479).
-q(8479) :- p(8478), q(8478).
-p(8480) :- p(8479), q(8480).
-q(8480) :- p(8479), q(8479).
-p(8481) :- p(8480), q(8481).
-q(8481) :- p(8480), q(8480).
-p(8482) :- p(8481), q(8482).
-q(8482) :- p(8481), q(8481).
-p(8483) :- p(8482), q(8483).
-q(8483) :- p(8482), q(8482).
-p(8484) :- p(8483), q(8484).
-q(8484) :- p(8483), q(8483).
-p(8485) :- p(8484), q(8485).
-q(8485) :- p(8484), q(8484).
-p(8486) :- p(8485), q(8486).
-q(8486) :- p(8485), q(8485).
-p(8487) :- p(8486), q(8487).
-q(8487) :- p(8486), q(8486).
-p(8488) :- p(8487), q(8488).
-q(8488) :- p(8487), q(8487).
-p(8489) :- p(8488), q(8489).
-q(8489) :- p(8488), q(8488).
-p(8490) :- p(8489), q(8490).
-q(8490) :- p(8489), q(8489).
-p(8491) :- p(8490), q(8491).
-q(8491) :- p(8490), q(8490).
-p(8492) :- p(8491), q(8492).
-q(8492) :- p(8491), q(8491).
-p(8493) :- p(8492), q(8493).
-q(8493) :- p(8492), q(8492).
-p(8494) :- p(8493), q(8494).
-q(8494) :- p(8493), q(8493).
-p(8495) :- p(8494), q(8495).
-q(8495) :- p(8494), q(8494).
-p(8496) :- p(8495), q(8496).
-q(8496) :- p(8495), q(8495).
-p(8497) :- p(8496), q(8497).
-q(8497) :- p(8496), q(8496).
-p(8498) :- p(8497), q(8498).
-q(8498) :- p(8497), q(8497).
-p(8499) :- p(8498), q(8499).
-q(8499) :- p(8498), q(8498).
-p(8500) :- p(8499), q(8500).
-q(8500) :- p(8499), q(8499).
-p(8501) :- p(8500), q(8501).
-q(8501) :- p(8500), q(8500).
-p(8502) :- p(8501), q(8502).
-q(8502) :- p(8501), q(8501).
-p(8503) :- p(8502), q(8503).
-q(8503) :- p(8502), q(8502).
-p(8504) :- p(8503), q(8504).
-q(8504) :- p(8503), q(8503).
-p(8505) :- p(8504), q(8505).
-q(8505) :- p(8504), q(8504).
-p(8506) :- p(8505), q(8506).
-q(8506) :- p(8505), q(8505).
-p(8507) :- p(8506), q(8507).
-q(8507) :- p(8506), q(8506).
-p(8508) :- p(8507), q(8508).
-q(8508) :- p(8507), q(8507).
-p(8509) :- p(8508), q(8509).
-q(8509) :- p(8508), q(8508).
-p(8510) :- p(8509), q(8510).
-q(8510) :- p(8509), q(8509).
-p(8511) :- p(8510), q(8511).
-q(8511) :- p(8510), q(8510).
-p(8512) :- p(8511), q(8512).
-q(8512) :- p(8511), q(8511).
-p(8513) :- p(8512), q(8513).
-q(8513) :- p(8512), q(8512).
-p(8514) :- p(8513), q(8514).
-q(8514) :- p(8513), q(8513).
-p(8515) :- p(8514), q(8515).
-q(8515) :- p(8514), q(8514).
-p(8516) :- p(8515), q(8516).
-q(8516) :- p(8515), q(8515).
-p(8517) :- p(8516), q(8517).
-q(8517) :- p(8516), q(8516).
-p(8518) :- p(8517), q(8518).
-q(8518) :- p(8517), q(8517).
-p(8519) :- p(8518), q(8519).
-q(8519) :- p(8518), q(8518).
-p(8520) :- p(8519), q(8520).
-q(8520) :- p(8519), q(8519).
-p(8521) :- p(8520), q(8521).
-q(8521) :- p(8520), q(8520).
-p(8522) :- p(8521), q(8522).
-q(8522) :- p(8521), q(8521).
-p(8523) :- p(8522), q(8523).
-q(8523) :- p(8522), q(8522).
-p(8524) :- p(8523), q(8524).
-q(8524) :- p(8523), q(8523).
-p(8525) :- p(8524), q(8525).
-q(8525) :- p(8524), q(8524).
-p(8526) :- p(8525), q(8526).
-q(8526) :- p(8525), q(8525).
-p(8527) :- p(8526), q(8527).
-q(8527) :- p(8526), q(8526).
-p(8528) :- p(8527), q(8528).
-q(8528) :- p(8527), q(8527).
-p(8529) :- p(8528), q(8529).
-q(8529) :- p(8528), q(8528).
-p(8530) :- p(8529), q(8530).
-q(8530) :- p(8529), q(8529).
-p(8531) :- p(8530), q(8531).
-q(8531) :- p(8530), q(8530).
-p(8532) :- p(8531), q(8532).
-q(8532) :- p(8531), q(8531).
-p(8533) :- p(8532), q(8533).
-q(8533) :- p(8532), q(8532).
-p(8534) :- p(8533), q(8534).
-q(8534) :- p(8533), q(8533).
-p(8535) :- p(8534), q(8535).
-q(8535) :- p(8534), q(8534).
-p(8536) :- p(8535), q(8536).
-q(8536) :- p(8535), q(8535).
-p(8537) :- p(8536), q(8537).
-q(8537) :- p(8536), q(8536).
-p(8538) :- p(8537), q(8538).
-q(8538) :- p(8537), q(8537).
-p(8539) :- p(8538), q(8539).
-q(8539) :- p(8538), q(8538).
-p(8540) :- p(8539), q(8540).
-q(8540) :- p(8539), q(8539).
-p(8541) :- p(8540), q(8541).
-q(8541) :- p(8540), q(8540).
-p(8542) :- p(8541), q(8542).
-q(8542) :- p(8541), q(8541).
-p(8543) :- p(8542), q(8543).
-q(8543) :- p(8542), q(8542).
-p(8544) :- p(8543), q(8544).
-q(8544) :- p(8543), q(8543).
-p(8545) :- p(8544), q(8545).
-q(8545) :- p(8544), q(8544).
-p(8546) :- p(8545), q(8546).
-q(8546) :- p(8545), q(8545).
-p(8547) :- p(8546), q(8547).
-q(8547) :- p(8546), q(8546).
-p(8548) :- p(8547), q(8548).
-q(8548) :- p(8547), q(8547).
-p(8549) :- p(8548), q(8549).
-q(8549) :- p(8548), q(8548).
-p(8550) :- p(8549), q(8550).
-q(8550) :- p(8549), q(8549).
-p(8551) :- p(8550), q(8551).
-q(8551) :- p(8550), q(8550).
-p(8552) :- p(8551), q(8552).
-q(8552) :- p(8551), q(8551).
-p(8553) :- p(8552), q(8553).
-q(8553) :- p(8552), q(8552).
-p(8554) :- p(8553), q(8554).
-q(8554) :- p(8553), q(8553).
-p(8555) :- p(8554), q(8555).
-q(8555) :- p(8554), q(8554).
-p(8556) :- p(8555), q(8556).
-q(8556) :- p(8555), q(8555).
-p(8557) :- p(8556), q(8557).
-q(8557) :- p(8556), q(8556).
-p(8558) :- p(8557), q(8558).
-q(8558) :- p(8557), q(8557).
-p(8559) :- p(8558), q(8559).
-q(8559) :- p(8558), q(8558).
-p(8560) :- p(8559), q(8560).
-q(8560) :- p(8559), q(8559).
-p(8561) :- p(8560), q(8561).
-q(8561) :- p(8560), q(8560).
-p(8562) :- p(8561), q(8562).
-q(8562) :- p(8561), q(8561).
-p(8563) :- p(8562), q(8563).
-q(8563) :- p(8562), q(8562).
-p(8564) :- p(8563), q(8564).
-q(8564) :- p(8563), q(8563).
-p(8565) :- p(8564), q(8565).
-q(8565) :- p(8564), q(8564).
-p(8566) :- p(8565), q(8566).
-q(8566) :- p(8565), q(8565).
-p(8567) :- p(8566), q(8567).
-q(8567) :- p(8566), q(8566).
-p(8568) :- p(8567), q(8568).
-q(8568) :- p(8567), q(8567).
-p(8569) :- p(8568), q(8569).
-q(8569) :- p(8568), q(8568).
-p(8570) :- p(8569), q(8570).
-q(8570) :- p(8569), q(8569).
-p(8571) :- p(8570), q(8571).
-q(8571) :- p(8570), q(8570).
-p(8572) :- p(8571), q(8572).
-q(8572) :- p(8571), q(8571).
-p(8573) :- p(8572), q(8573).
-q(8573) :- p(8572), q(8572).
-p(8574) :- p(8573), q(8574).
-q(8574) :- p(8573), q(8573).
-p(8575) :- p(8574), q(8575).
-q(8575) :- p(8574), q(8574).
-p(8576) :- p(8575), q(8576).
-q(8576) :- p(8575), q(8575).
-p(8577) :- p(8576), q(8577).
-q(8577) :- p(8576), q(8576).
-p(8578) :- p(8577), q(8578).
-q(8578) :- p(8577), q(8577).
-p(8579) :- p(8578), q(8579).
-q(8579) :- p(8578), q(8578).
-p(8580) :- p(8579), q(8580).
-q(8580) :- p(8579), q(8579).
-p(8581) :- p(8580), q(8581).
-q(8581) :- p(8580), q(8580).
-p(8582) :- p(8581), q(8582).
-q(8582) :- p(8581), q(8581).
-p(8583) :- p(8582), q(8583).
-q(8583) :- p(8582), q(8582).
-p(8584) :- p(8583), q(8584).
-q(8584) :- p(8583), q(8583).
-p(8585) :- p(8584), q(8585).
-q(8585) :- p(8584), q(8584).
-p(8586) :- p(8585), q(8586).
-q(8586) :- p(8585), q(8585).
-p(8587) :- p(8586), q(8587).
-q(8587) :- p(8586), q(8586).
-p(8588) :- p(8587), q(8588).
-q(8588) :- p(8587), q(8587).
-p(8589) :- p(8588), q(8589).
-q(8589) :- p(8588), q(8588).
-p(8590) :- p(8589), q(8590).
-q(8590) :- p(8589), q(8589).
-p(8591) :- p(8590), q(8591).
-q(8591) :- p(8590), q(8590).
-p(8592) :- p(8591), q(8592).
-q(8592) :- p(8591), q(8591).
-p(8593) :- p(8592), q(8593).
-q(8593) :- p(8592), q(8592).
-p(8594) :- p(8593), q(8594).
-q(8594) :- p(8593), q(8593).
-p(8595) :- p(8594), q(8595).
-q(8595) :- p(8594), q(8594).
-p(8596) :- p(8595), q(8596).
-q(8596) :- p(8595), q(8595).
-p(8597) :- p(8596), q(8597).
-q(8597) :- p(8596), q(8596).
-p(8598) :- p(8597), q(8598).
-q(8598) :- p(8597), q(8597).
-p(8599) :- p(8598), q(8599).
-q(8599) :- p(8598), q(8598).
-p(8600) :- p(8599), q(8600).
-q(8600) :- p(8599), q(8599).
-p(8601) :- p(8600), q(8601).
-q(8601) :- p(8600), q(8600).
-p(8602) :- p(8601), q(8602).
-q(8602) :- p(8601), q(8601).
-p(8603) :- p(8602), q(8603).
-q(8603) :- p(8602), q(8602).
-p(8604) :- p(8603), q(8604).
-q(8604) :- p(8603), q(8603).
-p(8605) :- p(8604), q(8605).
-q(8605) :- p(8604), q(8604).
-p(8606) :- p(8605), q(8606).
-q(8606) :- p(8605), q(8605).
-p(8607) :- p(8606), q(8607).
-q(8607) :- p(8606), q(8606).
-p(8608) :- p(8607), q(8608).
-q(8608) :- p(8607), q(8607).
-p(8609) :- p(8608), q(8609).
-q(8609) :- p(8608), q(8608).
-p(8610) :- p(8609), q(8610).
-q(8610) :- p(8609), q(8609).
-p(8611) :- p(8610), q(8611).
-q(8611) :- p(8610), q(8610).
-p(8612) :- p(8611), q(8612).
-q(8612) :- p(8611), q(8611).
-p(8613) :- p(8612), q(8613).
-q(8613) :- p(8612), q(8612).
-p(8614) :- p(8613), q(8614).
-q(8614) :- p(8613), q(8613).
-p(8615) :- p(8614), q(8615).
-q(8615) :- p(8614), q(8614).
-p(8616) :- p(8615), q(8616).
-q(8616) :- p(8615), q(8615).
-p(8617) :- p(8616), q(8617).
-q(8617) :- p(8616), q(8616).
-p(8618) :- p(8617), q(8618).
-q(8618) :- p(8617), q(8617).
-p(8619) :- p(8618), q(8619).
-q(8619) :- p(8618), q(8618).
-p(8620) :- p(8619), q(8620).
-q(8620) :- p(8619), q(8619).
-p(8621) :- p(8620), q(8621).
-q(8621) :- p(8620), q(8620).
-p(8622) :- p(8621), q(8622).
-q(8622) :- p(8621), q(8621).
-p(8623) :- p(8622), q(8623).
-q(8623) :- p(8622), q(8622).
-p(8624) :- p(8623), q(8624).
-q(8624) :- p(8623), q(8623).
-p(8625) :- p(8624), q(8625).
-q(8625) :- p(8624), q(8624).
-p(8626) :- p(8625), q(8626).
-q(8626) :- p(8625), q(8625).
-p(8627) :- p(8626), q(8627).
-q(8627) :- p(8626), q(8626).
-p(8628) :- p(8627), q(8628).
-q(8628) :- p(8627), q(8627).
-p(8629) :- p(8628), q(8629).
-q(8629) :- p(8628), q(8628).
-p(8630) :- p(8629), q(8630).
-q(8630) :- p(8629), q(8629).
-p(8631) :- p(8630), q(8631).
-q(8631) :- p(8630), q(8630).
-p(8632) :- p(8631), q(8632).
-q(8632) :- p(8631), q(8631).
-p(8633) :- p(8632), q(8633).
-q(8633) :- p(8632), q(8632).
-p(8634) :- p(8633), q(8634).
-q(8634) :- p(8633), q(8633).
-p(8635) :- p(8634), q(8635).
-q(8635) :- p(8634), q(8634).
-p(8636) :- p(8635), q(8636).
-q(8636) :- p(8635), q(8635).
-p(8637) :- p(8636), q(8637).
-q(8637) :- p(8636), q(8636).
-p(8638) :- p(8637), q(8638).
-q(8638) :- p(8637), q(8637).
-p(8639) :- p(8638), q(8639).
-q(8639) :- p(8638), q(8638).
-p(8640) :- p(8639), q(8640).
-q(8640) :- p(8639), q(8639).
-p(8641) :- p(8640), q(8641).
-q(8641) :- p(8640), q(8640).
-p(8642) :- p(8641), q(8642).
-q(8642) :- p(8641), q(8641).
-p(8643) :- p(8642), q(8643).
-q(8643) :- p(8642), q(8642).
-p(8644) :- p(8643), q(8644).
-q(8644) :- p(8643), q(8643).
-p(8645) :- p(8644), q(8645).
-q(8645) :- p(8644), q(8644).
-p(8646) :- p(8645), q(8646).
-q(8646) :- p(8645), q(8645).
-p(8647) :- p(8646), q(8647).
-q(8647) :- p(8646), q(8646).
-p(8648) :- p(8647), q(8648).
-q(8648) :- p(8647), q(8647).
-p(8649) :- p(8648), q(8649).
-q(8649) :- p(8648), q(8648).
-p(8650) :- p(8649), q(8650).
-q(8650) :- p(8649), q(8649).
-p(8651) :- p(8650), q(8651).
-q(8651) :- p(8650), q(8650).
-p(8652) :- p(8651), q(8652).
-q(8652) :- p(8651), q(8651).
-p(8653) :- p(8652), q(8653).
-q(8653) :- p(8652), q(8652).
-p(8654) :- p(8653), q(8654).
-q(8654) :- p(8653), q(8653).
-p(8655) :- p(8654), q(8655).
-q(8655) :- p(8654), q(8654).
-p(8656) :- p(8655), q(8656).
-q(8656) :- p(8655), q(8655).
-p(8657) :- p(8656), q(8657).
-q(8657) :- p(8656), q(8656).
-p(8658) :- p(8657), q(8658).
-q(8658) :- p(8657), q(8657).
-p(8659) :- p(8658), q(8659).
-q(8659) :- p(8658), q(8658).
-p(8660) :- p(8659), q(8660).
-q(8660) :- p(8659), q(8659).
-p(8661) :- p(8660), q(8661).
-q(8661) :- p(8660), q(8660).
-p(8662) :- p(8661), q(8662).
-q(8662) :- p(8661), q(8661).
-p(8663) :- p(8662), q(8663).
-q(8663) :- p(8662), q(8662).
-p(8664) :- p(8663), q(8664).
-q(8664) :- p(8663), q(8663).
-p(8665) :- p(8664), q(8665).
-q(8665) :- p(8664), q(8664).
-p(8666) :- p(8665), q(8666).
-q(8666) :- p(8665), q(8665).
-p(8667) :- p(8666), q(8667).
-q(8667) :- p(8666), q(8666).
-p(8668) :- p(8667), q(8668).
-q(8668) :- p(8667), q(8667).
-p(8669) :- p(8668), q(8669).
-q(8669) :- p(8668), q(8668).
-p(8670) :- p(8669), q(8670).
-q(8670) :- p(8669), q(8669).
-p(8671) :- p(8670), q(8671).
-q(8671) :- p(8670), q(8670).
-p(8672) :- p(8671), q(8672).
-q(8672) :- p(8671), q(8671).
-p(8673) :- p(8672), q(8673).
-q(8673) :- p(8672), q(8672).
-p(8674) :- p(8673), q(8674).
-q(8674) :- p(8673), q(8673).
-p(8675) :- p(8674), q(8675).
-q(8675) :- p(8674), q(8674).
-p(8676) :- p(8675), q(8676).
-q(8676) :- p(8675), q(8675).
-p(8677) :- p(8676), q(8677).
-q(8677) :- p(8676), q(8676).
-p(8678) :- p(8677), q(8678).
-q(8678) :- p(8677), q(8677).
-p(8679) :- p(8678), q(8679).
-q(8679) :- p(8678), q(8678).
-p(8680) :- p(8679), q(8680).
-q(8680) :- p(8679), q(8679).
-p(8681) :- p(8680), q(8681).
-q(8681) :- p(8680), q(8680).
-p(8682) :- p(8681), q(8682).
-q(8682) :- p(8681), q(8681).
-p(8683) :- p(8682), q(8683).
-q(8683) :- p(8682), q(8682).
-p(8684) :- p(8683), q(8684).
-q(8684) :- p(8683), q(8683).
-p(8685) :- p(8684), q(8685).
-q(8685) :- p(8684), q(8684).
-p(8686) :- p(8685), q(8686).
-q(8686) :- p(8685), q(8685).
-p(8687) :- p(8686), q(8687).
-q(8687) :- p(8686), q(8686).
-p(8688) :- p(8687), q(8688).
-q(8688) :- p(8687), q(8687).
-p(8689) :- p(8688), q(8689).
-q(8689) :- p(8688), q(8688).
-p(8690) :- p(8689), q(8690).
-q(8690) :- p(8689), q(8689).
-p(8691) :- p(8690), q(8691).
-q(8691) :- p(8690), q(8690).
-p(8692) :- p(8691), q(8692).
-q(8692) :- p(8691), q(8691).
-p(8693) :- p(8692), q(8693).
-q(8693) :- p(8692), q(8692).
-p(8694) :- p(8693), q(8694).
-q(8694) :- p(8693), q(8693).
-p(8695) :- p(8694), q(8695).
-q(8695) :- p(8694), q(8694).
-p(8696) :- p(8695), q(8696).
-q(8696) :- p(8695), q(8695).
-p(8697) :- p(8696), q(8697).
-q(8697) :- p(8696), q(8696).
-p(8698) :- p(8697), q(8698).
-q(8698) :- p(8697), q(8697).
-p(8699) :- p(8698), q(8699).
-q(8699) :- p(8698), q(8698).
-p(8700) :- p(8699), q(8700).
-q(8700) :- p(8699), q(8699).
-p(8701) :- p(8700), q(8701).
-q(8701) :- p(8700), q(8700).
-p(8702) :- p(8701), q(8702).
-q(8702) :- p(8701), q(8701).
-p(8703) :- p(8702), q(8703).
-q(8703) :- p(8702), q(8702).
-p(8704) :- p(8703), q(8704).
-q(8704) :- p(8703), q(8703).
-p(8705) :- p(8704), q(8705).
-q(8705) :- p(8704), q(8704).
-p(8706) :- p(8705), q(8706).
-q(8706) :- p(8705), q(8705).
-p(8707) :- p(8706), q(8707).
-q(8707) :- p(8706), q(8706).
-p(8708) :- p(8707), q(8708).
-q(8708) :- p(8707), q(8707).
-p(8709) :- p(8708), q(8709).
-q(8709) :- p(8708), q(8708).
-p(8710) :- p(8709), q(8710).
-q(8710) :- p(8709), q(8709).
-p(8711) :- p(8710), q(8711).
-q(8711) :- p(8710), q(8710).
-p(8712) :- p(8711), q(8712).
-q(8712) :- p(8711), q(8711).
-p(8713) :- p(8712), q(8713).
-q(8713) :- p(8712), q(8712).
-p(8714) :- p(8713), q(8714).
-q(8714) :- p(8713), q(8713).
-p(8715) :- p(8714), q(8715).
-q(8715) :- p(8714), q(8714).
-p(8716) :- p(8715), q(8716).
-q(8716) :- p(8715), q(8715).
-p(8717) :- p(8716), q(8717).
-q(8717) :- p(8716), q(8716).
-p(8718) :- p(8717), q(8718).
-q(8718) :- p(8717), q(8717).
-p(8719) :- p(8718), q(8719).
-q(8719) :- p(8718), q(8718).
-p(8720) :- p(8719), q(8720).
-q(8720) :- p(8719), q(8719).
-p(8721) :- p(8720), q(8721).
-q(8721) :- p(8720), q(8720).
-p(8722) :- p(8721), q(8722).
-q(8722) :- p(8721), q(8721).
-p(8723) :- p(8722), q(8723).
-q(8723) :- p(8722), q(8722).
-p(8724) :- p(8723), q(8724).
-q(8724) :- p(8723), q(8723).
-p(8725) :- p(8724), q(8725).
-q(8725) :- p(8724), q(8724).
-p(8726) :- p(8725), q(8726).
-q(8726) :- p(8725), q(8725).
-p(8727) :- p(8726), q(8727).
-q(8727) :- p(8726), q(8726).
-p(8728) :- p(8727), q(8728).
-q(8728) :- p(8727), q(8727).
-p(8729) :- p(8728), q(8729).
-q(8729) :- p(8728), q(8728).
-p(8730) :- p(8729), q(8730).
-q(8730) :- p(8729), q(8729).
-p(8731) :- p(8730), q(8731).
-q(8731) :- p(8730), q(8730).
-p(8732) :- p(8731), q(8732).
-q(8732) :- p(8731), q(8731).
-p(8733) :- p(8732), q(8733).
-q(8733) :- p(8732), q(8732).
-p(8734) :- p(8733), q(8734).
-q(8734) :- p(8733), q(8733).
-p(8735) :- p(8734), q(8735).
-q(8735) :- p(8734), q(8734).
-p(8736) :- p(8735), q(8736).
-q(8736) :- p(8735), q(8735).
-p(8737) :- p(8736), q(8737).
-q(8737) :- p(8736), q(8736).
-p(8738) :- p(8737), q(8738).
-q(8738) :- p(8737), q(8737).
-p(8739) :- p(8738), q(8739).
-q(8739) :- p(8738), q(8738).
-p(8740) :- p(8739), q(8740).
-q(8740) :- p(8739), q(8739).
-p(8741) :- p(8740), q(8741).
-q(8741) :- p(8740), q(8740).
-p(8742) :- p(8741), q(8742).
-q(8742) :- p(8741), q(8741).
-p(8743) :- p(8742), q(8743).
-q(8743) :- p(8742), q(8742).
-p(8744) :- p(8743), q(8744).
-q(8744) :- p(8743), q(8743).
-p(8745) :- p(8744), q(8745).
-q(8745) :- p(8744), q(8744).
-p(8746) :- p(8745), q(8746).
-q(8746) :- p(8745), q(8745).
-p(8747) :- p(8746), q(8747).
-q(8747) :- p(8746), q(8746).
-p(8748) :- p(8747), q(8748).
-q(8748) :- p(8747), q(8747).
-p(8749) :- p(8748), q(8749).
-q(8749) :- p(8748), q(8748).
-p(8750) :- p(8749), q(8750).
-q(8750) :- p(8749), q(8749).
-p(8751) :- p(8750), q(8751).
-q(8751) :- p(8750), q(8750).
-p(8752) :- p(8751), q(8752).
-q(8752) :- p(8751), q(8751).
-p(8753) :- p(8752), q(8753).
-q(8753) :- p(8752), q(8752).
-p(8754) :- p(8753), q(8754).
-q(8754) :- p(8753), q(8753).
-p(8755) :- p(8754), q(8755).
-q(8755) :- p(8754), q(8754).
-p(8756) :- p(8755), q(8756).
-q(8756) :- p(8755), q(8755).
-p(8757) :- p(8756), q(8757).
-q(8757) :- p(8756), q(8756).
-p(8758) :- p(8757), q(8758).
-q(8758) :- p(8757), q(8757).
-p(8759) :- p(8758), q(8759).
-q(8759) :- p(8758), q(8758).
-p(8760) :- p(8759), q(8760).
-q(8760) :- p(8759), q(8759).
-p(8761) :- p(8760), q(8761).
-q(8761) :- p(8760), q(8760).
-p(8762) :- p(8761), q(8762).
-q(8762) :- p(8761), q(8761).
-p(8763) :- p(8762), q(8763).
-q(8763) :- p(8762), q(8762).
-p(8764) :- p(8763), q(8764).
-q(8764) :- p(8763), q(8763).
-p(8765) :- p(8764), q(8765).
-q(8765) :- p(8764), q(8764).
-p(8766) :- p(8765), q(8766).
-q(8766) :- p(8765), q(8765).
-p(8767) :- p(8766), q(8767).
-q(8767) :- p(8766), q(8766).
-p(8768) :- p(8767), q(8768).
-q(8768) :- p(8767), q(8767).
-p(8769) :- p(8768), q(8769).
-q(8769) :- p(8768), q(8768).
-p(8770) :- p(8769), q(8770).
-q(8770) :- p(8769), q(8769).
-p(8771) :- p(8770), q(8771).
-q(8771) :- p(8770), q(8770).
-p(8772) :- p(8771), q(8772).
-q(8772) :- p(8771), q(8771).
-p(8773) :- p(8772), q(8773).
-q(8773) :- p(8772), q(8772).
-p(8774) :- p(8773), q(8774).
-q(8774) :- p(8773), q(8773).
-p(8775) :- p(8774), q(8775).
-q(8775) :- p(8774), q(8774).
-p(8776) :- p(8775), q(8776).
-q(8776) :- p(8775), q(8775).
-p(8777) :- p(8776), q(8777).
-q(8777) :- p(8776), q(8776).
-p(8778) :- p(8777), q(8778).
-q(8778) :- p(8777), q(8777).
-p(8779) :- p(8778), q(8779).
-q(8779) :- p(8778), q(8778).
-p(8780) :- p(8779), q(8780).
-q(8780) :- p(8779), q(8779).
-p(8781) :- p(8780), q(8781).
-q(8781) :- p(8780), q(8780).
-p(8782) :- p(8781), q(8782).
-q(8782) :- p(8781), q(8781).
-p(8783) :- p(8782), q(8783).
-q(8783) :- p(8782), q(8782).
-p(8784) :- p(8783), q(8784).
-q(8784) :- p(8783), q(8783).
-p(8785) :- p(8784), q(8785).
-q(8785) :- p(8784), q(8784).
-p(8786) :- p(8785), q(8786).
-q(8786) :- p(8785), q(8785).
-p(8787) :- p(8786), q(8787).
-q(8787) :- p(8786), q(8786).
-p(8788) :- p(8787), q(8788).
-q(8788) :- p(8787), q(8787).
-p(8789) :- p(8788), q(8789).
-q(8789) :- p(8788), q(8788).
-p(8790) :- p(8789), q(8790).
-q(8790) :- p(8789), q(8789).
-p(8791) :- p(8790), q(8791).
-q(8791) :- p(8790), q(8790).
-p(8792) :- p(8791), q(8792).
-q(8792) :- p(8791), q(8791).
-p(8793) :- p(8792), q(8793).
-q(8793) :- p(8792), q(8792).
-p(8794) :- p(8793), q(8794).
-q(8794) :- p(8793), q(8793).
-p(8795) :- p(8794), q(8795).
-q(8795) :- p(8794), q(8794).
-p(8796) :- p(8795), q(8796).
-q(8796) :- p(8795), q(8795).
-p(8797) :- p(8796), q(8797).
-q(8797) :- p(8796), q(8796).
-p(8798) :- p(8797), q(8798).
-q(8798) :- p(8797), q(8797).
-p(8799) :- p(8798), q(8799).
-q(8799) :- p(8798), q(8798).
-p(8800) :- p(8799), q(8800).
-q(8800) :- p(8799), q(8799).
-p(8801) :- p(8800), q(8801).
-q(8801) :- p(8800), q(8800).
-p(8802) :- p(8801), q(8802).
-q(8802) :- p(8801), q(8801).
-p(8803) :- p(8802), q(8803).
-q(8803) :- p(8802), q(8802).
-p(8804) :- p(8803), q(8804).
-q(8804) :- p(8803), q(8803).
-p(8805) :- p(8804), q(8805).
-q(8805) :- p(8804), q(8804).
-p(8806) :- p(8805), q(8806).
-q(8806) :- p(8805), q(8805).
-p(8807) :- p(8806), q(8807).
-q(8807) :- p(8806), q(8806).
-p(8808) :- p(8807), q(8808).
-q(8808) :- p(8807), q(8807).
-p(8809) :- p(8808), q(8809).
-q(8809) :- p(8808), q(8808).
-p(8810) :- p(8809), q(8810).
-q(8810) :- p(8809), q(8809).
-p(8811) :- p(8810), q(8811).
-q(8811) :- p(8810), q(8810).
-p(8812) :- p(8811), q(8812).
-q(8812) :- p(8811), q(8811).
-p(8813) :- p(8812), q(8813).
-q(8813) :- p(8812), q(8812).
-p(8814) :- p(8813), q(8814).
-q(8814) :- p(8813), q(8813).
-p(8815) :- p(8814), q(8815).
-q(8815) :- p(8814), q(8814).
-p(8816) :- p(8815), q(8816).
-q(8816) :- p(8815), q(8815).
-p(8817) :- p(8816), q(8817).
-q(8817) :- p(8816), q(8816).
-p(8818) :- p(8817), q(8818).
-q(8818) :- p(8817), q(8817).
-p(8819) :- p(8818), q(8819).
-q(8819) :- p(8818), q(8818).
-p(8820) :- p(8819), q(8820).
-q(8820) :- p(8819), q(8819).
-p(8821) :- p(8820), q(8821).
-q(8821) :- p(8820), q(8820).
-p(8822) :- p(8821), q(8822).
-q(8822) :- p(8821), q(8821).
-p(8823) :- p(8822), q(8823).
-q(8823) :- p(8822), q(8822).
-p(8824) :- p(8823), q(8824).
-q(8824) :- p(8823), q(8823).
-p(8825) :- p(8824), q(8825).
-q(8825) :- p(8824), q(8824).
-p(8826) :- p(8825), q(8826).
-q(8826) :- p(8825), q(8825).
-p(8827) :- p(8826), q(8827).
-q(8827) :- p(8826), q(8826).
-p(8828) :- p(8827), q(8828).
-q(8828) :- p(8827), q(8827).
-p(8829) :- p(8828), q(8829).
-q(8829) :- p(8828), q(8828).
-p(8830) :- p(8829), q(8830).
-q(8830) :- p(8829), q(8829).
-p(8831) :- p(8830), q(8831).
-q(8831) :- p(8830), q(8830).
-p(8832) :- p(8831), q(8832).
-q(8832) :- p(8831), q(8831).
-p(8833) :- p(8832), q(8833).
-q(8833) :- p(8832), q(8832).
-p(8834) :- p(8833), q(8834).
-q(8834) :- p(8833), q(8833).
-p(8835) :- p(8834), q(8835).
-q(8835) :- p(8834), q(8834).
-p(8836) :- p(8835), q(8836).
-q(8836) :- p(8835), q(8835).
-p(8837) :- p(8836), q(8837).
-q(8837) :- p(8836), q(8836).
-p(8838) :- p(8837), q(8838).
-q(8838) :- p(8837), q(8837).
-p(8839) :- p(8838), q(8839).
-q(8839) :- p(8838), q(8838).
-p(8840) :- p(8839), q(8840).
-q(8840) :- p(8839), q(8839).
-p(8841) :- p(8840), q(8841).
-q(8841) :- p(8840), q(8840).
-p(8842) :- p(8841), q(8842).
-q(8842) :- p(8841), q(8841).
-p(8843) :- p(8842), q(8843).
-q(8843) :- p(8842), q(8842).
-p(8844) :- p(8843), q(8844).
-q(8844) :- p(8843), q(8843).
-p(8845) :- p(8844), q(8845).
-q(8845) :- p(8844), q(8844).
-p(8846) :- p(8845), q(8846).
-q(8846) :- p(8845), q(8845).
-p(8847) :- p(8846), q(8847).
-q(8847) :- p(8846), q(8846).
-p(8848) :- p(8847), q(8848).
-q(8848) :- p(8847), q(8847).
-p(8849) :- p(8848), q(8849).
-q(8849) :- p(8848), q(8848).
-p(8850) :- p(8849), q(8850).
-q(8850) :- p(8849), q(8849).
-p(8851) :- p(8850), q(8851).
-q(8851) :- p(8850), q(8850).
-p(8852) :- p(8851), q(8852).
-q(8852) :- p(8851), q(8851).
-p(8853) :- p(8852), q(8853).
-q(8853) :- p(8852), q(8852).
-p(8854) :- p(8853), q(8854).
-q(8854) :- p(8853), q(8853).
-p(8855) :- p(8854), q(8855).
-q(8855) :- p(8854), q(8854).
-p(8856) :- p(8855), q(8856).
-q(8856) :- p(8855), q(8855).
-p(8857) :- p(8856), q(8857).
-q(8857) :- p(8856), q(8856).
-p(8858) :- p(8857), q(8858).
-q(8858) :- p(8857), q(8857).
-p(8859) :- p(8858), q(8859).
-q(8859) :- p(8858), q(8858).
-p(8860) :- p(8859), q(8860).
-q(8860) :- p(8859), q(8859).
-p(8861) :- p(8860), q(8861).
-q(8861) :- p(8860), q(8860).
-p(8862) :- p(8861), q(8862).
-q(8862) :- p(8861), q(8861).
-p(8863) :- p(8862), q(8863).
-q(8863) :- p(8862), q(8862).
-p(8864) :- p(8863), q(8864).
-q(8864) :- p(8863), q(8863).
-p(8865) :- p(8864), q(8865).
-q(8865) :- p(8864), q(8864).
-p(8866) :- p(8865), q(8866).
-q(8866) :- p(8865), q(8865).
-p(8867) :- p(8866), q(8867).
-q(8867) :- p(8866), q(8866).
-p(8868) :- p(8867), q(8868).
-q(8868) :- p(8867), q(8867).
-p(8869) :- p(8868), q(8869).
-q(8869) :- p(8868), q(8868).
-p(8870) :- p(8869), q(8870).
-q(8870) :- p(8869), q(8869).
-p(8871) :- p(8870), q(8871).
-q(8871) :- p(8870), q(8870).
-p(8872) :- p(8871), q(8872).
-q(8872) :- p(8871), q(8871).
-p(8873) :- p(8872), q(8873).
-q(8873) :- p(8872), q(8872).
-p(8874) :- p(8873), q(8874).
-q(8874) :- p(8873), q(8873).
-p(8875) :- p(8874), q(8875).
-q(8875) :- p(8874), q(8874).
-p(8876) :- p(8875), q(8876).
-q(8876) :- p(8875), q(8875).
-p(8877) :- p(8876), q(8877).
-q(8877) :- p(8876), q(8876).
-p(8878) :- p(8877), q(8878).
-q(8878) :- p(8877), q(8877).
-p(8879) :- p(8878), q(8879).
-q(8879) :- p(8878), q(8878).
-p(8880) :- p(8879), q(8880).
-q(8880) :- p(8879), q(8879).
-p(8881) :- p(8880), q(8881).
-q(8881) :- p(8880), q(8880).
-p(8882) :- p(8881), q(8882).
-q(8882) :- p(8881), q(8881).
-p(8883) :- p(8882), q(8883).
-q(8883) :- p(8882), q(8882).
-p(8884) :- p(8883), q(8884).
-q(8884) :- p(8883), q(8883).
-p(8885) :- p(8884), q(8885).
-q(8885) :- p(8884), q(8884).
-p(8886) :- p(8885), q(8886).
-q(8886) :- p(8885), q(8885).
-p(8887) :- p(8886), q(8887).
-q(8887) :- p(8886), q(8886).
-p(8888) :- p(8887), q(8888).
-q(8888) :- p(8887), q(8887).
-p(8889) :- p(8888), q(8889).
-q(8889) :- p(8888), q(8888).
-p(8890) :- p(8889), q(8890).
-q(8890) :- p(8889), q(8889).
-p(8891) :- p(8890), q(8891).
-q(8891) :- p(8890), q(8890).
-p(8892) :- p(8891), q(8892).
-q(8892) :- p(8891), q(8891).
-p(8893) :- p(8892), q(8893).
-q(8893) :- p(8892), q(8892).
-p(8894) :- p(8893), q(8894).
-q(8894) :- p(8893), q(8893).
-p(8895) :- p(8894), q(8895).
-q(8895) :- p(8894), q(8894).
-p(8896) :- p(8895), q(8896).
-q(8896) :- p(8895), q(8895).
-p(8897) :- p(8896), q(8897).
-q(8897) :- p(8896), q(8896).
-p(8898) :- p(8897), q(8898).
-q(8898) :- p(8897), q(8897).
-p(8899) :- p(8898), q(8899).
-q(8899) :- p(8898), q(8898).
-p(8900) :- p(8899), q(8900).
-q(8900) :- p(8899), q(8899).
-p(8901) :- p(8900), q(8901).
-q(8901) :- p(8900), q(8900).
-p(8902) :- p(8901), q(8902).
-q(8902) :- p(8901), q(8901).
-p(8903) :- p(8902), q(8903).
-q(8903) :- p(8902), q(8902).
-p(8904) :- p(8903), q(8904).
-q(8904) :- p(8903), q(8903).
-p(8905) :- p(8904), q(8905).
-q(8905) :- p(8904), q(8904).
-p(8906) :- p(8905), q(8906).
-q(8906) :- p(8905), q(8905).
-p(8907) :- p(8906), q(8907).
-q(8907) :- p(8906), q(8906).
-p(8908) :- p(8907), q(8908).
-q(8908) :- p(8907), q(8907).
-p(8909) :- p(8908), q(8909).
-q(8909) :- p(8908), q(8908).
-p(8910) :- p(8909), q(8910).
-q(8910) :- p(8909), q(8909).
-p(8911) :- p(8910), q(8911).
-q(8911) :- p(8910), q(8910).
-p(8912) :- p(8911), q(8912).
-q(8912) :- p(8911), q(8911).
-p(8913) :- p(8912), q(8913).
-q(8913) :- p(8912), q(8912).
-p(8914) :- p(8913), q(8914).
-q(8914) :- p(8913), q(8913).
-p(8915) :- p(8914), q(8915).
-q(8915) :- p(8914), q(8914).
-p(8916) :- p(8915), q(8916).
-q(8916) :- p(8915), q(8915).
-p(8917) :- p(8916), q(8917).
-q(8917) :- p(8916), q(8916).
-p(8918) :- p(8917), q(8918).
-q(8918) :- p(8917), q(8917).
-p(8919) :- p(8918), q(8919).
-q(8919) :- p(8918), q(8918).
-p(8920) :- p(8919), q(8920).
-q(8920) :- p(8919), q(8919).
-p(8921) :- p(8920), q(8921).
-q(8921) :- p(8920), q(8920).
-p(8922) :- p(8921), q(8922).
-q(8922) :- p(8921), q(8921).
-p(8923) :- p(8922), q(8923).
-q(8923) :- p(8922), q(8922).
-p(8924) :- p(8923), q(8924).
-q(8924) :- p(8923), q(8923).
-p(8925) :- p(8924), q(8925).
-q(8925) :- p(8924), q(8924).
-p(8926) :- p(8925), q(8926).
-q(8926) :- p(8925), q(8925).
-p(8927) :- p(8926), q(8927).
-q(8927) :- p(8926), q(8926).
-p(8928) :- p(8927), q(8928).
-q(8928) :- p(8927), q(8927).
-p(8929) :- p(8928), q(8929).
-q(8929) :- p(8928), q(8928).
-p(8930) :- p(8929), q(8930).
-q(8930) :- p(8929), q(8929).
-p(8931) :- p(8930), q(8931).
-q(8931) :- p(8930), q(8930).
-p(8932) :- p(8931), q(8932).
-q(8932) :- p(8931), q(8931).
-p(8933) :- p(8932), q(8933).
-q(8933) :- p(8932), q(8932).
-p(8934) :- p(8933), q(8934).
-q(8934) :- p(8933), q(8933).
-p(8935) :- p(8934), q(8935).
-q(8935) :- p(8934), q(8934).
-p(8936) :- p(8935), q(8936).
-q(8936) :- p(8935), q(8935).
-p(8937) :- p(8936), q(8937).
-q(8937) :- p(8936), q(8936).
-p(8938) :- p(8937), q(8938).
-q(8938) :- p(8937), q(8937).
-p(8939) :- p(8938), q(8939).
-q(8939) :- p(8938), q(8938).
-p(8940) :- p(8939), q(8940).
-q(8940) :- p(8939), q(8939).
-p(8941) :- p(8940), q(8941).
-q(8941) :- p(8940), q(8940).
-p(8942) :- p(8941), q(8942).
-q(8942) :- p(8941), q(8941).
-p(8943) :- p(8942), q(8943).
-q(8943) :- p(8942), q(8942).
-p(8944) :- p(8943), q(8944).
-q(8944) :- p(8943), q(8943).
-p(8945) :- p(8944), q(8945).
-q(8945) :- p(8944), q(8944).
-p(8946) :- p(8945), q(8946).
-q(8946) :- p(8945), q(8945).
-p(8947) :- p(8946), q(8947).
-q(8947) :- p(8946), q(8946).
-p(8948) :- p(8947), q(8948).
-q(8948) :- p(8947), q(8947).
-p(8949) :- p(8948), q(8949).
-q(8949) :- p(8948), q(8948).
-p(8950) :- p(8949), q(8950).
-q(8950) :- p(8949), q(8949).
-p(8951) :- p(8950), q(8951).
-q(8951) :- p(8950), q(8950).
-p(8952) :- p(8951), q(8952).
-q(8952) :- p(8951), q(8951).
-p(8953) :- p(8952), q(8953).
-q(8953) :- p(8952), q(8952).
-p(8954) :- p(8953), q(8954).
-q(8954) :- p(8953), q(8953).
-p(8955) :- p(8954), q(8955).
-q(8955) :- p(8954), q(8954).
-p(8956) :- p(8955), q(8956).
-q(8956) :- p(8955), q(8955).
-p(8957) :- p(8956), q(8957).
-q(8957) :- p(8956), q(8956).
-p(8958) :- p(8957), q(8958).
-q(8958) :- p(8957), q(8957).
-p(8959) :- p(8958), q(8959).
-q(8959) :- p(8958), q(8958).
-p(8960) :- p(8959), q(8960).
-q(8960) :- p(8959), q(8959).
-p(8961) :- p(8960), q(8961).
-q(8961) :- p(8960), q(8960).
-p(8962) :- p(8961), q(8962).
-q(8962) :- p(8961), q(8961).
-p(8963) :- p(8962), q(8963).
-q(8963) :- p(8962), q(8962).
-p(8964) :- p(8963), q(8964).
-q(8964) :- p(8963), q(8963).
-p(8965) :- p(8964), q(8965).
-q(8965) :- p(8964), q(8964).
-p(8966) :- p(8965), q(8966).
-q(8966) :- p(8965), q(8965).
-p(8967) :- p(8966), q(8967).
-q(8967) :- p(8966), q(8966).
-p(8968) :- p(8967), q(8968).
-q(8968) :- p(8967), q(8967).
-p(8969) :- p(8968), q(8969).
-q(8969) :- p(8968), q(8968).
-p(8970) :- p(8969), q(8970).
-q(8970) :- p(8969), q(8969).
-p(8971) :- p(8970), q(8971).
-q(8971) :- p(8970), q(8970).
-p(8972) :- p(8971), q(8972).
-q(8972) :- p(8971), q(8971).
-p(8973) :- p(8972), q(8973).
-q(8973) :- p(8972), q(8972).
-p(8974) :- p(8973), q(8974).
-q(8974) :- p(8973), q(8973).
-p(8975) :- p(8974), q(8975).
-q(8975) :- p(8974), q(8974).
-p(8976) :- p(8975), q(8976).
-q(8976) :- p(8975), q(8975).
-p(8977) :- p(8976), q(8977).
-q(8977) :- p(8976), q(8976).
-p(8978) :- p(8977), q(8978).
-q(8978) :- p(8977), q(8977).
-p(8979) :- p(8978), q(8979).
-q(8979) :- p(8978), q(8978).
-p(8980) :- p(8979), q(8980).
-q(8980) :- p(8979), q(8979).
-p(8981) :- p(8980), q(8981).
-q(8981) :- p(8980), q(8980).
-p(8982) :- p(8981), q(8982).
-q(8982) :- p(8981), q(8981).
-p(8983) :- p(8982), q(8983).
-q(8983) :- p(8982), q(8982).
-p(8984) :- p(8983), q(8984).
-q(8984) :- p(8983), q(8983).
-p(8985) :- p(8984), q(8985).
-q(8985) :- p(8984), q(8984).
-p(8986) :- p(8985), q(8986).
-q(8986) :- p(8985), q(8985).
-p(8987) :- p(8986), q(8987).
-q(8987) :- p(8986), q(8986).
-p(8988) :- p(8987), q(8988).
-q(8988) :- p(8987), q(8987).
-p(8989) :- p(8988), q(8989).
-q(8989) :- p(8988), q(8988).
-p(8990) :- p(8989), q(8990).
-q(8990) :- p(8989), q(8989).
-p(8991) :- p(8990), q(8991).
-q(8991) :- p(8990), q(8990).
-p(8992) :- p(8991), q(8992).
-q(8992) :- p(8991), q(8991).
-p(8993) :- p(8992), q(8993).
-q(8993) :- p(8992), q(8992).
-p(8994) :- p(8993), q(8994).
-q(8994) :- p(8993), q(8993).
-p(8995) :- p(8994), q(8995).
-q(8995) :- p(8994), q(8994).
-p(8996) :- p(8995), q(8996).
-q(8996) :- p(8995), q(8995).
-p(8997) :- p(8996), q(8997).
-q(8997) :- p(8996), q(8996).
-p(8998) :- p(8997), q(8998).
-q(8998) :- p(8997), q(8997).
-p(8999) :- p(8998), q(8999).
-q(8999) :- p(8998), q(8998).
-p(9000) :- p(8999), q(9000).
-q(9000) :- p(8999), q(8999).
-p(9001) :- p(9000), q(9001).
-q(9001) :- p(9000), q(9000).
-p(9002) :- p(9001), q(9002).
-q(9002) :- p(9001), q(9001).
-p(9003) :- p(9002), q(9003).
-q(9003) :- p(9002), q(9002).
-p(9004) :- p(9003), q(9004).
-q(9004) :- p(9003), q(9003).
-p(9005) :- p(9004), q(9005).
-q(9005) :- p(9004), q(9004).
-p(9006) :- p(9005), q(9006).
-q(9006) :- p(9005), q(9005).
-p(9007) :- p(9006), q(9007).
-q(9007) :- p(9006), q(9006).
-p(9008) :- p(9007), q(9008).
-q(9008) :- p(9007), q(9007).
-p(9009) :- p(9008), q(9009).
-q(9009) :- p(9008), q(9008).
-p(9010) :- p(9009), q(9010).
-q(9010) :- p(9009), q(9009).
-p(9011) :- p(9010), q(9011).
-q(9011) :- p(9010), q(9010).
-p(9012) :- p(9011), q(9012).
-q(9012) :- p(9011), q(9011).
-p(9013) :- p(9012), q(9013).
-q(9013) :- p(9012), q(9012).
-p(9014) :- p(9013), q(9014).
-q(9014) :- p(9013), q(9013).
-p(9015) :- p(9014), q(9015).
-q(9015) :- p(9014), q(9014).
-p(9016) :- p(9015), q(9016).
-q(9016) :- p(9015), q(9015).
-p(9017) :- p(9016), q(9017).
-q(9017) :- p(9016), q(9016).
-p(9018) :- p(9017), q(9018).
-q(9018) :- p(9017), q(9017).
-p(9019) :- p(9018), q(9019).
-q(9019) :- p(9018), q(9018).
-p(9020) :- p(9019), q(9020).
-q(9020) :- p(9019), q(9019).
-p(9021) :- p(9020), q(9021).
-q(9021) :- p(9020), q(9020).
-p(9022) :- p(9021), q(9022).
-q(9022) :- p(9021), q(9021).
-p(9023) :- p(9022), q(9023).
-q(9023) :- p(9022), q(9022).
-p(9024) :- p(9023), q(9024).
-q(9024) :- p(9023), q(9023).
-p(9025) :- p(9024), q(9025).
-q(9025) :- p(9024), q(9024).
-p(9026) :- p(9025), q(9026).
-q(9026) :- p(9025), q(9025).
-p(9027) :- p(9026), q(9027).
-q(9027) :- p(9026), q(9026).
-p(9028) :- p(9027), q(9028).
-q(9028) :- p(9027), q(9027).
-p(9029) :- p(9028), q(9029).
-q(9029) :- p(9028), q(9028).
-p(9030) :- p(9029), q(9030).
-q(9030) :- p(9029), q(9029).
-p(9031) :- p(9030), q(9031).
-q(9031) :- p(9030), q(9030).
-p(9032) :- p(9031), q(9032).
-q(9032) :- p(9031), q(9031).
-p(9033) :- p(9032), q(9033).
-q(9033) :- p(9032), q(9032).
-p(9034) :- p(9033), q(9034).
-q(9034) :- p(9033), q(9033).
-p(9035) :- p(9034), q(9035).
-q(9035) :- p(9034), q(9034).
-p(9036) :- p(9035), q(9036).
-q(9036) :- p(9035), q(9035).
-p(9037) :- p(9036), q(9037).
-q(9037) :- p(9036), q(9036).
-p(9038) :- p(9037), q(9038).
-q(9038) :- p(9037), q(9037).
-p(9039) :- p(9038), q(9039).
-q(9039) :- p(9038), q(9038).
-p(9040) :- p(9039), q(9040).
-q(9040) :- p(9039), q(9039).
-p(9041) :- p(9040), q(9041).
-q(9041) :- p(9040), q(9040).
-p(9042) :- p(9041), q(9042).
-q(9042) :- p(9041), q(9041).
-p(9043) :- p(9042), q(9043).
-q(9043) :- p(9042), q(9042).
-p(9044) :- p(9043), q(9044).
-q(9044) :- p(9043), q(9043).
-p(9045) :- p(9044), q(9045).
-q(9045) :- p(9044), q(9044).
-p(9046) :- p(9045), q(9046).
-q(9046) :- p(9045), q(9045).
-p(9047) :- p(9046), q(9047).
-q(9047) :- p(9046), q(9046).
-p(9048) :- p(9047), q(9048).
-q(9048) :- p(9047), q(9047).
-p(9049) :- p(9048), q(9049).
-q(9049) :- p(9048), q(9048).
-p(9050) :- p(9049), q(9050).
-q(9050) :- p(9049), q(9049).
-p(9051) :- p(9050), q(9051).
-q(9051) :- p(9050), q(9050).
-p(9052) :- p(9051), q(9052).
-q(9052) :- p(9051), q(9051).
-p(9053) :- p(9052), q(9053).
-q(9053) :- p(9052), q(9052).
-p(9054) :- p(9053), q(9054).
-q(9054) :- p(9053), q(9053).
-p(9055) :- p(9054), q(9055).
-q(9055) :- p(9054), q(9054).
-p(9056) :- p(9055), q(9056).
-q(9056) :- p(9055), q(9055).
-p(9057) :- p(9056), q(9057).
-q(9057) :- p(9056), q(9056).
-p(9058) :- p(9057), q(9058).
-q(9058) :- p(9057), q(9057).
-p(9059) :- p(9058), q(9059).
-q(9059) :- p(9058), q(9058).
-p(9060) :- p(9059), q(9060).
-q(9060) :- p(9059), q(9059).
-p(9061) :- p(9060), q(9061).
-q(9061) :- p(9060), q(9060).
-p(9062) :- p(9061), q(9062).
-q(9062) :- p(9061), q(9061).
-p(9063) :- p(9062), q(9063).
-q(9063) :- p(9062), q(9062).
-p(9064) :- p(9063), q(9064).
-q(9064) :- p(9063), q(9063).
-p(9065) :- p(9064), q(9065).
-q(9065) :- p(9064), q(9064).
-p(9066) :- p(9065), q(9066).
-q(9066) :- p(9065), q(9065).
-p(9067) :- p(9066), q(9067).
-q(9067) :- p(9066), q(9066).
-p(9068) :- p(9067), q(9068).
-q(9068) :- p(9067), q(9067).
-p(9069) :- p(9068), q(9069).
-q(9069) :- p(9068), q(9068).
-p(9070) :- p(9069), q(9070).
-q(9070) :- p(9069), q(9069).
-p(9071) :- p(9070), q(9071).
-q(9071) :- p(9070), q(9070).
-p(9072) :- p(9071), q(9072).
-q(9072) :- p(9071), q(9071).
-p(9073) :- p(9072), q(9073).
-q(9073) :- p(9072), q(9072).
-p(9074) :- p(9073), q(9074).
-q(9074) :- p(9073), q(9073).
-p(9075) :- p(9074), q(9075).
-q(9075) :- p(9074), q(9074).
-p(9076) :- p(9075), q(9076).
-q(9076) :- p(9075), q(9075).
-p(9077) :- p(9076), q(9077).
-q(9077) :- p(9076), q(9076).
-p(9078) :- p(9077), q(9078).
-q(9078) :- p(9077), q(9077).
-p(9079) :- p(9078), q(9079).
-q(9079) :- p(9078), q(9078).
-p(9080) :- p(9079), q(9080).
-q(9080) :- p(9079), q(9079).
-p(9081) :- p(9080), q(9081).
-q(9081) :- p(9080), q(9080).
-p(9082) :- p(9081), q(9082).
-q(9082) :- p(9081), q(9081).
-p(9083) :- p(9082), q(9083).
-q(9083) :- p(9082), q(9082).
-p(9084) :- p(9083), q(9084).
-q(9084) :- p(9083), q(9083).
-p(9085) :- p(9084), q(9085).
-q(9085) :- p(9084), q(9084).
-p(9086) :- p(9085), q(9086).
-q(9086) :- p(9085), q(9085).
-p(9087) :- p(9086), q(9087).
-q(9087) :- p(9086), q(9086).
-p(9088) :- p(9087), q(9088).
-q(9088) :- p(9087), q(9087).
-p(9089) :- p(9088), q(9089).
-q(9089) :- p(9088), q(9088).
-p(9090) :- p(9089), q(9090).
-q(9090) :- p(9089), q(9089).
-p(9091) :- p(9090), q(9091).
-q(9091) :- p(9090), q(9090).
-p(9092) :- p(9091), q(9092).
-q(9092) :- p(9091), q(9091).
-p(9093) :- p(9092), q(9093).
-q(9093) :- p(9092), q(9092).
-p(9094) :- p(9093), q(9094).
-q(9094) :- p(9093), q(9093).
-p(9095) :- p(9094), q(9095).
-q(9095) :- p(9094), q(9094).
-p(9096) :- p(9095), q(9096).
-q(9096) :- p(9095), q(9095).
-p(9097) :- p(9096), q(9097).
-q(9097) :- p(9096), q(9096).
-p(9098) :- p(9097), q(9098).
-q(9098) :- p(9097), q(9097).
-p(9099) :- p(9098), q(9099).
-q(9099) :- p(9098), q(9098).
-p(9100) :- p(9099), q(9100).
-q(9100) :- p(9099), q(9099).
-p(9101) :- p(9100), q(9101).
-q(9101) :- p(9100), q(9100).
-p(9102) :- p(9101), q(9102).
-q(9102) :- p(9101), q(9101).
-p(9103) :- p(9102), q(9103).
-q(9103) :- p(9102), q(9102).
-p(9104) :- p(9103), q(9104).
-q(9104) :- p(9103), q(9103).
-p(9105) :- p(9104), q(9105).
-q(9105) :- p(9104), q(9104).
-p(9106) :- p(9105), q(9106).
-q(9106) :- p(9105), q(9105).
-p(9107) :- p(9106), q(9107).
-q(9107) :- p(9106), q(9106).
-p(9108) :- p(9107), q(9108).
-q(9108) :- p(9107), q(9107).
-p(9109) :- p(9108), q(9109).
-q(9109) :- p(9108), q(9108).
-p(9110) :- p(9109), q(9110).
-q(9110) :- p(9109), q(9109).
-p(9111) :- p(9110), q(9111).
-q(9111) :- p(9110), q(9110).
-p(9112) :- p(9111), q(9112).
-q(9112) :- p(9111), q(9111).
-p(9113) :- p(9112), q(9113).
-q(9113) :- p(9112), q(9112).
-p(9114) :- p(9113), q(9114).
-q(9114) :- p(9113), q(9113).
-p(9115) :- p(9114), q(9115).
-q(9115) :- p(9114), q(9114).
-p(9116) :- p(9115), q(9116).
-q(9116) :- p(9115), q(9115).
-p(9117) :- p(9116), q(9117).
-q(9117) :- p(9116), q(9116).
-p(9118) :- p(9117), q(9118).
-q(9118) :- p(9117), q(9117).
-p(9119) :- p(9118), q(9119).
-q(9119) :- p(9118), q(9118).
-p(9120) :- p(9119), q(9120).
-q(9120) :- p(9119), q(9119).
-p(9121) :- p(9120), q(9121).
-q(9121) :- p(9120), q(9120).
-p(9122) :- p(9121), q(9122).
-q(9122) :- p(9121), q(9121).
-p(9123) :- p(9122), q(9123).
-q(9123) :- p(9122), q(9122).
-p(9124) :- p(9123), q(9124).
-q(9124) :- p(9123), q(9123).
-p(9125) :- p(9124), q(9125).
-q(9125) :- p(9124), q(9124).
-p(9126) :- p(9125), q(9126).
-q(9126) :- p(9125), q(9125).
-p(9127) :- p(9126), q(9127).
-q(9127) :- p(9126), q(9126).
-p(9128) :- p(9127), q(9128).
-q(9128) :- p(9127), q(9127).
-p(9129) :- p(9128), q(9129).
-q(9129) :- p(9128), q(9128).
-p(9130) :- p(9129), q(9130).
-q(9130) :- p(9129), q(9129).
-p(9131) :- p(9130), q(9131).
-q(9131) :- p(9130), q(9130).
-p(9132) :- p(9131), q(9132).
-q(9132) :- p(9131), q(9131).
-p(9133) :- p(9132), q(9133).
-q(9133) :- p(9132), q(9132).
-p(9134) :- p(9133), q(9134).
-q(9134) :- p(9133), q(9133).
-p(9135) :- p(9134), q(9135).
-q(9135) :- p(9134), q(9134).
-p(9136) :- p(9135), q(9136).
-q(9136) :- p(9135), q(9135).
-p(9137) :- p(9136), q(9137).
-q(9137) :- p(9136), q(9136).
-p(9138) :- p(9137), q(9138).
-q(9138) :- p(9137), q(9137).
-p(9139) :- p(9138), q(9139).
-q(9139) :- p(9138), q(9138).
-p(9140) :- p(9139), q(9140).
-q(9140) :- p(9139), q(9139).
-p(9141) :- p(9140), q(9141).
-q(9141) :- p(9140), q(9140).
-p(9142) :- p(9141), q(9142).
-q(9142) :- p(9141), q(9141).
-p(9143) :- p(9142), q(9143).
-q(9143) :- p(9142), q(9142).
-p(9144) :- p(9143), q(9144).
-q(9144) :- p(9143), q(9143).
-p(9145) :- p(9144), q(9145).
-q(9145) :- p(9144), q(9144).
-p(9146) :- p(9145), q(9146).
-q(9146) :- p(9145), q(9145).
-p(9147) :- p(9146), q(9147).
-q(9147) :- p(9146), q(9146).
-p(9148) :- p(9147), q(9148).
-q(9148) :- p(9147), q(9147).
-p(9149) :- p(9148), q(9149).
-q(9149) :- p(9148), q(9148).
-p(9150) :- p(9149), q(9150).
-q(9150) :- p(9149), q(9149).
-p(9151) :- p(9150), q(9151).
-q(9151) :- p(9150), q(9150).
-p(9152) :- p(9151), q(9152).
-q(9152) :- p(9151), q(9151).
-p(9153) :- p(9152), q(9153).
-q(9153) :- p(9152), q(9152).
-p(9154) :- p(9153), q(9154).
-q(9154) :- p(9153), q(9153).
-p(9155) :- p(9154), q(9155).
-q(9155) :- p(9154), q(9154).
-p(9156) :- p(9155), q(9156).
-q(9156) :- p(9155), q(9155).
-p(9157) :- p(9156), q(9157).
-q(9157) :- p(9156), q(9156).
-p(9158) :- p(9157), q(9158).
-q(9158) :- p(9157), q(9157).
-p(9159) :- p(9158), q(9159).
-q(9159) :- p(9158), q(9158).
-p(9160) :- p(9159), q(9160).
-q(9160) :- p(9159), q(9159).
-p(9161) :- p(9160), q(9161).
-q(9161) :- p(9160), q(9160).
-p(9162) :- p(9161), q(9162).
-q(9162) :- p(9161), q(9161).
-p(9163) :- p(9162), q(9163).
-q(9163) :- p(9162), q(9162).
-p(9164) :- p(9163), q(9164).
-q(9164) :- p(9163), q(9163).
-p(9165) :- p(9164), q(9165).
-q(9165) :- p(9164), q(9164).
-p(9166) :- p(9165), q(9166).
-q(9166) :- p(9165), q(9165).
-p(9167) :- p(9166), q(9167).
-q(9167) :- p(9166), q(9166).
-p(9168) :- p(9167), q(9168).
-q(9168) :- p(9167), q(9167).
-p(9169) :- p(9168), q(9169).
-q(9169) :- p(9168), q(9168).
-p(9170) :- p(9169), q(9170).
-q(9170) :- p(9169), q(9169).
-p(9171) :- p(9170), q(9171).
-q(9171) :- p(9170), q(9170).
-p(9172) :- p(9171), q(9172).
-q(9172) :- p(9171), q(9171).
-p(9173) :- p(9172), q(9173).
-q(9173) :- p(9172), q(9172).
-p(9174) :- p(9173), q(9174).
-q(9174) :- p(9173), q(9173).
-p(9175) :- p(9174), q(9175).
-q(9175) :- p(9174), q(9174).
-p(9176) :- p(9175), q(9176).
-q(9176) :- p(9175), q(9175).
-p(9177) :- p(9176), q(9177).
-q(9177) :- p(9176), q(9176).
-p(9178) :- p(9177), q(9178).
-q(9178) :- p(9177), q(9177).
-p(9179) :- p(9178), q(9179).
-q(9179) :- p(9178), q(9178).
-p(9180) :- p(9179), q(9180).
-q(9180) :- p(9179), q(9179).
-p(9181) :- p(9180), q(9181).
-q(9181) :- p(9180), q(9180).
-p(9182) :- p(9181), q(9182).
-q(9182) :- p(9181), q(9181).
-p(9183) :- p(9182), q(9183).
-q(9183) :- p(9182), q(9182).
-p(9184) :- p(9183), q(9184).
-q(9184) :- p(9183), q(9183).
-p(9185) :- p(9184), q(9185).
-q(9185) :- p(9184), q(9184).
-p(9186) :- p(9185), q(9186).
-q(9186) :- p(9185), q(9185).
-p(9187) :- p(9186), q(9187).
-q(9187) :- p(9186), q(9186).
-p(9188) :- p(9187), q(9188).
-q(9188) :- p(9187), q(9187).
-p(9189) :- p(9188), q(9189).
-q(9189) :- p(9188), q(9188).
-p(9190) :- p(9189), q(9190).
-q(9190) :- p(9189), q(9189).
-p(9191) :- p(9190), q(9191).
-q(9191) :- p(9190), q(9190).
-p(9192) :- p(9191), q(9192).
-q(9192) :- p(9191), q(9191).
-p(9193) :- p(9192), q(9193).
-q(9193) :- p(9192), q(9192).
-p(9194) :- p(9193), q(9194).
-q(9194) :- p(9193), q(9193).
-p(9195) :- p(9194), q(9195).
-q(9195) :- p(9194), q(9194).
-p(9196) :- p(9195), q(9196).
-q(9196) :- p(9195), q(9195).
-p(9197) :- p(9196), q(9197).
-q(9197) :- p(9196), q(9196).
-p(9198) :- p(9197), q(9198).
-q(9198) :- p(9197), q(9197).
-p(9199) :- p(9198), q(9199).
-q(9199) :- p(9198), q(9198).
-p(9200) :- p(9199), q(9200).
-q(9200) :- p(9199), q(9199).
-p(9201) :- p(9200), q(9201).
-q(9201) :- p(9200), q(9200).
-p(9202) :- p(9201), q(9202).
-q(9202) :- p(9201), q(9201).
-p(9203) :- p(9202), q(9203).
-q(9203) :- p(9202), q(9202).
-p(9204) :- p(9203), q(9204).
-q(9204) :- p(9203), q(9203).
-p(9205) :- p(9204), q(9205).
-q(9205) :- p(9204), q(9204).
-p(9206) :- p(9205), q(9206).
-q(9206) :- p(9205), q(9205).
-p(9207) :- p(9206), q(9207).
-q(9207) :- p(9206), q(9206).
-p(9208) :- p(9207), q(9208).
-q(9208) :- p(9207), q(9207).
-p(9209) :- p(9208), q(9209).
-q(9209) :- p(9208), q(9208).
-p(9210) :- p(9209), q(9210).
-q(9210) :- p(9209), q(9209).
-p(9211) :- p(9210), q(9211).
-q(9211) :- p(9210), q(9210).
-p(9212) :- p(9211), q(9212).
-q(9212) :- p(9211), q(9211).
-p(9213) :- p(9212), q(9213).
-q(9213) :- p(9212), q(9212).
-p(9214) :- p(9213), q(9214).
-q(9214) :- p(9213), q(9213).
-p(9215) :- p(9214), q(9215).
-q(9215) :- p(9214), q(9214).
-p(9216) :- p(9215), q(9216).
-q(9216) :- p(9215), q(9215).
-p(9217) :- p(9216), q(9217).
-q(9217) :- p(9216), q(9216).
-p(9218) :- p(9217), q(9218).
-q(9218) :- p(9217), q(9217).
-p(9219) :- p(9218), q(9219).
-q(9219) :- p(9218), q(9218).
-p(9220) :- p(9219), q(9220).
-q(9220) :- p(9219), q(9219).
-p(9221) :- p(9220), q(9221).
-q(9221) :- p(9220), q(9220).
-p(9222) :- p(9221), q(9222).
-q(9222) :- p(9221), q(9221).
-p(9223) :- p(9222), q(9223).
-q(9223) :- p(9222), q(9222).
-p(9224) :- p(9223), q(9224).
-q(9224) :- p(9223), q(9223).
-p(9225) :- p(9224), q(9225).
-q(9225) :- p(9224), q(9224).
-p(9226) :- p(9225), q(9226).
-q(9226) :- p(9225), q(9225).
-p(9227) :- p(9226), q(9227).
-q(9227) :- p(9226), q(9226).
-p(9228) :- p(9227), q(9228).
-q(9228) :- p(9227), q(9227).
-p(9229) :- p(9228), q(9229).
-q(9229) :- p(9228), q(9228).
-p(9230) :- p(9229), q(9230).
-q(9230) :- p(9229), q(9229).
-p(9231) :- p(9230), q(9231).
-q(9231) :- p(9230), q(9230).
-p(9232) :- p(9231), q(9232).
-q(9232) :- p(9231), q(9231).
-p(9233) :- p(9232), q(9233).
-q(9233) :- p(9232), q(9232).
-p(9234) :- p(9233), q(9234).
-q(9234) :- p(9233), q(9233).
-p(9235) :- p(9234), q(9235).
-q(9235) :- p(9234), q(9234).
-p(9236) :- p(9235), q(9236).
-q(9236) :- p(9235), q(9235).
-p(9237) :- p(9236), q(9237).
-q(9237) :- p(9236), q(9236).
-p(9238) :- p(9237), q(9238).
-q(9238) :- p(9237), q(9237).
-p(9239) :- p(9238), q(9239).
-q(9239) :- p(9238), q(9238).
-p(9240) :- p(9239), q(9240).
-q(9240) :- p(9239), q(9239).
-p(9241) :- p(9240), q(9241).
-q(9241) :- p(9240), q(9240).
-p(9242) :- p(9241), q(9242).
-q(9242) :- p(9241), q(9241).
-p(9243) :- p(9242), q(9243).
-q(9243) :- p(9242), q(9242).
-p(9244) :- p(9243), q(9244).
-q(9244) :- p(9243), q(9243).
-p(9245) :- p(9244), q(9245).
-q(9245) :- p(9244), q(9244).
-p(9246) :- p(9245), q(9246).
-q(9246) :- p(9245), q(9245).
-p(9247) :- p(9246), q(9247).
-q(9247) :- p(9246), q(9246).
-p(9248) :- p(9247), q(9248).
-q(9248) :- p(9247), q(9247).
-p(9249) :- p(9248), q(9249).
-q(9249) :- p(9248), q(9248).
-p(9250) :- p(9249), q(9250).
-q(9250) :- p(9249), q(9249).
-p(9251) :- p(9250), q(9251).
-q(9251) :- p(9250), q(9250).
-p(9252) :- p(9251), q(9252).
-q(9252) :- p(9251), q(9251).
-p(9253) :- p(9252), q(9253).
-q(9253) :- p(9252), q(9252).
-p(9254) :- p(9253), q(9254).
-q(9254) :- p(9253), q(9253).
-p(9255) :- p(9254), q(9255).
-q(9255) :- p(9254), q(9254).
-p(9256) :- p(9255), q(9256).
-q(9256) :- p(9255), q(9255).
-p(9257) :- p(9256), q(9257).
-q(9257) :- p(9256), q(9256).
-p(9258) :- p(9257), q(9258).
-q(9258) :- p(9257), q(9257).
-p(9259) :- p(9258), q(9259).
-q(9259) :- p(9258), q(9258).
-p(9260) :- p(9259), q(9260).
-q(9260) :- p(9259), q(9259).
-p(9261) :- p(9260), q(9261).
-q(9261) :- p(9260), q(9260).
-p(9262) :- p(9261), q(9262).
-q(9262) :- p(9261), q(9261).
-p(9263) :- p(9262), q(9263).
-q(9263) :- p(9262), q(9262).
-p(9264) :- p(9263), q(9264).
-q(9264) :- p(9263), q(9263).
-p(9265) :- p(9264), q(9265).
-q(9265) :- p(9264), q(9264).
-p(9266) :- p(9265), q(9266).
-q(9266) :- p(9265), q(9265).
-p(9267) :- p(9266), q(9267).
-q(9267) :- p(9266), q(9266).
-p(9268) :- p(9267), q(9268).
-q(9268) :- p(9267), q(9267).
-p(9269) :- p(9268), q(9269).
-q(9269) :- p(9268), q(9268).
-p(9270) :- p(9269), q(9270).
-q(9270) :- p(9269), q(9269).
-p(9271) :- p(9270), q(9271).
-q(9271) :- p(9270), q(9270).
-p(9272) :- p(9271), q(9272).
-q(9272) :- p(9271), q(9271).
-p(9273) :- p(9272), q(9273).
-q(9273) :- p(9272), q(9272).
-p(9274) :- p(9273), q(9274).
-q(9274) :- p(9273), q(9273).
-p(9275) :- p(9274), q(9275).
-q(9275) :- p(9274), q(9274).
-p(9276) :- p(9275), q(9276).
-q(9276) :- p(9275), q(9275).
-p(9277) :- p(9276), q(9277).
-q(9277) :- p(9276), q(9276).
-p(9278) :- p(9277), q(9278).
-q(9278) :- p(9277), q(9277).
-p(9279) :- p(9278), q(9279).
-q(9279) :- p(9278), q(9278).
-p(9280) :- p(9279), q(9280).
-q(9280) :- p(9279), q(9279).
-p(9281) :- p(9280), q(9281).
-q(9281) :- p(9280), q(9280).
-p(9282) :- p(9281), q(9282).
-q(9282) :- p(9281), q(9281).
-p(9283) :- p(9282), q(9283).
-q(9283) :- p(9282), q(9282).
-p(9284) :- p(9283), q(9284).
-q(9284) :- p(9283), q(9283).
-p(9285) :- p(9284), q(9285).
-q(9285) :- p(9284), q(9284).
-p(9286) :- p(9285), q(9286).
-q(9286) :- p(9285), q(9285).
-p(9287) :- p(9286), q(9287).
-q(9287) :- p(9286), q(9286).
-p(9288) :- p(9287), q(9288).
-q(9288) :- p(9287), q(9287).
-p(9289) :- p(9288), q(9289).
-q(9289) :- p(9288), q(9288).
-p(9290) :- p(9289), q(9290).
-q(9290) :- p(9289), q(9289).
-p(9291) :- p(9290), q(9291).
-q(9291) :- p(9290), q(9290).
-p(9292) :- p(9291), q(9292).
-q(9292) :- p(9291), q(9291).
-p(9293) :- p(9292), q(9293).
-q(9293) :- p(9292), q(9292).
-p(9294) :- p(9293), q(9294).
-q(9294) :- p(9293), q(9293).
-p(9295) :- p(9294), q(9295).
-q(9295) :- p(9294), q(9294).
-p(9296) :- p(9295), q(9296).
-q(9296) :- p(9295), q(9295).
-p(9297) :- p(9296), q(9297).
-q(9297) :- p(9296), q(9296).
-p(9298) :- p(9297), q(9298).
-q(9298) :- p(9297), q(9297).
-p(9299) :- p(9298), q(9299).
-q(9299) :- p(9298), q(9298).
-p(9300) :- p(9299), q(9300).
-q(9300) :- p(9299), q(9299).
-p(9301) :- p(9300), q(9301).
-q(9301) :- p(9300), q(9300).
-p(9302) :- p(9301), q(9302).
-q(9302) :- p(9301), q(9301).
-p(9303) :- p(9302), q(9303).
-q(9303) :- p(9302), q(9302).
-p(9304) :- p(9303), q(9304).
-q(9304) :- p(9303), q(9303).
-p(9305) :- p(9304), q(9305).
-q(9305) :- p(9304), q(9304).
-p(9306) :- p(9305), q(9306).
-q(9306) :- p(9305), q(9305).
-p(9307) :- p(9306), q(9307).
-q(9307) :- p(9306), q(9306).
-p(9308) :- p(9307), q(9308).
-q(9308) :- p(9307), q(9307).
-p(9309) :- p(9308), q(9309).
-q(9309) :- p(9308), q(9308).
-p(9310) :- p(9309), q(9310).
-q(9310) :- p(9309), q(9309).
-p(9311) :- p(9310), q(9311).
-q(9311) :- p(9310), q(9310).
-p(9312) :- p(9311), q(9312).
-q(9312) :- p(9311), q(9311).
-p(9313) :- p(9312), q(9313).
-q(9313) :- p(9312), q(9312).
-p(9314) :- p(9313), q(9314).
-q(9314) :- p(9313), q(9313).
-p(9315) :- p(9314), q(9315).
-q(9315) :- p(9314), q(9314).
-p(9316) :- p(9315), q(9316).
-q(9316) :- p(9315), q(9315).
-p(9317) :- p(9316), q(9317).
-q(9317) :- p(9316), q(9316).
-p(9318) :- p(9317), q(9318).
-q(9318) :- p(9317), q(9317).
-p(9319) :- p(9318), q(9319).
-q(9319) :- p(9318), q(9318).
-p(9320) :- p(9319), q(9320).
-q(9320) :- p(9319), q(9319).
-p(9321) :- p(9320), q(9321).
-q(9321) :- p(9320), q(9320).
-p(9322) :- p(9321), q(9322).
-q(9322) :- p(9321), q(9321).
-p(9323) :- p(9322), q(9323).
-q(9323) :- p(9322), q(9322).
-p(9324) :- p(9323), q(9324).
-q(9324) :- p(9323), q(9323).
-p(9325) :- p(9324), q(9325).
-q(9325) :- p(9324), q(9324).
-p(9326) :- p(9325), q(9326).
-q(9326) :- p(9325), q(9325).
-p(9327) :- p(9326), q(9327).
-q(9327) :- p(9326), q(9326).
-p(9328) :- p(9327), q(9328).
-q(9328) :- p(9327), q(9327).
-p(9329) :- p(9328), q(9329).
-q(9329) :- p(9328), q(9328).
-p(9330) :- p(9329), q(9330).
-q(9330) :- p(9329), q(9329).
-p(9331) :- p(9330), q(9331).
-q(9331) :- p(9330), q(9330).
-p(9332) :- p(9331), q(9332).
-q(9332) :- p(9331), q(9331).
-p(9333) :- p(9332), q(9333).
-q(9333) :- p(9332), q(9332).
-p(9334) :- p(9333), q(9334).
-q(9334) :- p(9333), q(9333).
-p(9335) :- p(9334), q(9335).
-q(9335) :- p(9334), q(9334).
-p(9336) :- p(9335), q(9336).
-q(9336) :- p(9335), q(9335).
-p(9337) :- p(9336), q(9337).
-q(9337) :- p(9336), q(9336).
-p(9338) :- p(9337), q(9338).
-q(9338) :- p(9337), q(9337).
-p(9339) :- p(9338), q(9339).
-q(9339) :- p(9338), q(9338).
-p(9340) :- p(9339), q(9340).
-q(9340) :- p(9339), q(9339).
-p(9341) :- p(9340), q(9341).
-q(9341) :- p(9340), q(9340).
-p(9342) :- p(9341), q(9342).
-q(9342) :- p(9341), q(9341).
-p(9343) :- p(9342), q(9343).
-q(9343) :- p(9342), q(9342).
-p(9344) :- p(9343), q(9344).
-q(9344) :- p(9343), q(9343).
-p(9345) :- p(9344), q(9345).
-q(9345) :- p(9344), q(9344).
-p(9346) :- p(9345), q(9346).
-q(9346) :- p(9345), q(9345).
-p(9347) :- p(9346), q(9347).
-q(9347) :- p(9346), q(9346).
-p(9348) :- p(9347), q(9348).
-q(9348) :- p(9347), q(9347).
-p(9349) :- p(9348), q(9349).
-q(9349) :- p(9348), q(9348).
-p(9350) :- p(9349), q(9350).
-q(9350) :- p(9349), q(9349).
-p(9351) :- p(9350), q(9351).
-q(9351) :- p(9350), q(9350).
-p(9352) :- p(9351), q(9352).
-q(9352) :- p(9351), q(9351).
-p(9353) :- p(9352), q(9353).
-q(9353) :- p(9352), q(9352).
-p(9354) :- p(9353), q(9354).
-q(9354) :- p(9353), q(9353).
-p(9355) :- p(9354), q(9355).
-q(9355) :- p(9354), q(9354).
-p(9356) :- p(9355), q(9356).
-q(9356) :- p(9355), q(9355).
-p(9357) :- p(9356), q(9357).
-q(9357) :- p(9356), q(9356).
-p(9358) :- p(9357), q(9358).
-q(9358) :- p(9357), q(9357).
-p(9359) :- p(9358), q(9359).
-q(9359) :- p(9358), q(9358).
-p(9360) :- p(9359), q(9360).
-q(9360) :- p(9359), q(9359).
-p(9361) :- p(9360), q(9361).
-q(9361) :- p(9360), q(9360).
-p(9362) :- p(9361), q(9362).
-q(9362) :- p(9361), q(9361).
-p(9363) :- p(9362), q(9363).
-q(9363) :- p(9362), q(9362).
-p(9364) :- p(9363), q(9364).
-q(9364) :- p(9363), q(9363).
-p(9365) :- p(9364), q(9365).
-q(9365) :- p(9364), q(9364).
-p(9366) :- p(9365), q(9366).
-q(9366) :- p(9365), q(9365).
-p(9367) :- p(9366), q(9367).
-q(9367) :- p(9366), q(9366).
-p(9368) :- p(9367), q(9368).
-q(9368) :- p(9367), q(9367).
-p(9369) :- p(9368), q(9369).
-q(9369) :- p(9368), q(9368).
-p(9370) :- p(9369), q(9370).
-q(9370) :- p(9369), q(9369).
-p(9371) :- p(9370), q(9371).
-q(9371) :- p(9370), q(9370).
-p(9372) :- p(9371), q(9372).
-q(9372) :- p(9371), q(9371).
-p(9373) :- p(9372), q(9373).
-q(9373) :- p(9372), q(9372).
-p(9374) :- p(9373), q(9374).
-q(9374) :- p(9373), q(9373).
-p(9375) :- p(9374), q(9375).
-q(9375) :- p(9374), q(9374).
-p(9376) :- p(9375), q(9376).
-q(9376) :- p(9375), q(9375).
-p(9377) :- p(9376), q(9377).
-q(9377) :- p(9376), q(9376).
-p(9378) :- p(9377), q(9378).
-q(9378) :- p(9377), q(9377).
-p(9379) :- p(9378), q(9379).
-q(9379) :- p(9378), q(9378).
-p(9380) :- p(9379), q(9380).
-q(9380) :- p(9379), q(9379).
-p(9381) :- p(9380), q(9381).
-q(9381) :- p(9380), q(9380).
-p(9382) :- p(9381), q(9382).
-q(9382) :- p(9381), q(9381).
-p(9383) :- p(9382), q(9383).
-q(9383) :- p(9382), q(9382).
-p(9384) :- p(9383), q(9384).
-q(9384) :- p(9383), q(9383).
-p(9385) :- p(9384), q(9385).
-q(9385) :- p(9384), q(9384).
-p(9386) :- p(9385), q(9386).
-q(9386) :- p(9385), q(9385).
-p(9387) :- p(9386), q(9387).
-q(9387) :- p(9386), q(9386).
-p(9388) :- p(9387), q(9388).
-q(9388) :- p(9387), q(9387).
-p(9389) :- p(9388), q(9389).
-q(9389) :- p(9388), q(9388).
-p(9390) :- p(9389), q(9390).
-q(9390) :- p(9389), q(9389).
-p(9391) :- p(9390), q(9391).
-q(9391) :- p(9390), q(9390).
-p(9392) :- p(9391), q(9392).
-q(9392) :- p(9391), q(9391).
-p(9393) :- p(9392), q(9393).
-q(9393) :- p(9392), q(9392).
-p(9394) :- p(9393), q(9394).
-q(9394) :- p(9393), q(9393).
-p(9395) :- p(9394), q(9395).
-q(9395) :- p(9394), q(9394).
-p(9396) :- p(9395), q(9396).
-q(9396) :- p(9395), q(9395).
-p(9397) :- p(9396), q(9397).
-q(9397) :- p(9396), q(9396).
-p(9398) :- p(9397), q(9398).
-q(9398) :- p(9397), q(9397).
-p(9399) :- p(9398), q(9399).
-q(9399) :- p(9398), q(9398).
-p(9400) :- p(9399), q(9400).
-q(9400) :- p(9399), q(9399).
-p(9401) :- p(9400), q(9401).
-q(9401) :- p(9400), q(9400).
-p(9402) :- p(9401), q(9402).
-q(9402) :- p(9401), q(9401).
-p(9403) :- p(9402), q(9403).
-q(9403) :- p(9402), q(9402).
-p(9404) :- p(9403), q(9404).
-q(9404) :- p(9403), q(9403).
-p(9405) :- p(9404), q(9405).
-q(9405) :- p(9404), q(9404).
-p(9406) :- p(9405), q(9406).
-q(9406) :- p(9405), q(9405).
-p(9407) :- p(9406), q(9407).
-q(9407) :- p(9406), q(9406).
-p(9408) :- p(9407), q(9408).
-q(9408) :- p(9407), q(9407).
-p(9409) :- p(9408), q(9409).
-q(9409) :- p(9408), q(9408).
-p(9410) :- p(9409), q(9410).
-q(9410) :- p(9409), q(9409).
-p(9411) :- p(9410), q(9411).
-q(9411) :- p(9410), q(9410).
-p(9412) :- p(9411), q(9412).
-q(9412) :- p(9411), q(9411).
-p(9413) :- p(9412), q(9413).
-q(9413) :- p(9412), q(9412).
-p(9414) :- p(9413), q(9414).
-q(9414) :- p(9413), q(9413).
-p(9415) :- p(9414), q(9415).
-q(9415) :- p(9414), q(9414).
-p(9416) :- p(9415), q(9416).
-q(9416) :- p(9415), q(9415).
-p(9417) :- p(9416), q(9417).
-q(9417) :- p(9416), q(9416).
-p(9418) :- p(9417), q(9418).
-q(9418) :- p(9417), q(9417).
-p(9419) :- p(9418), q(9419).
-q(9419) :- p(9418), q(9418).
-p(9420) :- p(9419), q(9420).
-q(9420) :- p(9419), q(9419).
-p(9421) :- p(9420), q(9421).
-q(9421) :- p(9420), q(9420).
-p(9422) :- p(9421), q(9422).
-q(9422) :- p(9421), q(9421).
-p(9423) :- p(9422), q(9423).
-q(9423) :- p(9422), q(9422).
-p(9424) :- p(9423), q(9424).
-q(9424) :- p(9423), q(9423).
-p(9425) :- p(9424), q(9425).
-q(9425) :- p(9424), q(9424).
-p(9426) :- p(9425), q(9426).
-q(9426) :- p(9425), q(9425).
-p(9427) :- p(9426), q(9427).
-q(9427) :- p(9426), q(9426).
-p(9428) :- p(9427), q(9428).
-q(9428) :- p(9427), q(9427).
-p(9429) :- p(9428), q(9429).
-q(9429) :- p(9428), q(9428).
-p(9430) :- p(9429), q(9430).
-q(9430) :- p(9429), q(9429).
-p(9431) :- p(9430), q(9431).
-q(9431) :- p(9430), q(9430).
-p(9432) :- p(9431), q(9432).
-q(9432) :- p(9431), q(9431).
-p(9433) :- p(9432), q(9433).
-q(9433) :- p(9432), q(9432).
-p(9434) :- p(9433), q(9434).
-q(9434) :- p(9433), q(9433).
-p(9435) :- p(9434), q(9435).
-q(9435) :- p(9434), q(9434).
-p(9436) :- p(9435), q(9436).
-q(9436) :- p(9435), q(9435).
-p(9437) :- p(9436), q(9437).
-q(9437) :- p(9436), q(9436).
-p(9438) :- p(9437), q(9438).
-q(9438) :- p(9437), q(9437).
-p(9439) :- p(9438), q(9439).
-q(9439) :- p(9438), q(9438).
-p(9440) :- p(9439), q(9440).
-q(9440) :- p(9439), q(9439).
-p(9441) :- p(9440), q(9441).
-q(9441) :- p(9440), q(9440).
-p(9442) :- p(9441), q(9442).
-q(9442) :- p(9441), q(9441).
-p(9443) :- p(9442), q(9443).
-q(9443) :- p(9442), q(9442).
-p(9444) :- p(9443), q(9444).
-q(9444) :- p(9443), q(9443).
-p(9445) :- p(9444), q(9445).
-q(9445) :- p(9444), q(9444).
-p(9446) :- p(9445), q(9446).
-q(9446) :- p(9445), q(9445).
-p(9447) :- p(9446), q(9447).
-q(9447) :- p(9446), q(9446).
-p(9448) :- p(9447), q(9448).
-q(9448) :- p(9447), q(9447).
-p(9449) :- p(9448), q(9449).
-q(9449) :- p(9448), q(9448).
-p(9450) :- p(9449), q(9450).
-q(9450) :- p(9449), q(9449).
-p(9451) :- p(9450), q(9451).
-q(9451) :- p(9450), q(9450).
-p(9452) :- p(9451), q(9452).
-q(9452) :- p(9451), q(9451).
-p(9453) :- p(9452), q(9453).
-q(9453) :- p(9452), q(9452).
-p(9454) :- p(9453), q(9454).
-q(9454) :- p(9453), q(9453).
-p(9455) :- p(9454), q(9455).
-q(9455) :- p(9454), q(9454).
-p(9456) :- p(9455), q(9456).
-q(9456) :- p(9455), q(9455).
-p(9457) :- p(9456), q(9457).
-q(9457) :- p(9456), q(9456).
-p(9458) :- p(9457), q(9458).
-q(9458) :- p(9457), q(9457).
-p(9459) :- p(9458), q(9459).
-q(9459) :- p(9458), q(9458).
-p(9460) :- p(9459), q(9460).
-q(9460) :- p(9459), q(9459).
-p(9461) :- p(9460), q(9461).
-q(9461) :- p(9460), q(9460).
-p(9462) :- p(9461), q(9462).
-q(9462) :- p(9461), q(9461).
-p(9463) :- p(9462), q(9463).
-q(9463) :- p(9462), q(9462).
-p(9464) :- p(9463), q(9464).
-q(9464) :- p(9463), q(9463).
-p(9465) :- p(9464), q(9465).
-q(9465) :- p(9464), q(9464).
-p(9466) :- p(9465), q(9466).
-q(9466) :- p(9465), q(9465).
-p(9467) :- p(9466), q(9467).
-q(9467) :- p(9466), q(9466).
-p(9468) :- p(9467), q(9468).
-q(9468) :- p(9467), q(9467).
-p(9469) :- p(9468), q(9469).
-q(9469) :- p(9468), q(9468).
-p(9470) :- p(9469), q(9470).
-q(9470) :- p(9469), q(9469).
-p(9471) :- p(9470), q(9471).
-q(9471) :- p(9470), q(9470).
-p(9472) :- p(9471), q(9472).
-q(9472) :- p(9471), q(9471).
-p(9473) :- p(9472), q(9473).
-q(9473) :- p(9472), q(9472).
-p(9474) :- p(9473), q(9474).
-q(9474) :- p(9473), q(9473).
-p(9475) :- p(9474), q(9475).
-q(9475) :- p(9474), q(9474).
-p(9476) :- p(9475), q(9476).
-q(9476) :- p(9475), q(9475).
-p(9477) :- p(9476), q(9477).
-q(9477) :- p(9476), q(9476).
-p(9478) :- p(9477), q(9478).
-q(9478) :- p(9477), q(9477).
-p(9479) :- p(9478), q(9479).
-q(9479) :- p(9478), q(9478).
-p(9480) :- p(9479), q(9480).
-q(9480) :- p(9479), q(9479).
-p(9481) :- p(9480), q(9481).
-q(9481) :- p(9480), q(9480).
-p(9482) :- p(9481), q(9482).
-q(9482) :- p(9481), q(9481).
-p(9483) :- p(9482), q(9483).
-q(9483) :- p(9482), q(9482).
-p(9484) :- p(9483), q(9484).
-q(9484) :- p(9483), q(9483).
-p(9485) :- p(9484), q(9485).
-q(9485) :- p(9484), q(9484).
-p(9486) :- p(9485), q(9486).
-q(9486) :- p(9485), q(9485).
-p(9487) :- p(9486), q(9487).
-q(9487) :- p(9486), q(9486).
-p(9488) :- p(9487), q(9488).
-q(9488) :- p(9487), q(9487).
-p(9489) :- p(9488), q(9489).
-q(9489) :- p(9488), q(9488).
-p(9490) :- p(9489), q(9490).
-q(9490) :- p(9489), q(9489).
-p(9491) :- p(9490), q(9491).
-q(9491) :- p(9490), q(9490).
-p(9492) :- p(9491), q(9492).
-q(9492) :- p(9491), q(9491).
-p(9493) :- p(9492), q(9493).
-q(9493) :- p(9492), q(9492).
-p(9494) :- p(9493), q(9494).
-q(9494) :- p(9493), q(9493).
-p(9495) :- p(9494), q(9495).
-q(9495) :- p(9494), q(9494).
-p(9496) :- p(9495), q(9496).
-q(9496) :- p(9495), q(9495).
-p(9497) :- p(9496), q(9497).
-q(9497) :- p(9496), q(9496).
-p(9498) :- p(9497), q(9498).
-q(9498) :- p(9497), q(9497).
-p(9499) :- p(9498), q(9499).
-q(9499) :- p(9498), q(9498).
-p(9500) :- p(9499), q(9500).
-q(9500) :- p(9499), q(9499).
-p(9501) :- p(9500), q(9501).
-q(9501) :- p(9500), q(9500).
-p(9502) :- p(9501), q(9502).
-q(9502) :- p(9501), q(9501).
-p(9503) :- p(9502), q(9503).
-q(9503) :- p(9502), q(9502).
-p(9504) :- p(9503), q(9504).
-q(9504) :- p(9503), q(9503).
-p(9505) :- p(9504), q(9505).
-q(9505) :- p(9504), q(9504).
-p(9506) :- p(9505), q(9506).
-q(9506) :- p(9505), q(9505).
-p(9507) :- p(9506), q(9507).
-q(9507) :- p(9506), q(9506).
-p(9508) :- p(9507), q(9508).
-q(9508) :- p(9507), q(9507).
-p(9509) :- p(9508), q(9509).
-q(9509) :- p(9508), q(9508).
-p(9510) :- p(9509), q(9510).
-q(9510) :- p(9509), q(9509).
-p(9511) :- p(9510), q(9511).
-q(9511) :- p(9510), q(9510).
-p(9512) :- p(9511), q(9512).
-q(9512) :- p(9511), q(9511).
-p(9513) :- p(9512), q(9513).
-q(9513) :- p(9512), q(9512).
-p(9514) :- p(9513), q(9514).
-q(9514) :- p(9513), q(9513).
-p(9515) :- p(9514), q(9515).
-q(9515) :- p(9514), q(9514).
-p(9516) :- p(9515), q(9516).
-q(9516) :- p(9515), q(9515).
-p(9517) :- p(9516), q(9517).
-q(9517) :- p(9516), q(9516).
-p(9518) :- p(9517), q(9518).
-q(9518) :- p(9517), q(9517).
-p(9519) :- p(9518), q(9519).
-q(9519) :- p(9518), q(9518).
-p(9520) :- p(9519), q(9520).
-q(9520) :- p(9519), q(9519).
-p(9521) :- p(9520), q(9521).
-q(9521) :- p(9520), q(9520).
-p(9522) :- p(9521), q(9522).
-q(9522) :- p(9521), q(9521).
-p(9523) :- p(9522), q(9523).
-q(9523) :- p(9522), q(9522).
-p(9524) :- p(9523), q(9524).
-q(9524) :- p(9523), q(9523).
-p(9525) :- p(9524), q(9525).
-q(9525) :- p(9524), q(9524).
-p(9526) :- p(9525), q(9526).
-q(9526) :- p(9525), q(9525).
-p(9527) :- p(9526), q(9527).
-q(9527) :- p(9526), q(9526).
-p(9528) :- p(9527), q(9528).
-q(9528) :- p(9527), q(9527).
-p(9529) :- p(9528), q(9529).
-q(9529) :- p(9528), q(9528).
-p(9530) :- p(9529), q(9530).
-q(9530) :- p(9529), q(9529).
-p(9531) :- p(9530), q(9531).
-q(9531) :- p(9530), q(9530).
-p(9532) :- p(9531), q(9532).
-q(9532) :- p(9531), q(9531).
-p(9533) :- p(9532), q(9533).
-q(9533) :- p(9532), q(9532).
-p(9534) :- p(9533), q(9534).
-q(9534) :- p(9533), q(9533).
-p(9535) :- p(9534), q(9535).
-q(9535) :- p(9534), q(9534).
-p(9536) :- p(9535), q(9536).
-q(9536) :- p(9535), q(9535).
-p(9537) :- p(9536), q(9537).
-q(9537) :- p(9536), q(9536).
-p(9538) :- p(9537), q(9538).
-q(9538) :- p(9537), q(9537).
-p(9539) :- p(9538), q(9539).
-q(9539) :- p(9538), q(9538).
-p(9540) :- p(9539), q(9540).
-q(9540) :- p(9539), q(9539).
-p(9541) :- p(9540), q(9541).
-q(9541) :- p(9540), q(9540).
-p(9542) :- p(9541), q(9542).
-q(9542) :- p(9541), q(9541).
-p(9543) :- p(9542), q(9543).
-q(9543) :- p(9542), q(9542).
-p(9544) :- p(9543), q(9544).
-q(9544) :- p(9543), q(9543).
-p(9545) :- p(9544), q(9545).
-q(9545) :- p(9544), q(9544).
-p(9546) :- p(9545), q(9546).
-q(9546) :- p(9545), q(9545).
-p(9547) :- p(9546), q(9547).
-q(9547) :- p(9546), q(9546).
-p(9548) :- p(9547), q(9548).
-q(9548) :- p(9547), q(9547).
-p(9549) :- p(9548), q(9549).
-q(9549) :- p(9548), q(9548).
-p(9550) :- p(9549), q(9550).
-q(9550) :- p(9549), q(9549).
-p(9551) :- p(9550), q(9551).
-q(9551) :- p(9550), q(9550).
-p(9552) :- p(9551), q(9552).
-q(9552) :- p(9551), q(9551).
-p(9553) :- p(9552), q(9553).
-q(9553) :- p(9552), q(9552).
-p(9554) :- p(9553), q(9554).
-q(9554) :- p(9553), q(9553).
-p(9555) :- p(9554), q(9555).
-q(9555) :- p(9554), q(9554).
-p(9556) :- p(9555), q(9556).
-q(9556) :- p(9555), q(9555).
-p(9557) :- p(9556), q(9557).
-q(9557) :- p(9556), q(9556).
-p(9558) :- p(9557), q(9558).
-q(9558) :- p(9557), q(9557).
-p(9559) :- p(9558), q(9559).
-q(9559) :- p(9558), q(9558).
-p(9560) :- p(9559), q(9560).
-q(9560) :- p(9559), q(9559).
-p(9561) :- p(9560), q(9561).
-q(9561) :- p(9560), q(9560).
-p(9562) :- p(9561), q(9562).
-q(9562) :- p(9561), q(9561).
-p(9563) :- p(9562), q(9563).
-q(9563) :- p(9562), q(9562).
-p(9564) :- p(9563), q(9564).
-q(9564) :- p(9563), q(9563).
-p(9565) :- p(9564), q(9565).
-q(9565) :- p(9564), q(9564).
-p(9566) :- p(9565), q(9566).
-q(9566) :- p(9565), q(9565).
-p(9567) :- p(9566), q(9567).
-q(9567) :- p(9566), q(9566).
-p(9568) :- p(9567), q(9568).
-q(9568) :- p(9567), q(9567).
-p(9569) :- p(9568), q(9569).
-q(9569) :- p(9568), q(9568).
-p(9570) :- p(9569), q(9570).
-q(9570) :- p(9569), q(9569).
-p(9571) :- p(9570), q(9571).
-q(9571) :- p(9570), q(9570).
-p(9572) :- p(9571), q(9572).
-q(9572) :- p(9571), q(9571).
-p(9573) :- p(9572), q(9573).
-q(9573) :- p(9572), q(9572).
-p(9574) :- p(9573), q(9574).
-q(9574) :- p(9573), q(9573).
-p(9575) :- p(9574), q(9575).
-q(9575) :- p(9574), q(9574).
-p(9576) :- p(9575), q(9576).
-q(9576) :- p(9575), q(9575).
-p(9577) :- p(9576), q(9577).
-q(9577) :- p(9576), q(9576).
-p(9578) :- p(9577), q(9578).
-q(9578) :- p(9577), q(9577).
-p(9579) :- p(9578), q(9579).
-q(9579) :- p(9578), q(9578).
-p(9580) :- p(9579), q(9580).
-q(9580) :- p(9579), q(9579).
-p(9581) :- p(9580), q(9581).
-q(9581) :- p(9580), q(9580).
-p(9582) :- p(9581), q(9582).
-q(9582) :- p(9581), q(9581).
-p(9583) :- p(9582), q(9583).
-q(9583) :- p(9582), q(9582).
-p(9584) :- p(9583), q(9584).
-q(9584) :- p(9583), q(9583).
-p(9585) :- p(9584), q(9585).
-q(9585) :- p(9584), q(9584).
-p(9586) :- p(9585), q(9586).
-q(9586) :- p(9585), q(9585).
-p(9587) :- p(9586), q(9587).
-q(9587) :- p(9586), q(9586).
-p(9588) :- p(9587), q(9588).
-q(9588) :- p(9587), q(9587).
-p(9589) :- p(9588), q(9589).
-q(9589) :- p(9588), q(9588).
-p(9590) :- p(9589), q(9590).
-q(9590) :- p(9589), q(9589).
-p(9591) :- p(9590), q(9591).
-q(9591) :- p(9590), q(9590).
-p(9592) :- p(9591), q(9592).
-q(9592) :- p(9591), q(9591).
-p(9593) :- p(9592), q(9593).
-q(9593) :- p(9592), q(9592).
-p(9594) :- p(9593), q(9594).
-q(9594) :- p(9593), q(9593).
-p(9595) :- p(9594), q(9595).
-q(9595) :- p(9594), q(9594).
-p(9596) :- p(9595), q(9596).
-q(9596) :- p(9595), q(9595).
-p(9597) :- p(9596), q(9597).
-q(9597) :- p(9596), q(9596).
-p(9598) :- p(9597), q(9598).
-q(9598) :- p(9597), q(9597).
-p(9599) :- p(9598), q(9599).
-q(9599) :- p(9598), q(9598).
-p(9600) :- p(9599), q(9600).
-q(9600) :- p(9599), q(9599).
-p(9601) :- p(9600), q(9601).
-q(9601) :- p(9600), q(9600).
-p(9602) :- p(9601), q(9602).
-q(9602) :- p(9601), q(9601).
-p(9603) :- p(9602), q(9603).
-q(9603) :- p(9602), q(9602).
-p(9604) :- p(9603), q(9604).
-q(9604) :- p(9603), q(9603).
-p(9605) :- p(9604), q(9605).
-q(9605) :- p(9604), q(9604).
-p(9606) :- p(9605), q(9606).
-q(9606) :- p(9605), q(9605).
-p(9607) :- p(9606), q(9607).
-q(9607) :- p(9606), q(9606).
-p(9608) :- p(9607), q(9608).
-q(9608) :- p(9607), q(9607).
-p(9609) :- p(9608), q(9609).
-q(9609) :- p(9608), q(9608).
-p(9610) :- p(9609), q(9610).
-q(9610) :- p(9609), q(9609).
-p(9611) :- p(9610), q(9611).
-q(9611) :- p(9610), q(9610).
-p(9612) :- p(9611), q(9612).
-q(9612) :- p(9611), q(9611).
-p(9613) :- p(9612), q(9613).
-q(9613) :- p(9612), q(9612).
-p(9614) :- p(9613), q(9614).
-q(9614) :- p(9613), q(9613).
-p(9615) :- p(9614), q(9615).
-q(9615) :- p(9614), q(9614).
-p(9616) :- p(9615), q(9616).
-q(9616) :- p(9615), q(9615).
-p(9617) :- p(9616), q(9617).
-q(9617) :- p(9616), q(9616).
-p(9618) :- p(9617), q(9618).
-q(9618) :- p(9617), q(9617).
-p(9619) :- p(9618), q(9619).
-q(9619) :- p(9618), q(9618).
-p(9620) :- p(9619), q(9620).
-q(9620) :- p(9619), q(9619).
-p(9621) :- p(9620), q(9621).
-q(9621) :- p(9620), q(9620).
-p(9622) :- p(9621), q(9622).
-q(9622) :- p(9621), q(9621).
-p(9623) :- p(9622), q(9623).
-q(9623) :- p(9622), q(9622).
-p(9624) :- p(9623), q(9624).
-q(9624) :- p(9623), q(9623).
-p(9625) :- p(9624), q(9625).
-q(9625) :- p(9624), q(9624).
-p(9626) :- p(9625), q(9626).
-q(9626) :- p(9625), q(9625).
-p(9627) :- p(9626), q(9627).
-q(9627) :- p(9626), q(9626).
-p(9628) :- p(9627), q(9628).
-q(9628) :- p(9627), q(9627).
-p(9629) :- p(9628), q(9629).
-q(9629) :- p(9628), q(9628).
-p(9630) :- p(9629), q(9630).
-q(9630) :- p(9629), q(9629).
-p(9631) :- p(9630), q(9631).
-q(9631) :- p(9630), q(9630).
-p(9632) :- p(9631), q(9632).
-q(9632) :- p(9631), q(9631).
-p(9633) :- p(9632), q(9633).
-q(9633) :- p(9632), q(9632).
-p(9634) :- p(9633), q(9634).
-q(9634) :- p(9633), q(9633).
-p(9635) :- p(9634), q(9635).
-q(9635) :- p(9634), q(9634).
-p(9636) :- p(9635), q(9636).
-q(9636) :- p(9635), q(9635).
-p(9637) :- p(9636), q(9637).
-q(9637) :- p(9636), q(9636).
-p(9638) :- p(9637), q(9638).
-q(9638) :- p(9637), q(9637).
-p(9639) :- p(9638), q(9639).
-q(9639) :- p(9638), q(9638).
-p(9640) :- p(9639), q(9640).
-q(9640) :- p(9639), q(9639).
-p(9641) :- p(9640), q(9641).
-q(9641) :- p(9640), q(9640).
-p(9642) :- p(9641), q(9642).
-q(9642) :- p(9641), q(9641).
-p(9643) :- p(9642), q(9643).
-q(9643) :- p(9642), q(9642).
-p(9644) :- p(9643), q(9644).
-q(9644) :- p(9643), q(9643).
-p(9645) :- p(9644), q(9645).
-q(9645) :- p(9644), q(9644).
-p(9646) :- p(9645), q(9646).
-q(9646) :- p(9645), q(9645).
-p(9647) :- p(9646), q(9647).
-q(9647) :- p(9646), q(9646).
-p(9648) :- p(9647), q(9648).
-q(9648) :- p(9647), q(9647).
-p(9649) :- p(9648), q(9649).
-q(9649) :- p(9648), q(9648).
-p(9650) :- p(9649), q(9650).
-q(9650) :- p(9649), q(9649).
-p(9651) :- p(9650), q(9651).
-q(9651) :- p(9650), q(9650).
-p(9652) :- p(9651), q(9652).
-q(9652) :- p(9651), q(9651).
-p(9653) :- p(9652), q(9653).
-q(9653) :- p(9652), q(9652).
-p(9654) :- p(9653), q(9654).
-q(9654) :- p(9653), q(9653).
-p(9655) :- p(9654), q(9655).
-q(9655) :- p(9654), q(9654).
-p(9656) :- p(9655), q(9656).
-q(9656) :- p(9655), q(9655).
-p(9657) :- p(9656), q(9657).
-q(9657) :- p(9656), q(9656).
-p(9658) :- p(9657), q(9658).
-q(9658) :- p(9657), q(9657).
-p(9659) :- p(9658), q(9659).
-q(9659) :- p(9658), q(9658).
-p(9660) :- p(9659), q(9660).
-q(9660) :- p(9659), q(9659).
-p(9661) :- p(9660), q(9661).
-q(9661) :- p(9660), q(9660).
-p(9662) :- p(9661), q(9662).
-q(9662) :- p(9661), q(9661).
-p(9663) :- p(9662), q(9663).
-q(9663) :- p(9662), q(9662).
-p(9664) :- p(9663), q(9664).
-q(9664) :- p(9663), q(9663).
-p(9665) :- p(9664), q(9665).
-q(9665) :- p(9664), q(9664).
-p(9666) :- p(9665), q(9666).
-q(9666) :- p(9665), q(9665).
-p(9667) :- p(9666), q(9667).
-q(9667) :- p(9666), q(9666).
-p(9668) :- p(9667), q(9668).
-q(9668) :- p(9667), q(9667).
-p(9669) :- p(9668), q(9669).
-q(9669) :- p(9668), q(9668).
-p(9670) :- p(9669), q(9670).
-q(9670) :- p(9669), q(9669).
-p(9671) :- p(9670), q(9671).
-q(9671) :- p(9670), q(9670).
-p(9672) :- p(9671), q(9672).
-q(9672) :- p(9671), q(9671).
-p(9673) :- p(9672), q(9673).
-q(9673) :- p(9672), q(9672).
-p(9674) :- p(9673), q(9674).
-q(9674) :- p(9673), q(9673).
-p(9675) :- p(9674), q(9675).
-q(9675) :- p(9674), q(9674).
-p(9676) :- p(9675), q(9676).
-q(9676) :- p(9675), q(9675).
-p(9677) :- p(9676), q(9677).
-q(9677) :- p(9676), q(9676).
-p(9678) :- p(9677), q(9678).
-q(9678) :- p(9677), q(9677).
-p(9679) :- p(9678), q(9679).
-q(9679) :- p(9678), q(9678).
-p(9680) :- p(9679), q(9680).
-q(9680) :- p(9679), q(9679).
-p(9681) :- p(9680), q(9681).
-q(9681) :- p(9680), q(9680).
-p(9682) :- p(9681), q(9682).
-q(9682) :- p(9681), q(9681).
-p(9683) :- p(9682), q(9683).
-q(9683) :- p(9682), q(9682).
-p(9684) :- p(9683), q(9684).
-q(9684) :- p(9683), q(9683).
-p(9685) :- p(9684), q(9685).
-q(9685) :- p(9684), q(9684).
-p(9686) :- p(9685), q(9686).
-q(9686) :- p(9685), q(9685).
-p(9687) :- p(9686), q(9687).
-q(9687) :- p(9686), q(9686).
-p(9688) :- p(9687), q(9688).
-q(9688) :- p(9687), q(9687).
-p(9689) :- p(9688), q(9689).
-q(9689) :- p(9688), q(9688).
-p(9690) :- p(9689), q(9690).
-q(9690) :- p(9689), q(9689).
-p(9691) :- p(9690), q(9691).
-q(9691) :- p(9690), q(9690).
-p(9692) :- p(9691), q(9692).
-q(9692) :- p(9691), q(9691).
-p(9693) :- p(9692), q(9693).
-q(9693) :- p(9692), q(9692).
-p(9694) :- p(9693), q(9694).
-q(9694) :- p(9693), q(9693).
-p(9695) :- p(9694), q(9695).
-q(9695) :- p(9694), q(9694).
-p(9696) :- p(9695), q(9696).
-q(9696) :- p(9695), q(9695).
-p(9697) :- p(9696), q(9697).
-q(9697) :- p(9696), q(9696).
-p(9698) :- p(9697), q(9698).
-q(9698) :- p(9697), q(9697).
-p(9699) :- p(9698), q(9699).
-q(9699) :- p(9698), q(9698).
-p(9700) :- p(9699), q(9700).
-q(9700) :- p(9699), q(9699).
-p(9701) :- p(9700), q(9701).
-q(9701) :- p(9700), q(9700).
-p(9702) :- p(9701), q(9702).
-q(9702) :- p(9701), q(9701).
-p(9703) :- p(9702), q(9703).
-q(9703) :- p(9702), q(9702).
-p(9704) :- p(9703), q(9704).
-q(9704) :- p(9703), q(9703).
-p(9705) :- p(9704), q(9705).
-q(9705) :- p(9704), q(9704).
-p(9706) :- p(9705), q(9706).
-q(9706) :- p(9705), q(9705).
-p(9707) :- p(9706), q(9707).
-q(9707) :- p(9706), q(9706).
-p(9708) :- p(9707), q(9708).
-q(9708) :- p(9707), q(9707).
-p(9709) :- p(9708), q(9709).
-q(9709) :- p(9708), q(9708).
-p(9710) :- p(9709), q(9710).
-q(9710) :- p(9709), q(9709).
-p(9711) :- p(9710), q(9711).
-q(9711) :- p(9710), q(9710).
-p(9712) :- p(9711), q(9712).
-q(9712) :- p(9711), q(9711).
-p(9713) :- p(9712), q(9713).
-q(9713) :- p(9712), q(9712).
-p(9714) :- p(9713), q(9714).
-q(9714) :- p(9713), q(9713).
-p(9715) :- p(9714), q(9715).
-q(9715) :- p(9714), q(9714).
-p(9716) :- p(9715), q(9716).
-q(9716) :- p(9715), q(9715).
-p(9717) :- p(9716), q(9717).
-q(9717) :- p(9716), q(9716).
-p(9718) :- p(9717), q(9718).
-q(9718) :- p(9717), q(9717).
-p(9719) :- p(9718), q(9719).
-q(9719) :- p(9718), q(9718).
-p(9720) :- p(9719), q(9720).
-q(9720) :- p(9719), q(9719).
-p(9721) :- p(9720), q(9721).
-q(9721) :- p(9720), q(9720).
-p(9722) :- p(9721), q(9722).
-q(9722) :- p(9721), q(9721).
-p(9723) :- p(9722), q(9723).
-q(9723) :- p(9722), q(9722).
-p(9724) :- p(9723), q(9724).
-q(9724) :- p(9723), q(9723).
-p(9725) :- p(9724), q(9725).
-q(9725) :- p(9724), q(9724).
-p(9726) :- p(9725), q(9726).
-q(9726) :- p(9725), q(9725).
-p(9727) :- p(9726), q(9727).
-q(9727) :- p(9726), q(9726).
-p(9728) :- p(9727), q(9728).
-q(9728) :- p(9727), q(9727).
-p(9729) :- p(9728), q(9729).
-q(9729) :- p(9728), q(9728).
-p(9730) :- p(9729), q(9730).
-q(9730) :- p(9729), q(9729).
-p(9731) :- p(9730), q(9731).
-q(9731) :- p(9730), q(9730).
-p(9732) :- p(9731), q(9732).
-q(9732) :- p(9731), q(9731).
-p(9733) :- p(9732), q(9733).
-q(9733) :- p(9732), q(9732).
-p(9734) :- p(9733), q(9734).
-q(9734) :- p(9733), q(9733).
-p(9735) :- p(9734), q(9735).
-q(9735) :- p(9734), q(9734).
-p(9736) :- p(9735), q(9736).
-q(9736) :- p(9735), q(9735).
-p(9737) :- p(9736), q(9737).
-q(9737) :- p(9736), q(9736).
-p(9738) :- p(9737), q(9738).
-q(9738) :- p(9737), q(9737).
-p(9739) :- p(9738), q(9739).
-q(9739) :- p(9738), q(9738).
-p(9740) :- p(9739), q(9740).
-q(9740) :- p(9739), q(9739).
-p(9741) :- p(9740), q(9741).
-q(9741) :- p(9740), q(9740).
-p(9742) :- p(9741), q(9742).
-q(9742) :- p(9741), q(9741).
-p(9743) :- p(9742), q(9743).
-q(9743) :- p(9742), q(9742).
-p(9744) :- p(9743), q(9744).
-q(9744) :- p(9743), q(9743).
-p(9745) :- p(9744), q(9745).
-q(9745) :- p(9744), q(9744).
-p(9746) :- p(9745), q(9746).
-q(9746) :- p(9745), q(9745).
-p(9747) :- p(9746), q(9747).
-q(9747) :- p(9746), q(9746).
-p(9748) :- p(9747), q(9748).
-q(9748) :- p(9747), q(9747).
-p(9749) :- p(9748), q(9749).
-q(9749) :- p(9748), q(9748).
-p(9750) :- p(9749), q(9750).
-q(9750) :- p(9749), q(9749).
-p(9751) :- p(9750), q(9751).
-q(9751) :- p(9750), q(9750).
-p(9752) :- p(9751), q(9752).
-q(9752) :- p(9751), q(9751).
-p(9753) :- p(9752), q(9753).
-q(9753) :- p(9752), q(9752).
-p(9754) :- p(9753), q(9754).
-q(9754) :- p(9753), q(9753).
-p(9755) :- p(9754), q(9755).
-q(9755) :- p(9754), q(9754).
-p(9756) :- p(9755), q(9756).
-q(9756) :- p(9755), q(9755).
-p(9757) :- p(9756), q(9757).
-q(9757) :- p(9756), q(9756).
-p(9758) :- p(9757), q(9758).
-q(9758) :- p(9757), q(9757).
-p(9759) :- p(9758), q(9759).
-q(9759) :- p(9758), q(9758).
-p(9760) :- p(9759), q(9760).
-q(9760) :- p(9759), q(9759).
-p(9761) :- p(9760), q(9761).
-q(9761) :- p(9760), q(9760).
-p(9762) :- p(9761), q(9762).
-q(9762) :- p(9761), q(9761).
-p(9763) :- p(9762), q(9763).
-q(9763) :- p(9762), q(9762).
-p(9764) :- p(9763), q(9764).
-q(9764) :- p(9763), q(9763).
-p(9765) :- p(9764), q(9765).
-q(9765) :- p(9764), q(9764).
-p(9766) :- p(9765), q(9766).
-q(9766) :- p(9765), q(9765).
-p(9767) :- p(9766), q(9767).
-q(9767) :- p(9766), q(9766).
-p(9768) :- p(9767), q(9768).
-q(9768) :- p(9767), q(9767).
-p(9769) :- p(9768), q(9769).
-q(9769) :- p(9768), q(9768).
-p(9770) :- p(9769), q(9770).
-q(9770) :- p(9769), q(9769).
-p(9771) :- p(9770), q(9771).
-q(9771) :- p(9770), q(9770).
-p(9772) :- p(9771), q(9772).
-q(9772) :- p(9771), q(9771).
-p(9773) :- p(9772), q(9773).
-q(9773) :- p(9772), q(9772).
-p(9774) :- p(9773), q(9774).
-q(9774) :- p(9773), q(9773).
-p(9775) :- p(9774), q(9775).
-q(9775) :- p(9774), q(9774).
-p(9776) :- p(9775), q(9776).
-q(9776) :- p(9775), q(9775).
-p(9777) :- p(9776), q(9777).
-q(9777) :- p(9776), q(9776).
-p(9778) :- p(9777), q(9778).
-q(9778) :- p(9777), q(9777).
-p(9779) :- p(9778), q(9779).
-q(9779) :- p(9778), q(9778).
-p(9780) :- p(9779), q(9780).
-q(9780) :- p(9779), q(9779).
-p(9781) :- p(9780), q(9781).
-q(9781) :- p(9780), q(9780).
-p(9782) :- p(9781), q(9782).
-q(9782) :- p(9781), q(9781).
-p(9783) :- p(9782), q(9783).
-q(9783) :- p(9782), q(9782).
-p(9784) :- p(9783), q(9784).
-q(9784) :- p(9783), q(9783).
-p(9785) :- p(9784), q(9785).
-q(9785) :- p(9784), q(9784).
-p(9786) :- p(9785), q(9786).
-q(9786) :- p(9785), q(9785).
-p(9787) :- p(9786), q(9787).
-q(9787) :- p(9786), q(9786).
-p(9788) :- p(9787), q(9788).
-q(9788) :- p(9787), q(9787).
-p(9789) :- p(9788), q(9789).
-q(9789) :- p(9788), q(9788).
-p(9790) :- p(9789), q(9790).
-q(9790) :- p(9789), q(9789).
-p(9791) :- p(9790), q(9791).
-q(9791) :- p(9790), q(9790).
-p(9792) :- p(9791), q(9792).
-q(9792) :- p(9791), q(9791).
-p(9793) :- p(9792), q(9793).
-q(9793) :- p(9792), q(9792).
-p(9794) :- p(9793), q(9794).
-q(9794) :- p(9793), q(9793).
-p(9795) :- p(9794), q(9795).
-q(9795) :- p(9794), q(9794).
-p(9796) :- p(9795), q(9796).
-q(9796) :- p(9795), q(9795).
-p(9797) :- p(9796), q(9797).
-q(9797) :- p(9796), q(9796).
-p(9798) :- p(9797), q(9798).
-q(9798) :- p(9797), q(9797).
-p(9799) :- p(9798), q(9799).
-q(9799) :- p(9798), q(9798).
-p(9800) :- p(9799), q(9800).
-q(9800) :- p(9799), q(9799).
-p(9801) :- p(9800), q(9801).
-q(9801) :- p(9800), q(9800).
-p(9802) :- p(9801), q(9802).
-q(9802) :- p(9801), q(9801).
-p(9803) :- p(9802), q(9803).
-q(9803) :- p(9802), q(9802).
-p(9804) :- p(9803), q(9804).
-q(9804) :- p(9803), q(9803).
-p(9805) :- p(9804), q(9805).
-q(9805) :- p(9804), q(9804).
-p(9806) :- p(9805), q(9806).
-q(9806) :- p(9805), q(9805).
-p(9807) :- p(9806), q(9807).
-q(9807) :- p(9806), q(9806).
-p(9808) :- p(9807), q(9808).
-q(9808) :- p(9807), q(9807).
-p(9809) :- p(9808), q(9809).
-q(9809) :- p(9808), q(9808).
-p(9810) :- p(9809), q(9810).
-q(9810) :- p(9809), q(9809).
-p(9811) :- p(9810), q(9811).
-q(9811) :- p(9810), q(9810).
-p(9812) :- p(9811), q(9812).
-q(9812) :- p(9811), q(9811).
-p(9813) :- p(9812), q(9813).
-q(9813) :- p(9812), q(9812).
-p(9814) :- p(9813), q(9814).
-q(9814) :- p(9813), q(9813).
-p(9815) :- p(9814), q(9815).
-q(9815) :- p(9814), q(9814).
-p(9816) :- p(9815), q(9816).
-q(9816) :- p(9815), q(9815).
-p(9817) :- p(9816), q(9817).
-q(9817) :- p(9816), q(9816).
-p(9818) :- p(9817), q(9818).
-q(9818) :- p(9817), q(9817).
-p(9819) :- p(9818), q(9819).
-q(9819) :- p(9818), q(9818).
-p(9820) :- p(9819), q(9820).
-q(9820) :- p(9819), q(9819).
-p(9821) :- p(9820), q(9821).
-q(9821) :- p(9820), q(9820).
-p(9822) :- p(9821), q(9822).
-q(9822) :- p(9821), q(9821).
-p(9823) :- p(9822), q(9823).
-q(9823) :- p(9822), q(9822).
-p(9824) :- p(9823), q(9824).
-q(9824) :- p(9823), q(9823).
-p(9825) :- p(9824), q(9825).
-q(9825) :- p(9824), q(9824).
-p(9826) :- p(9825), q(9826).
-q(9826) :- p(9825), q(9825).
-p(9827) :- p(9826), q(9827).
-q(9827) :- p(9826), q(9826).
-p(9828) :- p(9827), q(9828).
-q(9828) :- p(9827), q(9827).
-p(9829) :- p(9828), q(9829).
-q(9829) :- p(9828), q(9828).
-p(9830) :- p(9829), q(9830).
-q(9830) :- p(9829), q(9829).
-p(9831) :- p(9830), q(9831).
-q(9831) :- p(9830), q(9830).
-p(9832) :- p(9831), q(9832).
-q(9832) :- p(9831), q(9831).
-p(9833) :- p(9832), q(9833).
-q(9833) :- p(9832), q(9832).
-p(9834) :- p(9833), q(9834).
-q(9834) :- p(9833), q(9833).
-p(9835) :- p(9834), q(9835).
-q(9835) :- p(9834), q(9834).
-p(9836) :- p(9835), q(9836).
-q(9836) :- p(9835), q(9835).
-p(9837) :- p(9836), q(9837).
-q(9837) :- p(9836), q(9836).
-p(9838) :- p(9837), q(9838).
-q(9838) :- p(9837), q(9837).
-p(9839) :- p(9838), q(9839).
-q(9839) :- p(9838), q(9838).
-p(9840) :- p(9839), q(9840).
-q(9840) :- p(9839), q(9839).
-p(9841) :- p(9840), q(9841).
-q(9841) :- p(9840), q(9840).
-p(9842) :- p(9841), q(9842).
-q(9842) :- p(9841), q(9841).
-p(9843) :- p(9842), q(9843).
-q(9843) :- p(9842), q(9842).
-p(9844) :- p(9843), q(9844).
-q(9844) :- p(9843), q(9843).
-p(9845) :- p(9844), q(9845).
-q(9845) :- p(9844), q(9844).
-p(9846) :- p(9845), q(9846).
-q(9846) :- p(9845), q(9845).
-p(9847) :- p(9846), q(9847).
-q(9847) :- p(9846), q(9846).
-p(9848) :- p(9847), q(9848).
-q(9848) :- p(9847), q(9847).
-p(9849) :- p(9848), q(9849).
-q(9849) :- p(9848), q(9848).
-p(9850) :- p(9849), q(9850).
-q(9850) :- p(9849), q(9849).
-p(9851) :- p(9850), q(9851).
-q(9851) :- p(9850), q(9850).
-p(9852) :- p(9851), q(9852).
-q(9852) :- p(9851), q(9851).
-p(9853) :- p(9852), q(9853).
-q(9853) :- p(9852), q(9852).
-p(9854) :- p(9853), q(9854).
-q(9854) :- p(9853), q(9853).
-p(9855) :- p(9854), q(9855).
-q(9855) :- p(9854), q(9854).
-p(9856) :- p(9855), q(9856).
-q(9856) :- p(9855), q(9855).
-p(9857) :- p(9856), q(9857).
-q(9857) :- p(9856), q(9856).
-p(9858) :- p(9857), q(9858).
-q(9858) :- p(9857), q(9857).
-p(9859) :- p(9858), q(9859).
-q(9859) :- p(9858), q(9858).
-p(9860) :- p(9859), q(9860).
-q(9860) :- p(9859), q(9859).
-p(9861) :- p(9860), q(9861).
-q(9861) :- p(9860), q(9860).
-p(9862) :- p(9861), q(9862).
-q(9862) :- p(9861), q(9861).
-p(9863) :- p(9862), q(9863).
-q(9863) :- p(9862), q(9862).
-p(9864) :- p(9863), q(9864).
-q(9864) :- p(9863), q(9863).
-p(9865) :- p(9864), q(9865).
-q(9865) :- p(9864), q(9864).
-p(9866) :- p(9865), q(9866).
-q(9866) :- p(9865), q(9865).
-p(9867) :- p(9866), q(9867).
-q(9867) :- p(9866), q(9866).
-p(9868) :- p(9867), q(9868).
-q(9868) :- p(9867), q(9867).
-p(9869) :- p(9868), q(9869).
-q(9869) :- p(9868), q(9868).
-p(9870) :- p(9869), q(9870).
-q(9870) :- p(9869), q(9869).
-p(9871) :- p(9870), q(9871).
-q(9871) :- p(9870), q(9870).
-p(9872) :- p(9871), q(9872).
-q(9872) :- p(9871), q(9871).
-p(9873) :- p(9872), q(9873).
-q(9873) :- p(9872), q(9872).
-p(9874) :- p(9873), q(9874).
-q(9874) :- p(9873), q(9873).
-p(9875) :- p(9874), q(9875).
-q(9875) :- p(9874), q(9874).
-p(9876) :- p(9875), q(9876).
-q(9876) :- p(9875), q(9875).
-p(9877) :- p(9876), q(9877).
-q(9877) :- p(9876), q(9876).
-p(9878) :- p(9877), q(9878).
-q(9878) :- p(9877), q(9877).
-p(9879) :- p(9878), q(9879).
-q(9879) :- p(9878), q(9878).
-p(9880) :- p(9879), q(9880).
-q(9880) :- p(9879), q(9879).
-p(9881) :- p(9880), q(9881).
-q(9881) :- p(9880), q(9880).
-p(9882) :- p(9881), q(9882).
-q(9882) :- p(9881), q(9881).
-p(9883) :- p(9882), q(9883).
-q(9883) :- p(9882), q(9882).
-p(9884) :- p(9883), q(9884).
-q(9884) :- p(9883), q(9883).
-p(9885) :- p(9884), q(9885).
-q(9885) :- p(9884), q(9884).
-p(9886) :- p(9885), q(9886).
-q(9886) :- p(9885), q(9885).
-p(9887) :- p(9886), q(9887).
-q(9887) :- p(9886), q(9886).
-p(9888) :- p(9887), q(9888).
-q(9888) :- p(9887), q(9887).
-p(9889) :- p(9888), q(9889).
-q(9889) :- p(9888), q(9888).
-p(9890) :- p(9889), q(9890).
-q(9890) :- p(9889), q(9889).
-p(9891) :- p(9890), q(9891).
-q(9891) :- p(9890), q(9890).
-p(9892) :- p(9891), q(9892).
-q(9892) :- p(9891), q(9891).
-p(9893) :- p(9892), q(9893).
-q(9893) :- p(9892), q(9892).
-p(9894) :- p(9893), q(9894).
-q(9894) :- p(9893), q(9893).
-p(9895) :- p(9894), q(9895).
-q(9895) :- p(9894), q(9894).
-p(9896) :- p(9895), q(9896).
-q(9896) :- p(9895), q(9895).
-p(9897) :- p(9896), q(9897).
-q(9897) :- p(9896), q(9896).
-p(9898) :- p(9897), q(9898).
-q(9898) :- p(9897), q(9897).
-p(9899) :- p(9898), q(9899).
-q(9899) :- p(9898), q(9898).
-p(9900) :- p(9899), q(9900).
-q(9900) :- p(9899), q(9899).
-p(9901) :- p(9900), q(9901).
-q(9901) :- p(9900), q(9900).
-p(9902) :- p(9901), q(9902).
-q(9902) :- p(9901), q(9901).
-p(9903) :- p(9902), q(9903).
-q(9903) :- p(9902), q(9902).
-p(9904) :- p(9903), q(9904).
-q(9904) :- p(9903), q(9903).
-p(9905) :- p(9904), q(9905).
-q(9905) :- p(9904), q(9904).
-p(9906) :- p(9905), q(9906).
-q(9906) :- p(9905), q(9905).
-p(9907) :- p(9906), q(9907).
-q(9907) :- p(9906), q(9906).
-p(9908) :- p(9907), q(9908).
-q(9908) :- p(9907), q(9907).
-p(9909) :- p(9908), q(9909).
-q(9909) :- p(9908), q(9908).
-p(9910) :- p(9909), q(9910).
-q(9910) :- p(9909), q(9909).
-p(9911) :- p(9910), q(9911).
-q(9911) :- p(9910), q(9910).
-p(9912) :- p(9911), q(9912).
-q(9912) :- p(9911), q(9911).
-p(9913) :- p(9912), q(9913).
-q(9913) :- p(9912), q(9912).
-p(9914) :- p(9913), q(9914).
-q(9914) :- p(9913), q(9913).
-p(9915) :- p(9914), q(9915).
-q(9915) :- p(9914), q(9914).
-p(9916) :- p(9915), q(9916).
-q(9916) :- p(9915), q(9915).
-p(9917) :- p(9916), q(9917).
-q(9917) :- p(9916), q(9916).
-p(9918) :- p(9917), q(9918).
-q(9918) :- p(9917), q(9917).
-p(9919) :- p(9918), q(9919).
-q(9919) :- p(9918), q(9918).
-p(9920) :- p(9919), q(9920).
-q(9920) :- p(9919), q(9919).
-p(9921) :- p(9920), q(9921).
-q(9921) :- p(9920), q(9920).
-p(9922) :- p(9921), q(9922).
-q(9922) :- p(9921), q(9921).
-p(9923) :- p(9922), q(9923).
-q(9923) :- p(9922), q(9922).
-p(9924) :- p(9923), q(9924).
-q(9924) :- p(9923), q(9923).
-p(9925) :- p(9924), q(9925).
-q(9925) :- p(9924), q(9924).
-p(9926) :- p(9925), q(9926).
-q(9926) :- p(9925), q(9925).
-p(9927) :- p(9926), q(9927).
-q(9927) :- p(9926), q(9926).
-p(9928) :- p(9927), q(9928).
-q(9928) :- p(9927), q(9927).
-p(9929) :- p(9928), q(9929).
-q(9929) :- p(9928), q(9928).
-p(9930) :- p(9929), q(9930).
-q(9930) :- p(9929), q(9929).
-p(9931) :- p(9930), q(9931).
-q(9931) :- p(9930), q(9930).
-p(9932) :- p(9931), q(9932).
-q(9932) :- p(9931), q(9931).
-p(9933) :- p(9932), q(9933).
-q(9933) :- p(9932), q(9932).
-p(9934) :- p(9933), q(9934).
-q(9934) :- p(9933), q(9933).
-p(9935) :- p(9934), q(9935).
-q(9935) :- p(9934), q(9934).
-p(9936) :- p(9935), q(9936).
-q(9936) :- p(9935), q(9935).
-p(9937) :- p(9936), q(9937).
-q(9937) :- p(9936), q(9936).
-p(9938) :- p(9937), q(9938).
-q(9938) :- p(9937), q(9937).
-p(9939) :- p(9938), q(9939).
-q(9939) :- p(9938), q(9938).
-p(9940) :- p(9939), q(9940).
-q(9940) :- p(9939), q(9939).
-p(9941) :- p(9940), q(9941).
-q(9941) :- p(9940), q(9940).
-p(9942) :- p(9941), q(9942).
-q(9942) :- p(9941), q(9941).
-p(9943) :- p(9942), q(9943).
-q(9943) :- p(9942), q(9942).
-p(9944) :- p(9943), q(9944).
-q(9944) :- p(9943), q(9943).
-p(9945) :- p(9944), q(9945).
-q(9945) :- p(9944), q(9944).
-p(9946) :- p(9945), q(9946).
-q(9946) :- p(9945), q(9945).
-p(9947) :- p(9946), q(9947).
-q(9947) :- p(9946), q(9946).
-p(9948) :- p(9947), q(9948).
-q(9948) :- p(9947), q(9947).
-p(9949) :- p(9948), q(9949).
-q(9949) :- p(9948), q(9948).
-p(9950) :- p(9949), q(9950).
-q(9950) :- p(9949), q(9949).
-p(9951) :- p(9950), q(9951).
-q(9951) :- p(9950), q(9950).
-p(9952) :- p(9951), q(9952).
-q(9952) :- p(9951), q(9951).
-p(9953) :- p(9952), q(9953).
-q(9953) :- p(9952), q(9952).
-p(9954) :- p(9953), q(9954).
-q(9954) :- p(9953), q(9953).
-p(9955) :- p(9954), q(9955).
-q(9955) :- p(9954), q(9954).
-p(9956) :- p(9955), q(9956).
-q(9956) :- p(9955), q(9955).
-p(9957) :- p(9956), q(9957).
-q(9957) :- p(9956), q(9956).
-p(9958) :- p(9957), q(9958).
-q(9958) :- p(9957), q(9957).
-p(9959) :- p(9958), q(9959).
-q(9959) :- p(9958), q(9958).
-p(9960) :- p(9959), q(9960).
-q(9960) :- p(9959), q(9959).
-p(9961) :- p(9960), q(9961).
-q(9961) :- p(9960), q(9960).
-p(9962) :- p(9961), q(9962).
-q(9962) :- p(9961), q(9961).
-p(9963) :- p(9962), q(9963).
-q(9963) :- p(9962), q(9962).
-p(9964) :- p(9963), q(9964).
-q(9964) :- p(9963), q(9963).
-p(9965) :- p(9964), q(9965).
-q(9965) :- p(9964), q(9964).
-p(9966) :- p(9965), q(9966).
-q(9966) :- p(9965), q(9965).
-p(9967) :- p(9966), q(9967).
-q(9967) :- p(9966), q(9966).
-p(9968) :- p(9967), q(9968).
-q(9968) :- p(9967), q(9967).
-p(9969) :- p(9968), q(9969).
-q(9969) :- p(9968), q(9968).
-p(9970) :- p(9969), q(9970).
-q(9970) :- p(9969), q(9969).
-p(9971) :- p(9970), q(9971).
-q(9971) :- p(9970), q(9970).
-p(9972) :- p(9971), q(9972).
-q(9972) :- p(9971), q(9971).
-p(9973) :- p(9972), q(9973).
-q(9973) :- p(9972), q(9972).
-p(9974) :- p(9973), q(9974).
-q(9974) :- p(9973), q(9973).
-p(9975) :- p(9974), q(9975).
-q(9975) :- p(9974), q(9974).
-p(9976) :- p(9975), q(9976).
-q(9976) :- p(9975), q(9975).
-p(9977) :- p(9976), q(9977).
-q(9977) :- p(9976), q(9976).
-p(9978) :- p(9977), q(9978).
-q(9978) :- p(9977), q(9977).
-p(9979) :- p(9978), q(9979).
-q(9979) :- p(9978), q(9978).
-p(9980) :- p(9979), q(9980).
-q(9980) :- p(9979), q(9979).
-p(9981) :- p(9980), q(9981).
-q(9981) :- p(9980), q(9980).
-p(9982) :- p(9981), q(9982).
-q(9982) :- p(9981), q(9981).
-p(9983) :- p(9982), q(9983).
-q(9983) :- p(9982), q(9982).
-p(9984) :- p(9983), q(9984).
-q(9984) :- p(9983), q(9983).
-p(9985) :- p(9984), q(9985).
-q(9985) :- p(9984), q(9984).
-p(9986) :- p(9985), q(9986).
-q(9986) :- p(9985), q(9985).
-p(9987) :- p(9986), q(9987).
-q(9987) :- p(9986), q(9986).
-p(9988) :- p(9987), q(9988).
-q(9988) :- p(9987), q(9987).
-p(9989) :- p(9988), q(9989).
-q(9989) :- p(9988), q(9988).
-p(9990) :- p(9989), q(9990).
-q(9990) :- p(9989), q(9989).
-p(9991) :- p(9990), q(9991).
-q(9991) :- p(9990), q(9990).
-p(9992) :- p(9991), q(9992).
-q(9992) :- p(9991), q(9991).
-p(9993) :- p(9992), q(9993).
-q(9993) :- p(9992), q(9992).
-p(9994) :- p(9993), q(9994).
-q(9994) :- p(9993), q(9993).
-p(9995) :- p(9994), q(9995).
-q(9995) :- p(9994), q(9994).
-p(9996) :- p(9995), q(9996).
-q(9996) :- p(9995), q(9995).
-p(9997) :- p(9996), q(9997).
-q(9997) :- p(9996), q(9996).
-p(9998) :- p(9997), q(9998).
-q(9998) :- p(9997), q(9997).
-p(9999) :- p(9998), q(9999).
-q(9999) :- p(9998), q(9998).
-p(10000) :- p(9999), q(10000).
-q(10000) :- p(9999), q(9999).
-p(0).
-q(0).
-q(10000)?
\ No newline at end of file
@@ -1,10003 +0,0 @@
-% generate problem of size 5000
-p(1) :- p(0), q(1).
-q(1) :- p(0), q(0).
-p(2) :- p(1), q(2).
-q(2) :- p(1), q(1).
-p(3) :- p(2), q(3).
-q(3) :- p(2), q(2).
-p(4) :- p(3), q(4).
-q(4) :- p(3), q(3).
-p(5) :- p(4), q(5).
-q(5) :- p(4), q(4).
-p(6) :- p(5), q(6).
-q(6) :- p(5), q(5).
-p(7) :- p(6), q(7).
-q(7) :- p(6), q(6).
-p(8) :- p(7), q(8).
-q(8) :- p(7), q(7).
-p(9) :- p(8), q(9).
-q(9) :- p(8), q(8).
-p(10) :- p(9), q(10).
-q(10) :- p(9), q(9).
-p(11) :- p(10), q(11).
-q(11) :- p(10), q(10).
-p(12) :- p(11), q(12).
-q(12) :- p(11), q(11).
-p(13) :- p(12), q(13).
-q(13) :- p(12), q(12).
-p(14) :- p(13), q(14).
-q(14) :- p(13), q(13).
-p(15) :- p(14), q(15).
-q(15) :- p(14), q(14).
-p(16) :- p(15), q(16).
-q(16) :- p(15), q(15).
-p(17) :- p(16), q(17).
-q(17) :- p(16), q(16).
-p(18) :- p(17), q(18).
-q(18) :- p(17), q(17).
-p(19) :- p(18), q(19).
-q(19) :- p(18), q(18).
-p(20) :- p(19), q(20).
-q(20) :- p(19), q(19).
-p(21) :- p(20), q(21).
-q(21) :- p(20), q(20).
-p(22) :- p(21), q(22).
-q(22) :- p(21), q(21).
-p(23) :- p(22), q(23).
-q(23) :- p(22), q(22).
-p(24) :- p(23), q(24).
-q(24) :- p(23), q(23).
-p(25) :- p(24), q(25).
-q(25) :- p(24), q(24).
-p(26) :- p(25), q(26).
-q(26) :- p(25), q(25).
-p(27) :- p(26), q(27).
-q(27) :- p(26), q(26).
-p(28) :- p(27), q(28).
-q(28) :- p(27), q(27).
-p(29) :- p(28), q(29).
-q(29) :- p(28), q(28).
-p(30) :- p(29), q(30).
-q(30) :- p(29), q(29).
-p(31) :- p(30), q(31).
-q(31) :- p(30), q(30).
-p(32) :- p(31), q(32).
-q(32) :- p(31), q(31).
-p(33) :- p(32), q(33).
-q(33) :- p(32), q(32).
-p(34) :- p(33), q(34).
-q(34) :- p(33), q(33).
-p(35) :- p(34), q(35).
-q(35) :- p(34), q(34).
-p(36) :- p(35), q(36).
-q(36) :- p(35), q(35).
-p(37) :- p(36), q(37).
-q(37) :- p(36), q(36).
-p(38) :- p(37), q(38).
-q(38) :- p(37), q(37).
-p(39) :- p(38), q(39).
-q(39) :- p(38), q(38).
-p(40) :- p(39), q(40).
-q(40) :- p(39), q(39).
-p(41) :- p(40), q(41).
-q(41) :- p(40), q(40).
-p(42) :- p(41), q(42).
-q(42) :- p(41), q(41).
-p(43) :- p(42), q(43).
-q(43) :- p(42), q(42).
-p(44) :- p(43), q(44).
-q(44) :- p(43), q(43).
-p(45) :- p(44), q(45).
-q(45) :- p(44), q(44).
-p(46) :- p(45), q(46).
-q(46) :- p(45), q(45).
-p(47) :- p(46), q(47).
-q(47) :- p(46), q(46).
-p(48) :- p(47), q(48).
-q(48) :- p(47), q(47).
-p(49) :- p(48), q(49).
-q(49) :- p(48), q(48).
-p(50) :- p(49), q(50).
-q(50) :- p(49), q(49).
-p(51) :- p(50), q(51).
-q(51) :- p(50), q(50).
-p(52) :- p(51), q(52).
-q(52) :- p(51), q(51).
-p(53) :- p(52), q(53).
-q(53) :- p(52), q(52).
-p(54) :- p(53), q(54).
-q(54) :- p(53), q(53).
-p(55) :- p(54), q(55).
-q(55) :- p(54), q(54).
-p(56) :- p(55), q(56).
-q(56) :- p(55), q(55).
-p(57) :- p(56), q(57).
-q(57) :- p(56), q(56).
-p(58) :- p(57), q(58).
-q(58) :- p(57), q(57).
-p(59) :- p(58), q(59).
-q(59) :- p(58), q(58).
-p(60) :- p(59), q(60).
-q(60) :- p(59), q(59).
-p(61) :- p(60), q(61).
-q(61) :- p(60), q(60).
-p(62) :- p(61), q(62).
-q(62) :- p(61), q(61).
-p(63) :- p(62), q(63).
-q(63) :- p(62), q(62).
-p(64) :- p(63), q(64).
-q(64) :- p(63), q(63).
-p(65) :- p(64), q(65).
-q(65) :- p(64), q(64).
-p(66) :- p(65), q(66).
-q(66) :- p(65), q(65).
-p(67) :- p(66), q(67).
-q(67) :- p(66), q(66).
-p(68) :- p(67), q(68).
-q(68) :- p(67), q(67).
-p(69) :- p(68), q(69).
-q(69) :- p(68), q(68).
-p(70) :- p(69), q(70).
-q(70) :- p(69), q(69).
-p(71) :- p(70), q(71).
-q(71) :- p(70), q(70).
-p(72) :- p(71), q(72).
-q(72) :- p(71), q(71).
-p(73) :- p(72), q(73).
-q(73) :- p(72), q(72).
-p(74) :- p(73), q(74).
-q(74) :- p(73), q(73).
-p(75) :- p(74), q(75).
-q(75) :- p(74), q(74).
-p(76) :- p(75), q(76).
-q(76) :- p(75), q(75).
-p(77) :- p(76), q(77).
-q(77) :- p(76), q(76).
-p(78) :- p(77), q(78).
-q(78) :- p(77), q(77).
-p(79) :- p(78), q(79).
-q(79) :- p(78), q(78).
-p(80) :- p(79), q(80).
-q(80) :- p(79), q(79).
-p(81) :- p(80), q(81).
-q(81) :- p(80), q(80).
-p(82) :- p(81), q(82).
-q(82) :- p(81), q(81).
-p(83) :- p(82), q(83).
-q(83) :- p(82), q(82).
-p(84) :- p(83), q(84).
-q(84) :- p(83), q(83).
-p(85) :- p(84), q(85).
-q(85) :- p(84), q(84).
-p(86) :- p(85), q(86).
-q(86) :- p(85), q(85).
-p(87) :- p(86), q(87).
-q(87) :- p(86), q(86).
-p(88) :- p(87), q(88).
-q(88) :- p(87), q(87).
-p(89) :- p(88), q(89).
-q(89) :- p(88), q(88).
-p(90) :- p(89), q(90).
-q(90) :- p(89), q(89).
-p(91) :- p(90), q(91).
-q(91) :- p(90), q(90).
-p(92) :- p(91), q(92).
-q(92) :- p(91), q(91).
-p(93) :- p(92), q(93).
-q(93) :- p(92), q(92).
-p(94) :- p(93), q(94).
-q(94) :- p(93), q(93).
-p(95) :- p(94), q(95).
-q(95) :- p(94), q(94).
-p(96) :- p(95), q(96).
-q(96) :- p(95), q(95).
-p(97) :- p(96), q(97).
-q(97) :- p(96), q(96).
-p(98) :- p(97), q(98).
-q(98) :- p(97), q(97).
-p(99) :- p(98), q(99).
-q(99) :- p(98), q(98).
-p(100) :- p(99), q(100).
-q(100) :- p(99), q(99).
-p(101) :- p(100), q(101).
-q(101) :- p(100), q(100).
-p(102) :- p(101), q(102).
-q(102) :- p(101), q(101).
-p(103) :- p(102), q(103).
-q(103) :- p(102), q(102).
-p(104) :- p(103), q(104).
-q(104) :- p(103), q(103).
-p(105) :- p(104), q(105).
-q(105) :- p(104), q(104).
-p(106) :- p(105), q(106).
-q(106) :- p(105), q(105).
-p(107) :- p(106), q(107).
-q(107) :- p(106), q(106).
-p(108) :- p(107), q(108).
-q(108) :- p(107), q(107).
-p(109) :- p(108), q(109).
-q(109) :- p(108), q(108).
-p(110) :- p(109), q(110).
-q(110) :- p(109), q(109).
-p(111) :- p(110), q(111).
-q(111) :- p(110), q(110).
-p(112) :- p(111), q(112).
-q(112) :- p(111), q(111).
-p(113) :- p(112), q(113).
-q(113) :- p(112), q(112).
-p(114) :- p(113), q(114).
-q(114) :- p(113), q(113).
-p(115) :- p(114), q(115).
-q(115) :- p(114), q(114).
-p(116) :- p(115), q(116).
-q(116) :- p(115), q(115).
-p(117) :- p(116), q(117).
-q(117) :- p(116), q(116).
-p(118) :- p(117), q(118).
-q(118) :- p(117), q(117).
-p(119) :- p(118), q(119).
-q(119) :- p(118), q(118).
-p(120) :- p(119), q(120).
-q(120) :- p(119), q(119).
-p(121) :- p(120), q(121).
-q(121) :- p(120), q(120).
-p(122) :- p(121), q(122).
-q(122) :- p(121), q(121).
-p(123) :- p(122), q(123).
-q(123) :- p(122), q(122).
-p(124) :- p(123), q(124).
-q(124) :- p(123), q(123).
-p(125) :- p(124), q(125).
-q(125) :- p(124), q(124).
-p(126) :- p(125), q(126).
-q(126) :- p(125), q(125).
-p(127) :- p(126), q(127).
-q(127) :- p(126), q(126).
-p(128) :- p(127), q(128).
-q(128) :- p(127), q(127).
-p(129) :- p(128), q(129).
-q(129) :- p(128), q(128).
-p(130) :- p(129), q(130).
-q(130) :- p(129), q(129).
-p(131) :- p(130), q(131).
-q(131) :- p(130), q(130).
-p(132) :- p(131), q(132).
-q(132) :- p(131), q(131).
-p(133) :- p(132), q(133).
-q(133) :- p(132), q(132).
-p(134) :- p(133), q(134).
-q(134) :- p(133), q(133).
-p(135) :- p(134), q(135).
-q(135) :- p(134), q(134).
-p(136) :- p(135), q(136).
-q(136) :- p(135), q(135).
-p(137) :- p(136), q(137).
-q(137) :- p(136), q(136).
-p(138) :- p(137), q(138).
-q(138) :- p(137), q(137).
-p(139) :- p(138), q(139).
-q(139) :- p(138), q(138).
-p(140) :- p(139), q(140).
-q(140) :- p(139), q(139).
-p(141) :- p(140), q(141).
-q(141) :- p(140), q(140).
-p(142) :- p(141), q(142).
-q(142) :- p(141), q(141).
-p(143) :- p(142), q(143).
-q(143) :- p(142), q(142).
-p(144) :- p(143), q(144).
-q(144) :- p(143), q(143).
-p(145) :- p(144), q(145).
-q(145) :- p(144), q(144).
-p(146) :- p(145), q(146).
-q(146) :- p(145), q(145).
-p(147) :- p(146), q(147).
-q(147) :- p(146), q(146).
-p(148) :- p(147), q(148).
-q(148) :- p(147), q(147).
-p(149) :- p(148), q(149).
-q(149) :- p(148), q(148).
-p(150) :- p(149), q(150).
-q(150) :- p(149), q(149).
-p(151) :- p(150), q(151).
-q(151) :- p(150), q(150).
-p(152) :- p(151), q(152).
-q(152) :- p(151), q(151).
-p(153) :- p(152), q(153).
-q(153) :- p(152), q(152).
-p(154) :- p(153), q(154).
-q(154) :- p(153), q(153).
-p(155) :- p(154), q(155).
-q(155) :- p(154), q(154).
-p(156) :- p(155), q(156).
-q(156) :- p(155), q(155).
-p(157) :- p(156), q(157).
-q(157) :- p(156), q(156).
-p(158) :- p(157), q(158).
-q(158) :- p(157), q(157).
-p(159) :- p(158), q(159).
-q(159) :- p(158), q(158).
-p(160) :- p(159), q(160).
-q(160) :- p(159), q(159).
-p(161) :- p(160), q(161).
-q(161) :- p(160), q(160).
-p(162) :- p(161), q(162).
-q(162) :- p(161), q(161).
-p(163) :- p(162), q(163).
-q(163) :- p(162), q(162).
-p(164) :- p(163), q(164).
-q(164) :- p(163), q(163).
-p(165) :- p(164), q(165).
-q(165) :- p(164), q(164).
-p(166) :- p(165), q(166).
-q(166) :- p(165), q(165).
-p(167) :- p(166), q(167).
-q(167) :- p(166), q(166).
-p(168) :- p(167), q(168).
-q(168) :- p(167), q(167).
-p(169) :- p(168), q(169).
-q(169) :- p(168), q(168).
-p(170) :- p(169), q(170).
-q(170) :- p(169), q(169).
-p(171) :- p(170), q(171).
-q(171) :- p(170), q(170).
-p(172) :- p(171), q(172).
-q(172) :- p(171), q(171).
-p(173) :- p(172), q(173).
-q(173) :- p(172), q(172).
-p(174) :- p(173), q(174).
-q(174) :- p(173), q(173).
-p(175) :- p(174), q(175).
-q(175) :- p(174), q(174).
-p(176) :- p(175), q(176).
-q(176) :- p(175), q(175).
-p(177) :- p(176), q(177).
-q(177) :- p(176), q(176).
-p(178) :- p(177), q(178).
-q(178) :- p(177), q(177).
-p(179) :- p(178), q(179).
-q(179) :- p(178), q(178).
-p(180) :- p(179), q(180).
-q(180) :- p(179), q(179).
-p(181) :- p(180), q(181).
-q(181) :- p(180), q(180).
-p(182) :- p(181), q(182).
-q(182) :- p(181), q(181).
-p(183) :- p(182), q(183).
-q(183) :- p(182), q(182).
-p(184) :- p(183), q(184).
-q(184) :- p(183), q(183).
-p(185) :- p(184), q(185).
-q(185) :- p(184), q(184).
-p(186) :- p(185), q(186).
-q(186) :- p(185), q(185).
-p(187) :- p(186), q(187).
-q(187) :- p(186), q(186).
-p(188) :- p(187), q(188).
-q(188) :- p(187), q(187).
-p(189) :- p(188), q(189).
-q(189) :- p(188), q(188).
-p(190) :- p(189), q(190).
-q(190) :- p(189), q(189).
-p(191) :- p(190), q(191).
-q(191) :- p(190), q(190).
-p(192) :- p(191), q(192).
-q(192) :- p(191), q(191).
-p(193) :- p(192), q(193).
-q(193) :- p(192), q(192).
-p(194) :- p(193), q(194).
-q(194) :- p(193), q(193).
-p(195) :- p(194), q(195).
-q(195) :- p(194), q(194).
-p(196) :- p(195), q(196).
-q(196) :- p(195), q(195).
-p(197) :- p(196), q(197).
-q(197) :- p(196), q(196).
-p(198) :- p(197), q(198).
-q(198) :- p(197), q(197).
-p(199) :- p(198), q(199).
-q(199) :- p(198), q(198).
-p(200) :- p(199), q(200).
-q(200) :- p(199), q(199).
-p(201) :- p(200), q(201).
-q(201) :- p(200), q(200).
-p(202) :- p(201), q(202).
-q(202) :- p(201), q(201).
-p(203) :- p(202), q(203).
-q(203) :- p(202), q(202).
-p(204) :- p(203), q(204).
-q(204) :- p(203), q(203).
-p(205) :- p(204), q(205).
-q(205) :- p(204), q(204).
-p(206) :- p(205), q(206).
-q(206) :- p(205), q(205).
-p(207) :- p(206), q(207).
-q(207) :- p(206), q(206).
-p(208) :- p(207), q(208).
-q(208) :- p(207), q(207).
-p(209) :- p(208), q(209).
-q(209) :- p(208), q(208).
-p(210) :- p(209), q(210).
-q(210) :- p(209), q(209).
-p(211) :- p(210), q(211).
-q(211) :- p(210), q(210).
-p(212) :- p(211), q(212).
-q(212) :- p(211), q(211).
-p(213) :- p(212), q(213).
-q(213) :- p(212), q(212).
-p(214) :- p(213), q(214).
-q(214) :- p(213), q(213).
-p(215) :- p(214), q(215).
-q(215) :- p(214), q(214).
-p(216) :- p(215), q(216).
-q(216) :- p(215), q(215).
-p(217) :- p(216), q(217).
-q(217) :- p(216), q(216).
-p(218) :- p(217), q(218).
-q(218) :- p(217), q(217).
-p(219) :- p(218), q(219).
-q(219) :- p(218), q(218).
-p(220) :- p(219), q(220).
-q(220) :- p(219), q(219).
-p(221) :- p(220), q(221).
-q(221) :- p(220), q(220).
-p(222) :- p(221), q(222).
-q(222) :- p(221), q(221).
-p(223) :- p(222), q(223).
-q(223) :- p(222), q(222).
-p(224) :- p(223), q(224).
-q(224) :- p(223), q(223).
-p(225) :- p(224), q(225).
-q(225) :- p(224), q(224).
-p(226) :- p(225), q(226).
-q(226) :- p(225), q(225).
-p(227) :- p(226), q(227).
-q(227) :- p(226), q(226).
-p(228) :- p(227), q(228).
-q(228) :- p(227), q(227).
-p(229) :- p(228), q(229).
-q(229) :- p(228), q(228).
-p(230) :- p(229), q(230).
-q(230) :- p(229), q(229).
-p(231) :- p(230), q(231).
-q(231) :- p(230), q(230).
-p(232) :- p(231), q(232).
-q(232) :- p(231), q(231).
-p(233) :- p(232), q(233).
-q(233) :- p(232), q(232).
-p(234) :- p(233), q(234).
-q(234) :- p(233), q(233).
-p(235) :- p(234), q(235).
-q(235) :- p(234), q(234).
-p(236) :- p(235), q(236).
-q(236) :- p(235), q(235).
-p(237) :- p(236), q(237).
-q(237) :- p(236), q(236).
-p(238) :- p(237), q(238).
-q(238) :- p(237), q(237).
-p(239) :- p(238), q(239).
-q(239) :- p(238), q(238).
-p(240) :- p(239), q(240).
-q(240) :- p(239), q(239).
-p(241) :- p(240), q(241).
-q(241) :- p(240), q(240).
-p(242) :- p(241), q(242).
-q(242) :- p(241), q(241).
-p(243) :- p(242), q(243).
-q(243) :- p(242), q(242).
-p(244) :- p(243), q(244).
-q(244) :- p(243), q(243).
-p(245) :- p(244), q(245).
-q(245) :- p(244), q(244).
-p(246) :- p(245), q(246).
-q(246) :- p(245), q(245).
-p(247) :- p(246), q(247).
-q(247) :- p(246), q(246).
-p(248) :- p(247), q(248).
-q(248) :- p(247), q(247).
-p(249) :- p(248), q(249).
-q(249) :- p(248), q(248).
-p(250) :- p(249), q(250).
-q(250) :- p(249), q(249).
-p(251) :- p(250), q(251).
-q(251) :- p(250), q(250).
-p(252) :- p(251), q(252).
-q(252) :- p(251), q(251).
-p(253) :- p(252), q(253).
-q(253) :- p(252), q(252).
-p(254) :- p(253), q(254).
-q(254) :- p(253), q(253).
-p(255) :- p(254), q(255).
-q(255) :- p(254), q(254).
-p(256) :- p(255), q(256).
-q(256) :- p(255), q(255).
-p(257) :- p(256), q(257).
-q(257) :- p(256), q(256).
-p(258) :- p(257), q(258).
-q(258) :- p(257), q(257).
-p(259) :- p(258), q(259).
-q(259) :- p(258), q(258).
-p(260) :- p(259), q(260).
-q(260) :- p(259), q(259).
-p(261) :- p(260), q(261).
-q(261) :- p(260), q(260).
-p(262) :- p(261), q(262).
-q(262) :- p(261), q(261).
-p(263) :- p(262), q(263).
-q(263) :- p(262), q(262).
-p(264) :- p(263), q(264).
-q(264) :- p(263), q(263).
-p(265) :- p(264), q(265).
-q(265) :- p(264), q(264).
-p(266) :- p(265), q(266).
-q(266) :- p(265), q(265).
-p(267) :- p(266), q(267).
-q(267) :- p(266), q(266).
-p(268) :- p(267), q(268).
-q(268) :- p(267), q(267).
-p(269) :- p(268), q(269).
-q(269) :- p(268), q(268).
-p(270) :- p(269), q(270).
-q(270) :- p(269), q(269).
-p(271) :- p(270), q(271).
-q(271) :- p(270), q(270).
-p(272) :- p(271), q(272).
-q(272) :- p(271), q(271).
-p(273) :- p(272), q(273).
-q(273) :- p(272), q(272).
-p(274) :- p(273), q(274).
-q(274) :- p(273), q(273).
-p(275) :- p(274), q(275).
-q(275) :- p(274), q(274).
-p(276) :- p(275), q(276).
-q(276) :- p(275), q(275).
-p(277) :- p(276), q(277).
-q(277) :- p(276), q(276).
-p(278) :- p(277), q(278).
-q(278) :- p(277), q(277).
-p(279) :- p(278), q(279).
-q(279) :- p(278), q(278).
-p(280) :- p(279), q(280).
-q(280) :- p(279), q(279).
-p(281) :- p(280), q(281).
-q(281) :- p(280), q(280).
-p(282) :- p(281), q(282).
-q(282) :- p(281), q(281).
-p(283) :- p(282), q(283).
-q(283) :- p(282), q(282).
-p(284) :- p(283), q(284).
-q(284) :- p(283), q(283).
-p(285) :- p(284), q(285).
-q(285) :- p(284), q(284).
-p(286) :- p(285), q(286).
-q(286) :- p(285), q(285).
-p(287) :- p(286), q(287).
-q(287) :- p(286), q(286).
-p(288) :- p(287), q(288).
-q(288) :- p(287), q(287).
-p(289) :- p(288), q(289).
-q(289) :- p(288), q(288).
-p(290) :- p(289), q(290).
-q(290) :- p(289), q(289).
-p(291) :- p(290), q(291).
-q(291) :- p(290), q(290).
-p(292) :- p(291), q(292).
-q(292) :- p(291), q(291).
-p(293) :- p(292), q(293).
-q(293) :- p(292), q(292).
-p(294) :- p(293), q(294).
-q(294) :- p(293), q(293).
-p(295) :- p(294), q(295).
-q(295) :- p(294), q(294).
-p(296) :- p(295), q(296).
-q(296) :- p(295), q(295).
-p(297) :- p(296), q(297).
-q(297) :- p(296), q(296).
-p(298) :- p(297), q(298).
-q(298) :- p(297), q(297).
-p(299) :- p(298), q(299).
-q(299) :- p(298), q(298).
-p(300) :- p(299), q(300).
-q(300) :- p(299), q(299).
-p(301) :- p(300), q(301).
-q(301) :- p(300), q(300).
-p(302) :- p(301), q(302).
-q(302) :- p(301), q(301).
-p(303) :- p(302), q(303).
-q(303) :- p(302), q(302).
-p(304) :- p(303), q(304).
-q(304) :- p(303), q(303).
-p(305) :- p(304), q(305).
-q(305) :- p(304), q(304).
-p(306) :- p(305), q(306).
-q(306) :- p(305), q(305).
-p(307) :- p(306), q(307).
-q(307) :- p(306), q(306).
-p(308) :- p(307), q(308).
-q(308) :- p(307), q(307).
-p(309) :- p(308), q(309).
-q(309) :- p(308), q(308).
-p(310) :- p(309), q(310).
-q(310) :- p(309), q(309).
-p(311) :- p(310), q(311).
-q(311) :- p(310), q(310).
-p(312) :- p(311), q(312).
-q(312) :- p(311), q(311).
-p(313) :- p(312), q(313).
-q(313) :- p(312), q(312).
-p(314) :- p(313), q(314).
-q(314) :- p(313), q(313).
-p(315) :- p(314), q(315).
-q(315) :- p(314), q(314).
-p(316) :- p(315), q(316).
-q(316) :- p(315), q(315).
-p(317) :- p(316), q(317).
-q(317) :- p(316), q(316).
-p(318) :- p(317), q(318).
-q(318) :- p(317), q(317).
-p(319) :- p(318), q(319).
-q(319) :- p(318), q(318).
-p(320) :- p(319), q(320).
-q(320) :- p(319), q(319).
-p(321) :- p(320), q(321).
-q(321) :- p(320), q(320).
-p(322) :- p(321), q(322).
-q(322) :- p(321), q(321).
-p(323) :- p(322), q(323).
-q(323) :- p(322), q(322).
-p(324) :- p(323), q(324).
-q(324) :- p(323), q(323).
-p(325) :- p(324), q(325).
-q(325) :- p(324), q(324).
-p(326) :- p(325), q(326).
-q(326) :- p(325), q(325).
-p(327) :- p(326), q(327).
-q(327) :- p(326), q(326).
-p(328) :- p(327), q(328).
-q(328) :- p(327), q(327).
-p(329) :- p(328), q(329).
-q(329) :- p(328), q(328).
-p(330) :- p(329), q(330).
-q(330) :- p(329), q(329).
-p(331) :- p(330), q(331).
-q(331) :- p(330), q(330).
-p(332) :- p(331), q(332).
-q(332) :- p(331), q(331).
-p(333) :- p(332), q(333).
-q(333) :- p(332), q(332).
-p(334) :- p(333), q(334).
-q(334) :- p(333), q(333).
-p(335) :- p(334), q(335).
-q(335) :- p(334), q(334).
-p(336) :- p(335), q(336).
-q(336) :- p(335), q(335).
-p(337) :- p(336), q(337).
-q(337) :- p(336), q(336).
-p(338) :- p(337), q(338).
-q(338) :- p(337), q(337).
-p(339) :- p(338), q(339).
-q(339) :- p(338), q(338).
-p(340) :- p(339), q(340).
-q(340) :- p(339), q(339).
-p(341) :- p(340), q(341).
-q(341) :- p(340), q(340).
-p(342) :- p(341), q(342).
-q(342) :- p(341), q(341).
-p(343) :- p(342), q(343).
-q(343) :- p(342), q(342).
-p(344) :- p(343), q(344).
-q(344) :- p(343), q(343).
-p(345) :- p(344), q(345).
-q(345) :- p(344), q(344).
-p(346) :- p(345), q(346).
-q(346) :- p(345), q(345).
-p(347) :- p(346), q(347).
-q(347) :- p(346), q(346).
-p(348) :- p(347), q(348).
-q(348) :- p(347), q(347).
-p(349) :- p(348), q(349).
-q(349) :- p(348), q(348).
-p(350) :- p(349), q(350).
-q(350) :- p(349), q(349).
-p(351) :- p(350), q(351).
-q(351) :- p(350), q(350).
-p(352) :- p(351), q(352).
-q(352) :- p(351), q(351).
-p(353) :- p(352), q(353).
-q(353) :- p(352), q(352).
-p(354) :- p(353), q(354).
-q(354) :- p(353), q(353).
-p(355) :- p(354), q(355).
-q(355) :- p(354), q(354).
-p(356) :- p(355), q(356).
-q(356) :- p(355), q(355).
-p(357) :- p(356), q(357).
-q(357) :- p(356), q(356).
-p(358) :- p(357), q(358).
-q(358) :- p(357), q(357).
-p(359) :- p(358), q(359).
-q(359) :- p(358), q(358).
-p(360) :- p(359), q(360).
-q(360) :- p(359), q(359).
-p(361) :- p(360), q(361).
-q(361) :- p(360), q(360).
-p(362) :- p(361), q(362).
-q(362) :- p(361), q(361).
-p(363) :- p(362), q(363).
-q(363) :- p(362), q(362).
-p(364) :- p(363), q(364).
-q(364) :- p(363), q(363).
-p(365) :- p(364), q(365).
-q(365) :- p(364), q(364).
-p(366) :- p(365), q(366).
-q(366) :- p(365), q(365).
-p(367) :- p(366), q(367).
-q(367) :- p(366), q(366).
-p(368) :- p(367), q(368).
-q(368) :- p(367), q(367).
-p(369) :- p(368), q(369).
-q(369) :- p(368), q(368).
-p(370) :- p(369), q(370).
-q(370) :- p(369), q(369).
-p(371) :- p(370), q(371).
-q(371) :- p(370), q(370).
-p(372) :- p(371), q(372).
-q(372) :- p(371), q(371).
-p(373) :- p(372), q(373).
-q(373) :- p(372), q(372).
-p(374) :- p(373), q(374).
-q(374) :- p(373), q(373).
-p(375) :- p(374), q(375).
-q(375) :- p(374), q(374).
-p(376) :- p(375), q(376).
-q(376) :- p(375), q(375).
-p(377) :- p(376), q(377).
-q(377) :- p(376), q(376).
-p(378) :- p(377), q(378).
-q(378) :- p(377), q(377).
-p(379) :- p(378), q(379).
-q(379) :- p(378), q(378).
-p(380) :- p(379), q(380).
-q(380) :- p(379), q(379).
-p(381) :- p(380), q(381).
-q(381) :- p(380), q(380).
-p(382) :- p(381), q(382).
-q(382) :- p(381), q(381).
-p(383) :- p(382), q(383).
-q(383) :- p(382), q(382).
-p(384) :- p(383), q(384).
-q(384) :- p(383), q(383).
-p(385) :- p(384), q(385).
-q(385) :- p(384), q(384).
-p(386) :- p(385), q(386).
-q(386) :- p(385), q(385).
-p(387) :- p(386), q(387).
-q(387) :- p(386), q(386).
-p(388) :- p(387), q(388).
-q(388) :- p(387), q(387).
-p(389) :- p(388), q(389).
-q(389) :- p(388), q(388).
-p(390) :- p(389), q(390).
-q(390) :- p(389), q(389).
-p(391) :- p(390), q(391).
-q(391) :- p(390), q(390).
-p(392) :- p(391), q(392).
-q(392) :- p(391), q(391).
-p(393) :- p(392), q(393).
-q(393) :- p(392), q(392).
-p(394) :- p(393), q(394).
-q(394) :- p(393), q(393).
-p(395) :- p(394), q(395).
-q(395) :- p(394), q(394).
-p(396) :- p(395), q(396).
-q(396) :- p(395), q(395).
-p(397) :- p(396), q(397).
-q(397) :- p(396), q(396).
-p(398) :- p(397), q(398).
-q(398) :- p(397), q(397).
-p(399) :- p(398), q(399).
-q(399) :- p(398), q(398).
-p(400) :- p(399), q(400).
-q(400) :- p(399), q(399).
-p(401) :- p(400), q(401).
-q(401) :- p(400), q(400).
-p(402) :- p(401), q(402).
-q(402) :- p(401), q(401).
-p(403) :- p(402), q(403).
-q(403) :- p(402), q(402).
-p(404) :- p(403), q(404).
-q(404) :- p(403), q(403).
-p(405) :- p(404), q(405).
-q(405) :- p(404), q(404).
-p(406) :- p(405), q(406).
-q(406) :- p(405), q(405).
-p(407) :- p(406), q(407).
-q(407) :- p(406), q(406).
-p(408) :- p(407), q(408).
-q(408) :- p(407), q(407).
-p(409) :- p(408), q(409).
-q(409) :- p(408), q(408).
-p(410) :- p(409), q(410).
-q(410) :- p(409), q(409).
-p(411) :- p(410), q(411).
-q(411) :- p(410), q(410).
-p(412) :- p(411), q(412).
-q(412) :- p(411), q(411).
-p(413) :- p(412), q(413).
-q(413) :- p(412), q(412).
-p(414) :- p(413), q(414).
-q(414) :- p(413), q(413).
-p(415) :- p(414), q(415).
-q(415) :- p(414), q(414).
-p(416) :- p(415), q(416).
-q(416) :- p(415), q(415).
-p(417) :- p(416), q(417).
-q(417) :- p(416), q(416).
-p(418) :- p(417), q(418).
-q(418) :- p(417), q(417).
-p(419) :- p(418), q(419).
-q(419) :- p(418), q(418).
-p(420) :- p(419), q(420).
-q(420) :- p(419), q(419).
-p(421) :- p(420), q(421).
-q(421) :- p(420), q(420).
-p(422) :- p(421), q(422).
-q(422) :- p(421), q(421).
-p(423) :- p(422), q(423).
-q(423) :- p(422), q(422).
-p(424) :- p(423), q(424).
-q(424) :- p(423), q(423).
-p(425) :- p(424), q(425).
-q(425) :- p(424), q(424).
-p(426) :- p(425), q(426).
-q(426) :- p(425), q(425).
-p(427) :- p(426), q(427).
-q(427) :- p(426), q(426).
-p(428) :- p(427), q(428).
-q(428) :- p(427), q(427).
-p(429) :- p(428), q(429).
-q(429) :- p(428), q(428).
-p(430) :- p(429), q(430).
-q(430) :- p(429), q(429).
-p(431) :- p(430), q(431).
-q(431) :- p(430), q(430).
-p(432) :- p(431), q(432).
-q(432) :- p(431), q(431).
-p(433) :- p(432), q(433).
-q(433) :- p(432), q(432).
-p(434) :- p(433), q(434).
-q(434) :- p(433), q(433).
-p(435) :- p(434), q(435).
-q(435) :- p(434), q(434).
-p(436) :- p(435), q(436).
-q(436) :- p(435), q(435).
-p(437) :- p(436), q(437).
-q(437) :- p(436), q(436).
-p(438) :- p(437), q(438).
-q(438) :- p(437), q(437).
-p(439) :- p(438), q(439).
-q(439) :- p(438), q(438).
-p(440) :- p(439), q(440).
-q(440) :- p(439), q(439).
-p(441) :- p(440), q(441).
-q(441) :- p(440), q(440).
-p(442) :- p(441), q(442).
-q(442) :- p(441), q(441).
-p(443) :- p(442), q(443).
-q(443) :- p(442), q(442).
-p(444) :- p(443), q(444).
-q(444) :- p(443), q(443).
-p(445) :- p(444), q(445).
-q(445) :- p(444), q(444).
-p(446) :- p(445), q(446).
-q(446) :- p(445), q(445).
-p(447) :- p(446), q(447).
-q(447) :- p(446), q(446).
-p(448) :- p(447), q(448).
-q(448) :- p(447), q(447).
-p(449) :- p(448), q(449).
-q(449) :- p(448), q(448).
-p(450) :- p(449), q(450).
-q(450) :- p(449), q(449).
-p(451) :- p(450), q(451).
-q(451) :- p(450), q(450).
-p(452) :- p(451), q(452).
-q(452) :- p(451), q(451).
-p(453) :- p(452), q(453).
-q(453) :- p(452), q(452).
-p(454) :- p(453), q(454).
-q(454) :- p(453), q(453).
-p(455) :- p(454), q(455).
-q(455) :- p(454), q(454).
-p(456) :- p(455), q(456).
-q(456) :- p(455), q(455).
-p(457) :- p(456), q(457).
-q(457) :- p(456), q(456).
-p(458) :- p(457), q(458).
-q(458) :- p(457), q(457).
-p(459) :- p(458), q(459).
-q(459) :- p(458), q(458).
-p(460) :- p(459), q(460).
-q(460) :- p(459), q(459).
-p(461) :- p(460), q(461).
-q(461) :- p(460), q(460).
-p(462) :- p(461), q(462).
-q(462) :- p(461), q(461).
-p(463) :- p(462), q(463).
-q(463) :- p(462), q(462).
-p(464) :- p(463), q(464).
-q(464) :- p(463), q(463).
-p(465) :- p(464), q(465).
-q(465) :- p(464), q(464).
-p(466) :- p(465), q(466).
-q(466) :- p(465), q(465).
-p(467) :- p(466), q(467).
-q(467) :- p(466), q(466).
-p(468) :- p(467), q(468).
-q(468) :- p(467), q(467).
-p(469) :- p(468), q(469).
-q(469) :- p(468), q(468).
-p(470) :- p(469), q(470).
-q(470) :- p(469), q(469).
-p(471) :- p(470), q(471).
-q(471) :- p(470), q(470).
-p(472) :- p(471), q(472).
-q(472) :- p(471), q(471).
-p(473) :- p(472), q(473).
-q(473) :- p(472), q(472).
-p(474) :- p(473), q(474).
-q(474) :- p(473), q(473).
-p(475) :- p(474), q(475).
-q(475) :- p(474), q(474).
-p(476) :- p(475), q(476).
-q(476) :- p(475), q(475).
-p(477) :- p(476), q(477).
-q(477) :- p(476), q(476).
-p(478) :- p(477), q(478).
-q(478) :- p(477), q(477).
-p(479) :- p(478), q(479).
-q(479) :- p(478), q(478).
-p(480) :- p(479), q(480).
-q(480) :- p(479), q(479).
-p(481) :- p(480), q(481).
-q(481) :- p(480), q(480).
-p(482) :- p(481), q(482).
-q(482) :- p(481), q(481).
-p(483) :- p(482), q(483).
-q(483) :- p(482), q(482).
-p(484) :- p(483), q(484).
-q(484) :- p(483), q(483).
-p(485) :- p(484), q(485).
-q(485) :- p(484), q(484).
-p(486) :- p(485), q(486).
-q(486) :- p(485), q(485).
-p(487) :- p(486), q(487).
-q(487) :- p(486), q(486).
-p(488) :- p(487), q(488).
-q(488) :- p(487), q(487).
-p(489) :- p(488), q(489).
-q(489) :- p(488), q(488).
-p(490) :- p(489), q(490).
-q(490) :- p(489), q(489).
-p(491) :- p(490), q(491).
-q(491) :- p(490), q(490).
-p(492) :- p(491), q(492).
-q(492) :- p(491), q(491).
-p(493) :- p(492), q(493).
-q(493) :- p(492), q(492).
-p(494) :- p(493), q(494).
-q(494) :- p(493), q(493).
-p(495) :- p(494), q(495).
-q(495) :- p(494), q(494).
-p(496) :- p(495), q(496).
-q(496) :- p(495), q(495).
-p(497) :- p(496), q(497).
-q(497) :- p(496), q(496).
-p(498) :- p(497), q(498).
-q(498) :- p(497), q(497).
-p(499) :- p(498), q(499).
-q(499) :- p(498), q(498).
-p(500) :- p(499), q(500).
-q(500) :- p(499), q(499).
-p(501) :- p(500), q(501).
-q(501) :- p(500), q(500).
-p(502) :- p(501), q(502).
-q(502) :- p(501), q(501).
-p(503) :- p(502), q(503).
-q(503) :- p(502), q(502).
-p(504) :- p(503), q(504).
-q(504) :- p(503), q(503).
-p(505) :- p(504), q(505).
-q(505) :- p(504), q(504).
-p(506) :- p(505), q(506).
-q(506) :- p(505), q(505).
-p(507) :- p(506), q(507).
-q(507) :- p(506), q(506).
-p(508) :- p(507), q(508).
-q(508) :- p(507), q(507).
-p(509) :- p(508), q(509).
-q(509) :- p(508), q(508).
-p(510) :- p(509), q(510).
-q(510) :- p(509), q(509).
-p(511) :- p(510), q(511).
-q(511) :- p(510), q(510).
-p(512) :- p(511), q(512).
-q(512) :- p(511), q(511).
-p(513) :- p(512), q(513).
-q(513) :- p(512), q(512).
-p(514) :- p(513), q(514).
-q(514) :- p(513), q(513).
-p(515) :- p(514), q(515).
-q(515) :- p(514), q(514).
-p(516) :- p(515), q(516).
-q(516) :- p(515), q(515).
-p(517) :- p(516), q(517).
-q(517) :- p(516), q(516).
-p(518) :- p(517), q(518).
-q(518) :- p(517), q(517).
-p(519) :- p(518), q(519).
-q(519) :- p(518), q(518).
-p(520) :- p(519), q(520).
-q(520) :- p(519), q(519).
-p(521) :- p(520), q(521).
-q(521) :- p(520), q(520).
-p(522) :- p(521), q(522).
-q(522) :- p(521), q(521).
-p(523) :- p(522), q(523).
-q(523) :- p(522), q(522).
-p(524) :- p(523), q(524).
-q(524) :- p(523), q(523).
-p(525) :- p(524), q(525).
-q(525) :- p(524), q(524).
-p(526) :- p(525), q(526).
-q(526) :- p(525), q(525).
-p(527) :- p(526), q(527).
-q(527) :- p(526), q(526).
-p(528) :- p(527), q(528).
-q(528) :- p(527), q(527).
-p(529) :- p(528), q(529).
-q(529) :- p(528), q(528).
-p(530) :- p(529), q(530).
-q(530) :- p(529), q(529).
-p(531) :- p(530), q(531).
-q(531) :- p(530), q(530).
-p(532) :- p(531), q(532).
-q(532) :- p(531), q(531).
-p(533) :- p(532), q(533).
-q(533) :- p(532), q(532).
-p(534) :- p(533), q(534).
-q(534) :- p(533), q(533).
-p(535) :- p(534), q(535).
-q(535) :- p(534), q(534).
-p(536) :- p(535), q(536).
-q(536) :- p(535), q(535).
-p(537) :- p(536), q(537).
-q(537) :- p(536), q(536).
-p(538) :- p(537), q(538).
-q(538) :- p(537), q(537).
-p(539) :- p(538), q(539).
-q(539) :- p(538), q(538).
-p(540) :- p(539), q(540).
-q(540) :- p(539), q(539).
-p(541) :- p(540), q(541).
-q(541) :- p(540), q(540).
-p(542) :- p(541), q(542).
-q(542) :- p(541), q(541).
-p(543) :- p(542), q(543).
-q(543) :- p(542), q(542).
-p(544) :- p(543), q(544).
-q(544) :- p(543), q(543).
-p(545) :- p(544), q(545).
-q(545) :- p(544), q(544).
-p(546) :- p(545), q(546).
-q(546) :- p(545), q(545).
-p(547) :- p(546), q(547).
-q(547) :- p(546), q(546).
-p(548) :- p(547), q(548).
-q(548) :- p(547), q(547).
-p(549) :- p(548), q(549).
-q(549) :- p(548), q(548).
-p(550) :- p(549), q(550).
-q(550) :- p(549), q(549).
-p(551) :- p(550), q(551).
-q(551) :- p(550), q(550).
-p(552) :- p(551), q(552).
-q(552) :- p(551), q(551).
-p(553) :- p(552), q(553).
-q(553) :- p(552), q(552).
-p(554) :- p(553), q(554).
-q(554) :- p(553), q(553).
-p(555) :- p(554), q(555).
-q(555) :- p(554), q(554).
-p(556) :- p(555), q(556).
-q(556) :- p(555), q(555).
-p(557) :- p(556), q(557).
-q(557) :- p(556), q(556).
-p(558) :- p(557), q(558).
-q(558) :- p(557), q(557).
-p(559) :- p(558), q(559).
-q(559) :- p(558), q(558).
-p(560) :- p(559), q(560).
-q(560) :- p(559), q(559).
-p(561) :- p(560), q(561).
-q(561) :- p(560), q(560).
-p(562) :- p(561), q(562).
-q(562) :- p(561), q(561).
-p(563) :- p(562), q(563).
-q(563) :- p(562), q(562).
-p(564) :- p(563), q(564).
-q(564) :- p(563), q(563).
-p(565) :- p(564), q(565).
-q(565) :- p(564), q(564).
-p(566) :- p(565), q(566).
-q(566) :- p(565), q(565).
-p(567) :- p(566), q(567).
-q(567) :- p(566), q(566).
-p(568) :- p(567), q(568).
-q(568) :- p(567), q(567).
-p(569) :- p(568), q(569).
-q(569) :- p(568), q(568).
-p(570) :- p(569), q(570).
-q(570) :- p(569), q(569).
-p(571) :- p(570), q(571).
-q(571) :- p(570), q(570).
-p(572) :- p(571), q(572).
-q(572) :- p(571), q(571).
-p(573) :- p(572), q(573).
-q(573) :- p(572), q(572).
-p(574) :- p(573), q(574).
-q(574) :- p(573), q(573).
-p(575) :- p(574), q(575).
-q(575) :- p(574), q(574).
-p(576) :- p(575), q(576).
-q(576) :- p(575), q(575).
-p(577) :- p(576), q(577).
-q(577) :- p(576), q(576).
-p(578) :- p(577), q(578).
-q(578) :- p(577), q(577).
-p(579) :- p(578), q(579).
-q(579) :- p(578), q(578).
-p(580) :- p(579), q(580).
-q(580) :- p(579), q(579).
-p(581) :- p(580), q(581).
-q(581) :- p(580), q(580).
-p(582) :- p(581), q(582).
-q(582) :- p(581), q(581).
-p(583) :- p(582), q(583).
-q(583) :- p(582), q(582).
-p(584) :- p(583), q(584).
-q(584) :- p(583), q(583).
-p(585) :- p(584), q(585).
-q(585) :- p(584), q(584).
-p(586) :- p(585), q(586).
-q(586) :- p(585), q(585).
-p(587) :- p(586), q(587).
-q(587) :- p(586), q(586).
-p(588) :- p(587), q(588).
-q(588) :- p(587), q(587).
-p(589) :- p(588), q(589).
-q(589) :- p(588), q(588).
-p(590) :- p(589), q(590).
-q(590) :- p(589), q(589).
-p(591) :- p(590), q(591).
-q(591) :- p(590), q(590).
-p(592) :- p(591), q(592).
-q(592) :- p(591), q(591).
-p(593) :- p(592), q(593).
-q(593) :- p(592), q(592).
-p(594) :- p(593), q(594).
-q(594) :- p(593), q(593).
-p(595) :- p(594), q(595).
-q(595) :- p(594), q(594).
-p(596) :- p(595), q(596).
-q(596) :- p(595), q(595).
-p(597) :- p(596), q(597).
-q(597) :- p(596), q(596).
-p(598) :- p(597), q(598).
-q(598) :- p(597), q(597).
-p(599) :- p(598), q(599).
-q(599) :- p(598), q(598).
-p(600) :- p(599), q(600).
-q(600) :- p(599), q(599).
-p(601) :- p(600), q(601).
-q(601) :- p(600), q(600).
-p(602) :- p(601), q(602).
-q(602) :- p(601), q(601).
-p(603) :- p(602), q(603).
-q(603) :- p(602), q(602).
-p(604) :- p(603), q(604).
-q(604) :- p(603), q(603).
-p(605) :- p(604), q(605).
-q(605) :- p(604), q(604).
-p(606) :- p(605), q(606).
-q(606) :- p(605), q(605).
-p(607) :- p(606), q(607).
-q(607) :- p(606), q(606).
-p(608) :- p(607), q(608).
-q(608) :- p(607), q(607).
-p(609) :- p(608), q(609).
-q(609) :- p(608), q(608).
-p(610) :- p(609), q(610).
-q(610) :- p(609), q(609).
-p(611) :- p(610), q(611).
-q(611) :- p(610), q(610).
-p(612) :- p(611), q(612).
-q(612) :- p(611), q(611).
-p(613) :- p(612), q(613).
-q(613) :- p(612), q(612).
-p(614) :- p(613), q(614).
-q(614) :- p(613), q(613).
-p(615) :- p(614), q(615).
-q(615) :- p(614), q(614).
-p(616) :- p(615), q(616).
-q(616) :- p(615), q(615).
-p(617) :- p(616), q(617).
-q(617) :- p(616), q(616).
-p(618) :- p(617), q(618).
-q(618) :- p(617), q(617).
-p(619) :- p(618), q(619).
-q(619) :- p(618), q(618).
-p(620) :- p(619), q(620).
-q(620) :- p(619), q(619).
-p(621) :- p(620), q(621).
-q(621) :- p(620), q(620).
-p(622) :- p(621), q(622).
-q(622) :- p(621), q(621).
-p(623) :- p(622), q(623).
-q(623) :- p(622), q(622).
-p(624) :- p(623), q(624).
-q(624) :- p(623), q(623).
-p(625) :- p(624), q(625).
-q(625) :- p(624), q(624).
-p(626) :- p(625), q(626).
-q(626) :- p(625), q(625).
-p(627) :- p(626), q(627).
-q(627) :- p(626), q(626).
-p(628) :- p(627), q(628).
-q(628) :- p(627), q(627).
-p(629) :- p(628), q(629).
-q(629) :- p(628), q(628).
-p(630) :- p(629), q(630).
-q(630) :- p(629), q(629).
-p(631) :- p(630), q(631).
-q(631) :- p(630), q(630).
-p(632) :- p(631), q(632).
-q(632) :- p(631), q(631).
-p(633) :- p(632), q(633).
-q(633) :- p(632), q(632).
-p(634) :- p(633), q(634).
-q(634) :- p(633), q(633).
-p(635) :- p(634), q(635).
-q(635) :- p(634), q(634).
-p(636) :- p(635), q(636).
-q(636) :- p(635), q(635).
-p(637) :- p(636), q(637).
-q(637) :- p(636), q(636).
-p(638) :- p(637), q(638).
-q(638) :- p(637), q(637).
-p(639) :- p(638), q(639).
-q(639) :- p(638), q(638).
-p(640) :- p(639), q(640).
-q(640) :- p(639), q(639).
-p(641) :- p(640), q(641).
-q(641) :- p(640), q(640).
-p(642) :- p(641), q(642).
-q(642) :- p(641), q(641).
-p(643) :- p(642), q(643).
-q(643) :- p(642), q(642).
-p(644) :- p(643), q(644).
-q(644) :- p(643), q(643).
-p(645) :- p(644), q(645).
-q(645) :- p(644), q(644).
-p(646) :- p(645), q(646).
-q(646) :- p(645), q(645).
-p(647) :- p(646), q(647).
-q(647) :- p(646), q(646).
-p(648) :- p(647), q(648).
-q(648) :- p(647), q(647).
-p(649) :- p(648), q(649).
-q(649) :- p(648), q(648).
-p(650) :- p(649), q(650).
-q(650) :- p(649), q(649).
-p(651) :- p(650), q(651).
-q(651) :- p(650), q(650).
-p(652) :- p(651), q(652).
-q(652) :- p(651), q(651).
-p(653) :- p(652), q(653).
-q(653) :- p(652), q(652).
-p(654) :- p(653), q(654).
-q(654) :- p(653), q(653).
-p(655) :- p(654), q(655).
-q(655) :- p(654), q(654).
-p(656) :- p(655), q(656).
-q(656) :- p(655), q(655).
-p(657) :- p(656), q(657).
-q(657) :- p(656), q(656).
-p(658) :- p(657), q(658).
-q(658) :- p(657), q(657).
-p(659) :- p(658), q(659).
-q(659) :- p(658), q(658).
-p(660) :- p(659), q(660).
-q(660) :- p(659), q(659).
-p(661) :- p(660), q(661).
-q(661) :- p(660), q(660).
-p(662) :- p(661), q(662).
-q(662) :- p(661), q(661).
-p(663) :- p(662), q(663).
-q(663) :- p(662), q(662).
-p(664) :- p(663), q(664).
-q(664) :- p(663), q(663).
-p(665) :- p(664), q(665).
-q(665) :- p(664), q(664).
-p(666) :- p(665), q(666).
-q(666) :- p(665), q(665).
-p(667) :- p(666), q(667).
-q(667) :- p(666), q(666).
-p(668) :- p(667), q(668).
-q(668) :- p(667), q(667).
-p(669) :- p(668), q(669).
-q(669) :- p(668), q(668).
-p(670) :- p(669), q(670).
-q(670) :- p(669), q(669).
-p(671) :- p(670), q(671).
-q(671) :- p(670), q(670).
-p(672) :- p(671), q(672).
-q(672) :- p(671), q(671).
-p(673) :- p(672), q(673).
-q(673) :- p(672), q(672).
-p(674) :- p(673), q(674).
-q(674) :- p(673), q(673).
-p(675) :- p(674), q(675).
-q(675) :- p(674), q(674).
-p(676) :- p(675), q(676).
-q(676) :- p(675), q(675).
-p(677) :- p(676), q(677).
-q(677) :- p(676), q(676).
-p(678) :- p(677), q(678).
-q(678) :- p(677), q(677).
-p(679) :- p(678), q(679).
-q(679) :- p(678), q(678).
-p(680) :- p(679), q(680).
-q(680) :- p(679), q(679).
-p(681) :- p(680), q(681).
-q(681) :- p(680), q(680).
-p(682) :- p(681), q(682).
-q(682) :- p(681), q(681).
-p(683) :- p(682), q(683).
-q(683) :- p(682), q(682).
-p(684) :- p(683), q(684).
-q(684) :- p(683), q(683).
-p(685) :- p(684), q(685).
-q(685) :- p(684), q(684).
-p(686) :- p(685), q(686).
-q(686) :- p(685), q(685).
-p(687) :- p(686), q(687).
-q(687) :- p(686), q(686).
-p(688) :- p(687), q(688).
-q(688) :- p(687), q(687).
-p(689) :- p(688), q(689).
-q(689) :- p(688), q(688).
-p(690) :- p(689), q(690).
-q(690) :- p(689), q(689).
-p(691) :- p(690), q(691).
-q(691) :- p(690), q(690).
-p(692) :- p(691), q(692).
-q(692) :- p(691), q(691).
-p(693) :- p(692), q(693).
-q(693) :- p(692), q(692).
-p(694) :- p(693), q(694).
-q(694) :- p(693), q(693).
-p(695) :- p(694), q(695).
-q(695) :- p(694), q(694).
-p(696) :- p(695), q(696).
-q(696) :- p(695), q(695).
-p(697) :- p(696), q(697).
-q(697) :- p(696), q(696).
-p(698) :- p(697), q(698).
-q(698) :- p(697), q(697).
-p(699) :- p(698), q(699).
-q(699) :- p(698), q(698).
-p(700) :- p(699), q(700).
-q(700) :- p(699), q(699).
-p(701) :- p(700), q(701).
-q(701) :- p(700), q(700).
-p(702) :- p(701), q(702).
-q(702) :- p(701), q(701).
-p(703) :- p(702), q(703).
-q(703) :- p(702), q(702).
-p(704) :- p(703), q(704).
-q(704) :- p(703), q(703).
-p(705) :- p(704), q(705).
-q(705) :- p(704), q(704).
-p(706) :- p(705), q(706).
-q(706) :- p(705), q(705).
-p(707) :- p(706), q(707).
-q(707) :- p(706), q(706).
-p(708) :- p(707), q(708).
-q(708) :- p(707), q(707).
-p(709) :- p(708), q(709).
-q(709) :- p(708), q(708).
-p(710) :- p(709), q(710).
-q(710) :- p(709), q(709).
-p(711) :- p(710), q(711).
-q(711) :- p(710), q(710).
-p(712) :- p(711), q(712).
-q(712) :- p(711), q(711).
-p(713) :- p(712), q(713).
-q(713) :- p(712), q(712).
-p(714) :- p(713), q(714).
-q(714) :- p(713), q(713).
-p(715) :- p(714), q(715).
-q(715) :- p(714), q(714).
-p(716) :- p(715), q(716).
-q(716) :- p(715), q(715).
-p(717) :- p(716), q(717).
-q(717) :- p(716), q(716).
-p(718) :- p(717), q(718).
-q(718) :- p(717), q(717).
-p(719) :- p(718), q(719).
-q(719) :- p(718), q(718).
-p(720) :- p(719), q(720).
-q(720) :- p(719), q(719).
-p(721) :- p(720), q(721).
-q(721) :- p(720), q(720).
-p(722) :- p(721), q(722).
-q(722) :- p(721), q(721).
-p(723) :- p(722), q(723).
-q(723) :- p(722), q(722).
-p(724) :- p(723), q(724).
-q(724) :- p(723), q(723).
-p(725) :- p(724), q(725).
-q(725) :- p(724), q(724).
-p(726) :- p(725), q(726).
-q(726) :- p(725), q(725).
-p(727) :- p(726), q(727).
-q(727) :- p(726), q(726).
-p(728) :- p(727), q(728).
-q(728) :- p(727), q(727).
-p(729) :- p(728), q(729).
-q(729) :- p(728), q(728).
-p(730) :- p(729), q(730).
-q(730) :- p(729), q(729).
-p(731) :- p(730), q(731).
-q(731) :- p(730), q(730).
-p(732) :- p(731), q(732).
-q(732) :- p(731), q(731).
-p(733) :- p(732), q(733).
-q(733) :- p(732), q(732).
-p(734) :- p(733), q(734).
-q(734) :- p(733), q(733).
-p(735) :- p(734), q(735).
-q(735) :- p(734), q(734).
-p(736) :- p(735), q(736).
-q(736) :- p(735), q(735).
-p(737) :- p(736), q(737).
-q(737) :- p(736), q(736).
-p(738) :- p(737), q(738).
-q(738) :- p(737), q(737).
-p(739) :- p(738), q(739).
-q(739) :- p(738), q(738).
-p(740) :- p(739), q(740).
-q(740) :- p(739), q(739).
-p(741) :- p(740), q(741).
-q(741) :- p(740), q(740).
-p(742) :- p(741), q(742).
-q(742) :- p(741), q(741).
-p(743) :- p(742), q(743).
-q(743) :- p(742), q(742).
-p(744) :- p(743), q(744).
-q(744) :- p(743), q(743).
-p(745) :- p(744), q(745).
-q(745) :- p(744), q(744).
-p(746) :- p(745), q(746).
-q(746) :- p(745), q(745).
-p(747) :- p(746), q(747).
-q(747) :- p(746), q(746).
-p(748) :- p(747), q(748).
-q(748) :- p(747), q(747).
-p(749) :- p(748), q(749).
-q(749) :- p(748), q(748).
-p(750) :- p(749), q(750).
-q(750) :- p(749), q(749).
-p(751) :- p(750), q(751).
-q(751) :- p(750), q(750).
-p(752) :- p(751), q(752).
-q(752) :- p(751), q(751).
-p(753) :- p(752), q(753).
-q(753) :- p(752), q(752).
-p(754) :- p(753), q(754).
-q(754) :- p(753), q(753).
-p(755) :- p(754), q(755).
-q(755) :- p(754), q(754).
-p(756) :- p(755), q(756).
-q(756) :- p(755), q(755).
-p(757) :- p(756), q(757).
-q(757) :- p(756), q(756).
-p(758) :- p(757), q(758).
-q(758) :- p(757), q(757).
-p(759) :- p(758), q(759).
-q(759) :- p(758), q(758).
-p(760) :- p(759), q(760).
-q(760) :- p(759), q(759).
-p(761) :- p(760), q(761).
-q(761) :- p(760), q(760).
-p(762) :- p(761), q(762).
-q(762) :- p(761), q(761).
-p(763) :- p(762), q(763).
-q(763) :- p(762), q(762).
-p(764) :- p(763), q(764).
-q(764) :- p(763), q(763).
-p(765) :- p(764), q(765).
-q(765) :- p(764), q(764).
-p(766) :- p(765), q(766).
-q(766) :- p(765), q(765).
-p(767) :- p(766), q(767).
-q(767) :- p(766), q(766).
-p(768) :- p(767), q(768).
-q(768) :- p(767), q(767).
-p(769) :- p(768), q(769).
-q(769) :- p(768), q(768).
-p(770) :- p(769), q(770).
-q(770) :- p(769), q(769).
-p(771) :- p(770), q(771).
-q(771) :- p(770), q(770).
-p(772) :- p(771), q(772).
-q(772) :- p(771), q(771).
-p(773) :- p(772), q(773).
-q(773) :- p(772), q(772).
-p(774) :- p(773), q(774).
-q(774) :- p(773), q(773).
-p(775) :- p(774), q(775).
-q(775) :- p(774), q(774).
-p(776) :- p(775), q(776).
-q(776) :- p(775), q(775).
-p(777) :- p(776), q(777).
-q(777) :- p(776), q(776).
-p(778) :- p(777), q(778).
-q(778) :- p(777), q(777).
-p(779) :- p(778), q(779).
-q(779) :- p(778), q(778).
-p(780) :- p(779), q(780).
-q(780) :- p(779), q(779).
-p(781) :- p(780), q(781).
-q(781) :- p(780), q(780).
-p(782) :- p(781), q(782).
-q(782) :- p(781), q(781).
-p(783) :- p(782), q(783).
-q(783) :- p(782), q(782).
-p(784) :- p(783), q(784).
-q(784) :- p(783), q(783).
-p(785) :- p(784), q(785).
-q(785) :- p(784), q(784).
-p(786) :- p(785), q(786).
-q(786) :- p(785), q(785).
-p(787) :- p(786), q(787).
-q(787) :- p(786), q(786).
-p(788) :- p(787), q(788).
-q(788) :- p(787), q(787).
-p(789) :- p(788), q(789).
-q(789) :- p(788), q(788).
-p(790) :- p(789), q(790).
-q(790) :- p(789), q(789).
-p(791) :- p(790), q(791).
-q(791) :- p(790), q(790).
-p(792) :- p(791), q(792).
-q(792) :- p(791), q(791).
-p(793) :- p(792), q(793).
-q(793) :- p(792), q(792).
-p(794) :- p(793), q(794).
-q(794) :- p(793), q(793).
-p(795) :- p(794), q(795).
-q(795) :- p(794), q(794).
-p(796) :- p(795), q(796).
-q(796) :- p(795), q(795).
-p(797) :- p(796), q(797).
-q(797) :- p(796), q(796).
-p(798) :- p(797), q(798).
-q(798) :- p(797), q(797).
-p(799) :- p(798), q(799).
-q(799) :- p(798), q(798).
-p(800) :- p(799), q(800).
-q(800) :- p(799), q(799).
-p(801) :- p(800), q(801).
-q(801) :- p(800), q(800).
-p(802) :- p(801), q(802).
-q(802) :- p(801), q(801).
-p(803) :- p(802), q(803).
-q(803) :- p(802), q(802).
-p(804) :- p(803), q(804).
-q(804) :- p(803), q(803).
-p(805) :- p(804), q(805).
-q(805) :- p(804), q(804).
-p(806) :- p(805), q(806).
-q(806) :- p(805), q(805).
-p(807) :- p(806), q(807).
-q(807) :- p(806), q(806).
-p(808) :- p(807), q(808).
-q(808) :- p(807), q(807).
-p(809) :- p(808), q(809).
-q(809) :- p(808), q(808).
-p(810) :- p(809), q(810).
-q(810) :- p(809), q(809).
-p(811) :- p(810), q(811).
-q(811) :- p(810), q(810).
-p(812) :- p(811), q(812).
-q(812) :- p(811), q(811).
-p(813) :- p(812), q(813).
-q(813) :- p(812), q(812).
-p(814) :- p(813), q(814).
-q(814) :- p(813), q(813).
-p(815) :- p(814), q(815).
-q(815) :- p(814), q(814).
-p(816) :- p(815), q(816).
-q(816) :- p(815), q(815).
-p(817) :- p(816), q(817).
-q(817) :- p(816), q(816).
-p(818) :- p(817), q(818).
-q(818) :- p(817), q(817).
-p(819) :- p(818), q(819).
-q(819) :- p(818), q(818).
-p(820) :- p(819), q(820).
-q(820) :- p(819), q(819).
-p(821) :- p(820), q(821).
-q(821) :- p(820), q(820).
-p(822) :- p(821), q(822).
-q(822) :- p(821), q(821).
-p(823) :- p(822), q(823).
-q(823) :- p(822), q(822).
-p(824) :- p(823), q(824).
-q(824) :- p(823), q(823).
-p(825) :- p(824), q(825).
-q(825) :- p(824), q(824).
-p(826) :- p(825), q(826).
-q(826) :- p(825), q(825).
-p(827) :- p(826), q(827).
-q(827) :- p(826), q(826).
-p(828) :- p(827), q(828).
-q(828) :- p(827), q(827).
-p(829) :- p(828), q(829).
-q(829) :- p(828), q(828).
-p(830) :- p(829), q(830).
-q(830) :- p(829), q(829).
-p(831) :- p(830), q(831).
-q(831) :- p(830), q(830).
-p(832) :- p(831), q(832).
-q(832) :- p(831), q(831).
-p(833) :- p(832), q(833).
-q(833) :- p(832), q(832).
-p(834) :- p(833), q(834).
-q(834) :- p(833), q(833).
-p(835) :- p(834), q(835).
-q(835) :- p(834), q(834).
-p(836) :- p(835), q(836).
-q(836) :- p(835), q(835).
-p(837) :- p(836), q(837).
-q(837) :- p(836), q(836).
-p(838) :- p(837), q(838).
-q(838) :- p(837), q(837).
-p(839) :- p(838), q(839).
-q(839) :- p(838), q(838).
-p(840) :- p(839), q(840).
-q(840) :- p(839), q(839).
-p(841) :- p(840), q(841).
-q(841) :- p(840), q(840).
-p(842) :- p(841), q(842).
-q(842) :- p(841), q(841).
-p(843) :- p(842), q(843).
-q(843) :- p(842), q(842).
-p(844) :- p(843), q(844).
-q(844) :- p(843), q(843).
-p(845) :- p(844), q(845).
-q(845) :- p(844), q(844).
-p(846) :- p(845), q(846).
-q(846) :- p(845), q(845).
-p(847) :- p(846), q(847).
-q(847) :- p(846), q(846).
-p(848) :- p(847), q(848).
-q(848) :- p(847), q(847).
-p(849) :- p(848), q(849).
-q(849) :- p(848), q(848).
-p(850) :- p(849), q(850).
-q(850) :- p(849), q(849).
-p(851) :- p(850), q(851).
-q(851) :- p(850), q(850).
-p(852) :- p(851), q(852).
-q(852) :- p(851), q(851).
-p(853) :- p(852), q(853).
-q(853) :- p(852), q(852).
-p(854) :- p(853), q(854).
-q(854) :- p(853), q(853).
-p(855) :- p(854), q(855).
-q(855) :- p(854), q(854).
-p(856) :- p(855), q(856).
-q(856) :- p(855), q(855).
-p(857) :- p(856), q(857).
-q(857) :- p(856), q(856).
-p(858) :- p(857), q(858).
-q(858) :- p(857), q(857).
-p(859) :- p(858), q(859).
-q(859) :- p(858), q(858).
-p(860) :- p(859), q(860).
-q(860) :- p(859), q(859).
-p(861) :- p(860), q(861).
-q(861) :- p(860), q(860).
-p(862) :- p(861), q(862).
-q(862) :- p(861), q(861).
-p(863) :- p(862), q(863).
-q(863) :- p(862), q(862).
-p(864) :- p(863), q(864).
-q(864) :- p(863), q(863).
-p(865) :- p(864), q(865).
-q(865) :- p(864), q(864).
-p(866) :- p(865), q(866).
-q(866) :- p(865), q(865).
-p(867) :- p(866), q(867).
-q(867) :- p(866), q(866).
-p(868) :- p(867), q(868).
-q(868) :- p(867), q(867).
-p(869) :- p(868), q(869).
-q(869) :- p(868), q(868).
-p(870) :- p(869), q(870).
-q(870) :- p(869), q(869).
-p(871) :- p(870), q(871).
-q(871) :- p(870), q(870).
-p(872) :- p(871), q(872).
-q(872) :- p(871), q(871).
-p(873) :- p(872), q(873).
-q(873) :- p(872), q(872).
-p(874) :- p(873), q(874).
-q(874) :- p(873), q(873).
-p(875) :- p(874), q(875).
-q(875) :- p(874), q(874).
-p(876) :- p(875), q(876).
-q(876) :- p(875), q(875).
-p(877) :- p(876), q(877).
-q(877) :- p(876), q(876).
-p(878) :- p(877), q(878).
-q(878) :- p(877), q(877).
-p(879) :- p(878), q(879).
-q(879) :- p(878), q(878).
-p(880) :- p(879), q(880).
-q(880) :- p(879), q(879).
-p(881) :- p(880), q(881).
-q(881) :- p(880), q(880).
-p(882) :- p(881), q(882).
-q(882) :- p(881), q(881).
-p(883) :- p(882), q(883).
-q(883) :- p(882), q(882).
-p(884) :- p(883), q(884).
-q(884) :- p(883), q(883).
-p(885) :- p(884), q(885).
-q(885) :- p(884), q(884).
-p(886) :- p(885), q(886).
-q(886) :- p(885), q(885).
-p(887) :- p(886), q(887).
-q(887) :- p(886), q(886).
-p(888) :- p(887), q(888).
-q(888) :- p(887), q(887).
-p(889) :- p(888), q(889).
-q(889) :- p(888), q(888).
-p(890) :- p(889), q(890).
-q(890) :- p(889), q(889).
-p(891) :- p(890), q(891).
-q(891) :- p(890), q(890).
-p(892) :- p(891), q(892).
-q(892) :- p(891), q(891).
-p(893) :- p(892), q(893).
-q(893) :- p(892), q(892).
-p(894) :- p(893), q(894).
-q(894) :- p(893), q(893).
-p(895) :- p(894), q(895).
-q(895) :- p(894), q(894).
-p(896) :- p(895), q(896).
-q(896) :- p(895), q(895).
-p(897) :- p(896), q(897).
-q(897) :- p(896), q(896).
-p(898) :- p(897), q(898).
-q(898) :- p(897), q(897).
-p(899) :- p(898), q(899).
-q(899) :- p(898), q(898).
-p(900) :- p(899), q(900).
-q(900) :- p(899), q(899).
-p(901) :- p(900), q(901).
-q(901) :- p(900), q(900).
-p(902) :- p(901), q(902).
-q(902) :- p(901), q(901).
-p(903) :- p(902), q(903).
-q(903) :- p(902), q(902).
-p(904) :- p(903), q(904).
-q(904) :- p(903), q(903).
-p(905) :- p(904), q(905).
-q(905) :- p(904), q(904).
-p(906) :- p(905), q(906).
-q(906) :- p(905), q(905).
-p(907) :- p(906), q(907).
-q(907) :- p(906), q(906).
-p(908) :- p(907), q(908).
-q(908) :- p(907), q(907).
-p(909) :- p(908), q(909).
-q(909) :- p(908), q(908).
-p(910) :- p(909), q(910).
-q(910) :- p(909), q(909).
-p(911) :- p(910), q(911).
-q(911) :- p(910), q(910).
-p(912) :- p(911), q(912).
-q(912) :- p(911), q(911).
-p(913) :- p(912), q(913).
-q(913) :- p(912), q(912).
-p(914) :- p(913), q(914).
-q(914) :- p(913), q(913).
-p(915) :- p(914), q(915).
-q(915) :- p(914), q(914).
-p(916) :- p(915), q(916).
-q(916) :- p(915), q(915).
-p(917) :- p(916), q(917).
-q(917) :- p(916), q(916).
-p(918) :- p(917), q(918).
-q(918) :- p(917), q(917).
-p(919) :- p(918), q(919).
-q(919) :- p(918), q(918).
-p(920) :- p(919), q(920).
-q(920) :- p(919), q(919).
-p(921) :- p(920), q(921).
-q(921) :- p(920), q(920).
-p(922) :- p(921), q(922).
-q(922) :- p(921), q(921).
-p(923) :- p(922), q(923).
-q(923) :- p(922), q(922).
-p(924) :- p(923), q(924).
-q(924) :- p(923), q(923).
-p(925) :- p(924), q(925).
-q(925) :- p(924), q(924).
-p(926) :- p(925), q(926).
-q(926) :- p(925), q(925).
-p(927) :- p(926), q(927).
-q(927) :- p(926), q(926).
-p(928) :- p(927), q(928).
-q(928) :- p(927), q(927).
-p(929) :- p(928), q(929).
-q(929) :- p(928), q(928).
-p(930) :- p(929), q(930).
-q(930) :- p(929), q(929).
-p(931) :- p(930), q(931).
-q(931) :- p(930), q(930).
-p(932) :- p(931), q(932).
-q(932) :- p(931), q(931).
-p(933) :- p(932), q(933).
-q(933) :- p(932), q(932).
-p(934) :- p(933), q(934).
-q(934) :- p(933), q(933).
-p(935) :- p(934), q(935).
-q(935) :- p(934), q(934).
-p(936) :- p(935), q(936).
-q(936) :- p(935), q(935).
-p(937) :- p(936), q(937).
-q(937) :- p(936), q(936).
-p(938) :- p(937), q(938).
-q(938) :- p(937), q(937).
-p(939) :- p(938), q(939).
-q(939) :- p(938), q(938).
-p(940) :- p(939), q(940).
-q(940) :- p(939), q(939).
-p(941) :- p(940), q(941).
-q(941) :- p(940), q(940).
-p(942) :- p(941), q(942).
-q(942) :- p(941), q(941).
-p(943) :- p(942), q(943).
-q(943) :- p(942), q(942).
-p(944) :- p(943), q(944).
-q(944) :- p(943), q(943).
-p(945) :- p(944), q(945).
-q(945) :- p(944), q(944).
-p(946) :- p(945), q(946).
-q(946) :- p(945), q(945).
-p(947) :- p(946), q(947).
-q(947) :- p(946), q(946).
-p(948) :- p(947), q(948).
-q(948) :- p(947), q(947).
-p(949) :- p(948), q(949).
-q(949) :- p(948), q(948).
-p(950) :- p(949), q(950).
-q(950) :- p(949), q(949).
-p(951) :- p(950), q(951).
-q(951) :- p(950), q(950).
-p(952) :- p(951), q(952).
-q(952) :- p(951), q(951).
-p(953) :- p(952), q(953).
-q(953) :- p(952), q(952).
-p(954) :- p(953), q(954).
-q(954) :- p(953), q(953).
-p(955) :- p(954), q(955).
-q(955) :- p(954), q(954).
-p(956) :- p(955), q(956).
-q(956) :- p(955), q(955).
-p(957) :- p(956), q(957).
-q(957) :- p(956), q(956).
-p(958) :- p(957), q(958).
-q(958) :- p(957), q(957).
-p(959) :- p(958), q(959).
-q(959) :- p(958), q(958).
-p(960) :- p(959), q(960).
-q(960) :- p(959), q(959).
-p(961) :- p(960), q(961).
-q(961) :- p(960), q(960).
-p(962) :- p(961), q(962).
-q(962) :- p(961), q(961).
-p(963) :- p(962), q(963).
-q(963) :- p(962), q(962).
-p(964) :- p(963), q(964).
-q(964) :- p(963), q(963).
-p(965) :- p(964), q(965).
-q(965) :- p(964), q(964).
-p(966) :- p(965), q(966).
-q(966) :- p(965), q(965).
-p(967) :- p(966), q(967).
-q(967) :- p(966), q(966).
-p(968) :- p(967), q(968).
-q(968) :- p(967), q(967).
-p(969) :- p(968), q(969).
-q(969) :- p(968), q(968).
-p(970) :- p(969), q(970).
-q(970) :- p(969), q(969).
-p(971) :- p(970), q(971).
-q(971) :- p(970), q(970).
-p(972) :- p(971), q(972).
-q(972) :- p(971), q(971).
-p(973) :- p(972), q(973).
-q(973) :- p(972), q(972).
-p(974) :- p(973), q(974).
-q(974) :- p(973), q(973).
-p(975) :- p(974), q(975).
-q(975) :- p(974), q(974).
-p(976) :- p(975), q(976).
-q(976) :- p(975), q(975).
-p(977) :- p(976), q(977).
-q(977) :- p(976), q(976).
-p(978) :- p(977), q(978).
-q(978) :- p(977), q(977).
-p(979) :- p(978), q(979).
-q(979) :- p(978), q(978).
-p(980) :- p(979), q(980).
-q(980) :- p(979), q(979).
-p(981) :- p(980), q(981).
-q(981) :- p(980), q(980).
-p(982) :- p(981), q(982).
-q(982) :- p(981), q(981).
-p(983) :- p(982), q(983).
-q(983) :- p(982), q(982).
-p(984) :- p(983), q(984).
-q(984) :- p(983), q(983).
-p(985) :- p(984), q(985).
-q(985) :- p(984), q(984).
-p(986) :- p(985), q(986).
-q(986) :- p(985), q(985).
-p(987) :- p(986), q(987).
-q(987) :- p(986), q(986).
-p(988) :- p(987), q(988).
-q(988) :- p(987), q(987).
-p(989) :- p(988), q(989).
-q(989) :- p(988), q(988).
-p(990) :- p(989), q(990).
-q(990) :- p(989), q(989).
-p(991) :- p(990), q(991).
-q(991) :- p(990), q(990).
-p(992) :- p(991), q(992).
-q(992) :- p(991), q(991).
-p(993) :- p(992), q(993).
-q(993) :- p(992), q(992).
-p(994) :- p(993), q(994).
-q(994) :- p(993), q(993).
-p(995) :- p(994), q(995).
-q(995) :- p(994), q(994).
-p(996) :- p(995), q(996).
-q(996) :- p(995), q(995).
-p(997) :- p(996), q(997).
-q(997) :- p(996), q(996).
-p(998) :- p(997), q(998).
-q(998) :- p(997), q(997).
-p(999) :- p(998), q(999).
-q(999) :- p(998), q(998).
-p(1000) :- p(999), q(1000).
-q(1000) :- p(999), q(999).
-p(1001) :- p(1000), q(1001).
-q(1001) :- p(1000), q(1000).
-p(1002) :- p(1001), q(1002).
-q(1002) :- p(1001), q(1001).
-p(1003) :- p(1002), q(1003).
-q(1003) :- p(1002), q(1002).
-p(1004) :- p(1003), q(1004).
-q(1004) :- p(1003), q(1003).
-p(1005) :- p(1004), q(1005).
-q(1005) :- p(1004), q(1004).
-p(1006) :- p(1005), q(1006).
-q(1006) :- p(1005), q(1005).
-p(1007) :- p(1006), q(1007).
-q(1007) :- p(1006), q(1006).
-p(1008) :- p(1007), q(1008).
-q(1008) :- p(1007), q(1007).
-p(1009) :- p(1008), q(1009).
-q(1009) :- p(1008), q(1008).
-p(1010) :- p(1009), q(1010).
-q(1010) :- p(1009), q(1009).
-p(1011) :- p(1010), q(1011).
-q(1011) :- p(1010), q(1010).
-p(1012) :- p(1011), q(1012).
-q(1012) :- p(1011), q(1011).
-p(1013) :- p(1012), q(1013).
-q(1013) :- p(1012), q(1012).
-p(1014) :- p(1013), q(1014).
-q(1014) :- p(1013), q(1013).
-p(1015) :- p(1014), q(1015).
-q(1015) :- p(1014), q(1014).
-p(1016) :- p(1015), q(1016).
-q(1016) :- p(1015), q(1015).
-p(1017) :- p(1016), q(1017).
-q(1017) :- p(1016), q(1016).
-p(1018) :- p(1017), q(1018).
-q(1018) :- p(1017), q(1017).
-p(1019) :- p(1018), q(1019).
-q(1019) :- p(1018), q(1018).
-p(1020) :- p(1019), q(1020).
-q(1020) :- p(1019), q(1019).
-p(1021) :- p(1020), q(1021).
-q(1021) :- p(1020), q(1020).
-p(1022) :- p(1021), q(1022).
-q(1022) :- p(1021), q(1021).
-p(1023) :- p(1022), q(1023).
-q(1023) :- p(1022), q(1022).
-p(1024) :- p(1023), q(1024).
-q(1024) :- p(1023), q(1023).
-p(1025) :- p(1024), q(1025).
-q(1025) :- p(1024), q(1024).
-p(1026) :- p(1025), q(1026).
-q(1026) :- p(1025), q(1025).
-p(1027) :- p(1026), q(1027).
-q(1027) :- p(1026), q(1026).
-p(1028) :- p(1027), q(1028).
-q(1028) :- p(1027), q(1027).
-p(1029) :- p(1028), q(1029).
-q(1029) :- p(1028), q(1028).
-p(1030) :- p(1029), q(1030).
-q(1030) :- p(1029), q(1029).
-p(1031) :- p(1030), q(1031).
-q(1031) :- p(1030), q(1030).
-p(1032) :- p(1031), q(1032).
-q(1032) :- p(1031), q(1031).
-p(1033) :- p(1032), q(1033).
-q(1033) :- p(1032), q(1032).
-p(1034) :- p(1033), q(1034).
-q(1034) :- p(1033), q(1033).
-p(1035) :- p(1034), q(1035).
-q(1035) :- p(1034), q(1034).
-p(1036) :- p(1035), q(1036).
-q(1036) :- p(1035), q(1035).
-p(1037) :- p(1036), q(1037).
-q(1037) :- p(1036), q(1036).
-p(1038) :- p(1037), q(1038).
-q(1038) :- p(1037), q(1037).
-p(1039) :- p(1038), q(1039).
-q(1039) :- p(1038), q(1038).
-p(1040) :- p(1039), q(1040).
-q(1040) :- p(1039), q(1039).
-p(1041) :- p(1040), q(1041).
-q(1041) :- p(1040), q(1040).
-p(1042) :- p(1041), q(1042).
-q(1042) :- p(1041), q(1041).
-p(1043) :- p(1042), q(1043).
-q(1043) :- p(1042), q(1042).
-p(1044) :- p(1043), q(1044).
-q(1044) :- p(1043), q(1043).
-p(1045) :- p(1044), q(1045).
-q(1045) :- p(1044), q(1044).
-p(1046) :- p(1045), q(1046).
-q(1046) :- p(1045), q(1045).
-p(1047) :- p(1046), q(1047).
-q(1047) :- p(1046), q(1046).
-p(1048) :- p(1047), q(1048).
-q(1048) :- p(1047), q(1047).
-p(1049) :- p(1048), q(1049).
-q(1049) :- p(1048), q(1048).
-p(1050) :- p(1049), q(1050).
-q(1050) :- p(1049), q(1049).
-p(1051) :- p(1050), q(1051).
-q(1051) :- p(1050), q(1050).
-p(1052) :- p(1051), q(1052).
-q(1052) :- p(1051), q(1051).
-p(1053) :- p(1052), q(1053).
-q(1053) :- p(1052), q(1052).
-p(1054) :- p(1053), q(1054).
-q(1054) :- p(1053), q(1053).
-p(1055) :- p(1054), q(1055).
-q(1055) :- p(1054), q(1054).
-p(1056) :- p(1055), q(1056).
-q(1056) :- p(1055), q(1055).
-p(1057) :- p(1056), q(1057).
-q(1057) :- p(1056), q(1056).
-p(1058) :- p(1057), q(1058).
-q(1058) :- p(1057), q(1057).
-p(1059) :- p(1058), q(1059).
-q(1059) :- p(1058), q(1058).
-p(1060) :- p(1059), q(1060).
-q(1060) :- p(1059), q(1059).
-p(1061) :- p(1060), q(1061).
-q(1061) :- p(1060), q(1060).
-p(1062) :- p(1061), q(1062).
-q(1062) :- p(1061), q(1061).
-p(1063) :- p(1062), q(1063).
-q(1063) :- p(1062), q(1062).
-p(1064) :- p(1063), q(1064).
-q(1064) :- p(1063), q(1063).
-p(1065) :- p(1064), q(1065).
-q(1065) :- p(1064), q(1064).
-p(1066) :- p(1065), q(1066).
-q(1066) :- p(1065), q(1065).
-p(1067) :- p(1066), q(1067).
-q(1067) :- p(1066), q(1066).
-p(1068) :- p(1067), q(1068).
-q(1068) :- p(1067), q(1067).
-p(1069) :- p(1068), q(1069).
-q(1069) :- p(1068), q(1068).
-p(1070) :- p(1069), q(1070).
-q(1070) :- p(1069), q(1069).
-p(1071) :- p(1070), q(1071).
-q(1071) :- p(1070), q(1070).
-p(1072) :- p(1071), q(1072).
-q(1072) :- p(1071), q(1071).
-p(1073) :- p(1072), q(1073).
-q(1073) :- p(1072), q(1072).
-p(1074) :- p(1073), q(1074).
-q(1074) :- p(1073), q(1073).
-p(1075) :- p(1074), q(1075).
-q(1075) :- p(1074), q(1074).
-p(1076) :- p(1075), q(1076).
-q(1076) :- p(1075), q(1075).
-p(1077) :- p(1076), q(1077).
-q(1077) :- p(1076), q(1076).
-p(1078) :- p(1077), q(1078).
-q(1078) :- p(1077), q(1077).
-p(1079) :- p(1078), q(1079).
-q(1079) :- p(1078), q(1078).
-p(1080) :- p(1079), q(1080).
-q(1080) :- p(1079), q(1079).
-p(1081) :- p(1080), q(1081).
-q(1081) :- p(1080), q(1080).
-p(1082) :- p(1081), q(1082).
-q(1082) :- p(1081), q(1081).
-p(1083) :- p(1082), q(1083).
-q(1083) :- p(1082), q(1082).
-p(1084) :- p(1083), q(1084).
-q(1084) :- p(1083), q(1083).
-p(1085) :- p(1084), q(1085).
-q(1085) :- p(1084), q(1084).
-p(1086) :- p(1085), q(1086).
-q(1086) :- p(1085), q(1085).
-p(1087) :- p(1086), q(1087).
-q(1087) :- p(1086), q(1086).
-p(1088) :- p(1087), q(1088).
-q(1088) :- p(1087), q(1087).
-p(1089) :- p(1088), q(1089).
-q(1089) :- p(1088), q(1088).
-p(1090) :- p(1089), q(1090).
-q(1090) :- p(1089), q(1089).
-p(1091) :- p(1090), q(1091).
-q(1091) :- p(1090), q(1090).
-p(1092) :- p(1091), q(1092).
-q(1092) :- p(1091), q(1091).
-p(1093) :- p(1092), q(1093).
-q(1093) :- p(1092), q(1092).
-p(1094) :- p(1093), q(1094).
-q(1094) :- p(1093), q(1093).
-p(1095) :- p(1094), q(1095).
-q(1095) :- p(1094), q(1094).
-p(1096) :- p(1095), q(1096).
-q(1096) :- p(1095), q(1095).
-p(1097) :- p(1096), q(1097).
-q(1097) :- p(1096), q(1096).
-p(1098) :- p(1097), q(1098).
-q(1098) :- p(1097), q(1097).
-p(1099) :- p(1098), q(1099).
-q(1099) :- p(1098), q(1098).
-p(1100) :- p(1099), q(1100).
-q(1100) :- p(1099), q(1099).
-p(1101) :- p(1100), q(1101).
-q(1101) :- p(1100), q(1100).
-p(1102) :- p(1101), q(1102).
-q(1102) :- p(1101), q(1101).
-p(1103) :- p(1102), q(1103).
-q(1103) :- p(1102), q(1102).
-p(1104) :- p(1103), q(1104).
-q(1104) :- p(1103), q(1103).
-p(1105) :- p(1104), q(1105).
-q(1105) :- p(1104), q(1104).
-p(1106) :- p(1105), q(1106).
-q(1106) :- p(1105), q(1105).
-p(1107) :- p(1106), q(1107).
-q(1107) :- p(1106), q(1106).
-p(1108) :- p(1107), q(1108).
-q(1108) :- p(1107), q(1107).
-p(1109) :- p(1108), q(1109).
-q(1109) :- p(1108), q(1108).
-p(1110) :- p(1109), q(1110).
-q(1110) :- p(1109), q(1109).
-p(1111) :- p(1110), q(1111).
-q(1111) :- p(1110), q(1110).
-p(1112) :- p(1111), q(1112).
-q(1112) :- p(1111), q(1111).
-p(1113) :- p(1112), q(1113).
-q(1113) :- p(1112), q(1112).
-p(1114) :- p(1113), q(1114).
-q(1114) :- p(1113), q(1113).
-p(1115) :- p(1114), q(1115).
-q(1115) :- p(1114), q(1114).
-p(1116) :- p(1115), q(1116).
-q(1116) :- p(1115), q(1115).
-p(1117) :- p(1116), q(1117).
-q(1117) :- p(1116), q(1116).
-p(1118) :- p(1117), q(1118).
-q(1118) :- p(1117), q(1117).
-p(1119) :- p(1118), q(1119).
-q(1119) :- p(1118), q(1118).
-p(1120) :- p(1119), q(1120).
-q(1120) :- p(1119), q(1119).
-p(1121) :- p(1120), q(1121).
-q(1121) :- p(1120), q(1120).
-p(1122) :- p(1121), q(1122).
-q(1122) :- p(1121), q(1121).
-p(1123) :- p(1122), q(1123).
-q(1123) :- p(1122), q(1122).
-p(1124) :- p(1123), q(1124).
-q(1124) :- p(1123), q(1123).
-p(1125) :- p(1124), q(1125).
-q(1125) :- p(1124), q(1124).
-p(1126) :- p(1125), q(1126).
-q(1126) :- p(1125), q(1125).
-p(1127) :- p(1126), q(1127).
-q(1127) :- p(1126), q(1126).
-p(1128) :- p(1127), q(1128).
-q(1128) :- p(1127), q(1127).
-p(1129) :- p(1128), q(1129).
-q(1129) :- p(1128), q(1128).
-p(1130) :- p(1129), q(1130).
-q(1130) :- p(1129), q(1129).
-p(1131) :- p(1130), q(1131).
-q(1131) :- p(1130), q(1130).
-p(1132) :- p(1131), q(1132).
-q(1132) :- p(1131), q(1131).
-p(1133) :- p(1132), q(1133).
-q(1133) :- p(1132), q(1132).
-p(1134) :- p(1133), q(1134).
-q(1134) :- p(1133), q(1133).
-p(1135) :- p(1134), q(1135).
-q(1135) :- p(1134), q(1134).
-p(1136) :- p(1135), q(1136).
-q(1136) :- p(1135), q(1135).
-p(1137) :- p(1136), q(1137).
-q(1137) :- p(1136), q(1136).
-p(1138) :- p(1137), q(1138).
-q(1138) :- p(1137), q(1137).
-p(1139) :- p(1138), q(1139).
-q(1139) :- p(1138), q(1138).
-p(1140) :- p(1139), q(1140).
-q(1140) :- p(1139), q(1139).
-p(1141) :- p(1140), q(1141).
-q(1141) :- p(1140), q(1140).
-p(1142) :- p(1141), q(1142).
-q(1142) :- p(1141), q(1141).
-p(1143) :- p(1142), q(1143).
-q(1143) :- p(1142), q(1142).
-p(1144) :- p(1143), q(1144).
-q(1144) :- p(1143), q(1143).
-p(1145) :- p(1144), q(1145).
-q(1145) :- p(1144), q(1144).
-p(1146) :- p(1145), q(1146).
-q(1146) :- p(1145), q(1145).
-p(1147) :- p(1146), q(1147).
-q(1147) :- p(1146), q(1146).
-p(1148) :- p(1147), q(1148).
-q(1148) :- p(1147), q(1147).
-p(1149) :- p(1148), q(1149).
-q(1149) :- p(1148), q(1148).
-p(1150) :- p(1149), q(1150).
-q(1150) :- p(1149), q(1149).
-p(1151) :- p(1150), q(1151).
-q(1151) :- p(1150), q(1150).
-p(1152) :- p(1151), q(1152).
-q(1152) :- p(1151), q(1151).
-p(1153) :- p(1152), q(1153).
-q(1153) :- p(1152), q(1152).
-p(1154) :- p(1153), q(1154).
-q(1154) :- p(1153), q(1153).
-p(1155) :- p(1154), q(1155).
-q(1155) :- p(1154), q(1154).
-p(1156) :- p(1155), q(1156).
-q(1156) :- p(1155), q(1155).
-p(1157) :- p(1156), q(1157).
-q(1157) :- p(1156), q(1156).
-p(1158) :- p(1157), q(1158).
-q(1158) :- p(1157), q(1157).
-p(1159) :- p(1158), q(1159).
-q(1159) :- p(1158), q(1158).
-p(1160) :- p(1159), q(1160).
-q(1160) :- p(1159), q(1159).
-p(1161) :- p(1160), q(1161).
-q(1161) :- p(1160), q(1160).
-p(1162) :- p(1161), q(1162).
-q(1162) :- p(1161), q(1161).
-p(1163) :- p(1162), q(1163).
-q(1163) :- p(1162), q(1162).
-p(1164) :- p(1163), q(1164).
-q(1164) :- p(1163), q(1163).
-p(1165) :- p(1164), q(1165).
-q(1165) :- p(1164), q(1164).
-p(1166) :- p(1165), q(1166).
-q(1166) :- p(1165), q(1165).
-p(1167) :- p(1166), q(1167).
-q(1167) :- p(1166), q(1166).
-p(1168) :- p(1167), q(1168).
-q(1168) :- p(1167), q(1167).
-p(1169) :- p(1168), q(1169).
-q(1169) :- p(1168), q(1168).
-p(1170) :- p(1169), q(1170).
-q(1170) :- p(1169), q(1169).
-p(1171) :- p(1170), q(1171).
-q(1171) :- p(1170), q(1170).
-p(1172) :- p(1171), q(1172).
-q(1172) :- p(1171), q(1171).
-p(1173) :- p(1172), q(1173).
-q(1173) :- p(1172), q(1172).
-p(1174) :- p(1173), q(1174).
-q(1174) :- p(1173), q(1173).
-p(1175) :- p(1174), q(1175).
-q(1175) :- p(1174), q(1174).
-p(1176) :- p(1175), q(1176).
-q(1176) :- p(1175), q(1175).
-p(1177) :- p(1176), q(1177).
-q(1177) :- p(1176), q(1176).
-p(1178) :- p(1177), q(1178).
-q(1178) :- p(1177), q(1177).
-p(1179) :- p(1178), q(1179).
-q(1179) :- p(1178), q(1178).
-p(1180) :- p(1179), q(1180).
-q(1180) :- p(1179), q(1179).
-p(1181) :- p(1180), q(1181).
-q(1181) :- p(1180), q(1180).
-p(1182) :- p(1181), q(1182).
-q(1182) :- p(1181), q(1181).
-p(1183) :- p(1182), q(1183).
-q(1183) :- p(1182), q(1182).
-p(1184) :- p(1183), q(1184).
-q(1184) :- p(1183), q(1183).
-p(1185) :- p(1184), q(1185).
-q(1185) :- p(1184), q(1184).
-p(1186) :- p(1185), q(1186).
-q(1186) :- p(1185), q(1185).
-p(1187) :- p(1186), q(1187).
-q(1187) :- p(1186), q(1186).
-p(1188) :- p(1187), q(1188).
-q(1188) :- p(1187), q(1187).
-p(1189) :- p(1188), q(1189).
-q(1189) :- p(1188), q(1188).
-p(1190) :- p(1189), q(1190).
-q(1190) :- p(1189), q(1189).
-p(1191) :- p(1190), q(1191).
-q(1191) :- p(1190), q(1190).
-p(1192) :- p(1191), q(1192).
-q(1192) :- p(1191), q(1191).
-p(1193) :- p(1192), q(1193).
-q(1193) :- p(1192), q(1192).
-p(1194) :- p(1193), q(1194).
-q(1194) :- p(1193), q(1193).
-p(1195) :- p(1194), q(1195).
-q(1195) :- p(1194), q(1194).
-p(1196) :- p(1195), q(1196).
-q(1196) :- p(1195), q(1195).
-p(1197) :- p(1196), q(1197).
-q(1197) :- p(1196), q(1196).
-p(1198) :- p(1197), q(1198).
-q(1198) :- p(1197), q(1197).
-p(1199) :- p(1198), q(1199).
-q(1199) :- p(1198), q(1198).
-p(1200) :- p(1199), q(1200).
-q(1200) :- p(1199), q(1199).
-p(1201) :- p(1200), q(1201).
-q(1201) :- p(1200), q(1200).
-p(1202) :- p(1201), q(1202).
-q(1202) :- p(1201), q(1201).
-p(1203) :- p(1202), q(1203).
-q(1203) :- p(1202), q(1202).
-p(1204) :- p(1203), q(1204).
-q(1204) :- p(1203), q(1203).
-p(1205) :- p(1204), q(1205).
-q(1205) :- p(1204), q(1204).
-p(1206) :- p(1205), q(1206).
-q(1206) :- p(1205), q(1205).
-p(1207) :- p(1206), q(1207).
-q(1207) :- p(1206), q(1206).
-p(1208) :- p(1207), q(1208).
-q(1208) :- p(1207), q(1207).
-p(1209) :- p(1208), q(1209).
-q(1209) :- p(1208), q(1208).
-p(1210) :- p(1209), q(1210).
-q(1210) :- p(1209), q(1209).
-p(1211) :- p(1210), q(1211).
-q(1211) :- p(1210), q(1210).
-p(1212) :- p(1211), q(1212).
-q(1212) :- p(1211), q(1211).
-p(1213) :- p(1212), q(1213).
-q(1213) :- p(1212), q(1212).
-p(1214) :- p(1213), q(1214).
-q(1214) :- p(1213), q(1213).
-p(1215) :- p(1214), q(1215).
-q(1215) :- p(1214), q(1214).
-p(1216) :- p(1215), q(1216).
-q(1216) :- p(1215), q(1215).
-p(1217) :- p(1216), q(1217).
-q(1217) :- p(1216), q(1216).
-p(1218) :- p(1217), q(1218).
-q(1218) :- p(1217), q(1217).
-p(1219) :- p(1218), q(1219).
-q(1219) :- p(1218), q(1218).
-p(1220) :- p(1219), q(1220).
-q(1220) :- p(1219), q(1219).
-p(1221) :- p(1220), q(1221).
-q(1221) :- p(1220), q(1220).
-p(1222) :- p(1221), q(1222).
-q(1222) :- p(1221), q(1221).
-p(1223) :- p(1222), q(1223).
-q(1223) :- p(1222), q(1222).
-p(1224) :- p(1223), q(1224).
-q(1224) :- p(1223), q(1223).
-p(1225) :- p(1224), q(1225).
-q(1225) :- p(1224), q(1224).
-p(1226) :- p(1225), q(1226).
-q(1226) :- p(1225), q(1225).
-p(1227) :- p(1226), q(1227).
-q(1227) :- p(1226), q(1226).
-p(1228) :- p(1227), q(1228).
-q(1228) :- p(1227), q(1227).
-p(1229) :- p(1228), q(1229).
-q(1229) :- p(1228), q(1228).
-p(1230) :- p(1229), q(1230).
-q(1230) :- p(1229), q(1229).
-p(1231) :- p(1230), q(1231).
-q(1231) :- p(1230), q(1230).
-p(1232) :- p(1231), q(1232).
-q(1232) :- p(1231), q(1231).
-p(1233) :- p(1232), q(1233).
-q(1233) :- p(1232), q(1232).
-p(1234) :- p(1233), q(1234).
-q(1234) :- p(1233), q(1233).
-p(1235) :- p(1234), q(1235).
-q(1235) :- p(1234), q(1234).
-p(1236) :- p(1235), q(1236).
-q(1236) :- p(1235), q(1235).
-p(1237) :- p(1236), q(1237).
-q(1237) :- p(1236), q(1236).
-p(1238) :- p(1237), q(1238).
-q(1238) :- p(1237), q(1237).
-p(1239) :- p(1238), q(1239).
-q(1239) :- p(1238), q(1238).
-p(1240) :- p(1239), q(1240).
-q(1240) :- p(1239), q(1239).
-p(1241) :- p(1240), q(1241).
-q(1241) :- p(1240), q(1240).
-p(1242) :- p(1241), q(1242).
-q(1242) :- p(1241), q(1241).
-p(1243) :- p(1242), q(1243).
-q(1243) :- p(1242), q(1242).
-p(1244) :- p(1243), q(1244).
-q(1244) :- p(1243), q(1243).
-p(1245) :- p(1244), q(1245).
-q(1245) :- p(1244), q(1244).
-p(1246) :- p(1245), q(1246).
-q(1246) :- p(1245), q(1245).
-p(1247) :- p(1246), q(1247).
-q(1247) :- p(1246), q(1246).
-p(1248) :- p(1247), q(1248).
-q(1248) :- p(1247), q(1247).
-p(1249) :- p(1248), q(1249).
-q(1249) :- p(1248), q(1248).
-p(1250) :- p(1249), q(1250).
-q(1250) :- p(1249), q(1249).
-p(1251) :- p(1250), q(1251).
-q(1251) :- p(1250), q(1250).
-p(1252) :- p(1251), q(1252).
-q(1252) :- p(1251), q(1251).
-p(1253) :- p(1252), q(1253).
-q(1253) :- p(1252), q(1252).
-p(1254) :- p(1253), q(1254).
-q(1254) :- p(1253), q(1253).
-p(1255) :- p(1254), q(1255).
-q(1255) :- p(1254), q(1254).
-p(1256) :- p(1255), q(1256).
-q(1256) :- p(1255), q(1255).
-p(1257) :- p(1256), q(1257).
-q(1257) :- p(1256), q(1256).
-p(1258) :- p(1257), q(1258).
-q(1258) :- p(1257), q(1257).
-p(1259) :- p(1258), q(1259).
-q(1259) :- p(1258), q(1258).
-p(1260) :- p(1259), q(1260).
-q(1260) :- p(1259), q(1259).
-p(1261) :- p(1260), q(1261).
-q(1261) :- p(1260), q(1260).
-p(1262) :- p(1261), q(1262).
-q(1262) :- p(1261), q(1261).
-p(1263) :- p(1262), q(1263).
-q(1263) :- p(1262), q(1262).
-p(1264) :- p(1263), q(1264).
-q(1264) :- p(1263), q(1263).
-p(1265) :- p(1264), q(1265).
-q(1265) :- p(1264), q(1264).
-p(1266) :- p(1265), q(1266).
-q(1266) :- p(1265), q(1265).
-p(1267) :- p(1266), q(1267).
-q(1267) :- p(1266), q(1266).
-p(1268) :- p(1267), q(1268).
-q(1268) :- p(1267), q(1267).
-p(1269) :- p(1268), q(1269).
-q(1269) :- p(1268), q(1268).
-p(1270) :- p(1269), q(1270).
-q(1270) :- p(1269), q(1269).
-p(1271) :- p(1270), q(1271).
-q(1271) :- p(1270), q(1270).
-p(1272) :- p(1271), q(1272).
-q(1272) :- p(1271), q(1271).
-p(1273) :- p(1272), q(1273).
-q(1273) :- p(1272), q(1272).
-p(1274) :- p(1273), q(1274).
-q(1274) :- p(1273), q(1273).
-p(1275) :- p(1274), q(1275).
-q(1275) :- p(1274), q(1274).
-p(1276) :- p(1275), q(1276).
-q(1276) :- p(1275), q(1275).
-p(1277) :- p(1276), q(1277).
-q(1277) :- p(1276), q(1276).
-p(1278) :- p(1277), q(1278).
-q(1278) :- p(1277), q(1277).
-p(1279) :- p(1278), q(1279).
-q(1279) :- p(1278), q(1278).
-p(1280) :- p(1279), q(1280).
-q(1280) :- p(1279), q(1279).
-p(1281) :- p(1280), q(1281).
-q(1281) :- p(1280), q(1280).
-p(1282) :- p(1281), q(1282).
-q(1282) :- p(1281), q(1281).
-p(1283) :- p(1282), q(1283).
-q(1283) :- p(1282), q(1282).
-p(1284) :- p(1283), q(1284).
-q(1284) :- p(1283), q(1283).
-p(1285) :- p(1284), q(1285).
-q(1285) :- p(1284), q(1284).
-p(1286) :- p(1285), q(1286).
-q(1286) :- p(1285), q(1285).
-p(1287) :- p(1286), q(1287).
-q(1287) :- p(1286), q(1286).
-p(1288) :- p(1287), q(1288).
-q(1288) :- p(1287), q(1287).
-p(1289) :- p(1288), q(1289).
-q(1289) :- p(1288), q(1288).
-p(1290) :- p(1289), q(1290).
-q(1290) :- p(1289), q(1289).
-p(1291) :- p(1290), q(1291).
-q(1291) :- p(1290), q(1290).
-p(1292) :- p(1291), q(1292).
-q(1292) :- p(1291), q(1291).
-p(1293) :- p(1292), q(1293).
-q(1293) :- p(1292), q(1292).
-p(1294) :- p(1293), q(1294).
-q(1294) :- p(1293), q(1293).
-p(1295) :- p(1294), q(1295).
-q(1295) :- p(1294), q(1294).
-p(1296) :- p(1295), q(1296).
-q(1296) :- p(1295), q(1295).
-p(1297) :- p(1296), q(1297).
-q(1297) :- p(1296), q(1296).
-p(1298) :- p(1297), q(1298).
-q(1298) :- p(1297), q(1297).
-p(1299) :- p(1298), q(1299).
-q(1299) :- p(1298), q(1298).
-p(1300) :- p(1299), q(1300).
-q(1300) :- p(1299), q(1299).
-p(1301) :- p(1300), q(1301).
-q(1301) :- p(1300), q(1300).
-p(1302) :- p(1301), q(1302).
-q(1302) :- p(1301), q(1301).
-p(1303) :- p(1302), q(1303).
-q(1303) :- p(1302), q(1302).
-p(1304) :- p(1303), q(1304).
-q(1304) :- p(1303), q(1303).
-p(1305) :- p(1304), q(1305).
-q(1305) :- p(1304), q(1304).
-p(1306) :- p(1305), q(1306).
-q(1306) :- p(1305), q(1305).
-p(1307) :- p(1306), q(1307).
-q(1307) :- p(1306), q(1306).
-p(1308) :- p(1307), q(1308).
-q(1308) :- p(1307), q(1307).
-p(1309) :- p(1308), q(1309).
-q(1309) :- p(1308), q(1308).
-p(1310) :- p(1309), q(1310).
-q(1310) :- p(1309), q(1309).
-p(1311) :- p(1310), q(1311).
-q(1311) :- p(1310), q(1310).
-p(1312) :- p(1311), q(1312).
-q(1312) :- p(1311), q(1311).
-p(1313) :- p(1312), q(1313).
-q(1313) :- p(1312), q(1312).
-p(1314) :- p(1313), q(1314).
-q(1314) :- p(1313), q(1313).
-p(1315) :- p(1314), q(1315).
-q(1315) :- p(1314), q(1314).
-p(1316) :- p(1315), q(1316).
-q(1316) :- p(1315), q(1315).
-p(1317) :- p(1316), q(1317).
-q(1317) :- p(1316), q(1316).
-p(1318) :- p(1317), q(1318).
-q(1318) :- p(1317), q(1317).
-p(1319) :- p(1318), q(1319).
-q(1319) :- p(1318), q(1318).
-p(1320) :- p(1319), q(1320).
-q(1320) :- p(1319), q(1319).
-p(1321) :- p(1320), q(1321).
-q(1321) :- p(1320), q(1320).
-p(1322) :- p(1321), q(1322).
-q(1322) :- p(1321), q(1321).
-p(1323) :- p(1322), q(1323).
-q(1323) :- p(1322), q(1322).
-p(1324) :- p(1323), q(1324).
-q(1324) :- p(1323), q(1323).
-p(1325) :- p(1324), q(1325).
-q(1325) :- p(1324), q(1324).
-p(1326) :- p(1325), q(1326).
-q(1326) :- p(1325), q(1325).
-p(1327) :- p(1326), q(1327).
-q(1327) :- p(1326), q(1326).
-p(1328) :- p(1327), q(1328).
-q(1328) :- p(1327), q(1327).
-p(1329) :- p(1328), q(1329).
-q(1329) :- p(1328), q(1328).
-p(1330) :- p(1329), q(1330).
-q(1330) :- p(1329), q(1329).
-p(1331) :- p(1330), q(1331).
-q(1331) :- p(1330), q(1330).
-p(1332) :- p(1331), q(1332).
-q(1332) :- p(1331), q(1331).
-p(1333) :- p(1332), q(1333).
-q(1333) :- p(1332), q(1332).
-p(1334) :- p(1333), q(1334).
-q(1334) :- p(1333), q(1333).
-p(1335) :- p(1334), q(1335).
-q(1335) :- p(1334), q(1334).
-p(1336) :- p(1335), q(1336).
-q(1336) :- p(1335), q(1335).
-p(1337) :- p(1336), q(1337).
-q(1337) :- p(1336), q(1336).
-p(1338) :- p(1337), q(1338).
-q(1338) :- p(1337), q(1337).
-p(1339) :- p(1338), q(1339).
-q(1339) :- p(1338), q(1338).
-p(1340) :- p(1339), q(1340).
-q(1340) :- p(1339), q(1339).
-p(1341) :- p(1340), q(1341).
-q(1341) :- p(1340), q(1340).
-p(1342) :- p(1341), q(1342).
-q(1342) :- p(1341), q(1341).
-p(1343) :- p(1342), q(1343).
-q(1343) :- p(1342), q(1342).
-p(1344) :- p(1343), q(1344).
-q(1344) :- p(1343), q(1343).
-p(1345) :- p(1344), q(1345).
-q(1345) :- p(1344), q(1344).
-p(1346) :- p(1345), q(1346).
-q(1346) :- p(1345), q(1345).
-p(1347) :- p(1346), q(1347).
-q(1347) :- p(1346), q(1346).
-p(1348) :- p(1347), q(1348).
-q(1348) :- p(1347), q(1347).
-p(1349) :- p(1348), q(1349).
-q(1349) :- p(1348), q(1348).
-p(1350) :- p(1349), q(1350).
-q(1350) :- p(1349), q(1349).
-p(1351) :- p(1350), q(1351).
-q(1351) :- p(1350), q(1350).
-p(1352) :- p(1351), q(1352).
-q(1352) :- p(1351), q(1351).
-p(1353) :- p(1352), q(1353).
-q(1353) :- p(1352), q(1352).
-p(1354) :- p(1353), q(1354).
-q(1354) :- p(1353), q(1353).
-p(1355) :- p(1354), q(1355).
-q(1355) :- p(1354), q(1354).
-p(1356) :- p(1355), q(1356).
-q(1356) :- p(1355), q(1355).
-p(1357) :- p(1356), q(1357).
-q(1357) :- p(1356), q(1356).
-p(1358) :- p(1357), q(1358).
-q(1358) :- p(1357), q(1357).
-p(1359) :- p(1358), q(1359).
-q(1359) :- p(1358), q(1358).
-p(1360) :- p(1359), q(1360).
-q(1360) :- p(1359), q(1359).
-p(1361) :- p(1360), q(1361).
-q(1361) :- p(1360), q(1360).
-p(1362) :- p(1361), q(1362).
-q(1362) :- p(1361), q(1361).
-p(1363) :- p(1362), q(1363).
-q(1363) :- p(1362), q(1362).
-p(1364) :- p(1363), q(1364).
-q(1364) :- p(1363), q(1363).
-p(1365) :- p(1364), q(1365).
-q(1365) :- p(1364), q(1364).
-p(1366) :- p(1365), q(1366).
-q(1366) :- p(1365), q(1365).
-p(1367) :- p(1366), q(1367).
-q(1367) :- p(1366), q(1366).
-p(1368) :- p(1367), q(1368).
-q(1368) :- p(1367), q(1367).
-p(1369) :- p(1368), q(1369).
-q(1369) :- p(1368), q(1368).
-p(1370) :- p(1369), q(1370).
-q(1370) :- p(1369), q(1369).
-p(1371) :- p(1370), q(1371).
-q(1371) :- p(1370), q(1370).
-p(1372) :- p(1371), q(1372).
-q(1372) :- p(1371), q(1371).
-p(1373) :- p(1372), q(1373).
-q(1373) :- p(1372), q(1372).
-p(1374) :- p(1373), q(1374).
-q(1374) :- p(1373), q(1373).
-p(1375) :- p(1374), q(1375).
-q(1375) :- p(1374), q(1374).
-p(1376) :- p(1375), q(1376).
-q(1376) :- p(1375), q(1375).
-p(1377) :- p(1376), q(1377).
-q(1377) :- p(1376), q(1376).
-p(1378) :- p(1377), q(1378).
-q(1378) :- p(1377), q(1377).
-p(1379) :- p(1378), q(1379).
-q(1379) :- p(1378), q(1378).
-p(1380) :- p(1379), q(1380).
-q(1380) :- p(1379), q(1379).
-p(1381) :- p(1380), q(1381).
-q(1381) :- p(1380), q(1380).
-p(1382) :- p(1381), q(1382).
-q(1382) :- p(1381), q(1381).
-p(1383) :- p(1382), q(1383).
-q(1383) :- p(1382), q(1382).
-p(1384) :- p(1383), q(1384).
-q(1384) :- p(1383), q(1383).
-p(1385) :- p(1384), q(1385).
-q(1385) :- p(1384), q(1384).
-p(1386) :- p(1385), q(1386).
-q(1386) :- p(1385), q(1385).
-p(1387) :- p(1386), q(1387).
-q(1387) :- p(1386), q(1386).
-p(1388) :- p(1387), q(1388).
-q(1388) :- p(1387), q(1387).
-p(1389) :- p(1388), q(1389).
-q(1389) :- p(1388), q(1388).
-p(1390) :- p(1389), q(1390).
-q(1390) :- p(1389), q(1389).
-p(1391) :- p(1390), q(1391).
-q(1391) :- p(1390), q(1390).
-p(1392) :- p(1391), q(1392).
-q(1392) :- p(1391), q(1391).
-p(1393) :- p(1392), q(1393).
-q(1393) :- p(1392), q(1392).
-p(1394) :- p(1393), q(1394).
-q(1394) :- p(1393), q(1393).
-p(1395) :- p(1394), q(1395).
-q(1395) :- p(1394), q(1394).
-p(1396) :- p(1395), q(1396).
-q(1396) :- p(1395), q(1395).
-p(1397) :- p(1396), q(1397).
-q(1397) :- p(1396), q(1396).
-p(1398) :- p(1397), q(1398).
-q(1398) :- p(1397), q(1397).
-p(1399) :- p(1398), q(1399).
-q(1399) :- p(1398), q(1398).
-p(1400) :- p(1399), q(1400).
-q(1400) :- p(1399), q(1399).
-p(1401) :- p(1400), q(1401).
-q(1401) :- p(1400), q(1400).
-p(1402) :- p(1401), q(1402).
-q(1402) :- p(1401), q(1401).
-p(1403) :- p(1402), q(1403).
-q(1403) :- p(1402), q(1402).
-p(1404) :- p(1403), q(1404).
-q(1404) :- p(1403), q(1403).
-p(1405) :- p(1404), q(1405).
-q(1405) :- p(1404), q(1404).
-p(1406) :- p(1405), q(1406).
-q(1406) :- p(1405), q(1405).
-p(1407) :- p(1406), q(1407).
-q(1407) :- p(1406), q(1406).
-p(1408) :- p(1407), q(1408).
-q(1408) :- p(1407), q(1407).
-p(1409) :- p(1408), q(1409).
-q(1409) :- p(1408), q(1408).
-p(1410) :- p(1409), q(1410).
-q(1410) :- p(1409), q(1409).
-p(1411) :- p(1410), q(1411).
-q(1411) :- p(1410), q(1410).
-p(1412) :- p(1411), q(1412).
-q(1412) :- p(1411), q(1411).
-p(1413) :- p(1412), q(1413).
-q(1413) :- p(1412), q(1412).
-p(1414) :- p(1413), q(1414).
-q(1414) :- p(1413), q(1413).
-p(1415) :- p(1414), q(1415).
-q(1415) :- p(1414), q(1414).
-p(1416) :- p(1415), q(1416).
-q(1416) :- p(1415), q(1415).
-p(1417) :- p(1416), q(1417).
-q(1417) :- p(1416), q(1416).
-p(1418) :- p(1417), q(1418).
-q(1418) :- p(1417), q(1417).
-p(1419) :- p(1418), q(1419).
-q(1419) :- p(1418), q(1418).
-p(1420) :- p(1419), q(1420).
-q(1420) :- p(1419), q(1419).
-p(1421) :- p(1420), q(1421).
-q(1421) :- p(1420), q(1420).
-p(1422) :- p(1421), q(1422).
-q(1422) :- p(1421), q(1421).
-p(1423) :- p(1422), q(1423).
-q(1423) :- p(1422), q(1422).
-p(1424) :- p(1423), q(1424).
-q(1424) :- p(1423), q(1423).
-p(1425) :- p(1424), q(1425).
-q(1425) :- p(1424), q(1424).
-p(1426) :- p(1425), q(1426).
-q(1426) :- p(1425), q(1425).
-p(1427) :- p(1426), q(1427).
-q(1427) :- p(1426), q(1426).
-p(1428) :- p(1427), q(1428).
-q(1428) :- p(1427), q(1427).
-p(1429) :- p(1428), q(1429).
-q(1429) :- p(1428), q(1428).
-p(1430) :- p(1429), q(1430).
-q(1430) :- p(1429), q(1429).
-p(1431) :- p(1430), q(1431).
-q(1431) :- p(1430), q(1430).
-p(1432) :- p(1431), q(1432).
-q(1432) :- p(1431), q(1431).
-p(1433) :- p(1432), q(1433).
-q(1433) :- p(1432), q(1432).
-p(1434) :- p(1433), q(1434).
-q(1434) :- p(1433), q(1433).
-p(1435) :- p(1434), q(1435).
-q(1435) :- p(1434), q(1434).
-p(1436) :- p(1435), q(1436).
-q(1436) :- p(1435), q(1435).
-p(1437) :- p(1436), q(1437).
-q(1437) :- p(1436), q(1436).
-p(1438) :- p(1437), q(1438).
-q(1438) :- p(1437), q(1437).
-p(1439) :- p(1438), q(1439).
-q(1439) :- p(1438), q(1438).
-p(1440) :- p(1439), q(1440).
-q(1440) :- p(1439), q(1439).
-p(1441) :- p(1440), q(1441).
-q(1441) :- p(1440), q(1440).
-p(1442) :- p(1441), q(1442).
-q(1442) :- p(1441), q(1441).
-p(1443) :- p(1442), q(1443).
-q(1443) :- p(1442), q(1442).
-p(1444) :- p(1443), q(1444).
-q(1444) :- p(1443), q(1443).
-p(1445) :- p(1444), q(1445).
-q(1445) :- p(1444), q(1444).
-p(1446) :- p(1445), q(1446).
-q(1446) :- p(1445), q(1445).
-p(1447) :- p(1446), q(1447).
-q(1447) :- p(1446), q(1446).
-p(1448) :- p(1447), q(1448).
-q(1448) :- p(1447), q(1447).
-p(1449) :- p(1448), q(1449).
-q(1449) :- p(1448), q(1448).
-p(1450) :- p(1449), q(1450).
-q(1450) :- p(1449), q(1449).
-p(1451) :- p(1450), q(1451).
-q(1451) :- p(1450), q(1450).
-p(1452) :- p(1451), q(1452).
-q(1452) :- p(1451), q(1451).
-p(1453) :- p(1452), q(1453).
-q(1453) :- p(1452), q(1452).
-p(1454) :- p(1453), q(1454).
-q(1454) :- p(1453), q(1453).
-p(1455) :- p(1454), q(1455).
-q(1455) :- p(1454), q(1454).
-p(1456) :- p(1455), q(1456).
-q(1456) :- p(1455), q(1455).
-p(1457) :- p(1456), q(1457).
-q(1457) :- p(1456), q(1456).
-p(1458) :- p(1457), q(1458).
-q(1458) :- p(1457), q(1457).
-p(1459) :- p(1458), q(1459).
-q(1459) :- p(1458), q(1458).
-p(1460) :- p(1459), q(1460).
-q(1460) :- p(1459), q(1459).
-p(1461) :- p(1460), q(1461).
-q(1461) :- p(1460), q(1460).
-p(1462) :- p(1461), q(1462).
-q(1462) :- p(1461), q(1461).
-p(1463) :- p(1462), q(1463).
-q(1463) :- p(1462), q(1462).
-p(1464) :- p(1463), q(1464).
-q(1464) :- p(1463), q(1463).
-p(1465) :- p(1464), q(1465).
-q(1465) :- p(1464), q(1464).
-p(1466) :- p(1465), q(1466).
-q(1466) :- p(1465), q(1465).
-p(1467) :- p(1466), q(1467).
-q(1467) :- p(1466), q(1466).
-p(1468) :- p(1467), q(1468).
-q(1468) :- p(1467), q(1467).
-p(1469) :- p(1468), q(1469).
-q(1469) :- p(1468), q(1468).
-p(1470) :- p(1469), q(1470).
-q(1470) :- p(1469), q(1469).
-p(1471) :- p(1470), q(1471).
-q(1471) :- p(1470), q(1470).
-p(1472) :- p(1471), q(1472).
-q(1472) :- p(1471), q(1471).
-p(1473) :- p(1472), q(1473).
-q(1473) :- p(1472), q(1472).
-p(1474) :- p(1473), q(1474).
-q(1474) :- p(1473), q(1473).
-p(1475) :- p(1474), q(1475).
-q(1475) :- p(1474), q(1474).
-p(1476) :- p(1475), q(1476).
-q(1476) :- p(1475), q(1475).
-p(1477) :- p(1476), q(1477).
-q(1477) :- p(1476), q(1476).
-p(1478) :- p(1477), q(1478).
-q(1478) :- p(1477), q(1477).
-p(1479) :- p(1478), q(1479).
-q(1479) :- p(1478), q(1478).
-p(1480) :- p(1479), q(1480).
-q(1480) :- p(1479), q(1479).
-p(1481) :- p(1480), q(1481).
-q(1481) :- p(1480), q(1480).
-p(1482) :- p(1481), q(1482).
-q(1482) :- p(1481), q(1481).
-p(1483) :- p(1482), q(1483).
-q(1483) :- p(1482), q(1482).
-p(1484) :- p(1483), q(1484).
-q(1484) :- p(1483), q(1483).
-p(1485) :- p(1484), q(1485).
-q(1485) :- p(1484), q(1484).
-p(1486) :- p(1485), q(1486).
-q(1486) :- p(1485), q(1485).
-p(1487) :- p(1486), q(1487).
-q(1487) :- p(1486), q(1486).
-p(1488) :- p(1487), q(1488).
-q(1488) :- p(1487), q(1487).
-p(1489) :- p(1488), q(1489).
-q(1489) :- p(1488), q(1488).
-p(1490) :- p(1489), q(1490).
-q(1490) :- p(1489), q(1489).
-p(1491) :- p(1490), q(1491).
-q(1491) :- p(1490), q(1490).
-p(1492) :- p(1491), q(1492).
-q(1492) :- p(1491), q(1491).
-p(1493) :- p(1492), q(1493).
-q(1493) :- p(1492), q(1492).
-p(1494) :- p(1493), q(1494).
-q(1494) :- p(1493), q(1493).
-p(1495) :- p(1494), q(1495).
-q(1495) :- p(1494), q(1494).
-p(1496) :- p(1495), q(1496).
-q(1496) :- p(1495), q(1495).
-p(1497) :- p(1496), q(1497).
-q(1497) :- p(1496), q(1496).
-p(1498) :- p(1497), q(1498).
-q(1498) :- p(1497), q(1497).
-p(1499) :- p(1498), q(1499).
-q(1499) :- p(1498), q(1498).
-p(1500) :- p(1499), q(1500).
-q(1500) :- p(1499), q(1499).
-p(1501) :- p(1500), q(1501).
-q(1501) :- p(1500), q(1500).
-p(1502) :- p(1501), q(1502).
-q(1502) :- p(1501), q(1501).
-p(1503) :- p(1502), q(1503).
-q(1503) :- p(1502), q(1502).
-p(1504) :- p(1503), q(1504).
-q(1504) :- p(1503), q(1503).
-p(1505) :- p(1504), q(1505).
-q(1505) :- p(1504), q(1504).
-p(1506) :- p(1505), q(1506).
-q(1506) :- p(1505), q(1505).
-p(1507) :- p(1506), q(1507).
-q(1507) :- p(1506), q(1506).
-p(1508) :- p(1507), q(1508).
-q(1508) :- p(1507), q(1507).
-p(1509) :- p(1508), q(1509).
-q(1509) :- p(1508), q(1508).
-p(1510) :- p(1509), q(1510).
-q(1510) :- p(1509), q(1509).
-p(1511) :- p(1510), q(1511).
-q(1511) :- p(1510), q(1510).
-p(1512) :- p(1511), q(1512).
-q(1512) :- p(1511), q(1511).
-p(1513) :- p(1512), q(1513).
-q(1513) :- p(1512), q(1512).
-p(1514) :- p(1513), q(1514).
-q(1514) :- p(1513), q(1513).
-p(1515) :- p(1514), q(1515).
-q(1515) :- p(1514), q(1514).
-p(1516) :- p(1515), q(1516).
-q(1516) :- p(1515), q(1515).
-p(1517) :- p(1516), q(1517).
-q(1517) :- p(1516), q(1516).
-p(1518) :- p(1517), q(1518).
-q(1518) :- p(1517), q(1517).
-p(1519) :- p(1518), q(1519).
-q(1519) :- p(1518), q(1518).
-p(1520) :- p(1519), q(1520).
-q(1520) :- p(1519), q(1519).
-p(1521) :- p(1520), q(1521).
-q(1521) :- p(1520), q(1520).
-p(1522) :- p(1521), q(1522).
-q(1522) :- p(1521), q(1521).
-p(1523) :- p(1522), q(1523).
-q(1523) :- p(1522), q(1522).
-p(1524) :- p(1523), q(1524).
-q(1524) :- p(1523), q(1523).
-p(1525) :- p(1524), q(1525).
-q(1525) :- p(1524), q(1524).
-p(1526) :- p(1525), q(1526).
-q(1526) :- p(1525), q(1525).
-p(1527) :- p(1526), q(1527).
-q(1527) :- p(1526), q(1526).
-p(1528) :- p(1527), q(1528).
-q(1528) :- p(1527), q(1527).
-p(1529) :- p(1528), q(1529).
-q(1529) :- p(1528), q(1528).
-p(1530) :- p(1529), q(1530).
-q(1530) :- p(1529), q(1529).
-p(1531) :- p(1530), q(1531).
-q(1531) :- p(1530), q(1530).
-p(1532) :- p(1531), q(1532).
-q(1532) :- p(1531), q(1531).
-p(1533) :- p(1532), q(1533).
-q(1533) :- p(1532), q(1532).
-p(1534) :- p(1533), q(1534).
-q(1534) :- p(1533), q(1533).
-p(1535) :- p(1534), q(1535).
-q(1535) :- p(1534), q(1534).
-p(1536) :- p(1535), q(1536).
-q(1536) :- p(1535), q(1535).
-p(1537) :- p(1536), q(1537).
-q(1537) :- p(1536), q(1536).
-p(1538) :- p(1537), q(1538).
-q(1538) :- p(1537), q(1537).
-p(1539) :- p(1538), q(1539).
-q(1539) :- p(1538), q(1538).
-p(1540) :- p(1539), q(1540).
-q(1540) :- p(1539), q(1539).
-p(1541) :- p(1540), q(1541).
-q(1541) :- p(1540), q(1540).
-p(1542) :- p(1541), q(1542).
-q(1542) :- p(1541), q(1541).
-p(1543) :- p(1542), q(1543).
-q(1543) :- p(1542), q(1542).
-p(1544) :- p(1543), q(1544).
-q(1544) :- p(1543), q(1543).
-p(1545) :- p(1544), q(1545).
-q(1545) :- p(1544), q(1544).
-p(1546) :- p(1545), q(1546).
-q(1546) :- p(1545), q(1545).
-p(1547) :- p(1546), q(1547).
-q(1547) :- p(1546), q(1546).
-p(1548) :- p(1547), q(1548).
-q(1548) :- p(1547), q(1547).
-p(1549) :- p(1548), q(1549).
-q(1549) :- p(1548), q(1548).
-p(1550) :- p(1549), q(1550).
-q(1550) :- p(1549), q(1549).
-p(1551) :- p(1550), q(1551).
-q(1551) :- p(1550), q(1550).
-p(1552) :- p(1551), q(1552).
-q(1552) :- p(1551), q(1551).
-p(1553) :- p(1552), q(1553).
-q(1553) :- p(1552), q(1552).
-p(1554) :- p(1553), q(1554).
-q(1554) :- p(1553), q(1553).
-p(1555) :- p(1554), q(1555).
-q(1555) :- p(1554), q(1554).
-p(1556) :- p(1555), q(1556).
-q(1556) :- p(1555), q(1555).
-p(1557) :- p(1556), q(1557).
-q(1557) :- p(1556), q(1556).
-p(1558) :- p(1557), q(1558).
-q(1558) :- p(1557), q(1557).
-p(1559) :- p(1558), q(1559).
-q(1559) :- p(1558), q(1558).
-p(1560) :- p(1559), q(1560).
-q(1560) :- p(1559), q(1559).
-p(1561) :- p(1560), q(1561).
-q(1561) :- p(1560), q(1560).
-p(1562) :- p(1561), q(1562).
-q(1562) :- p(1561), q(1561).
-p(1563) :- p(1562), q(1563).
-q(1563) :- p(1562), q(1562).
-p(1564) :- p(1563), q(1564).
-q(1564) :- p(1563), q(1563).
-p(1565) :- p(1564), q(1565).
-q(1565) :- p(1564), q(1564).
-p(1566) :- p(1565), q(1566).
-q(1566) :- p(1565), q(1565).
-p(1567) :- p(1566), q(1567).
-q(1567) :- p(1566), q(1566).
-p(1568) :- p(1567), q(1568).
-q(1568) :- p(1567), q(1567).
-p(1569) :- p(1568), q(1569).
-q(1569) :- p(1568), q(1568).
-p(1570) :- p(1569), q(1570).
-q(1570) :- p(1569), q(1569).
-p(1571) :- p(1570), q(1571).
-q(1571) :- p(1570), q(1570).
-p(1572) :- p(1571), q(1572).
-q(1572) :- p(1571), q(1571).
-p(1573) :- p(1572), q(1573).
-q(1573) :- p(1572), q(1572).
-p(1574) :- p(1573), q(1574).
-q(1574) :- p(1573), q(1573).
-p(1575) :- p(1574), q(1575).
-q(1575) :- p(1574), q(1574).
-p(1576) :- p(1575), q(1576).
-q(1576) :- p(1575), q(1575).
-p(1577) :- p(1576), q(1577).
-q(1577) :- p(1576), q(1576).
-p(1578) :- p(1577), q(1578).
-q(1578) :- p(1577), q(1577).
-p(1579) :- p(1578), q(1579).
-q(1579) :- p(1578), q(1578).
-p(1580) :- p(1579), q(1580).
-q(1580) :- p(1579), q(1579).
-p(1581) :- p(1580), q(1581).
-q(1581) :- p(1580), q(1580).
-p(1582) :- p(1581), q(1582).
-q(1582) :- p(1581), q(1581).
-p(1583) :- p(1582), q(1583).
-q(1583) :- p(1582), q(1582).
-p(1584) :- p(1583), q(1584).
-q(1584) :- p(1583), q(1583).
-p(1585) :- p(1584), q(1585).
-q(1585) :- p(1584), q(1584).
-p(1586) :- p(1585), q(1586).
-q(1586) :- p(1585), q(1585).
-p(1587) :- p(1586), q(1587).
-q(1587) :- p(1586), q(1586).
-p(1588) :- p(1587), q(1588).
-q(1588) :- p(1587), q(1587).
-p(1589) :- p(1588), q(1589).
-q(1589) :- p(1588), q(1588).
-p(1590) :- p(1589), q(1590).
-q(1590) :- p(1589), q(1589).
-p(1591) :- p(1590), q(1591).
-q(1591) :- p(1590), q(1590).
-p(1592) :- p(1591), q(1592).
-q(1592) :- p(1591), q(1591).
-p(1593) :- p(1592), q(1593).
-q(1593) :- p(1592), q(1592).
-p(1594) :- p(1593), q(1594).
-q(1594) :- p(1593), q(1593).
-p(1595) :- p(1594), q(1595).
-q(1595) :- p(1594), q(1594).
-p(1596) :- p(1595), q(1596).
-q(1596) :- p(1595), q(1595).
-p(1597) :- p(1596), q(1597).
-q(1597) :- p(1596), q(1596).
-p(1598) :- p(1597), q(1598).
-q(1598) :- p(1597), q(1597).
-p(1599) :- p(1598), q(1599).
-q(1599) :- p(1598), q(1598).
-p(1600) :- p(1599), q(1600).
-q(1600) :- p(1599), q(1599).
-p(1601) :- p(1600), q(1601).
-q(1601) :- p(1600), q(1600).
-p(1602) :- p(1601), q(1602).
-q(1602) :- p(1601), q(1601).
-p(1603) :- p(1602), q(1603).
-q(1603) :- p(1602), q(1602).
-p(1604) :- p(1603), q(1604).
-q(1604) :- p(1603), q(1603).
-p(1605) :- p(1604), q(1605).
-q(1605) :- p(1604), q(1604).
-p(1606) :- p(1605), q(1606).
-q(1606) :- p(1605), q(1605).
-p(1607) :- p(1606), q(1607).
-q(1607) :- p(1606), q(1606).
-p(1608) :- p(1607), q(1608).
-q(1608) :- p(1607), q(1607).
-p(1609) :- p(1608), q(1609).
-q(1609) :- p(1608), q(1608).
-p(1610) :- p(1609), q(1610).
-q(1610) :- p(1609), q(1609).
-p(1611) :- p(1610), q(1611).
-q(1611) :- p(1610), q(1610).
-p(1612) :- p(1611), q(1612).
-q(1612) :- p(1611), q(1611).
-p(1613) :- p(1612), q(1613).
-q(1613) :- p(1612), q(1612).
-p(1614) :- p(1613), q(1614).
-q(1614) :- p(1613), q(1613).
-p(1615) :- p(1614), q(1615).
-q(1615) :- p(1614), q(1614).
-p(1616) :- p(1615), q(1616).
-q(1616) :- p(1615), q(1615).
-p(1617) :- p(1616), q(1617).
-q(1617) :- p(1616), q(1616).
-p(1618) :- p(1617), q(1618).
-q(1618) :- p(1617), q(1617).
-p(1619) :- p(1618), q(1619).
-q(1619) :- p(1618), q(1618).
-p(1620) :- p(1619), q(1620).
-q(1620) :- p(1619), q(1619).
-p(1621) :- p(1620), q(1621).
-q(1621) :- p(1620), q(1620).
-p(1622) :- p(1621), q(1622).
-q(1622) :- p(1621), q(1621).
-p(1623) :- p(1622), q(1623).
-q(1623) :- p(1622), q(1622).
-p(1624) :- p(1623), q(1624).
-q(1624) :- p(1623), q(1623).
-p(1625) :- p(1624), q(1625).
-q(1625) :- p(1624), q(1624).
-p(1626) :- p(1625), q(1626).
-q(1626) :- p(1625), q(1625).
-p(1627) :- p(1626), q(1627).
-q(1627) :- p(1626), q(1626).
-p(1628) :- p(1627), q(1628).
-q(1628) :- p(1627), q(1627).
-p(1629) :- p(1628), q(1629).
-q(1629) :- p(1628), q(1628).
-p(1630) :- p(1629), q(1630).
-q(1630) :- p(1629), q(1629).
-p(1631) :- p(1630), q(1631).
-q(1631) :- p(1630), q(1630).
-p(1632) :- p(1631), q(1632).
-q(1632) :- p(1631), q(1631).
-p(1633) :- p(1632), q(1633).
-q(1633) :- p(1632), q(1632).
-p(1634) :- p(1633), q(1634).
-q(1634) :- p(1633), q(1633).
-p(1635) :- p(1634), q(1635).
-q(1635) :- p(1634), q(1634).
-p(1636) :- p(1635), q(1636).
-q(1636) :- p(1635), q(1635).
-p(1637) :- p(1636), q(1637).
-q(1637) :- p(1636), q(1636).
-p(1638) :- p(1637), q(1638).
-q(1638) :- p(1637), q(1637).
-p(1639) :- p(1638), q(1639).
-q(1639) :- p(1638), q(1638).
-p(1640) :- p(1639), q(1640).
-q(1640) :- p(1639), q(1639).
-p(1641) :- p(1640), q(1641).
-q(1641) :- p(1640), q(1640).
-p(1642) :- p(1641), q(1642).
-q(1642) :- p(1641), q(1641).
-p(1643) :- p(1642), q(1643).
-q(1643) :- p(1642), q(1642).
-p(1644) :- p(1643), q(1644).
-q(1644) :- p(1643), q(1643).
-p(1645) :- p(1644), q(1645).
-q(1645) :- p(1644), q(1644).
-p(1646) :- p(1645), q(1646).
-q(1646) :- p(1645), q(1645).
-p(1647) :- p(1646), q(1647).
-q(1647) :- p(1646), q(1646).
-p(1648) :- p(1647), q(1648).
-q(1648) :- p(1647), q(1647).
-p(1649) :- p(1648), q(1649).
-q(1649) :- p(1648), q(1648).
-p(1650) :- p(1649), q(1650).
-q(1650) :- p(1649), q(1649).
-p(1651) :- p(1650), q(1651).
-q(1651) :- p(1650), q(1650).
-p(1652) :- p(1651), q(1652).
-q(1652) :- p(1651), q(1651).
-p(1653) :- p(1652), q(1653).
-q(1653) :- p(1652), q(1652).
-p(1654) :- p(1653), q(1654).
-q(1654) :- p(1653), q(1653).
-p(1655) :- p(1654), q(1655).
-q(1655) :- p(1654), q(1654).
-p(1656) :- p(1655), q(1656).
-q(1656) :- p(1655), q(1655).
-p(1657) :- p(1656), q(1657).
-q(1657) :- p(1656), q(1656).
-p(1658) :- p(1657), q(1658).
-q(1658) :- p(1657), q(1657).
-p(1659) :- p(1658), q(1659).
-q(1659) :- p(1658), q(1658).
-p(1660) :- p(1659), q(1660).
-q(1660) :- p(1659), q(1659).
-p(1661) :- p(1660), q(1661).
-q(1661) :- p(1660), q(1660).
-p(1662) :- p(1661), q(1662).
-q(1662) :- p(1661), q(1661).
-p(1663) :- p(1662), q(1663).
-q(1663) :- p(1662), q(1662).
-p(1664) :- p(1663), q(1664).
-q(1664) :- p(1663), q(1663).
-p(1665) :- p(1664), q(1665).
-q(1665) :- p(1664), q(1664).
-p(1666) :- p(1665), q(1666).
-q(1666) :- p(1665), q(1665).
-p(1667) :- p(1666), q(1667).
-q(1667) :- p(1666), q(1666).
-p(1668) :- p(1667), q(1668).
-q(1668) :- p(1667), q(1667).
-p(1669) :- p(1668), q(1669).
-q(1669) :- p(1668), q(1668).
-p(1670) :- p(1669), q(1670).
-q(1670) :- p(1669), q(1669).
-p(1671) :- p(1670), q(1671).
-q(1671) :- p(1670), q(1670).
-p(1672) :- p(1671), q(1672).
-q(1672) :- p(1671), q(1671).
-p(1673) :- p(1672), q(1673).
-q(1673) :- p(1672), q(1672).
-p(1674) :- p(1673), q(1674).
-q(1674) :- p(1673), q(1673).
-p(1675) :- p(1674), q(1675).
-q(1675) :- p(1674), q(1674).
-p(1676) :- p(1675), q(1676).
-q(1676) :- p(1675), q(1675).
-p(1677) :- p(1676), q(1677).
-q(1677) :- p(1676), q(1676).
-p(1678) :- p(1677), q(1678).
-q(1678) :- p(1677), q(1677).
-p(1679) :- p(1678), q(1679).
-q(1679) :- p(1678), q(1678).
-p(1680) :- p(1679), q(1680).
-q(1680) :- p(1679), q(1679).
-p(1681) :- p(1680), q(1681).
-q(1681) :- p(1680), q(1680).
-p(1682) :- p(1681), q(1682).
-q(1682) :- p(1681), q(1681).
-p(1683) :- p(1682), q(1683).
-q(1683) :- p(1682), q(1682).
-p(1684) :- p(1683), q(1684).
-q(1684) :- p(1683), q(1683).
-p(1685) :- p(1684), q(1685).
-q(1685) :- p(1684), q(1684).
-p(1686) :- p(1685), q(1686).
-q(1686) :- p(1685), q(1685).
-p(1687) :- p(1686), q(1687).
-q(1687) :- p(1686), q(1686).
-p(1688) :- p(1687), q(1688).
-q(1688) :- p(1687), q(1687).
-p(1689) :- p(1688), q(1689).
-q(1689) :- p(1688), q(1688).
-p(1690) :- p(1689), q(1690).
-q(1690) :- p(1689), q(1689).
-p(1691) :- p(1690), q(1691).
-q(1691) :- p(1690), q(1690).
-p(1692) :- p(1691), q(1692).
-q(1692) :- p(1691), q(1691).
-p(1693) :- p(1692), q(1693).
-q(1693) :- p(1692), q(1692).
-p(1694) :- p(1693), q(1694).
-q(1694) :- p(1693), q(1693).
-p(1695) :- p(1694), q(1695).
-q(1695) :- p(1694), q(1694).
-p(1696) :- p(1695), q(1696).
-q(1696) :- p(1695), q(1695).
-p(1697) :- p(1696), q(1697).
-q(1697) :- p(1696), q(1696).
-p(1698) :- p(1697), q(1698).
-q(1698) :- p(1697), q(1697).
-p(1699) :- p(1698), q(1699).
-q(1699) :- p(1698), q(1698).
-p(1700) :- p(1699), q(1700).
-q(1700) :- p(1699), q(1699).
-p(1701) :- p(1700), q(1701).
-q(1701) :- p(1700), q(1700).
-p(1702) :- p(1701), q(1702).
-q(1702) :- p(1701), q(1701).
-p(1703) :- p(1702), q(1703).
-q(1703) :- p(1702), q(1702).
-p(1704) :- p(1703), q(1704).
-q(1704) :- p(1703), q(1703).
-p(1705) :- p(1704), q(1705).
-q(1705) :- p(1704), q(1704).
-p(1706) :- p(1705), q(1706).
-q(1706) :- p(1705), q(1705).
-p(1707) :- p(1706), q(1707).
-q(1707) :- p(1706), q(1706).
-p(1708) :- p(1707), q(1708).
-q(1708) :- p(1707), q(1707).
-p(1709) :- p(1708), q(1709).
-q(1709) :- p(1708), q(1708).
-p(1710) :- p(1709), q(1710).
-q(1710) :- p(1709), q(1709).
-p(1711) :- p(1710), q(1711).
-q(1711) :- p(1710), q(1710).
-p(1712) :- p(1711), q(1712).
-q(1712) :- p(1711), q(1711).
-p(1713) :- p(1712), q(1713).
-q(1713) :- p(1712), q(1712).
-p(1714) :- p(1713), q(1714).
-q(1714) :- p(1713), q(1713).
-p(1715) :- p(1714), q(1715).
-q(1715) :- p(1714), q(1714).
-p(1716) :- p(1715), q(1716).
-q(1716) :- p(1715), q(1715).
-p(1717) :- p(1716), q(1717).
-q(1717) :- p(1716), q(1716).
-p(1718) :- p(1717), q(1718).
-q(1718) :- p(1717), q(1717).
-p(1719) :- p(1718), q(1719).
-q(1719) :- p(1718), q(1718).
-p(1720) :- p(1719), q(1720).
-q(1720) :- p(1719), q(1719).
-p(1721) :- p(1720), q(1721).
-q(1721) :- p(1720), q(1720).
-p(1722) :- p(1721), q(1722).
-q(1722) :- p(1721), q(1721).
-p(1723) :- p(1722), q(1723).
-q(1723) :- p(1722), q(1722).
-p(1724) :- p(1723), q(1724).
-q(1724) :- p(1723), q(1723).
-p(1725) :- p(1724), q(1725).
-q(1725) :- p(1724), q(1724).
-p(1726) :- p(1725), q(1726).
-q(1726) :- p(1725), q(1725).
-p(1727) :- p(1726), q(1727).
-q(1727) :- p(1726), q(1726).
-p(1728) :- p(1727), q(1728).
-q(1728) :- p(1727), q(1727).
-p(1729) :- p(1728), q(1729).
-q(1729) :- p(1728), q(1728).
-p(1730) :- p(1729), q(1730).
-q(1730) :- p(1729), q(1729).
-p(1731) :- p(1730), q(1731).
-q(1731) :- p(1730), q(1730).
-p(1732) :- p(1731), q(1732).
-q(1732) :- p(1731), q(1731).
-p(1733) :- p(1732), q(1733).
-q(1733) :- p(1732), q(1732).
-p(1734) :- p(1733), q(1734).
-q(1734) :- p(1733), q(1733).
-p(1735) :- p(1734), q(1735).
-q(1735) :- p(1734), q(1734).
-p(1736) :- p(1735), q(1736).
-q(1736) :- p(1735), q(1735).
-p(1737) :- p(1736), q(1737).
-q(1737) :- p(1736), q(1736).
-p(1738) :- p(1737), q(1738).
-q(1738) :- p(1737), q(1737).
-p(1739) :- p(1738), q(1739).
-q(1739) :- p(1738), q(1738).
-p(1740) :- p(1739), q(1740).
-q(1740) :- p(1739), q(1739).
-p(1741) :- p(1740), q(1741).
-q(1741) :- p(1740), q(1740).
-p(1742) :- p(1741), q(1742).
-q(1742) :- p(1741), q(1741).
-p(1743) :- p(1742), q(1743).
-q(1743) :- p(1742), q(1742).
-p(1744) :- p(1743), q(1744).
-q(1744) :- p(1743), q(1743).
-p(1745) :- p(1744), q(1745).
-q(1745) :- p(1744), q(1744).
-p(1746) :- p(1745), q(1746).
-q(1746) :- p(1745), q(1745).
-p(1747) :- p(1746), q(1747).
-q(1747) :- p(1746), q(1746).
-p(1748) :- p(1747), q(1748).
-q(1748) :- p(1747), q(1747).
-p(1749) :- p(1748), q(1749).
-q(1749) :- p(1748), q(1748).
-p(1750) :- p(1749), q(1750).
-q(1750) :- p(1749), q(1749).
-p(1751) :- p(1750), q(1751).
-q(1751) :- p(1750), q(1750).
-p(1752) :- p(1751), q(1752).
-q(1752) :- p(1751), q(1751).
-p(1753) :- p(1752), q(1753).
-q(1753) :- p(1752), q(1752).
-p(1754) :- p(1753), q(1754).
-q(1754) :- p(1753), q(1753).
-p(1755) :- p(1754), q(1755).
-q(1755) :- p(1754), q(1754).
-p(1756) :- p(1755), q(1756).
-q(1756) :- p(1755), q(1755).
-p(1757) :- p(1756), q(1757).
-q(1757) :- p(1756), q(1756).
-p(1758) :- p(1757), q(1758).
-q(1758) :- p(1757), q(1757).
-p(1759) :- p(1758), q(1759).
-q(1759) :- p(1758), q(1758).
-p(1760) :- p(1759), q(1760).
-q(1760) :- p(1759), q(1759).
-p(1761) :- p(1760), q(1761).
-q(1761) :- p(1760), q(1760).
-p(1762) :- p(1761), q(1762).
-q(1762) :- p(1761), q(1761).
-p(1763) :- p(1762), q(1763).
-q(1763) :- p(1762), q(1762).
-p(1764) :- p(1763), q(1764).
-q(1764) :- p(1763), q(1763).
-p(1765) :- p(1764), q(1765).
-q(1765) :- p(1764), q(1764).
-p(1766) :- p(1765), q(1766).
-q(1766) :- p(1765), q(1765).
-p(1767) :- p(1766), q(1767).
-q(1767) :- p(1766), q(1766).
-p(1768) :- p(1767), q(1768).
-q(1768) :- p(1767), q(1767).
-p(1769) :- p(1768), q(1769).
-q(1769) :- p(1768), q(1768).
-p(1770) :- p(1769), q(1770).
-q(1770) :- p(1769), q(1769).
-p(1771) :- p(1770), q(1771).
-q(1771) :- p(1770), q(1770).
-p(1772) :- p(1771), q(1772).
-q(1772) :- p(1771), q(1771).
-p(1773) :- p(1772), q(1773).
-q(1773) :- p(1772), q(1772).
-p(1774) :- p(1773), q(1774).
-q(1774) :- p(1773), q(1773).
-p(1775) :- p(1774), q(1775).
-q(1775) :- p(1774), q(1774).
-p(1776) :- p(1775), q(1776).
-q(1776) :- p(1775), q(1775).
-p(1777) :- p(1776), q(1777).
-q(1777) :- p(1776), q(1776).
-p(1778) :- p(1777), q(1778).
-q(1778) :- p(1777), q(1777).
-p(1779) :- p(1778), q(1779).
-q(1779) :- p(1778), q(1778).
-p(1780) :- p(1779), q(1780).
-q(1780) :- p(1779), q(1779).
-p(1781) :- p(1780), q(1781).
-q(1781) :- p(1780), q(1780).
-p(1782) :- p(1781), q(1782).
-q(1782) :- p(1781), q(1781).
-p(1783) :- p(1782), q(1783).
-q(1783) :- p(1782), q(1782).
-p(1784) :- p(1783), q(1784).
-q(1784) :- p(1783), q(1783).
-p(1785) :- p(1784), q(1785).
-q(1785) :- p(1784), q(1784).
-p(1786) :- p(1785), q(1786).
-q(1786) :- p(1785), q(1785).
-p(1787) :- p(1786), q(1787).
-q(1787) :- p(1786), q(1786).
-p(1788) :- p(1787), q(1788).
-q(1788) :- p(1787), q(1787).
-p(1789) :- p(1788), q(1789).
-q(1789) :- p(1788), q(1788).
-p(1790) :- p(1789), q(1790).
-q(1790) :- p(1789), q(1789).
-p(1791) :- p(1790), q(1791).
-q(1791) :- p(1790), q(1790).
-p(1792) :- p(1791), q(1792).
-q(1792) :- p(1791), q(1791).
-p(1793) :- p(1792), q(1793).
-q(1793) :- p(1792), q(1792).
-p(1794) :- p(1793), q(1794).
-q(1794) :- p(1793), q(1793).
-p(1795) :- p(1794), q(1795).
-q(1795) :- p(1794), q(1794).
-p(1796) :- p(1795), q(1796).
-q(1796) :- p(1795), q(1795).
-p(1797) :- p(1796), q(1797).
-q(1797) :- p(1796), q(1796).
-p(1798) :- p(1797), q(1798).
-q(1798) :- p(1797), q(1797).
-p(1799) :- p(1798), q(1799).
-q(1799) :- p(1798), q(1798).
-p(1800) :- p(1799), q(1800).
-q(1800) :- p(1799), q(1799).
-p(1801) :- p(1800), q(1801).
-q(1801) :- p(1800), q(1800).
-p(1802) :- p(1801), q(1802).
-q(1802) :- p(1801), q(1801).
-p(1803) :- p(1802), q(1803).
-q(1803) :- p(1802), q(1802).
-p(1804) :- p(1803), q(1804).
-q(1804) :- p(1803), q(1803).
-p(1805) :- p(1804), q(1805).
-q(1805) :- p(1804), q(1804).
-p(1806) :- p(1805), q(1806).
-q(1806) :- p(1805), q(1805).
-p(1807) :- p(1806), q(1807).
-q(1807) :- p(1806), q(1806).
-p(1808) :- p(1807), q(1808).
-q(1808) :- p(1807), q(1807).
-p(1809) :- p(1808), q(1809).
-q(1809) :- p(1808), q(1808).
-p(1810) :- p(1809), q(1810).
-q(1810) :- p(1809), q(1809).
-p(1811) :- p(1810), q(1811).
-q(1811) :- p(1810), q(1810).
-p(1812) :- p(1811), q(1812).
-q(1812) :- p(1811), q(1811).
-p(1813) :- p(1812), q(1813).
-q(1813) :- p(1812), q(1812).
-p(1814) :- p(1813), q(1814).
-q(1814) :- p(1813), q(1813).
-p(1815) :- p(1814), q(1815).
-q(1815) :- p(1814), q(1814).
-p(1816) :- p(1815), q(1816).
-q(1816) :- p(1815), q(1815).
-p(1817) :- p(1816), q(1817).
-q(1817) :- p(1816), q(1816).
-p(1818) :- p(1817), q(1818).
-q(1818) :- p(1817), q(1817).
-p(1819) :- p(1818), q(1819).
-q(1819) :- p(1818), q(1818).
-p(1820) :- p(1819), q(1820).
-q(1820) :- p(1819), q(1819).
-p(1821) :- p(1820), q(1821).
-q(1821) :- p(1820), q(1820).
-p(1822) :- p(1821), q(1822).
-q(1822) :- p(1821), q(1821).
-p(1823) :- p(1822), q(1823).
-q(1823) :- p(1822), q(1822).
-p(1824) :- p(1823), q(1824).
-q(1824) :- p(1823), q(1823).
-p(1825) :- p(1824), q(1825).
-q(1825) :- p(1824), q(1824).
-p(1826) :- p(1825), q(1826).
-q(1826) :- p(1825), q(1825).
-p(1827) :- p(1826), q(1827).
-q(1827) :- p(1826), q(1826).
-p(1828) :- p(1827), q(1828).
-q(1828) :- p(1827), q(1827).
-p(1829) :- p(1828), q(1829).
-q(1829) :- p(1828), q(1828).
-p(1830) :- p(1829), q(1830).
-q(1830) :- p(1829), q(1829).
-p(1831) :- p(1830), q(1831).
-q(1831) :- p(1830), q(1830).
-p(1832) :- p(1831), q(1832).
-q(1832) :- p(1831), q(1831).
-p(1833) :- p(1832), q(1833).
-q(1833) :- p(1832), q(1832).
-p(1834) :- p(1833), q(1834).
-q(1834) :- p(1833), q(1833).
-p(1835) :- p(1834), q(1835).
-q(1835) :- p(1834), q(1834).
-p(1836) :- p(1835), q(1836).
-q(1836) :- p(1835), q(1835).
-p(1837) :- p(1836), q(1837).
-q(1837) :- p(1836), q(1836).
-p(1838) :- p(1837), q(1838).
-q(1838) :- p(1837), q(1837).
-p(1839) :- p(1838), q(1839).
-q(1839) :- p(1838), q(1838).
-p(1840) :- p(1839), q(1840).
-q(1840) :- p(1839), q(1839).
-p(1841) :- p(1840), q(1841).
-q(1841) :- p(1840), q(1840).
-p(1842) :- p(1841), q(1842).
-q(1842) :- p(1841), q(1841).
-p(1843) :- p(1842), q(1843).
-q(1843) :- p(1842), q(1842).
-p(1844) :- p(1843), q(1844).
-q(1844) :- p(1843), q(1843).
-p(1845) :- p(1844), q(1845).
-q(1845) :- p(1844), q(1844).
-p(1846) :- p(1845), q(1846).
-q(1846) :- p(1845), q(1845).
-p(1847) :- p(1846), q(1847).
-q(1847) :- p(1846), q(1846).
-p(1848) :- p(1847), q(1848).
-q(1848) :- p(1847), q(1847).
-p(1849) :- p(1848), q(1849).
-q(1849) :- p(1848), q(1848).
-p(1850) :- p(1849), q(1850).
-q(1850) :- p(1849), q(1849).
-p(1851) :- p(1850), q(1851).
-q(1851) :- p(1850), q(1850).
-p(1852) :- p(1851), q(1852).
-q(1852) :- p(1851), q(1851).
-p(1853) :- p(1852), q(1853).
-q(1853) :- p(1852), q(1852).
-p(1854) :- p(1853), q(1854).
-q(1854) :- p(1853), q(1853).
-p(1855) :- p(1854), q(1855).
-q(1855) :- p(1854), q(1854).
-p(1856) :- p(1855), q(1856).
-q(1856) :- p(1855), q(1855).
-p(1857) :- p(1856), q(1857).
-q(1857) :- p(1856), q(1856).
-p(1858) :- p(1857), q(1858).
-q(1858) :- p(1857), q(1857).
-p(1859) :- p(1858), q(1859).
-q(1859) :- p(1858), q(1858).
-p(1860) :- p(1859), q(1860).
-q(1860) :- p(1859), q(1859).
-p(1861) :- p(1860), q(1861).
-q(1861) :- p(1860), q(1860).
-p(1862) :- p(1861), q(1862).
-q(1862) :- p(1861), q(1861).
-p(1863) :- p(1862), q(1863).
-q(1863) :- p(1862), q(1862).
-p(1864) :- p(1863), q(1864).
-q(1864) :- p(1863), q(1863).
-p(1865) :- p(1864), q(1865).
-q(1865) :- p(1864), q(1864).
-p(1866) :- p(1865), q(1866).
-q(1866) :- p(1865), q(1865).
-p(1867) :- p(1866), q(1867).
-q(1867) :- p(1866), q(1866).
-p(1868) :- p(1867), q(1868).
-q(1868) :- p(1867), q(1867).
-p(1869) :- p(1868), q(1869).
-q(1869) :- p(1868), q(1868).
-p(1870) :- p(1869), q(1870).
-q(1870) :- p(1869), q(1869).
-p(1871) :- p(1870), q(1871).
-q(1871) :- p(1870), q(1870).
-p(1872) :- p(1871), q(1872).
-q(1872) :- p(1871), q(1871).
-p(1873) :- p(1872), q(1873).
-q(1873) :- p(1872), q(1872).
-p(1874) :- p(1873), q(1874).
-q(1874) :- p(1873), q(1873).
-p(1875) :- p(1874), q(1875).
-q(1875) :- p(1874), q(1874).
-p(1876) :- p(1875), q(1876).
-q(1876) :- p(1875), q(1875).
-p(1877) :- p(1876), q(1877).
-q(1877) :- p(1876), q(1876).
-p(1878) :- p(1877), q(1878).
-q(1878) :- p(1877), q(1877).
-p(1879) :- p(1878), q(1879).
-q(1879) :- p(1878), q(1878).
-p(1880) :- p(1879), q(1880).
-q(1880) :- p(1879), q(1879).
-p(1881) :- p(1880), q(1881).
-q(1881) :- p(1880), q(1880).
-p(1882) :- p(1881), q(1882).
-q(1882) :- p(1881), q(1881).
-p(1883) :- p(1882), q(1883).
-q(1883) :- p(1882), q(1882).
-p(1884) :- p(1883), q(1884).
-q(1884) :- p(1883), q(1883).
-p(1885) :- p(1884), q(1885).
-q(1885) :- p(1884), q(1884).
-p(1886) :- p(1885), q(1886).
-q(1886) :- p(1885), q(1885).
-p(1887) :- p(1886), q(1887).
-q(1887) :- p(1886), q(1886).
-p(1888) :- p(1887), q(1888).
-q(1888) :- p(1887), q(1887).
-p(1889) :- p(1888), q(1889).
-q(1889) :- p(1888), q(1888).
-p(1890) :- p(1889), q(1890).
-q(1890) :- p(1889), q(1889).
-p(1891) :- p(1890), q(1891).
-q(1891) :- p(1890), q(1890).
-p(1892) :- p(1891), q(1892).
-q(1892) :- p(1891), q(1891).
-p(1893) :- p(1892), q(1893).
-q(1893) :- p(1892), q(1892).
-p(1894) :- p(1893), q(1894).
-q(1894) :- p(1893), q(1893).
-p(1895) :- p(1894), q(1895).
-q(1895) :- p(1894), q(1894).
-p(1896) :- p(1895), q(1896).
-q(1896) :- p(1895), q(1895).
-p(1897) :- p(1896), q(1897).
-q(1897) :- p(1896), q(1896).
-p(1898) :- p(1897), q(1898).
-q(1898) :- p(1897), q(1897).
-p(1899) :- p(1898), q(1899).
-q(1899) :- p(1898), q(1898).
-p(1900) :- p(1899), q(1900).
-q(1900) :- p(1899), q(1899).
-p(1901) :- p(1900), q(1901).
-q(1901) :- p(1900), q(1900).
-p(1902) :- p(1901), q(1902).
-q(1902) :- p(1901), q(1901).
-p(1903) :- p(1902), q(1903).
-q(1903) :- p(1902), q(1902).
-p(1904) :- p(1903), q(1904).
-q(1904) :- p(1903), q(1903).
-p(1905) :- p(1904), q(1905).
-q(1905) :- p(1904), q(1904).
-p(1906) :- p(1905), q(1906).
-q(1906) :- p(1905), q(1905).
-p(1907) :- p(1906), q(1907).
-q(1907) :- p(1906), q(1906).
-p(1908) :- p(1907), q(1908).
-q(1908) :- p(1907), q(1907).
-p(1909) :- p(1908), q(1909).
-q(1909) :- p(1908), q(1908).
-p(1910) :- p(1909), q(1910).
-q(1910) :- p(1909), q(1909).
-p(1911) :- p(1910), q(1911).
-q(1911) :- p(1910), q(1910).
-p(1912) :- p(1911), q(1912).
-q(1912) :- p(1911), q(1911).
-p(1913) :- p(1912), q(1913).
-q(1913) :- p(1912), q(1912).
-p(1914) :- p(1913), q(1914).
-q(1914) :- p(1913), q(1913).
-p(1915) :- p(1914), q(1915).
-q(1915) :- p(1914), q(1914).
-p(1916) :- p(1915), q(1916).
-q(1916) :- p(1915), q(1915).
-p(1917) :- p(1916), q(1917).
-q(1917) :- p(1916), q(1916).
-p(1918) :- p(1917), q(1918).
-q(1918) :- p(1917), q(1917).
-p(1919) :- p(1918), q(1919).
-q(1919) :- p(1918), q(1918).
-p(1920) :- p(1919), q(1920).
-q(1920) :- p(1919), q(1919).
-p(1921) :- p(1920), q(1921).
-q(1921) :- p(1920), q(1920).
-p(1922) :- p(1921), q(1922).
-q(1922) :- p(1921), q(1921).
-p(1923) :- p(1922), q(1923).
-q(1923) :- p(1922), q(1922).
-p(1924) :- p(1923), q(1924).
-q(1924) :- p(1923), q(1923).
-p(1925) :- p(1924), q(1925).
-q(1925) :- p(1924), q(1924).
-p(1926) :- p(1925), q(1926).
-q(1926) :- p(1925), q(1925).
-p(1927) :- p(1926), q(1927).
-q(1927) :- p(1926), q(1926).
-p(1928) :- p(1927), q(1928).
-q(1928) :- p(1927), q(1927).
-p(1929) :- p(1928), q(1929).
-q(1929) :- p(1928), q(1928).
-p(1930) :- p(1929), q(1930).
-q(1930) :- p(1929), q(1929).
-p(1931) :- p(1930), q(1931).
-q(1931) :- p(1930), q(1930).
-p(1932) :- p(1931), q(1932).
-q(1932) :- p(1931), q(1931).
-p(1933) :- p(1932), q(1933).
-q(1933) :- p(1932), q(1932).
-p(1934) :- p(1933), q(1934).
-q(1934) :- p(1933), q(1933).
-p(1935) :- p(1934), q(1935).
-q(1935) :- p(1934), q(1934).
-p(1936) :- p(1935), q(1936).
-q(1936) :- p(1935), q(1935).
-p(1937) :- p(1936), q(1937).
-q(1937) :- p(1936), q(1936).
-p(1938) :- p(1937), q(1938).
-q(1938) :- p(1937), q(1937).
-p(1939) :- p(1938), q(1939).
-q(1939) :- p(1938), q(1938).
-p(1940) :- p(1939), q(1940).
-q(1940) :- p(1939), q(1939).
-p(1941) :- p(1940), q(1941).
-q(1941) :- p(1940), q(1940).
-p(1942) :- p(1941), q(1942).
-q(1942) :- p(1941), q(1941).
-p(1943) :- p(1942), q(1943).
-q(1943) :- p(1942), q(1942).
-p(1944) :- p(1943), q(1944).
-q(1944) :- p(1943), q(1943).
-p(1945) :- p(1944), q(1945).
-q(1945) :- p(1944), q(1944).
-p(1946) :- p(1945), q(1946).
-q(1946) :- p(1945), q(1945).
-p(1947) :- p(1946), q(1947).
-q(1947) :- p(1946), q(1946).
-p(1948) :- p(1947), q(1948).
-q(1948) :- p(1947), q(1947).
-p(1949) :- p(1948), q(1949).
-q(1949) :- p(1948), q(1948).
-p(1950) :- p(1949), q(1950).
-q(1950) :- p(1949), q(1949).
-p(1951) :- p(1950), q(1951).
-q(1951) :- p(1950), q(1950).
-p(1952) :- p(1951), q(1952).
-q(1952) :- p(1951), q(1951).
-p(1953) :- p(1952), q(1953).
-q(1953) :- p(1952), q(1952).
-p(1954) :- p(1953), q(1954).
-q(1954) :- p(1953), q(1953).
-p(1955) :- p(1954), q(1955).
-q(1955) :- p(1954), q(1954).
-p(1956) :- p(1955), q(1956).
-q(1956) :- p(1955), q(1955).
-p(1957) :- p(1956), q(1957).
-q(1957) :- p(1956), q(1956).
-p(1958) :- p(1957), q(1958).
-q(1958) :- p(1957), q(1957).
-p(1959) :- p(1958), q(1959).
-q(1959) :- p(1958), q(1958).
-p(1960) :- p(1959), q(1960).
-q(1960) :- p(1959), q(1959).
-p(1961) :- p(1960), q(1961).
-q(1961) :- p(1960), q(1960).
-p(1962) :- p(1961), q(1962).
-q(1962) :- p(1961), q(1961).
-p(1963) :- p(1962), q(1963).
-q(1963) :- p(1962), q(1962).
-p(1964) :- p(1963), q(1964).
-q(1964) :- p(1963), q(1963).
-p(1965) :- p(1964), q(1965).
-q(1965) :- p(1964), q(1964).
-p(1966) :- p(1965), q(1966).
-q(1966) :- p(1965), q(1965).
-p(1967) :- p(1966), q(1967).
-q(1967) :- p(1966), q(1966).
-p(1968) :- p(1967), q(1968).
-q(1968) :- p(1967), q(1967).
-p(1969) :- p(1968), q(1969).
-q(1969) :- p(1968), q(1968).
-p(1970) :- p(1969), q(1970).
-q(1970) :- p(1969), q(1969).
-p(1971) :- p(1970), q(1971).
-q(1971) :- p(1970), q(1970).
-p(1972) :- p(1971), q(1972).
-q(1972) :- p(1971), q(1971).
-p(1973) :- p(1972), q(1973).
-q(1973) :- p(1972), q(1972).
-p(1974) :- p(1973), q(1974).
-q(1974) :- p(1973), q(1973).
-p(1975) :- p(1974), q(1975).
-q(1975) :- p(1974), q(1974).
-p(1976) :- p(1975), q(1976).
-q(1976) :- p(1975), q(1975).
-p(1977) :- p(1976), q(1977).
-q(1977) :- p(1976), q(1976).
-p(1978) :- p(1977), q(1978).
-q(1978) :- p(1977), q(1977).
-p(1979) :- p(1978), q(1979).
-q(1979) :- p(1978), q(1978).
-p(1980) :- p(1979), q(1980).
-q(1980) :- p(1979), q(1979).
-p(1981) :- p(1980), q(1981).
-q(1981) :- p(1980), q(1980).
-p(1982) :- p(1981), q(1982).
-q(1982) :- p(1981), q(1981).
-p(1983) :- p(1982), q(1983).
-q(1983) :- p(1982), q(1982).
-p(1984) :- p(1983), q(1984).
-q(1984) :- p(1983), q(1983).
-p(1985) :- p(1984), q(1985).
-q(1985) :- p(1984), q(1984).
-p(1986) :- p(1985), q(1986).
-q(1986) :- p(1985), q(1985).
-p(1987) :- p(1986), q(1987).
-q(1987) :- p(1986), q(1986).
-p(1988) :- p(1987), q(1988).
-q(1988) :- p(1987), q(1987).
-p(1989) :- p(1988), q(1989).
-q(1989) :- p(1988), q(1988).
-p(1990) :- p(1989), q(1990).
-q(1990) :- p(1989), q(1989).
-p(1991) :- p(1990), q(1991).
-q(1991) :- p(1990), q(1990).
-p(1992) :- p(1991), q(1992).
-q(1992) :- p(1991), q(1991).
-p(1993) :- p(1992), q(1993).
-q(1993) :- p(1992), q(1992).
-p(1994) :- p(1993), q(1994).
-q(1994) :- p(1993), q(1993).
-p(1995) :- p(1994), q(1995).
-q(1995) :- p(1994), q(1994).
-p(1996) :- p(1995), q(1996).
-q(1996) :- p(1995), q(1995).
-p(1997) :- p(1996), q(1997).
-q(1997) :- p(1996), q(1996).
-p(1998) :- p(1997), q(1998).
-q(1998) :- p(1997), q(1997).
-p(1999) :- p(1998), q(1999).
-q(1999) :- p(1998), q(1998).
-p(2000) :- p(1999), q(2000).
-q(2000) :- p(1999), q(1999).
-p(2001) :- p(2000), q(2001).
-q(2001) :- p(2000), q(2000).
-p(2002) :- p(2001), q(2002).
-q(2002) :- p(2001), q(2001).
-p(2003) :- p(2002), q(2003).
-q(2003) :- p(2002), q(2002).
-p(2004) :- p(2003), q(2004).
-q(2004) :- p(2003), q(2003).
-p(2005) :- p(2004), q(2005).
-q(2005) :- p(2004), q(2004).
-p(2006) :- p(2005), q(2006).
-q(2006) :- p(2005), q(2005).
-p(2007) :- p(2006), q(2007).
-q(2007) :- p(2006), q(2006).
-p(2008) :- p(2007), q(2008).
-q(2008) :- p(2007), q(2007).
-p(2009) :- p(2008), q(2009).
-q(2009) :- p(2008), q(2008).
-p(2010) :- p(2009), q(2010).
-q(2010) :- p(2009), q(2009).
-p(2011) :- p(2010), q(2011).
-q(2011) :- p(2010), q(2010).
-p(2012) :- p(2011), q(2012).
-q(2012) :- p(2011), q(2011).
-p(2013) :- p(2012), q(2013).
-q(2013) :- p(2012), q(2012).
-p(2014) :- p(2013), q(2014).
-q(2014) :- p(2013), q(2013).
-p(2015) :- p(2014), q(2015).
-q(2015) :- p(2014), q(2014).
-p(2016) :- p(2015), q(2016).
-q(2016) :- p(2015), q(2015).
-p(2017) :- p(2016), q(2017).
-q(2017) :- p(2016), q(2016).
-p(2018) :- p(2017), q(2018).
-q(2018) :- p(2017), q(2017).
-p(2019) :- p(2018), q(2019).
-q(2019) :- p(2018), q(2018).
-p(2020) :- p(2019), q(2020).
-q(2020) :- p(2019), q(2019).
-p(2021) :- p(2020), q(2021).
-q(2021) :- p(2020), q(2020).
-p(2022) :- p(2021), q(2022).
-q(2022) :- p(2021), q(2021).
-p(2023) :- p(2022), q(2023).
-q(2023) :- p(2022), q(2022).
-p(2024) :- p(2023), q(2024).
-q(2024) :- p(2023), q(2023).
-p(2025) :- p(2024), q(2025).
-q(2025) :- p(2024), q(2024).
-p(2026) :- p(2025), q(2026).
-q(2026) :- p(2025), q(2025).
-p(2027) :- p(2026), q(2027).
-q(2027) :- p(2026), q(2026).
-p(2028) :- p(2027), q(2028).
-q(2028) :- p(2027), q(2027).
-p(2029) :- p(2028), q(2029).
-q(2029) :- p(2028), q(2028).
-p(2030) :- p(2029), q(2030).
-q(2030) :- p(2029), q(2029).
-p(2031) :- p(2030), q(2031).
-q(2031) :- p(2030), q(2030).
-p(2032) :- p(2031), q(2032).
-q(2032) :- p(2031), q(2031).
-p(2033) :- p(2032), q(2033).
-q(2033) :- p(2032), q(2032).
-p(2034) :- p(2033), q(2034).
-q(2034) :- p(2033), q(2033).
-p(2035) :- p(2034), q(2035).
-q(2035) :- p(2034), q(2034).
-p(2036) :- p(2035), q(2036).
-q(2036) :- p(2035), q(2035).
-p(2037) :- p(2036), q(2037).
-q(2037) :- p(2036), q(2036).
-p(2038) :- p(2037), q(2038).
-q(2038) :- p(2037), q(2037).
-p(2039) :- p(2038), q(2039).
-q(2039) :- p(2038), q(2038).
-p(2040) :- p(2039), q(2040).
-q(2040) :- p(2039), q(2039).
-p(2041) :- p(2040), q(2041).
-q(2041) :- p(2040), q(2040).
-p(2042) :- p(2041), q(2042).
-q(2042) :- p(2041), q(2041).
-p(2043) :- p(2042), q(2043).
-q(2043) :- p(2042), q(2042).
-p(2044) :- p(2043), q(2044).
-q(2044) :- p(2043), q(2043).
-p(2045) :- p(2044), q(2045).
-q(2045) :- p(2044), q(2044).
-p(2046) :- p(2045), q(2046).
-q(2046) :- p(2045), q(2045).
-p(2047) :- p(2046), q(2047).
-q(2047) :- p(2046), q(2046).
-p(2048) :- p(2047), q(2048).
-q(2048) :- p(2047), q(2047).
-p(2049) :- p(2048), q(2049).
-q(2049) :- p(2048), q(2048).
-p(2050) :- p(2049), q(2050).
-q(2050) :- p(2049), q(2049).
-p(2051) :- p(2050), q(2051).
-q(2051) :- p(2050), q(2050).
-p(2052) :- p(2051), q(2052).
-q(2052) :- p(2051), q(2051).
-p(2053) :- p(2052), q(2053).
-q(2053) :- p(2052), q(2052).
-p(2054) :- p(2053), q(2054).
-q(2054) :- p(2053), q(2053).
-p(2055) :- p(2054), q(2055).
-q(2055) :- p(2054), q(2054).
-p(2056) :- p(2055), q(2056).
-q(2056) :- p(2055), q(2055).
-p(2057) :- p(2056), q(2057).
-q(2057) :- p(2056), q(2056).
-p(2058) :- p(2057), q(2058).
-q(2058) :- p(2057), q(2057).
-p(2059) :- p(2058), q(2059).
-q(2059) :- p(2058), q(2058).
-p(2060) :- p(2059), q(2060).
-q(2060) :- p(2059), q(2059).
-p(2061) :- p(2060), q(2061).
-q(2061) :- p(2060), q(2060).
-p(2062) :- p(2061), q(2062).
-q(2062) :- p(2061), q(2061).
-p(2063) :- p(2062), q(2063).
-q(2063) :- p(2062), q(2062).
-p(2064) :- p(2063), q(2064).
-q(2064) :- p(2063), q(2063).
-p(2065) :- p(2064), q(2065).
-q(2065) :- p(2064), q(2064).
-p(2066) :- p(2065), q(2066).
-q(2066) :- p(2065), q(2065).
-p(2067) :- p(2066), q(2067).
-q(2067) :- p(2066), q(2066).
-p(2068) :- p(2067), q(2068).
-q(2068) :- p(2067), q(2067).
-p(2069) :- p(2068), q(2069).
-q(2069) :- p(2068), q(2068).
-p(2070) :- p(2069), q(2070).
-q(2070) :- p(2069), q(2069).
-p(2071) :- p(2070), q(2071).
-q(2071) :- p(2070), q(2070).
-p(2072) :- p(2071), q(2072).
-q(2072) :- p(2071), q(2071).
-p(2073) :- p(2072), q(2073).
-q(2073) :- p(2072), q(2072).
-p(2074) :- p(2073), q(2074).
-q(2074) :- p(2073), q(2073).
-p(2075) :- p(2074), q(2075).
-q(2075) :- p(2074), q(2074).
-p(2076) :- p(2075), q(2076).
-q(2076) :- p(2075), q(2075).
-p(2077) :- p(2076), q(2077).
-q(2077) :- p(2076), q(2076).
-p(2078) :- p(2077), q(2078).
-q(2078) :- p(2077), q(2077).
-p(2079) :- p(2078), q(2079).
-q(2079) :- p(2078), q(2078).
-p(2080) :- p(2079), q(2080).
-q(2080) :- p(2079), q(2079).
-p(2081) :- p(2080), q(2081).
-q(2081) :- p(2080), q(2080).
-p(2082) :- p(2081), q(2082).
-q(2082) :- p(2081), q(2081).
-p(2083) :- p(2082), q(2083).
-q(2083) :- p(2082), q(2082).
-p(2084) :- p(2083), q(2084).
-q(2084) :- p(2083), q(2083).
-p(2085) :- p(2084), q(2085).
-q(2085) :- p(2084), q(2084).
-p(2086) :- p(2085), q(2086).
-q(2086) :- p(2085), q(2085).
-p(2087) :- p(2086), q(2087).
-q(2087) :- p(2086), q(2086).
-p(2088) :- p(2087), q(2088).
-q(2088) :- p(2087), q(2087).
-p(2089) :- p(2088), q(2089).
-q(2089) :- p(2088), q(2088).
-p(2090) :- p(2089), q(2090).
-q(2090) :- p(2089), q(2089).
-p(2091) :- p(2090), q(2091).
-q(2091) :- p(2090), q(2090).
-p(2092) :- p(2091), q(2092).
-q(2092) :- p(2091), q(2091).
-p(2093) :- p(2092), q(2093).
-q(2093) :- p(2092), q(2092).
-p(2094) :- p(2093), q(2094).
-q(2094) :- p(2093), q(2093).
-p(2095) :- p(2094), q(2095).
-q(2095) :- p(2094), q(2094).
-p(2096) :- p(2095), q(2096).
-q(2096) :- p(2095), q(2095).
-p(2097) :- p(2096), q(2097).
-q(2097) :- p(2096), q(2096).
-p(2098) :- p(2097), q(2098).
-q(2098) :- p(2097), q(2097).
-p(2099) :- p(2098), q(2099).
-q(2099) :- p(2098), q(2098).
-p(2100) :- p(2099), q(2100).
-q(2100) :- p(2099), q(2099).
-p(2101) :- p(2100), q(2101).
-q(2101) :- p(2100), q(2100).
-p(2102) :- p(2101), q(2102).
-q(2102) :- p(2101), q(2101).
-p(2103) :- p(2102), q(2103).
-q(2103) :- p(2102), q(2102).
-p(2104) :- p(2103), q(2104).
-q(2104) :- p(2103), q(2103).
-p(2105) :- p(2104), q(2105).
-q(2105) :- p(2104), q(2104).
-p(2106) :- p(2105), q(2106).
-q(2106) :- p(2105), q(2105).
-p(2107) :- p(2106), q(2107).
-q(2107) :- p(2106), q(2106).
-p(2108) :- p(2107), q(2108).
-q(2108) :- p(2107), q(2107).
-p(2109) :- p(2108), q(2109).
-q(2109) :- p(2108), q(2108).
-p(2110) :- p(2109), q(2110).
-q(2110) :- p(2109), q(2109).
-p(2111) :- p(2110), q(2111).
-q(2111) :- p(2110), q(2110).
-p(2112) :- p(2111), q(2112).
-q(2112) :- p(2111), q(2111).
-p(2113) :- p(2112), q(2113).
-q(2113) :- p(2112), q(2112).
-p(2114) :- p(2113), q(2114).
-q(2114) :- p(2113), q(2113).
-p(2115) :- p(2114), q(2115).
-q(2115) :- p(2114), q(2114).
-p(2116) :- p(2115), q(2116).
-q(2116) :- p(2115), q(2115).
-p(2117) :- p(2116), q(2117).
-q(2117) :- p(2116), q(2116).
-p(2118) :- p(2117), q(2118).
-q(2118) :- p(2117), q(2117).
-p(2119) :- p(2118), q(2119).
-q(2119) :- p(2118), q(2118).
-p(2120) :- p(2119), q(2120).
-q(2120) :- p(2119), q(2119).
-p(2121) :- p(2120), q(2121).
-q(2121) :- p(2120), q(2120).
-p(2122) :- p(2121), q(2122).
-q(2122) :- p(2121), q(2121).
-p(2123) :- p(2122), q(2123).
-q(2123) :- p(2122), q(2122).
-p(2124) :- p(2123), q(2124).
-q(2124) :- p(2123), q(2123).
-p(2125) :- p(2124), q(2125).
-q(2125) :- p(2124), q(2124).
-p(2126) :- p(2125), q(2126).
-q(2126) :- p(2125), q(2125).
-p(2127) :- p(2126), q(2127).
-q(2127) :- p(2126), q(2126).
-p(2128) :- p(2127), q(2128).
-q(2128) :- p(2127), q(2127).
-p(2129) :- p(2128), q(2129).
-q(2129) :- p(2128), q(2128).
-p(2130) :- p(2129), q(2130).
-q(2130) :- p(2129), q(2129).
-p(2131) :- p(2130), q(2131).
-q(2131) :- p(2130), q(2130).
-p(2132) :- p(2131), q(2132).
-q(2132) :- p(2131), q(2131).
-p(2133) :- p(2132), q(2133).
-q(2133) :- p(2132), q(2132).
-p(2134) :- p(2133), q(2134).
-q(2134) :- p(2133), q(2133).
-p(2135) :- p(2134), q(2135).
-q(2135) :- p(2134), q(2134).
-p(2136) :- p(2135), q(2136).
-q(2136) :- p(2135), q(2135).
-p(2137) :- p(2136), q(2137).
-q(2137) :- p(2136), q(2136).
-p(2138) :- p(2137), q(2138).
-q(2138) :- p(2137), q(2137).
-p(2139) :- p(2138), q(2139).
-q(2139) :- p(2138), q(2138).
-p(2140) :- p(2139), q(2140).
-q(2140) :- p(2139), q(2139).
-p(2141) :- p(2140), q(2141).
-q(2141) :- p(2140), q(2140).
-p(2142) :- p(2141), q(2142).
-q(2142) :- p(2141), q(2141).
-p(2143) :- p(2142), q(2143).
-q(2143) :- p(2142), q(2142).
-p(2144) :- p(2143), q(2144).
-q(2144) :- p(2143), q(2143).
-p(2145) :- p(2144), q(2145).
-q(2145) :- p(2144), q(2144).
-p(2146) :- p(2145), q(2146).
-q(2146) :- p(2145), q(2145).
-p(2147) :- p(2146), q(2147).
-q(2147) :- p(2146), q(2146).
-p(2148) :- p(2147), q(2148).
-q(2148) :- p(2147), q(2147).
-p(2149) :- p(2148), q(2149).
-q(2149) :- p(2148), q(2148).
-p(2150) :- p(2149), q(2150).
-q(2150) :- p(2149), q(2149).
-p(2151) :- p(2150), q(2151).
-q(2151) :- p(2150), q(2150).
-p(2152) :- p(2151), q(2152).
-q(2152) :- p(2151), q(2151).
-p(2153) :- p(2152), q(2153).
-q(2153) :- p(2152), q(2152).
-p(2154) :- p(2153), q(2154).
-q(2154) :- p(2153), q(2153).
-p(2155) :- p(2154), q(2155).
-q(2155) :- p(2154), q(2154).
-p(2156) :- p(2155), q(2156).
-q(2156) :- p(2155), q(2155).
-p(2157) :- p(2156), q(2157).
-q(2157) :- p(2156), q(2156).
-p(2158) :- p(2157), q(2158).
-q(2158) :- p(2157), q(2157).
-p(2159) :- p(2158), q(2159).
-q(2159) :- p(2158), q(2158).
-p(2160) :- p(2159), q(2160).
-q(2160) :- p(2159), q(2159).
-p(2161) :- p(2160), q(2161).
-q(2161) :- p(2160), q(2160).
-p(2162) :- p(2161), q(2162).
-q(2162) :- p(2161), q(2161).
-p(2163) :- p(2162), q(2163).
-q(2163) :- p(2162), q(2162).
-p(2164) :- p(2163), q(2164).
-q(2164) :- p(2163), q(2163).
-p(2165) :- p(2164), q(2165).
-q(2165) :- p(2164), q(2164).
-p(2166) :- p(2165), q(2166).
-q(2166) :- p(2165), q(2165).
-p(2167) :- p(2166), q(2167).
-q(2167) :- p(2166), q(2166).
-p(2168) :- p(2167), q(2168).
-q(2168) :- p(2167), q(2167).
-p(2169) :- p(2168), q(2169).
-q(2169) :- p(2168), q(2168).
-p(2170) :- p(2169), q(2170).
-q(2170) :- p(2169), q(2169).
-p(2171) :- p(2170), q(2171).
-q(2171) :- p(2170), q(2170).
-p(2172) :- p(2171), q(2172).
-q(2172) :- p(2171), q(2171).
-p(2173) :- p(2172), q(2173).
-q(2173) :- p(2172), q(2172).
-p(2174) :- p(2173), q(2174).
-q(2174) :- p(2173), q(2173).
-p(2175) :- p(2174), q(2175).
-q(2175) :- p(2174), q(2174).
-p(2176) :- p(2175), q(2176).
-q(2176) :- p(2175), q(2175).
-p(2177) :- p(2176), q(2177).
-q(2177) :- p(2176), q(2176).
-p(2178) :- p(2177), q(2178).
-q(2178) :- p(2177), q(2177).
-p(2179) :- p(2178), q(2179).
-q(2179) :- p(2178), q(2178).
-p(2180) :- p(2179), q(2180).
-q(2180) :- p(2179), q(2179).
-p(2181) :- p(2180), q(2181).
-q(2181) :- p(2180), q(2180).
-p(2182) :- p(2181), q(2182).
-q(2182) :- p(2181), q(2181).
-p(2183) :- p(2182), q(2183).
-q(2183) :- p(2182), q(2182).
-p(2184) :- p(2183), q(2184).
-q(2184) :- p(2183), q(2183).
-p(2185) :- p(2184), q(2185).
-q(2185) :- p(2184), q(2184).
-p(2186) :- p(2185), q(2186).
-q(2186) :- p(2185), q(2185).
-p(2187) :- p(2186), q(2187).
-q(2187) :- p(2186), q(2186).
-p(2188) :- p(2187), q(2188).
-q(2188) :- p(2187), q(2187).
-p(2189) :- p(2188), q(2189).
-q(2189) :- p(2188), q(2188).
-p(2190) :- p(2189), q(2190).
-q(2190) :- p(2189), q(2189).
-p(2191) :- p(2190), q(2191).
-q(2191) :- p(2190), q(2190).
-p(2192) :- p(2191), q(2192).
-q(2192) :- p(2191), q(2191).
-p(2193) :- p(2192), q(2193).
-q(2193) :- p(2192), q(2192).
-p(2194) :- p(2193), q(2194).
-q(2194) :- p(2193), q(2193).
-p(2195) :- p(2194), q(2195).
-q(2195) :- p(2194), q(2194).
-p(2196) :- p(2195), q(2196).
-q(2196) :- p(2195), q(2195).
-p(2197) :- p(2196), q(2197).
-q(2197) :- p(2196), q(2196).
-p(2198) :- p(2197), q(2198).
-q(2198) :- p(2197), q(2197).
-p(2199) :- p(2198), q(2199).
-q(2199) :- p(2198), q(2198).
-p(2200) :- p(2199), q(2200).
-q(2200) :- p(2199), q(2199).
-p(2201) :- p(2200), q(2201).
-q(2201) :- p(2200), q(2200).
-p(2202) :- p(2201), q(2202).
-q(2202) :- p(2201), q(2201).
-p(2203) :- p(2202), q(2203).
-q(2203) :- p(2202), q(2202).
-p(2204) :- p(2203), q(2204).
-q(2204) :- p(2203), q(2203).
-p(2205) :- p(2204), q(2205).
-q(2205) :- p(2204), q(2204).
-p(2206) :- p(2205), q(2206).
-q(2206) :- p(2205), q(2205).
-p(2207) :- p(2206), q(2207).
-q(2207) :- p(2206), q(2206).
-p(2208) :- p(2207), q(2208).
-q(2208) :- p(2207), q(2207).
-p(2209) :- p(2208), q(2209).
-q(2209) :- p(2208), q(2208).
-p(2210) :- p(2209), q(2210).
-q(2210) :- p(2209), q(2209).
-p(2211) :- p(2210), q(2211).
-q(2211) :- p(2210), q(2210).
-p(2212) :- p(2211), q(2212).
-q(2212) :- p(2211), q(2211).
-p(2213) :- p(2212), q(2213).
-q(2213) :- p(2212), q(2212).
-p(2214) :- p(2213), q(2214).
-q(2214) :- p(2213), q(2213).
-p(2215) :- p(2214), q(2215).
-q(2215) :- p(2214), q(2214).
-p(2216) :- p(2215), q(2216).
-q(2216) :- p(2215), q(2215).
-p(2217) :- p(2216), q(2217).
-q(2217) :- p(2216), q(2216).
-p(2218) :- p(2217), q(2218).
-q(2218) :- p(2217), q(2217).
-p(2219) :- p(2218), q(2219).
-q(2219) :- p(2218), q(2218).
-p(2220) :- p(2219), q(2220).
-q(2220) :- p(2219), q(2219).
-p(2221) :- p(2220), q(2221).
-q(2221) :- p(2220), q(2220).
-p(2222) :- p(2221), q(2222).
-q(2222) :- p(2221), q(2221).
-p(2223) :- p(2222), q(2223).
-q(2223) :- p(2222), q(2222).
-p(2224) :- p(2223), q(2224).
-q(2224) :- p(2223), q(2223).
-p(2225) :- p(2224), q(2225).
-q(2225) :- p(2224), q(2224).
-p(2226) :- p(2225), q(2226).
-q(2226) :- p(2225), q(2225).
-p(2227) :- p(2226), q(2227).
-q(2227) :- p(2226), q(2226).
-p(2228) :- p(2227), q(2228).
-q(2228) :- p(2227), q(2227).
-p(2229) :- p(2228), q(2229).
-q(2229) :- p(2228), q(2228).
-p(2230) :- p(2229), q(2230).
-q(2230) :- p(2229), q(2229).
-p(2231) :- p(2230), q(2231).
-q(2231) :- p(2230), q(2230).
-p(2232) :- p(2231), q(2232).
-q(2232) :- p(2231), q(2231).
-p(2233) :- p(2232), q(2233).
-q(2233) :- p(2232), q(2232).
-p(2234) :- p(2233), q(2234).
-q(2234) :- p(2233), q(2233).
-p(2235) :- p(2234), q(2235).
-q(2235) :- p(2234), q(2234).
-p(2236) :- p(2235), q(2236).
-q(2236) :- p(2235), q(2235).
-p(2237) :- p(2236), q(2237).
-q(2237) :- p(2236), q(2236).
-p(2238) :- p(2237), q(2238).
-q(2238) :- p(2237), q(2237).
-p(2239) :- p(2238), q(2239).
-q(2239) :- p(2238), q(2238).
-p(2240) :- p(2239), q(2240).
-q(2240) :- p(2239), q(2239).
-p(2241) :- p(2240), q(2241).
-q(2241) :- p(2240), q(2240).
-p(2242) :- p(2241), q(2242).
-q(2242) :- p(2241), q(2241).
-p(2243) :- p(2242), q(2243).
-q(2243) :- p(2242), q(2242).
-p(2244) :- p(2243), q(2244).
-q(2244) :- p(2243), q(2243).
-p(2245) :- p(2244), q(2245).
-q(2245) :- p(2244), q(2244).
-p(2246) :- p(2245), q(2246).
-q(2246) :- p(2245), q(2245).
-p(2247) :- p(2246), q(2247).
-q(2247) :- p(2246), q(2246).
-p(2248) :- p(2247), q(2248).
-q(2248) :- p(2247), q(2247).
-p(2249) :- p(2248), q(2249).
-q(2249) :- p(2248), q(2248).
-p(2250) :- p(2249), q(2250).
-q(2250) :- p(2249), q(2249).
-p(2251) :- p(2250), q(2251).
-q(2251) :- p(2250), q(2250).
-p(2252) :- p(2251), q(2252).
-q(2252) :- p(2251), q(2251).
-p(2253) :- p(2252), q(2253).
-q(2253) :- p(2252), q(2252).
-p(2254) :- p(2253), q(2254).
-q(2254) :- p(2253), q(2253).
-p(2255) :- p(2254), q(2255).
-q(2255) :- p(2254), q(2254).
-p(2256) :- p(2255), q(2256).
-q(2256) :- p(2255), q(2255).
-p(2257) :- p(2256), q(2257).
-q(2257) :- p(2256), q(2256).
-p(2258) :- p(2257), q(2258).
-q(2258) :- p(2257), q(2257).
-p(2259) :- p(2258), q(2259).
-q(2259) :- p(2258), q(2258).
-p(2260) :- p(2259), q(2260).
-q(2260) :- p(2259), q(2259).
-p(2261) :- p(2260), q(2261).
-q(2261) :- p(2260), q(2260).
-p(2262) :- p(2261), q(2262).
-q(2262) :- p(2261), q(2261).
-p(2263) :- p(2262), q(2263).
-q(2263) :- p(2262), q(2262).
-p(2264) :- p(2263), q(2264).
-q(2264) :- p(2263), q(2263).
-p(2265) :- p(2264), q(2265).
-q(2265) :- p(2264), q(2264).
-p(2266) :- p(2265), q(2266).
-q(2266) :- p(2265), q(2265).
-p(2267) :- p(2266), q(2267).
-q(2267) :- p(2266), q(2266).
-p(2268) :- p(2267), q(2268).
-q(2268) :- p(2267), q(2267).
-p(2269) :- p(2268), q(2269).
-q(2269) :- p(2268), q(2268).
-p(2270) :- p(2269), q(2270).
-q(2270) :- p(2269), q(2269).
-p(2271) :- p(2270), q(2271).
-q(2271) :- p(2270), q(2270).
-p(2272) :- p(2271), q(2272).
-q(2272) :- p(2271), q(2271).
-p(2273) :- p(2272), q(2273).
-q(2273) :- p(2272), q(2272).
-p(2274) :- p(2273), q(2274).
-q(2274) :- p(2273), q(2273).
-p(2275) :- p(2274), q(2275).
-q(2275) :- p(2274), q(2274).
-p(2276) :- p(2275), q(2276).
-q(2276) :- p(2275), q(2275).
-p(2277) :- p(2276), q(2277).
-q(2277) :- p(2276), q(2276).
-p(2278) :- p(2277), q(2278).
-q(2278) :- p(2277), q(2277).
-p(2279) :- p(2278), q(2279).
-q(2279) :- p(2278), q(2278).
-p(2280) :- p(2279), q(2280).
-q(2280) :- p(2279), q(2279).
-p(2281) :- p(2280), q(2281).
-q(2281) :- p(2280), q(2280).
-p(2282) :- p(2281), q(2282).
-q(2282) :- p(2281), q(2281).
-p(2283) :- p(2282), q(2283).
-q(2283) :- p(2282), q(2282).
-p(2284) :- p(2283), q(2284).
-q(2284) :- p(2283), q(2283).
-p(2285) :- p(2284), q(2285).
-q(2285) :- p(2284), q(2284).
-p(2286) :- p(2285), q(2286).
-q(2286) :- p(2285), q(2285).
-p(2287) :- p(2286), q(2287).
-q(2287) :- p(2286), q(2286).
-p(2288) :- p(2287), q(2288).
-q(2288) :- p(2287), q(2287).
-p(2289) :- p(2288), q(2289).
-q(2289) :- p(2288), q(2288).
-p(2290) :- p(2289), q(2290).
-q(2290) :- p(2289), q(2289).
-p(2291) :- p(2290), q(2291).
-q(2291) :- p(2290), q(2290).
-p(2292) :- p(2291), q(2292).
-q(2292) :- p(2291), q(2291).
-p(2293) :- p(2292), q(2293).
-q(2293) :- p(2292), q(2292).
-p(2294) :- p(2293), q(2294).
-q(2294) :- p(2293), q(2293).
-p(2295) :- p(2294), q(2295).
-q(2295) :- p(2294), q(2294).
-p(2296) :- p(2295), q(2296).
-q(2296) :- p(2295), q(2295).
-p(2297) :- p(2296), q(2297).
-q(2297) :- p(2296), q(2296).
-p(2298) :- p(2297), q(2298).
-q(2298) :- p(2297), q(2297).
-p(2299) :- p(2298), q(2299).
-q(2299) :- p(2298), q(2298).
-p(2300) :- p(2299), q(2300).
-q(2300) :- p(2299), q(2299).
-p(2301) :- p(2300), q(2301).
-q(2301) :- p(2300), q(2300).
-p(2302) :- p(2301), q(2302).
-q(2302) :- p(2301), q(2301).
-p(2303) :- p(2302), q(2303).
-q(2303) :- p(2302), q(2302).
-p(2304) :- p(2303), q(2304).
-q(2304) :- p(2303), q(2303).
-p(2305) :- p(2304), q(2305).
-q(2305) :- p(2304), q(2304).
-p(2306) :- p(2305), q(2306).
-q(2306) :- p(2305), q(2305).
-p(2307) :- p(2306), q(2307).
-q(2307) :- p(2306), q(2306).
-p(2308) :- p(2307), q(2308).
-q(2308) :- p(2307), q(2307).
-p(2309) :- p(2308), q(2309).
-q(2309) :- p(2308), q(2308).
-p(2310) :- p(2309), q(2310).
-q(2310) :- p(2309), q(2309).
-p(2311) :- p(2310), q(2311).
-q(2311) :- p(2310), q(2310).
-p(2312) :- p(2311), q(2312).
-q(2312) :- p(2311), q(2311).
-p(2313) :- p(2312), q(2313).
-q(2313) :- p(2312), q(2312).
-p(2314) :- p(2313), q(2314).
-q(2314) :- p(2313), q(2313).
-p(2315) :- p(2314), q(2315).
-q(2315) :- p(2314), q(2314).
-p(2316) :- p(2315), q(2316).
-q(2316) :- p(2315), q(2315).
-p(2317) :- p(2316), q(2317).
-q(2317) :- p(2316), q(2316).
-p(2318) :- p(2317), q(2318).
-q(2318) :- p(2317), q(2317).
-p(2319) :- p(2318), q(2319).
-q(2319) :- p(2318), q(2318).
-p(2320) :- p(2319), q(2320).
-q(2320) :- p(2319), q(2319).
-p(2321) :- p(2320), q(2321).
-q(2321) :- p(2320), q(2320).
-p(2322) :- p(2321), q(2322).
-q(2322) :- p(2321), q(2321).
-p(2323) :- p(2322), q(2323).
-q(2323) :- p(2322), q(2322).
-p(2324) :- p(2323), q(2324).
-q(2324) :- p(2323), q(2323).
-p(2325) :- p(2324), q(2325).
-q(2325) :- p(2324), q(2324).
-p(2326) :- p(2325), q(2326).
-q(2326) :- p(2325), q(2325).
-p(2327) :- p(2326), q(2327).
-q(2327) :- p(2326), q(2326).
-p(2328) :- p(2327), q(2328).
-q(2328) :- p(2327), q(2327).
-p(2329) :- p(2328), q(2329).
-q(2329) :- p(2328), q(2328).
-p(2330) :- p(2329), q(2330).
-q(2330) :- p(2329), q(2329).
-p(2331) :- p(2330), q(2331).
-q(2331) :- p(2330), q(2330).
-p(2332) :- p(2331), q(2332).
-q(2332) :- p(2331), q(2331).
-p(2333) :- p(2332), q(2333).
-q(2333) :- p(2332), q(2332).
-p(2334) :- p(2333), q(2334).
-q(2334) :- p(2333), q(2333).
-p(2335) :- p(2334), q(2335).
-q(2335) :- p(2334), q(2334).
-p(2336) :- p(2335), q(2336).
-q(2336) :- p(2335), q(2335).
-p(2337) :- p(2336), q(2337).
-q(2337) :- p(2336), q(2336).
-p(2338) :- p(2337), q(2338).
-q(2338) :- p(2337), q(2337).
-p(2339) :- p(2338), q(2339).
-q(2339) :- p(2338), q(2338).
-p(2340) :- p(2339), q(2340).
-q(2340) :- p(2339), q(2339).
-p(2341) :- p(2340), q(2341).
-q(2341) :- p(2340), q(2340).
-p(2342) :- p(2341), q(2342).
-q(2342) :- p(2341), q(2341).
-p(2343) :- p(2342), q(2343).
-q(2343) :- p(2342), q(2342).
-p(2344) :- p(2343), q(2344).
-q(2344) :- p(2343), q(2343).
-p(2345) :- p(2344), q(2345).
-q(2345) :- p(2344), q(2344).
-p(2346) :- p(2345), q(2346).
-q(2346) :- p(2345), q(2345).
-p(2347) :- p(2346), q(2347).
-q(2347) :- p(2346), q(2346).
-p(2348) :- p(2347), q(2348).
-q(2348) :- p(2347), q(2347).
-p(2349) :- p(2348), q(2349).
-q(2349) :- p(2348), q(2348).
-p(2350) :- p(2349), q(2350).
-q(2350) :- p(2349), q(2349).
-p(2351) :- p(2350), q(2351).
-q(2351) :- p(2350), q(2350).
-p(2352) :- p(2351), q(2352).
-q(2352) :- p(2351), q(2351).
-p(2353) :- p(2352), q(2353).
-q(2353) :- p(2352), q(2352).
-p(2354) :- p(2353), q(2354).
-q(2354) :- p(2353), q(2353).
-p(2355) :- p(2354), q(2355).
-q(2355) :- p(2354), q(2354).
-p(2356) :- p(2355), q(2356).
-q(2356) :- p(2355), q(2355).
-p(2357) :- p(2356), q(2357).
-q(2357) :- p(2356), q(2356).
-p(2358) :- p(2357), q(2358).
-q(2358) :- p(2357), q(2357).
-p(2359) :- p(2358), q(2359).
-q(2359) :- p(2358), q(2358).
-p(2360) :- p(2359), q(2360).
-q(2360) :- p(2359), q(2359).
-p(2361) :- p(2360), q(2361).
-q(2361) :- p(2360), q(2360).
-p(2362) :- p(2361), q(2362).
-q(2362) :- p(2361), q(2361).
-p(2363) :- p(2362), q(2363).
-q(2363) :- p(2362), q(2362).
-p(2364) :- p(2363), q(2364).
-q(2364) :- p(2363), q(2363).
-p(2365) :- p(2364), q(2365).
-q(2365) :- p(2364), q(2364).
-p(2366) :- p(2365), q(2366).
-q(2366) :- p(2365), q(2365).
-p(2367) :- p(2366), q(2367).
-q(2367) :- p(2366), q(2366).
-p(2368) :- p(2367), q(2368).
-q(2368) :- p(2367), q(2367).
-p(2369) :- p(2368), q(2369).
-q(2369) :- p(2368), q(2368).
-p(2370) :- p(2369), q(2370).
-q(2370) :- p(2369), q(2369).
-p(2371) :- p(2370), q(2371).
-q(2371) :- p(2370), q(2370).
-p(2372) :- p(2371), q(2372).
-q(2372) :- p(2371), q(2371).
-p(2373) :- p(2372), q(2373).
-q(2373) :- p(2372), q(2372).
-p(2374) :- p(2373), q(2374).
-q(2374) :- p(2373), q(2373).
-p(2375) :- p(2374), q(2375).
-q(2375) :- p(2374), q(2374).
-p(2376) :- p(2375), q(2376).
-q(2376) :- p(2375), q(2375).
-p(2377) :- p(2376), q(2377).
-q(2377) :- p(2376), q(2376).
-p(2378) :- p(2377), q(2378).
-q(2378) :- p(2377), q(2377).
-p(2379) :- p(2378), q(2379).
-q(2379) :- p(2378), q(2378).
-p(2380) :- p(2379), q(2380).
-q(2380) :- p(2379), q(2379).
-p(2381) :- p(2380), q(2381).
-q(2381) :- p(2380), q(2380).
-p(2382) :- p(2381), q(2382).
-q(2382) :- p(2381), q(2381).
-p(2383) :- p(2382), q(2383).
-q(2383) :- p(2382), q(2382).
-p(2384) :- p(2383), q(2384).
-q(2384) :- p(2383), q(2383).
-p(2385) :- p(2384), q(2385).
-q(2385) :- p(2384), q(2384).
-p(2386) :- p(2385), q(2386).
-q(2386) :- p(2385), q(2385).
-p(2387) :- p(2386), q(2387).
-q(2387) :- p(2386), q(2386).
-p(2388) :- p(2387), q(2388).
-q(2388) :- p(2387), q(2387).
-p(2389) :- p(2388), q(2389).
-q(2389) :- p(2388), q(2388).
-p(2390) :- p(2389), q(2390).
-q(2390) :- p(2389), q(2389).
-p(2391) :- p(2390), q(2391).
-q(2391) :- p(2390), q(2390).
-p(2392) :- p(2391), q(2392).
-q(2392) :- p(2391), q(2391).
-p(2393) :- p(2392), q(2393).
-q(2393) :- p(2392), q(2392).
-p(2394) :- p(2393), q(2394).
-q(2394) :- p(2393), q(2393).
-p(2395) :- p(2394), q(2395).
-q(2395) :- p(2394), q(2394).
-p(2396) :- p(2395), q(2396).
-q(2396) :- p(2395), q(2395).
-p(2397) :- p(2396), q(2397).
-q(2397) :- p(2396), q(2396).
-p(2398) :- p(2397), q(2398).
-q(2398) :- p(2397), q(2397).
-p(2399) :- p(2398), q(2399).
-q(2399) :- p(2398), q(2398).
-p(2400) :- p(2399), q(2400).
-q(2400) :- p(2399), q(2399).
-p(2401) :- p(2400), q(2401).
-q(2401) :- p(2400), q(2400).
-p(2402) :- p(2401), q(2402).
-q(2402) :- p(2401), q(2401).
-p(2403) :- p(2402), q(2403).
-q(2403) :- p(2402), q(2402).
-p(2404) :- p(2403), q(2404).
-q(2404) :- p(2403), q(2403).
-p(2405) :- p(2404), q(2405).
-q(2405) :- p(2404), q(2404).
-p(2406) :- p(2405), q(2406).
-q(2406) :- p(2405), q(2405).
-p(2407) :- p(2406), q(2407).
-q(2407) :- p(2406), q(2406).
-p(2408) :- p(2407), q(2408).
-q(2408) :- p(2407), q(2407).
-p(2409) :- p(2408), q(2409).
-q(2409) :- p(2408), q(2408).
-p(2410) :- p(2409), q(2410).
-q(2410) :- p(2409), q(2409).
-p(2411) :- p(2410), q(2411).
-q(2411) :- p(2410), q(2410).
-p(2412) :- p(2411), q(2412).
-q(2412) :- p(2411), q(2411).
-p(2413) :- p(2412), q(2413).
-q(2413) :- p(2412), q(2412).
-p(2414) :- p(2413), q(2414).
-q(2414) :- p(2413), q(2413).
-p(2415) :- p(2414), q(2415).
-q(2415) :- p(2414), q(2414).
-p(2416) :- p(2415), q(2416).
-q(2416) :- p(2415), q(2415).
-p(2417) :- p(2416), q(2417).
-q(2417) :- p(2416), q(2416).
-p(2418) :- p(2417), q(2418).
-q(2418) :- p(2417), q(2417).
-p(2419) :- p(2418), q(2419).
-q(2419) :- p(2418), q(2418).
-p(2420) :- p(2419), q(2420).
-q(2420) :- p(2419), q(2419).
-p(2421) :- p(2420), q(2421).
-q(2421) :- p(2420), q(2420).
-p(2422) :- p(2421), q(2422).
-q(2422) :- p(2421), q(2421).
-p(2423) :- p(2422), q(2423).
-q(2423) :- p(2422), q(2422).
-p(2424) :- p(2423), q(2424).
-q(2424) :- p(2423), q(2423).
-p(2425) :- p(2424), q(2425).
-q(2425) :- p(2424), q(2424).
-p(2426) :- p(2425), q(2426).
-q(2426) :- p(2425), q(2425).
-p(2427) :- p(2426), q(2427).
-q(2427) :- p(2426), q(2426).
-p(2428) :- p(2427), q(2428).
-q(2428) :- p(2427), q(2427).
-p(2429) :- p(2428), q(2429).
-q(2429) :- p(2428), q(2428).
-p(2430) :- p(2429), q(2430).
-q(2430) :- p(2429), q(2429).
-p(2431) :- p(2430), q(2431).
-q(2431) :- p(2430), q(2430).
-p(2432) :- p(2431), q(2432).
-q(2432) :- p(2431), q(2431).
-p(2433) :- p(2432), q(2433).
-q(2433) :- p(2432), q(2432).
-p(2434) :- p(2433), q(2434).
-q(2434) :- p(2433), q(2433).
-p(2435) :- p(2434), q(2435).
-q(2435) :- p(2434), q(2434).
-p(2436) :- p(2435), q(2436).
-q(2436) :- p(2435), q(2435).
-p(2437) :- p(2436), q(2437).
-q(2437) :- p(2436), q(2436).
-p(2438) :- p(2437), q(2438).
-q(2438) :- p(2437), q(2437).
-p(2439) :- p(2438), q(2439).
-q(2439) :- p(2438), q(2438).
-p(2440) :- p(2439), q(2440).
-q(2440) :- p(2439), q(2439).
-p(2441) :- p(2440), q(2441).
-q(2441) :- p(2440), q(2440).
-p(2442) :- p(2441), q(2442).
-q(2442) :- p(2441), q(2441).
-p(2443) :- p(2442), q(2443).
-q(2443) :- p(2442), q(2442).
-p(2444) :- p(2443), q(2444).
-q(2444) :- p(2443), q(2443).
-p(2445) :- p(2444), q(2445).
-q(2445) :- p(2444), q(2444).
-p(2446) :- p(2445), q(2446).
-q(2446) :- p(2445), q(2445).
-p(2447) :- p(2446), q(2447).
-q(2447) :- p(2446), q(2446).
-p(2448) :- p(2447), q(2448).
-q(2448) :- p(2447), q(2447).
-p(2449) :- p(2448), q(2449).
-q(2449) :- p(2448), q(2448).
-p(2450) :- p(2449), q(2450).
-q(2450) :- p(2449), q(2449).
-p(2451) :- p(2450), q(2451).
-q(2451) :- p(2450), q(2450).
-p(2452) :- p(2451), q(2452).
-q(2452) :- p(2451), q(2451).
-p(2453) :- p(2452), q(2453).
-q(2453) :- p(2452), q(2452).
-p(2454) :- p(2453), q(2454).
-q(2454) :- p(2453), q(2453).
-p(2455) :- p(2454), q(2455).
-q(2455) :- p(2454), q(2454).
-p(2456) :- p(2455), q(2456).
-q(2456) :- p(2455), q(2455).
-p(2457) :- p(2456), q(2457).
-q(2457) :- p(2456), q(2456).
-p(2458) :- p(2457), q(2458).
-q(2458) :- p(2457), q(2457).
-p(2459) :- p(2458), q(2459).
-q(2459) :- p(2458), q(2458).
-p(2460) :- p(2459), q(2460).
-q(2460) :- p(2459), q(2459).
-p(2461) :- p(2460), q(2461).
-q(2461) :- p(2460), q(2460).
-p(2462) :- p(2461), q(2462).
-q(2462) :- p(2461), q(2461).
-p(2463) :- p(2462), q(2463).
-q(2463) :- p(2462), q(2462).
-p(2464) :- p(2463), q(2464).
-q(2464) :- p(2463), q(2463).
-p(2465) :- p(2464), q(2465).
-q(2465) :- p(2464), q(2464).
-p(2466) :- p(2465), q(2466).
-q(2466) :- p(2465), q(2465).
-p(2467) :- p(2466), q(2467).
-q(2467) :- p(2466), q(2466).
-p(2468) :- p(2467), q(2468).
-q(2468) :- p(2467), q(2467).
-p(2469) :- p(2468), q(2469).
-q(2469) :- p(2468), q(2468).
-p(2470) :- p(2469), q(2470).
-q(2470) :- p(2469), q(2469).
-p(2471) :- p(2470), q(2471).
-q(2471) :- p(2470), q(2470).
-p(2472) :- p(2471), q(2472).
-q(2472) :- p(2471), q(2471).
-p(2473) :- p(2472), q(2473).
-q(2473) :- p(2472), q(2472).
-p(2474) :- p(2473), q(2474).
-q(2474) :- p(2473), q(2473).
-p(2475) :- p(2474), q(2475).
-q(2475) :- p(2474), q(2474).
-p(2476) :- p(2475), q(2476).
-q(2476) :- p(2475), q(2475).
-p(2477) :- p(2476), q(2477).
-q(2477) :- p(2476), q(2476).
-p(2478) :- p(2477), q(2478).
-q(2478) :- p(2477), q(2477).
-p(2479) :- p(2478), q(2479).
-q(2479) :- p(2478), q(2478).
-p(2480) :- p(2479), q(2480).
-q(2480) :- p(2479), q(2479).
-p(2481) :- p(2480), q(2481).
-q(2481) :- p(2480), q(2480).
-p(2482) :- p(2481), q(2482).
-q(2482) :- p(2481), q(2481).
-p(2483) :- p(2482), q(2483).
-q(2483) :- p(2482), q(2482).
-p(2484) :- p(2483), q(2484).
-q(2484) :- p(2483), q(2483).
-p(2485) :- p(2484), q(2485).
-q(2485) :- p(2484), q(2484).
-p(2486) :- p(2485), q(2486).
-q(2486) :- p(2485), q(2485).
-p(2487) :- p(2486), q(2487).
-q(2487) :- p(2486), q(2486).
-p(2488) :- p(2487), q(2488).
-q(2488) :- p(2487), q(2487).
-p(2489) :- p(2488), q(2489).
-q(2489) :- p(2488), q(2488).
-p(2490) :- p(2489), q(2490).
-q(2490) :- p(2489), q(2489).
-p(2491) :- p(2490), q(2491).
-q(2491) :- p(2490), q(2490).
-p(2492) :- p(2491), q(2492).
-q(2492) :- p(2491), q(2491).
-p(2493) :- p(2492), q(2493).
-q(2493) :- p(2492), q(2492).
-p(2494) :- p(2493), q(2494).
-q(2494) :- p(2493), q(2493).
-p(2495) :- p(2494), q(2495).
-q(2495) :- p(2494), q(2494).
-p(2496) :- p(2495), q(2496).
-q(2496) :- p(2495), q(2495).
-p(2497) :- p(2496), q(2497).
-q(2497) :- p(2496), q(2496).
-p(2498) :- p(2497), q(2498).
-q(2498) :- p(2497), q(2497).
-p(2499) :- p(2498), q(2499).
-q(2499) :- p(2498), q(2498).
-p(2500) :- p(2499), q(2500).
-q(2500) :- p(2499), q(2499).
-p(2501) :- p(2500), q(2501).
-q(2501) :- p(2500), q(2500).
-p(2502) :- p(2501), q(2502).
-q(2502) :- p(2501), q(2501).
-p(2503) :- p(2502), q(2503).
-q(2503) :- p(2502), q(2502).
-p(2504) :- p(2503), q(2504).
-q(2504) :- p(2503), q(2503).
-p(2505) :- p(2504), q(2505).
-q(2505) :- p(2504), q(2504).
-p(2506) :- p(2505), q(2506).
-q(2506) :- p(2505), q(2505).
-p(2507) :- p(2506), q(2507).
-q(2507) :- p(2506), q(2506).
-p(2508) :- p(2507), q(2508).
-q(2508) :- p(2507), q(2507).
-p(2509) :- p(2508), q(2509).
-q(2509) :- p(2508), q(2508).
-p(2510) :- p(2509), q(2510).
-q(2510) :- p(2509), q(2509).
-p(2511) :- p(2510), q(2511).
-q(2511) :- p(2510), q(2510).
-p(2512) :- p(2511), q(2512).
-q(2512) :- p(2511), q(2511).
-p(2513) :- p(2512), q(2513).
-q(2513) :- p(2512), q(2512).
-p(2514) :- p(2513), q(2514).
-q(2514) :- p(2513), q(2513).
-p(2515) :- p(2514), q(2515).
-q(2515) :- p(2514), q(2514).
-p(2516) :- p(2515), q(2516).
-q(2516) :- p(2515), q(2515).
-p(2517) :- p(2516), q(2517).
-q(2517) :- p(2516), q(2516).
-p(2518) :- p(2517), q(2518).
-q(2518) :- p(2517), q(2517).
-p(2519) :- p(2518), q(2519).
-q(2519) :- p(2518), q(2518).
-p(2520) :- p(2519), q(2520).
-q(2520) :- p(2519), q(2519).
-p(2521) :- p(2520), q(2521).
-q(2521) :- p(2520), q(2520).
-p(2522) :- p(2521), q(2522).
-q(2522) :- p(2521), q(2521).
-p(2523) :- p(2522), q(2523).
-q(2523) :- p(2522), q(2522).
-p(2524) :- p(2523), q(2524).
-q(2524) :- p(2523), q(2523).
-p(2525) :- p(2524), q(2525).
-q(2525) :- p(2524), q(2524).
-p(2526) :- p(2525), q(2526).
-q(2526) :- p(2525), q(2525).
-p(2527) :- p(2526), q(2527).
-q(2527) :- p(2526), q(2526).
-p(2528) :- p(2527), q(2528).
-q(2528) :- p(2527), q(2527).
-p(2529) :- p(2528), q(2529).
-q(2529) :- p(2528), q(2528).
-p(2530) :- p(2529), q(2530).
-q(2530) :- p(2529), q(2529).
-p(2531) :- p(2530), q(2531).
-q(2531) :- p(2530), q(2530).
-p(2532) :- p(2531), q(2532).
-q(2532) :- p(2531), q(2531).
-p(2533) :- p(2532), q(2533).
-q(2533) :- p(2532), q(2532).
-p(2534) :- p(2533), q(2534).
-q(2534) :- p(2533), q(2533).
-p(2535) :- p(2534), q(2535).
-q(2535) :- p(2534), q(2534).
-p(2536) :- p(2535), q(2536).
-q(2536) :- p(2535), q(2535).
-p(2537) :- p(2536), q(2537).
-q(2537) :- p(2536), q(2536).
-p(2538) :- p(2537), q(2538).
-q(2538) :- p(2537), q(2537).
-p(2539) :- p(2538), q(2539).
-q(2539) :- p(2538), q(2538).
-p(2540) :- p(2539), q(2540).
-q(2540) :- p(2539), q(2539).
-p(2541) :- p(2540), q(2541).
-q(2541) :- p(2540), q(2540).
-p(2542) :- p(2541), q(2542).
-q(2542) :- p(2541), q(2541).
-p(2543) :- p(2542), q(2543).
-q(2543) :- p(2542), q(2542).
-p(2544) :- p(2543), q(2544).
-q(2544) :- p(2543), q(2543).
-p(2545) :- p(2544), q(2545).
-q(2545) :- p(2544), q(2544).
-p(2546) :- p(2545), q(2546).
-q(2546) :- p(2545), q(2545).
-p(2547) :- p(2546), q(2547).
-q(2547) :- p(2546), q(2546).
-p(2548) :- p(2547), q(2548).
-q(2548) :- p(2547), q(2547).
-p(2549) :- p(2548), q(2549).
-q(2549) :- p(2548), q(2548).
-p(2550) :- p(2549), q(2550).
-q(2550) :- p(2549), q(2549).
-p(2551) :- p(2550), q(2551).
-q(2551) :- p(2550), q(2550).
-p(2552) :- p(2551), q(2552).
-q(2552) :- p(2551), q(2551).
-p(2553) :- p(2552), q(2553).
-q(2553) :- p(2552), q(2552).
-p(2554) :- p(2553), q(2554).
-q(2554) :- p(2553), q(2553).
-p(2555) :- p(2554), q(2555).
-q(2555) :- p(2554), q(2554).
-p(2556) :- p(2555), q(2556).
-q(2556) :- p(2555), q(2555).
-p(2557) :- p(2556), q(2557).
-q(2557) :- p(2556), q(2556).
-p(2558) :- p(2557), q(2558).
-q(2558) :- p(2557), q(2557).
-p(2559) :- p(2558), q(2559).
-q(2559) :- p(2558), q(2558).
-p(2560) :- p(2559), q(2560).
-q(2560) :- p(2559), q(2559).
-p(2561) :- p(2560), q(2561).
-q(2561) :- p(2560), q(2560).
-p(2562) :- p(2561), q(2562).
-q(2562) :- p(2561), q(2561).
-p(2563) :- p(2562), q(2563).
-q(2563) :- p(2562), q(2562).
-p(2564) :- p(2563), q(2564).
-q(2564) :- p(2563), q(2563).
-p(2565) :- p(2564), q(2565).
-q(2565) :- p(2564), q(2564).
-p(2566) :- p(2565), q(2566).
-q(2566) :- p(2565), q(2565).
-p(2567) :- p(2566), q(2567).
-q(2567) :- p(2566), q(2566).
-p(2568) :- p(2567), q(2568).
-q(2568) :- p(2567), q(2567).
-p(2569) :- p(2568), q(2569).
-q(2569) :- p(2568), q(2568).
-p(2570) :- p(2569), q(2570).
-q(2570) :- p(2569), q(2569).
-p(2571) :- p(2570), q(2571).
-q(2571) :- p(2570), q(2570).
-p(2572) :- p(2571), q(2572).
-q(2572) :- p(2571), q(2571).
-p(2573) :- p(2572), q(2573).
-q(2573) :- p(2572), q(2572).
-p(2574) :- p(2573), q(2574).
-q(2574) :- p(2573), q(2573).
-p(2575) :- p(2574), q(2575).
-q(2575) :- p(2574), q(2574).
-p(2576) :- p(2575), q(2576).
-q(2576) :- p(2575), q(2575).
-p(2577) :- p(2576), q(2577).
-q(2577) :- p(2576), q(2576).
-p(2578) :- p(2577), q(2578).
-q(2578) :- p(2577), q(2577).
-p(2579) :- p(2578), q(2579).
-q(2579) :- p(2578), q(2578).
-p(2580) :- p(2579), q(2580).
-q(2580) :- p(2579), q(2579).
-p(2581) :- p(2580), q(2581).
-q(2581) :- p(2580), q(2580).
-p(2582) :- p(2581), q(2582).
-q(2582) :- p(2581), q(2581).
-p(2583) :- p(2582), q(2583).
-q(2583) :- p(2582), q(2582).
-p(2584) :- p(2583), q(2584).
-q(2584) :- p(2583), q(2583).
-p(2585) :- p(2584), q(2585).
-q(2585) :- p(2584), q(2584).
-p(2586) :- p(2585), q(2586).
-q(2586) :- p(2585), q(2585).
-p(2587) :- p(2586), q(2587).
-q(2587) :- p(2586), q(2586).
-p(2588) :- p(2587), q(2588).
-q(2588) :- p(2587), q(2587).
-p(2589) :- p(2588), q(2589).
-q(2589) :- p(2588), q(2588).
-p(2590) :- p(2589), q(2590).
-q(2590) :- p(2589), q(2589).
-p(2591) :- p(2590), q(2591).
-q(2591) :- p(2590), q(2590).
-p(2592) :- p(2591), q(2592).
-q(2592) :- p(2591), q(2591).
-p(2593) :- p(2592), q(2593).
-q(2593) :- p(2592), q(2592).
-p(2594) :- p(2593), q(2594).
-q(2594) :- p(2593), q(2593).
-p(2595) :- p(2594), q(2595).
-q(2595) :- p(2594), q(2594).
-p(2596) :- p(2595), q(2596).
-q(2596) :- p(2595), q(2595).
-p(2597) :- p(2596), q(2597).
-q(2597) :- p(2596), q(2596).
-p(2598) :- p(2597), q(2598).
-q(2598) :- p(2597), q(2597).
-p(2599) :- p(2598), q(2599).
-q(2599) :- p(2598), q(2598).
-p(2600) :- p(2599), q(2600).
-q(2600) :- p(2599), q(2599).
-p(2601) :- p(2600), q(2601).
-q(2601) :- p(2600), q(2600).
-p(2602) :- p(2601), q(2602).
-q(2602) :- p(2601), q(2601).
-p(2603) :- p(2602), q(2603).
-q(2603) :- p(2602), q(2602).
-p(2604) :- p(2603), q(2604).
-q(2604) :- p(2603), q(2603).
-p(2605) :- p(2604), q(2605).
-q(2605) :- p(2604), q(2604).
-p(2606) :- p(2605), q(2606).
-q(2606) :- p(2605), q(2605).
-p(2607) :- p(2606), q(2607).
-q(2607) :- p(2606), q(2606).
-p(2608) :- p(2607), q(2608).
-q(2608) :- p(2607), q(2607).
-p(2609) :- p(2608), q(2609).
-q(2609) :- p(2608), q(2608).
-p(2610) :- p(2609), q(2610).
-q(2610) :- p(2609), q(2609).
-p(2611) :- p(2610), q(2611).
-q(2611) :- p(2610), q(2610).
-p(2612) :- p(2611), q(2612).
-q(2612) :- p(2611), q(2611).
-p(2613) :- p(2612), q(2613).
-q(2613) :- p(2612), q(2612).
-p(2614) :- p(2613), q(2614).
-q(2614) :- p(2613), q(2613).
-p(2615) :- p(2614), q(2615).
-q(2615) :- p(2614), q(2614).
-p(2616) :- p(2615), q(2616).
-q(2616) :- p(2615), q(2615).
-p(2617) :- p(2616), q(2617).
-q(2617) :- p(2616), q(2616).
-p(2618) :- p(2617), q(2618).
-q(2618) :- p(2617), q(2617).
-p(2619) :- p(2618), q(2619).
-q(2619) :- p(2618), q(2618).
-p(2620) :- p(2619), q(2620).
-q(2620) :- p(2619), q(2619).
-p(2621) :- p(2620), q(2621).
-q(2621) :- p(2620), q(2620).
-p(2622) :- p(2621), q(2622).
-q(2622) :- p(2621), q(2621).
-p(2623) :- p(2622), q(2623).
-q(2623) :- p(2622), q(2622).
-p(2624) :- p(2623), q(2624).
-q(2624) :- p(2623), q(2623).
-p(2625) :- p(2624), q(2625).
-q(2625) :- p(2624), q(2624).
-p(2626) :- p(2625), q(2626).
-q(2626) :- p(2625), q(2625).
-p(2627) :- p(2626), q(2627).
-q(2627) :- p(2626), q(2626).
-p(2628) :- p(2627), q(2628).
-q(2628) :- p(2627), q(2627).
-p(2629) :- p(2628), q(2629).
-q(2629) :- p(2628), q(2628).
-p(2630) :- p(2629), q(2630).
-q(2630) :- p(2629), q(2629).
-p(2631) :- p(2630), q(2631).
-q(2631) :- p(2630), q(2630).
-p(2632) :- p(2631), q(2632).
-q(2632) :- p(2631), q(2631).
-p(2633) :- p(2632), q(2633).
-q(2633) :- p(2632), q(2632).
-p(2634) :- p(2633), q(2634).
-q(2634) :- p(2633), q(2633).
-p(2635) :- p(2634), q(2635).
-q(2635) :- p(2634), q(2634).
-p(2636) :- p(2635), q(2636).
-q(2636) :- p(2635), q(2635).
-p(2637) :- p(2636), q(2637).
-q(2637) :- p(2636), q(2636).
-p(2638) :- p(2637), q(2638).
-q(2638) :- p(2637), q(2637).
-p(2639) :- p(2638), q(2639).
-q(2639) :- p(2638), q(2638).
-p(2640) :- p(2639), q(2640).
-q(2640) :- p(2639), q(2639).
-p(2641) :- p(2640), q(2641).
-q(2641) :- p(2640), q(2640).
-p(2642) :- p(2641), q(2642).
-q(2642) :- p(2641), q(2641).
-p(2643) :- p(2642), q(2643).
-q(2643) :- p(2642), q(2642).
-p(2644) :- p(2643), q(2644).
-q(2644) :- p(2643), q(2643).
-p(2645) :- p(2644), q(2645).
-q(2645) :- p(2644), q(2644).
-p(2646) :- p(2645), q(2646).
-q(2646) :- p(2645), q(2645).
-p(2647) :- p(2646), q(2647).
-q(2647) :- p(2646), q(2646).
-p(2648) :- p(2647), q(2648).
-q(2648) :- p(2647), q(2647).
-p(2649) :- p(2648), q(2649).
-q(2649) :- p(2648), q(2648).
-p(2650) :- p(2649), q(2650).
-q(2650) :- p(2649), q(2649).
-p(2651) :- p(2650), q(2651).
-q(2651) :- p(2650), q(2650).
-p(2652) :- p(2651), q(2652).
-q(2652) :- p(2651), q(2651).
-p(2653) :- p(2652), q(2653).
-q(2653) :- p(2652), q(2652).
-p(2654) :- p(2653), q(2654).
-q(2654) :- p(2653), q(2653).
-p(2655) :- p(2654), q(2655).
-q(2655) :- p(2654), q(2654).
-p(2656) :- p(2655), q(2656).
-q(2656) :- p(2655), q(2655).
-p(2657) :- p(2656), q(2657).
-q(2657) :- p(2656), q(2656).
-p(2658) :- p(2657), q(2658).
-q(2658) :- p(2657), q(2657).
-p(2659) :- p(2658), q(2659).
-q(2659) :- p(2658), q(2658).
-p(2660) :- p(2659), q(2660).
-q(2660) :- p(2659), q(2659).
-p(2661) :- p(2660), q(2661).
-q(2661) :- p(2660), q(2660).
-p(2662) :- p(2661), q(2662).
-q(2662) :- p(2661), q(2661).
-p(2663) :- p(2662), q(2663).
-q(2663) :- p(2662), q(2662).
-p(2664) :- p(2663), q(2664).
-q(2664) :- p(2663), q(2663).
-p(2665) :- p(2664), q(2665).
-q(2665) :- p(2664), q(2664).
-p(2666) :- p(2665), q(2666).
-q(2666) :- p(2665), q(2665).
-p(2667) :- p(2666), q(2667).
-q(2667) :- p(2666), q(2666).
-p(2668) :- p(2667), q(2668).
-q(2668) :- p(2667), q(2667).
-p(2669) :- p(2668), q(2669).
-q(2669) :- p(2668), q(2668).
-p(2670) :- p(2669), q(2670).
-q(2670) :- p(2669), q(2669).
-p(2671) :- p(2670), q(2671).
-q(2671) :- p(2670), q(2670).
-p(2672) :- p(2671), q(2672).
-q(2672) :- p(2671), q(2671).
-p(2673) :- p(2672), q(2673).
-q(2673) :- p(2672), q(2672).
-p(2674) :- p(2673), q(2674).
-q(2674) :- p(2673), q(2673).
-p(2675) :- p(2674), q(2675).
-q(2675) :- p(2674), q(2674).
-p(2676) :- p(2675), q(2676).
-q(2676) :- p(2675), q(2675).
-p(2677) :- p(2676), q(2677).
-q(2677) :- p(2676), q(2676).
-p(2678) :- p(2677), q(2678).
-q(2678) :- p(2677), q(2677).
-p(2679) :- p(2678), q(2679).
-q(2679) :- p(2678), q(2678).
-p(2680) :- p(2679), q(2680).
-q(2680) :- p(2679), q(2679).
-p(2681) :- p(2680), q(2681).
-q(2681) :- p(2680), q(2680).
-p(2682) :- p(2681), q(2682).
-q(2682) :- p(2681), q(2681).
-p(2683) :- p(2682), q(2683).
-q(2683) :- p(2682), q(2682).
-p(2684) :- p(2683), q(2684).
-q(2684) :- p(2683), q(2683).
-p(2685) :- p(2684), q(2685).
-q(2685) :- p(2684), q(2684).
-p(2686) :- p(2685), q(2686).
-q(2686) :- p(2685), q(2685).
-p(2687) :- p(2686), q(2687).
-q(2687) :- p(2686), q(2686).
-p(2688) :- p(2687), q(2688).
-q(2688) :- p(2687), q(2687).
-p(2689) :- p(2688), q(2689).
-q(2689) :- p(2688), q(2688).
-p(2690) :- p(2689), q(2690).
-q(2690) :- p(2689), q(2689).
-p(2691) :- p(2690), q(2691).
-q(2691) :- p(2690), q(2690).
-p(2692) :- p(2691), q(2692).
-q(2692) :- p(2691), q(2691).
-p(2693) :- p(2692), q(2693).
-q(2693) :- p(2692), q(2692).
-p(2694) :- p(2693), q(2694).
-q(2694) :- p(2693), q(2693).
-p(2695) :- p(2694), q(2695).
-q(2695) :- p(2694), q(2694).
-p(2696) :- p(2695), q(2696).
-q(2696) :- p(2695), q(2695).
-p(2697) :- p(2696), q(2697).
-q(2697) :- p(2696), q(2696).
-p(2698) :- p(2697), q(2698).
-q(2698) :- p(2697), q(2697).
-p(2699) :- p(2698), q(2699).
-q(2699) :- p(2698), q(2698).
-p(2700) :- p(2699), q(2700).
-q(2700) :- p(2699), q(2699).
-p(2701) :- p(2700), q(2701).
-q(2701) :- p(2700), q(2700).
-p(2702) :- p(2701), q(2702).
-q(2702) :- p(2701), q(2701).
-p(2703) :- p(2702), q(2703).
-q(2703) :- p(2702), q(2702).
-p(2704) :- p(2703), q(2704).
-q(2704) :- p(2703), q(2703).
-p(2705) :- p(2704), q(2705).
-q(2705) :- p(2704), q(2704).
-p(2706) :- p(2705), q(2706).
-q(2706) :- p(2705), q(2705).
-p(2707) :- p(2706), q(2707).
-q(2707) :- p(2706), q(2706).
-p(2708) :- p(2707), q(2708).
-q(2708) :- p(2707), q(2707).
-p(2709) :- p(2708), q(2709).
-q(2709) :- p(2708), q(2708).
-p(2710) :- p(2709), q(2710).
-q(2710) :- p(2709), q(2709).
-p(2711) :- p(2710), q(2711).
-q(2711) :- p(2710), q(2710).
-p(2712) :- p(2711), q(2712).
-q(2712) :- p(2711), q(2711).
-p(2713) :- p(2712), q(2713).
-q(2713) :- p(2712), q(2712).
-p(2714) :- p(2713), q(2714).
-q(2714) :- p(2713), q(2713).
-p(2715) :- p(2714), q(2715).
-q(2715) :- p(2714), q(2714).
-p(2716) :- p(2715), q(2716).
-q(2716) :- p(2715), q(2715).
-p(2717) :- p(2716), q(2717).
-q(2717) :- p(2716), q(2716).
-p(2718) :- p(2717), q(2718).
-q(2718) :- p(2717), q(2717).
-p(2719) :- p(2718), q(2719).
-q(2719) :- p(2718), q(2718).
-p(2720) :- p(2719), q(2720).
-q(2720) :- p(2719), q(2719).
-p(2721) :- p(2720), q(2721).
-q(2721) :- p(2720), q(2720).
-p(2722) :- p(2721), q(2722).
-q(2722) :- p(2721), q(2721).
-p(2723) :- p(2722), q(2723).
-q(2723) :- p(2722), q(2722).
-p(2724) :- p(2723), q(2724).
-q(2724) :- p(2723), q(2723).
-p(2725) :- p(2724), q(2725).
-q(2725) :- p(2724), q(2724).
-p(2726) :- p(2725), q(2726).
-q(2726) :- p(2725), q(2725).
-p(2727) :- p(2726), q(2727).
-q(2727) :- p(2726), q(2726).
-p(2728) :- p(2727), q(2728).
-q(2728) :- p(2727), q(2727).
-p(2729) :- p(2728), q(2729).
-q(2729) :- p(2728), q(2728).
-p(2730) :- p(2729), q(2730).
-q(2730) :- p(2729), q(2729).
-p(2731) :- p(2730), q(2731).
-q(2731) :- p(2730), q(2730).
-p(2732) :- p(2731), q(2732).
-q(2732) :- p(2731), q(2731).
-p(2733) :- p(2732), q(2733).
-q(2733) :- p(2732), q(2732).
-p(2734) :- p(2733), q(2734).
-q(2734) :- p(2733), q(2733).
-p(2735) :- p(2734), q(2735).
-q(2735) :- p(2734), q(2734).
-p(2736) :- p(2735), q(2736).
-q(2736) :- p(2735), q(2735).
-p(2737) :- p(2736), q(2737).
-q(2737) :- p(2736), q(2736).
-p(2738) :- p(2737), q(2738).
-q(2738) :- p(2737), q(2737).
-p(2739) :- p(2738), q(2739).
-q(2739) :- p(2738), q(2738).
-p(2740) :- p(2739), q(2740).
-q(2740) :- p(2739), q(2739).
-p(2741) :- p(2740), q(2741).
-q(2741) :- p(2740), q(2740).
-p(2742) :- p(2741), q(2742).
-q(2742) :- p(2741), q(2741).
-p(2743) :- p(2742), q(2743).
-q(2743) :- p(2742), q(2742).
-p(2744) :- p(2743), q(2744).
-q(2744) :- p(2743), q(2743).
-p(2745) :- p(2744), q(2745).
-q(2745) :- p(2744), q(2744).
-p(2746) :- p(2745), q(2746).
-q(2746) :- p(2745), q(2745).
-p(2747) :- p(2746), q(2747).
-q(2747) :- p(2746), q(2746).
-p(2748) :- p(2747), q(2748).
-q(2748) :- p(2747), q(2747).
-p(2749) :- p(2748), q(2749).
-q(2749) :- p(2748), q(2748).
-p(2750) :- p(2749), q(2750).
-q(2750) :- p(2749), q(2749).
-p(2751) :- p(2750), q(2751).
-q(2751) :- p(2750), q(2750).
-p(2752) :- p(2751), q(2752).
-q(2752) :- p(2751), q(2751).
-p(2753) :- p(2752), q(2753).
-q(2753) :- p(2752), q(2752).
-p(2754) :- p(2753), q(2754).
-q(2754) :- p(2753), q(2753).
-p(2755) :- p(2754), q(2755).
-q(2755) :- p(2754), q(2754).
-p(2756) :- p(2755), q(2756).
-q(2756) :- p(2755), q(2755).
-p(2757) :- p(2756), q(2757).
-q(2757) :- p(2756), q(2756).
-p(2758) :- p(2757), q(2758).
-q(2758) :- p(2757), q(2757).
-p(2759) :- p(2758), q(2759).
-q(2759) :- p(2758), q(2758).
-p(2760) :- p(2759), q(2760).
-q(2760) :- p(2759), q(2759).
-p(2761) :- p(2760), q(2761).
-q(2761) :- p(2760), q(2760).
-p(2762) :- p(2761), q(2762).
-q(2762) :- p(2761), q(2761).
-p(2763) :- p(2762), q(2763).
-q(2763) :- p(2762), q(2762).
-p(2764) :- p(2763), q(2764).
-q(2764) :- p(2763), q(2763).
-p(2765) :- p(2764), q(2765).
-q(2765) :- p(2764), q(2764).
-p(2766) :- p(2765), q(2766).
-q(2766) :- p(2765), q(2765).
-p(2767) :- p(2766), q(2767).
-q(2767) :- p(2766), q(2766).
-p(2768) :- p(2767), q(2768).
-q(2768) :- p(2767), q(2767).
-p(2769) :- p(2768), q(2769).
-q(2769) :- p(2768), q(2768).
-p(2770) :- p(2769), q(2770).
-q(2770) :- p(2769), q(2769).
-p(2771) :- p(2770), q(2771).
-q(2771) :- p(2770), q(2770).
-p(2772) :- p(2771), q(2772).
-q(2772) :- p(2771), q(2771).
-p(2773) :- p(2772), q(2773).
-q(2773) :- p(2772), q(2772).
-p(2774) :- p(2773), q(2774).
-q(2774) :- p(2773), q(2773).
-p(2775) :- p(2774), q(2775).
-q(2775) :- p(2774), q(2774).
-p(2776) :- p(2775), q(2776).
-q(2776) :- p(2775), q(2775).
-p(2777) :- p(2776), q(2777).
-q(2777) :- p(2776), q(2776).
-p(2778) :- p(2777), q(2778).
-q(2778) :- p(2777), q(2777).
-p(2779) :- p(2778), q(2779).
-q(2779) :- p(2778), q(2778).
-p(2780) :- p(2779), q(2780).
-q(2780) :- p(2779), q(2779).
-p(2781) :- p(2780), q(2781).
-q(2781) :- p(2780), q(2780).
-p(2782) :- p(2781), q(2782).
-q(2782) :- p(2781), q(2781).
-p(2783) :- p(2782), q(2783).
-q(2783) :- p(2782), q(2782).
-p(2784) :- p(2783), q(2784).
-q(2784) :- p(2783), q(2783).
-p(2785) :- p(2784), q(2785).
-q(2785) :- p(2784), q(2784).
-p(2786) :- p(2785), q(2786).
-q(2786) :- p(2785), q(2785).
-p(2787) :- p(2786), q(2787).
-q(2787) :- p(2786), q(2786).
-p(2788) :- p(2787), q(2788).
-q(2788) :- p(2787), q(2787).
-p(2789) :- p(2788), q(2789).
-q(2789) :- p(2788), q(2788).
-p(2790) :- p(2789), q(2790).
-q(2790) :- p(2789), q(2789).
-p(2791) :- p(2790), q(2791).
-q(2791) :- p(2790), q(2790).
-p(2792) :- p(2791), q(2792).
-q(2792) :- p(2791), q(2791).
-p(2793) :- p(2792), q(2793).
-q(2793) :- p(2792), q(2792).
-p(2794) :- p(2793), q(2794).
-q(2794) :- p(2793), q(2793).
-p(2795) :- p(2794), q(2795).
-q(2795) :- p(2794), q(2794).
-p(2796) :- p(2795), q(2796).
-q(2796) :- p(2795), q(2795).
-p(2797) :- p(2796), q(2797).
-q(2797) :- p(2796), q(2796).
-p(2798) :- p(2797), q(2798).
-q(2798) :- p(2797), q(2797).
-p(2799) :- p(2798), q(2799).
-q(2799) :- p(2798), q(2798).
-p(2800) :- p(2799), q(2800).
-q(2800) :- p(2799), q(2799).
-p(2801) :- p(2800), q(2801).
-q(2801) :- p(2800), q(2800).
-p(2802) :- p(2801), q(2802).
-q(2802) :- p(2801), q(2801).
-p(2803) :- p(2802), q(2803).
-q(2803) :- p(2802), q(2802).
-p(2804) :- p(2803), q(2804).
-q(2804) :- p(2803), q(2803).
-p(2805) :- p(2804), q(2805).
-q(2805) :- p(2804), q(2804).
-p(2806) :- p(2805), q(2806).
-q(2806) :- p(2805), q(2805).
-p(2807) :- p(2806), q(2807).
-q(2807) :- p(2806), q(2806).
-p(2808) :- p(2807), q(2808).
-q(2808) :- p(2807), q(2807).
-p(2809) :- p(2808), q(2809).
-q(2809) :- p(2808), q(2808).
-p(2810) :- p(2809), q(2810).
-q(2810) :- p(2809), q(2809).
-p(2811) :- p(2810), q(2811).
-q(2811) :- p(2810), q(2810).
-p(2812) :- p(2811), q(2812).
-q(2812) :- p(2811), q(2811).
-p(2813) :- p(2812), q(2813).
-q(2813) :- p(2812), q(2812).
-p(2814) :- p(2813), q(2814).
-q(2814) :- p(2813), q(2813).
-p(2815) :- p(2814), q(2815).
-q(2815) :- p(2814), q(2814).
-p(2816) :- p(2815), q(2816).
-q(2816) :- p(2815), q(2815).
-p(2817) :- p(2816), q(2817).
-q(2817) :- p(2816), q(2816).
-p(2818) :- p(2817), q(2818).
-q(2818) :- p(2817), q(2817).
-p(2819) :- p(2818), q(2819).
-q(2819) :- p(2818), q(2818).
-p(2820) :- p(2819), q(2820).
-q(2820) :- p(2819), q(2819).
-p(2821) :- p(2820), q(2821).
-q(2821) :- p(2820), q(2820).
-p(2822) :- p(2821), q(2822).
-q(2822) :- p(2821), q(2821).
-p(2823) :- p(2822), q(2823).
-q(2823) :- p(2822), q(2822).
-p(2824) :- p(2823), q(2824).
-q(2824) :- p(2823), q(2823).
-p(2825) :- p(2824), q(2825).
-q(2825) :- p(2824), q(2824).
-p(2826) :- p(2825), q(2826).
-q(2826) :- p(2825), q(2825).
-p(2827) :- p(2826), q(2827).
-q(2827) :- p(2826), q(2826).
-p(2828) :- p(2827), q(2828).
-q(2828) :- p(2827), q(2827).
-p(2829) :- p(2828), q(2829).
-q(2829) :- p(2828), q(2828).
-p(2830) :- p(2829), q(2830).
-q(2830) :- p(2829), q(2829).
-p(2831) :- p(2830), q(2831).
-q(2831) :- p(2830), q(2830).
-p(2832) :- p(2831), q(2832).
-q(2832) :- p(2831), q(2831).
-p(2833) :- p(2832), q(2833).
-q(2833) :- p(2832), q(2832).
-p(2834) :- p(2833), q(2834).
-q(2834) :- p(2833), q(2833).
-p(2835) :- p(2834), q(2835).
-q(2835) :- p(2834), q(2834).
-p(2836) :- p(2835), q(2836).
-q(2836) :- p(2835), q(2835).
-p(2837) :- p(2836), q(2837).
-q(2837) :- p(2836), q(2836).
-p(2838) :- p(2837), q(2838).
-q(2838) :- p(2837), q(2837).
-p(2839) :- p(2838), q(2839).
-q(2839) :- p(2838), q(2838).
-p(2840) :- p(2839), q(2840).
-q(2840) :- p(2839), q(2839).
-p(2841) :- p(2840), q(2841).
-q(2841) :- p(2840), q(2840).
-p(2842) :- p(2841), q(2842).
-q(2842) :- p(2841), q(2841).
-p(2843) :- p(2842), q(2843).
-q(2843) :- p(2842), q(2842).
-p(2844) :- p(2843), q(2844).
-q(2844) :- p(2843), q(2843).
-p(2845) :- p(2844), q(2845).
-q(2845) :- p(2844), q(2844).
-p(2846) :- p(2845), q(2846).
-q(2846) :- p(2845), q(2845).
-p(2847) :- p(2846), q(2847).
-q(2847) :- p(2846), q(2846).
-p(2848) :- p(2847), q(2848).
-q(2848) :- p(2847), q(2847).
-p(2849) :- p(2848), q(2849).
-q(2849) :- p(2848), q(2848).
-p(2850) :- p(2849), q(2850).
-q(2850) :- p(2849), q(2849).
-p(2851) :- p(2850), q(2851).
-q(2851) :- p(2850), q(2850).
-p(2852) :- p(2851), q(2852).
-q(2852) :- p(2851), q(2851).
-p(2853) :- p(2852), q(2853).
-q(2853) :- p(2852), q(2852).
-p(2854) :- p(2853), q(2854).
-q(2854) :- p(2853), q(2853).
-p(2855) :- p(2854), q(2855).
-q(2855) :- p(2854), q(2854).
-p(2856) :- p(2855), q(2856).
-q(2856) :- p(2855), q(2855).
-p(2857) :- p(2856), q(2857).
-q(2857) :- p(2856), q(2856).
-p(2858) :- p(2857), q(2858).
-q(2858) :- p(2857), q(2857).
-p(2859) :- p(2858), q(2859).
-q(2859) :- p(2858), q(2858).
-p(2860) :- p(2859), q(2860).
-q(2860) :- p(2859), q(2859).
-p(2861) :- p(2860), q(2861).
-q(2861) :- p(2860), q(2860).
-p(2862) :- p(2861), q(2862).
-q(2862) :- p(2861), q(2861).
-p(2863) :- p(2862), q(2863).
-q(2863) :- p(2862), q(2862).
-p(2864) :- p(2863), q(2864).
-q(2864) :- p(2863), q(2863).
-p(2865) :- p(2864), q(2865).
-q(2865) :- p(2864), q(2864).
-p(2866) :- p(2865), q(2866).
-q(2866) :- p(2865), q(2865).
-p(2867) :- p(2866), q(2867).
-q(2867) :- p(2866), q(2866).
-p(2868) :- p(2867), q(2868).
-q(2868) :- p(2867), q(2867).
-p(2869) :- p(2868), q(2869).
-q(2869) :- p(2868), q(2868).
-p(2870) :- p(2869), q(2870).
-q(2870) :- p(2869), q(2869).
-p(2871) :- p(2870), q(2871).
-q(2871) :- p(2870), q(2870).
-p(2872) :- p(2871), q(2872).
-q(2872) :- p(2871), q(2871).
-p(2873) :- p(2872), q(2873).
-q(2873) :- p(2872), q(2872).
-p(2874) :- p(2873), q(2874).
-q(2874) :- p(2873), q(2873).
-p(2875) :- p(2874), q(2875).
-q(2875) :- p(2874), q(2874).
-p(2876) :- p(2875), q(2876).
-q(2876) :- p(2875), q(2875).
-p(2877) :- p(2876), q(2877).
-q(2877) :- p(2876), q(2876).
-p(2878) :- p(2877), q(2878).
-q(2878) :- p(2877), q(2877).
-p(2879) :- p(2878), q(2879).
-q(2879) :- p(2878), q(2878).
-p(2880) :- p(2879), q(2880).
-q(2880) :- p(2879), q(2879).
-p(2881) :- p(2880), q(2881).
-q(2881) :- p(2880), q(2880).
-p(2882) :- p(2881), q(2882).
-q(2882) :- p(2881), q(2881).
-p(2883) :- p(2882), q(2883).
-q(2883) :- p(2882), q(2882).
-p(2884) :- p(2883), q(2884).
-q(2884) :- p(2883), q(2883).
-p(2885) :- p(2884), q(2885).
-q(2885) :- p(2884), q(2884).
-p(2886) :- p(2885), q(2886).
-q(2886) :- p(2885), q(2885).
-p(2887) :- p(2886), q(2887).
-q(2887) :- p(2886), q(2886).
-p(2888) :- p(2887), q(2888).
-q(2888) :- p(2887), q(2887).
-p(2889) :- p(2888), q(2889).
-q(2889) :- p(2888), q(2888).
-p(2890) :- p(2889), q(2890).
-q(2890) :- p(2889), q(2889).
-p(2891) :- p(2890), q(2891).
-q(2891) :- p(2890), q(2890).
-p(2892) :- p(2891), q(2892).
-q(2892) :- p(2891), q(2891).
-p(2893) :- p(2892), q(2893).
-q(2893) :- p(2892), q(2892).
-p(2894) :- p(2893), q(2894).
-q(2894) :- p(2893), q(2893).
-p(2895) :- p(2894), q(2895).
-q(2895) :- p(2894), q(2894).
-p(2896) :- p(2895), q(2896).
-q(2896) :- p(2895), q(2895).
-p(2897) :- p(2896), q(2897).
-q(2897) :- p(2896), q(2896).
-p(2898) :- p(2897), q(2898).
-q(2898) :- p(2897), q(2897).
-p(2899) :- p(2898), q(2899).
-q(2899) :- p(2898), q(2898).
-p(2900) :- p(2899), q(2900).
-q(2900) :- p(2899), q(2899).
-p(2901) :- p(2900), q(2901).
-q(2901) :- p(2900), q(2900).
-p(2902) :- p(2901), q(2902).
-q(2902) :- p(2901), q(2901).
-p(2903) :- p(2902), q(2903).
-q(2903) :- p(2902), q(2902).
-p(2904) :- p(2903), q(2904).
-q(2904) :- p(2903), q(2903).
-p(2905) :- p(2904), q(2905).
-q(2905) :- p(2904), q(2904).
-p(2906) :- p(2905), q(2906).
-q(2906) :- p(2905), q(2905).
-p(2907) :- p(2906), q(2907).
-q(2907) :- p(2906), q(2906).
-p(2908) :- p(2907), q(2908).
-q(2908) :- p(2907), q(2907).
-p(2909) :- p(2908), q(2909).
-q(2909) :- p(2908), q(2908).
-p(2910) :- p(2909), q(2910).
-q(2910) :- p(2909), q(2909).
-p(2911) :- p(2910), q(2911).
-q(2911) :- p(2910), q(2910).
-p(2912) :- p(2911), q(2912).
-q(2912) :- p(2911), q(2911).
-p(2913) :- p(2912), q(2913).
-q(2913) :- p(2912), q(2912).
-p(2914) :- p(2913), q(2914).
-q(2914) :- p(2913), q(2913).
-p(2915) :- p(2914), q(2915).
-q(2915) :- p(2914), q(2914).
-p(2916) :- p(2915), q(2916).
-q(2916) :- p(2915), q(2915).
-p(2917) :- p(2916), q(2917).
-q(2917) :- p(2916), q(2916).
-p(2918) :- p(2917), q(2918).
-q(2918) :- p(2917), q(2917).
-p(2919) :- p(2918), q(2919).
-q(2919) :- p(2918), q(2918).
-p(2920) :- p(2919), q(2920).
-q(2920) :- p(2919), q(2919).
-p(2921) :- p(2920), q(2921).
-q(2921) :- p(2920), q(2920).
-p(2922) :- p(2921), q(2922).
-q(2922) :- p(2921), q(2921).
-p(2923) :- p(2922), q(2923).
-q(2923) :- p(2922), q(2922).
-p(2924) :- p(2923), q(2924).
-q(2924) :- p(2923), q(2923).
-p(2925) :- p(2924), q(2925).
-q(2925) :- p(2924), q(2924).
-p(2926) :- p(2925), q(2926).
-q(2926) :- p(2925), q(2925).
-p(2927) :- p(2926), q(2927).
-q(2927) :- p(2926), q(2926).
-p(2928) :- p(2927), q(2928).
-q(2928) :- p(2927), q(2927).
-p(2929) :- p(2928), q(2929).
-q(2929) :- p(2928), q(2928).
-p(2930) :- p(2929), q(2930).
-q(2930) :- p(2929), q(2929).
-p(2931) :- p(2930), q(2931).
-q(2931) :- p(2930), q(2930).
-p(2932) :- p(2931), q(2932).
-q(2932) :- p(2931), q(2931).
-p(2933) :- p(2932), q(2933).
-q(2933) :- p(2932), q(2932).
-p(2934) :- p(2933), q(2934).
-q(2934) :- p(2933), q(2933).
-p(2935) :- p(2934), q(2935).
-q(2935) :- p(2934), q(2934).
-p(2936) :- p(2935), q(2936).
-q(2936) :- p(2935), q(2935).
-p(2937) :- p(2936), q(2937).
-q(2937) :- p(2936), q(2936).
-p(2938) :- p(2937), q(2938).
-q(2938) :- p(2937), q(2937).
-p(2939) :- p(2938), q(2939).
-q(2939) :- p(2938), q(2938).
-p(2940) :- p(2939), q(2940).
-q(2940) :- p(2939), q(2939).
-p(2941) :- p(2940), q(2941).
-q(2941) :- p(2940), q(2940).
-p(2942) :- p(2941), q(2942).
-q(2942) :- p(2941), q(2941).
-p(2943) :- p(2942), q(2943).
-q(2943) :- p(2942), q(2942).
-p(2944) :- p(2943), q(2944).
-q(2944) :- p(2943), q(2943).
-p(2945) :- p(2944), q(2945).
-q(2945) :- p(2944), q(2944).
-p(2946) :- p(2945), q(2946).
-q(2946) :- p(2945), q(2945).
-p(2947) :- p(2946), q(2947).
-q(2947) :- p(2946), q(2946).
-p(2948) :- p(2947), q(2948).
-q(2948) :- p(2947), q(2947).
-p(2949) :- p(2948), q(2949).
-q(2949) :- p(2948), q(2948).
-p(2950) :- p(2949), q(2950).
-q(2950) :- p(2949), q(2949).
-p(2951) :- p(2950), q(2951).
-q(2951) :- p(2950), q(2950).
-p(2952) :- p(2951), q(2952).
-q(2952) :- p(2951), q(2951).
-p(2953) :- p(2952), q(2953).
-q(2953) :- p(2952), q(2952).
-p(2954) :- p(2953), q(2954).
-q(2954) :- p(2953), q(2953).
-p(2955) :- p(2954), q(2955).
-q(2955) :- p(2954), q(2954).
-p(2956) :- p(2955), q(2956).
-q(2956) :- p(2955), q(2955).
-p(2957) :- p(2956), q(2957).
-q(2957) :- p(2956), q(2956).
-p(2958) :- p(2957), q(2958).
-q(2958) :- p(2957), q(2957).
-p(2959) :- p(2958), q(2959).
-q(2959) :- p(2958), q(2958).
-p(2960) :- p(2959), q(2960).
-q(2960) :- p(2959), q(2959).
-p(2961) :- p(2960), q(2961).
-q(2961) :- p(2960), q(2960).
-p(2962) :- p(2961), q(2962).
-q(2962) :- p(2961), q(2961).
-p(2963) :- p(2962), q(2963).
-q(2963) :- p(2962), q(2962).
-p(2964) :- p(2963), q(2964).
-q(2964) :- p(2963), q(2963).
-p(2965) :- p(2964), q(2965).
-q(2965) :- p(2964), q(2964).
-p(2966) :- p(2965), q(2966).
-q(2966) :- p(2965), q(2965).
-p(2967) :- p(2966), q(2967).
-q(2967) :- p(2966), q(2966).
-p(2968) :- p(2967), q(2968).
-q(2968) :- p(2967), q(2967).
-p(2969) :- p(2968), q(2969).
-q(2969) :- p(2968), q(2968).
-p(2970) :- p(2969), q(2970).
-q(2970) :- p(2969), q(2969).
-p(2971) :- p(2970), q(2971).
-q(2971) :- p(2970), q(2970).
-p(2972) :- p(2971), q(2972).
-q(2972) :- p(2971), q(2971).
-p(2973) :- p(2972), q(2973).
-q(2973) :- p(2972), q(2972).
-p(2974) :- p(2973), q(2974).
-q(2974) :- p(2973), q(2973).
-p(2975) :- p(2974), q(2975).
-q(2975) :- p(2974), q(2974).
-p(2976) :- p(2975), q(2976).
-q(2976) :- p(2975), q(2975).
-p(2977) :- p(2976), q(2977).
-q(2977) :- p(2976), q(2976).
-p(2978) :- p(2977), q(2978).
-q(2978) :- p(2977), q(2977).
-p(2979) :- p(2978), q(2979).
-q(2979) :- p(2978), q(2978).
-p(2980) :- p(2979), q(2980).
-q(2980) :- p(2979), q(2979).
-p(2981) :- p(2980), q(2981).
-q(2981) :- p(2980), q(2980).
-p(2982) :- p(2981), q(2982).
-q(2982) :- p(2981), q(2981).
-p(2983) :- p(2982), q(2983).
-q(2983) :- p(2982), q(2982).
-p(2984) :- p(2983), q(2984).
-q(2984) :- p(2983), q(2983).
-p(2985) :- p(2984), q(2985).
-q(2985) :- p(2984), q(2984).
-p(2986) :- p(2985), q(2986).
-q(2986) :- p(2985), q(2985).
-p(2987) :- p(2986), q(2987).
-q(2987) :- p(2986), q(2986).
-p(2988) :- p(2987), q(2988).
-q(2988) :- p(2987), q(2987).
-p(2989) :- p(2988), q(2989).
-q(2989) :- p(2988), q(2988).
-p(2990) :- p(2989), q(2990).
-q(2990) :- p(2989), q(2989).
-p(2991) :- p(2990), q(2991).
-q(2991) :- p(2990), q(2990).
-p(2992) :- p(2991), q(2992).
-q(2992) :- p(2991), q(2991).
-p(2993) :- p(2992), q(2993).
-q(2993) :- p(2992), q(2992).
-p(2994) :- p(2993), q(2994).
-q(2994) :- p(2993), q(2993).
-p(2995) :- p(2994), q(2995).
-q(2995) :- p(2994), q(2994).
-p(2996) :- p(2995), q(2996).
-q(2996) :- p(2995), q(2995).
-p(2997) :- p(2996), q(2997).
-q(2997) :- p(2996), q(2996).
-p(2998) :- p(2997), q(2998).
-q(2998) :- p(2997), q(2997).
-p(2999) :- p(2998), q(2999).
-q(2999) :- p(2998), q(2998).
-p(3000) :- p(2999), q(3000).
-q(3000) :- p(2999), q(2999).
-p(3001) :- p(3000), q(3001).
-q(3001) :- p(3000), q(3000).
-p(3002) :- p(3001), q(3002).
-q(3002) :- p(3001), q(3001).
-p(3003) :- p(3002), q(3003).
-q(3003) :- p(3002), q(3002).
-p(3004) :- p(3003), q(3004).
-q(3004) :- p(3003), q(3003).
-p(3005) :- p(3004), q(3005).
-q(3005) :- p(3004), q(3004).
-p(3006) :- p(3005), q(3006).
-q(3006) :- p(3005), q(3005).
-p(3007) :- p(3006), q(3007).
-q(3007) :- p(3006), q(3006).
-p(3008) :- p(3007), q(3008).
-q(3008) :- p(3007), q(3007).
-p(3009) :- p(3008), q(3009).
-q(3009) :- p(3008), q(3008).
-p(3010) :- p(3009), q(3010).
-q(3010) :- p(3009), q(3009).
-p(3011) :- p(3010), q(3011).
-q(3011) :- p(3010), q(3010).
-p(3012) :- p(3011), q(3012).
-q(3012) :- p(3011), q(3011).
-p(3013) :- p(3012), q(3013).
-q(3013) :- p(3012), q(3012).
-p(3014) :- p(3013), q(3014).
-q(3014) :- p(3013), q(3013).
-p(3015) :- p(3014), q(3015).
-q(3015) :- p(3014), q(3014).
-p(3016) :- p(3015), q(3016).
-q(3016) :- p(3015), q(3015).
-p(3017) :- p(3016), q(3017).
-q(3017) :- p(3016), q(3016).
-p(3018) :- p(3017), q(3018).
-q(3018) :- p(3017), q(3017).
-p(3019) :- p(3018), q(3019).
-q(3019) :- p(3018), q(3018).
-p(3020) :- p(3019), q(3020).
-q(3020) :- p(3019), q(3019).
-p(3021) :- p(3020), q(3021).
-q(3021) :- p(3020), q(3020).
-p(3022) :- p(3021), q(3022).
-q(3022) :- p(3021), q(3021).
-p(3023) :- p(3022), q(3023).
-q(3023) :- p(3022), q(3022).
-p(3024) :- p(3023), q(3024).
-q(3024) :- p(3023), q(3023).
-p(3025) :- p(3024), q(3025).
-q(3025) :- p(3024), q(3024).
-p(3026) :- p(3025), q(3026).
-q(3026) :- p(3025), q(3025).
-p(3027) :- p(3026), q(3027).
-q(3027) :- p(3026), q(3026).
-p(3028) :- p(3027), q(3028).
-q(3028) :- p(3027), q(3027).
-p(3029) :- p(3028), q(3029).
-q(3029) :- p(3028), q(3028).
-p(3030) :- p(3029), q(3030).
-q(3030) :- p(3029), q(3029).
-p(3031) :- p(3030), q(3031).
-q(3031) :- p(3030), q(3030).
-p(3032) :- p(3031), q(3032).
-q(3032) :- p(3031), q(3031).
-p(3033) :- p(3032), q(3033).
-q(3033) :- p(3032), q(3032).
-p(3034) :- p(3033), q(3034).
-q(3034) :- p(3033), q(3033).
-p(3035) :- p(3034), q(3035).
-q(3035) :- p(3034), q(3034).
-p(3036) :- p(3035), q(3036).
-q(3036) :- p(3035), q(3035).
-p(3037) :- p(3036), q(3037).
-q(3037) :- p(3036), q(3036).
-p(3038) :- p(3037), q(3038).
-q(3038) :- p(3037), q(3037).
-p(3039) :- p(3038), q(3039).
-q(3039) :- p(3038), q(3038).
-p(3040) :- p(3039), q(3040).
-q(3040) :- p(3039), q(3039).
-p(3041) :- p(3040), q(3041).
-q(3041) :- p(3040), q(3040).
-p(3042) :- p(3041), q(3042).
-q(3042) :- p(3041), q(3041).
-p(3043) :- p(3042), q(3043).
-q(3043) :- p(3042), q(3042).
-p(3044) :- p(3043), q(3044).
-q(3044) :- p(3043), q(3043).
-p(3045) :- p(3044), q(3045).
-q(3045) :- p(3044), q(3044).
-p(3046) :- p(3045), q(3046).
-q(3046) :- p(3045), q(3045).
-p(3047) :- p(3046), q(3047).
-q(3047) :- p(3046), q(3046).
-p(3048) :- p(3047), q(3048).
-q(3048) :- p(3047), q(3047).
-p(3049) :- p(3048), q(3049).
-q(3049) :- p(3048), q(3048).
-p(3050) :- p(3049), q(3050).
-q(3050) :- p(3049), q(3049).
-p(3051) :- p(3050), q(3051).
-q(3051) :- p(3050), q(3050).
-p(3052) :- p(3051), q(3052).
-q(3052) :- p(3051), q(3051).
-p(3053) :- p(3052), q(3053).
-q(3053) :- p(3052), q(3052).
-p(3054) :- p(3053), q(3054).
-q(3054) :- p(3053), q(3053).
-p(3055) :- p(3054), q(3055).
-q(3055) :- p(3054), q(3054).
-p(3056) :- p(3055), q(3056).
-q(3056) :- p(3055), q(3055).
-p(3057) :- p(3056), q(3057).
-q(3057) :- p(3056), q(3056).
-p(3058) :- p(3057), q(3058).
-q(3058) :- p(3057), q(3057).
-p(3059) :- p(3058), q(3059).
-q(3059) :- p(3058), q(3058).
-p(3060) :- p(3059), q(3060).
-q(3060) :- p(3059), q(3059).
-p(3061) :- p(3060), q(3061).
-q(3061) :- p(3060), q(3060).
-p(3062) :- p(3061), q(3062).
-q(3062) :- p(3061), q(3061).
-p(3063) :- p(3062), q(3063).
-q(3063) :- p(3062), q(3062).
-p(3064) :- p(3063), q(3064).
-q(3064) :- p(3063), q(3063).
-p(3065) :- p(3064), q(3065).
-q(3065) :- p(3064), q(3064).
-p(3066) :- p(3065), q(3066).
-q(3066) :- p(3065), q(3065).
-p(3067) :- p(3066), q(3067).
-q(3067) :- p(3066), q(3066).
-p(3068) :- p(3067), q(3068).
-q(3068) :- p(3067), q(3067).
-p(3069) :- p(3068), q(3069).
-q(3069) :- p(3068), q(3068).
-p(3070) :- p(3069), q(3070).
-q(3070) :- p(3069), q(3069).
-p(3071) :- p(3070), q(3071).
-q(3071) :- p(3070), q(3070).
-p(3072) :- p(3071), q(3072).
-q(3072) :- p(3071), q(3071).
-p(3073) :- p(3072), q(3073).
-q(3073) :- p(3072), q(3072).
-p(3074) :- p(3073), q(3074).
-q(3074) :- p(3073), q(3073).
-p(3075) :- p(3074), q(3075).
-q(3075) :- p(3074), q(3074).
-p(3076) :- p(3075), q(3076).
-q(3076) :- p(3075), q(3075).
-p(3077) :- p(3076), q(3077).
-q(3077) :- p(3076), q(3076).
-p(3078) :- p(3077), q(3078).
-q(3078) :- p(3077), q(3077).
-p(3079) :- p(3078), q(3079).
-q(3079) :- p(3078), q(3078).
-p(3080) :- p(3079), q(3080).
-q(3080) :- p(3079), q(3079).
-p(3081) :- p(3080), q(3081).
-q(3081) :- p(3080), q(3080).
-p(3082) :- p(3081), q(3082).
-q(3082) :- p(3081), q(3081).
-p(3083) :- p(3082), q(3083).
-q(3083) :- p(3082), q(3082).
-p(3084) :- p(3083), q(3084).
-q(3084) :- p(3083), q(3083).
-p(3085) :- p(3084), q(3085).
-q(3085) :- p(3084), q(3084).
-p(3086) :- p(3085), q(3086).
-q(3086) :- p(3085), q(3085).
-p(3087) :- p(3086), q(3087).
-q(3087) :- p(3086), q(3086).
-p(3088) :- p(3087), q(3088).
-q(3088) :- p(3087), q(3087).
-p(3089) :- p(3088), q(3089).
-q(3089) :- p(3088), q(3088).
-p(3090) :- p(3089), q(3090).
-q(3090) :- p(3089), q(3089).
-p(3091) :- p(3090), q(3091).
-q(3091) :- p(3090), q(3090).
-p(3092) :- p(3091), q(3092).
-q(3092) :- p(3091), q(3091).
-p(3093) :- p(3092), q(3093).
-q(3093) :- p(3092), q(3092).
-p(3094) :- p(3093), q(3094).
-q(3094) :- p(3093), q(3093).
-p(3095) :- p(3094), q(3095).
-q(3095) :- p(3094), q(3094).
-p(3096) :- p(3095), q(3096).
-q(3096) :- p(3095), q(3095).
-p(3097) :- p(3096), q(3097).
-q(3097) :- p(3096), q(3096).
-p(3098) :- p(3097), q(3098).
-q(3098) :- p(3097), q(3097).
-p(3099) :- p(3098), q(3099).
-q(3099) :- p(3098), q(3098).
-p(3100) :- p(3099), q(3100).
-q(3100) :- p(3099), q(3099).
-p(3101) :- p(3100), q(3101).
-q(3101) :- p(3100), q(3100).
-p(3102) :- p(3101), q(3102).
-q(3102) :- p(3101), q(3101).
-p(3103) :- p(3102), q(3103).
-q(3103) :- p(3102), q(3102).
-p(3104) :- p(3103), q(3104).
-q(3104) :- p(3103), q(3103).
-p(3105) :- p(3104), q(3105).
-q(3105) :- p(3104), q(3104).
-p(3106) :- p(3105), q(3106).
-q(3106) :- p(3105), q(3105).
-p(3107) :- p(3106), q(3107).
-q(3107) :- p(3106), q(3106).
-p(3108) :- p(3107), q(3108).
-q(3108) :- p(3107), q(3107).
-p(3109) :- p(3108), q(3109).
-q(3109) :- p(3108), q(3108).
-p(3110) :- p(3109), q(3110).
-q(3110) :- p(3109), q(3109).
-p(3111) :- p(3110), q(3111).
-q(3111) :- p(3110), q(3110).
-p(3112) :- p(3111), q(3112).
-q(3112) :- p(3111), q(3111).
-p(3113) :- p(3112), q(3113).
-q(3113) :- p(3112), q(3112).
-p(3114) :- p(3113), q(3114).
-q(3114) :- p(3113), q(3113).
-p(3115) :- p(3114), q(3115).
-q(3115) :- p(3114), q(3114).
-p(3116) :- p(3115), q(3116).
-q(3116) :- p(3115), q(3115).
-p(3117) :- p(3116), q(3117).
-q(3117) :- p(3116), q(3116).
-p(3118) :- p(3117), q(3118).
-q(3118) :- p(3117), q(3117).
-p(3119) :- p(3118), q(3119).
-q(3119) :- p(3118), q(3118).
-p(3120) :- p(3119), q(3120).
-q(3120) :- p(3119), q(3119).
-p(3121) :- p(3120), q(3121).
-q(3121) :- p(3120), q(3120).
-p(3122) :- p(3121), q(3122).
-q(3122) :- p(3121), q(3121).
-p(3123) :- p(3122), q(3123).
-q(3123) :- p(3122), q(3122).
-p(3124) :- p(3123), q(3124).
-q(3124) :- p(3123), q(3123).
-p(3125) :- p(3124), q(3125).
-q(3125) :- p(3124), q(3124).
-p(3126) :- p(3125), q(3126).
-q(3126) :- p(3125), q(3125).
-p(3127) :- p(3126), q(3127).
-q(3127) :- p(3126), q(3126).
-p(3128) :- p(3127), q(3128).
-q(3128) :- p(3127), q(3127).
-p(3129) :- p(3128), q(3129).
-q(3129) :- p(3128), q(3128).
-p(3130) :- p(3129), q(3130).
-q(3130) :- p(3129), q(3129).
-p(3131) :- p(3130), q(3131).
-q(3131) :- p(3130), q(3130).
-p(3132) :- p(3131), q(3132).
-q(3132) :- p(3131), q(3131).
-p(3133) :- p(3132), q(3133).
-q(3133) :- p(3132), q(3132).
-p(3134) :- p(3133), q(3134).
-q(3134) :- p(3133), q(3133).
-p(3135) :- p(3134), q(3135).
-q(3135) :- p(3134), q(3134).
-p(3136) :- p(3135), q(3136).
-q(3136) :- p(3135), q(3135).
-p(3137) :- p(3136), q(3137).
-q(3137) :- p(3136), q(3136).
-p(3138) :- p(3137), q(3138).
-q(3138) :- p(3137), q(3137).
-p(3139) :- p(3138), q(3139).
-q(3139) :- p(3138), q(3138).
-p(3140) :- p(3139), q(3140).
-q(3140) :- p(3139), q(3139).
-p(3141) :- p(3140), q(3141).
-q(3141) :- p(3140), q(3140).
-p(3142) :- p(3141), q(3142).
-q(3142) :- p(3141), q(3141).
-p(3143) :- p(3142), q(3143).
-q(3143) :- p(3142), q(3142).
-p(3144) :- p(3143), q(3144).
-q(3144) :- p(3143), q(3143).
-p(3145) :- p(3144), q(3145).
-q(3145) :- p(3144), q(3144).
-p(3146) :- p(3145), q(3146).
-q(3146) :- p(3145), q(3145).
-p(3147) :- p(3146), q(3147).
-q(3147) :- p(3146), q(3146).
-p(3148) :- p(3147), q(3148).
-q(3148) :- p(3147), q(3147).
-p(3149) :- p(3148), q(3149).
-q(3149) :- p(3148), q(3148).
-p(3150) :- p(3149), q(3150).
-q(3150) :- p(3149), q(3149).
-p(3151) :- p(3150), q(3151).
-q(3151) :- p(3150), q(3150).
-p(3152) :- p(3151), q(3152).
-q(3152) :- p(3151), q(3151).
-p(3153) :- p(3152), q(3153).
-q(3153) :- p(3152), q(3152).
-p(3154) :- p(3153), q(3154).
-q(3154) :- p(3153), q(3153).
-p(3155) :- p(3154), q(3155).
-q(3155) :- p(3154), q(3154).
-p(3156) :- p(3155), q(3156).
-q(3156) :- p(3155), q(3155).
-p(3157) :- p(3156), q(3157).
-q(3157) :- p(3156), q(3156).
-p(3158) :- p(3157), q(3158).
-q(3158) :- p(3157), q(3157).
-p(3159) :- p(3158), q(3159).
-q(3159) :- p(3158), q(3158).
-p(3160) :- p(3159), q(3160).
-q(3160) :- p(3159), q(3159).
-p(3161) :- p(3160), q(3161).
-q(3161) :- p(3160), q(3160).
-p(3162) :- p(3161), q(3162).
-q(3162) :- p(3161), q(3161).
-p(3163) :- p(3162), q(3163).
-q(3163) :- p(3162), q(3162).
-p(3164) :- p(3163), q(3164).
-q(3164) :- p(3163), q(3163).
-p(3165) :- p(3164), q(3165).
-q(3165) :- p(3164), q(3164).
-p(3166) :- p(3165), q(3166).
-q(3166) :- p(3165), q(3165).
-p(3167) :- p(3166), q(3167).
-q(3167) :- p(3166), q(3166).
-p(3168) :- p(3167), q(3168).
-q(3168) :- p(3167), q(3167).
-p(3169) :- p(3168), q(3169).
-q(3169) :- p(3168), q(3168).
-p(3170) :- p(3169), q(3170).
-q(3170) :- p(3169), q(3169).
-p(3171) :- p(3170), q(3171).
-q(3171) :- p(3170), q(3170).
-p(3172) :- p(3171), q(3172).
-q(3172) :- p(3171), q(3171).
-p(3173) :- p(3172), q(3173).
-q(3173) :- p(3172), q(3172).
-p(3174) :- p(3173), q(3174).
-q(3174) :- p(3173), q(3173).
-p(3175) :- p(3174), q(3175).
-q(3175) :- p(3174), q(3174).
-p(3176) :- p(3175), q(3176).
-q(3176) :- p(3175), q(3175).
-p(3177) :- p(3176), q(3177).
-q(3177) :- p(3176), q(3176).
-p(3178) :- p(3177), q(3178).
-q(3178) :- p(3177), q(3177).
-p(3179) :- p(3178), q(3179).
-q(3179) :- p(3178), q(3178).
-p(3180) :- p(3179), q(3180).
-q(3180) :- p(3179), q(3179).
-p(3181) :- p(3180), q(3181).
-q(3181) :- p(3180), q(3180).
-p(3182) :- p(3181), q(3182).
-q(3182) :- p(3181), q(3181).
-p(3183) :- p(3182), q(3183).
-q(3183) :- p(3182), q(3182).
-p(3184) :- p(3183), q(3184).
-q(3184) :- p(3183), q(3183).
-p(3185) :- p(3184), q(3185).
-q(3185) :- p(3184), q(3184).
-p(3186) :- p(3185), q(3186).
-q(3186) :- p(3185), q(3185).
-p(3187) :- p(3186), q(3187).
-q(3187) :- p(3186), q(3186).
-p(3188) :- p(3187), q(3188).
-q(3188) :- p(3187), q(3187).
-p(3189) :- p(3188), q(3189).
-q(3189) :- p(3188), q(3188).
-p(3190) :- p(3189), q(3190).
-q(3190) :- p(3189), q(3189).
-p(3191) :- p(3190), q(3191).
-q(3191) :- p(3190), q(3190).
-p(3192) :- p(3191), q(3192).
-q(3192) :- p(3191), q(3191).
-p(3193) :- p(3192), q(3193).
-q(3193) :- p(3192), q(3192).
-p(3194) :- p(3193), q(3194).
-q(3194) :- p(3193), q(3193).
-p(3195) :- p(3194), q(3195).
-q(3195) :- p(3194), q(3194).
-p(3196) :- p(3195), q(3196).
-q(3196) :- p(3195), q(3195).
-p(3197) :- p(3196), q(3197).
-q(3197) :- p(3196), q(3196).
-p(3198) :- p(3197), q(3198).
-q(3198) :- p(3197), q(3197).
-p(3199) :- p(3198), q(3199).
-q(3199) :- p(3198), q(3198).
-p(3200) :- p(3199), q(3200).
-q(3200) :- p(3199), q(3199).
-p(3201) :- p(3200), q(3201).
-q(3201) :- p(3200), q(3200).
-p(3202) :- p(3201), q(3202).
-q(3202) :- p(3201), q(3201).
-p(3203) :- p(3202), q(3203).
-q(3203) :- p(3202), q(3202).
-p(3204) :- p(3203), q(3204).
-q(3204) :- p(3203), q(3203).
-p(3205) :- p(3204), q(3205).
-q(3205) :- p(3204), q(3204).
-p(3206) :- p(3205), q(3206).
-q(3206) :- p(3205), q(3205).
-p(3207) :- p(3206), q(3207).
-q(3207) :- p(3206), q(3206).
-p(3208) :- p(3207), q(3208).
-q(3208) :- p(3207), q(3207).
-p(3209) :- p(3208), q(3209).
-q(3209) :- p(3208), q(3208).
-p(3210) :- p(3209), q(3210).
-q(3210) :- p(3209), q(3209).
-p(3211) :- p(3210), q(3211).
-q(3211) :- p(3210), q(3210).
-p(3212) :- p(3211), q(3212).
-q(3212) :- p(3211), q(3211).
-p(3213) :- p(3212), q(3213).
-q(3213) :- p(3212), q(3212).
-p(3214) :- p(3213), q(3214).
-q(3214) :- p(3213), q(3213).
-p(3215) :- p(3214), q(3215).
-q(3215) :- p(3214), q(3214).
-p(3216) :- p(3215), q(3216).
-q(3216) :- p(3215), q(3215).
-p(3217) :- p(3216), q(3217).
-q(3217) :- p(3216), q(3216).
-p(3218) :- p(3217), q(3218).
-q(3218) :- p(3217), q(3217).
-p(3219) :- p(3218), q(3219).
-q(3219) :- p(3218), q(3218).
-p(3220) :- p(3219), q(3220).
-q(3220) :- p(3219), q(3219).
-p(3221) :- p(3220), q(3221).
-q(3221) :- p(3220), q(3220).
-p(3222) :- p(3221), q(3222).
-q(3222) :- p(3221), q(3221).
-p(3223) :- p(3222), q(3223).
-q(3223) :- p(3222), q(3222).
-p(3224) :- p(3223), q(3224).
-q(3224) :- p(3223), q(3223).
-p(3225) :- p(3224), q(3225).
-q(3225) :- p(3224), q(3224).
-p(3226) :- p(3225), q(3226).
-q(3226) :- p(3225), q(3225).
-p(3227) :- p(3226), q(3227).
-q(3227) :- p(3226), q(3226).
-p(3228) :- p(3227), q(3228).
-q(3228) :- p(3227), q(3227).
-p(3229) :- p(3228), q(3229).
-q(3229) :- p(3228), q(3228).
-p(3230) :- p(3229), q(3230).
-q(3230) :- p(3229), q(3229).
-p(3231) :- p(3230), q(3231).
-q(3231) :- p(3230), q(3230).
-p(3232) :- p(3231), q(3232).
-q(3232) :- p(3231), q(3231).
-p(3233) :- p(3232), q(3233).
-q(3233) :- p(3232), q(3232).
-p(3234) :- p(3233), q(3234).
-q(3234) :- p(3233), q(3233).
-p(3235) :- p(3234), q(3235).
-q(3235) :- p(3234), q(3234).
-p(3236) :- p(3235), q(3236).
-q(3236) :- p(3235), q(3235).
-p(3237) :- p(3236), q(3237).
-q(3237) :- p(3236), q(3236).
-p(3238) :- p(3237), q(3238).
-q(3238) :- p(3237), q(3237).
-p(3239) :- p(3238), q(3239).
-q(3239) :- p(3238), q(3238).
-p(3240) :- p(3239), q(3240).
-q(3240) :- p(3239), q(3239).
-p(3241) :- p(3240), q(3241).
-q(3241) :- p(3240), q(3240).
-p(3242) :- p(3241), q(3242).
-q(3242) :- p(3241), q(3241).
-p(3243) :- p(3242), q(3243).
-q(3243) :- p(3242), q(3242).
-p(3244) :- p(3243), q(3244).
-q(3244) :- p(3243), q(3243).
-p(3245) :- p(3244), q(3245).
-q(3245) :- p(3244), q(3244).
-p(3246) :- p(3245), q(3246).
-q(3246) :- p(3245), q(3245).
-p(3247) :- p(3246), q(3247).
-q(3247) :- p(3246), q(3246).
-p(3248) :- p(3247), q(3248).
-q(3248) :- p(3247), q(3247).
-p(3249) :- p(3248), q(3249).
-q(3249) :- p(3248), q(3248).
-p(3250) :- p(3249), q(3250).
-q(3250) :- p(3249), q(3249).
-p(3251) :- p(3250), q(3251).
-q(3251) :- p(3250), q(3250).
-p(3252) :- p(3251), q(3252).
-q(3252) :- p(3251), q(3251).
-p(3253) :- p(3252), q(3253).
-q(3253) :- p(3252), q(3252).
-p(3254) :- p(3253), q(3254).
-q(3254) :- p(3253), q(3253).
-p(3255) :- p(3254), q(3255).
-q(3255) :- p(3254), q(3254).
-p(3256) :- p(3255), q(3256).
-q(3256) :- p(3255), q(3255).
-p(3257) :- p(3256), q(3257).
-q(3257) :- p(3256), q(3256).
-p(3258) :- p(3257), q(3258).
-q(3258) :- p(3257), q(3257).
-p(3259) :- p(3258), q(3259).
-q(3259) :- p(3258), q(3258).
-p(3260) :- p(3259), q(3260).
-q(3260) :- p(3259), q(3259).
-p(3261) :- p(3260), q(3261).
-q(3261) :- p(3260), q(3260).
-p(3262) :- p(3261), q(3262).
-q(3262) :- p(3261), q(3261).
-p(3263) :- p(3262), q(3263).
-q(3263) :- p(3262), q(3262).
-p(3264) :- p(3263), q(3264).
-q(3264) :- p(3263), q(3263).
-p(3265) :- p(3264), q(3265).
-q(3265) :- p(3264), q(3264).
-p(3266) :- p(3265), q(3266).
-q(3266) :- p(3265), q(3265).
-p(3267) :- p(3266), q(3267).
-q(3267) :- p(3266), q(3266).
-p(3268) :- p(3267), q(3268).
-q(3268) :- p(3267), q(3267).
-p(3269) :- p(3268), q(3269).
-q(3269) :- p(3268), q(3268).
-p(3270) :- p(3269), q(3270).
-q(3270) :- p(3269), q(3269).
-p(3271) :- p(3270), q(3271).
-q(3271) :- p(3270), q(3270).
-p(3272) :- p(3271), q(3272).
-q(3272) :- p(3271), q(3271).
-p(3273) :- p(3272), q(3273).
-q(3273) :- p(3272), q(3272).
-p(3274) :- p(3273), q(3274).
-q(3274) :- p(3273), q(3273).
-p(3275) :- p(3274), q(3275).
-q(3275) :- p(3274), q(3274).
-p(3276) :- p(3275), q(3276).
-q(3276) :- p(3275), q(3275).
-p(3277) :- p(3276), q(3277).
-q(3277) :- p(3276), q(3276).
-p(3278) :- p(3277), q(3278).
-q(3278) :- p(3277), q(3277).
-p(3279) :- p(3278), q(3279).
-q(3279) :- p(3278), q(3278).
-p(3280) :- p(3279), q(3280).
-q(3280) :- p(3279), q(3279).
-p(3281) :- p(3280), q(3281).
-q(3281) :- p(3280), q(3280).
-p(3282) :- p(3281), q(3282).
-q(3282) :- p(3281), q(3281).
-p(3283) :- p(3282), q(3283).
-q(3283) :- p(3282), q(3282).
-p(3284) :- p(3283), q(3284).
-q(3284) :- p(3283), q(3283).
-p(3285) :- p(3284), q(3285).
-q(3285) :- p(3284), q(3284).
-p(3286) :- p(3285), q(3286).
-q(3286) :- p(3285), q(3285).
-p(3287) :- p(3286), q(3287).
-q(3287) :- p(3286), q(3286).
-p(3288) :- p(3287), q(3288).
-q(3288) :- p(3287), q(3287).
-p(3289) :- p(3288), q(3289).
-q(3289) :- p(3288), q(3288).
-p(3290) :- p(3289), q(3290).
-q(3290) :- p(3289), q(3289).
-p(3291) :- p(3290), q(3291).
-q(3291) :- p(3290), q(3290).
-p(3292) :- p(3291), q(3292).
-q(3292) :- p(3291), q(3291).
-p(3293) :- p(3292), q(3293).
-q(3293) :- p(3292), q(3292).
-p(3294) :- p(3293), q(3294).
-q(3294) :- p(3293), q(3293).
-p(3295) :- p(3294), q(3295).
-q(3295) :- p(3294), q(3294).
-p(3296) :- p(3295), q(3296).
-q(3296) :- p(3295), q(3295).
-p(3297) :- p(3296), q(3297).
-q(3297) :- p(3296), q(3296).
-p(3298) :- p(3297), q(3298).
-q(3298) :- p(3297), q(3297).
-p(3299) :- p(3298), q(3299).
-q(3299) :- p(3298), q(3298).
-p(3300) :- p(3299), q(3300).
-q(3300) :- p(3299), q(3299).
-p(3301) :- p(3300), q(3301).
-q(3301) :- p(3300), q(3300).
-p(3302) :- p(3301), q(3302).
-q(3302) :- p(3301), q(3301).
-p(3303) :- p(3302), q(3303).
-q(3303) :- p(3302), q(3302).
-p(3304) :- p(3303), q(3304).
-q(3304) :- p(3303), q(3303).
-p(3305) :- p(3304), q(3305).
-q(3305) :- p(3304), q(3304).
-p(3306) :- p(3305), q(3306).
-q(3306) :- p(3305), q(3305).
-p(3307) :- p(3306), q(3307).
-q(3307) :- p(3306), q(3306).
-p(3308) :- p(3307), q(3308).
-q(3308) :- p(3307), q(3307).
-p(3309) :- p(3308), q(3309).
-q(3309) :- p(3308), q(3308).
-p(3310) :- p(3309), q(3310).
-q(3310) :- p(3309), q(3309).
-p(3311) :- p(3310), q(3311).
-q(3311) :- p(3310), q(3310).
-p(3312) :- p(3311), q(3312).
-q(3312) :- p(3311), q(3311).
-p(3313) :- p(3312), q(3313).
-q(3313) :- p(3312), q(3312).
-p(3314) :- p(3313), q(3314).
-q(3314) :- p(3313), q(3313).
-p(3315) :- p(3314), q(3315).
-q(3315) :- p(3314), q(3314).
-p(3316) :- p(3315), q(3316).
-q(3316) :- p(3315), q(3315).
-p(3317) :- p(3316), q(3317).
-q(3317) :- p(3316), q(3316).
-p(3318) :- p(3317), q(3318).
-q(3318) :- p(3317), q(3317).
-p(3319) :- p(3318), q(3319).
-q(3319) :- p(3318), q(3318).
-p(3320) :- p(3319), q(3320).
-q(3320) :- p(3319), q(3319).
-p(3321) :- p(3320), q(3321).
-q(3321) :- p(3320), q(3320).
-p(3322) :- p(3321), q(3322).
-q(3322) :- p(3321), q(3321).
-p(3323) :- p(3322), q(3323).
-q(3323) :- p(3322), q(3322).
-p(3324) :- p(3323), q(3324).
-q(3324) :- p(3323), q(3323).
-p(3325) :- p(3324), q(3325).
-q(3325) :- p(3324), q(3324).
-p(3326) :- p(3325), q(3326).
-q(3326) :- p(3325), q(3325).
-p(3327) :- p(3326), q(3327).
-q(3327) :- p(3326), q(3326).
-p(3328) :- p(3327), q(3328).
-q(3328) :- p(3327), q(3327).
-p(3329) :- p(3328), q(3329).
-q(3329) :- p(3328), q(3328).
-p(3330) :- p(3329), q(3330).
-q(3330) :- p(3329), q(3329).
-p(3331) :- p(3330), q(3331).
-q(3331) :- p(3330), q(3330).
-p(3332) :- p(3331), q(3332).
-q(3332) :- p(3331), q(3331).
-p(3333) :- p(3332), q(3333).
-q(3333) :- p(3332), q(3332).
-p(3334) :- p(3333), q(3334).
-q(3334) :- p(3333), q(3333).
-p(3335) :- p(3334), q(3335).
-q(3335) :- p(3334), q(3334).
-p(3336) :- p(3335), q(3336).
-q(3336) :- p(3335), q(3335).
-p(3337) :- p(3336), q(3337).
-q(3337) :- p(3336), q(3336).
-p(3338) :- p(3337), q(3338).
-q(3338) :- p(3337), q(3337).
-p(3339) :- p(3338), q(3339).
-q(3339) :- p(3338), q(3338).
-p(3340) :- p(3339), q(3340).
-q(3340) :- p(3339), q(3339).
-p(3341) :- p(3340), q(3341).
-q(3341) :- p(3340), q(3340).
-p(3342) :- p(3341), q(3342).
-q(3342) :- p(3341), q(3341).
-p(3343) :- p(3342), q(3343).
-q(3343) :- p(3342), q(3342).
-p(3344) :- p(3343), q(3344).
-q(3344) :- p(3343), q(3343).
-p(3345) :- p(3344), q(3345).
-q(3345) :- p(3344), q(3344).
-p(3346) :- p(3345), q(3346).
-q(3346) :- p(3345), q(3345).
-p(3347) :- p(3346), q(3347).
-q(3347) :- p(3346), q(3346).
-p(3348) :- p(3347), q(3348).
-q(3348) :- p(3347), q(3347).
-p(3349) :- p(3348), q(3349).
-q(3349) :- p(3348), q(3348).
-p(3350) :- p(3349), q(3350).
-q(3350) :- p(3349), q(3349).
-p(3351) :- p(3350), q(3351).
-q(3351) :- p(3350), q(3350).
-p(3352) :- p(3351), q(3352).
-q(3352) :- p(3351), q(3351).
-p(3353) :- p(3352), q(3353).
-q(3353) :- p(3352), q(3352).
-p(3354) :- p(3353), q(3354).
-q(3354) :- p(3353), q(3353).
-p(3355) :- p(3354), q(3355).
-q(3355) :- p(3354), q(3354).
-p(3356) :- p(3355), q(3356).
-q(3356) :- p(3355), q(3355).
-p(3357) :- p(3356), q(3357).
-q(3357) :- p(3356), q(3356).
-p(3358) :- p(3357), q(3358).
-q(3358) :- p(3357), q(3357).
-p(3359) :- p(3358), q(3359).
-q(3359) :- p(3358), q(3358).
-p(3360) :- p(3359), q(3360).
-q(3360) :- p(3359), q(3359).
-p(3361) :- p(3360), q(3361).
-q(3361) :- p(3360), q(3360).
-p(3362) :- p(3361), q(3362).
-q(3362) :- p(3361), q(3361).
-p(3363) :- p(3362), q(3363).
-q(3363) :- p(3362), q(3362).
-p(3364) :- p(3363), q(3364).
-q(3364) :- p(3363), q(3363).
-p(3365) :- p(3364), q(3365).
-q(3365) :- p(3364), q(3364).
-p(3366) :- p(3365), q(3366).
-q(3366) :- p(3365), q(3365).
-p(3367) :- p(3366), q(3367).
-q(3367) :- p(3366), q(3366).
-p(3368) :- p(3367), q(3368).
-q(3368) :- p(3367), q(3367).
-p(3369) :- p(3368), q(3369).
-q(3369) :- p(3368), q(3368).
-p(3370) :- p(3369), q(3370).
-q(3370) :- p(3369), q(3369).
-p(3371) :- p(3370), q(3371).
-q(3371) :- p(3370), q(3370).
-p(3372) :- p(3371), q(3372).
-q(3372) :- p(3371), q(3371).
-p(3373) :- p(3372), q(3373).
-q(3373) :- p(3372), q(3372).
-p(3374) :- p(3373), q(3374).
-q(3374) :- p(3373), q(3373).
-p(3375) :- p(3374), q(3375).
-q(3375) :- p(3374), q(3374).
-p(3376) :- p(3375), q(3376).
-q(3376) :- p(3375), q(3375).
-p(3377) :- p(3376), q(3377).
-q(3377) :- p(3376), q(3376).
-p(3378) :- p(3377), q(3378).
-q(3378) :- p(3377), q(3377).
-p(3379) :- p(3378), q(3379).
-q(3379) :- p(3378), q(3378).
-p(3380) :- p(3379), q(3380).
-q(3380) :- p(3379), q(3379).
-p(3381) :- p(3380), q(3381).
-q(3381) :- p(3380), q(3380).
-p(3382) :- p(3381), q(3382).
-q(3382) :- p(3381), q(3381).
-p(3383) :- p(3382), q(3383).
-q(3383) :- p(3382), q(3382).
-p(3384) :- p(3383), q(3384).
-q(3384) :- p(3383), q(3383).
-p(3385) :- p(3384), q(3385).
-q(3385) :- p(3384), q(3384).
-p(3386) :- p(3385), q(3386).
-q(3386) :- p(3385), q(3385).
-p(3387) :- p(3386), q(3387).
-q(3387) :- p(3386), q(3386).
-p(3388) :- p(3387), q(3388).
-q(3388) :- p(3387), q(3387).
-p(3389) :- p(3388), q(3389).
-q(3389) :- p(3388), q(3388).
-p(3390) :- p(3389), q(3390).
-q(3390) :- p(3389), q(3389).
-p(3391) :- p(3390), q(3391).
-q(3391) :- p(3390), q(3390).
-p(3392) :- p(3391), q(3392).
-q(3392) :- p(3391), q(3391).
-p(3393) :- p(3392), q(3393).
-q(3393) :- p(3392), q(3392).
-p(3394) :- p(3393), q(3394).
-q(3394) :- p(3393), q(3393).
-p(3395) :- p(3394), q(3395).
-q(3395) :- p(3394), q(3394).
-p(3396) :- p(3395), q(3396).
-q(3396) :- p(3395), q(3395).
-p(3397) :- p(3396), q(3397).
-q(3397) :- p(3396), q(3396).
-p(3398) :- p(3397), q(3398).
-q(3398) :- p(3397), q(3397).
-p(3399) :- p(3398), q(3399).
-q(3399) :- p(3398), q(3398).
-p(3400) :- p(3399), q(3400).
-q(3400) :- p(3399), q(3399).
-p(3401) :- p(3400), q(3401).
-q(3401) :- p(3400), q(3400).
-p(3402) :- p(3401), q(3402).
-q(3402) :- p(3401), q(3401).
-p(3403) :- p(3402), q(3403).
-q(3403) :- p(3402), q(3402).
-p(3404) :- p(3403), q(3404).
-q(3404) :- p(3403), q(3403).
-p(3405) :- p(3404), q(3405).
-q(3405) :- p(3404), q(3404).
-p(3406) :- p(3405), q(3406).
-q(3406) :- p(3405), q(3405).
-p(3407) :- p(3406), q(3407).
-q(3407) :- p(3406), q(3406).
-p(3408) :- p(3407), q(3408).
-q(3408) :- p(3407), q(3407).
-p(3409) :- p(3408), q(3409).
-q(3409) :- p(3408), q(3408).
-p(3410) :- p(3409), q(3410).
-q(3410) :- p(3409), q(3409).
-p(3411) :- p(3410), q(3411).
-q(3411) :- p(3410), q(3410).
-p(3412) :- p(3411), q(3412).
-q(3412) :- p(3411), q(3411).
-p(3413) :- p(3412), q(3413).
-q(3413) :- p(3412), q(3412).
-p(3414) :- p(3413), q(3414).
-q(3414) :- p(3413), q(3413).
-p(3415) :- p(3414), q(3415).
-q(3415) :- p(3414), q(3414).
-p(3416) :- p(3415), q(3416).
-q(3416) :- p(3415), q(3415).
-p(3417) :- p(3416), q(3417).
-q(3417) :- p(3416), q(3416).
-p(3418) :- p(3417), q(3418).
-q(3418) :- p(3417), q(3417).
-p(3419) :- p(3418), q(3419).
-q(3419) :- p(3418), q(3418).
-p(3420) :- p(3419), q(3420).
-q(3420) :- p(3419), q(3419).
-p(3421) :- p(3420), q(3421).
-q(3421) :- p(3420), q(3420).
-p(3422) :- p(3421), q(3422).
-q(3422) :- p(3421), q(3421).
-p(3423) :- p(3422), q(3423).
-q(3423) :- p(3422), q(3422).
-p(3424) :- p(3423), q(3424).
-q(3424) :- p(3423), q(3423).
-p(3425) :- p(3424), q(3425).
-q(3425) :- p(3424), q(3424).
-p(3426) :- p(3425), q(3426).
-q(3426) :- p(3425), q(3425).
-p(3427) :- p(3426), q(3427).
-q(3427) :- p(3426), q(3426).
-p(3428) :- p(3427), q(3428).
-q(3428) :- p(3427), q(3427).
-p(3429) :- p(3428), q(3429).
-q(3429) :- p(3428), q(3428).
-p(3430) :- p(3429), q(3430).
-q(3430) :- p(3429), q(3429).
-p(3431) :- p(3430), q(3431).
-q(3431) :- p(3430), q(3430).
-p(3432) :- p(3431), q(3432).
-q(3432) :- p(3431), q(3431).
-p(3433) :- p(3432), q(3433).
-q(3433) :- p(3432), q(3432).
-p(3434) :- p(3433), q(3434).
-q(3434) :- p(3433), q(3433).
-p(3435) :- p(3434), q(3435).
-q(3435) :- p(3434), q(3434).
-p(3436) :- p(3435), q(3436).
-q(3436) :- p(3435), q(3435).
-p(3437) :- p(3436), q(3437).
-q(3437) :- p(3436), q(3436).
-p(3438) :- p(3437), q(3438).
-q(3438) :- p(3437), q(3437).
-p(3439) :- p(3438), q(3439).
-q(3439) :- p(3438), q(3438).
-p(3440) :- p(3439), q(3440).
-q(3440) :- p(3439), q(3439).
-p(3441) :- p(3440), q(3441).
-q(3441) :- p(3440), q(3440).
-p(3442) :- p(3441), q(3442).
-q(3442) :- p(3441), q(3441).
-p(3443) :- p(3442), q(3443).
-q(3443) :- p(3442), q(3442).
-p(3444) :- p(3443), q(3444).
-q(3444) :- p(3443), q(3443).
-p(3445) :- p(3444), q(3445).
-q(3445) :- p(3444), q(3444).
-p(3446) :- p(3445), q(3446).
-q(3446) :- p(3445), q(3445).
-p(3447) :- p(3446), q(3447).
-q(3447) :- p(3446), q(3446).
-p(3448) :- p(3447), q(3448).
-q(3448) :- p(3447), q(3447).
-p(3449) :- p(3448), q(3449).
-q(3449) :- p(3448), q(3448).
-p(3450) :- p(3449), q(3450).
-q(3450) :- p(3449), q(3449).
-p(3451) :- p(3450), q(3451).
-q(3451) :- p(3450), q(3450).
-p(3452) :- p(3451), q(3452).
-q(3452) :- p(3451), q(3451).
-p(3453) :- p(3452), q(3453).
-q(3453) :- p(3452), q(3452).
-p(3454) :- p(3453), q(3454).
-q(3454) :- p(3453), q(3453).
-p(3455) :- p(3454), q(3455).
-q(3455) :- p(3454), q(3454).
-p(3456) :- p(3455), q(3456).
-q(3456) :- p(3455), q(3455).
-p(3457) :- p(3456), q(3457).
-q(3457) :- p(3456), q(3456).
-p(3458) :- p(3457), q(3458).
-q(3458) :- p(3457), q(3457).
-p(3459) :- p(3458), q(3459).
-q(3459) :- p(3458), q(3458).
-p(3460) :- p(3459), q(3460).
-q(3460) :- p(3459), q(3459).
-p(3461) :- p(3460), q(3461).
-q(3461) :- p(3460), q(3460).
-p(3462) :- p(3461), q(3462).
-q(3462) :- p(3461), q(3461).
-p(3463) :- p(3462), q(3463).
-q(3463) :- p(3462), q(3462).
-p(3464) :- p(3463), q(3464).
-q(3464) :- p(3463), q(3463).
-p(3465) :- p(3464), q(3465).
-q(3465) :- p(3464), q(3464).
-p(3466) :- p(3465), q(3466).
-q(3466) :- p(3465), q(3465).
-p(3467) :- p(3466), q(3467).
-q(3467) :- p(3466), q(3466).
-p(3468) :- p(3467), q(3468).
-q(3468) :- p(3467), q(3467).
-p(3469) :- p(3468), q(3469).
-q(3469) :- p(3468), q(3468).
-p(3470) :- p(3469), q(3470).
-q(3470) :- p(3469), q(3469).
-p(3471) :- p(3470), q(3471).
-q(3471) :- p(3470), q(3470).
-p(3472) :- p(3471), q(3472).
-q(3472) :- p(3471), q(3471).
-p(3473) :- p(3472), q(3473).
-q(3473) :- p(3472), q(3472).
-p(3474) :- p(3473), q(3474).
-q(3474) :- p(3473), q(3473).
-p(3475) :- p(3474), q(3475).
-q(3475) :- p(3474), q(3474).
-p(3476) :- p(3475), q(3476).
-q(3476) :- p(3475), q(3475).
-p(3477) :- p(3476), q(3477).
-q(3477) :- p(3476), q(3476).
-p(3478) :- p(3477), q(3478).
-q(3478) :- p(3477), q(3477).
-p(3479) :- p(3478), q(3479).
-q(3479) :- p(3478), q(3478).
-p(3480) :- p(3479), q(3480).
-q(3480) :- p(3479), q(3479).
-p(3481) :- p(3480), q(3481).
-q(3481) :- p(3480), q(3480).
-p(3482) :- p(3481), q(3482).
-q(3482) :- p(3481), q(3481).
-p(3483) :- p(3482), q(3483).
-q(3483) :- p(3482), q(3482).
-p(3484) :- p(3483), q(3484).
-q(3484) :- p(3483), q(3483).
-p(3485) :- p(3484), q(3485).
-q(3485) :- p(3484), q(3484).
-p(3486) :- p(3485), q(3486).
-q(3486) :- p(3485), q(3485).
-p(3487) :- p(3486), q(3487).
-q(3487) :- p(3486), q(3486).
-p(3488) :- p(3487), q(3488).
-q(3488) :- p(3487), q(3487).
-p(3489) :- p(3488), q(3489).
-q(3489) :- p(3488), q(3488).
-p(3490) :- p(3489), q(3490).
-q(3490) :- p(3489), q(3489).
-p(3491) :- p(3490), q(3491).
-q(3491) :- p(3490), q(3490).
-p(3492) :- p(3491), q(3492).
-q(3492) :- p(3491), q(3491).
-p(3493) :- p(3492), q(3493).
-q(3493) :- p(3492), q(3492).
-p(3494) :- p(3493), q(3494).
-q(3494) :- p(3493), q(3493).
-p(3495) :- p(3494), q(3495).
-q(3495) :- p(3494), q(3494).
-p(3496) :- p(3495), q(3496).
-q(3496) :- p(3495), q(3495).
-p(3497) :- p(3496), q(3497).
-q(3497) :- p(3496), q(3496).
-p(3498) :- p(3497), q(3498).
-q(3498) :- p(3497), q(3497).
-p(3499) :- p(3498), q(3499).
-q(3499) :- p(3498), q(3498).
-p(3500) :- p(3499), q(3500).
-q(3500) :- p(3499), q(3499).
-p(3501) :- p(3500), q(3501).
-q(3501) :- p(3500), q(3500).
-p(3502) :- p(3501), q(3502).
-q(3502) :- p(3501), q(3501).
-p(3503) :- p(3502), q(3503).
-q(3503) :- p(3502), q(3502).
-p(3504) :- p(3503), q(3504).
-q(3504) :- p(3503), q(3503).
-p(3505) :- p(3504), q(3505).
-q(3505) :- p(3504), q(3504).
-p(3506) :- p(3505), q(3506).
-q(3506) :- p(3505), q(3505).
-p(3507) :- p(3506), q(3507).
-q(3507) :- p(3506), q(3506).
-p(3508) :- p(3507), q(3508).
-q(3508) :- p(3507), q(3507).
-p(3509) :- p(3508), q(3509).
-q(3509) :- p(3508), q(3508).
-p(3510) :- p(3509), q(3510).
-q(3510) :- p(3509), q(3509).
-p(3511) :- p(3510), q(3511).
-q(3511) :- p(3510), q(3510).
-p(3512) :- p(3511), q(3512).
-q(3512) :- p(3511), q(3511).
-p(3513) :- p(3512), q(3513).
-q(3513) :- p(3512), q(3512).
-p(3514) :- p(3513), q(3514).
-q(3514) :- p(3513), q(3513).
-p(3515) :- p(3514), q(3515).
-q(3515) :- p(3514), q(3514).
-p(3516) :- p(3515), q(3516).
-q(3516) :- p(3515), q(3515).
-p(3517) :- p(3516), q(3517).
-q(3517) :- p(3516), q(3516).
-p(3518) :- p(3517), q(3518).
-q(3518) :- p(3517), q(3517).
-p(3519) :- p(3518), q(3519).
-q(3519) :- p(3518), q(3518).
-p(3520) :- p(3519), q(3520).
-q(3520) :- p(3519), q(3519).
-p(3521) :- p(3520), q(3521).
-q(3521) :- p(3520), q(3520).
-p(3522) :- p(3521), q(3522).
-q(3522) :- p(3521), q(3521).
-p(3523) :- p(3522), q(3523).
-q(3523) :- p(3522), q(3522).
-p(3524) :- p(3523), q(3524).
-q(3524) :- p(3523), q(3523).
-p(3525) :- p(3524), q(3525).
-q(3525) :- p(3524), q(3524).
-p(3526) :- p(3525), q(3526).
-q(3526) :- p(3525), q(3525).
-p(3527) :- p(3526), q(3527).
-q(3527) :- p(3526), q(3526).
-p(3528) :- p(3527), q(3528).
-q(3528) :- p(3527), q(3527).
-p(3529) :- p(3528), q(3529).
-q(3529) :- p(3528), q(3528).
-p(3530) :- p(3529), q(3530).
-q(3530) :- p(3529), q(3529).
-p(3531) :- p(3530), q(3531).
-q(3531) :- p(3530), q(3530).
-p(3532) :- p(3531), q(3532).
-q(3532) :- p(3531), q(3531).
-p(3533) :- p(3532), q(3533).
-q(3533) :- p(3532), q(3532).
-p(3534) :- p(3533), q(3534).
-q(3534) :- p(3533), q(3533).
-p(3535) :- p(3534), q(3535).
-q(3535) :- p(3534), q(3534).
-p(3536) :- p(3535), q(3536).
-q(3536) :- p(3535), q(3535).
-p(3537) :- p(3536), q(3537).
-q(3537) :- p(3536), q(3536).
-p(3538) :- p(3537), q(3538).
-q(3538) :- p(3537), q(3537).
-p(3539) :- p(3538), q(3539).
-q(3539) :- p(3538), q(3538).
-p(3540) :- p(3539), q(3540).
-q(3540) :- p(3539), q(3539).
-p(3541) :- p(3540), q(3541).
-q(3541) :- p(3540), q(3540).
-p(3542) :- p(3541), q(3542).
-q(3542) :- p(3541), q(3541).
-p(3543) :- p(3542), q(3543).
-q(3543) :- p(3542), q(3542).
-p(3544) :- p(3543), q(3544).
-q(3544) :- p(3543), q(3543).
-p(3545) :- p(3544), q(3545).
-q(3545) :- p(3544), q(3544).
-p(3546) :- p(3545), q(3546).
-q(3546) :- p(3545), q(3545).
-p(3547) :- p(3546), q(3547).
-q(3547) :- p(3546), q(3546).
-p(3548) :- p(3547), q(3548).
-q(3548) :- p(3547), q(3547).
-p(3549) :- p(3548), q(3549).
-q(3549) :- p(3548), q(3548).
-p(3550) :- p(3549), q(3550).
-q(3550) :- p(3549), q(3549).
-p(3551) :- p(3550), q(3551).
-q(3551) :- p(3550), q(3550).
-p(3552) :- p(3551), q(3552).
-q(3552) :- p(3551), q(3551).
-p(3553) :- p(3552), q(3553).
-q(3553) :- p(3552), q(3552).
-p(3554) :- p(3553), q(3554).
-q(3554) :- p(3553), q(3553).
-p(3555) :- p(3554), q(3555).
-q(3555) :- p(3554), q(3554).
-p(3556) :- p(3555), q(3556).
-q(3556) :- p(3555), q(3555).
-p(3557) :- p(3556), q(3557).
-q(3557) :- p(3556), q(3556).
-p(3558) :- p(3557), q(3558).
-q(3558) :- p(3557), q(3557).
-p(3559) :- p(3558), q(3559).
-q(3559) :- p(3558), q(3558).
-p(3560) :- p(3559), q(3560).
-q(3560) :- p(3559), q(3559).
-p(3561) :- p(3560), q(3561).
-q(3561) :- p(3560), q(3560).
-p(3562) :- p(3561), q(3562).
-q(3562) :- p(3561), q(3561).
-p(3563) :- p(3562), q(3563).
-q(3563) :- p(3562), q(3562).
-p(3564) :- p(3563), q(3564).
-q(3564) :- p(3563), q(3563).
-p(3565) :- p(3564), q(3565).
-q(3565) :- p(3564), q(3564).
-p(3566) :- p(3565), q(3566).
-q(3566) :- p(3565), q(3565).
-p(3567) :- p(3566), q(3567).
-q(3567) :- p(3566), q(3566).
-p(3568) :- p(3567), q(3568).
-q(3568) :- p(3567), q(3567).
-p(3569) :- p(3568), q(3569).
-q(3569) :- p(3568), q(3568).
-p(3570) :- p(3569), q(3570).
-q(3570) :- p(3569), q(3569).
-p(3571) :- p(3570), q(3571).
-q(3571) :- p(3570), q(3570).
-p(3572) :- p(3571), q(3572).
-q(3572) :- p(3571), q(3571).
-p(3573) :- p(3572), q(3573).
-q(3573) :- p(3572), q(3572).
-p(3574) :- p(3573), q(3574).
-q(3574) :- p(3573), q(3573).
-p(3575) :- p(3574), q(3575).
-q(3575) :- p(3574), q(3574).
-p(3576) :- p(3575), q(3576).
-q(3576) :- p(3575), q(3575).
-p(3577) :- p(3576), q(3577).
-q(3577) :- p(3576), q(3576).
-p(3578) :- p(3577), q(3578).
-q(3578) :- p(3577), q(3577).
-p(3579) :- p(3578), q(3579).
-q(3579) :- p(3578), q(3578).
-p(3580) :- p(3579), q(3580).
-q(3580) :- p(3579), q(3579).
-p(3581) :- p(3580), q(3581).
-q(3581) :- p(3580), q(3580).
-p(3582) :- p(3581), q(3582).
-q(3582) :- p(3581), q(3581).
-p(3583) :- p(3582), q(3583).
-q(3583) :- p(3582), q(3582).
-p(3584) :- p(3583), q(3584).
-q(3584) :- p(3583), q(3583).
-p(3585) :- p(3584), q(3585).
-q(3585) :- p(3584), q(3584).
-p(3586) :- p(3585), q(3586).
-q(3586) :- p(3585), q(3585).
-p(3587) :- p(3586), q(3587).
-q(3587) :- p(3586), q(3586).
-p(3588) :- p(3587), q(3588).
-q(3588) :- p(3587), q(3587).
-p(3589) :- p(3588), q(3589).
-q(3589) :- p(3588), q(3588).
-p(3590) :- p(3589), q(3590).
-q(3590) :- p(3589), q(3589).
-p(3591) :- p(3590), q(3591).
-q(3591) :- p(3590), q(3590).
-p(3592) :- p(3591), q(3592).
-q(3592) :- p(3591), q(3591).
-p(3593) :- p(3592), q(3593).
-q(3593) :- p(3592), q(3592).
-p(3594) :- p(3593), q(3594).
-q(3594) :- p(3593), q(3593).
-p(3595) :- p(3594), q(3595).
-q(3595) :- p(3594), q(3594).
-p(3596) :- p(3595), q(3596).
-q(3596) :- p(3595), q(3595).
-p(3597) :- p(3596), q(3597).
-q(3597) :- p(3596), q(3596).
-p(3598) :- p(3597), q(3598).
-q(3598) :- p(3597), q(3597).
-p(3599) :- p(3598), q(3599).
-q(3599) :- p(3598), q(3598).
-p(3600) :- p(3599), q(3600).
-q(3600) :- p(3599), q(3599).
-p(3601) :- p(3600), q(3601).
-q(3601) :- p(3600), q(3600).
-p(3602) :- p(3601), q(3602).
-q(3602) :- p(3601), q(3601).
-p(3603) :- p(3602), q(3603).
-q(3603) :- p(3602), q(3602).
-p(3604) :- p(3603), q(3604).
-q(3604) :- p(3603), q(3603).
-p(3605) :- p(3604), q(3605).
-q(3605) :- p(3604), q(3604).
-p(3606) :- p(3605), q(3606).
-q(3606) :- p(3605), q(3605).
-p(3607) :- p(3606), q(3607).
-q(3607) :- p(3606), q(3606).
-p(3608) :- p(3607), q(3608).
-q(3608) :- p(3607), q(3607).
-p(3609) :- p(3608), q(3609).
-q(3609) :- p(3608), q(3608).
-p(3610) :- p(3609), q(3610).
-q(3610) :- p(3609), q(3609).
-p(3611) :- p(3610), q(3611).
-q(3611) :- p(3610), q(3610).
-p(3612) :- p(3611), q(3612).
-q(3612) :- p(3611), q(3611).
-p(3613) :- p(3612), q(3613).
-q(3613) :- p(3612), q(3612).
-p(3614) :- p(3613), q(3614).
-q(3614) :- p(3613), q(3613).
-p(3615) :- p(3614), q(3615).
-q(3615) :- p(3614), q(3614).
-p(3616) :- p(3615), q(3616).
-q(3616) :- p(3615), q(3615).
-p(3617) :- p(3616), q(3617).
-q(3617) :- p(3616), q(3616).
-p(3618) :- p(3617), q(3618).
-q(3618) :- p(3617), q(3617).
-p(3619) :- p(3618), q(3619).
-q(3619) :- p(3618), q(3618).
-p(3620) :- p(3619), q(3620).
-q(3620) :- p(3619), q(3619).
-p(3621) :- p(3620), q(3621).
-q(3621) :- p(3620), q(3620).
-p(3622) :- p(3621), q(3622).
-q(3622) :- p(3621), q(3621).
-p(3623) :- p(3622), q(3623).
-q(3623) :- p(3622), q(3622).
-p(3624) :- p(3623), q(3624).
-q(3624) :- p(3623), q(3623).
-p(3625) :- p(3624), q(3625).
-q(3625) :- p(3624), q(3624).
-p(3626) :- p(3625), q(3626).
-q(3626) :- p(3625), q(3625).
-p(3627) :- p(3626), q(3627).
-q(3627) :- p(3626), q(3626).
-p(3628) :- p(3627), q(3628).
-q(3628) :- p(3627), q(3627).
-p(3629) :- p(3628), q(3629).
-q(3629) :- p(3628), q(3628).
-p(3630) :- p(3629), q(3630).
-q(3630) :- p(3629), q(3629).
-p(3631) :- p(3630), q(3631).
-q(3631) :- p(3630), q(3630).
-p(3632) :- p(3631), q(3632).
-q(3632) :- p(3631), q(3631).
-p(3633) :- p(3632), q(3633).
-q(3633) :- p(3632), q(3632).
-p(3634) :- p(3633), q(3634).
-q(3634) :- p(3633), q(3633).
-p(3635) :- p(3634), q(3635).
-q(3635) :- p(3634), q(3634).
-p(3636) :- p(3635), q(3636).
-q(3636) :- p(3635), q(3635).
-p(3637) :- p(3636), q(3637).
-q(3637) :- p(3636), q(3636).
-p(3638) :- p(3637), q(3638).
-q(3638) :- p(3637), q(3637).
-p(3639) :- p(3638), q(3639).
-q(3639) :- p(3638), q(3638).
-p(3640) :- p(3639), q(3640).
-q(3640) :- p(3639), q(3639).
-p(3641) :- p(3640), q(3641).
-q(3641) :- p(3640), q(3640).
-p(3642) :- p(3641), q(3642).
-q(3642) :- p(3641), q(3641).
-p(3643) :- p(3642), q(3643).
-q(3643) :- p(3642), q(3642).
-p(3644) :- p(3643), q(3644).
-q(3644) :- p(3643), q(3643).
-p(3645) :- p(3644), q(3645).
-q(3645) :- p(3644), q(3644).
-p(3646) :- p(3645), q(3646).
-q(3646) :- p(3645), q(3645).
-p(3647) :- p(3646), q(3647).
-q(3647) :- p(3646), q(3646).
-p(3648) :- p(3647), q(3648).
-q(3648) :- p(3647), q(3647).
-p(3649) :- p(3648), q(3649).
-q(3649) :- p(3648), q(3648).
-p(3650) :- p(3649), q(3650).
-q(3650) :- p(3649), q(3649).
-p(3651) :- p(3650), q(3651).
-q(3651) :- p(3650), q(3650).
-p(3652) :- p(3651), q(3652).
-q(3652) :- p(3651), q(3651).
-p(3653) :- p(3652), q(3653).
-q(3653) :- p(3652), q(3652).
-p(3654) :- p(3653), q(3654).
-q(3654) :- p(3653), q(3653).
-p(3655) :- p(3654), q(3655).
-q(3655) :- p(3654), q(3654).
-p(3656) :- p(3655), q(3656).
-q(3656) :- p(3655), q(3655).
-p(3657) :- p(3656), q(3657).
-q(3657) :- p(3656), q(3656).
-p(3658) :- p(3657), q(3658).
-q(3658) :- p(3657), q(3657).
-p(3659) :- p(3658), q(3659).
-q(3659) :- p(3658), q(3658).
-p(3660) :- p(3659), q(3660).
-q(3660) :- p(3659), q(3659).
-p(3661) :- p(3660), q(3661).
-q(3661) :- p(3660), q(3660).
-p(3662) :- p(3661), q(3662).
-q(3662) :- p(3661), q(3661).
-p(3663) :- p(3662), q(3663).
-q(3663) :- p(3662), q(3662).
-p(3664) :- p(3663), q(3664).
-q(3664) :- p(3663), q(3663).
-p(3665) :- p(3664), q(3665).
-q(3665) :- p(3664), q(3664).
-p(3666) :- p(3665), q(3666).
-q(3666) :- p(3665), q(3665).
-p(3667) :- p(3666), q(3667).
-q(3667) :- p(3666), q(3666).
-p(3668) :- p(3667), q(3668).
-q(3668) :- p(3667), q(3667).
-p(3669) :- p(3668), q(3669).
-q(3669) :- p(3668), q(3668).
-p(3670) :- p(3669), q(3670).
-q(3670) :- p(3669), q(3669).
-p(3671) :- p(3670), q(3671).
-q(3671) :- p(3670), q(3670).
-p(3672) :- p(3671), q(3672).
-q(3672) :- p(3671), q(3671).
-p(3673) :- p(3672), q(3673).
-q(3673) :- p(3672), q(3672).
-p(3674) :- p(3673), q(3674).
-q(3674) :- p(3673), q(3673).
-p(3675) :- p(3674), q(3675).
-q(3675) :- p(3674), q(3674).
-p(3676) :- p(3675), q(3676).
-q(3676) :- p(3675), q(3675).
-p(3677) :- p(3676), q(3677).
-q(3677) :- p(3676), q(3676).
-p(3678) :- p(3677), q(3678).
-q(3678) :- p(3677), q(3677).
-p(3679) :- p(3678), q(3679).
-q(3679) :- p(3678), q(3678).
-p(3680) :- p(3679), q(3680).
-q(3680) :- p(3679), q(3679).
-p(3681) :- p(3680), q(3681).
-q(3681) :- p(3680), q(3680).
-p(3682) :- p(3681), q(3682).
-q(3682) :- p(3681), q(3681).
-p(3683) :- p(3682), q(3683).
-q(3683) :- p(3682), q(3682).
-p(3684) :- p(3683), q(3684).
-q(3684) :- p(3683), q(3683).
-p(3685) :- p(3684), q(3685).
-q(3685) :- p(3684), q(3684).
-p(3686) :- p(3685), q(3686).
-q(3686) :- p(3685), q(3685).
-p(3687) :- p(3686), q(3687).
-q(3687) :- p(3686), q(3686).
-p(3688) :- p(3687), q(3688).
-q(3688) :- p(3687), q(3687).
-p(3689) :- p(3688), q(3689).
-q(3689) :- p(3688), q(3688).
-p(3690) :- p(3689), q(3690).
-q(3690) :- p(3689), q(3689).
-p(3691) :- p(3690), q(3691).
-q(3691) :- p(3690), q(3690).
-p(3692) :- p(3691), q(3692).
-q(3692) :- p(3691), q(3691).
-p(3693) :- p(3692), q(3693).
-q(3693) :- p(3692), q(3692).
-p(3694) :- p(3693), q(3694).
-q(3694) :- p(3693), q(3693).
-p(3695) :- p(3694), q(3695).
-q(3695) :- p(3694), q(3694).
-p(3696) :- p(3695), q(3696).
-q(3696) :- p(3695), q(3695).
-p(3697) :- p(3696), q(3697).
-q(3697) :- p(3696), q(3696).
-p(3698) :- p(3697), q(3698).
-q(3698) :- p(3697), q(3697).
-p(3699) :- p(3698), q(3699).
-q(3699) :- p(3698), q(3698).
-p(3700) :- p(3699), q(3700).
-q(3700) :- p(3699), q(3699).
-p(3701) :- p(3700), q(3701).
-q(3701) :- p(3700), q(3700).
-p(3702) :- p(3701), q(3702).
-q(3702) :- p(3701), q(3701).
-p(3703) :- p(3702), q(3703).
-q(3703) :- p(3702), q(3702).
-p(3704) :- p(3703), q(3704).
-q(3704) :- p(3703), q(3703).
-p(3705) :- p(3704), q(3705).
-q(3705) :- p(3704), q(3704).
-p(3706) :- p(3705), q(3706).
-q(3706) :- p(3705), q(3705).
-p(3707) :- p(3706), q(3707).
-q(3707) :- p(3706), q(3706).
-p(3708) :- p(3707), q(3708).
-q(3708) :- p(3707), q(3707).
-p(3709) :- p(3708), q(3709).
-q(3709) :- p(3708), q(3708).
-p(3710) :- p(3709), q(3710).
-q(3710) :- p(3709), q(3709).
-p(3711) :- p(3710), q(3711).
-q(3711) :- p(3710), q(3710).
-p(3712) :- p(3711), q(3712).
-q(3712) :- p(3711), q(3711).
-p(3713) :- p(3712), q(3713).
-q(3713) :- p(3712), q(3712).
-p(3714) :- p(3713), q(3714).
-q(3714) :- p(3713), q(3713).
-p(3715) :- p(3714), q(3715).
-q(3715) :- p(3714), q(3714).
-p(3716) :- p(3715), q(3716).
-q(3716) :- p(3715), q(3715).
-p(3717) :- p(3716), q(3717).
-q(3717) :- p(3716), q(3716).
-p(3718) :- p(3717), q(3718).
-q(3718) :- p(3717), q(3717).
-p(3719) :- p(3718), q(3719).
-q(3719) :- p(3718), q(3718).
-p(3720) :- p(3719), q(3720).
-q(3720) :- p(3719), q(3719).
-p(3721) :- p(3720), q(3721).
-q(3721) :- p(3720), q(3720).
-p(3722) :- p(3721), q(3722).
-q(3722) :- p(3721), q(3721).
-p(3723) :- p(3722), q(3723).
-q(3723) :- p(3722), q(3722).
-p(3724) :- p(3723), q(3724).
-q(3724) :- p(3723), q(3723).
-p(3725) :- p(3724), q(3725).
-q(3725) :- p(3724), q(3724).
-p(3726) :- p(3725), q(3726).
-q(3726) :- p(3725), q(3725).
-p(3727) :- p(3726), q(3727).
-q(3727) :- p(3726), q(3726).
-p(3728) :- p(3727), q(3728).
-q(3728) :- p(3727), q(3727).
-p(3729) :- p(3728), q(3729).
-q(3729) :- p(3728), q(3728).
-p(3730) :- p(3729), q(3730).
-q(3730) :- p(3729), q(3729).
-p(3731) :- p(3730), q(3731).
-q(3731) :- p(3730), q(3730).
-p(3732) :- p(3731), q(3732).
-q(3732) :- p(3731), q(3731).
-p(3733) :- p(3732), q(3733).
-q(3733) :- p(3732), q(3732).
-p(3734) :- p(3733), q(3734).
-q(3734) :- p(3733), q(3733).
-p(3735) :- p(3734), q(3735).
-q(3735) :- p(3734), q(3734).
-p(3736) :- p(3735), q(3736).
-q(3736) :- p(3735), q(3735).
-p(3737) :- p(3736), q(3737).
-q(3737) :- p(3736), q(3736).
-p(3738) :- p(3737), q(3738).
-q(3738) :- p(3737), q(3737).
-p(3739) :- p(3738), q(3739).
-q(3739) :- p(3738), q(3738).
-p(3740) :- p(3739), q(3740).
-q(3740) :- p(3739), q(3739).
-p(3741) :- p(3740), q(3741).
-q(3741) :- p(3740), q(3740).
-p(3742) :- p(3741), q(3742).
-q(3742) :- p(3741), q(3741).
-p(3743) :- p(3742), q(3743).
-q(3743) :- p(3742), q(3742).
-p(3744) :- p(3743), q(3744).
-q(3744) :- p(3743), q(3743).
-p(3745) :- p(3744), q(3745).
-q(3745) :- p(3744), q(3744).
-p(3746) :- p(3745), q(3746).
-q(3746) :- p(3745), q(3745).
-p(3747) :- p(3746), q(3747).
-q(3747) :- p(3746), q(3746).
-p(3748) :- p(3747), q(3748).
-q(3748) :- p(3747), q(3747).
-p(3749) :- p(3748), q(3749).
-q(3749) :- p(3748), q(3748).
-p(3750) :- p(3749), q(3750).
-q(3750) :- p(3749), q(3749).
-p(3751) :- p(3750), q(3751).
-q(3751) :- p(3750), q(3750).
-p(3752) :- p(3751), q(3752).
-q(3752) :- p(3751), q(3751).
-p(3753) :- p(3752), q(3753).
-q(3753) :- p(3752), q(3752).
-p(3754) :- p(3753), q(3754).
-q(3754) :- p(3753), q(3753).
-p(3755) :- p(3754), q(3755).
-q(3755) :- p(3754), q(3754).
-p(3756) :- p(3755), q(3756).
-q(3756) :- p(3755), q(3755).
-p(3757) :- p(3756), q(3757).
-q(3757) :- p(3756), q(3756).
-p(3758) :- p(3757), q(3758).
-q(3758) :- p(3757), q(3757).
-p(3759) :- p(3758), q(3759).
-q(3759) :- p(3758), q(3758).
-p(3760) :- p(3759), q(3760).
-q(3760) :- p(3759), q(3759).
-p(3761) :- p(3760), q(3761).
-q(3761) :- p(3760), q(3760).
-p(3762) :- p(3761), q(3762).
-q(3762) :- p(3761), q(3761).
-p(3763) :- p(3762), q(3763).
-q(3763) :- p(3762), q(3762).
-p(3764) :- p(3763), q(3764).
-q(3764) :- p(3763), q(3763).
-p(3765) :- p(3764), q(3765).
-q(3765) :- p(3764), q(3764).
-p(3766) :- p(3765), q(3766).
-q(3766) :- p(3765), q(3765).
-p(3767) :- p(3766), q(3767).
-q(3767) :- p(3766), q(3766).
-p(3768) :- p(3767), q(3768).
-q(3768) :- p(3767), q(3767).
-p(3769) :- p(3768), q(3769).
-q(3769) :- p(3768), q(3768).
-p(3770) :- p(3769), q(3770).
-q(3770) :- p(3769), q(3769).
-p(3771) :- p(3770), q(3771).
-q(3771) :- p(3770), q(3770).
-p(3772) :- p(3771), q(3772).
-q(3772) :- p(3771), q(3771).
-p(3773) :- p(3772), q(3773).
-q(3773) :- p(3772), q(3772).
-p(3774) :- p(3773), q(3774).
-q(3774) :- p(3773), q(3773).
-p(3775) :- p(3774), q(3775).
-q(3775) :- p(3774), q(3774).
-p(3776) :- p(3775), q(3776).
-q(3776) :- p(3775), q(3775).
-p(3777) :- p(3776), q(3777).
-q(3777) :- p(3776), q(3776).
-p(3778) :- p(3777), q(3778).
-q(3778) :- p(3777), q(3777).
-p(3779) :- p(3778), q(3779).
-q(3779) :- p(3778), q(3778).
-p(3780) :- p(3779), q(3780).
-q(3780) :- p(3779), q(3779).
-p(3781) :- p(3780), q(3781).
-q(3781) :- p(3780), q(3780).
-p(3782) :- p(3781), q(3782).
-q(3782) :- p(3781), q(3781).
-p(3783) :- p(3782), q(3783).
-q(3783) :- p(3782), q(3782).
-p(3784) :- p(3783), q(3784).
-q(3784) :- p(3783), q(3783).
-p(3785) :- p(3784), q(3785).
-q(3785) :- p(3784), q(3784).
-p(3786) :- p(3785), q(3786).
-q(3786) :- p(3785), q(3785).
-p(3787) :- p(3786), q(3787).
-q(3787) :- p(3786), q(3786).
-p(3788) :- p(3787), q(3788).
-q(3788) :- p(3787), q(3787).
-p(3789) :- p(3788), q(3789).
-q(3789) :- p(3788), q(3788).
-p(3790) :- p(3789), q(3790).
-q(3790) :- p(3789), q(3789).
-p(3791) :- p(3790), q(3791).
-q(3791) :- p(3790), q(3790).
-p(3792) :- p(3791), q(3792).
-q(3792) :- p(3791), q(3791).
-p(3793) :- p(3792), q(3793).
-q(3793) :- p(3792), q(3792).
-p(3794) :- p(3793), q(3794).
-q(3794) :- p(3793), q(3793).
-p(3795) :- p(3794), q(3795).
-q(3795) :- p(3794), q(3794).
-p(3796) :- p(3795), q(3796).
-q(3796) :- p(3795), q(3795).
-p(3797) :- p(3796), q(3797).
-q(3797) :- p(3796), q(3796).
-p(3798) :- p(3797), q(3798).
-q(3798) :- p(3797), q(3797).
-p(3799) :- p(3798), q(3799).
-q(3799) :- p(3798), q(3798).
-p(3800) :- p(3799), q(3800).
-q(3800) :- p(3799), q(3799).
-p(3801) :- p(3800), q(3801).
-q(3801) :- p(3800), q(3800).
-p(3802) :- p(3801), q(3802).
-q(3802) :- p(3801), q(3801).
-p(3803) :- p(3802), q(3803).
-q(3803) :- p(3802), q(3802).
-p(3804) :- p(3803), q(3804).
-q(3804) :- p(3803), q(3803).
-p(3805) :- p(3804), q(3805).
-q(3805) :- p(3804), q(3804).
-p(3806) :- p(3805), q(3806).
-q(3806) :- p(3805), q(3805).
-p(3807) :- p(3806), q(3807).
-q(3807) :- p(3806), q(3806).
-p(3808) :- p(3807), q(3808).
-q(3808) :- p(3807), q(3807).
-p(3809) :- p(3808), q(3809).
-q(3809) :- p(3808), q(3808).
-p(3810) :- p(3809), q(3810).
-q(3810) :- p(3809), q(3809).
-p(3811) :- p(3810), q(3811).
-q(3811) :- p(3810), q(3810).
-p(3812) :- p(3811), q(3812).
-q(3812) :- p(3811), q(3811).
-p(3813) :- p(3812), q(3813).
-q(3813) :- p(3812), q(3812).
-p(3814) :- p(3813), q(3814).
-q(3814) :- p(3813), q(3813).
-p(3815) :- p(3814), q(3815).
-q(3815) :- p(3814), q(3814).
-p(3816) :- p(3815), q(3816).
-q(3816) :- p(3815), q(3815).
-p(3817) :- p(3816), q(3817).
-q(3817) :- p(3816), q(3816).
-p(3818) :- p(3817), q(3818).
-q(3818) :- p(3817), q(3817).
-p(3819) :- p(3818), q(3819).
-q(3819) :- p(3818), q(3818).
-p(3820) :- p(3819), q(3820).
-q(3820) :- p(3819), q(3819).
-p(3821) :- p(3820), q(3821).
-q(3821) :- p(3820), q(3820).
-p(3822) :- p(3821), q(3822).
-q(3822) :- p(3821), q(3821).
-p(3823) :- p(3822), q(3823).
-q(3823) :- p(3822), q(3822).
-p(3824) :- p(3823), q(3824).
-q(3824) :- p(3823), q(3823).
-p(3825) :- p(3824), q(3825).
-q(3825) :- p(3824), q(3824).
-p(3826) :- p(3825), q(3826).
-q(3826) :- p(3825), q(3825).
-p(3827) :- p(3826), q(3827).
-q(3827) :- p(3826), q(3826).
-p(3828) :- p(3827), q(3828).
-q(3828) :- p(3827), q(3827).
-p(3829) :- p(3828), q(3829).
-q(3829) :- p(3828), q(3828).
-p(3830) :- p(3829), q(3830).
-q(3830) :- p(3829), q(3829).
-p(3831) :- p(3830), q(3831).
-q(3831) :- p(3830), q(3830).
-p(3832) :- p(3831), q(3832).
-q(3832) :- p(3831), q(3831).
-p(3833) :- p(3832), q(3833).
-q(3833) :- p(3832), q(3832).
-p(3834) :- p(3833), q(3834).
-q(3834) :- p(3833), q(3833).
-p(3835) :- p(3834), q(3835).
-q(3835) :- p(3834), q(3834).
-p(3836) :- p(3835), q(3836).
-q(3836) :- p(3835), q(3835).
-p(3837) :- p(3836), q(3837).
-q(3837) :- p(3836), q(3836).
-p(3838) :- p(3837), q(3838).
-q(3838) :- p(3837), q(3837).
-p(3839) :- p(3838), q(3839).
-q(3839) :- p(3838), q(3838).
-p(3840) :- p(3839), q(3840).
-q(3840) :- p(3839), q(3839).
-p(3841) :- p(3840), q(3841).
-q(3841) :- p(3840), q(3840).
-p(3842) :- p(3841), q(3842).
-q(3842) :- p(3841), q(3841).
-p(3843) :- p(3842), q(3843).
-q(3843) :- p(3842), q(3842).
-p(3844) :- p(3843), q(3844).
-q(3844) :- p(3843), q(3843).
-p(3845) :- p(3844), q(3845).
-q(3845) :- p(3844), q(3844).
-p(3846) :- p(3845), q(3846).
-q(3846) :- p(3845), q(3845).
-p(3847) :- p(3846), q(3847).
-q(3847) :- p(3846), q(3846).
-p(3848) :- p(3847), q(3848).
-q(3848) :- p(3847), q(3847).
-p(3849) :- p(3848), q(3849).
-q(3849) :- p(3848), q(3848).
-p(3850) :- p(3849), q(3850).
-q(3850) :- p(3849), q(3849).
-p(3851) :- p(3850), q(3851).
-q(3851) :- p(3850), q(3850).
-p(3852) :- p(3851), q(3852).
-q(3852) :- p(3851), q(3851).
-p(3853) :- p(3852), q(3853).
-q(3853) :- p(3852), q(3852).
-p(3854) :- p(3853), q(3854).
-q(3854) :- p(3853), q(3853).
-p(3855) :- p(3854), q(3855).
-q(3855) :- p(3854), q(3854).
-p(3856) :- p(3855), q(3856).
-q(3856) :- p(3855), q(3855).
-p(3857) :- p(3856), q(3857).
-q(3857) :- p(3856), q(3856).
-p(3858) :- p(3857), q(3858).
-q(3858) :- p(3857), q(3857).
-p(3859) :- p(3858), q(3859).
-q(3859) :- p(3858), q(3858).
-p(3860) :- p(3859), q(3860).
-q(3860) :- p(3859), q(3859).
-p(3861) :- p(3860), q(3861).
-q(3861) :- p(3860), q(3860).
-p(3862) :- p(3861), q(3862).
-q(3862) :- p(3861), q(3861).
-p(3863) :- p(3862), q(3863).
-q(3863) :- p(3862), q(3862).
-p(3864) :- p(3863), q(3864).
-q(3864) :- p(3863), q(3863).
-p(3865) :- p(3864), q(3865).
-q(3865) :- p(3864), q(3864).
-p(3866) :- p(3865), q(3866).
-q(3866) :- p(3865), q(3865).
-p(3867) :- p(3866), q(3867).
-q(3867) :- p(3866), q(3866).
-p(3868) :- p(3867), q(3868).
-q(3868) :- p(3867), q(3867).
-p(3869) :- p(3868), q(3869).
-q(3869) :- p(3868), q(3868).
-p(3870) :- p(3869), q(3870).
-q(3870) :- p(3869), q(3869).
-p(3871) :- p(3870), q(3871).
-q(3871) :- p(3870), q(3870).
-p(3872) :- p(3871), q(3872).
-q(3872) :- p(3871), q(3871).
-p(3873) :- p(3872), q(3873).
-q(3873) :- p(3872), q(3872).
-p(3874) :- p(3873), q(3874).
-q(3874) :- p(3873), q(3873).
-p(3875) :- p(3874), q(3875).
-q(3875) :- p(3874), q(3874).
-p(3876) :- p(3875), q(3876).
-q(3876) :- p(3875), q(3875).
-p(3877) :- p(3876), q(3877).
-q(3877) :- p(3876), q(3876).
-p(3878) :- p(3877), q(3878).
-q(3878) :- p(3877), q(3877).
-p(3879) :- p(3878), q(3879).
-q(3879) :- p(3878), q(3878).
-p(3880) :- p(3879), q(3880).
-q(3880) :- p(3879), q(3879).
-p(3881) :- p(3880), q(3881).
-q(3881) :- p(3880), q(3880).
-p(3882) :- p(3881), q(3882).
-q(3882) :- p(3881), q(3881).
-p(3883) :- p(3882), q(3883).
-q(3883) :- p(3882), q(3882).
-p(3884) :- p(3883), q(3884).
-q(3884) :- p(3883), q(3883).
-p(3885) :- p(3884), q(3885).
-q(3885) :- p(3884), q(3884).
-p(3886) :- p(3885), q(3886).
-q(3886) :- p(3885), q(3885).
-p(3887) :- p(3886), q(3887).
-q(3887) :- p(3886), q(3886).
-p(3888) :- p(3887), q(3888).
-q(3888) :- p(3887), q(3887).
-p(3889) :- p(3888), q(3889).
-q(3889) :- p(3888), q(3888).
-p(3890) :- p(3889), q(3890).
-q(3890) :- p(3889), q(3889).
-p(3891) :- p(3890), q(3891).
-q(3891) :- p(3890), q(3890).
-p(3892) :- p(3891), q(3892).
-q(3892) :- p(3891), q(3891).
-p(3893) :- p(3892), q(3893).
-q(3893) :- p(3892), q(3892).
-p(3894) :- p(3893), q(3894).
-q(3894) :- p(3893), q(3893).
-p(3895) :- p(3894), q(3895).
-q(3895) :- p(3894), q(3894).
-p(3896) :- p(3895), q(3896).
-q(3896) :- p(3895), q(3895).
-p(3897) :- p(3896), q(3897).
-q(3897) :- p(3896), q(3896).
-p(3898) :- p(3897), q(3898).
-q(3898) :- p(3897), q(3897).
-p(3899) :- p(3898), q(3899).
-q(3899) :- p(3898), q(3898).
-p(3900) :- p(3899), q(3900).
-q(3900) :- p(3899), q(3899).
-p(3901) :- p(3900), q(3901).
-q(3901) :- p(3900), q(3900).
-p(3902) :- p(3901), q(3902).
-q(3902) :- p(3901), q(3901).
-p(3903) :- p(3902), q(3903).
-q(3903) :- p(3902), q(3902).
-p(3904) :- p(3903), q(3904).
-q(3904) :- p(3903), q(3903).
-p(3905) :- p(3904), q(3905).
-q(3905) :- p(3904), q(3904).
-p(3906) :- p(3905), q(3906).
-q(3906) :- p(3905), q(3905).
-p(3907) :- p(3906), q(3907).
-q(3907) :- p(3906), q(3906).
-p(3908) :- p(3907), q(3908).
-q(3908) :- p(3907), q(3907).
-p(3909) :- p(3908), q(3909).
-q(3909) :- p(3908), q(3908).
-p(3910) :- p(3909), q(3910).
-q(3910) :- p(3909), q(3909).
-p(3911) :- p(3910), q(3911).
-q(3911) :- p(3910), q(3910).
-p(3912) :- p(3911), q(3912).
-q(3912) :- p(3911), q(3911).
-p(3913) :- p(3912), q(3913).
-q(3913) :- p(3912), q(3912).
-p(3914) :- p(3913), q(3914).
-q(3914) :- p(3913), q(3913).
-p(3915) :- p(3914), q(3915).
-q(3915) :- p(3914), q(3914).
-p(3916) :- p(3915), q(3916).
-q(3916) :- p(3915), q(3915).
-p(3917) :- p(3916), q(3917).
-q(3917) :- p(3916), q(3916).
-p(3918) :- p(3917), q(3918).
-q(3918) :- p(3917), q(3917).
-p(3919) :- p(3918), q(3919).
-q(3919) :- p(3918), q(3918).
-p(3920) :- p(3919), q(3920).
-q(3920) :- p(3919), q(3919).
-p(3921) :- p(3920), q(3921).
-q(3921) :- p(3920), q(3920).
-p(3922) :- p(3921), q(3922).
-q(3922) :- p(3921), q(3921).
-p(3923) :- p(3922), q(3923).
-q(3923) :- p(3922), q(3922).
-p(3924) :- p(3923), q(3924).
-q(3924) :- p(3923), q(3923).
-p(3925) :- p(3924), q(3925).
-q(3925) :- p(3924), q(3924).
-p(3926) :- p(3925), q(3926).
-q(3926) :- p(3925), q(3925).
-p(3927) :- p(3926), q(3927).
-q(3927) :- p(3926), q(3926).
-p(3928) :- p(3927), q(3928).
-q(3928) :- p(3927), q(3927).
-p(3929) :- p(3928), q(3929).
-q(3929) :- p(3928), q(3928).
-p(3930) :- p(3929), q(3930).
-q(3930) :- p(3929), q(3929).
-p(3931) :- p(3930), q(3931).
-q(3931) :- p(3930), q(3930).
-p(3932) :- p(3931), q(3932).
-q(3932) :- p(3931), q(3931).
-p(3933) :- p(3932), q(3933).
-q(3933) :- p(3932), q(3932).
-p(3934) :- p(3933), q(3934).
-q(3934) :- p(3933), q(3933).
-p(3935) :- p(3934), q(3935).
-q(3935) :- p(3934), q(3934).
-p(3936) :- p(3935), q(3936).
-q(3936) :- p(3935), q(3935).
-p(3937) :- p(3936), q(3937).
-q(3937) :- p(3936), q(3936).
-p(3938) :- p(3937), q(3938).
-q(3938) :- p(3937), q(3937).
-p(3939) :- p(3938), q(3939).
-q(3939) :- p(3938), q(3938).
-p(3940) :- p(3939), q(3940).
-q(3940) :- p(3939), q(3939).
-p(3941) :- p(3940), q(3941).
-q(3941) :- p(3940), q(3940).
-p(3942) :- p(3941), q(3942).
-q(3942) :- p(3941), q(3941).
-p(3943) :- p(3942), q(3943).
-q(3943) :- p(3942), q(3942).
-p(3944) :- p(3943), q(3944).
-q(3944) :- p(3943), q(3943).
-p(3945) :- p(3944), q(3945).
-q(3945) :- p(3944), q(3944).
-p(3946) :- p(3945), q(3946).
-q(3946) :- p(3945), q(3945).
-p(3947) :- p(3946), q(3947).
-q(3947) :- p(3946), q(3946).
-p(3948) :- p(3947), q(3948).
-q(3948) :- p(3947), q(3947).
-p(3949) :- p(3948), q(3949).
-q(3949) :- p(3948), q(3948).
-p(3950) :- p(3949), q(3950).
-q(3950) :- p(3949), q(3949).
-p(3951) :- p(3950), q(3951).
-q(3951) :- p(3950), q(3950).
-p(3952) :- p(3951), q(3952).
-q(3952) :- p(3951), q(3951).
-p(3953) :- p(3952), q(3953).
-q(3953) :- p(3952), q(3952).
-p(3954) :- p(3953), q(3954).
-q(3954) :- p(3953), q(3953).
-p(3955) :- p(3954), q(3955).
-q(3955) :- p(3954), q(3954).
-p(3956) :- p(3955), q(3956).
-q(3956) :- p(3955), q(3955).
-p(3957) :- p(3956), q(3957).
-q(3957) :- p(3956), q(3956).
-p(3958) :- p(3957), q(3958).
-q(3958) :- p(3957), q(3957).
-p(3959) :- p(3958), q(3959).
-q(3959) :- p(3958), q(3958).
-p(3960) :- p(3959), q(3960).
-q(3960) :- p(3959), q(3959).
-p(3961) :- p(3960), q(3961).
-q(3961) :- p(3960), q(3960).
-p(3962) :- p(3961), q(3962).
-q(3962) :- p(3961), q(3961).
-p(3963) :- p(3962), q(3963).
-q(3963) :- p(3962), q(3962).
-p(3964) :- p(3963), q(3964).
-q(3964) :- p(3963), q(3963).
-p(3965) :- p(3964), q(3965).
-q(3965) :- p(3964), q(3964).
-p(3966) :- p(3965), q(3966).
-q(3966) :- p(3965), q(3965).
-p(3967) :- p(3966), q(3967).
-q(3967) :- p(3966), q(3966).
-p(3968) :- p(3967), q(3968).
-q(3968) :- p(3967), q(3967).
-p(3969) :- p(3968), q(3969).
-q(3969) :- p(3968), q(3968).
-p(3970) :- p(3969), q(3970).
-q(3970) :- p(3969), q(3969).
-p(3971) :- p(3970), q(3971).
-q(3971) :- p(3970), q(3970).
-p(3972) :- p(3971), q(3972).
-q(3972) :- p(3971), q(3971).
-p(3973) :- p(3972), q(3973).
-q(3973) :- p(3972), q(3972).
-p(3974) :- p(3973), q(3974).
-q(3974) :- p(3973), q(3973).
-p(3975) :- p(3974), q(3975).
-q(3975) :- p(3974), q(3974).
-p(3976) :- p(3975), q(3976).
-q(3976) :- p(3975), q(3975).
-p(3977) :- p(3976), q(3977).
-q(3977) :- p(3976), q(3976).
-p(3978) :- p(3977), q(3978).
-q(3978) :- p(3977), q(3977).
-p(3979) :- p(3978), q(3979).
-q(3979) :- p(3978), q(3978).
-p(3980) :- p(3979), q(3980).
-q(3980) :- p(3979), q(3979).
-p(3981) :- p(3980), q(3981).
-q(3981) :- p(3980), q(3980).
-p(3982) :- p(3981), q(3982).
-q(3982) :- p(3981), q(3981).
-p(3983) :- p(3982), q(3983).
-q(3983) :- p(3982), q(3982).
-p(3984) :- p(3983), q(3984).
-q(3984) :- p(3983), q(3983).
-p(3985) :- p(3984), q(3985).
-q(3985) :- p(3984), q(3984).
-p(3986) :- p(3985), q(3986).
-q(3986) :- p(3985), q(3985).
-p(3987) :- p(3986), q(3987).
-q(3987) :- p(3986), q(3986).
-p(3988) :- p(3987), q(3988).
-q(3988) :- p(3987), q(3987).
-p(3989) :- p(3988), q(3989).
-q(3989) :- p(3988), q(3988).
-p(3990) :- p(3989), q(3990).
-q(3990) :- p(3989), q(3989).
-p(3991) :- p(3990), q(3991).
-q(3991) :- p(3990), q(3990).
-p(3992) :- p(3991), q(3992).
-q(3992) :- p(3991), q(3991).
-p(3993) :- p(3992), q(3993).
-q(3993) :- p(3992), q(3992).
-p(3994) :- p(3993), q(3994).
-q(3994) :- p(3993), q(3993).
-p(3995) :- p(3994), q(3995).
-q(3995) :- p(3994), q(3994).
-p(3996) :- p(3995), q(3996).
-q(3996) :- p(3995), q(3995).
-p(3997) :- p(3996), q(3997).
-q(3997) :- p(3996), q(3996).
-p(3998) :- p(3997), q(3998).
-q(3998) :- p(3997), q(3997).
-p(3999) :- p(3998), q(3999).
-q(3999) :- p(3998), q(3998).
-p(4000) :- p(3999), q(4000).
-q(4000) :- p(3999), q(3999).
-p(4001) :- p(4000), q(4001).
-q(4001) :- p(4000), q(4000).
-p(4002) :- p(4001), q(4002).
-q(4002) :- p(4001), q(4001).
-p(4003) :- p(4002), q(4003).
-q(4003) :- p(4002), q(4002).
-p(4004) :- p(4003), q(4004).
-q(4004) :- p(4003), q(4003).
-p(4005) :- p(4004), q(4005).
-q(4005) :- p(4004), q(4004).
-p(4006) :- p(4005), q(4006).
-q(4006) :- p(4005), q(4005).
-p(4007) :- p(4006), q(4007).
-q(4007) :- p(4006), q(4006).
-p(4008) :- p(4007), q(4008).
-q(4008) :- p(4007), q(4007).
-p(4009) :- p(4008), q(4009).
-q(4009) :- p(4008), q(4008).
-p(4010) :- p(4009), q(4010).
-q(4010) :- p(4009), q(4009).
-p(4011) :- p(4010), q(4011).
-q(4011) :- p(4010), q(4010).
-p(4012) :- p(4011), q(4012).
-q(4012) :- p(4011), q(4011).
-p(4013) :- p(4012), q(4013).
-q(4013) :- p(4012), q(4012).
-p(4014) :- p(4013), q(4014).
-q(4014) :- p(4013), q(4013).
-p(4015) :- p(4014), q(4015).
-q(4015) :- p(4014), q(4014).
-p(4016) :- p(4015), q(4016).
-q(4016) :- p(4015), q(4015).
-p(4017) :- p(4016), q(4017).
-q(4017) :- p(4016), q(4016).
-p(4018) :- p(4017), q(4018).
-q(4018) :- p(4017), q(4017).
-p(4019) :- p(4018), q(4019).
-q(4019) :- p(4018), q(4018).
-p(4020) :- p(4019), q(4020).
-q(4020) :- p(4019), q(4019).
-p(4021) :- p(4020), q(4021).
-q(4021) :- p(4020), q(4020).
-p(4022) :- p(4021), q(4022).
-q(4022) :- p(4021), q(4021).
-p(4023) :- p(4022), q(4023).
-q(4023) :- p(4022), q(4022).
-p(4024) :- p(4023), q(4024).
-q(4024) :- p(4023), q(4023).
-p(4025) :- p(4024), q(4025).
-q(4025) :- p(4024), q(4024).
-p(4026) :- p(4025), q(4026).
-q(4026) :- p(4025), q(4025).
-p(4027) :- p(4026), q(4027).
-q(4027) :- p(4026), q(4026).
-p(4028) :- p(4027), q(4028).
-q(4028) :- p(4027), q(4027).
-p(4029) :- p(4028), q(4029).
-q(4029) :- p(4028), q(4028).
-p(4030) :- p(4029), q(4030).
-q(4030) :- p(4029), q(4029).
-p(4031) :- p(4030), q(4031).
-q(4031) :- p(4030), q(4030).
-p(4032) :- p(4031), q(4032).
-q(4032) :- p(4031), q(4031).
-p(4033) :- p(4032), q(4033).
-q(4033) :- p(4032), q(4032).
-p(4034) :- p(4033), q(4034).
-q(4034) :- p(4033), q(4033).
-p(4035) :- p(4034), q(4035).
-q(4035) :- p(4034), q(4034).
-p(4036) :- p(4035), q(4036).
-q(4036) :- p(4035), q(4035).
-p(4037) :- p(4036), q(4037).
-q(4037) :- p(4036), q(4036).
-p(4038) :- p(4037), q(4038).
-q(4038) :- p(4037), q(4037).
-p(4039) :- p(4038), q(4039).
-q(4039) :- p(4038), q(4038).
-p(4040) :- p(4039), q(4040).
-q(4040) :- p(4039), q(4039).
-p(4041) :- p(4040), q(4041).
-q(4041) :- p(4040), q(4040).
-p(4042) :- p(4041), q(4042).
-q(4042) :- p(4041), q(4041).
-p(4043) :- p(4042), q(4043).
-q(4043) :- p(4042), q(4042).
-p(4044) :- p(4043), q(4044).
-q(4044) :- p(4043), q(4043).
-p(4045) :- p(4044), q(4045).
-q(4045) :- p(4044), q(4044).
-p(4046) :- p(4045), q(4046).
-q(4046) :- p(4045), q(4045).
-p(4047) :- p(4046), q(4047).
-q(4047) :- p(4046), q(4046).
-p(4048) :- p(4047), q(4048).
-q(4048) :- p(4047), q(4047).
-p(4049) :- p(4048), q(4049).
-q(4049) :- p(4048), q(4048).
-p(4050) :- p(4049), q(4050).
-q(4050) :- p(4049), q(4049).
-p(4051) :- p(4050), q(4051).
-q(4051) :- p(4050), q(4050).
-p(4052) :- p(4051), q(4052).
-q(4052) :- p(4051), q(4051).
-p(4053) :- p(4052), q(4053).
-q(4053) :- p(4052), q(4052).
-p(4054) :- p(4053), q(4054).
-q(4054) :- p(4053), q(4053).
-p(4055) :- p(4054), q(4055).
-q(4055) :- p(4054), q(4054).
-p(4056) :- p(4055), q(4056).
-q(4056) :- p(4055), q(4055).
-p(4057) :- p(4056), q(4057).
-q(4057) :- p(4056), q(4056).
-p(4058) :- p(4057), q(4058).
-q(4058) :- p(4057), q(4057).
-p(4059) :- p(4058), q(4059).
-q(4059) :- p(4058), q(4058).
-p(4060) :- p(4059), q(4060).
-q(4060) :- p(4059), q(4059).
-p(4061) :- p(4060), q(4061).
-q(4061) :- p(4060), q(4060).
-p(4062) :- p(4061), q(4062).
-q(4062) :- p(4061), q(4061).
-p(4063) :- p(4062), q(4063).
-q(4063) :- p(4062), q(4062).
-p(4064) :- p(4063), q(4064).
-q(4064) :- p(4063), q(4063).
-p(4065) :- p(4064), q(4065).
-q(4065) :- p(4064), q(4064).
-p(4066) :- p(4065), q(4066).
-q(4066) :- p(4065), q(4065).
-p(4067) :- p(4066), q(4067).
-q(4067) :- p(4066), q(4066).
-p(4068) :- p(4067), q(4068).
-q(4068) :- p(4067), q(4067).
-p(4069) :- p(4068), q(4069).
-q(4069) :- p(4068), q(4068).
-p(4070) :- p(4069), q(4070).
-q(4070) :- p(4069), q(4069).
-p(4071) :- p(4070), q(4071).
-q(4071) :- p(4070), q(4070).
-p(4072) :- p(4071), q(4072).
-q(4072) :- p(4071), q(4071).
-p(4073) :- p(4072), q(4073).
-q(4073) :- p(4072), q(4072).
-p(4074) :- p(4073), q(4074).
-q(4074) :- p(4073), q(4073).
-p(4075) :- p(4074), q(4075).
-q(4075) :- p(4074), q(4074).
-p(4076) :- p(4075), q(4076).
-q(4076) :- p(4075), q(4075).
-p(4077) :- p(4076), q(4077).
-q(4077) :- p(4076), q(4076).
-p(4078) :- p(4077), q(4078).
-q(4078) :- p(4077), q(4077).
-p(4079) :- p(4078), q(4079).
-q(4079) :- p(4078), q(4078).
-p(4080) :- p(4079), q(4080).
-q(4080) :- p(4079), q(4079).
-p(4081) :- p(4080), q(4081).
-q(4081) :- p(4080), q(4080).
-p(4082) :- p(4081), q(4082).
-q(4082) :- p(4081), q(4081).
-p(4083) :- p(4082), q(4083).
-q(4083) :- p(4082), q(4082).
-p(4084) :- p(4083), q(4084).
-q(4084) :- p(4083), q(4083).
-p(4085) :- p(4084), q(4085).
-q(4085) :- p(4084), q(4084).
-p(4086) :- p(4085), q(4086).
-q(4086) :- p(4085), q(4085).
-p(4087) :- p(4086), q(4087).
-q(4087) :- p(4086), q(4086).
-p(4088) :- p(4087), q(4088).
-q(4088) :- p(4087), q(4087).
-p(4089) :- p(4088), q(4089).
-q(4089) :- p(4088), q(4088).
-p(4090) :- p(4089), q(4090).
-q(4090) :- p(4089), q(4089).
-p(4091) :- p(4090), q(4091).
-q(4091) :- p(4090), q(4090).
-p(4092) :- p(4091), q(4092).
-q(4092) :- p(4091), q(4091).
-p(4093) :- p(4092), q(4093).
-q(4093) :- p(4092), q(4092).
-p(4094) :- p(4093), q(4094).
-q(4094) :- p(4093), q(4093).
-p(4095) :- p(4094), q(4095).
-q(4095) :- p(4094), q(4094).
-p(4096) :- p(4095), q(4096).
-q(4096) :- p(4095), q(4095).
-p(4097) :- p(4096), q(4097).
-q(4097) :- p(4096), q(4096).
-p(4098) :- p(4097), q(4098).
-q(4098) :- p(4097), q(4097).
-p(4099) :- p(4098), q(4099).
-q(4099) :- p(4098), q(4098).
-p(4100) :- p(4099), q(4100).
-q(4100) :- p(4099), q(4099).
-p(4101) :- p(4100), q(4101).
-q(4101) :- p(4100), q(4100).
-p(4102) :- p(4101), q(4102).
-q(4102) :- p(4101), q(4101).
-p(4103) :- p(4102), q(4103).
-q(4103) :- p(4102), q(4102).
-p(4104) :- p(4103), q(4104).
-q(4104) :- p(4103), q(4103).
-p(4105) :- p(4104), q(4105).
-q(4105) :- p(4104), q(4104).
-p(4106) :- p(4105), q(4106).
-q(4106) :- p(4105), q(4105).
-p(4107) :- p(4106), q(4107).
-q(4107) :- p(4106), q(4106).
-p(4108) :- p(4107), q(4108).
-q(4108) :- p(4107), q(4107).
-p(4109) :- p(4108), q(4109).
-q(4109) :- p(4108), q(4108).
-p(4110) :- p(4109), q(4110).
-q(4110) :- p(4109), q(4109).
-p(4111) :- p(4110), q(4111).
-q(4111) :- p(4110), q(4110).
-p(4112) :- p(4111), q(4112).
-q(4112) :- p(4111), q(4111).
-p(4113) :- p(4112), q(4113).
-q(4113) :- p(4112), q(4112).
-p(4114) :- p(4113), q(4114).
-q(4114) :- p(4113), q(4113).
-p(4115) :- p(4114), q(4115).
-q(4115) :- p(4114), q(4114).
-p(4116) :- p(4115), q(4116).
-q(4116) :- p(4115), q(4115).
-p(4117) :- p(4116), q(4117).
-q(4117) :- p(4116), q(4116).
-p(4118) :- p(4117), q(4118).
-q(4118) :- p(4117), q(4117).
-p(4119) :- p(4118), q(4119).
-q(4119) :- p(4118), q(4118).
-p(4120) :- p(4119), q(4120).
-q(4120) :- p(4119), q(4119).
-p(4121) :- p(4120), q(4121).
-q(4121) :- p(4120), q(4120).
-p(4122) :- p(4121), q(4122).
-q(4122) :- p(4121), q(4121).
-p(4123) :- p(4122), q(4123).
-q(4123) :- p(4122), q(4122).
-p(4124) :- p(4123), q(4124).
-q(4124) :- p(4123), q(4123).
-p(4125) :- p(4124), q(4125).
-q(4125) :- p(4124), q(4124).
-p(4126) :- p(4125), q(4126).
-q(4126) :- p(4125), q(4125).
-p(4127) :- p(4126), q(4127).
-q(4127) :- p(4126), q(4126).
-p(4128) :- p(4127), q(4128).
-q(4128) :- p(4127), q(4127).
-p(4129) :- p(4128), q(4129).
-q(4129) :- p(4128), q(4128).
-p(4130) :- p(4129), q(4130).
-q(4130) :- p(4129), q(4129).
-p(4131) :- p(4130), q(4131).
-q(4131) :- p(4130), q(4130).
-p(4132) :- p(4131), q(4132).
-q(4132) :- p(4131), q(4131).
-p(4133) :- p(4132), q(4133).
-q(4133) :- p(4132), q(4132).
-p(4134) :- p(4133), q(4134).
-q(4134) :- p(4133), q(4133).
-p(4135) :- p(4134), q(4135).
-q(4135) :- p(4134), q(4134).
-p(4136) :- p(4135), q(4136).
-q(4136) :- p(4135), q(4135).
-p(4137) :- p(4136), q(4137).
-q(4137) :- p(4136), q(4136).
-p(4138) :- p(4137), q(4138).
-q(4138) :- p(4137), q(4137).
-p(4139) :- p(4138), q(4139).
-q(4139) :- p(4138), q(4138).
-p(4140) :- p(4139), q(4140).
-q(4140) :- p(4139), q(4139).
-p(4141) :- p(4140), q(4141).
-q(4141) :- p(4140), q(4140).
-p(4142) :- p(4141), q(4142).
-q(4142) :- p(4141), q(4141).
-p(4143) :- p(4142), q(4143).
-q(4143) :- p(4142), q(4142).
-p(4144) :- p(4143), q(4144).
-q(4144) :- p(4143), q(4143).
-p(4145) :- p(4144), q(4145).
-q(4145) :- p(4144), q(4144).
-p(4146) :- p(4145), q(4146).
-q(4146) :- p(4145), q(4145).
-p(4147) :- p(4146), q(4147).
-q(4147) :- p(4146), q(4146).
-p(4148) :- p(4147), q(4148).
-q(4148) :- p(4147), q(4147).
-p(4149) :- p(4148), q(4149).
-q(4149) :- p(4148), q(4148).
-p(4150) :- p(4149), q(4150).
-q(4150) :- p(4149), q(4149).
-p(4151) :- p(4150), q(4151).
-q(4151) :- p(4150), q(4150).
-p(4152) :- p(4151), q(4152).
-q(4152) :- p(4151), q(4151).
-p(4153) :- p(4152), q(4153).
-q(4153) :- p(4152), q(4152).
-p(4154) :- p(4153), q(4154).
-q(4154) :- p(4153), q(4153).
-p(4155) :- p(4154), q(4155).
-q(4155) :- p(4154), q(4154).
-p(4156) :- p(4155), q(4156).
-q(4156) :- p(4155), q(4155).
-p(4157) :- p(4156), q(4157).
-q(4157) :- p(4156), q(4156).
-p(4158) :- p(4157), q(4158).
-q(4158) :- p(4157), q(4157).
-p(4159) :- p(4158), q(4159).
-q(4159) :- p(4158), q(4158).
-p(4160) :- p(4159), q(4160).
-q(4160) :- p(4159), q(4159).
-p(4161) :- p(4160), q(4161).
-q(4161) :- p(4160), q(4160).
-p(4162) :- p(4161), q(4162).
-q(4162) :- p(4161), q(4161).
-p(4163) :- p(4162), q(4163).
-q(4163) :- p(4162), q(4162).
-p(4164) :- p(4163), q(4164).
-q(4164) :- p(4163), q(4163).
-p(4165) :- p(4164), q(4165).
-q(4165) :- p(4164), q(4164).
-p(4166) :- p(4165), q(4166).
-q(4166) :- p(4165), q(4165).
-p(4167) :- p(4166), q(4167).
-q(4167) :- p(4166), q(4166).
-p(4168) :- p(4167), q(4168).
-q(4168) :- p(4167), q(4167).
-p(4169) :- p(4168), q(4169).
-q(4169) :- p(4168), q(4168).
-p(4170) :- p(4169), q(4170).
-q(4170) :- p(4169), q(4169).
-p(4171) :- p(4170), q(4171).
-q(4171) :- p(4170), q(4170).
-p(4172) :- p(4171), q(4172).
-q(4172) :- p(4171), q(4171).
-p(4173) :- p(4172), q(4173).
-q(4173) :- p(4172), q(4172).
-p(4174) :- p(4173), q(4174).
-q(4174) :- p(4173), q(4173).
-p(4175) :- p(4174), q(4175).
-q(4175) :- p(4174), q(4174).
-p(4176) :- p(4175), q(4176).
-q(4176) :- p(4175), q(4175).
-p(4177) :- p(4176), q(4177).
-q(4177) :- p(4176), q(4176).
-p(4178) :- p(4177), q(4178).
-q(4178) :- p(4177), q(4177).
-p(4179) :- p(4178), q(4179).
-q(4179) :- p(4178), q(4178).
-p(4180) :- p(4179), q(4180).
-q(4180) :- p(4179), q(4179).
-p(4181) :- p(4180), q(4181).
-q(4181) :- p(4180), q(4180).
-p(4182) :- p(4181), q(4182).
-q(4182) :- p(4181), q(4181).
-p(4183) :- p(4182), q(4183).
-q(4183) :- p(4182), q(4182).
-p(4184) :- p(4183), q(4184).
-q(4184) :- p(4183), q(4183).
-p(4185) :- p(4184), q(4185).
-q(4185) :- p(4184), q(4184).
-p(4186) :- p(4185), q(4186).
-q(4186) :- p(4185), q(4185).
-p(4187) :- p(4186), q(4187).
-q(4187) :- p(4186), q(4186).
-p(4188) :- p(4187), q(4188).
-q(4188) :- p(4187), q(4187).
-p(4189) :- p(4188), q(4189).
-q(4189) :- p(4188), q(4188).
-p(4190) :- p(4189), q(4190).
-q(4190) :- p(4189), q(4189).
-p(4191) :- p(4190), q(4191).
-q(4191) :- p(4190), q(4190).
-p(4192) :- p(4191), q(4192).
-q(4192) :- p(4191), q(4191).
-p(4193) :- p(4192), q(4193).
-q(4193) :- p(4192), q(4192).
-p(4194) :- p(4193), q(4194).
-q(4194) :- p(4193), q(4193).
-p(4195) :- p(4194), q(4195).
-q(4195) :- p(4194), q(4194).
-p(4196) :- p(4195), q(4196).
-q(4196) :- p(4195), q(4195).
-p(4197) :- p(4196), q(4197).
-q(4197) :- p(4196), q(4196).
-p(4198) :- p(4197), q(4198).
-q(4198) :- p(4197), q(4197).
-p(4199) :- p(4198), q(4199).
-q(4199) :- p(4198), q(4198).
-p(4200) :- p(4199), q(4200).
-q(4200) :- p(4199), q(4199).
-p(4201) :- p(4200), q(4201).
-q(4201) :- p(4200), q(4200).
-p(4202) :- p(4201), q(4202).
-q(4202) :- p(4201), q(4201).
-p(4203) :- p(4202), q(4203).
-q(4203) :- p(4202), q(4202).
-p(4204) :- p(4203), q(4204).
-q(4204) :- p(4203), q(4203).
-p(4205) :- p(4204), q(4205).
-q(4205) :- p(4204), q(4204).
-p(4206) :- p(4205), q(4206).
-q(4206) :- p(4205), q(4205).
-p(4207) :- p(4206), q(4207).
-q(4207) :- p(4206), q(4206).
-p(4208) :- p(4207), q(4208).
-q(4208) :- p(4207), q(4207).
-p(4209) :- p(4208), q(4209).
-q(4209) :- p(4208), q(4208).
-p(4210) :- p(4209), q(4210).
-q(4210) :- p(4209), q(4209).
-p(4211) :- p(4210), q(4211).
-q(4211) :- p(4210), q(4210).
-p(4212) :- p(4211), q(4212).
-q(4212) :- p(4211), q(4211).
-p(4213) :- p(4212), q(4213).
-q(4213) :- p(4212), q(4212).
-p(4214) :- p(4213), q(4214).
-q(4214) :- p(4213), q(4213).
-p(4215) :- p(4214), q(4215).
-q(4215) :- p(4214), q(4214).
-p(4216) :- p(4215), q(4216).
-q(4216) :- p(4215), q(4215).
-p(4217) :- p(4216), q(4217).
-q(4217) :- p(4216), q(4216).
-p(4218) :- p(4217), q(4218).
-q(4218) :- p(4217), q(4217).
-p(4219) :- p(4218), q(4219).
-q(4219) :- p(4218), q(4218).
-p(4220) :- p(4219), q(4220).
-q(4220) :- p(4219), q(4219).
-p(4221) :- p(4220), q(4221).
-q(4221) :- p(4220), q(4220).
-p(4222) :- p(4221), q(4222).
-q(4222) :- p(4221), q(4221).
-p(4223) :- p(4222), q(4223).
-q(4223) :- p(4222), q(4222).
-p(4224) :- p(4223), q(4224).
-q(4224) :- p(4223), q(4223).
-p(4225) :- p(4224), q(4225).
-q(4225) :- p(4224), q(4224).
-p(4226) :- p(4225), q(4226).
-q(4226) :- p(4225), q(4225).
-p(4227) :- p(4226), q(4227).
-q(4227) :- p(4226), q(4226).
-p(4228) :- p(4227), q(4228).
-q(4228) :- p(4227), q(4227).
-p(4229) :- p(4228), q(4229).
-q(4229) :- p(4228), q(4228).
-p(4230) :- p(4229), q(4230).
-q(4230) :- p(4229), q(4229).
-p(4231) :- p(4230), q(4231).
-q(4231) :- p(4230), q(4230).
-p(4232) :- p(4231), q(4232).
-q(4232) :- p(4231), q(4231).
-p(4233) :- p(4232), q(4233).
-q(4233) :- p(4232), q(4232).
-p(4234) :- p(4233), q(4234).
-q(4234) :- p(4233), q(4233).
-p(4235) :- p(4234), q(4235).
-q(4235) :- p(4234), q(4234).
-p(4236) :- p(4235), q(4236).
-q(4236) :- p(4235), q(4235).
-p(4237) :- p(4236), q(4237).
-q(4237) :- p(4236), q(4236).
-p(4238) :- p(4237), q(4238).
-q(4238) :- p(4237), q(4237).
-p(4239) :- p(4238), q(4239).
-q(4239) :- p(4238), q(4238).
-p(4240) :- p(4239), q(4240).
-q(4240) :- p(4239), q(4239).
-p(4241) :- p(4240), q(4241).
-q(4241) :- p(4240), q(4240).
-p(4242) :- p(4241), q(4242).
-q(4242) :- p(4241), q(4241).
-p(4243) :- p(4242), q(4243).
-q(4243) :- p(4242), q(4242).
-p(4244) :- p(4243), q(4244).
-q(4244) :- p(4243), q(4243).
-p(4245) :- p(4244), q(4245).
-q(4245) :- p(4244), q(4244).
-p(4246) :- p(4245), q(4246).
-q(4246) :- p(4245), q(4245).
-p(4247) :- p(4246), q(4247).
-q(4247) :- p(4246), q(4246).
-p(4248) :- p(4247), q(4248).
-q(4248) :- p(4247), q(4247).
-p(4249) :- p(4248), q(4249).
-q(4249) :- p(4248), q(4248).
-p(4250) :- p(4249), q(4250).
-q(4250) :- p(4249), q(4249).
-p(4251) :- p(4250), q(4251).
-q(4251) :- p(4250), q(4250).
-p(4252) :- p(4251), q(4252).
-q(4252) :- p(4251), q(4251).
-p(4253) :- p(4252), q(4253).
-q(4253) :- p(4252), q(4252).
-p(4254) :- p(4253), q(4254).
-q(4254) :- p(4253), q(4253).
-p(4255) :- p(4254), q(4255).
-q(4255) :- p(4254), q(4254).
-p(4256) :- p(4255), q(4256).
-q(4256) :- p(4255), q(4255).
-p(4257) :- p(4256), q(4257).
-q(4257) :- p(4256), q(4256).
-p(4258) :- p(4257), q(4258).
-q(4258) :- p(4257), q(4257).
-p(4259) :- p(4258), q(4259).
-q(4259) :- p(4258), q(4258).
-p(4260) :- p(4259), q(4260).
-q(4260) :- p(4259), q(4259).
-p(4261) :- p(4260), q(4261).
-q(4261) :- p(4260), q(4260).
-p(4262) :- p(4261), q(4262).
-q(4262) :- p(4261), q(4261).
-p(4263) :- p(4262), q(4263).
-q(4263) :- p(4262), q(4262).
-p(4264) :- p(4263), q(4264).
-q(4264) :- p(4263), q(4263).
-p(4265) :- p(4264), q(4265).
-q(4265) :- p(4264), q(4264).
-p(4266) :- p(4265), q(4266).
-q(4266) :- p(4265), q(4265).
-p(4267) :- p(4266), q(4267).
-q(4267) :- p(4266), q(4266).
-p(4268) :- p(4267), q(4268).
-q(4268) :- p(4267), q(4267).
-p(4269) :- p(4268), q(4269).
-q(4269) :- p(4268), q(4268).
-p(4270) :- p(4269), q(4270).
-q(4270) :- p(4269), q(4269).
-p(4271) :- p(4270), q(4271).
-q(4271) :- p(4270), q(4270).
-p(4272) :- p(4271), q(4272).
-q(4272) :- p(4271), q(4271).
-p(4273) :- p(4272), q(4273).
-q(4273) :- p(4272), q(4272).
-p(4274) :- p(4273), q(4274).
-q(4274) :- p(4273), q(4273).
-p(4275) :- p(4274), q(4275).
-q(4275) :- p(4274), q(4274).
-p(4276) :- p(4275), q(4276).
-q(4276) :- p(4275), q(4275).
-p(4277) :- p(4276), q(4277).
-q(4277) :- p(4276), q(4276).
-p(4278) :- p(4277), q(4278).
-q(4278) :- p(4277), q(4277).
-p(4279) :- p(4278), q(4279).
-q(4279) :- p(4278), q(4278).
-p(4280) :- p(4279), q(4280).
-q(4280) :- p(4279), q(4279).
-p(4281) :- p(4280), q(4281).
-q(4281) :- p(4280), q(4280).
-p(4282) :- p(4281), q(4282).
-q(4282) :- p(4281), q(4281).
-p(4283) :- p(4282), q(4283).
-q(4283) :- p(4282), q(4282).
-p(4284) :- p(4283), q(4284).
-q(4284) :- p(4283), q(4283).
-p(4285) :- p(4284), q(4285).
-q(4285) :- p(4284), q(4284).
-p(4286) :- p(4285), q(4286).
-q(4286) :- p(4285), q(4285).
-p(4287) :- p(4286), q(4287).
-q(4287) :- p(4286), q(4286).
-p(4288) :- p(4287), q(4288).
-q(4288) :- p(4287), q(4287).
-p(4289) :- p(4288), q(4289).
-q(4289) :- p(4288), q(4288).
-p(4290) :- p(4289), q(4290).
-q(4290) :- p(4289), q(4289).
-p(4291) :- p(4290), q(4291).
-q(4291) :- p(4290), q(4290).
-p(4292) :- p(4291), q(4292).
-q(4292) :- p(4291), q(4291).
-p(4293) :- p(4292), q(4293).
-q(4293) :- p(4292), q(4292).
-p(4294) :- p(4293), q(4294).
-q(4294) :- p(4293), q(4293).
-p(4295) :- p(4294), q(4295).
-q(4295) :- p(4294), q(4294).
-p(4296) :- p(4295), q(4296).
-q(4296) :- p(4295), q(4295).
-p(4297) :- p(4296), q(4297).
-q(4297) :- p(4296), q(4296).
-p(4298) :- p(4297), q(4298).
-q(4298) :- p(4297), q(4297).
-p(4299) :- p(4298), q(4299).
-q(4299) :- p(4298), q(4298).
-p(4300) :- p(4299), q(4300).
-q(4300) :- p(4299), q(4299).
-p(4301) :- p(4300), q(4301).
-q(4301) :- p(4300), q(4300).
-p(4302) :- p(4301), q(4302).
-q(4302) :- p(4301), q(4301).
-p(4303) :- p(4302), q(4303).
-q(4303) :- p(4302), q(4302).
-p(4304) :- p(4303), q(4304).
-q(4304) :- p(4303), q(4303).
-p(4305) :- p(4304), q(4305).
-q(4305) :- p(4304), q(4304).
-p(4306) :- p(4305), q(4306).
-q(4306) :- p(4305), q(4305).
-p(4307) :- p(4306), q(4307).
-q(4307) :- p(4306), q(4306).
-p(4308) :- p(4307), q(4308).
-q(4308) :- p(4307), q(4307).
-p(4309) :- p(4308), q(4309).
-q(4309) :- p(4308), q(4308).
-p(4310) :- p(4309), q(4310).
-q(4310) :- p(4309), q(4309).
-p(4311) :- p(4310), q(4311).
-q(4311) :- p(4310), q(4310).
-p(4312) :- p(4311), q(4312).
-q(4312) :- p(4311), q(4311).
-p(4313) :- p(4312), q(4313).
-q(4313) :- p(4312), q(4312).
-p(4314) :- p(4313), q(4314).
-q(4314) :- p(4313), q(4313).
-p(4315) :- p(4314), q(4315).
-q(4315) :- p(4314), q(4314).
-p(4316) :- p(4315), q(4316).
-q(4316) :- p(4315), q(4315).
-p(4317) :- p(4316), q(4317).
-q(4317) :- p(4316), q(4316).
-p(4318) :- p(4317), q(4318).
-q(4318) :- p(4317), q(4317).
-p(4319) :- p(4318), q(4319).
-q(4319) :- p(4318), q(4318).
-p(4320) :- p(4319), q(4320).
-q(4320) :- p(4319), q(4319).
-p(4321) :- p(4320), q(4321).
-q(4321) :- p(4320), q(4320).
-p(4322) :- p(4321), q(4322).
-q(4322) :- p(4321), q(4321).
-p(4323) :- p(4322), q(4323).
-q(4323) :- p(4322), q(4322).
-p(4324) :- p(4323), q(4324).
-q(4324) :- p(4323), q(4323).
-p(4325) :- p(4324), q(4325).
-q(4325) :- p(4324), q(4324).
-p(4326) :- p(4325), q(4326).
-q(4326) :- p(4325), q(4325).
-p(4327) :- p(4326), q(4327).
-q(4327) :- p(4326), q(4326).
-p(4328) :- p(4327), q(4328).
-q(4328) :- p(4327), q(4327).
-p(4329) :- p(4328), q(4329).
-q(4329) :- p(4328), q(4328).
-p(4330) :- p(4329), q(4330).
-q(4330) :- p(4329), q(4329).
-p(4331) :- p(4330), q(4331).
-q(4331) :- p(4330), q(4330).
-p(4332) :- p(4331), q(4332).
-q(4332) :- p(4331), q(4331).
-p(4333) :- p(4332), q(4333).
-q(4333) :- p(4332), q(4332).
-p(4334) :- p(4333), q(4334).
-q(4334) :- p(4333), q(4333).
-p(4335) :- p(4334), q(4335).
-q(4335) :- p(4334), q(4334).
-p(4336) :- p(4335), q(4336).
-q(4336) :- p(4335), q(4335).
-p(4337) :- p(4336), q(4337).
-q(4337) :- p(4336), q(4336).
-p(4338) :- p(4337), q(4338).
-q(4338) :- p(4337), q(4337).
-p(4339) :- p(4338), q(4339).
-q(4339) :- p(4338), q(4338).
-p(4340) :- p(4339), q(4340).
-q(4340) :- p(4339), q(4339).
-p(4341) :- p(4340), q(4341).
-q(4341) :- p(4340), q(4340).
-p(4342) :- p(4341), q(4342).
-q(4342) :- p(4341), q(4341).
-p(4343) :- p(4342), q(4343).
-q(4343) :- p(4342), q(4342).
-p(4344) :- p(4343), q(4344).
-q(4344) :- p(4343), q(4343).
-p(4345) :- p(4344), q(4345).
-q(4345) :- p(4344), q(4344).
-p(4346) :- p(4345), q(4346).
-q(4346) :- p(4345), q(4345).
-p(4347) :- p(4346), q(4347).
-q(4347) :- p(4346), q(4346).
-p(4348) :- p(4347), q(4348).
-q(4348) :- p(4347), q(4347).
-p(4349) :- p(4348), q(4349).
-q(4349) :- p(4348), q(4348).
-p(4350) :- p(4349), q(4350).
-q(4350) :- p(4349), q(4349).
-p(4351) :- p(4350), q(4351).
-q(4351) :- p(4350), q(4350).
-p(4352) :- p(4351), q(4352).
-q(4352) :- p(4351), q(4351).
-p(4353) :- p(4352), q(4353).
-q(4353) :- p(4352), q(4352).
-p(4354) :- p(4353), q(4354).
-q(4354) :- p(4353), q(4353).
-p(4355) :- p(4354), q(4355).
-q(4355) :- p(4354), q(4354).
-p(4356) :- p(4355), q(4356).
-q(4356) :- p(4355), q(4355).
-p(4357) :- p(4356), q(4357).
-q(4357) :- p(4356), q(4356).
-p(4358) :- p(4357), q(4358).
-q(4358) :- p(4357), q(4357).
-p(4359) :- p(4358), q(4359).
-q(4359) :- p(4358), q(4358).
-p(4360) :- p(4359), q(4360).
-q(4360) :- p(4359), q(4359).
-p(4361) :- p(4360), q(4361).
-q(4361) :- p(4360), q(4360).
-p(4362) :- p(4361), q(4362).
-q(4362) :- p(4361), q(4361).
-p(4363) :- p(4362), q(4363).
-q(4363) :- p(4362), q(4362).
-p(4364) :- p(4363), q(4364).
-q(4364) :- p(4363), q(4363).
-p(4365) :- p(4364), q(4365).
-q(4365) :- p(4364), q(4364).
-p(4366) :- p(4365), q(4366).
-q(4366) :- p(4365), q(4365).
-p(4367) :- p(4366), q(4367).
-q(4367) :- p(4366), q(4366).
-p(4368) :- p(4367), q(4368).
-q(4368) :- p(4367), q(4367).
-p(4369) :- p(4368), q(4369).
-q(4369) :- p(4368), q(4368).
-p(4370) :- p(4369), q(4370).
-q(4370) :- p(4369), q(4369).
-p(4371) :- p(4370), q(4371).
-q(4371) :- p(4370), q(4370).
-p(4372) :- p(4371), q(4372).
-q(4372) :- p(4371), q(4371).
-p(4373) :- p(4372), q(4373).
-q(4373) :- p(4372), q(4372).
-p(4374) :- p(4373), q(4374).
-q(4374) :- p(4373), q(4373).
-p(4375) :- p(4374), q(4375).
-q(4375) :- p(4374), q(4374).
-p(4376) :- p(4375), q(4376).
-q(4376) :- p(4375), q(4375).
-p(4377) :- p(4376), q(4377).
-q(4377) :- p(4376), q(4376).
-p(4378) :- p(4377), q(4378).
-q(4378) :- p(4377), q(4377).
-p(4379) :- p(4378), q(4379).
-q(4379) :- p(4378), q(4378).
-p(4380) :- p(4379), q(4380).
-q(4380) :- p(4379), q(4379).
-p(4381) :- p(4380), q(4381).
-q(4381) :- p(4380), q(4380).
-p(4382) :- p(4381), q(4382).
-q(4382) :- p(4381), q(4381).
-p(4383) :- p(4382), q(4383).
-q(4383) :- p(4382), q(4382).
-p(4384) :- p(4383), q(4384).
-q(4384) :- p(4383), q(4383).
-p(4385) :- p(4384), q(4385).
-q(4385) :- p(4384), q(4384).
-p(4386) :- p(4385), q(4386).
-q(4386) :- p(4385), q(4385).
-p(4387) :- p(4386), q(4387).
-q(4387) :- p(4386), q(4386).
-p(4388) :- p(4387), q(4388).
-q(4388) :- p(4387), q(4387).
-p(4389) :- p(4388), q(4389).
-q(4389) :- p(4388), q(4388).
-p(4390) :- p(4389), q(4390).
-q(4390) :- p(4389), q(4389).
-p(4391) :- p(4390), q(4391).
-q(4391) :- p(4390), q(4390).
-p(4392) :- p(4391), q(4392).
-q(4392) :- p(4391), q(4391).
-p(4393) :- p(4392), q(4393).
-q(4393) :- p(4392), q(4392).
-p(4394) :- p(4393), q(4394).
-q(4394) :- p(4393), q(4393).
-p(4395) :- p(4394), q(4395).
-q(4395) :- p(4394), q(4394).
-p(4396) :- p(4395), q(4396).
-q(4396) :- p(4395), q(4395).
-p(4397) :- p(4396), q(4397).
-q(4397) :- p(4396), q(4396).
-p(4398) :- p(4397), q(4398).
-q(4398) :- p(4397), q(4397).
-p(4399) :- p(4398), q(4399).
-q(4399) :- p(4398), q(4398).
-p(4400) :- p(4399), q(4400).
-q(4400) :- p(4399), q(4399).
-p(4401) :- p(4400), q(4401).
-q(4401) :- p(4400), q(4400).
-p(4402) :- p(4401), q(4402).
-q(4402) :- p(4401), q(4401).
-p(4403) :- p(4402), q(4403).
-q(4403) :- p(4402), q(4402).
-p(4404) :- p(4403), q(4404).
-q(4404) :- p(4403), q(4403).
-p(4405) :- p(4404), q(4405).
-q(4405) :- p(4404), q(4404).
-p(4406) :- p(4405), q(4406).
-q(4406) :- p(4405), q(4405).
-p(4407) :- p(4406), q(4407).
-q(4407) :- p(4406), q(4406).
-p(4408) :- p(4407), q(4408).
-q(4408) :- p(4407), q(4407).
-p(4409) :- p(4408), q(4409).
-q(4409) :- p(4408), q(4408).
-p(4410) :- p(4409), q(4410).
-q(4410) :- p(4409), q(4409).
-p(4411) :- p(4410), q(4411).
-q(4411) :- p(4410), q(4410).
-p(4412) :- p(4411), q(4412).
-q(4412) :- p(4411), q(4411).
-p(4413) :- p(4412), q(4413).
-q(4413) :- p(4412), q(4412).
-p(4414) :- p(4413), q(4414).
-q(4414) :- p(4413), q(4413).
-p(4415) :- p(4414), q(4415).
-q(4415) :- p(4414), q(4414).
-p(4416) :- p(4415), q(4416).
-q(4416) :- p(4415), q(4415).
-p(4417) :- p(4416), q(4417).
-q(4417) :- p(4416), q(4416).
-p(4418) :- p(4417), q(4418).
-q(4418) :- p(4417), q(4417).
-p(4419) :- p(4418), q(4419).
-q(4419) :- p(4418), q(4418).
-p(4420) :- p(4419), q(4420).
-q(4420) :- p(4419), q(4419).
-p(4421) :- p(4420), q(4421).
-q(4421) :- p(4420), q(4420).
-p(4422) :- p(4421), q(4422).
-q(4422) :- p(4421), q(4421).
-p(4423) :- p(4422), q(4423).
-q(4423) :- p(4422), q(4422).
-p(4424) :- p(4423), q(4424).
-q(4424) :- p(4423), q(4423).
-p(4425) :- p(4424), q(4425).
-q(4425) :- p(4424), q(4424).
-p(4426) :- p(4425), q(4426).
-q(4426) :- p(4425), q(4425).
-p(4427) :- p(4426), q(4427).
-q(4427) :- p(4426), q(4426).
-p(4428) :- p(4427), q(4428).
-q(4428) :- p(4427), q(4427).
-p(4429) :- p(4428), q(4429).
-q(4429) :- p(4428), q(4428).
-p(4430) :- p(4429), q(4430).
-q(4430) :- p(4429), q(4429).
-p(4431) :- p(4430), q(4431).
-q(4431) :- p(4430), q(4430).
-p(4432) :- p(4431), q(4432).
-q(4432) :- p(4431), q(4431).
-p(4433) :- p(4432), q(4433).
-q(4433) :- p(4432), q(4432).
-p(4434) :- p(4433), q(4434).
-q(4434) :- p(4433), q(4433).
-p(4435) :- p(4434), q(4435).
-q(4435) :- p(4434), q(4434).
-p(4436) :- p(4435), q(4436).
-q(4436) :- p(4435), q(4435).
-p(4437) :- p(4436), q(4437).
-q(4437) :- p(4436), q(4436).
-p(4438) :- p(4437), q(4438).
-q(4438) :- p(4437), q(4437).
-p(4439) :- p(4438), q(4439).
-q(4439) :- p(4438), q(4438).
-p(4440) :- p(4439), q(4440).
-q(4440) :- p(4439), q(4439).
-p(4441) :- p(4440), q(4441).
-q(4441) :- p(4440), q(4440).
-p(4442) :- p(4441), q(4442).
-q(4442) :- p(4441), q(4441).
-p(4443) :- p(4442), q(4443).
-q(4443) :- p(4442), q(4442).
-p(4444) :- p(4443), q(4444).
-q(4444) :- p(4443), q(4443).
-p(4445) :- p(4444), q(4445).
-q(4445) :- p(4444), q(4444).
-p(4446) :- p(4445), q(4446).
-q(4446) :- p(4445), q(4445).
-p(4447) :- p(4446), q(4447).
-q(4447) :- p(4446), q(4446).
-p(4448) :- p(4447), q(4448).
-q(4448) :- p(4447), q(4447).
-p(4449) :- p(4448), q(4449).
-q(4449) :- p(4448), q(4448).
-p(4450) :- p(4449), q(4450).
-q(4450) :- p(4449), q(4449).
-p(4451) :- p(4450), q(4451).
-q(4451) :- p(4450), q(4450).
-p(4452) :- p(4451), q(4452).
-q(4452) :- p(4451), q(4451).
-p(4453) :- p(4452), q(4453).
-q(4453) :- p(4452), q(4452).
-p(4454) :- p(4453), q(4454).
-q(4454) :- p(4453), q(4453).
-p(4455) :- p(4454), q(4455).
-q(4455) :- p(4454), q(4454).
-p(4456) :- p(4455), q(4456).
-q(4456) :- p(4455), q(4455).
-p(4457) :- p(4456), q(4457).
-q(4457) :- p(4456), q(4456).
-p(4458) :- p(4457), q(4458).
-q(4458) :- p(4457), q(4457).
-p(4459) :- p(4458), q(4459).
-q(4459) :- p(4458), q(4458).
-p(4460) :- p(4459), q(4460).
-q(4460) :- p(4459), q(4459).
-p(4461) :- p(4460), q(4461).
-q(4461) :- p(4460), q(4460).
-p(4462) :- p(4461), q(4462).
-q(4462) :- p(4461), q(4461).
-p(4463) :- p(4462), q(4463).
-q(4463) :- p(4462), q(4462).
-p(4464) :- p(4463), q(4464).
-q(4464) :- p(4463), q(4463).
-p(4465) :- p(4464), q(4465).
-q(4465) :- p(4464), q(4464).
-p(4466) :- p(4465), q(4466).
-q(4466) :- p(4465), q(4465).
-p(4467) :- p(4466), q(4467).
-q(4467) :- p(4466), q(4466).
-p(4468) :- p(4467), q(4468).
-q(4468) :- p(4467), q(4467).
-p(4469) :- p(4468), q(4469).
-q(4469) :- p(4468), q(4468).
-p(4470) :- p(4469), q(4470).
-q(4470) :- p(4469), q(4469).
-p(4471) :- p(4470), q(4471).
-q(4471) :- p(4470), q(4470).
-p(4472) :- p(4471), q(4472).
-q(4472) :- p(4471), q(4471).
-p(4473) :- p(4472), q(4473).
-q(4473) :- p(4472), q(4472).
-p(4474) :- p(4473), q(4474).
-q(4474) :- p(4473), q(4473).
-p(4475) :- p(4474), q(4475).
-q(4475) :- p(4474), q(4474).
-p(4476) :- p(4475), q(4476).
-q(4476) :- p(4475), q(4475).
-p(4477) :- p(4476), q(4477).
-q(4477) :- p(4476), q(4476).
-p(4478) :- p(4477), q(4478).
-q(4478) :- p(4477), q(4477).
-p(4479) :- p(4478), q(4479).
-q(4479) :- p(4478), q(4478).
-p(4480) :- p(4479), q(4480).
-q(4480) :- p(4479), q(4479).
-p(4481) :- p(4480), q(4481).
-q(4481) :- p(4480), q(4480).
-p(4482) :- p(4481), q(4482).
-q(4482) :- p(4481), q(4481).
-p(4483) :- p(4482), q(4483).
-q(4483) :- p(4482), q(4482).
-p(4484) :- p(4483), q(4484).
-q(4484) :- p(4483), q(4483).
-p(4485) :- p(4484), q(4485).
-q(4485) :- p(4484), q(4484).
-p(4486) :- p(4485), q(4486).
-q(4486) :- p(4485), q(4485).
-p(4487) :- p(4486), q(4487).
-q(4487) :- p(4486), q(4486).
-p(4488) :- p(4487), q(4488).
-q(4488) :- p(4487), q(4487).
-p(4489) :- p(4488), q(4489).
-q(4489) :- p(4488), q(4488).
-p(4490) :- p(4489), q(4490).
-q(4490) :- p(4489), q(4489).
-p(4491) :- p(4490), q(4491).
-q(4491) :- p(4490), q(4490).
-p(4492) :- p(4491), q(4492).
-q(4492) :- p(4491), q(4491).
-p(4493) :- p(4492), q(4493).
-q(4493) :- p(4492), q(4492).
-p(4494) :- p(4493), q(4494).
-q(4494) :- p(4493), q(4493).
-p(4495) :- p(4494), q(4495).
-q(4495) :- p(4494), q(4494).
-p(4496) :- p(4495), q(4496).
-q(4496) :- p(4495), q(4495).
-p(4497) :- p(4496), q(4497).
-q(4497) :- p(4496), q(4496).
-p(4498) :- p(4497), q(4498).
-q(4498) :- p(4497), q(4497).
-p(4499) :- p(4498), q(4499).
-q(4499) :- p(4498), q(4498).
-p(4500) :- p(4499), q(4500).
-q(4500) :- p(4499), q(4499).
-p(4501) :- p(4500), q(4501).
-q(4501) :- p(4500), q(4500).
-p(4502) :- p(4501), q(4502).
-q(4502) :- p(4501), q(4501).
-p(4503) :- p(4502), q(4503).
-q(4503) :- p(4502), q(4502).
-p(4504) :- p(4503), q(4504).
-q(4504) :- p(4503), q(4503).
-p(4505) :- p(4504), q(4505).
-q(4505) :- p(4504), q(4504).
-p(4506) :- p(4505), q(4506).
-q(4506) :- p(4505), q(4505).
-p(4507) :- p(4506), q(4507).
-q(4507) :- p(4506), q(4506).
-p(4508) :- p(4507), q(4508).
-q(4508) :- p(4507), q(4507).
-p(4509) :- p(4508), q(4509).
-q(4509) :- p(4508), q(4508).
-p(4510) :- p(4509), q(4510).
-q(4510) :- p(4509), q(4509).
-p(4511) :- p(4510), q(4511).
-q(4511) :- p(4510), q(4510).
-p(4512) :- p(4511), q(4512).
-q(4512) :- p(4511), q(4511).
-p(4513) :- p(4512), q(4513).
-q(4513) :- p(4512), q(4512).
-p(4514) :- p(4513), q(4514).
-q(4514) :- p(4513), q(4513).
-p(4515) :- p(4514), q(4515).
-q(4515) :- p(4514), q(4514).
-p(4516) :- p(4515), q(4516).
-q(4516) :- p(4515), q(4515).
-p(4517) :- p(4516), q(4517).
-q(4517) :- p(4516), q(4516).
-p(4518) :- p(4517), q(4518).
-q(4518) :- p(4517), q(4517).
-p(4519) :- p(4518), q(4519).
-q(4519) :- p(4518), q(4518).
-p(4520) :- p(4519), q(4520).
-q(4520) :- p(4519), q(4519).
-p(4521) :- p(4520), q(4521).
-q(4521) :- p(4520), q(4520).
-p(4522) :- p(4521), q(4522).
-q(4522) :- p(4521), q(4521).
-p(4523) :- p(4522), q(4523).
-q(4523) :- p(4522), q(4522).
-p(4524) :- p(4523), q(4524).
-q(4524) :- p(4523), q(4523).
-p(4525) :- p(4524), q(4525).
-q(4525) :- p(4524), q(4524).
-p(4526) :- p(4525), q(4526).
-q(4526) :- p(4525), q(4525).
-p(4527) :- p(4526), q(4527).
-q(4527) :- p(4526), q(4526).
-p(4528) :- p(4527), q(4528).
-q(4528) :- p(4527), q(4527).
-p(4529) :- p(4528), q(4529).
-q(4529) :- p(4528), q(4528).
-p(4530) :- p(4529), q(4530).
-q(4530) :- p(4529), q(4529).
-p(4531) :- p(4530), q(4531).
-q(4531) :- p(4530), q(4530).
-p(4532) :- p(4531), q(4532).
-q(4532) :- p(4531), q(4531).
-p(4533) :- p(4532), q(4533).
-q(4533) :- p(4532), q(4532).
-p(4534) :- p(4533), q(4534).
-q(4534) :- p(4533), q(4533).
-p(4535) :- p(4534), q(4535).
-q(4535) :- p(4534), q(4534).
-p(4536) :- p(4535), q(4536).
-q(4536) :- p(4535), q(4535).
-p(4537) :- p(4536), q(4537).
-q(4537) :- p(4536), q(4536).
-p(4538) :- p(4537), q(4538).
-q(4538) :- p(4537), q(4537).
-p(4539) :- p(4538), q(4539).
-q(4539) :- p(4538), q(4538).
-p(4540) :- p(4539), q(4540).
-q(4540) :- p(4539), q(4539).
-p(4541) :- p(4540), q(4541).
-q(4541) :- p(4540), q(4540).
-p(4542) :- p(4541), q(4542).
-q(4542) :- p(4541), q(4541).
-p(4543) :- p(4542), q(4543).
-q(4543) :- p(4542), q(4542).
-p(4544) :- p(4543), q(4544).
-q(4544) :- p(4543), q(4543).
-p(4545) :- p(4544), q(4545).
-q(4545) :- p(4544), q(4544).
-p(4546) :- p(4545), q(4546).
-q(4546) :- p(4545), q(4545).
-p(4547) :- p(4546), q(4547).
-q(4547) :- p(4546), q(4546).
-p(4548) :- p(4547), q(4548).
-q(4548) :- p(4547), q(4547).
-p(4549) :- p(4548), q(4549).
-q(4549) :- p(4548), q(4548).
-p(4550) :- p(4549), q(4550).
-q(4550) :- p(4549), q(4549).
-p(4551) :- p(4550), q(4551).
-q(4551) :- p(4550), q(4550).
-p(4552) :- p(4551), q(4552).
-q(4552) :- p(4551), q(4551).
-p(4553) :- p(4552), q(4553).
-q(4553) :- p(4552), q(4552).
-p(4554) :- p(4553), q(4554).
-q(4554) :- p(4553), q(4553).
-p(4555) :- p(4554), q(4555).
-q(4555) :- p(4554), q(4554).
-p(4556) :- p(4555), q(4556).
-q(4556) :- p(4555), q(4555).
-p(4557) :- p(4556), q(4557).
-q(4557) :- p(4556), q(4556).
-p(4558) :- p(4557), q(4558).
-q(4558) :- p(4557), q(4557).
-p(4559) :- p(4558), q(4559).
-q(4559) :- p(4558), q(4558).
-p(4560) :- p(4559), q(4560).
-q(4560) :- p(4559), q(4559).
-p(4561) :- p(4560), q(4561).
-q(4561) :- p(4560), q(4560).
-p(4562) :- p(4561), q(4562).
-q(4562) :- p(4561), q(4561).
-p(4563) :- p(4562), q(4563).
-q(4563) :- p(4562), q(4562).
-p(4564) :- p(4563), q(4564).
-q(4564) :- p(4563), q(4563).
-p(4565) :- p(4564), q(4565).
-q(4565) :- p(4564), q(4564).
-p(4566) :- p(4565), q(4566).
-q(4566) :- p(4565), q(4565).
-p(4567) :- p(4566), q(4567).
-q(4567) :- p(4566), q(4566).
-p(4568) :- p(4567), q(4568).
-q(4568) :- p(4567), q(4567).
-p(4569) :- p(4568), q(4569).
-q(4569) :- p(4568), q(4568).
-p(4570) :- p(4569), q(4570).
-q(4570) :- p(4569), q(4569).
-p(4571) :- p(4570), q(4571).
-q(4571) :- p(4570), q(4570).
-p(4572) :- p(4571), q(4572).
-q(4572) :- p(4571), q(4571).
-p(4573) :- p(4572), q(4573).
-q(4573) :- p(4572), q(4572).
-p(4574) :- p(4573), q(4574).
-q(4574) :- p(4573), q(4573).
-p(4575) :- p(4574), q(4575).
-q(4575) :- p(4574), q(4574).
-p(4576) :- p(4575), q(4576).
-q(4576) :- p(4575), q(4575).
-p(4577) :- p(4576), q(4577).
-q(4577) :- p(4576), q(4576).
-p(4578) :- p(4577), q(4578).
-q(4578) :- p(4577), q(4577).
-p(4579) :- p(4578), q(4579).
-q(4579) :- p(4578), q(4578).
-p(4580) :- p(4579), q(4580).
-q(4580) :- p(4579), q(4579).
-p(4581) :- p(4580), q(4581).
-q(4581) :- p(4580), q(4580).
-p(4582) :- p(4581), q(4582).
-q(4582) :- p(4581), q(4581).
-p(4583) :- p(4582), q(4583).
-q(4583) :- p(4582), q(4582).
-p(4584) :- p(4583), q(4584).
-q(4584) :- p(4583), q(4583).
-p(4585) :- p(4584), q(4585).
-q(4585) :- p(4584), q(4584).
-p(4586) :- p(4585), q(4586).
-q(4586) :- p(4585), q(4585).
-p(4587) :- p(4586), q(4587).
-q(4587) :- p(4586), q(4586).
-p(4588) :- p(4587), q(4588).
-q(4588) :- p(4587), q(4587).
-p(4589) :- p(4588), q(4589).
-q(4589) :- p(4588), q(4588).
-p(4590) :- p(4589), q(4590).
-q(4590) :- p(4589), q(4589).
-p(4591) :- p(4590), q(4591).
-q(4591) :- p(4590), q(4590).
-p(4592) :- p(4591), q(4592).
-q(4592) :- p(4591), q(4591).
-p(4593) :- p(4592), q(4593).
-q(4593) :- p(4592), q(4592).
-p(4594) :- p(4593), q(4594).
-q(4594) :- p(4593), q(4593).
-p(4595) :- p(4594), q(4595).
-q(4595) :- p(4594), q(4594).
-p(4596) :- p(4595), q(4596).
-q(4596) :- p(4595), q(4595).
-p(4597) :- p(4596), q(4597).
-q(4597) :- p(4596), q(4596).
-p(4598) :- p(4597), q(4598).
-q(4598) :- p(4597), q(4597).
-p(4599) :- p(4598), q(4599).
-q(4599) :- p(4598), q(4598).
-p(4600) :- p(4599), q(4600).
-q(4600) :- p(4599), q(4599).
-p(4601) :- p(4600), q(4601).
-q(4601) :- p(4600), q(4600).
-p(4602) :- p(4601), q(4602).
-q(4602) :- p(4601), q(4601).
-p(4603) :- p(4602), q(4603).
-q(4603) :- p(4602), q(4602).
-p(4604) :- p(4603), q(4604).
-q(4604) :- p(4603), q(4603).
-p(4605) :- p(4604), q(4605).
-q(4605) :- p(4604), q(4604).
-p(4606) :- p(4605), q(4606).
-q(4606) :- p(4605), q(4605).
-p(4607) :- p(4606), q(4607).
-q(4607) :- p(4606), q(4606).
-p(4608) :- p(4607), q(4608).
-q(4608) :- p(4607), q(4607).
-p(4609) :- p(4608), q(4609).
-q(4609) :- p(4608), q(4608).
-p(4610) :- p(4609), q(4610).
-q(4610) :- p(4609), q(4609).
-p(4611) :- p(4610), q(4611).
-q(4611) :- p(4610), q(4610).
-p(4612) :- p(4611), q(4612).
-q(4612) :- p(4611), q(4611).
-p(4613) :- p(4612), q(4613).
-q(4613) :- p(4612), q(4612).
-p(4614) :- p(4613), q(4614).
-q(4614) :- p(4613), q(4613).
-p(4615) :- p(4614), q(4615).
-q(4615) :- p(4614), q(4614).
-p(4616) :- p(4615), q(4616).
-q(4616) :- p(4615), q(4615).
-p(4617) :- p(4616), q(4617).
-q(4617) :- p(4616), q(4616).
-p(4618) :- p(4617), q(4618).
-q(4618) :- p(4617), q(4617).
-p(4619) :- p(4618), q(4619).
-q(4619) :- p(4618), q(4618).
-p(4620) :- p(4619), q(4620).
-q(4620) :- p(4619), q(4619).
-p(4621) :- p(4620), q(4621).
-q(4621) :- p(4620), q(4620).
-p(4622) :- p(4621), q(4622).
-q(4622) :- p(4621), q(4621).
-p(4623) :- p(4622), q(4623).
-q(4623) :- p(4622), q(4622).
-p(4624) :- p(4623), q(4624).
-q(4624) :- p(4623), q(4623).
-p(4625) :- p(4624), q(4625).
-q(4625) :- p(4624), q(4624).
-p(4626) :- p(4625), q(4626).
-q(4626) :- p(4625), q(4625).
-p(4627) :- p(4626), q(4627).
-q(4627) :- p(4626), q(4626).
-p(4628) :- p(4627), q(4628).
-q(4628) :- p(4627), q(4627).
-p(4629) :- p(4628), q(4629).
-q(4629) :- p(4628), q(4628).
-p(4630) :- p(4629), q(4630).
-q(4630) :- p(4629), q(4629).
-p(4631) :- p(4630), q(4631).
-q(4631) :- p(4630), q(4630).
-p(4632) :- p(4631), q(4632).
-q(4632) :- p(4631), q(4631).
-p(4633) :- p(4632), q(4633).
-q(4633) :- p(4632), q(4632).
-p(4634) :- p(4633), q(4634).
-q(4634) :- p(4633), q(4633).
-p(4635) :- p(4634), q(4635).
-q(4635) :- p(4634), q(4634).
-p(4636) :- p(4635), q(4636).
-q(4636) :- p(4635), q(4635).
-p(4637) :- p(4636), q(4637).
-q(4637) :- p(4636), q(4636).
-p(4638) :- p(4637), q(4638).
-q(4638) :- p(4637), q(4637).
-p(4639) :- p(4638), q(4639).
-q(4639) :- p(4638), q(4638).
-p(4640) :- p(4639), q(4640).
-q(4640) :- p(4639), q(4639).
-p(4641) :- p(4640), q(4641).
-q(4641) :- p(4640), q(4640).
-p(4642) :- p(4641), q(4642).
-q(4642) :- p(4641), q(4641).
-p(4643) :- p(4642), q(4643).
-q(4643) :- p(4642), q(4642).
-p(4644) :- p(4643), q(4644).
-q(4644) :- p(4643), q(4643).
-p(4645) :- p(4644), q(4645).
-q(4645) :- p(4644), q(4644).
-p(4646) :- p(4645), q(4646).
-q(4646) :- p(4645), q(4645).
-p(4647) :- p(4646), q(4647).
-q(4647) :- p(4646), q(4646).
-p(4648) :- p(4647), q(4648).
-q(4648) :- p(4647), q(4647).
-p(4649) :- p(4648), q(4649).
-q(4649) :- p(4648), q(4648).
-p(4650) :- p(4649), q(4650).
-q(4650) :- p(4649), q(4649).
-p(4651) :- p(4650), q(4651).
-q(4651) :- p(4650), q(4650).
-p(4652) :- p(4651), q(4652).
-q(4652) :- p(4651), q(4651).
-p(4653) :- p(4652), q(4653).
-q(4653) :- p(4652), q(4652).
-p(4654) :- p(4653), q(4654).
-q(4654) :- p(4653), q(4653).
-p(4655) :- p(4654), q(4655).
-q(4655) :- p(4654), q(4654).
-p(4656) :- p(4655), q(4656).
-q(4656) :- p(4655), q(4655).
-p(4657) :- p(4656), q(4657).
-q(4657) :- p(4656), q(4656).
-p(4658) :- p(4657), q(4658).
-q(4658) :- p(4657), q(4657).
-p(4659) :- p(4658), q(4659).
-q(4659) :- p(4658), q(4658).
-p(4660) :- p(4659), q(4660).
-q(4660) :- p(4659), q(4659).
-p(4661) :- p(4660), q(4661).
-q(4661) :- p(4660), q(4660).
-p(4662) :- p(4661), q(4662).
-q(4662) :- p(4661), q(4661).
-p(4663) :- p(4662), q(4663).
-q(4663) :- p(4662), q(4662).
-p(4664) :- p(4663), q(4664).
-q(4664) :- p(4663), q(4663).
-p(4665) :- p(4664), q(4665).
-q(4665) :- p(4664), q(4664).
-p(4666) :- p(4665), q(4666).
-q(4666) :- p(4665), q(4665).
-p(4667) :- p(4666), q(4667).
-q(4667) :- p(4666), q(4666).
-p(4668) :- p(4667), q(4668).
-q(4668) :- p(4667), q(4667).
-p(4669) :- p(4668), q(4669).
-q(4669) :- p(4668), q(4668).
-p(4670) :- p(4669), q(4670).
-q(4670) :- p(4669), q(4669).
-p(4671) :- p(4670), q(4671).
-q(4671) :- p(4670), q(4670).
-p(4672) :- p(4671), q(4672).
-q(4672) :- p(4671), q(4671).
-p(4673) :- p(4672), q(4673).
-q(4673) :- p(4672), q(4672).
-p(4674) :- p(4673), q(4674).
-q(4674) :- p(4673), q(4673).
-p(4675) :- p(4674), q(4675).
-q(4675) :- p(4674), q(4674).
-p(4676) :- p(4675), q(4676).
-q(4676) :- p(4675), q(4675).
-p(4677) :- p(4676), q(4677).
-q(4677) :- p(4676), q(4676).
-p(4678) :- p(4677), q(4678).
-q(4678) :- p(4677), q(4677).
-p(4679) :- p(4678), q(4679).
-q(4679) :- p(4678), q(4678).
-p(4680) :- p(4679), q(4680).
-q(4680) :- p(4679), q(4679).
-p(4681) :- p(4680), q(4681).
-q(4681) :- p(4680), q(4680).
-p(4682) :- p(4681), q(4682).
-q(4682) :- p(4681), q(4681).
-p(4683) :- p(4682), q(4683).
-q(4683) :- p(4682), q(4682).
-p(4684) :- p(4683), q(4684).
-q(4684) :- p(4683), q(4683).
-p(4685) :- p(4684), q(4685).
-q(4685) :- p(4684), q(4684).
-p(4686) :- p(4685), q(4686).
-q(4686) :- p(4685), q(4685).
-p(4687) :- p(4686), q(4687).
-q(4687) :- p(4686), q(4686).
-p(4688) :- p(4687), q(4688).
-q(4688) :- p(4687), q(4687).
-p(4689) :- p(4688), q(4689).
-q(4689) :- p(4688), q(4688).
-p(4690) :- p(4689), q(4690).
-q(4690) :- p(4689), q(4689).
-p(4691) :- p(4690), q(4691).
-q(4691) :- p(4690), q(4690).
-p(4692) :- p(4691), q(4692).
-q(4692) :- p(4691), q(4691).
-p(4693) :- p(4692), q(4693).
-q(4693) :- p(4692), q(4692).
-p(4694) :- p(4693), q(4694).
-q(4694) :- p(4693), q(4693).
-p(4695) :- p(4694), q(4695).
-q(4695) :- p(4694), q(4694).
-p(4696) :- p(4695), q(4696).
-q(4696) :- p(4695), q(4695).
-p(4697) :- p(4696), q(4697).
-q(4697) :- p(4696), q(4696).
-p(4698) :- p(4697), q(4698).
-q(4698) :- p(4697), q(4697).
-p(4699) :- p(4698), q(4699).
-q(4699) :- p(4698), q(4698).
-p(4700) :- p(4699), q(4700).
-q(4700) :- p(4699), q(4699).
-p(4701) :- p(4700), q(4701).
-q(4701) :- p(4700), q(4700).
-p(4702) :- p(4701), q(4702).
-q(4702) :- p(4701), q(4701).
-p(4703) :- p(4702), q(4703).
-q(4703) :- p(4702), q(4702).
-p(4704) :- p(4703), q(4704).
-q(4704) :- p(4703), q(4703).
-p(4705) :- p(4704), q(4705).
-q(4705) :- p(4704), q(4704).
-p(4706) :- p(4705), q(4706).
-q(4706) :- p(4705), q(4705).
-p(4707) :- p(4706), q(4707).
-q(4707) :- p(4706), q(4706).
-p(4708) :- p(4707), q(4708).
-q(4708) :- p(4707), q(4707).
-p(4709) :- p(4708), q(4709).
-q(4709) :- p(4708), q(4708).
-p(4710) :- p(4709), q(4710).
-q(4710) :- p(4709), q(4709).
-p(4711) :- p(4710), q(4711).
-q(4711) :- p(4710), q(4710).
-p(4712) :- p(4711), q(4712).
-q(4712) :- p(4711), q(4711).
-p(4713) :- p(4712), q(4713).
-q(4713) :- p(4712), q(4712).
-p(4714) :- p(4713), q(4714).
-q(4714) :- p(4713), q(4713).
-p(4715) :- p(4714), q(4715).
-q(4715) :- p(4714), q(4714).
-p(4716) :- p(4715), q(4716).
-q(4716) :- p(4715), q(4715).
-p(4717) :- p(4716), q(4717).
-q(4717) :- p(4716), q(4716).
-p(4718) :- p(4717), q(4718).
-q(4718) :- p(4717), q(4717).
-p(4719) :- p(4718), q(4719).
-q(4719) :- p(4718), q(4718).
-p(4720) :- p(4719), q(4720).
-q(4720) :- p(4719), q(4719).
-p(4721) :- p(4720), q(4721).
-q(4721) :- p(4720), q(4720).
-p(4722) :- p(4721), q(4722).
-q(4722) :- p(4721), q(4721).
-p(4723) :- p(4722), q(4723).
-q(4723) :- p(4722), q(4722).
-p(4724) :- p(4723), q(4724).
-q(4724) :- p(4723), q(4723).
-p(4725) :- p(4724), q(4725).
-q(4725) :- p(4724), q(4724).
-p(4726) :- p(4725), q(4726).
-q(4726) :- p(4725), q(4725).
-p(4727) :- p(4726), q(4727).
-q(4727) :- p(4726), q(4726).
-p(4728) :- p(4727), q(4728).
-q(4728) :- p(4727), q(4727).
-p(4729) :- p(4728), q(4729).
-q(4729) :- p(4728), q(4728).
-p(4730) :- p(4729), q(4730).
-q(4730) :- p(4729), q(4729).
-p(4731) :- p(4730), q(4731).
-q(4731) :- p(4730), q(4730).
-p(4732) :- p(4731), q(4732).
-q(4732) :- p(4731), q(4731).
-p(4733) :- p(4732), q(4733).
-q(4733) :- p(4732), q(4732).
-p(4734) :- p(4733), q(4734).
-q(4734) :- p(4733), q(4733).
-p(4735) :- p(4734), q(4735).
-q(4735) :- p(4734), q(4734).
-p(4736) :- p(4735), q(4736).
-q(4736) :- p(4735), q(4735).
-p(4737) :- p(4736), q(4737).
-q(4737) :- p(4736), q(4736).
-p(4738) :- p(4737), q(4738).
-q(4738) :- p(4737), q(4737).
-p(4739) :- p(4738), q(4739).
-q(4739) :- p(4738), q(4738).
-p(4740) :- p(4739), q(4740).
-q(4740) :- p(4739), q(4739).
-p(4741) :- p(4740), q(4741).
-q(4741) :- p(4740), q(4740).
-p(4742) :- p(4741), q(4742).
-q(4742) :- p(4741), q(4741).
-p(4743) :- p(4742), q(4743).
-q(4743) :- p(4742), q(4742).
-p(4744) :- p(4743), q(4744).
-q(4744) :- p(4743), q(4743).
-p(4745) :- p(4744), q(4745).
-q(4745) :- p(4744), q(4744).
-p(4746) :- p(4745), q(4746).
-q(4746) :- p(4745), q(4745).
-p(4747) :- p(4746), q(4747).
-q(4747) :- p(4746), q(4746).
-p(4748) :- p(4747), q(4748).
-q(4748) :- p(4747), q(4747).
-p(4749) :- p(4748), q(4749).
-q(4749) :- p(4748), q(4748).
-p(4750) :- p(4749), q(4750).
-q(4750) :- p(4749), q(4749).
-p(4751) :- p(4750), q(4751).
-q(4751) :- p(4750), q(4750).
-p(4752) :- p(4751), q(4752).
-q(4752) :- p(4751), q(4751).
-p(4753) :- p(4752), q(4753).
-q(4753) :- p(4752), q(4752).
-p(4754) :- p(4753), q(4754).
-q(4754) :- p(4753), q(4753).
-p(4755) :- p(4754), q(4755).
-q(4755) :- p(4754), q(4754).
-p(4756) :- p(4755), q(4756).
-q(4756) :- p(4755), q(4755).
-p(4757) :- p(4756), q(4757).
-q(4757) :- p(4756), q(4756).
-p(4758) :- p(4757), q(4758).
-q(4758) :- p(4757), q(4757).
-p(4759) :- p(4758), q(4759).
-q(4759) :- p(4758), q(4758).
-p(4760) :- p(4759), q(4760).
-q(4760) :- p(4759), q(4759).
-p(4761) :- p(4760), q(4761).
-q(4761) :- p(4760), q(4760).
-p(4762) :- p(4761), q(4762).
-q(4762) :- p(4761), q(4761).
-p(4763) :- p(4762), q(4763).
-q(4763) :- p(4762), q(4762).
-p(4764) :- p(4763), q(4764).
-q(4764) :- p(4763), q(4763).
-p(4765) :- p(4764), q(4765).
-q(4765) :- p(4764), q(4764).
-p(4766) :- p(4765), q(4766).
-q(4766) :- p(4765), q(4765).
-p(4767) :- p(4766), q(4767).
-q(4767) :- p(4766), q(4766).
-p(4768) :- p(4767), q(4768).
-q(4768) :- p(4767), q(4767).
-p(4769) :- p(4768), q(4769).
-q(4769) :- p(4768), q(4768).
-p(4770) :- p(4769), q(4770).
-q(4770) :- p(4769), q(4769).
-p(4771) :- p(4770), q(4771).
-q(4771) :- p(4770), q(4770).
-p(4772) :- p(4771), q(4772).
-q(4772) :- p(4771), q(4771).
-p(4773) :- p(4772), q(4773).
-q(4773) :- p(4772), q(4772).
-p(4774) :- p(4773), q(4774).
-q(4774) :- p(4773), q(4773).
-p(4775) :- p(4774), q(4775).
-q(4775) :- p(4774), q(4774).
-p(4776) :- p(4775), q(4776).
-q(4776) :- p(4775), q(4775).
-p(4777) :- p(4776), q(4777).
-q(4777) :- p(4776), q(4776).
-p(4778) :- p(4777), q(4778).
-q(4778) :- p(4777), q(4777).
-p(4779) :- p(4778), q(4779).
-q(4779) :- p(4778), q(4778).
-p(4780) :- p(4779), q(4780).
-q(4780) :- p(4779), q(4779).
-p(4781) :- p(4780), q(4781).
-q(4781) :- p(4780), q(4780).
-p(4782) :- p(4781), q(4782).
-q(4782) :- p(4781), q(4781).
-p(4783) :- p(4782), q(4783).
-q(4783) :- p(4782), q(4782).
-p(4784) :- p(4783), q(4784).
-q(4784) :- p(4783), q(4783).
-p(4785) :- p(4784), q(4785).
-q(4785) :- p(4784), q(4784).
-p(4786) :- p(4785), q(4786).
-q(4786) :- p(4785), q(4785).
-p(4787) :- p(4786), q(4787).
-q(4787) :- p(4786), q(4786).
-p(4788) :- p(4787), q(4788).
-q(4788) :- p(4787), q(4787).
-p(4789) :- p(4788), q(4789).
-q(4789) :- p(4788), q(4788).
-p(4790) :- p(4789), q(4790).
-q(4790) :- p(4789), q(4789).
-p(4791) :- p(4790), q(4791).
-q(4791) :- p(4790), q(4790).
-p(4792) :- p(4791), q(4792).
-q(4792) :- p(4791), q(4791).
-p(4793) :- p(4792), q(4793).
-q(4793) :- p(4792), q(4792).
-p(4794) :- p(4793), q(4794).
-q(4794) :- p(4793), q(4793).
-p(4795) :- p(4794), q(4795).
-q(4795) :- p(4794), q(4794).
-p(4796) :- p(4795), q(4796).
-q(4796) :- p(4795), q(4795).
-p(4797) :- p(4796), q(4797).
-q(4797) :- p(4796), q(4796).
-p(4798) :- p(4797), q(4798).
-q(4798) :- p(4797), q(4797).
-p(4799) :- p(4798), q(4799).
-q(4799) :- p(4798), q(4798).
-p(4800) :- p(4799), q(4800).
-q(4800) :- p(4799), q(4799).
-p(4801) :- p(4800), q(4801).
-q(4801) :- p(4800), q(4800).
-p(4802) :- p(4801), q(4802).
-q(4802) :- p(4801), q(4801).
-p(4803) :- p(4802), q(4803).
-q(4803) :- p(4802), q(4802).
-p(4804) :- p(4803), q(4804).
-q(4804) :- p(4803), q(4803).
-p(4805) :- p(4804), q(4805).
-q(4805) :- p(4804), q(4804).
-p(4806) :- p(4805), q(4806).
-q(4806) :- p(4805), q(4805).
-p(4807) :- p(4806), q(4807).
-q(4807) :- p(4806), q(4806).
-p(4808) :- p(4807), q(4808).
-q(4808) :- p(4807), q(4807).
-p(4809) :- p(4808), q(4809).
-q(4809) :- p(4808), q(4808).
-p(4810) :- p(4809), q(4810).
-q(4810) :- p(4809), q(4809).
-p(4811) :- p(4810), q(4811).
-q(4811) :- p(4810), q(4810).
-p(4812) :- p(4811), q(4812).
-q(4812) :- p(4811), q(4811).
-p(4813) :- p(4812), q(4813).
-q(4813) :- p(4812), q(4812).
-p(4814) :- p(4813), q(4814).
-q(4814) :- p(4813), q(4813).
-p(4815) :- p(4814), q(4815).
-q(4815) :- p(4814), q(4814).
-p(4816) :- p(4815), q(4816).
-q(4816) :- p(4815), q(4815).
-p(4817) :- p(4816), q(4817).
-q(4817) :- p(4816), q(4816).
-p(4818) :- p(4817), q(4818).
-q(4818) :- p(4817), q(4817).
-p(4819) :- p(4818), q(4819).
-q(4819) :- p(4818), q(4818).
-p(4820) :- p(4819), q(4820).
-q(4820) :- p(4819), q(4819).
-p(4821) :- p(4820), q(4821).
-q(4821) :- p(4820), q(4820).
-p(4822) :- p(4821), q(4822).
-q(4822) :- p(4821), q(4821).
-p(4823) :- p(4822), q(4823).
-q(4823) :- p(4822), q(4822).
-p(4824) :- p(4823), q(4824).
-q(4824) :- p(4823), q(4823).
-p(4825) :- p(4824), q(4825).
-q(4825) :- p(4824), q(4824).
-p(4826) :- p(4825), q(4826).
-q(4826) :- p(4825), q(4825).
-p(4827) :- p(4826), q(4827).
-q(4827) :- p(4826), q(4826).
-p(4828) :- p(4827), q(4828).
-q(4828) :- p(4827), q(4827).
-p(4829) :- p(4828), q(4829).
-q(4829) :- p(4828), q(4828).
-p(4830) :- p(4829), q(4830).
-q(4830) :- p(4829), q(4829).
-p(4831) :- p(4830), q(4831).
-q(4831) :- p(4830), q(4830).
-p(4832) :- p(4831), q(4832).
-q(4832) :- p(4831), q(4831).
-p(4833) :- p(4832), q(4833).
-q(4833) :- p(4832), q(4832).
-p(4834) :- p(4833), q(4834).
-q(4834) :- p(4833), q(4833).
-p(4835) :- p(4834), q(4835).
-q(4835) :- p(4834), q(4834).
-p(4836) :- p(4835), q(4836).
-q(4836) :- p(4835), q(4835).
-p(4837) :- p(4836), q(4837).
-q(4837) :- p(4836), q(4836).
-p(4838) :- p(4837), q(4838).
-q(4838) :- p(4837), q(4837).
-p(4839) :- p(4838), q(4839).
-q(4839) :- p(4838), q(4838).
-p(4840) :- p(4839), q(4840).
-q(4840) :- p(4839), q(4839).
-p(4841) :- p(4840), q(4841).
-q(4841) :- p(4840), q(4840).
-p(4842) :- p(4841), q(4842).
-q(4842) :- p(4841), q(4841).
-p(4843) :- p(4842), q(4843).
-q(4843) :- p(4842), q(4842).
-p(4844) :- p(4843), q(4844).
-q(4844) :- p(4843), q(4843).
-p(4845) :- p(4844), q(4845).
-q(4845) :- p(4844), q(4844).
-p(4846) :- p(4845), q(4846).
-q(4846) :- p(4845), q(4845).
-p(4847) :- p(4846), q(4847).
-q(4847) :- p(4846), q(4846).
-p(4848) :- p(4847), q(4848).
-q(4848) :- p(4847), q(4847).
-p(4849) :- p(4848), q(4849).
-q(4849) :- p(4848), q(4848).
-p(4850) :- p(4849), q(4850).
-q(4850) :- p(4849), q(4849).
-p(4851) :- p(4850), q(4851).
-q(4851) :- p(4850), q(4850).
-p(4852) :- p(4851), q(4852).
-q(4852) :- p(4851), q(4851).
-p(4853) :- p(4852), q(4853).
-q(4853) :- p(4852), q(4852).
-p(4854) :- p(4853), q(4854).
-q(4854) :- p(4853), q(4853).
-p(4855) :- p(4854), q(4855).
-q(4855) :- p(4854), q(4854).
-p(4856) :- p(4855), q(4856).
-q(4856) :- p(4855), q(4855).
-p(4857) :- p(4856), q(4857).
-q(4857) :- p(4856), q(4856).
-p(4858) :- p(4857), q(4858).
-q(4858) :- p(4857), q(4857).
-p(4859) :- p(4858), q(4859).
-q(4859) :- p(4858), q(4858).
-p(4860) :- p(4859), q(4860).
-q(4860) :- p(4859), q(4859).
-p(4861) :- p(4860), q(4861).
-q(4861) :- p(4860), q(4860).
-p(4862) :- p(4861), q(4862).
-q(4862) :- p(4861), q(4861).
-p(4863) :- p(4862), q(4863).
-q(4863) :- p(4862), q(4862).
-p(4864) :- p(4863), q(4864).
-q(4864) :- p(4863), q(4863).
-p(4865) :- p(4864), q(4865).
-q(4865) :- p(4864), q(4864).
-p(4866) :- p(4865), q(4866).
-q(4866) :- p(4865), q(4865).
-p(4867) :- p(4866), q(4867).
-q(4867) :- p(4866), q(4866).
-p(4868) :- p(4867), q(4868).
-q(4868) :- p(4867), q(4867).
-p(4869) :- p(4868), q(4869).
-q(4869) :- p(4868), q(4868).
-p(4870) :- p(4869), q(4870).
-q(4870) :- p(4869), q(4869).
-p(4871) :- p(4870), q(4871).
-q(4871) :- p(4870), q(4870).
-p(4872) :- p(4871), q(4872).
-q(4872) :- p(4871), q(4871).
-p(4873) :- p(4872), q(4873).
-q(4873) :- p(4872), q(4872).
-p(4874) :- p(4873), q(4874).
-q(4874) :- p(4873), q(4873).
-p(4875) :- p(4874), q(4875).
-q(4875) :- p(4874), q(4874).
-p(4876) :- p(4875), q(4876).
-q(4876) :- p(4875), q(4875).
-p(4877) :- p(4876), q(4877).
-q(4877) :- p(4876), q(4876).
-p(4878) :- p(4877), q(4878).
-q(4878) :- p(4877), q(4877).
-p(4879) :- p(4878), q(4879).
-q(4879) :- p(4878), q(4878).
-p(4880) :- p(4879), q(4880).
-q(4880) :- p(4879), q(4879).
-p(4881) :- p(4880), q(4881).
-q(4881) :- p(4880), q(4880).
-p(4882) :- p(4881), q(4882).
-q(4882) :- p(4881), q(4881).
-p(4883) :- p(4882), q(4883).
-q(4883) :- p(4882), q(4882).
-p(4884) :- p(4883), q(4884).
-q(4884) :- p(4883), q(4883).
-p(4885) :- p(4884), q(4885).
-q(4885) :- p(4884), q(4884).
-p(4886) :- p(4885), q(4886).
-q(4886) :- p(4885), q(4885).
-p(4887) :- p(4886), q(4887).
-q(4887) :- p(4886), q(4886).
-p(4888) :- p(4887), q(4888).
-q(4888) :- p(4887), q(4887).
-p(4889) :- p(4888), q(4889).
-q(4889) :- p(4888), q(4888).
-p(4890) :- p(4889), q(4890).
-q(4890) :- p(4889), q(4889).
-p(4891) :- p(4890), q(4891).
-q(4891) :- p(4890), q(4890).
-p(4892) :- p(4891), q(4892).
-q(4892) :- p(4891), q(4891).
-p(4893) :- p(4892), q(4893).
-q(4893) :- p(4892), q(4892).
-p(4894) :- p(4893), q(4894).
-q(4894) :- p(4893), q(4893).
-p(4895) :- p(4894), q(4895).
-q(4895) :- p(4894), q(4894).
-p(4896) :- p(4895), q(4896).
-q(4896) :- p(4895), q(4895).
-p(4897) :- p(4896), q(4897).
-q(4897) :- p(4896), q(4896).
-p(4898) :- p(4897), q(4898).
-q(4898) :- p(4897), q(4897).
-p(4899) :- p(4898), q(4899).
-q(4899) :- p(4898), q(4898).
-p(4900) :- p(4899), q(4900).
-q(4900) :- p(4899), q(4899).
-p(4901) :- p(4900), q(4901).
-q(4901) :- p(4900), q(4900).
-p(4902) :- p(4901), q(4902).
-q(4902) :- p(4901), q(4901).
-p(4903) :- p(4902), q(4903).
-q(4903) :- p(4902), q(4902).
-p(4904) :- p(4903), q(4904).
-q(4904) :- p(4903), q(4903).
-p(4905) :- p(4904), q(4905).
-q(4905) :- p(4904), q(4904).
-p(4906) :- p(4905), q(4906).
-q(4906) :- p(4905), q(4905).
-p(4907) :- p(4906), q(4907).
-q(4907) :- p(4906), q(4906).
-p(4908) :- p(4907), q(4908).
-q(4908) :- p(4907), q(4907).
-p(4909) :- p(4908), q(4909).
-q(4909) :- p(4908), q(4908).
-p(4910) :- p(4909), q(4910).
-q(4910) :- p(4909), q(4909).
-p(4911) :- p(4910), q(4911).
-q(4911) :- p(4910), q(4910).
-p(4912) :- p(4911), q(4912).
-q(4912) :- p(4911), q(4911).
-p(4913) :- p(4912), q(4913).
-q(4913) :- p(4912), q(4912).
-p(4914) :- p(4913), q(4914).
-q(4914) :- p(4913), q(4913).
-p(4915) :- p(4914), q(4915).
-q(4915) :- p(4914), q(4914).
-p(4916) :- p(4915), q(4916).
-q(4916) :- p(4915), q(4915).
-p(4917) :- p(4916), q(4917).
-q(4917) :- p(4916), q(4916).
-p(4918) :- p(4917), q(4918).
-q(4918) :- p(4917), q(4917).
-p(4919) :- p(4918), q(4919).
-q(4919) :- p(4918), q(4918).
-p(4920) :- p(4919), q(4920).
-q(4920) :- p(4919), q(4919).
-p(4921) :- p(4920), q(4921).
-q(4921) :- p(4920), q(4920).
-p(4922) :- p(4921), q(4922).
-q(4922) :- p(4921), q(4921).
-p(4923) :- p(4922), q(4923).
-q(4923) :- p(4922), q(4922).
-p(4924) :- p(4923), q(4924).
-q(4924) :- p(4923), q(4923).
-p(4925) :- p(4924), q(4925).
-q(4925) :- p(4924), q(4924).
-p(4926) :- p(4925), q(4926).
-q(4926) :- p(4925), q(4925).
-p(4927) :- p(4926), q(4927).
-q(4927) :- p(4926), q(4926).
-p(4928) :- p(4927), q(4928).
-q(4928) :- p(4927), q(4927).
-p(4929) :- p(4928), q(4929).
-q(4929) :- p(4928), q(4928).
-p(4930) :- p(4929), q(4930).
-q(4930) :- p(4929), q(4929).
-p(4931) :- p(4930), q(4931).
-q(4931) :- p(4930), q(4930).
-p(4932) :- p(4931), q(4932).
-q(4932) :- p(4931), q(4931).
-p(4933) :- p(4932), q(4933).
-q(4933) :- p(4932), q(4932).
-p(4934) :- p(4933), q(4934).
-q(4934) :- p(4933), q(4933).
-p(4935) :- p(4934), q(4935).
-q(4935) :- p(4934), q(4934).
-p(4936) :- p(4935), q(4936).
-q(4936) :- p(4935), q(4935).
-p(4937) :- p(4936), q(4937).
-q(4937) :- p(4936), q(4936).
-p(4938) :- p(4937), q(4938).
-q(4938) :- p(4937), q(4937).
-p(4939) :- p(4938), q(4939).
-q(4939) :- p(4938), q(4938).
-p(4940) :- p(4939), q(4940).
-q(4940) :- p(4939), q(4939).
-p(4941) :- p(4940), q(4941).
-q(4941) :- p(4940), q(4940).
-p(4942) :- p(4941), q(4942).
-q(4942) :- p(4941), q(4941).
-p(4943) :- p(4942), q(4943).
-q(4943) :- p(4942), q(4942).
-p(4944) :- p(4943), q(4944).
-q(4944) :- p(4943), q(4943).
-p(4945) :- p(4944), q(4945).
-q(4945) :- p(4944), q(4944).
-p(4946) :- p(4945), q(4946).
-q(4946) :- p(4945), q(4945).
-p(4947) :- p(4946), q(4947).
-q(4947) :- p(4946), q(4946).
-p(4948) :- p(4947), q(4948).
-q(4948) :- p(4947), q(4947).
-p(4949) :- p(4948), q(4949).
-q(4949) :- p(4948), q(4948).
-p(4950) :- p(4949), q(4950).
-q(4950) :- p(4949), q(4949).
-p(4951) :- p(4950), q(4951).
-q(4951) :- p(4950), q(4950).
-p(4952) :- p(4951), q(4952).
-q(4952) :- p(4951), q(4951).
-p(4953) :- p(4952), q(4953).
-q(4953) :- p(4952), q(4952).
-p(4954) :- p(4953), q(4954).
-q(4954) :- p(4953), q(4953).
-p(4955) :- p(4954), q(4955).
-q(4955) :- p(4954), q(4954).
-p(4956) :- p(4955), q(4956).
-q(4956) :- p(4955), q(4955).
-p(4957) :- p(4956), q(4957).
-q(4957) :- p(4956), q(4956).
-p(4958) :- p(4957), q(4958).
-q(4958) :- p(4957), q(4957).
-p(4959) :- p(4958), q(4959).
-q(4959) :- p(4958), q(4958).
-p(4960) :- p(4959), q(4960).
-q(4960) :- p(4959), q(4959).
-p(4961) :- p(4960), q(4961).
-q(4961) :- p(4960), q(4960).
-p(4962) :- p(4961), q(4962).
-q(4962) :- p(4961), q(4961).
-p(4963) :- p(4962), q(4963).
-q(4963) :- p(4962), q(4962).
-p(4964) :- p(4963), q(4964).
-q(4964) :- p(4963), q(4963).
-p(4965) :- p(4964), q(4965).
-q(4965) :- p(4964), q(4964).
-p(4966) :- p(4965), q(4966).
-q(4966) :- p(4965), q(4965).
-p(4967) :- p(4966), q(4967).
-q(4967) :- p(4966), q(4966).
-p(4968) :- p(4967), q(4968).
-q(4968) :- p(4967), q(4967).
-p(4969) :- p(4968), q(4969).
-q(4969) :- p(4968), q(4968).
-p(4970) :- p(4969), q(4970).
-q(4970) :- p(4969), q(4969).
-p(4971) :- p(4970), q(4971).
-q(4971) :- p(4970), q(4970).
-p(4972) :- p(4971), q(4972).
-q(4972) :- p(4971), q(4971).
-p(4973) :- p(4972), q(4973).
-q(4973) :- p(4972), q(4972).
-p(4974) :- p(4973), q(4974).
-q(4974) :- p(4973), q(4973).
-p(4975) :- p(4974), q(4975).
-q(4975) :- p(4974), q(4974).
-p(4976) :- p(4975), q(4976).
-q(4976) :- p(4975), q(4975).
-p(4977) :- p(4976), q(4977).
-q(4977) :- p(4976), q(4976).
-p(4978) :- p(4977), q(4978).
-q(4978) :- p(4977), q(4977).
-p(4979) :- p(4978), q(4979).
-q(4979) :- p(4978), q(4978).
-p(4980) :- p(4979), q(4980).
-q(4980) :- p(4979), q(4979).
-p(4981) :- p(4980), q(4981).
-q(4981) :- p(4980), q(4980).
-p(4982) :- p(4981), q(4982).
-q(4982) :- p(4981), q(4981).
-p(4983) :- p(4982), q(4983).
-q(4983) :- p(4982), q(4982).
-p(4984) :- p(4983), q(4984).
-q(4984) :- p(4983), q(4983).
-p(4985) :- p(4984), q(4985).
-q(4985) :- p(4984), q(4984).
-p(4986) :- p(4985), q(4986).
-q(4986) :- p(4985), q(4985).
-p(4987) :- p(4986), q(4987).
-q(4987) :- p(4986), q(4986).
-p(4988) :- p(4987), q(4988).
-q(4988) :- p(4987), q(4987).
-p(4989) :- p(4988), q(4989).
-q(4989) :- p(4988), q(4988).
-p(4990) :- p(4989), q(4990).
-q(4990) :- p(4989), q(4989).
-p(4991) :- p(4990), q(4991).
-q(4991) :- p(4990), q(4990).
-p(4992) :- p(4991), q(4992).
-q(4992) :- p(4991), q(4991).
-p(4993) :- p(4992), q(4993).
-q(4993) :- p(4992), q(4992).
-p(4994) :- p(4993), q(4994).
-q(4994) :- p(4993), q(4993).
-p(4995) :- p(4994), q(4995).
-q(4995) :- p(4994), q(4994).
-p(4996) :- p(4995), q(4996).
-q(4996) :- p(4995), q(4995).
-p(4997) :- p(4996), q(4997).
-q(4997) :- p(4996), q(4996).
-p(4998) :- p(4997), q(4998).
-q(4998) :- p(4997), q(4997).
-p(4999) :- p(4998), q(4999).
-q(4999) :- p(4998), q(4998).
-p(5000) :- p(4999), q(5000).
-q(5000) :- p(4999), q(4999).
-p(0).
-q(0).